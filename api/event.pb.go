@@ -23,26 +23,305 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EventStatus int32
+
+const (
+	EventStatus_DRAFT     EventStatus = 0
+	EventStatus_SCHEDULED EventStatus = 1
+	EventStatus_LIVE      EventStatus = 2
+	EventStatus_PAUSED    EventStatus = 3
+	EventStatus_ENDED     EventStatus = 4
+	EventStatus_CANCELLED EventStatus = 5
+)
+
+// Enum value maps for EventStatus.
+var (
+	EventStatus_name = map[int32]string{
+		0: "DRAFT",
+		1: "SCHEDULED",
+		2: "LIVE",
+		3: "PAUSED",
+		4: "ENDED",
+		5: "CANCELLED",
+	}
+	EventStatus_value = map[string]int32{
+		"DRAFT":     0,
+		"SCHEDULED": 1,
+		"LIVE":      2,
+		"PAUSED":    3,
+		"ENDED":     4,
+		"CANCELLED": 5,
+	}
+)
+
+func (x EventStatus) Enum() *EventStatus {
+	p := new(EventStatus)
+	*p = x
+	return p
+}
+
+func (x EventStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[0].Descriptor()
+}
+
+func (EventStatus) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[0]
+}
+
+func (x EventStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventStatus.Descriptor instead.
+func (EventStatus) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{0}
+}
+
+type EventRoundResultOrder int32
+
+const (
+	EventRoundResultOrder_ASC  EventRoundResultOrder = 0
+	EventRoundResultOrder_DESC EventRoundResultOrder = 1
+)
+
+// Enum value maps for EventRoundResultOrder.
+var (
+	EventRoundResultOrder_name = map[int32]string{
+		0: "ASC",
+		1: "DESC",
+	}
+	EventRoundResultOrder_value = map[string]int32{
+		"ASC":  0,
+		"DESC": 1,
+	}
+)
+
+func (x EventRoundResultOrder) Enum() *EventRoundResultOrder {
+	p := new(EventRoundResultOrder)
+	*p = x
+	return p
+}
+
+func (x EventRoundResultOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventRoundResultOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[1].Descriptor()
+}
+
+func (EventRoundResultOrder) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[1]
+}
+
+func (x EventRoundResultOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventRoundResultOrder.Descriptor instead.
+func (EventRoundResultOrder) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{1}
+}
+
+type EventRoundResultAggregation int32
+
+const (
+	EventRoundResultAggregation_LATEST         EventRoundResultAggregation = 0
+	EventRoundResultAggregation_BEST           EventRoundResultAggregation = 1
+	EventRoundResultAggregation_SUM            EventRoundResultAggregation = 2
+	EventRoundResultAggregation_AVERAGE_OF_TOP EventRoundResultAggregation = 3
+)
+
+// Enum value maps for EventRoundResultAggregation.
+var (
+	EventRoundResultAggregation_name = map[int32]string{
+		0: "LATEST",
+		1: "BEST",
+		2: "SUM",
+		3: "AVERAGE_OF_TOP",
+	}
+	EventRoundResultAggregation_value = map[string]int32{
+		"LATEST":         0,
+		"BEST":           1,
+		"SUM":            2,
+		"AVERAGE_OF_TOP": 3,
+	}
+)
+
+func (x EventRoundResultAggregation) Enum() *EventRoundResultAggregation {
+	p := new(EventRoundResultAggregation)
+	*p = x
+	return p
+}
+
+func (x EventRoundResultAggregation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventRoundResultAggregation) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[2].Descriptor()
+}
+
+func (EventRoundResultAggregation) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[2]
+}
+
+func (x EventRoundResultAggregation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventRoundResultAggregation.Descriptor instead.
+func (EventRoundResultAggregation) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{2}
+}
+
+type EventRoundScoringFormulaType int32
+
+const (
+	EventRoundScoringFormulaType_PERCENTILE_BANDS       EventRoundScoringFormulaType = 0
+	EventRoundScoringFormulaType_LINEAR_DECAY           EventRoundScoringFormulaType = 1
+	EventRoundScoringFormulaType_PROPORTIONAL_TO_RESULT EventRoundScoringFormulaType = 2
+)
+
+// Enum value maps for EventRoundScoringFormulaType.
+var (
+	EventRoundScoringFormulaType_name = map[int32]string{
+		0: "PERCENTILE_BANDS",
+		1: "LINEAR_DECAY",
+		2: "PROPORTIONAL_TO_RESULT",
+	}
+	EventRoundScoringFormulaType_value = map[string]int32{
+		"PERCENTILE_BANDS":       0,
+		"LINEAR_DECAY":           1,
+		"PROPORTIONAL_TO_RESULT": 2,
+	}
+)
+
+func (x EventRoundScoringFormulaType) Enum() *EventRoundScoringFormulaType {
+	p := new(EventRoundScoringFormulaType)
+	*p = x
+	return p
+}
+
+func (x EventRoundScoringFormulaType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventRoundScoringFormulaType) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[3].Descriptor()
+}
+
+func (EventRoundScoringFormulaType) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[3]
+}
+
+func (x EventRoundScoringFormulaType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventRoundScoringFormulaType.Descriptor instead.
+func (EventRoundScoringFormulaType) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{3}
+}
+
+type EventThirdPartyDeliveryField int32
+
+const (
+	EventThirdPartyDeliveryField_ID             EventThirdPartyDeliveryField = 0
+	EventThirdPartyDeliveryField_EVENT_ID       EventThirdPartyDeliveryField = 1
+	EventThirdPartyDeliveryField_EVENT_USER_ID  EventThirdPartyDeliveryField = 2
+	EventThirdPartyDeliveryField_CLIENT_USER_ID EventThirdPartyDeliveryField = 3
+	EventThirdPartyDeliveryField_RESULT         EventThirdPartyDeliveryField = 4
+	EventThirdPartyDeliveryField_SCORE          EventThirdPartyDeliveryField = 5
+	EventThirdPartyDeliveryField_RANKING        EventThirdPartyDeliveryField = 6
+	EventThirdPartyDeliveryField_DATA           EventThirdPartyDeliveryField = 7
+	EventThirdPartyDeliveryField_CREATED_AT     EventThirdPartyDeliveryField = 8
+	EventThirdPartyDeliveryField_UPDATED_AT     EventThirdPartyDeliveryField = 9
+)
+
+// Enum value maps for EventThirdPartyDeliveryField.
+var (
+	EventThirdPartyDeliveryField_name = map[int32]string{
+		0: "ID",
+		1: "EVENT_ID",
+		2: "EVENT_USER_ID",
+		3: "CLIENT_USER_ID",
+		4: "RESULT",
+		5: "SCORE",
+		6: "RANKING",
+		7: "DATA",
+		8: "CREATED_AT",
+		9: "UPDATED_AT",
+	}
+	EventThirdPartyDeliveryField_value = map[string]int32{
+		"ID":             0,
+		"EVENT_ID":       1,
+		"EVENT_USER_ID":  2,
+		"CLIENT_USER_ID": 3,
+		"RESULT":         4,
+		"SCORE":          5,
+		"RANKING":        6,
+		"DATA":           7,
+		"CREATED_AT":     8,
+		"UPDATED_AT":     9,
+	}
+)
+
+func (x EventThirdPartyDeliveryField) Enum() *EventThirdPartyDeliveryField {
+	p := new(EventThirdPartyDeliveryField)
+	*p = x
+	return p
+}
+
+func (x EventThirdPartyDeliveryField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventThirdPartyDeliveryField) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[4].Descriptor()
+}
+
+func (EventThirdPartyDeliveryField) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[4]
+}
+
+func (x EventThirdPartyDeliveryField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventThirdPartyDeliveryField.Descriptor instead.
+func (EventThirdPartyDeliveryField) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{4}
+}
+
 type CreateEventResponse_Error int32
 
 const (
-	CreateEventResponse_NONE                             CreateEventResponse_Error = 0
-	CreateEventResponse_NAME_TOO_SHORT                   CreateEventResponse_Error = 1
-	CreateEventResponse_NAME_TOO_LONG                    CreateEventResponse_Error = 2
-	CreateEventResponse_DATA_REQUIRED                    CreateEventResponse_Error = 3
-	CreateEventResponse_STARTED_AT_REQUIRED              CreateEventResponse_Error = 4
-	CreateEventResponse_STARTED_AT_IN_THE_PAST           CreateEventResponse_Error = 5
-	CreateEventResponse_ROUNDS_REQUIRED                  CreateEventResponse_Error = 6
-	CreateEventResponse_TOO_MANY_ROUNDS                  CreateEventResponse_Error = 7
-	CreateEventResponse_ROUND_NAME_TOO_SHORT             CreateEventResponse_Error = 8
-	CreateEventResponse_ROUND_NAME_TOO_LONG              CreateEventResponse_Error = 9
-	CreateEventResponse_ROUND_DATA_REQUIRED              CreateEventResponse_Error = 10
-	CreateEventResponse_ROUND_ENDED_AT_REQUIRED          CreateEventResponse_Error = 11
-	CreateEventResponse_ROUND_ENDED_AT_BEFORE_STARTED_AT CreateEventResponse_Error = 12
-	CreateEventResponse_ROUND_SCORING_REQUIRED           CreateEventResponse_Error = 13
-	CreateEventResponse_ALREADY_EXISTS                   CreateEventResponse_Error = 14
-	CreateEventResponse_DUPLICATE_ROUND_NAME             CreateEventResponse_Error = 15
-	CreateEventResponse_DUPLICATE_ROUND_ENDED_AT         CreateEventResponse_Error = 16
+	CreateEventResponse_NONE                                   CreateEventResponse_Error = 0
+	CreateEventResponse_NAME_TOO_SHORT                         CreateEventResponse_Error = 1
+	CreateEventResponse_NAME_TOO_LONG                          CreateEventResponse_Error = 2
+	CreateEventResponse_DATA_REQUIRED                          CreateEventResponse_Error = 3
+	CreateEventResponse_STARTED_AT_REQUIRED                    CreateEventResponse_Error = 4
+	CreateEventResponse_STARTED_AT_IN_THE_PAST                 CreateEventResponse_Error = 5
+	CreateEventResponse_ROUNDS_REQUIRED                        CreateEventResponse_Error = 6
+	CreateEventResponse_TOO_MANY_ROUNDS                        CreateEventResponse_Error = 7
+	CreateEventResponse_ROUND_NAME_TOO_SHORT                   CreateEventResponse_Error = 8
+	CreateEventResponse_ROUND_NAME_TOO_LONG                    CreateEventResponse_Error = 9
+	CreateEventResponse_ROUND_DATA_REQUIRED                    CreateEventResponse_Error = 10
+	CreateEventResponse_ROUND_ENDED_AT_REQUIRED                CreateEventResponse_Error = 11
+	CreateEventResponse_ROUND_ENDED_AT_BEFORE_STARTED_AT       CreateEventResponse_Error = 12
+	CreateEventResponse_ROUND_SCORING_REQUIRED                 CreateEventResponse_Error = 13
+	CreateEventResponse_ALREADY_EXISTS                         CreateEventResponse_Error = 14
+	CreateEventResponse_DUPLICATE_ROUND_NAME                   CreateEventResponse_Error = 15
+	CreateEventResponse_DUPLICATE_ROUND_ENDED_AT               CreateEventResponse_Error = 16
+	CreateEventResponse_ROUND_SCORING_INVALID                  CreateEventResponse_Error = 17
+	CreateEventResponse_ROUND_RESULT_AGGREGATION_TOP_REQUIRED  CreateEventResponse_Error = 18
+	CreateEventResponse_REGISTRATION_CLOSES_AT_BEFORE_OPENS_AT CreateEventResponse_Error = 19
+	CreateEventResponse_ELIGIBILITY_INVALID                    CreateEventResponse_Error = 20
 )
 
 // Enum value maps for CreateEventResponse_Error.
@@ -65,25 +344,33 @@ var (
 		14: "ALREADY_EXISTS",
 		15: "DUPLICATE_ROUND_NAME",
 		16: "DUPLICATE_ROUND_ENDED_AT",
+		17: "ROUND_SCORING_INVALID",
+		18: "ROUND_RESULT_AGGREGATION_TOP_REQUIRED",
+		19: "REGISTRATION_CLOSES_AT_BEFORE_OPENS_AT",
+		20: "ELIGIBILITY_INVALID",
 	}
 	CreateEventResponse_Error_value = map[string]int32{
-		"NONE":                             0,
-		"NAME_TOO_SHORT":                   1,
-		"NAME_TOO_LONG":                    2,
-		"DATA_REQUIRED":                    3,
-		"STARTED_AT_REQUIRED":              4,
-		"STARTED_AT_IN_THE_PAST":           5,
-		"ROUNDS_REQUIRED":                  6,
-		"TOO_MANY_ROUNDS":                  7,
-		"ROUND_NAME_TOO_SHORT":             8,
-		"ROUND_NAME_TOO_LONG":              9,
-		"ROUND_DATA_REQUIRED":              10,
-		"ROUND_ENDED_AT_REQUIRED":          11,
-		"ROUND_ENDED_AT_BEFORE_STARTED_AT": 12,
-		"ROUND_SCORING_REQUIRED":           13,
-		"ALREADY_EXISTS":                   14,
-		"DUPLICATE_ROUND_NAME":             15,
-		"DUPLICATE_ROUND_ENDED_AT":         16,
+		"NONE":                                   0,
+		"NAME_TOO_SHORT":                         1,
+		"NAME_TOO_LONG":                          2,
+		"DATA_REQUIRED":                          3,
+		"STARTED_AT_REQUIRED":                    4,
+		"STARTED_AT_IN_THE_PAST":                 5,
+		"ROUNDS_REQUIRED":                        6,
+		"TOO_MANY_ROUNDS":                        7,
+		"ROUND_NAME_TOO_SHORT":                   8,
+		"ROUND_NAME_TOO_LONG":                    9,
+		"ROUND_DATA_REQUIRED":                    10,
+		"ROUND_ENDED_AT_REQUIRED":                11,
+		"ROUND_ENDED_AT_BEFORE_STARTED_AT":       12,
+		"ROUND_SCORING_REQUIRED":                 13,
+		"ALREADY_EXISTS":                         14,
+		"DUPLICATE_ROUND_NAME":                   15,
+		"DUPLICATE_ROUND_ENDED_AT":               16,
+		"ROUND_SCORING_INVALID":                  17,
+		"ROUND_RESULT_AGGREGATION_TOP_REQUIRED":  18,
+		"REGISTRATION_CLOSES_AT_BEFORE_OPENS_AT": 19,
+		"ELIGIBILITY_INVALID":                    20,
 	}
 )
 
@@ -98,11 +385,11 @@ func (x CreateEventResponse_Error) String() string {
 }
 
 func (CreateEventResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_proto_enumTypes[0].Descriptor()
+	return file_event_proto_enumTypes[5].Descriptor()
 }
 
 func (CreateEventResponse_Error) Type() protoreflect.EnumType {
-	return &file_event_proto_enumTypes[0]
+	return &file_event_proto_enumTypes[5]
 }
 
 func (x CreateEventResponse_Error) Number() protoreflect.EnumNumber {
@@ -153,11 +440,11 @@ func (x GetEventResponse_Error) String() string {
 }
 
 func (GetEventResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_proto_enumTypes[1].Descriptor()
+	return file_event_proto_enumTypes[6].Descriptor()
 }
 
 func (GetEventResponse_Error) Type() protoreflect.EnumType {
-	return &file_event_proto_enumTypes[1]
+	return &file_event_proto_enumTypes[6]
 }
 
 func (x GetEventResponse_Error) Number() protoreflect.EnumNumber {
@@ -211,11 +498,11 @@ func (x UpdateEventResponse_Error) String() string {
 }
 
 func (UpdateEventResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_proto_enumTypes[2].Descriptor()
+	return file_event_proto_enumTypes[7].Descriptor()
 }
 
 func (UpdateEventResponse_Error) Type() protoreflect.EnumType {
-	return &file_event_proto_enumTypes[2]
+	return &file_event_proto_enumTypes[7]
 }
 
 func (x UpdateEventResponse_Error) Number() protoreflect.EnumNumber {
@@ -224,7 +511,7 @@ func (x UpdateEventResponse_Error) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UpdateEventResponse_Error.Descriptor instead.
 func (UpdateEventResponse_Error) EnumDescriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{7, 0}
+	return file_event_proto_rawDescGZIP(), []int{9, 0}
 }
 
 type EventResponse_Error int32
@@ -266,11 +553,11 @@ func (x EventResponse_Error) String() string {
 }
 
 func (EventResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_proto_enumTypes[3].Descriptor()
+	return file_event_proto_enumTypes[8].Descriptor()
 }
 
 func (EventResponse_Error) Type() protoreflect.EnumType {
-	return &file_event_proto_enumTypes[3]
+	return &file_event_proto_enumTypes[8]
 }
 
 func (x EventResponse_Error) Number() protoreflect.EnumNumber {
@@ -279,7 +566,7 @@ func (x EventResponse_Error) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EventResponse_Error.Descriptor instead.
 func (EventResponse_Error) EnumDescriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{8, 0}
+	return file_event_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type CreateEventRoundResponse_Error int32
@@ -300,6 +587,8 @@ const (
 	CreateEventRoundResponse_NOT_FOUND                              CreateEventRoundResponse_Error = 12
 	CreateEventRoundResponse_DUPLICATE_ROUND_NAME                   CreateEventRoundResponse_Error = 13
 	CreateEventRoundResponse_DUPLICATE_ROUND_ENDED_AT               CreateEventRoundResponse_Error = 14
+	CreateEventRoundResponse_ROUND_SCORING_INVALID                  CreateEventRoundResponse_Error = 15
+	CreateEventRoundResponse_ROUND_RESULT_AGGREGATION_TOP_REQUIRED  CreateEventRoundResponse_Error = 16
 )
 
 // Enum value maps for CreateEventRoundResponse_Error.
@@ -320,6 +609,8 @@ var (
 		12: "NOT_FOUND",
 		13: "DUPLICATE_ROUND_NAME",
 		14: "DUPLICATE_ROUND_ENDED_AT",
+		15: "ROUND_SCORING_INVALID",
+		16: "ROUND_RESULT_AGGREGATION_TOP_REQUIRED",
 	}
 	CreateEventRoundResponse_Error_value = map[string]int32{
 		"NONE":                                   0,
@@ -337,6 +628,8 @@ var (
 		"NOT_FOUND":                              12,
 		"DUPLICATE_ROUND_NAME":                   13,
 		"DUPLICATE_ROUND_ENDED_AT":               14,
+		"ROUND_SCORING_INVALID":                  15,
+		"ROUND_RESULT_AGGREGATION_TOP_REQUIRED":  16,
 	}
 )
 
@@ -351,11 +644,11 @@ func (x CreateEventRoundResponse_Error) String() string {
 }
 
 func (CreateEventRoundResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_proto_enumTypes[4].Descriptor()
+	return file_event_proto_enumTypes[9].Descriptor()
 }
 
 func (CreateEventRoundResponse_Error) Type() protoreflect.EnumType {
-	return &file_event_proto_enumTypes[4]
+	return &file_event_proto_enumTypes[9]
 }
 
 func (x CreateEventRoundResponse_Error) Number() protoreflect.EnumNumber {
@@ -364,7 +657,7 @@ func (x CreateEventRoundResponse_Error) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CreateEventRoundResponse_Error.Descriptor instead.
 func (CreateEventRoundResponse_Error) EnumDescriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{10, 0}
+	return file_event_proto_rawDescGZIP(), []int{12, 0}
 }
 
 type GetEventRoundResponse_Error int32
@@ -409,11 +702,11 @@ func (x GetEventRoundResponse_Error) String() string {
 }
 
 func (GetEventRoundResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_proto_enumTypes[5].Descriptor()
+	return file_event_proto_enumTypes[10].Descriptor()
 }
 
 func (GetEventRoundResponse_Error) Type() protoreflect.EnumType {
-	return &file_event_proto_enumTypes[5]
+	return &file_event_proto_enumTypes[10]
 }
 
 func (x GetEventRoundResponse_Error) Number() protoreflect.EnumNumber {
@@ -422,19 +715,21 @@ func (x GetEventRoundResponse_Error) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetEventRoundResponse_Error.Descriptor instead.
 func (GetEventRoundResponse_Error) EnumDescriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{13, 0}
+	return file_event_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type UpdateEventRoundResponse_Error int32
 
 const (
-	UpdateEventRoundResponse_NONE                       UpdateEventRoundResponse_Error = 0
-	UpdateEventRoundResponse_NAME_TOO_SHORT             UpdateEventRoundResponse_Error = 1
-	UpdateEventRoundResponse_NAME_TOO_LONG              UpdateEventRoundResponse_Error = 2
-	UpdateEventRoundResponse_ID_OR_NAME_REQUIRED        UpdateEventRoundResponse_Error = 3
-	UpdateEventRoundResponse_EVENT_ROUND_OR_ID_REQUIRED UpdateEventRoundResponse_Error = 4
-	UpdateEventRoundResponse_NO_UPDATE_SPECIFIED        UpdateEventRoundResponse_Error = 5
-	UpdateEventRoundResponse_NOT_FOUND                  UpdateEventRoundResponse_Error = 6
+	UpdateEventRoundResponse_NONE                            UpdateEventRoundResponse_Error = 0
+	UpdateEventRoundResponse_NAME_TOO_SHORT                  UpdateEventRoundResponse_Error = 1
+	UpdateEventRoundResponse_NAME_TOO_LONG                   UpdateEventRoundResponse_Error = 2
+	UpdateEventRoundResponse_ID_OR_NAME_REQUIRED             UpdateEventRoundResponse_Error = 3
+	UpdateEventRoundResponse_EVENT_ROUND_OR_ID_REQUIRED      UpdateEventRoundResponse_Error = 4
+	UpdateEventRoundResponse_NO_UPDATE_SPECIFIED             UpdateEventRoundResponse_Error = 5
+	UpdateEventRoundResponse_NOT_FOUND                       UpdateEventRoundResponse_Error = 6
+	UpdateEventRoundResponse_SCORING_INVALID                 UpdateEventRoundResponse_Error = 7
+	UpdateEventRoundResponse_RESULT_AGGREGATION_TOP_REQUIRED UpdateEventRoundResponse_Error = 8
 )
 
 // Enum value maps for UpdateEventRoundResponse_Error.
@@ -447,15 +742,19 @@ var (
 		4: "EVENT_ROUND_OR_ID_REQUIRED",
 		5: "NO_UPDATE_SPECIFIED",
 		6: "NOT_FOUND",
+		7: "SCORING_INVALID",
+		8: "RESULT_AGGREGATION_TOP_REQUIRED",
 	}
 	UpdateEventRoundResponse_Error_value = map[string]int32{
-		"NONE":                       0,
-		"NAME_TOO_SHORT":             1,
-		"NAME_TOO_LONG":              2,
-		"ID_OR_NAME_REQUIRED":        3,
-		"EVENT_ROUND_OR_ID_REQUIRED": 4,
-		"NO_UPDATE_SPECIFIED":        5,
-		"NOT_FOUND":                  6,
+		"NONE":                            0,
+		"NAME_TOO_SHORT":                  1,
+		"NAME_TOO_LONG":                   2,
+		"ID_OR_NAME_REQUIRED":             3,
+		"EVENT_ROUND_OR_ID_REQUIRED":      4,
+		"NO_UPDATE_SPECIFIED":             5,
+		"NOT_FOUND":                       6,
+		"SCORING_INVALID":                 7,
+		"RESULT_AGGREGATION_TOP_REQUIRED": 8,
 	}
 )
 
@@ -470,11 +769,11 @@ func (x UpdateEventRoundResponse_Error) String() string {
 }
 
 func (UpdateEventRoundResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_proto_enumTypes[6].Descriptor()
+	return file_event_proto_enumTypes[11].Descriptor()
 }
 
 func (UpdateEventRoundResponse_Error) Type() protoreflect.EnumType {
-	return &file_event_proto_enumTypes[6]
+	return &file_event_proto_enumTypes[11]
 }
 
 func (x UpdateEventRoundResponse_Error) Number() protoreflect.EnumNumber {
@@ -483,7 +782,7 @@ func (x UpdateEventRoundResponse_Error) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UpdateEventRoundResponse_Error.Descriptor instead.
 func (UpdateEventRoundResponse_Error) EnumDescriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{15, 0}
+	return file_event_proto_rawDescGZIP(), []int{17, 0}
 }
 
 type GetEventUserResponse_Error int32
@@ -531,11 +830,11 @@ func (x GetEventUserResponse_Error) String() string {
 }
 
 func (GetEventUserResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_proto_enumTypes[7].Descriptor()
+	return file_event_proto_enumTypes[12].Descriptor()
 }
 
 func (GetEventUserResponse_Error) Type() protoreflect.EnumType {
-	return &file_event_proto_enumTypes[7]
+	return &file_event_proto_enumTypes[12]
 }
 
 func (x GetEventUserResponse_Error) Number() protoreflect.EnumNumber {
@@ -544,7 +843,7 @@ func (x GetEventUserResponse_Error) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetEventUserResponse_Error.Descriptor instead.
 func (GetEventUserResponse_Error) EnumDescriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{18, 0}
+	return file_event_proto_rawDescGZIP(), []int{20, 0}
 }
 
 type UpdateEventUserResponse_Error int32
@@ -595,11 +894,11 @@ func (x UpdateEventUserResponse_Error) String() string {
 }
 
 func (UpdateEventUserResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_proto_enumTypes[8].Descriptor()
+	return file_event_proto_enumTypes[13].Descriptor()
 }
 
 func (UpdateEventUserResponse_Error) Type() protoreflect.EnumType {
-	return &file_event_proto_enumTypes[8]
+	return &file_event_proto_enumTypes[13]
 }
 
 func (x UpdateEventUserResponse_Error) Number() protoreflect.EnumNumber {
@@ -608,7 +907,7 @@ func (x UpdateEventUserResponse_Error) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UpdateEventUserResponse_Error.Descriptor instead.
 func (UpdateEventUserResponse_Error) EnumDescriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{20, 0}
+	return file_event_proto_rawDescGZIP(), []int{22, 0}
 }
 
 type EventUserResponse_Error int32
@@ -620,6 +919,7 @@ const (
 	EventUserResponse_ID_OR_NAME_REQUIRED     EventUserResponse_Error = 3
 	EventUserResponse_CLIENT_USER_ID_REQUIRED EventUserResponse_Error = 4
 	EventUserResponse_NOT_FOUND               EventUserResponse_Error = 5
+	EventUserResponse_DISQUALIFIED            EventUserResponse_Error = 6
 )
 
 // Enum value maps for EventUserResponse_Error.
@@ -631,6 +931,7 @@ var (
 		3: "ID_OR_NAME_REQUIRED",
 		4: "CLIENT_USER_ID_REQUIRED",
 		5: "NOT_FOUND",
+		6: "DISQUALIFIED",
 	}
 	EventUserResponse_Error_value = map[string]int32{
 		"NONE":                    0,
@@ -639,6 +940,7 @@ var (
 		"ID_OR_NAME_REQUIRED":     3,
 		"CLIENT_USER_ID_REQUIRED": 4,
 		"NOT_FOUND":               5,
+		"DISQUALIFIED":            6,
 	}
 )
 
@@ -653,11 +955,11 @@ func (x EventUserResponse_Error) String() string {
 }
 
 func (EventUserResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_proto_enumTypes[9].Descriptor()
+	return file_event_proto_enumTypes[14].Descriptor()
 }
 
 func (EventUserResponse_Error) Type() protoreflect.EnumType {
-	return &file_event_proto_enumTypes[9]
+	return &file_event_proto_enumTypes[14]
 }
 
 func (x EventUserResponse_Error) Number() protoreflect.EnumNumber {
@@ -666,7 +968,7 @@ func (x EventUserResponse_Error) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EventUserResponse_Error.Descriptor instead.
 func (EventUserResponse_Error) EnumDescriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{21, 0}
+	return file_event_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type AddEventResultResponse_Error int32
@@ -682,21 +984,35 @@ const (
 	AddEventResultResponse_ROUND_USER_DATA_REQUIRED AddEventResultResponse_Error = 7
 	AddEventResultResponse_NOT_FOUND                AddEventResultResponse_Error = 8
 	AddEventResultResponse_EVENT_ENDED              AddEventResultResponse_Error = 9
+	AddEventResultResponse_EVENT_PAUSED             AddEventResultResponse_Error = 10
+	AddEventResultResponse_EVENT_CANCELLED          AddEventResultResponse_Error = 11
+	AddEventResultResponse_NOT_REGISTERED           AddEventResultResponse_Error = 12
+	AddEventResultResponse_REGISTRATION_CLOSED      AddEventResultResponse_Error = 13
+	AddEventResultResponse_EVENT_FULL               AddEventResultResponse_Error = 14
+	AddEventResultResponse_NOT_ELIGIBLE             AddEventResultResponse_Error = 15
+	AddEventResultResponse_DISQUALIFIED             AddEventResultResponse_Error = 16
 )
 
 // Enum value maps for AddEventResultResponse_Error.
 var (
 	AddEventResultResponse_Error_name = map[int32]string{
-		0: "NONE",
-		1: "NAME_TOO_SHORT",
-		2: "NAME_TOO_LONG",
-		3: "ID_OR_NAME_REQUIRED",
-		4: "CLIENT_USER_ID_REQUIRED",
-		5: "RESULT_REQUIRED",
-		6: "USER_DATA_REQUIRED",
-		7: "ROUND_USER_DATA_REQUIRED",
-		8: "NOT_FOUND",
-		9: "EVENT_ENDED",
+		0:  "NONE",
+		1:  "NAME_TOO_SHORT",
+		2:  "NAME_TOO_LONG",
+		3:  "ID_OR_NAME_REQUIRED",
+		4:  "CLIENT_USER_ID_REQUIRED",
+		5:  "RESULT_REQUIRED",
+		6:  "USER_DATA_REQUIRED",
+		7:  "ROUND_USER_DATA_REQUIRED",
+		8:  "NOT_FOUND",
+		9:  "EVENT_ENDED",
+		10: "EVENT_PAUSED",
+		11: "EVENT_CANCELLED",
+		12: "NOT_REGISTERED",
+		13: "REGISTRATION_CLOSED",
+		14: "EVENT_FULL",
+		15: "NOT_ELIGIBLE",
+		16: "DISQUALIFIED",
 	}
 	AddEventResultResponse_Error_value = map[string]int32{
 		"NONE":                     0,
@@ -709,6 +1025,13 @@ var (
 		"ROUND_USER_DATA_REQUIRED": 7,
 		"NOT_FOUND":                8,
 		"EVENT_ENDED":              9,
+		"EVENT_PAUSED":             10,
+		"EVENT_CANCELLED":          11,
+		"NOT_REGISTERED":           12,
+		"REGISTRATION_CLOSED":      13,
+		"EVENT_FULL":               14,
+		"NOT_ELIGIBLE":             15,
+		"DISQUALIFIED":             16,
 	}
 )
 
@@ -723,11 +1046,11 @@ func (x AddEventResultResponse_Error) String() string {
 }
 
 func (AddEventResultResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_proto_enumTypes[10].Descriptor()
+	return file_event_proto_enumTypes[15].Descriptor()
 }
 
 func (AddEventResultResponse_Error) Type() protoreflect.EnumType {
-	return &file_event_proto_enumTypes[10]
+	return &file_event_proto_enumTypes[15]
 }
 
 func (x AddEventResultResponse_Error) Number() protoreflect.EnumNumber {
@@ -736,85 +1059,966 @@ func (x AddEventResultResponse_Error) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AddEventResultResponse_Error.Descriptor instead.
 func (AddEventResultResponse_Error) EnumDescriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{23, 0}
+	return file_event_proto_rawDescGZIP(), []int{25, 0}
 }
 
-type RemoveEventResultResponse_Error int32
+type PauseEventResponse_Error int32
 
 const (
-	RemoveEventResultResponse_NONE        RemoveEventResultResponse_Error = 0
-	RemoveEventResultResponse_ID_REQUIRED RemoveEventResultResponse_Error = 1
-	RemoveEventResultResponse_NOT_FOUND   RemoveEventResultResponse_Error = 2
-	RemoveEventResultResponse_EVENT_ENDED RemoveEventResultResponse_Error = 3
+	PauseEventResponse_NONE                PauseEventResponse_Error = 0
+	PauseEventResponse_NAME_TOO_SHORT      PauseEventResponse_Error = 1
+	PauseEventResponse_NAME_TOO_LONG       PauseEventResponse_Error = 2
+	PauseEventResponse_ID_OR_NAME_REQUIRED PauseEventResponse_Error = 3
+	PauseEventResponse_NOT_FOUND           PauseEventResponse_Error = 4
+	PauseEventResponse_ALREADY_PAUSED      PauseEventResponse_Error = 5
+	PauseEventResponse_EVENT_ENDED         PauseEventResponse_Error = 6
+	PauseEventResponse_EVENT_CANCELLED     PauseEventResponse_Error = 7
 )
 
-// Enum value maps for RemoveEventResultResponse_Error.
+// Enum value maps for PauseEventResponse_Error.
 var (
-	RemoveEventResultResponse_Error_name = map[int32]string{
+	PauseEventResponse_Error_name = map[int32]string{
 		0: "NONE",
-		1: "ID_REQUIRED",
-		2: "NOT_FOUND",
-		3: "EVENT_ENDED",
+		1: "NAME_TOO_SHORT",
+		2: "NAME_TOO_LONG",
+		3: "ID_OR_NAME_REQUIRED",
+		4: "NOT_FOUND",
+		5: "ALREADY_PAUSED",
+		6: "EVENT_ENDED",
+		7: "EVENT_CANCELLED",
 	}
-	RemoveEventResultResponse_Error_value = map[string]int32{
-		"NONE":        0,
-		"ID_REQUIRED": 1,
-		"NOT_FOUND":   2,
-		"EVENT_ENDED": 3,
+	PauseEventResponse_Error_value = map[string]int32{
+		"NONE":                0,
+		"NAME_TOO_SHORT":      1,
+		"NAME_TOO_LONG":       2,
+		"ID_OR_NAME_REQUIRED": 3,
+		"NOT_FOUND":           4,
+		"ALREADY_PAUSED":      5,
+		"EVENT_ENDED":         6,
+		"EVENT_CANCELLED":     7,
 	}
 )
 
-func (x RemoveEventResultResponse_Error) Enum() *RemoveEventResultResponse_Error {
-	p := new(RemoveEventResultResponse_Error)
+func (x PauseEventResponse_Error) Enum() *PauseEventResponse_Error {
+	p := new(PauseEventResponse_Error)
 	*p = x
 	return p
 }
 
-func (x RemoveEventResultResponse_Error) String() string {
+func (x PauseEventResponse_Error) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (RemoveEventResultResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_proto_enumTypes[11].Descriptor()
+func (PauseEventResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[16].Descriptor()
 }
 
-func (RemoveEventResultResponse_Error) Type() protoreflect.EnumType {
-	return &file_event_proto_enumTypes[11]
+func (PauseEventResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[16]
 }
 
-func (x RemoveEventResultResponse_Error) Number() protoreflect.EnumNumber {
+func (x PauseEventResponse_Error) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use RemoveEventResultResponse_Error.Descriptor instead.
-func (RemoveEventResultResponse_Error) EnumDescriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{25, 0}
-}
-
-type CreateEventRound struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=endedAt,proto3" json:"endedAt,omitempty"`
-	Scoring       []uint64               `protobuf:"varint,4,rep,packed,name=scoring,proto3" json:"scoring,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateEventRound) Reset() {
-	*x = CreateEventRound{}
-	mi := &file_event_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+// Deprecated: Use PauseEventResponse_Error.Descriptor instead.
+func (PauseEventResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{26, 0}
 }
 
-func (x *CreateEventRound) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+type ResumeEventResponse_Error int32
 
-func (*CreateEventRound) ProtoMessage() {}
+const (
+	ResumeEventResponse_NONE                ResumeEventResponse_Error = 0
+	ResumeEventResponse_NAME_TOO_SHORT      ResumeEventResponse_Error = 1
+	ResumeEventResponse_NAME_TOO_LONG       ResumeEventResponse_Error = 2
+	ResumeEventResponse_ID_OR_NAME_REQUIRED ResumeEventResponse_Error = 3
+	ResumeEventResponse_NOT_FOUND           ResumeEventResponse_Error = 4
+	ResumeEventResponse_NOT_PAUSED          ResumeEventResponse_Error = 5
+)
 
-func (x *CreateEventRound) ProtoReflect() protoreflect.Message {
+// Enum value maps for ResumeEventResponse_Error.
+var (
+	ResumeEventResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "NAME_TOO_SHORT",
+		2: "NAME_TOO_LONG",
+		3: "ID_OR_NAME_REQUIRED",
+		4: "NOT_FOUND",
+		5: "NOT_PAUSED",
+	}
+	ResumeEventResponse_Error_value = map[string]int32{
+		"NONE":                0,
+		"NAME_TOO_SHORT":      1,
+		"NAME_TOO_LONG":       2,
+		"ID_OR_NAME_REQUIRED": 3,
+		"NOT_FOUND":           4,
+		"NOT_PAUSED":          5,
+	}
+)
+
+func (x ResumeEventResponse_Error) Enum() *ResumeEventResponse_Error {
+	p := new(ResumeEventResponse_Error)
+	*p = x
+	return p
+}
+
+func (x ResumeEventResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResumeEventResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[17].Descriptor()
+}
+
+func (ResumeEventResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[17]
+}
+
+func (x ResumeEventResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResumeEventResponse_Error.Descriptor instead.
+func (ResumeEventResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type CancelEventResponse_Error int32
+
+const (
+	CancelEventResponse_NONE                CancelEventResponse_Error = 0
+	CancelEventResponse_NAME_TOO_SHORT      CancelEventResponse_Error = 1
+	CancelEventResponse_NAME_TOO_LONG       CancelEventResponse_Error = 2
+	CancelEventResponse_ID_OR_NAME_REQUIRED CancelEventResponse_Error = 3
+	CancelEventResponse_NOT_FOUND           CancelEventResponse_Error = 4
+	CancelEventResponse_ALREADY_CANCELLED   CancelEventResponse_Error = 5
+	CancelEventResponse_EVENT_ENDED         CancelEventResponse_Error = 6
+)
+
+// Enum value maps for CancelEventResponse_Error.
+var (
+	CancelEventResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "NAME_TOO_SHORT",
+		2: "NAME_TOO_LONG",
+		3: "ID_OR_NAME_REQUIRED",
+		4: "NOT_FOUND",
+		5: "ALREADY_CANCELLED",
+		6: "EVENT_ENDED",
+	}
+	CancelEventResponse_Error_value = map[string]int32{
+		"NONE":                0,
+		"NAME_TOO_SHORT":      1,
+		"NAME_TOO_LONG":       2,
+		"ID_OR_NAME_REQUIRED": 3,
+		"NOT_FOUND":           4,
+		"ALREADY_CANCELLED":   5,
+		"EVENT_ENDED":         6,
+	}
+)
+
+func (x CancelEventResponse_Error) Enum() *CancelEventResponse_Error {
+	p := new(CancelEventResponse_Error)
+	*p = x
+	return p
+}
+
+func (x CancelEventResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CancelEventResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[18].Descriptor()
+}
+
+func (CancelEventResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[18]
+}
+
+func (x CancelEventResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CancelEventResponse_Error.Descriptor instead.
+func (CancelEventResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{28, 0}
+}
+
+type RegisterForEventResponse_Error int32
+
+const (
+	RegisterForEventResponse_NONE                    RegisterForEventResponse_Error = 0
+	RegisterForEventResponse_NAME_TOO_SHORT          RegisterForEventResponse_Error = 1
+	RegisterForEventResponse_NAME_TOO_LONG           RegisterForEventResponse_Error = 2
+	RegisterForEventResponse_ID_OR_NAME_REQUIRED     RegisterForEventResponse_Error = 3
+	RegisterForEventResponse_CLIENT_USER_ID_REQUIRED RegisterForEventResponse_Error = 4
+	RegisterForEventResponse_DATA_REQUIRED           RegisterForEventResponse_Error = 5
+	RegisterForEventResponse_NOT_FOUND               RegisterForEventResponse_Error = 6
+	RegisterForEventResponse_ALREADY_REGISTERED      RegisterForEventResponse_Error = 7
+	RegisterForEventResponse_REGISTRATION_CLOSED     RegisterForEventResponse_Error = 8
+	RegisterForEventResponse_EVENT_FULL              RegisterForEventResponse_Error = 9
+	RegisterForEventResponse_NOT_ELIGIBLE            RegisterForEventResponse_Error = 10
+	RegisterForEventResponse_EVENT_ENDED             RegisterForEventResponse_Error = 11
+	RegisterForEventResponse_EVENT_CANCELLED         RegisterForEventResponse_Error = 12
+)
+
+// Enum value maps for RegisterForEventResponse_Error.
+var (
+	RegisterForEventResponse_Error_name = map[int32]string{
+		0:  "NONE",
+		1:  "NAME_TOO_SHORT",
+		2:  "NAME_TOO_LONG",
+		3:  "ID_OR_NAME_REQUIRED",
+		4:  "CLIENT_USER_ID_REQUIRED",
+		5:  "DATA_REQUIRED",
+		6:  "NOT_FOUND",
+		7:  "ALREADY_REGISTERED",
+		8:  "REGISTRATION_CLOSED",
+		9:  "EVENT_FULL",
+		10: "NOT_ELIGIBLE",
+		11: "EVENT_ENDED",
+		12: "EVENT_CANCELLED",
+	}
+	RegisterForEventResponse_Error_value = map[string]int32{
+		"NONE":                    0,
+		"NAME_TOO_SHORT":          1,
+		"NAME_TOO_LONG":           2,
+		"ID_OR_NAME_REQUIRED":     3,
+		"CLIENT_USER_ID_REQUIRED": 4,
+		"DATA_REQUIRED":           5,
+		"NOT_FOUND":               6,
+		"ALREADY_REGISTERED":      7,
+		"REGISTRATION_CLOSED":     8,
+		"EVENT_FULL":              9,
+		"NOT_ELIGIBLE":            10,
+		"EVENT_ENDED":             11,
+		"EVENT_CANCELLED":         12,
+	}
+)
+
+func (x RegisterForEventResponse_Error) Enum() *RegisterForEventResponse_Error {
+	p := new(RegisterForEventResponse_Error)
+	*p = x
+	return p
+}
+
+func (x RegisterForEventResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegisterForEventResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[19].Descriptor()
+}
+
+func (RegisterForEventResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[19]
+}
+
+func (x RegisterForEventResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegisterForEventResponse_Error.Descriptor instead.
+func (RegisterForEventResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{30, 0}
+}
+
+type UnregisterFromEventResponse_Error int32
+
+const (
+	UnregisterFromEventResponse_NONE                      UnregisterFromEventResponse_Error = 0
+	UnregisterFromEventResponse_NAME_TOO_SHORT            UnregisterFromEventResponse_Error = 1
+	UnregisterFromEventResponse_NAME_TOO_LONG             UnregisterFromEventResponse_Error = 2
+	UnregisterFromEventResponse_ID_OR_NAME_REQUIRED       UnregisterFromEventResponse_Error = 3
+	UnregisterFromEventResponse_CLIENT_USER_ID_REQUIRED   UnregisterFromEventResponse_Error = 4
+	UnregisterFromEventResponse_EVENT_USER_OR_ID_REQUIRED UnregisterFromEventResponse_Error = 5
+	UnregisterFromEventResponse_NOT_FOUND                 UnregisterFromEventResponse_Error = 6
+	UnregisterFromEventResponse_REGISTRATION_CLOSED       UnregisterFromEventResponse_Error = 7
+	UnregisterFromEventResponse_DISQUALIFIED              UnregisterFromEventResponse_Error = 8
+	UnregisterFromEventResponse_HAS_RESULTS               UnregisterFromEventResponse_Error = 9
+)
+
+// Enum value maps for UnregisterFromEventResponse_Error.
+var (
+	UnregisterFromEventResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "NAME_TOO_SHORT",
+		2: "NAME_TOO_LONG",
+		3: "ID_OR_NAME_REQUIRED",
+		4: "CLIENT_USER_ID_REQUIRED",
+		5: "EVENT_USER_OR_ID_REQUIRED",
+		6: "NOT_FOUND",
+		7: "REGISTRATION_CLOSED",
+		8: "DISQUALIFIED",
+		9: "HAS_RESULTS",
+	}
+	UnregisterFromEventResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"NAME_TOO_SHORT":            1,
+		"NAME_TOO_LONG":             2,
+		"ID_OR_NAME_REQUIRED":       3,
+		"CLIENT_USER_ID_REQUIRED":   4,
+		"EVENT_USER_OR_ID_REQUIRED": 5,
+		"NOT_FOUND":                 6,
+		"REGISTRATION_CLOSED":       7,
+		"DISQUALIFIED":              8,
+		"HAS_RESULTS":               9,
+	}
+)
+
+func (x UnregisterFromEventResponse_Error) Enum() *UnregisterFromEventResponse_Error {
+	p := new(UnregisterFromEventResponse_Error)
+	*p = x
+	return p
+}
+
+func (x UnregisterFromEventResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnregisterFromEventResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[20].Descriptor()
+}
+
+func (UnregisterFromEventResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[20]
+}
+
+func (x UnregisterFromEventResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnregisterFromEventResponse_Error.Descriptor instead.
+func (UnregisterFromEventResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{31, 0}
+}
+
+type DisqualifyEventUserResponse_Error int32
+
+const (
+	DisqualifyEventUserResponse_NONE                      DisqualifyEventUserResponse_Error = 0
+	DisqualifyEventUserResponse_NAME_TOO_SHORT            DisqualifyEventUserResponse_Error = 1
+	DisqualifyEventUserResponse_NAME_TOO_LONG             DisqualifyEventUserResponse_Error = 2
+	DisqualifyEventUserResponse_ID_OR_NAME_REQUIRED       DisqualifyEventUserResponse_Error = 3
+	DisqualifyEventUserResponse_CLIENT_USER_ID_REQUIRED   DisqualifyEventUserResponse_Error = 4
+	DisqualifyEventUserResponse_EVENT_USER_OR_ID_REQUIRED DisqualifyEventUserResponse_Error = 5
+	DisqualifyEventUserResponse_REASON_REQUIRED           DisqualifyEventUserResponse_Error = 6
+	DisqualifyEventUserResponse_MODERATOR_REQUIRED        DisqualifyEventUserResponse_Error = 7
+	DisqualifyEventUserResponse_MODERATOR_TOO_LONG        DisqualifyEventUserResponse_Error = 8
+	DisqualifyEventUserResponse_NOT_FOUND                 DisqualifyEventUserResponse_Error = 9
+	DisqualifyEventUserResponse_ALREADY_DISQUALIFIED      DisqualifyEventUserResponse_Error = 10
+)
+
+// Enum value maps for DisqualifyEventUserResponse_Error.
+var (
+	DisqualifyEventUserResponse_Error_name = map[int32]string{
+		0:  "NONE",
+		1:  "NAME_TOO_SHORT",
+		2:  "NAME_TOO_LONG",
+		3:  "ID_OR_NAME_REQUIRED",
+		4:  "CLIENT_USER_ID_REQUIRED",
+		5:  "EVENT_USER_OR_ID_REQUIRED",
+		6:  "REASON_REQUIRED",
+		7:  "MODERATOR_REQUIRED",
+		8:  "MODERATOR_TOO_LONG",
+		9:  "NOT_FOUND",
+		10: "ALREADY_DISQUALIFIED",
+	}
+	DisqualifyEventUserResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"NAME_TOO_SHORT":            1,
+		"NAME_TOO_LONG":             2,
+		"ID_OR_NAME_REQUIRED":       3,
+		"CLIENT_USER_ID_REQUIRED":   4,
+		"EVENT_USER_OR_ID_REQUIRED": 5,
+		"REASON_REQUIRED":           6,
+		"MODERATOR_REQUIRED":        7,
+		"MODERATOR_TOO_LONG":        8,
+		"NOT_FOUND":                 9,
+		"ALREADY_DISQUALIFIED":      10,
+	}
+)
+
+func (x DisqualifyEventUserResponse_Error) Enum() *DisqualifyEventUserResponse_Error {
+	p := new(DisqualifyEventUserResponse_Error)
+	*p = x
+	return p
+}
+
+func (x DisqualifyEventUserResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisqualifyEventUserResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[21].Descriptor()
+}
+
+func (DisqualifyEventUserResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[21]
+}
+
+func (x DisqualifyEventUserResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisqualifyEventUserResponse_Error.Descriptor instead.
+func (DisqualifyEventUserResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{33, 0}
+}
+
+type ReinstateEventUserResponse_Error int32
+
+const (
+	ReinstateEventUserResponse_NONE                      ReinstateEventUserResponse_Error = 0
+	ReinstateEventUserResponse_NAME_TOO_SHORT            ReinstateEventUserResponse_Error = 1
+	ReinstateEventUserResponse_NAME_TOO_LONG             ReinstateEventUserResponse_Error = 2
+	ReinstateEventUserResponse_ID_OR_NAME_REQUIRED       ReinstateEventUserResponse_Error = 3
+	ReinstateEventUserResponse_CLIENT_USER_ID_REQUIRED   ReinstateEventUserResponse_Error = 4
+	ReinstateEventUserResponse_EVENT_USER_OR_ID_REQUIRED ReinstateEventUserResponse_Error = 5
+	ReinstateEventUserResponse_REASON_REQUIRED           ReinstateEventUserResponse_Error = 6
+	ReinstateEventUserResponse_MODERATOR_REQUIRED        ReinstateEventUserResponse_Error = 7
+	ReinstateEventUserResponse_MODERATOR_TOO_LONG        ReinstateEventUserResponse_Error = 8
+	ReinstateEventUserResponse_NOT_FOUND                 ReinstateEventUserResponse_Error = 9
+	ReinstateEventUserResponse_NOT_DISQUALIFIED          ReinstateEventUserResponse_Error = 10
+)
+
+// Enum value maps for ReinstateEventUserResponse_Error.
+var (
+	ReinstateEventUserResponse_Error_name = map[int32]string{
+		0:  "NONE",
+		1:  "NAME_TOO_SHORT",
+		2:  "NAME_TOO_LONG",
+		3:  "ID_OR_NAME_REQUIRED",
+		4:  "CLIENT_USER_ID_REQUIRED",
+		5:  "EVENT_USER_OR_ID_REQUIRED",
+		6:  "REASON_REQUIRED",
+		7:  "MODERATOR_REQUIRED",
+		8:  "MODERATOR_TOO_LONG",
+		9:  "NOT_FOUND",
+		10: "NOT_DISQUALIFIED",
+	}
+	ReinstateEventUserResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"NAME_TOO_SHORT":            1,
+		"NAME_TOO_LONG":             2,
+		"ID_OR_NAME_REQUIRED":       3,
+		"CLIENT_USER_ID_REQUIRED":   4,
+		"EVENT_USER_OR_ID_REQUIRED": 5,
+		"REASON_REQUIRED":           6,
+		"MODERATOR_REQUIRED":        7,
+		"MODERATOR_TOO_LONG":        8,
+		"NOT_FOUND":                 9,
+		"NOT_DISQUALIFIED":          10,
+	}
+)
+
+func (x ReinstateEventUserResponse_Error) Enum() *ReinstateEventUserResponse_Error {
+	p := new(ReinstateEventUserResponse_Error)
+	*p = x
+	return p
+}
+
+func (x ReinstateEventUserResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReinstateEventUserResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[22].Descriptor()
+}
+
+func (ReinstateEventUserResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[22]
+}
+
+func (x ReinstateEventUserResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReinstateEventUserResponse_Error.Descriptor instead.
+func (ReinstateEventUserResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{35, 0}
+}
+
+type SetEventThirdPartyDeliveryResponse_Error int32
+
+const (
+	SetEventThirdPartyDeliveryResponse_NONE                SetEventThirdPartyDeliveryResponse_Error = 0
+	SetEventThirdPartyDeliveryResponse_NAME_TOO_SHORT      SetEventThirdPartyDeliveryResponse_Error = 1
+	SetEventThirdPartyDeliveryResponse_NAME_TOO_LONG       SetEventThirdPartyDeliveryResponse_Error = 2
+	SetEventThirdPartyDeliveryResponse_ID_OR_NAME_REQUIRED SetEventThirdPartyDeliveryResponse_Error = 3
+	SetEventThirdPartyDeliveryResponse_DELIVERY_REQUIRED   SetEventThirdPartyDeliveryResponse_Error = 4
+	SetEventThirdPartyDeliveryResponse_TOP_LIMIT_REQUIRED  SetEventThirdPartyDeliveryResponse_Error = 5
+	SetEventThirdPartyDeliveryResponse_URI_INVALID         SetEventThirdPartyDeliveryResponse_Error = 6
+	SetEventThirdPartyDeliveryResponse_NOT_FOUND           SetEventThirdPartyDeliveryResponse_Error = 7
+)
+
+// Enum value maps for SetEventThirdPartyDeliveryResponse_Error.
+var (
+	SetEventThirdPartyDeliveryResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "NAME_TOO_SHORT",
+		2: "NAME_TOO_LONG",
+		3: "ID_OR_NAME_REQUIRED",
+		4: "DELIVERY_REQUIRED",
+		5: "TOP_LIMIT_REQUIRED",
+		6: "URI_INVALID",
+		7: "NOT_FOUND",
+	}
+	SetEventThirdPartyDeliveryResponse_Error_value = map[string]int32{
+		"NONE":                0,
+		"NAME_TOO_SHORT":      1,
+		"NAME_TOO_LONG":       2,
+		"ID_OR_NAME_REQUIRED": 3,
+		"DELIVERY_REQUIRED":   4,
+		"TOP_LIMIT_REQUIRED":  5,
+		"URI_INVALID":         6,
+		"NOT_FOUND":           7,
+	}
+)
+
+func (x SetEventThirdPartyDeliveryResponse_Error) Enum() *SetEventThirdPartyDeliveryResponse_Error {
+	p := new(SetEventThirdPartyDeliveryResponse_Error)
+	*p = x
+	return p
+}
+
+func (x SetEventThirdPartyDeliveryResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SetEventThirdPartyDeliveryResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[23].Descriptor()
+}
+
+func (SetEventThirdPartyDeliveryResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[23]
+}
+
+func (x SetEventThirdPartyDeliveryResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SetEventThirdPartyDeliveryResponse_Error.Descriptor instead.
+func (SetEventThirdPartyDeliveryResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{37, 0}
+}
+
+type CreateEventTemplateResponse_Error int32
+
+const (
+	CreateEventTemplateResponse_NONE                                  CreateEventTemplateResponse_Error = 0
+	CreateEventTemplateResponse_NAME_TOO_SHORT                        CreateEventTemplateResponse_Error = 1
+	CreateEventTemplateResponse_NAME_TOO_LONG                         CreateEventTemplateResponse_Error = 2
+	CreateEventTemplateResponse_DATA_REQUIRED                         CreateEventTemplateResponse_Error = 3
+	CreateEventTemplateResponse_ROUNDS_REQUIRED                       CreateEventTemplateResponse_Error = 4
+	CreateEventTemplateResponse_TOO_MANY_ROUNDS                       CreateEventTemplateResponse_Error = 5
+	CreateEventTemplateResponse_ROUND_NAME_TOO_SHORT                  CreateEventTemplateResponse_Error = 6
+	CreateEventTemplateResponse_ROUND_NAME_TOO_LONG                   CreateEventTemplateResponse_Error = 7
+	CreateEventTemplateResponse_ROUND_DATA_REQUIRED                   CreateEventTemplateResponse_Error = 8
+	CreateEventTemplateResponse_ROUND_ENDED_AFTER_SECONDS_REQUIRED    CreateEventTemplateResponse_Error = 9
+	CreateEventTemplateResponse_ROUND_SCORING_REQUIRED                CreateEventTemplateResponse_Error = 10
+	CreateEventTemplateResponse_ROUND_SCORING_INVALID                 CreateEventTemplateResponse_Error = 11
+	CreateEventTemplateResponse_ROUND_RESULT_AGGREGATION_TOP_REQUIRED CreateEventTemplateResponse_Error = 12
+	CreateEventTemplateResponse_DUPLICATE_ROUND_NAME                  CreateEventTemplateResponse_Error = 13
+	CreateEventTemplateResponse_DUPLICATE_ROUND_ENDED_AFTER_SECONDS   CreateEventTemplateResponse_Error = 14
+	CreateEventTemplateResponse_CRON_SCHEDULE_INVALID                 CreateEventTemplateResponse_Error = 15
+	CreateEventTemplateResponse_ALREADY_EXISTS                        CreateEventTemplateResponse_Error = 16
+	CreateEventTemplateResponse_LEAD_TIME_TOO_LONG                    CreateEventTemplateResponse_Error = 17
+)
+
+// Enum value maps for CreateEventTemplateResponse_Error.
+var (
+	CreateEventTemplateResponse_Error_name = map[int32]string{
+		0:  "NONE",
+		1:  "NAME_TOO_SHORT",
+		2:  "NAME_TOO_LONG",
+		3:  "DATA_REQUIRED",
+		4:  "ROUNDS_REQUIRED",
+		5:  "TOO_MANY_ROUNDS",
+		6:  "ROUND_NAME_TOO_SHORT",
+		7:  "ROUND_NAME_TOO_LONG",
+		8:  "ROUND_DATA_REQUIRED",
+		9:  "ROUND_ENDED_AFTER_SECONDS_REQUIRED",
+		10: "ROUND_SCORING_REQUIRED",
+		11: "ROUND_SCORING_INVALID",
+		12: "ROUND_RESULT_AGGREGATION_TOP_REQUIRED",
+		13: "DUPLICATE_ROUND_NAME",
+		14: "DUPLICATE_ROUND_ENDED_AFTER_SECONDS",
+		15: "CRON_SCHEDULE_INVALID",
+		16: "ALREADY_EXISTS",
+		17: "LEAD_TIME_TOO_LONG",
+	}
+	CreateEventTemplateResponse_Error_value = map[string]int32{
+		"NONE":                                  0,
+		"NAME_TOO_SHORT":                        1,
+		"NAME_TOO_LONG":                         2,
+		"DATA_REQUIRED":                         3,
+		"ROUNDS_REQUIRED":                       4,
+		"TOO_MANY_ROUNDS":                       5,
+		"ROUND_NAME_TOO_SHORT":                  6,
+		"ROUND_NAME_TOO_LONG":                   7,
+		"ROUND_DATA_REQUIRED":                   8,
+		"ROUND_ENDED_AFTER_SECONDS_REQUIRED":    9,
+		"ROUND_SCORING_REQUIRED":                10,
+		"ROUND_SCORING_INVALID":                 11,
+		"ROUND_RESULT_AGGREGATION_TOP_REQUIRED": 12,
+		"DUPLICATE_ROUND_NAME":                  13,
+		"DUPLICATE_ROUND_ENDED_AFTER_SECONDS":   14,
+		"CRON_SCHEDULE_INVALID":                 15,
+		"ALREADY_EXISTS":                        16,
+		"LEAD_TIME_TOO_LONG":                    17,
+	}
+)
+
+func (x CreateEventTemplateResponse_Error) Enum() *CreateEventTemplateResponse_Error {
+	p := new(CreateEventTemplateResponse_Error)
+	*p = x
+	return p
+}
+
+func (x CreateEventTemplateResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateEventTemplateResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[24].Descriptor()
+}
+
+func (CreateEventTemplateResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[24]
+}
+
+func (x CreateEventTemplateResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateEventTemplateResponse_Error.Descriptor instead.
+func (CreateEventTemplateResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{39, 0}
+}
+
+type GetEventTemplateResponse_Error int32
+
+const (
+	GetEventTemplateResponse_NONE                GetEventTemplateResponse_Error = 0
+	GetEventTemplateResponse_NAME_TOO_SHORT      GetEventTemplateResponse_Error = 1
+	GetEventTemplateResponse_NAME_TOO_LONG       GetEventTemplateResponse_Error = 2
+	GetEventTemplateResponse_ID_OR_NAME_REQUIRED GetEventTemplateResponse_Error = 3
+	GetEventTemplateResponse_NOT_FOUND           GetEventTemplateResponse_Error = 4
+)
+
+// Enum value maps for GetEventTemplateResponse_Error.
+var (
+	GetEventTemplateResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "NAME_TOO_SHORT",
+		2: "NAME_TOO_LONG",
+		3: "ID_OR_NAME_REQUIRED",
+		4: "NOT_FOUND",
+	}
+	GetEventTemplateResponse_Error_value = map[string]int32{
+		"NONE":                0,
+		"NAME_TOO_SHORT":      1,
+		"NAME_TOO_LONG":       2,
+		"ID_OR_NAME_REQUIRED": 3,
+		"NOT_FOUND":           4,
+	}
+)
+
+func (x GetEventTemplateResponse_Error) Enum() *GetEventTemplateResponse_Error {
+	p := new(GetEventTemplateResponse_Error)
+	*p = x
+	return p
+}
+
+func (x GetEventTemplateResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetEventTemplateResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[25].Descriptor()
+}
+
+func (GetEventTemplateResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[25]
+}
+
+func (x GetEventTemplateResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetEventTemplateResponse_Error.Descriptor instead.
+func (GetEventTemplateResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{41, 0}
+}
+
+type EventTemplateResponse_Error int32
+
+const (
+	EventTemplateResponse_NONE                EventTemplateResponse_Error = 0
+	EventTemplateResponse_NAME_TOO_SHORT      EventTemplateResponse_Error = 1
+	EventTemplateResponse_NAME_TOO_LONG       EventTemplateResponse_Error = 2
+	EventTemplateResponse_ID_OR_NAME_REQUIRED EventTemplateResponse_Error = 3
+	EventTemplateResponse_NOT_FOUND           EventTemplateResponse_Error = 4
+)
+
+// Enum value maps for EventTemplateResponse_Error.
+var (
+	EventTemplateResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "NAME_TOO_SHORT",
+		2: "NAME_TOO_LONG",
+		3: "ID_OR_NAME_REQUIRED",
+		4: "NOT_FOUND",
+	}
+	EventTemplateResponse_Error_value = map[string]int32{
+		"NONE":                0,
+		"NAME_TOO_SHORT":      1,
+		"NAME_TOO_LONG":       2,
+		"ID_OR_NAME_REQUIRED": 3,
+		"NOT_FOUND":           4,
+	}
+)
+
+func (x EventTemplateResponse_Error) Enum() *EventTemplateResponse_Error {
+	p := new(EventTemplateResponse_Error)
+	*p = x
+	return p
+}
+
+func (x EventTemplateResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventTemplateResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[26].Descriptor()
+}
+
+func (EventTemplateResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[26]
+}
+
+func (x EventTemplateResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventTemplateResponse_Error.Descriptor instead.
+func (EventTemplateResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{42, 0}
+}
+
+type CreateEventFromTemplateResponse_Error int32
+
+const (
+	CreateEventFromTemplateResponse_NONE                   CreateEventFromTemplateResponse_Error = 0
+	CreateEventFromTemplateResponse_NAME_TOO_SHORT         CreateEventFromTemplateResponse_Error = 1
+	CreateEventFromTemplateResponse_NAME_TOO_LONG          CreateEventFromTemplateResponse_Error = 2
+	CreateEventFromTemplateResponse_ID_OR_NAME_REQUIRED    CreateEventFromTemplateResponse_Error = 3
+	CreateEventFromTemplateResponse_STARTED_AT_REQUIRED    CreateEventFromTemplateResponse_Error = 4
+	CreateEventFromTemplateResponse_STARTED_AT_IN_THE_PAST CreateEventFromTemplateResponse_Error = 5
+	CreateEventFromTemplateResponse_NOT_FOUND              CreateEventFromTemplateResponse_Error = 6
+	CreateEventFromTemplateResponse_ALREADY_EXISTS         CreateEventFromTemplateResponse_Error = 7
+)
+
+// Enum value maps for CreateEventFromTemplateResponse_Error.
+var (
+	CreateEventFromTemplateResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "NAME_TOO_SHORT",
+		2: "NAME_TOO_LONG",
+		3: "ID_OR_NAME_REQUIRED",
+		4: "STARTED_AT_REQUIRED",
+		5: "STARTED_AT_IN_THE_PAST",
+		6: "NOT_FOUND",
+		7: "ALREADY_EXISTS",
+	}
+	CreateEventFromTemplateResponse_Error_value = map[string]int32{
+		"NONE":                   0,
+		"NAME_TOO_SHORT":         1,
+		"NAME_TOO_LONG":          2,
+		"ID_OR_NAME_REQUIRED":    3,
+		"STARTED_AT_REQUIRED":    4,
+		"STARTED_AT_IN_THE_PAST": 5,
+		"NOT_FOUND":              6,
+		"ALREADY_EXISTS":         7,
+	}
+)
+
+func (x CreateEventFromTemplateResponse_Error) Enum() *CreateEventFromTemplateResponse_Error {
+	p := new(CreateEventFromTemplateResponse_Error)
+	*p = x
+	return p
+}
+
+func (x CreateEventFromTemplateResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateEventFromTemplateResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[27].Descriptor()
+}
+
+func (CreateEventFromTemplateResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[27]
+}
+
+func (x CreateEventFromTemplateResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateEventFromTemplateResponse_Error.Descriptor instead.
+func (CreateEventFromTemplateResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{44, 0}
+}
+
+type RemoveEventResultResponse_Error int32
+
+const (
+	RemoveEventResultResponse_NONE        RemoveEventResultResponse_Error = 0
+	RemoveEventResultResponse_ID_REQUIRED RemoveEventResultResponse_Error = 1
+	RemoveEventResultResponse_NOT_FOUND   RemoveEventResultResponse_Error = 2
+	RemoveEventResultResponse_EVENT_ENDED RemoveEventResultResponse_Error = 3
+)
+
+// Enum value maps for RemoveEventResultResponse_Error.
+var (
+	RemoveEventResultResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "ID_REQUIRED",
+		2: "NOT_FOUND",
+		3: "EVENT_ENDED",
+	}
+	RemoveEventResultResponse_Error_value = map[string]int32{
+		"NONE":        0,
+		"ID_REQUIRED": 1,
+		"NOT_FOUND":   2,
+		"EVENT_ENDED": 3,
+	}
+)
+
+func (x RemoveEventResultResponse_Error) Enum() *RemoveEventResultResponse_Error {
+	p := new(RemoveEventResultResponse_Error)
+	*p = x
+	return p
+}
+
+func (x RemoveEventResultResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RemoveEventResultResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[28].Descriptor()
+}
+
+func (RemoveEventResultResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[28]
+}
+
+func (x RemoveEventResultResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RemoveEventResultResponse_Error.Descriptor instead.
+func (RemoveEventResultResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{46, 0}
+}
+
+type GetEventRoundAttemptsResponse_Error int32
+
+const (
+	GetEventRoundAttemptsResponse_NONE                      GetEventRoundAttemptsResponse_Error = 0
+	GetEventRoundAttemptsResponse_NAME_TOO_SHORT            GetEventRoundAttemptsResponse_Error = 1
+	GetEventRoundAttemptsResponse_NAME_TOO_LONG             GetEventRoundAttemptsResponse_Error = 2
+	GetEventRoundAttemptsResponse_ID_OR_NAME_REQUIRED       GetEventRoundAttemptsResponse_Error = 3
+	GetEventRoundAttemptsResponse_CLIENT_USER_ID_REQUIRED   GetEventRoundAttemptsResponse_Error = 4
+	GetEventRoundAttemptsResponse_EVENT_USER_OR_ID_REQUIRED GetEventRoundAttemptsResponse_Error = 5
+	GetEventRoundAttemptsResponse_ROUND_NAME_TOO_SHORT      GetEventRoundAttemptsResponse_Error = 6
+	GetEventRoundAttemptsResponse_ROUND_NAME_TOO_LONG       GetEventRoundAttemptsResponse_Error = 7
+	GetEventRoundAttemptsResponse_NOT_FOUND                 GetEventRoundAttemptsResponse_Error = 8
+)
+
+// Enum value maps for GetEventRoundAttemptsResponse_Error.
+var (
+	GetEventRoundAttemptsResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "NAME_TOO_SHORT",
+		2: "NAME_TOO_LONG",
+		3: "ID_OR_NAME_REQUIRED",
+		4: "CLIENT_USER_ID_REQUIRED",
+		5: "EVENT_USER_OR_ID_REQUIRED",
+		6: "ROUND_NAME_TOO_SHORT",
+		7: "ROUND_NAME_TOO_LONG",
+		8: "NOT_FOUND",
+	}
+	GetEventRoundAttemptsResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"NAME_TOO_SHORT":            1,
+		"NAME_TOO_LONG":             2,
+		"ID_OR_NAME_REQUIRED":       3,
+		"CLIENT_USER_ID_REQUIRED":   4,
+		"EVENT_USER_OR_ID_REQUIRED": 5,
+		"ROUND_NAME_TOO_SHORT":      6,
+		"ROUND_NAME_TOO_LONG":       7,
+		"NOT_FOUND":                 8,
+	}
+)
+
+func (x GetEventRoundAttemptsResponse_Error) Enum() *GetEventRoundAttemptsResponse_Error {
+	p := new(GetEventRoundAttemptsResponse_Error)
+	*p = x
+	return p
+}
+
+func (x GetEventRoundAttemptsResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetEventRoundAttemptsResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[29].Descriptor()
+}
+
+func (GetEventRoundAttemptsResponse_Error) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[29]
+}
+
+func (x GetEventRoundAttemptsResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetEventRoundAttemptsResponse_Error.Descriptor instead.
+func (GetEventRoundAttemptsResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{48, 0}
+}
+
+type CreateEventRound struct {
+	state                protoimpl.MessageState      `protogen:"open.v1"`
+	Name                 string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data                 *structpb.Struct            `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	EndedAt              *timestamppb.Timestamp      `protobuf:"bytes,3,opt,name=endedAt,proto3" json:"endedAt,omitempty"`
+	Scoring              []uint64                    `protobuf:"varint,4,rep,packed,name=scoring,proto3" json:"scoring,omitempty"`
+	ResultOrder          EventRoundResultOrder       `protobuf:"varint,5,opt,name=resultOrder,proto3,enum=api.EventRoundResultOrder" json:"resultOrder,omitempty"`
+	ScoringFormula       *EventRoundScoringFormula   `protobuf:"bytes,6,opt,name=scoringFormula,proto3,oneof" json:"scoringFormula,omitempty"`
+	ResultAggregation    EventRoundResultAggregation `protobuf:"varint,7,opt,name=resultAggregation,proto3,enum=api.EventRoundResultAggregation" json:"resultAggregation,omitempty"`
+	ResultAggregationTop *uint32                     `protobuf:"varint,8,opt,name=resultAggregationTop,proto3,oneof" json:"resultAggregationTop,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateEventRound) Reset() {
+	*x = CreateEventRound{}
+	mi := &file_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRound) ProtoMessage() {}
+
+func (x *CreateEventRound) ProtoReflect() protoreflect.Message {
 	mi := &file_event_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -826,64 +2030,2243 @@ func (x *CreateEventRound) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventRound.ProtoReflect.Descriptor instead.
-func (*CreateEventRound) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use CreateEventRound.ProtoReflect.Descriptor instead.
+func (*CreateEventRound) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateEventRound) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEventRound) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateEventRound) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *CreateEventRound) GetScoring() []uint64 {
+	if x != nil {
+		return x.Scoring
+	}
+	return nil
+}
+
+func (x *CreateEventRound) GetResultOrder() EventRoundResultOrder {
+	if x != nil {
+		return x.ResultOrder
+	}
+	return EventRoundResultOrder_ASC
+}
+
+func (x *CreateEventRound) GetScoringFormula() *EventRoundScoringFormula {
+	if x != nil {
+		return x.ScoringFormula
+	}
+	return nil
+}
+
+func (x *CreateEventRound) GetResultAggregation() EventRoundResultAggregation {
+	if x != nil {
+		return x.ResultAggregation
+	}
+	return EventRoundResultAggregation_LATEST
+}
+
+func (x *CreateEventRound) GetResultAggregationTop() uint32 {
+	if x != nil && x.ResultAggregationTop != nil {
+		return *x.ResultAggregationTop
+	}
+	return 0
+}
+
+type CreateEventRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data      *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	Rounds    []*CreateEventRound    `protobuf:"bytes,4,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	// Draft events can be created without rounds, and are scheduled once a round is created
+	Draft                bool                    `protobuf:"varint,5,opt,name=draft,proto3" json:"draft,omitempty"`
+	RegistrationRequired bool                    `protobuf:"varint,6,opt,name=registrationRequired,proto3" json:"registrationRequired,omitempty"`
+	RegistrationOpensAt  *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=registrationOpensAt,proto3,oneof" json:"registrationOpensAt,omitempty"`
+	RegistrationClosesAt *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=registrationClosesAt,proto3,oneof" json:"registrationClosesAt,omitempty"`
+	MaxParticipants      *uint32                 `protobuf:"varint,9,opt,name=maxParticipants,proto3,oneof" json:"maxParticipants,omitempty"`
+	Eligibility          []*EventEligibilityRule `protobuf:"bytes,10,rep,name=eligibility,proto3" json:"eligibility,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
+	mi := &file_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRequest) ProtoMessage() {}
+
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetRounds() []*CreateEventRound {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *CreateEventRequest) GetRegistrationRequired() bool {
+	if x != nil {
+		return x.RegistrationRequired
+	}
+	return false
+}
+
+func (x *CreateEventRequest) GetRegistrationOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationOpensAt
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetRegistrationClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationClosesAt
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetMaxParticipants() uint32 {
+	if x != nil && x.MaxParticipants != nil {
+		return *x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetEligibility() []*EventEligibilityRule {
+	if x != nil {
+		return x.Eligibility
+	}
+	return nil
+}
+
+type CreateEventResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Success       bool                      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            *uint64                   `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Error         CreateEventResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.CreateEventResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventResponse) Reset() {
+	*x = CreateEventResponse{}
+	mi := &file_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventResponse) ProtoMessage() {}
+
+func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
+func (*CreateEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateEventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateEventResponse) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *CreateEventResponse) GetError() CreateEventResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return CreateEventResponse_NONE
+}
+
+type EventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventRequest) Reset() {
+	*x = EventRequest{}
+	mi := &file_event_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRequest) ProtoMessage() {}
+
+func (x *EventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRequest.ProtoReflect.Descriptor instead.
+func (*EventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventRequest) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *EventRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type GetEventRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Event           *EventRequest          `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Pagination      *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	TeamLeaderboard bool                   `protobuf:"varint,3,opt,name=teamLeaderboard,proto3" json:"teamLeaderboard,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	mi := &file_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRequest) ProtoMessage() {}
+
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetEventRequest) GetEvent() *EventRequest {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *GetEventRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetEventRequest) GetTeamLeaderboard() bool {
+	if x != nil {
+		return x.TeamLeaderboard
+	}
+	return false
+}
+
+type GetEventResponse struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Success            bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Event              *Event                   `protobuf:"bytes,2,opt,name=event,proto3,oneof" json:"event,omitempty"`
+	Leaderboard        []*EventUser             `protobuf:"bytes,3,rep,name=leaderboard,proto3" json:"leaderboard,omitempty"`
+	TeamLeaderboard    []*EventTeam             `protobuf:"bytes,5,rep,name=teamLeaderboard,proto3" json:"teamLeaderboard,omitempty"`
+	ThirdPartyDelivery *EventThirdPartyDelivery `protobuf:"bytes,6,opt,name=thirdPartyDelivery,proto3,oneof" json:"thirdPartyDelivery,omitempty"`
+	Error              GetEventResponse_Error   `protobuf:"varint,4,opt,name=error,proto3,enum=api.GetEventResponse_Error" json:"error,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetEventResponse) Reset() {
+	*x = GetEventResponse{}
+	mi := &file_event_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventResponse) ProtoMessage() {}
+
+func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
+func (*GetEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetEventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetEventResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *GetEventResponse) GetLeaderboard() []*EventUser {
+	if x != nil {
+		return x.Leaderboard
+	}
+	return nil
+}
+
+func (x *GetEventResponse) GetTeamLeaderboard() []*EventTeam {
+	if x != nil {
+		return x.TeamLeaderboard
+	}
+	return nil
+}
+
+func (x *GetEventResponse) GetThirdPartyDelivery() *EventThirdPartyDelivery {
+	if x != nil {
+		return x.ThirdPartyDelivery
+	}
+	return nil
+}
+
+func (x *GetEventResponse) GetError() GetEventResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetEventResponse_NONE
+}
+
+type GetEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []EventStatus          `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=api.EventStatus" json:"statuses,omitempty"`
+	StartedAfter  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=startedAfter,proto3,oneof" json:"startedAfter,omitempty"`
+	StartedBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=startedBefore,proto3,oneof" json:"startedBefore,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,4,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsRequest) Reset() {
+	*x = GetEventsRequest{}
+	mi := &file_event_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsRequest) ProtoMessage() {}
+
+func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetEventsRequest) GetStatuses() []EventStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetEventsRequest) GetStartedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAfter
+	}
+	return nil
+}
+
+func (x *GetEventsRequest) GetStartedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedBefore
+	}
+	return nil
+}
+
+func (x *GetEventsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Events        []*Event               `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsResponse) Reset() {
+	*x = GetEventsResponse{}
+	mi := &file_event_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsResponse) ProtoMessage() {}
+
+func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetEventsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type UpdateEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *EventRequest          `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	mi := &file_event_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateEventRequest) GetEvent() *EventRequest {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateEventResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Success       bool                      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         UpdateEventResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.UpdateEventResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventResponse) Reset() {
+	*x = UpdateEventResponse{}
+	mi := &file_event_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventResponse) ProtoMessage() {}
+
+func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateEventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateEventResponse) GetError() UpdateEventResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return UpdateEventResponse_NONE
+}
+
+type EventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         EventResponse_Error    `protobuf:"varint,2,opt,name=error,proto3,enum=api.EventResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventResponse) Reset() {
+	*x = EventResponse{}
+	mi := &file_event_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventResponse) ProtoMessage() {}
+
+func (x *EventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventResponse.ProtoReflect.Descriptor instead.
+func (*EventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EventResponse) GetError() EventResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return EventResponse_NONE
+}
+
+type CreateEventRoundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *EventRequest          `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Round         *CreateEventRound      `protobuf:"bytes,2,opt,name=round,proto3" json:"round,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventRoundRequest) Reset() {
+	*x = CreateEventRoundRequest{}
+	mi := &file_event_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRoundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRoundRequest) ProtoMessage() {}
+
+func (x *CreateEventRoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRoundRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRoundRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateEventRoundRequest) GetEvent() *EventRequest {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CreateEventRoundRequest) GetRound() *CreateEventRound {
+	if x != nil {
+		return x.Round
+	}
+	return nil
+}
+
+type CreateEventRoundResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Success       bool                           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            *uint64                        `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Error         CreateEventRoundResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.CreateEventRoundResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventRoundResponse) Reset() {
+	*x = CreateEventRoundResponse{}
+	mi := &file_event_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRoundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRoundResponse) ProtoMessage() {}
+
+func (x *CreateEventRoundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRoundResponse.ProtoReflect.Descriptor instead.
+func (*CreateEventRoundResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateEventRoundResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateEventRoundResponse) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *CreateEventRoundResponse) GetError() CreateEventRoundResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return CreateEventRoundResponse_NONE
+}
+
+type EventRoundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Event         *EventRequest          `protobuf:"bytes,2,opt,name=event,proto3,oneof" json:"event,omitempty"`
+	RoundName     *string                `protobuf:"bytes,3,opt,name=roundName,proto3,oneof" json:"roundName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventRoundRequest) Reset() {
+	*x = EventRoundRequest{}
+	mi := &file_event_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventRoundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRoundRequest) ProtoMessage() {}
+
+func (x *EventRoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRoundRequest.ProtoReflect.Descriptor instead.
+func (*EventRoundRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EventRoundRequest) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *EventRoundRequest) GetEvent() *EventRequest {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventRoundRequest) GetRoundName() string {
+	if x != nil && x.RoundName != nil {
+		return *x.RoundName
+	}
+	return ""
+}
+
+type GetEventRoundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         *EventRoundRequest     `protobuf:"bytes,1,opt,name=round,proto3" json:"round,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRoundRequest) Reset() {
+	*x = GetEventRoundRequest{}
+	mi := &file_event_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRoundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRoundRequest) ProtoMessage() {}
+
+func (x *GetEventRoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRoundRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRoundRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetEventRoundRequest) GetRound() *EventRoundRequest {
+	if x != nil {
+		return x.Round
+	}
+	return nil
+}
+
+func (x *GetEventRoundRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetEventRoundResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Success       bool                        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Round         *EventRound                 `protobuf:"bytes,2,opt,name=round,proto3,oneof" json:"round,omitempty"`
+	Results       []*EventRoundUser           `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	Error         GetEventRoundResponse_Error `protobuf:"varint,4,opt,name=error,proto3,enum=api.GetEventRoundResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRoundResponse) Reset() {
+	*x = GetEventRoundResponse{}
+	mi := &file_event_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRoundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRoundResponse) ProtoMessage() {}
+
+func (x *GetEventRoundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRoundResponse.ProtoReflect.Descriptor instead.
+func (*GetEventRoundResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetEventRoundResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetEventRoundResponse) GetRound() *EventRound {
+	if x != nil {
+		return x.Round
+	}
+	return nil
+}
+
+func (x *GetEventRoundResponse) GetResults() []*EventRoundUser {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GetEventRoundResponse) GetError() GetEventRoundResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetEventRoundResponse_NONE
+}
+
+type UpdateEventRoundRequest struct {
+	state                protoimpl.MessageState       `protogen:"open.v1"`
+	Round                *EventRoundRequest           `protobuf:"bytes,1,opt,name=round,proto3" json:"round,omitempty"`
+	Data                 *structpb.Struct             `protobuf:"bytes,2,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	Scoring              []uint64                     `protobuf:"varint,3,rep,packed,name=scoring,proto3" json:"scoring,omitempty"`
+	ResultOrder          *EventRoundResultOrder       `protobuf:"varint,4,opt,name=resultOrder,proto3,enum=api.EventRoundResultOrder,oneof" json:"resultOrder,omitempty"`
+	ScoringFormula       *EventRoundScoringFormula    `protobuf:"bytes,5,opt,name=scoringFormula,proto3,oneof" json:"scoringFormula,omitempty"`
+	ResultAggregation    *EventRoundResultAggregation `protobuf:"varint,6,opt,name=resultAggregation,proto3,enum=api.EventRoundResultAggregation,oneof" json:"resultAggregation,omitempty"`
+	ResultAggregationTop *uint32                      `protobuf:"varint,7,opt,name=resultAggregationTop,proto3,oneof" json:"resultAggregationTop,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateEventRoundRequest) Reset() {
+	*x = UpdateEventRoundRequest{}
+	mi := &file_event_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRoundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRoundRequest) ProtoMessage() {}
+
+func (x *UpdateEventRoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRoundRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRoundRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateEventRoundRequest) GetRound() *EventRoundRequest {
+	if x != nil {
+		return x.Round
+	}
+	return nil
+}
+
+func (x *UpdateEventRoundRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateEventRoundRequest) GetScoring() []uint64 {
+	if x != nil {
+		return x.Scoring
+	}
+	return nil
+}
+
+func (x *UpdateEventRoundRequest) GetResultOrder() EventRoundResultOrder {
+	if x != nil && x.ResultOrder != nil {
+		return *x.ResultOrder
+	}
+	return EventRoundResultOrder_ASC
+}
+
+func (x *UpdateEventRoundRequest) GetScoringFormula() *EventRoundScoringFormula {
+	if x != nil {
+		return x.ScoringFormula
+	}
+	return nil
+}
+
+func (x *UpdateEventRoundRequest) GetResultAggregation() EventRoundResultAggregation {
+	if x != nil && x.ResultAggregation != nil {
+		return *x.ResultAggregation
+	}
+	return EventRoundResultAggregation_LATEST
+}
+
+func (x *UpdateEventRoundRequest) GetResultAggregationTop() uint32 {
+	if x != nil && x.ResultAggregationTop != nil {
+		return *x.ResultAggregationTop
+	}
+	return 0
+}
+
+type UpdateEventRoundResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Success       bool                           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         UpdateEventRoundResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.UpdateEventRoundResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventRoundResponse) Reset() {
+	*x = UpdateEventRoundResponse{}
+	mi := &file_event_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRoundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRoundResponse) ProtoMessage() {}
+
+func (x *UpdateEventRoundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRoundResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEventRoundResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateEventRoundResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateEventRoundResponse) GetError() UpdateEventRoundResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return UpdateEventRoundResponse_NONE
+}
+
+type EventUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Event         *EventRequest          `protobuf:"bytes,2,opt,name=event,proto3,oneof" json:"event,omitempty"`
+	ClientUserId  *uint64                `protobuf:"varint,3,opt,name=clientUserId,proto3,oneof" json:"clientUserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventUserRequest) Reset() {
+	*x = EventUserRequest{}
+	mi := &file_event_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUserRequest) ProtoMessage() {}
+
+func (x *EventUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventUserRequest.ProtoReflect.Descriptor instead.
+func (*EventUserRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EventUserRequest) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *EventUserRequest) GetEvent() *EventRequest {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventUserRequest) GetClientUserId() uint64 {
+	if x != nil && x.ClientUserId != nil {
+		return *x.ClientUserId
+	}
+	return 0
+}
+
+type GetEventUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *EventUserRequest      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventUserRequest) Reset() {
+	*x = GetEventUserRequest{}
+	mi := &file_event_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventUserRequest) ProtoMessage() {}
+
+func (x *GetEventUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventUserRequest.ProtoReflect.Descriptor instead.
+func (*GetEventUserRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetEventUserRequest) GetUser() *EventUserRequest {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetEventUserRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetEventUserResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Success       bool                       `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	User          *EventUser                 `protobuf:"bytes,2,opt,name=user,proto3,oneof" json:"user,omitempty"`
+	Results       []*EventRoundUser          `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	Error         GetEventUserResponse_Error `protobuf:"varint,6,opt,name=error,proto3,enum=api.GetEventUserResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventUserResponse) Reset() {
+	*x = GetEventUserResponse{}
+	mi := &file_event_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventUserResponse) ProtoMessage() {}
+
+func (x *GetEventUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventUserResponse.ProtoReflect.Descriptor instead.
+func (*GetEventUserResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetEventUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetEventUserResponse) GetUser() *EventUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetEventUserResponse) GetResults() []*EventRoundUser {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GetEventUserResponse) GetError() GetEventUserResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetEventUserResponse_NONE
+}
+
+type UpdateEventUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *EventUserRequest      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventUserRequest) Reset() {
+	*x = UpdateEventUserRequest{}
+	mi := &file_event_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventUserRequest) ProtoMessage() {}
+
+func (x *UpdateEventUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventUserRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateEventUserRequest) GetUser() *EventUserRequest {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UpdateEventUserRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateEventUserResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Success       bool                          `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         UpdateEventUserResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.UpdateEventUserResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventUserResponse) Reset() {
+	*x = UpdateEventUserResponse{}
+	mi := &file_event_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventUserResponse) ProtoMessage() {}
+
+func (x *UpdateEventUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEventUserResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateEventUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateEventUserResponse) GetError() UpdateEventUserResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return UpdateEventUserResponse_NONE
+}
+
+type EventUserResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         EventUserResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.EventUserResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventUserResponse) Reset() {
+	*x = EventUserResponse{}
+	mi := &file_event_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUserResponse) ProtoMessage() {}
+
+func (x *EventUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventUserResponse.ProtoReflect.Descriptor instead.
+func (*EventUserResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EventUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EventUserResponse) GetError() EventUserResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return EventUserResponse_NONE
+}
+
+type AddEventResultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *EventRequest          `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	ClientUserId  uint64                 `protobuf:"varint,2,opt,name=clientUserId,proto3" json:"clientUserId,omitempty"`
+	Result        uint64                 `protobuf:"varint,3,opt,name=result,proto3" json:"result,omitempty"`
+	UserData      *structpb.Struct       `protobuf:"bytes,4,opt,name=userData,proto3" json:"userData,omitempty"`
+	RoundUserData *structpb.Struct       `protobuf:"bytes,5,opt,name=roundUserData,proto3" json:"roundUserData,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEventResultRequest) Reset() {
+	*x = AddEventResultRequest{}
+	mi := &file_event_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEventResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEventResultRequest) ProtoMessage() {}
+
+func (x *AddEventResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEventResultRequest.ProtoReflect.Descriptor instead.
+func (*AddEventResultRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddEventResultRequest) GetEvent() *EventRequest {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *AddEventResultRequest) GetClientUserId() uint64 {
+	if x != nil {
+		return x.ClientUserId
+	}
+	return 0
+}
+
+func (x *AddEventResultRequest) GetResult() uint64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *AddEventResultRequest) GetUserData() *structpb.Struct {
+	if x != nil {
+		return x.UserData
+	}
+	return nil
+}
+
+func (x *AddEventResultRequest) GetRoundUserData() *structpb.Struct {
+	if x != nil {
+		return x.RoundUserData
+	}
+	return nil
+}
+
+type AddEventResultResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Success       bool                         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         AddEventResultResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.AddEventResultResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEventResultResponse) Reset() {
+	*x = AddEventResultResponse{}
+	mi := &file_event_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEventResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEventResultResponse) ProtoMessage() {}
+
+func (x *AddEventResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEventResultResponse.ProtoReflect.Descriptor instead.
+func (*AddEventResultResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AddEventResultResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddEventResultResponse) GetError() AddEventResultResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return AddEventResultResponse_NONE
+}
+
+type PauseEventResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Success       bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         PauseEventResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.PauseEventResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseEventResponse) Reset() {
+	*x = PauseEventResponse{}
+	mi := &file_event_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseEventResponse) ProtoMessage() {}
+
+func (x *PauseEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseEventResponse.ProtoReflect.Descriptor instead.
+func (*PauseEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PauseEventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PauseEventResponse) GetError() PauseEventResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return PauseEventResponse_NONE
+}
+
+type ResumeEventResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Success       bool                      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         ResumeEventResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.ResumeEventResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeEventResponse) Reset() {
+	*x = ResumeEventResponse{}
+	mi := &file_event_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeEventResponse) ProtoMessage() {}
+
+func (x *ResumeEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeEventResponse.ProtoReflect.Descriptor instead.
+func (*ResumeEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ResumeEventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResumeEventResponse) GetError() ResumeEventResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return ResumeEventResponse_NONE
+}
+
+type CancelEventResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Success       bool                      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         CancelEventResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.CancelEventResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEventResponse) Reset() {
+	*x = CancelEventResponse{}
+	mi := &file_event_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventResponse) ProtoMessage() {}
+
+func (x *CancelEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventResponse.ProtoReflect.Descriptor instead.
+func (*CancelEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CancelEventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelEventResponse) GetError() CancelEventResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return CancelEventResponse_NONE
+}
+
+type RegisterForEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *EventRequest          `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	ClientUserId  uint64                 `protobuf:"varint,2,opt,name=clientUserId,proto3" json:"clientUserId,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterForEventRequest) Reset() {
+	*x = RegisterForEventRequest{}
+	mi := &file_event_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterForEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterForEventRequest) ProtoMessage() {}
+
+func (x *RegisterForEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterForEventRequest.ProtoReflect.Descriptor instead.
+func (*RegisterForEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RegisterForEventRequest) GetEvent() *EventRequest {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *RegisterForEventRequest) GetClientUserId() uint64 {
+	if x != nil {
+		return x.ClientUserId
+	}
+	return 0
+}
+
+func (x *RegisterForEventRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RegisterForEventResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Success       bool                           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            *uint64                        `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Error         RegisterForEventResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.RegisterForEventResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterForEventResponse) Reset() {
+	*x = RegisterForEventResponse{}
+	mi := &file_event_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterForEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterForEventResponse) ProtoMessage() {}
+
+func (x *RegisterForEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterForEventResponse.ProtoReflect.Descriptor instead.
+func (*RegisterForEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RegisterForEventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RegisterForEventResponse) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *RegisterForEventResponse) GetError() RegisterForEventResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return RegisterForEventResponse_NONE
+}
+
+type UnregisterFromEventResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Success       bool                              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         UnregisterFromEventResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.UnregisterFromEventResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterFromEventResponse) Reset() {
+	*x = UnregisterFromEventResponse{}
+	mi := &file_event_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterFromEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterFromEventResponse) ProtoMessage() {}
+
+func (x *UnregisterFromEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterFromEventResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterFromEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UnregisterFromEventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnregisterFromEventResponse) GetError() UnregisterFromEventResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return UnregisterFromEventResponse_NONE
+}
+
+type DisqualifyEventUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *EventUserRequest      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Moderator     string                 `protobuf:"bytes,3,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisqualifyEventUserRequest) Reset() {
+	*x = DisqualifyEventUserRequest{}
+	mi := &file_event_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisqualifyEventUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisqualifyEventUserRequest) ProtoMessage() {}
+
+func (x *DisqualifyEventUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisqualifyEventUserRequest.ProtoReflect.Descriptor instead.
+func (*DisqualifyEventUserRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CreateEventRound) GetName() string {
+func (x *DisqualifyEventUserRequest) GetUser() *EventUserRequest {
 	if x != nil {
-		return x.Name
+		return x.User
+	}
+	return nil
+}
+
+func (x *DisqualifyEventUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CreateEventRound) GetData() *structpb.Struct {
+func (x *DisqualifyEventUserRequest) GetModerator() string {
 	if x != nil {
-		return x.Data
+		return x.Moderator
+	}
+	return ""
+}
+
+type DisqualifyEventUserResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Success       bool                              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         DisqualifyEventUserResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.DisqualifyEventUserResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisqualifyEventUserResponse) Reset() {
+	*x = DisqualifyEventUserResponse{}
+	mi := &file_event_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisqualifyEventUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisqualifyEventUserResponse) ProtoMessage() {}
+
+func (x *DisqualifyEventUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisqualifyEventUserResponse.ProtoReflect.Descriptor instead.
+func (*DisqualifyEventUserResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DisqualifyEventUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DisqualifyEventUserResponse) GetError() DisqualifyEventUserResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return DisqualifyEventUserResponse_NONE
+}
+
+type ReinstateEventUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *EventUserRequest      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Moderator     string                 `protobuf:"bytes,3,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateEventUserRequest) Reset() {
+	*x = ReinstateEventUserRequest{}
+	mi := &file_event_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateEventUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateEventUserRequest) ProtoMessage() {}
+
+func (x *ReinstateEventUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateEventUserRequest.ProtoReflect.Descriptor instead.
+func (*ReinstateEventUserRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReinstateEventUserRequest) GetUser() *EventUserRequest {
+	if x != nil {
+		return x.User
 	}
 	return nil
 }
 
-func (x *CreateEventRound) GetEndedAt() *timestamppb.Timestamp {
+func (x *ReinstateEventUserRequest) GetReason() string {
 	if x != nil {
-		return x.EndedAt
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReinstateEventUserRequest) GetModerator() string {
+	if x != nil {
+		return x.Moderator
+	}
+	return ""
+}
+
+type ReinstateEventUserResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Success       bool                             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         ReinstateEventUserResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.ReinstateEventUserResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateEventUserResponse) Reset() {
+	*x = ReinstateEventUserResponse{}
+	mi := &file_event_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateEventUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateEventUserResponse) ProtoMessage() {}
+
+func (x *ReinstateEventUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateEventUserResponse.ProtoReflect.Descriptor instead.
+func (*ReinstateEventUserResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReinstateEventUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReinstateEventUserResponse) GetError() ReinstateEventUserResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return ReinstateEventUserResponse_NONE
+}
+
+type SetEventThirdPartyDeliveryRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Event         *EventRequest            `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Delivery      *EventThirdPartyDelivery `protobuf:"bytes,2,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEventThirdPartyDeliveryRequest) Reset() {
+	*x = SetEventThirdPartyDeliveryRequest{}
+	mi := &file_event_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEventThirdPartyDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventThirdPartyDeliveryRequest) ProtoMessage() {}
+
+func (x *SetEventThirdPartyDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventThirdPartyDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*SetEventThirdPartyDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetEventThirdPartyDeliveryRequest) GetEvent() *EventRequest {
+	if x != nil {
+		return x.Event
 	}
 	return nil
 }
 
-func (x *CreateEventRound) GetScoring() []uint64 {
+func (x *SetEventThirdPartyDeliveryRequest) GetDelivery() *EventThirdPartyDelivery {
 	if x != nil {
-		return x.Scoring
+		return x.Delivery
 	}
 	return nil
 }
 
-type CreateEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
-	Rounds        []*CreateEventRound    `protobuf:"bytes,4,rep,name=rounds,proto3" json:"rounds,omitempty"`
+type SetEventThirdPartyDeliveryResponse struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Success       bool                                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         SetEventThirdPartyDeliveryResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.SetEventThirdPartyDeliveryResponse_Error" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEventRequest) Reset() {
-	*x = CreateEventRequest{}
-	mi := &file_event_proto_msgTypes[1]
+func (x *SetEventThirdPartyDeliveryResponse) Reset() {
+	*x = SetEventThirdPartyDeliveryResponse{}
+	mi := &file_event_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEventRequest) String() string {
+func (x *SetEventThirdPartyDeliveryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventRequest) ProtoMessage() {}
+func (*SetEventThirdPartyDeliveryResponse) ProtoMessage() {}
 
-func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[1]
+func (x *SetEventThirdPartyDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,63 +4277,126 @@ func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
-func (*CreateEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use SetEventThirdPartyDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*SetEventThirdPartyDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CreateEventRequest) GetName() string {
+func (x *SetEventThirdPartyDeliveryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetEventThirdPartyDeliveryResponse) GetError() SetEventThirdPartyDeliveryResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return SetEventThirdPartyDeliveryResponse_NONE
+}
+
+type CreateEventTemplateRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data   *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Rounds []*EventTemplateRound  `protobuf:"bytes,3,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	// Events are created from the template on every activation of the cron schedule in UTC, the lead time before they start
+	CronSchedule    *string `protobuf:"bytes,4,opt,name=cronSchedule,proto3,oneof" json:"cronSchedule,omitempty"`
+	LeadTimeSeconds uint32  `protobuf:"varint,5,opt,name=leadTimeSeconds,proto3" json:"leadTimeSeconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateEventTemplateRequest) Reset() {
+	*x = CreateEventTemplateRequest{}
+	mi := &file_event_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventTemplateRequest) ProtoMessage() {}
+
+func (x *CreateEventTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateEventTemplateRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateEventRequest) GetData() *structpb.Struct {
+func (x *CreateEventTemplateRequest) GetData() *structpb.Struct {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *CreateEventRequest) GetStartedAt() *timestamppb.Timestamp {
+func (x *CreateEventTemplateRequest) GetRounds() []*EventTemplateRound {
 	if x != nil {
-		return x.StartedAt
+		return x.Rounds
 	}
 	return nil
 }
 
-func (x *CreateEventRequest) GetRounds() []*CreateEventRound {
+func (x *CreateEventTemplateRequest) GetCronSchedule() string {
+	if x != nil && x.CronSchedule != nil {
+		return *x.CronSchedule
+	}
+	return ""
+}
+
+func (x *CreateEventTemplateRequest) GetLeadTimeSeconds() uint32 {
 	if x != nil {
-		return x.Rounds
+		return x.LeadTimeSeconds
 	}
-	return nil
+	return 0
 }
 
-type CreateEventResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Success       bool                      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Id            *uint64                   `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Error         CreateEventResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.CreateEventResponse_Error" json:"error,omitempty"`
+type CreateEventTemplateResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Success       bool                              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            *uint64                           `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Error         CreateEventTemplateResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.CreateEventTemplateResponse_Error" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEventResponse) Reset() {
-	*x = CreateEventResponse{}
-	mi := &file_event_proto_msgTypes[2]
+func (x *CreateEventTemplateResponse) Reset() {
+	*x = CreateEventTemplateResponse{}
+	mi := &file_event_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEventResponse) String() string {
+func (x *CreateEventTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventResponse) ProtoMessage() {}
+func (*CreateEventTemplateResponse) ProtoMessage() {}
 
-func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[2]
+func (x *CreateEventTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,33 +4407,33 @@ func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
-func (*CreateEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CreateEventTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateEventTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *CreateEventResponse) GetSuccess() bool {
+func (x *CreateEventTemplateResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateEventResponse) GetId() uint64 {
+func (x *CreateEventTemplateResponse) GetId() uint64 {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return 0
 }
 
-func (x *CreateEventResponse) GetError() CreateEventResponse_Error {
+func (x *CreateEventTemplateResponse) GetError() CreateEventTemplateResponse_Error {
 	if x != nil {
 		return x.Error
 	}
-	return CreateEventResponse_NONE
+	return CreateEventTemplateResponse_NONE
 }
 
-type EventRequest struct {
+type EventTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
 	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
@@ -995,21 +4441,21 @@ type EventRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventRequest) Reset() {
-	*x = EventRequest{}
-	mi := &file_event_proto_msgTypes[3]
+func (x *EventTemplateRequest) Reset() {
+	*x = EventTemplateRequest{}
+	mi := &file_event_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventRequest) String() string {
+func (x *EventTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventRequest) ProtoMessage() {}
+func (*EventTemplateRequest) ProtoMessage() {}
 
-func (x *EventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[3]
+func (x *EventTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,48 +4466,49 @@ func (x *EventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventRequest.ProtoReflect.Descriptor instead.
-func (*EventRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use EventTemplateRequest.ProtoReflect.Descriptor instead.
+func (*EventTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *EventRequest) GetId() uint64 {
+func (x *EventTemplateRequest) GetId() uint64 {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return 0
 }
 
-func (x *EventRequest) GetName() string {
+func (x *EventTemplateRequest) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-type GetEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *EventRequest          `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+type GetEventTemplateResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Success       bool                           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Template      *EventTemplate                 `protobuf:"bytes,2,opt,name=template,proto3,oneof" json:"template,omitempty"`
+	Error         GetEventTemplateResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetEventTemplateResponse_Error" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventRequest) Reset() {
-	*x = GetEventRequest{}
-	mi := &file_event_proto_msgTypes[4]
+func (x *GetEventTemplateResponse) Reset() {
+	*x = GetEventTemplateResponse{}
+	mi := &file_event_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventRequest) String() string {
+func (x *GetEventTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventRequest) ProtoMessage() {}
+func (*GetEventTemplateResponse) ProtoMessage() {}
 
-func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[4]
+func (x *GetEventTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,50 +4519,55 @@ func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
-func (*GetEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetEventTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetEventTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetEventRequest) GetEvent() *EventRequest {
+func (x *GetEventTemplateResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Event
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *GetEventRequest) GetPagination() *Pagination {
+func (x *GetEventTemplateResponse) GetTemplate() *EventTemplate {
 	if x != nil {
-		return x.Pagination
+		return x.Template
 	}
 	return nil
 }
 
-type GetEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Event         *Event                 `protobuf:"bytes,2,opt,name=event,proto3,oneof" json:"event,omitempty"`
-	Leaderboard   []*EventUser           `protobuf:"bytes,3,rep,name=leaderboard,proto3" json:"leaderboard,omitempty"`
-	Error         GetEventResponse_Error `protobuf:"varint,4,opt,name=error,proto3,enum=api.GetEventResponse_Error" json:"error,omitempty"`
+func (x *GetEventTemplateResponse) GetError() GetEventTemplateResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetEventTemplateResponse_NONE
+}
+
+type EventTemplateResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Success       bool                        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         EventTemplateResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.EventTemplateResponse_Error" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventResponse) Reset() {
-	*x = GetEventResponse{}
-	mi := &file_event_proto_msgTypes[5]
+func (x *EventTemplateResponse) Reset() {
+	*x = EventTemplateResponse{}
+	mi := &file_event_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventResponse) String() string {
+func (x *EventTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventResponse) ProtoMessage() {}
+func (*EventTemplateResponse) ProtoMessage() {}
 
-func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[5]
+func (x *EventTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1126,62 +4578,48 @@ func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
-func (*GetEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use EventTemplateResponse.ProtoReflect.Descriptor instead.
+func (*EventTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetEventResponse) GetSuccess() bool {
+func (x *EventTemplateResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetEventResponse) GetEvent() *Event {
-	if x != nil {
-		return x.Event
-	}
-	return nil
-}
-
-func (x *GetEventResponse) GetLeaderboard() []*EventUser {
-	if x != nil {
-		return x.Leaderboard
-	}
-	return nil
-}
-
-func (x *GetEventResponse) GetError() GetEventResponse_Error {
+func (x *EventTemplateResponse) GetError() EventTemplateResponse_Error {
 	if x != nil {
 		return x.Error
 	}
-	return GetEventResponse_NONE
+	return EventTemplateResponse_NONE
 }
 
-type UpdateEventRequest struct {
+type CreateEventFromTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *EventRequest          `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Template      *EventTemplateRequest  `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventRequest) Reset() {
-	*x = UpdateEventRequest{}
-	mi := &file_event_proto_msgTypes[6]
+func (x *CreateEventFromTemplateRequest) Reset() {
+	*x = CreateEventFromTemplateRequest{}
+	mi := &file_event_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventRequest) String() string {
+func (x *CreateEventFromTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventRequest) ProtoMessage() {}
+func (*CreateEventFromTemplateRequest) ProtoMessage() {}
 
-func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[6]
+func (x *CreateEventFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,48 +4630,50 @@ func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CreateEventFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UpdateEventRequest) GetEvent() *EventRequest {
+func (x *CreateEventFromTemplateRequest) GetTemplate() *EventTemplateRequest {
 	if x != nil {
-		return x.Event
+		return x.Template
 	}
 	return nil
 }
 
-func (x *UpdateEventRequest) GetData() *structpb.Struct {
+func (x *CreateEventFromTemplateRequest) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Data
+		return x.StartedAt
 	}
 	return nil
 }
 
-type UpdateEventResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Success       bool                      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         UpdateEventResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.UpdateEventResponse_Error" json:"error,omitempty"`
+type CreateEventFromTemplateResponse struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Success       bool                                  `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            *uint64                               `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name          *string                               `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Error         CreateEventFromTemplateResponse_Error `protobuf:"varint,4,opt,name=error,proto3,enum=api.CreateEventFromTemplateResponse_Error" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventResponse) Reset() {
-	*x = UpdateEventResponse{}
-	mi := &file_event_proto_msgTypes[7]
+func (x *CreateEventFromTemplateResponse) Reset() {
+	*x = CreateEventFromTemplateResponse{}
+	mi := &file_event_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventResponse) String() string {
+func (x *CreateEventFromTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventResponse) ProtoMessage() {}
+func (*CreateEventFromTemplateResponse) ProtoMessage() {}
 
-func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[7]
+func (x *CreateEventFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1244,48 +4684,61 @@ func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CreateEventFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateEventFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UpdateEventResponse) GetSuccess() bool {
+func (x *CreateEventFromTemplateResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpdateEventResponse) GetError() UpdateEventResponse_Error {
+func (x *CreateEventFromTemplateResponse) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *CreateEventFromTemplateResponse) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CreateEventFromTemplateResponse) GetError() CreateEventFromTemplateResponse_Error {
 	if x != nil {
 		return x.Error
 	}
-	return UpdateEventResponse_NONE
+	return CreateEventFromTemplateResponse_NONE
 }
 
-type EventResponse struct {
+type EventRoundUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         EventResponse_Error    `protobuf:"varint,2,opt,name=error,proto3,enum=api.EventResponse_Error" json:"error,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventResponse) Reset() {
-	*x = EventResponse{}
-	mi := &file_event_proto_msgTypes[8]
+func (x *EventRoundUserRequest) Reset() {
+	*x = EventRoundUserRequest{}
+	mi := &file_event_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventResponse) String() string {
+func (x *EventRoundUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventResponse) ProtoMessage() {}
+func (*EventRoundUserRequest) ProtoMessage() {}
 
-func (x *EventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[8]
+func (x *EventRoundUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,48 +4749,94 @@ func (x *EventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventResponse.ProtoReflect.Descriptor instead.
-func (*EventResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use EventRoundUserRequest.ProtoReflect.Descriptor instead.
+func (*EventRoundUserRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *EventResponse) GetSuccess() bool {
+func (x *EventRoundUserRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveEventResultResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Success       bool                            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         RemoveEventResultResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.RemoveEventResultResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEventResultResponse) Reset() {
+	*x = RemoveEventResultResponse{}
+	mi := &file_event_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEventResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEventResultResponse) ProtoMessage() {}
+
+func (x *RemoveEventResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEventResultResponse.ProtoReflect.Descriptor instead.
+func (*RemoveEventResultResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RemoveEventResultResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *EventResponse) GetError() EventResponse_Error {
+func (x *RemoveEventResultResponse) GetError() RemoveEventResultResponse_Error {
 	if x != nil {
 		return x.Error
 	}
-	return EventResponse_NONE
+	return RemoveEventResultResponse_NONE
 }
 
-type CreateEventRoundRequest struct {
+type GetEventRoundAttemptsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *EventRequest          `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-	Round         *CreateEventRound      `protobuf:"bytes,2,opt,name=round,proto3" json:"round,omitempty"`
+	User          *EventUserRequest      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	RoundName     *string                `protobuf:"bytes,2,opt,name=roundName,proto3,oneof" json:"roundName,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,3,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEventRoundRequest) Reset() {
-	*x = CreateEventRoundRequest{}
-	mi := &file_event_proto_msgTypes[9]
+func (x *GetEventRoundAttemptsRequest) Reset() {
+	*x = GetEventRoundAttemptsRequest{}
+	mi := &file_event_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEventRoundRequest) String() string {
+func (x *GetEventRoundAttemptsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventRoundRequest) ProtoMessage() {}
+func (*GetEventRoundAttemptsRequest) ProtoMessage() {}
 
-func (x *CreateEventRoundRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[9]
+func (x *GetEventRoundAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,49 +4847,56 @@ func (x *CreateEventRoundRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventRoundRequest.ProtoReflect.Descriptor instead.
-func (*CreateEventRoundRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetEventRoundAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRoundAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CreateEventRoundRequest) GetEvent() *EventRequest {
+func (x *GetEventRoundAttemptsRequest) GetUser() *EventUserRequest {
 	if x != nil {
-		return x.Event
+		return x.User
 	}
 	return nil
 }
 
-func (x *CreateEventRoundRequest) GetRound() *CreateEventRound {
+func (x *GetEventRoundAttemptsRequest) GetRoundName() string {
+	if x != nil && x.RoundName != nil {
+		return *x.RoundName
+	}
+	return ""
+}
+
+func (x *GetEventRoundAttemptsRequest) GetPagination() *Pagination {
 	if x != nil {
-		return x.Round
+		return x.Pagination
 	}
 	return nil
 }
 
-type CreateEventRoundResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Success       bool                           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Id            *uint64                        `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Error         CreateEventRoundResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.CreateEventRoundResponse_Error" json:"error,omitempty"`
+type GetEventRoundAttemptsResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Success       bool                                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Attempts      []*EventRoundAttempt                `protobuf:"bytes,2,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	Error         GetEventRoundAttemptsResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetEventRoundAttemptsResponse_Error" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEventRoundResponse) Reset() {
-	*x = CreateEventRoundResponse{}
-	mi := &file_event_proto_msgTypes[10]
+func (x *GetEventRoundAttemptsResponse) Reset() {
+	*x = GetEventRoundAttemptsResponse{}
+	mi := &file_event_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEventRoundResponse) String() string {
+func (x *GetEventRoundAttemptsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventRoundResponse) ProtoMessage() {}
+func (*GetEventRoundAttemptsResponse) ProtoMessage() {}
 
-func (x *CreateEventRoundResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[10]
+func (x *GetEventRoundAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1401,56 +4907,71 @@ func (x *CreateEventRoundResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventRoundResponse.ProtoReflect.Descriptor instead.
-func (*CreateEventRoundResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetEventRoundAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventRoundAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CreateEventRoundResponse) GetSuccess() bool {
+func (x *GetEventRoundAttemptsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateEventRoundResponse) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *GetEventRoundAttemptsResponse) GetAttempts() []*EventRoundAttempt {
+	if x != nil {
+		return x.Attempts
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateEventRoundResponse) GetError() CreateEventRoundResponse_Error {
+func (x *GetEventRoundAttemptsResponse) GetError() GetEventRoundAttemptsResponse_Error {
 	if x != nil {
 		return x.Error
 	}
-	return CreateEventRoundResponse_NONE
+	return GetEventRoundAttemptsResponse_NONE
 }
 
-type EventRoundRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Event         *EventRequest          `protobuf:"bytes,2,opt,name=event,proto3,oneof" json:"event,omitempty"`
-	RoundName     *string                `protobuf:"bytes,3,opt,name=roundName,proto3,oneof" json:"roundName,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Event struct {
+	state                protoimpl.MessageState  `protogen:"open.v1"`
+	Id                   uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CurrentRoundId       *uint64                 `protobuf:"varint,4,opt,name=currentRoundId,proto3,oneof" json:"currentRoundId,omitempty"`
+	CurrentRoundName     *string                 `protobuf:"bytes,5,opt,name=currentRoundName,proto3,oneof" json:"currentRoundName,omitempty"`
+	Data                 *structpb.Struct        `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	Rounds               []*EventRound           `protobuf:"bytes,7,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	StartedAt            *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	CreatedAt            *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp  `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Status               EventStatus             `protobuf:"varint,11,opt,name=status,proto3,enum=api.EventStatus" json:"status,omitempty"`
+	EndedAt              *timestamppb.Timestamp  `protobuf:"bytes,12,opt,name=endedAt,proto3,oneof" json:"endedAt,omitempty"`
+	PausedAt             *timestamppb.Timestamp  `protobuf:"bytes,13,opt,name=pausedAt,proto3,oneof" json:"pausedAt,omitempty"`
+	CancelledAt          *timestamppb.Timestamp  `protobuf:"bytes,14,opt,name=cancelledAt,proto3,oneof" json:"cancelledAt,omitempty"`
+	RegistrationRequired bool                    `protobuf:"varint,15,opt,name=registrationRequired,proto3" json:"registrationRequired,omitempty"`
+	RegistrationOpensAt  *timestamppb.Timestamp  `protobuf:"bytes,16,opt,name=registrationOpensAt,proto3,oneof" json:"registrationOpensAt,omitempty"`
+	RegistrationClosesAt *timestamppb.Timestamp  `protobuf:"bytes,17,opt,name=registrationClosesAt,proto3,oneof" json:"registrationClosesAt,omitempty"`
+	MaxParticipants      *uint32                 `protobuf:"varint,18,opt,name=maxParticipants,proto3,oneof" json:"maxParticipants,omitempty"`
+	Eligibility          []*EventEligibilityRule `protobuf:"bytes,19,rep,name=eligibility,proto3" json:"eligibility,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *EventRoundRequest) Reset() {
-	*x = EventRoundRequest{}
-	mi := &file_event_proto_msgTypes[11]
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_event_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventRoundRequest) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventRoundRequest) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *EventRoundRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[11]
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,176 +4982,162 @@ func (x *EventRoundRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventRoundRequest.ProtoReflect.Descriptor instead.
-func (*EventRoundRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *EventRoundRequest) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *Event) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *EventRoundRequest) GetEvent() *EventRequest {
+func (x *Event) GetName() string {
 	if x != nil {
-		return x.Event
-	}
-	return nil
-}
-
-func (x *EventRoundRequest) GetRoundName() string {
-	if x != nil && x.RoundName != nil {
-		return *x.RoundName
+		return x.Name
 	}
 	return ""
 }
 
-type GetEventRoundRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Round         *EventRoundRequest     `protobuf:"bytes,1,opt,name=round,proto3" json:"round,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetEventRoundRequest) Reset() {
-	*x = GetEventRoundRequest{}
-	mi := &file_event_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Event) GetCurrentRoundId() uint64 {
+	if x != nil && x.CurrentRoundId != nil {
+		return *x.CurrentRoundId
+	}
+	return 0
 }
 
-func (x *GetEventRoundRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Event) GetCurrentRoundName() string {
+	if x != nil && x.CurrentRoundName != nil {
+		return *x.CurrentRoundName
+	}
+	return ""
 }
 
-func (*GetEventRoundRequest) ProtoMessage() {}
-
-func (x *GetEventRoundRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[12]
+func (x *Event) GetData() *structpb.Struct {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Data
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetEventRoundRequest.ProtoReflect.Descriptor instead.
-func (*GetEventRoundRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{12}
+func (x *Event) GetRounds() []*EventRound {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
 }
 
-func (x *GetEventRoundRequest) GetRound() *EventRoundRequest {
+func (x *Event) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Round
+		return x.StartedAt
 	}
 	return nil
 }
 
-func (x *GetEventRoundRequest) GetPagination() *Pagination {
+func (x *Event) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Pagination
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type GetEventRoundResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Success       bool                        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Round         *EventRound                 `protobuf:"bytes,2,opt,name=round,proto3,oneof" json:"round,omitempty"`
-	Results       []*EventRoundUser           `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
-	Error         GetEventRoundResponse_Error `protobuf:"varint,4,opt,name=error,proto3,enum=api.GetEventRoundResponse_Error" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Event) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-func (x *GetEventRoundResponse) Reset() {
-	*x = GetEventRoundResponse{}
-	mi := &file_event_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Event) GetStatus() EventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EventStatus_DRAFT
 }
 
-func (x *GetEventRoundResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Event) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
 }
 
-func (*GetEventRoundResponse) ProtoMessage() {}
-
-func (x *GetEventRoundResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[13]
+func (x *Event) GetPausedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PausedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetEventRoundResponse.ProtoReflect.Descriptor instead.
-func (*GetEventRoundResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{13}
+func (x *Event) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
 }
 
-func (x *GetEventRoundResponse) GetSuccess() bool {
+func (x *Event) GetRegistrationRequired() bool {
 	if x != nil {
-		return x.Success
+		return x.RegistrationRequired
 	}
 	return false
 }
 
-func (x *GetEventRoundResponse) GetRound() *EventRound {
+func (x *Event) GetRegistrationOpensAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Round
+		return x.RegistrationOpensAt
 	}
 	return nil
 }
 
-func (x *GetEventRoundResponse) GetResults() []*EventRoundUser {
+func (x *Event) GetRegistrationClosesAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Results
+		return x.RegistrationClosesAt
 	}
 	return nil
 }
 
-func (x *GetEventRoundResponse) GetError() GetEventRoundResponse_Error {
+func (x *Event) GetMaxParticipants() uint32 {
+	if x != nil && x.MaxParticipants != nil {
+		return *x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *Event) GetEligibility() []*EventEligibilityRule {
 	if x != nil {
-		return x.Error
+		return x.Eligibility
 	}
-	return GetEventRoundResponse_NONE
+	return nil
 }
 
-type UpdateEventRoundRequest struct {
+// A rule on a number in the data of a user, such as a minimum level
+type EventEligibilityRule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Round         *EventRoundRequest     `protobuf:"bytes,1,opt,name=round,proto3" json:"round,omitempty"`
-	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3,oneof" json:"data,omitempty"`
-	Scoring       []uint64               `protobuf:"varint,3,rep,packed,name=scoring,proto3" json:"scoring,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Minimum       *float64               `protobuf:"fixed64,2,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+	Maximum       *float64               `protobuf:"fixed64,3,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventRoundRequest) Reset() {
-	*x = UpdateEventRoundRequest{}
-	mi := &file_event_proto_msgTypes[14]
+func (x *EventEligibilityRule) Reset() {
+	*x = EventEligibilityRule{}
+	mi := &file_event_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventRoundRequest) String() string {
+func (x *EventEligibilityRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventRoundRequest) ProtoMessage() {}
+func (*EventEligibilityRule) ProtoMessage() {}
 
-func (x *UpdateEventRoundRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[14]
+func (x *EventEligibilityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1641,55 +5148,65 @@ func (x *UpdateEventRoundRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventRoundRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEventRoundRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use EventEligibilityRule.ProtoReflect.Descriptor instead.
+func (*EventEligibilityRule) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *UpdateEventRoundRequest) GetRound() *EventRoundRequest {
+func (x *EventEligibilityRule) GetKey() string {
 	if x != nil {
-		return x.Round
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateEventRoundRequest) GetData() *structpb.Struct {
-	if x != nil {
-		return x.Data
+func (x *EventEligibilityRule) GetMinimum() float64 {
+	if x != nil && x.Minimum != nil {
+		return *x.Minimum
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateEventRoundRequest) GetScoring() []uint64 {
-	if x != nil {
-		return x.Scoring
+func (x *EventEligibilityRule) GetMaximum() float64 {
+	if x != nil && x.Maximum != nil {
+		return *x.Maximum
 	}
-	return nil
+	return 0
 }
 
-type UpdateEventRoundResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Success       bool                           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         UpdateEventRoundResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.UpdateEventRoundResponse_Error" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type EventRound struct {
+	state                protoimpl.MessageState      `protogen:"open.v1"`
+	Id                   uint64                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId              uint64                      `protobuf:"varint,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	Name                 string                      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Scoring              []uint64                    `protobuf:"varint,4,rep,packed,name=scoring,proto3" json:"scoring,omitempty"`
+	Data                 *structpb.Struct            `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	EndedAt              *timestamppb.Timestamp      `protobuf:"bytes,6,opt,name=endedAt,proto3" json:"endedAt,omitempty"`
+	CreatedAt            *timestamppb.Timestamp      `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp      `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	ResultOrder          EventRoundResultOrder       `protobuf:"varint,9,opt,name=resultOrder,proto3,enum=api.EventRoundResultOrder" json:"resultOrder,omitempty"`
+	ScoringFormula       *EventRoundScoringFormula   `protobuf:"bytes,10,opt,name=scoringFormula,proto3,oneof" json:"scoringFormula,omitempty"`
+	ResultAggregation    EventRoundResultAggregation `protobuf:"varint,11,opt,name=resultAggregation,proto3,enum=api.EventRoundResultAggregation" json:"resultAggregation,omitempty"`
+	ResultAggregationTop *uint32                     `protobuf:"varint,12,opt,name=resultAggregationTop,proto3,oneof" json:"resultAggregationTop,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *UpdateEventRoundResponse) Reset() {
-	*x = UpdateEventRoundResponse{}
-	mi := &file_event_proto_msgTypes[15]
+func (x *EventRound) Reset() {
+	*x = EventRound{}
+	mi := &file_event_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventRoundResponse) String() string {
+func (x *EventRound) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventRoundResponse) ProtoMessage() {}
+func (*EventRound) ProtoMessage() {}
 
-func (x *UpdateEventRoundResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[15]
+func (x *EventRound) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1700,108 +5217,127 @@ func (x *UpdateEventRoundResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventRoundResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEventRoundResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use EventRound.ProtoReflect.Descriptor instead.
+func (*EventRound) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *UpdateEventRoundResponse) GetSuccess() bool {
+func (x *EventRound) GetId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-func (x *UpdateEventRoundResponse) GetError() UpdateEventRoundResponse_Error {
+func (x *EventRound) GetEventId() uint64 {
 	if x != nil {
-		return x.Error
+		return x.EventId
 	}
-	return UpdateEventRoundResponse_NONE
+	return 0
 }
 
-type EventUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint64                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Event         *EventRequest          `protobuf:"bytes,2,opt,name=event,proto3,oneof" json:"event,omitempty"`
-	ClientUserId  *uint64                `protobuf:"varint,3,opt,name=clientUserId,proto3,oneof" json:"clientUserId,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EventRound) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *EventUserRequest) Reset() {
-	*x = EventUserRequest{}
-	mi := &file_event_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EventRound) GetScoring() []uint64 {
+	if x != nil {
+		return x.Scoring
+	}
+	return nil
 }
 
-func (x *EventUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventRound) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
-func (*EventUserRequest) ProtoMessage() {}
+func (x *EventRound) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
 
-func (x *EventUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[16]
+func (x *EventRound) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
+}
+
+func (x *EventRound) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-// Deprecated: Use EventUserRequest.ProtoReflect.Descriptor instead.
-func (*EventUserRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{16}
+func (x *EventRound) GetResultOrder() EventRoundResultOrder {
+	if x != nil {
+		return x.ResultOrder
+	}
+	return EventRoundResultOrder_ASC
 }
 
-func (x *EventUserRequest) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *EventRound) GetScoringFormula() *EventRoundScoringFormula {
+	if x != nil {
+		return x.ScoringFormula
 	}
-	return 0
+	return nil
 }
 
-func (x *EventUserRequest) GetEvent() *EventRequest {
+func (x *EventRound) GetResultAggregation() EventRoundResultAggregation {
 	if x != nil {
-		return x.Event
+		return x.ResultAggregation
 	}
-	return nil
+	return EventRoundResultAggregation_LATEST
 }
 
-func (x *EventUserRequest) GetClientUserId() uint64 {
-	if x != nil && x.ClientUserId != nil {
-		return *x.ClientUserId
+func (x *EventRound) GetResultAggregationTop() uint32 {
+	if x != nil && x.ResultAggregationTop != nil {
+		return *x.ResultAggregationTop
 	}
 	return 0
 }
 
-type GetEventUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *EventUserRequest      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type EventUser struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId                uint64                 `protobuf:"varint,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	ClientUserId           uint64                 `protobuf:"varint,3,opt,name=clientUserId,proto3" json:"clientUserId,omitempty"`
+	Score                  uint64                 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Ranking                uint64                 `protobuf:"varint,5,opt,name=ranking,proto3" json:"ranking,omitempty"`
+	Data                   *structpb.Struct       `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt              *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	DisqualifiedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=disqualifiedAt,proto3,oneof" json:"disqualifiedAt,omitempty"`
+	DisqualificationReason *string                `protobuf:"bytes,10,opt,name=disqualificationReason,proto3,oneof" json:"disqualificationReason,omitempty"`
+	DisqualifiedBy         *string                `protobuf:"bytes,11,opt,name=disqualifiedBy,proto3,oneof" json:"disqualifiedBy,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetEventUserRequest) Reset() {
-	*x = GetEventUserRequest{}
-	mi := &file_event_proto_msgTypes[17]
+func (x *EventUser) Reset() {
+	*x = EventUser{}
+	mi := &file_event_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventUserRequest) String() string {
+func (x *EventUser) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventUserRequest) ProtoMessage() {}
+func (*EventUser) ProtoMessage() {}
 
-func (x *GetEventUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[17]
+func (x *EventUser) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1812,116 +5348,119 @@ func (x *GetEventUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventUserRequest.ProtoReflect.Descriptor instead.
-func (*GetEventUserRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use EventUser.ProtoReflect.Descriptor instead.
+func (*EventUser) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetEventUserRequest) GetUser() *EventUserRequest {
+func (x *EventUser) GetId() uint64 {
 	if x != nil {
-		return x.User
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *GetEventUserRequest) GetPagination() *Pagination {
+func (x *EventUser) GetEventId() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.EventId
 	}
-	return nil
-}
-
-type GetEventUserResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Success       bool                       `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	User          *EventUser                 `protobuf:"bytes,2,opt,name=user,proto3,oneof" json:"user,omitempty"`
-	Results       []*EventRoundUser          `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
-	Error         GetEventUserResponse_Error `protobuf:"varint,6,opt,name=error,proto3,enum=api.GetEventUserResponse_Error" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *GetEventUserResponse) Reset() {
-	*x = GetEventUserResponse{}
-	mi := &file_event_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EventUser) GetClientUserId() uint64 {
+	if x != nil {
+		return x.ClientUserId
+	}
+	return 0
 }
 
-func (x *GetEventUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventUser) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
 }
 
-func (*GetEventUserResponse) ProtoMessage() {}
-
-func (x *GetEventUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[18]
+func (x *EventUser) GetRanking() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Ranking
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetEventUserResponse.ProtoReflect.Descriptor instead.
-func (*GetEventUserResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{18}
+func (x *EventUser) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
-func (x *GetEventUserResponse) GetSuccess() bool {
+func (x *EventUser) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Success
+		return x.CreatedAt
 	}
-	return false
+	return nil
 }
 
-func (x *GetEventUserResponse) GetUser() *EventUser {
+func (x *EventUser) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.User
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *GetEventUserResponse) GetResults() []*EventRoundUser {
+func (x *EventUser) GetDisqualifiedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Results
+		return x.DisqualifiedAt
 	}
 	return nil
 }
 
-func (x *GetEventUserResponse) GetError() GetEventUserResponse_Error {
-	if x != nil {
-		return x.Error
+func (x *EventUser) GetDisqualificationReason() string {
+	if x != nil && x.DisqualificationReason != nil {
+		return *x.DisqualificationReason
 	}
-	return GetEventUserResponse_NONE
+	return ""
 }
 
-type UpdateEventUserRequest struct {
+func (x *EventUser) GetDisqualifiedBy() string {
+	if x != nil && x.DisqualifiedBy != nil {
+		return *x.DisqualifiedBy
+	}
+	return ""
+}
+
+type EventRoundUser struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *EventUserRequest      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventUserId   uint64                 `protobuf:"varint,2,opt,name=eventUserId,proto3" json:"eventUserId,omitempty"`
+	ClientUserId  uint64                 `protobuf:"varint,3,opt,name=clientUserId,proto3" json:"clientUserId,omitempty"`
+	EventRoundId  uint64                 `protobuf:"varint,4,opt,name=eventRoundId,proto3" json:"eventRoundId,omitempty"`
+	Result        uint64                 `protobuf:"varint,5,opt,name=result,proto3" json:"result,omitempty"`
+	Ranking       uint64                 `protobuf:"varint,6,opt,name=ranking,proto3" json:"ranking,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	EventTeamId   *uint64                `protobuf:"varint,10,opt,name=eventTeamId,proto3,oneof" json:"eventTeamId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventUserRequest) Reset() {
-	*x = UpdateEventUserRequest{}
-	mi := &file_event_proto_msgTypes[19]
+func (x *EventRoundUser) Reset() {
+	*x = EventRoundUser{}
+	mi := &file_event_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventUserRequest) String() string {
+func (x *EventRoundUser) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventUserRequest) ProtoMessage() {}
+func (*EventRoundUser) ProtoMessage() {}
 
-func (x *UpdateEventUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[19]
+func (x *EventRoundUser) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1932,155 +5471,109 @@ func (x *UpdateEventUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEventUserRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use EventRoundUser.ProtoReflect.Descriptor instead.
+func (*EventRoundUser) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UpdateEventUserRequest) GetUser() *EventUserRequest {
+func (x *EventRoundUser) GetId() uint64 {
 	if x != nil {
-		return x.User
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateEventUserRequest) GetData() *structpb.Struct {
+func (x *EventRoundUser) GetEventUserId() uint64 {
 	if x != nil {
-		return x.Data
+		return x.EventUserId
 	}
-	return nil
-}
-
-type UpdateEventUserResponse struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Success       bool                          `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         UpdateEventUserResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.UpdateEventUserResponse_Error" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateEventUserResponse) Reset() {
-	*x = UpdateEventUserResponse{}
-	mi := &file_event_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateEventUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*UpdateEventUserResponse) ProtoMessage() {}
-
-func (x *UpdateEventUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[20]
+func (x *EventRoundUser) GetClientUserId() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ClientUserId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateEventUserResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEventUserResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{20}
+	return 0
 }
 
-func (x *UpdateEventUserResponse) GetSuccess() bool {
+func (x *EventRoundUser) GetEventRoundId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.EventRoundId
 	}
-	return false
+	return 0
 }
 
-func (x *UpdateEventUserResponse) GetError() UpdateEventUserResponse_Error {
+func (x *EventRoundUser) GetResult() uint64 {
 	if x != nil {
-		return x.Error
+		return x.Result
 	}
-	return UpdateEventUserResponse_NONE
-}
-
-type EventUserResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         EventUserResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.EventUserResponse_Error" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *EventUserResponse) Reset() {
-	*x = EventUserResponse{}
-	mi := &file_event_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *EventUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventRoundUser) GetRanking() uint64 {
+	if x != nil {
+		return x.Ranking
+	}
+	return 0
 }
 
-func (*EventUserResponse) ProtoMessage() {}
-
-func (x *EventUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[21]
+func (x *EventRoundUser) GetData() *structpb.Struct {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Data
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use EventUserResponse.ProtoReflect.Descriptor instead.
-func (*EventUserResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{21}
+func (x *EventRoundUser) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *EventUserResponse) GetSuccess() bool {
+func (x *EventRoundUser) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Success
+		return x.UpdatedAt
 	}
-	return false
+	return nil
 }
 
-func (x *EventUserResponse) GetError() EventUserResponse_Error {
-	if x != nil {
-		return x.Error
+func (x *EventRoundUser) GetEventTeamId() uint64 {
+	if x != nil && x.EventTeamId != nil {
+		return *x.EventTeamId
 	}
-	return EventUserResponse_NONE
+	return 0
 }
 
-type AddEventResultRequest struct {
+type EventTeam struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *EventRequest          `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-	ClientUserId  uint64                 `protobuf:"varint,2,opt,name=clientUserId,proto3" json:"clientUserId,omitempty"`
-	Result        uint64                 `protobuf:"varint,3,opt,name=result,proto3" json:"result,omitempty"`
-	UserData      *structpb.Struct       `protobuf:"bytes,4,opt,name=userData,proto3" json:"userData,omitempty"`
-	RoundUserData *structpb.Struct       `protobuf:"bytes,5,opt,name=roundUserData,proto3" json:"roundUserData,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       uint64                 `protobuf:"varint,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	TeamId        uint64                 `protobuf:"varint,3,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Score         uint64                 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Ranking       uint64                 `protobuf:"varint,5,opt,name=ranking,proto3" json:"ranking,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddEventResultRequest) Reset() {
-	*x = AddEventResultRequest{}
-	mi := &file_event_proto_msgTypes[22]
+func (x *EventTeam) Reset() {
+	*x = EventTeam{}
+	mi := &file_event_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddEventResultRequest) String() string {
+func (x *EventTeam) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventResultRequest) ProtoMessage() {}
+func (*EventTeam) ProtoMessage() {}
 
-func (x *AddEventResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[22]
+func (x *EventTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2091,69 +5584,87 @@ func (x *AddEventResultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventResultRequest.ProtoReflect.Descriptor instead.
-func (*AddEventResultRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use EventTeam.ProtoReflect.Descriptor instead.
+func (*EventTeam) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *AddEventResultRequest) GetEvent() *EventRequest {
+func (x *EventTeam) GetId() uint64 {
 	if x != nil {
-		return x.Event
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *AddEventResultRequest) GetClientUserId() uint64 {
+func (x *EventTeam) GetEventId() uint64 {
 	if x != nil {
-		return x.ClientUserId
+		return x.EventId
 	}
 	return 0
 }
 
-func (x *AddEventResultRequest) GetResult() uint64 {
+func (x *EventTeam) GetTeamId() uint64 {
 	if x != nil {
-		return x.Result
+		return x.TeamId
 	}
 	return 0
 }
 
-func (x *AddEventResultRequest) GetUserData() *structpb.Struct {
+func (x *EventTeam) GetScore() uint64 {
 	if x != nil {
-		return x.UserData
+		return x.Score
+	}
+	return 0
+}
+
+func (x *EventTeam) GetRanking() uint64 {
+	if x != nil {
+		return x.Ranking
+	}
+	return 0
+}
+
+func (x *EventTeam) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *AddEventResultRequest) GetRoundUserData() *structpb.Struct {
+func (x *EventTeam) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RoundUserData
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type AddEventResultResponse struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Success       bool                         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         AddEventResultResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.AddEventResultResponse_Error" json:"error,omitempty"`
+type EventRoundAttempt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventUserId   uint64                 `protobuf:"varint,2,opt,name=eventUserId,proto3" json:"eventUserId,omitempty"`
+	EventRoundId  uint64                 `protobuf:"varint,3,opt,name=eventRoundId,proto3" json:"eventRoundId,omitempty"`
+	Result        uint64                 `protobuf:"varint,4,opt,name=result,proto3" json:"result,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddEventResultResponse) Reset() {
-	*x = AddEventResultResponse{}
-	mi := &file_event_proto_msgTypes[23]
+func (x *EventRoundAttempt) Reset() {
+	*x = EventRoundAttempt{}
+	mi := &file_event_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddEventResultResponse) String() string {
+func (x *EventRoundAttempt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventResultResponse) ProtoMessage() {}
+func (*EventRoundAttempt) ProtoMessage() {}
 
-func (x *AddEventResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[23]
+func (x *EventRoundAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,92 +5675,76 @@ func (x *AddEventResultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventResultResponse.ProtoReflect.Descriptor instead.
-func (*AddEventResultResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use EventRoundAttempt.ProtoReflect.Descriptor instead.
+func (*EventRoundAttempt) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *AddEventResultResponse) GetSuccess() bool {
+func (x *EventRoundAttempt) GetId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-func (x *AddEventResultResponse) GetError() AddEventResultResponse_Error {
+func (x *EventRoundAttempt) GetEventUserId() uint64 {
 	if x != nil {
-		return x.Error
+		return x.EventUserId
 	}
-	return AddEventResultResponse_NONE
-}
-
-type EventRoundUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *EventRoundUserRequest) Reset() {
-	*x = EventRoundUserRequest{}
-	mi := &file_event_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *EventRoundUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventRoundAttempt) GetEventRoundId() uint64 {
+	if x != nil {
+		return x.EventRoundId
+	}
+	return 0
 }
 
-func (*EventRoundUserRequest) ProtoMessage() {}
-
-func (x *EventRoundUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[24]
+func (x *EventRoundAttempt) GetResult() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Result
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use EventRoundUserRequest.ProtoReflect.Descriptor instead.
-func (*EventRoundUserRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{24}
+func (x *EventRoundAttempt) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
-func (x *EventRoundUserRequest) GetId() uint64 {
+func (x *EventRoundAttempt) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-type RemoveEventResultResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Success       bool                            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         RemoveEventResultResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.RemoveEventResultResponse_Error" json:"error,omitempty"`
+type EventRoundPercentileBand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Percentile    uint32                 `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	Points        uint64                 `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveEventResultResponse) Reset() {
-	*x = RemoveEventResultResponse{}
-	mi := &file_event_proto_msgTypes[25]
+func (x *EventRoundPercentileBand) Reset() {
+	*x = EventRoundPercentileBand{}
+	mi := &file_event_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveEventResultResponse) String() string {
+func (x *EventRoundPercentileBand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveEventResultResponse) ProtoMessage() {}
+func (*EventRoundPercentileBand) ProtoMessage() {}
 
-func (x *RemoveEventResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[25]
+func (x *EventRoundPercentileBand) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2260,55 +5755,53 @@ func (x *RemoveEventResultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveEventResultResponse.ProtoReflect.Descriptor instead.
-func (*RemoveEventResultResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use EventRoundPercentileBand.ProtoReflect.Descriptor instead.
+func (*EventRoundPercentileBand) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *RemoveEventResultResponse) GetSuccess() bool {
+func (x *EventRoundPercentileBand) GetPercentile() uint32 {
 	if x != nil {
-		return x.Success
+		return x.Percentile
 	}
-	return false
+	return 0
 }
 
-func (x *RemoveEventResultResponse) GetError() RemoveEventResultResponse_Error {
+func (x *EventRoundPercentileBand) GetPoints() uint64 {
 	if x != nil {
-		return x.Error
+		return x.Points
 	}
-	return RemoveEventResultResponse_NONE
+	return 0
 }
 
-type Event struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CurrentRoundId   *uint64                `protobuf:"varint,4,opt,name=currentRoundId,proto3,oneof" json:"currentRoundId,omitempty"`
-	CurrentRoundName *string                `protobuf:"bytes,5,opt,name=currentRoundName,proto3,oneof" json:"currentRoundName,omitempty"`
-	Data             *structpb.Struct       `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
-	Rounds           []*EventRound          `protobuf:"bytes,7,rep,name=rounds,proto3" json:"rounds,omitempty"`
-	StartedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type EventRoundScoringFormula struct {
+	state           protoimpl.MessageState       `protogen:"open.v1"`
+	Type            EventRoundScoringFormulaType `protobuf:"varint,1,opt,name=type,proto3,enum=api.EventRoundScoringFormulaType" json:"type,omitempty"`
+	PercentileBands []*EventRoundPercentileBand  `protobuf:"bytes,2,rep,name=percentileBands,proto3" json:"percentileBands,omitempty"`
+	Points          uint64                       `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	Decay           uint64                       `protobuf:"varint,4,opt,name=decay,proto3" json:"decay,omitempty"`
+	Minimum         uint64                       `protobuf:"varint,5,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	Factor          float64                      `protobuf:"fixed64,6,opt,name=factor,proto3" json:"factor,omitempty"`
+	Maximum         *uint64                      `protobuf:"varint,7,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
-	mi := &file_event_proto_msgTypes[26]
+func (x *EventRoundScoringFormula) Reset() {
+	*x = EventRoundScoringFormula{}
+	mi := &file_event_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) String() string {
+func (x *EventRoundScoringFormula) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*EventRoundScoringFormula) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[26]
+func (x *EventRoundScoringFormula) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2319,103 +5812,88 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use EventRoundScoringFormula.ProtoReflect.Descriptor instead.
+func (*EventRoundScoringFormula) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *Event) GetId() uint64 {
+func (x *EventRoundScoringFormula) GetType() EventRoundScoringFormulaType {
 	if x != nil {
-		return x.Id
+		return x.Type
 	}
-	return 0
+	return EventRoundScoringFormulaType_PERCENTILE_BANDS
 }
 
-func (x *Event) GetName() string {
+func (x *EventRoundScoringFormula) GetPercentileBands() []*EventRoundPercentileBand {
 	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Event) GetCurrentRoundId() uint64 {
-	if x != nil && x.CurrentRoundId != nil {
-		return *x.CurrentRoundId
-	}
-	return 0
-}
-
-func (x *Event) GetCurrentRoundName() string {
-	if x != nil && x.CurrentRoundName != nil {
-		return *x.CurrentRoundName
+		return x.PercentileBands
 	}
-	return ""
+	return nil
 }
 
-func (x *Event) GetData() *structpb.Struct {
+func (x *EventRoundScoringFormula) GetPoints() uint64 {
 	if x != nil {
-		return x.Data
+		return x.Points
 	}
-	return nil
+	return 0
 }
 
-func (x *Event) GetRounds() []*EventRound {
+func (x *EventRoundScoringFormula) GetDecay() uint64 {
 	if x != nil {
-		return x.Rounds
+		return x.Decay
 	}
-	return nil
+	return 0
 }
 
-func (x *Event) GetStartedAt() *timestamppb.Timestamp {
+func (x *EventRoundScoringFormula) GetMinimum() uint64 {
 	if x != nil {
-		return x.StartedAt
+		return x.Minimum
 	}
-	return nil
+	return 0
 }
 
-func (x *Event) GetCreatedAt() *timestamppb.Timestamp {
+func (x *EventRoundScoringFormula) GetFactor() float64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Factor
 	}
-	return nil
+	return 0
 }
 
-func (x *Event) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
+func (x *EventRoundScoringFormula) GetMaximum() uint64 {
+	if x != nil && x.Maximum != nil {
+		return *x.Maximum
 	}
-	return nil
+	return 0
 }
 
-type EventRound struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	EventId       uint64                 `protobuf:"varint,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Scoring       []uint64               `protobuf:"varint,4,rep,packed,name=scoring,proto3" json:"scoring,omitempty"`
-	Data          *structpb.Struct       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=endedAt,proto3" json:"endedAt,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+// How an event and its rounds are sent to the third party once they end, using the defaults of the sender for anything not given
+type EventThirdPartyDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExcludedRounds []string               `protobuf:"bytes,1,rep,name=excludedRounds,proto3" json:"excludedRounds,omitempty"`
+	TopLimit       *uint32                `protobuf:"varint,2,opt,name=topLimit,proto3,oneof" json:"topLimit,omitempty"`
+	// The fields of the users sent, every field is sent if none are given
+	Fields []EventThirdPartyDeliveryField `protobuf:"varint,3,rep,packed,name=fields,proto3,enum=api.EventThirdPartyDeliveryField" json:"fields,omitempty"`
+	// Sent to instead of the third party of the sender, without its api key
+	Uri           *string `protobuf:"bytes,4,opt,name=uri,proto3,oneof" json:"uri,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventRound) Reset() {
-	*x = EventRound{}
-	mi := &file_event_proto_msgTypes[27]
+func (x *EventThirdPartyDelivery) Reset() {
+	*x = EventThirdPartyDelivery{}
+	mi := &file_event_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventRound) String() string {
+func (x *EventThirdPartyDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventRound) ProtoMessage() {}
+func (*EventThirdPartyDelivery) ProtoMessage() {}
 
-func (x *EventRound) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[27]
+func (x *EventThirdPartyDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2426,96 +5904,68 @@ func (x *EventRound) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventRound.ProtoReflect.Descriptor instead.
-func (*EventRound) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *EventRound) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *EventRound) GetEventId() uint64 {
-	if x != nil {
-		return x.EventId
-	}
-	return 0
-}
-
-func (x *EventRound) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *EventRound) GetScoring() []uint64 {
-	if x != nil {
-		return x.Scoring
-	}
-	return nil
+// Deprecated: Use EventThirdPartyDelivery.ProtoReflect.Descriptor instead.
+func (*EventThirdPartyDelivery) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *EventRound) GetData() *structpb.Struct {
+func (x *EventThirdPartyDelivery) GetExcludedRounds() []string {
 	if x != nil {
-		return x.Data
+		return x.ExcludedRounds
 	}
 	return nil
 }
 
-func (x *EventRound) GetEndedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndedAt
+func (x *EventThirdPartyDelivery) GetTopLimit() uint32 {
+	if x != nil && x.TopLimit != nil {
+		return *x.TopLimit
 	}
-	return nil
+	return 0
 }
 
-func (x *EventRound) GetCreatedAt() *timestamppb.Timestamp {
+func (x *EventThirdPartyDelivery) GetFields() []EventThirdPartyDeliveryField {
 	if x != nil {
-		return x.CreatedAt
+		return x.Fields
 	}
 	return nil
 }
 
-func (x *EventRound) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
+func (x *EventThirdPartyDelivery) GetUri() string {
+	if x != nil && x.Uri != nil {
+		return *x.Uri
 	}
-	return nil
+	return ""
 }
 
-type EventUser struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	EventId       uint64                 `protobuf:"varint,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
-	ClientUserId  uint64                 `protobuf:"varint,3,opt,name=clientUserId,proto3" json:"clientUserId,omitempty"`
-	Score         uint64                 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
-	Ranking       uint64                 `protobuf:"varint,5,opt,name=ranking,proto3" json:"ranking,omitempty"`
-	Data          *structpb.Struct       `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type EventTemplate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Data            *structpb.Struct       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Rounds          []*EventTemplateRound  `protobuf:"bytes,4,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	CronSchedule    *string                `protobuf:"bytes,5,opt,name=cronSchedule,proto3,oneof" json:"cronSchedule,omitempty"`
+	LeadTimeSeconds uint32                 `protobuf:"varint,6,opt,name=leadTimeSeconds,proto3" json:"leadTimeSeconds,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *EventUser) Reset() {
-	*x = EventUser{}
-	mi := &file_event_proto_msgTypes[28]
+func (x *EventTemplate) Reset() {
+	*x = EventTemplate{}
+	mi := &file_event_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventUser) String() string {
+func (x *EventTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventUser) ProtoMessage() {}
+func (*EventTemplate) ProtoMessage() {}
 
-func (x *EventUser) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[28]
+func (x *EventTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2526,97 +5976,97 @@ func (x *EventUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventUser.ProtoReflect.Descriptor instead.
-func (*EventUser) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use EventTemplate.ProtoReflect.Descriptor instead.
+func (*EventTemplate) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *EventUser) GetId() uint64 {
+func (x *EventTemplate) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *EventUser) GetEventId() uint64 {
+func (x *EventTemplate) GetName() string {
 	if x != nil {
-		return x.EventId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *EventUser) GetClientUserId() uint64 {
+func (x *EventTemplate) GetData() *structpb.Struct {
 	if x != nil {
-		return x.ClientUserId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *EventUser) GetScore() uint64 {
+func (x *EventTemplate) GetRounds() []*EventTemplateRound {
 	if x != nil {
-		return x.Score
+		return x.Rounds
 	}
-	return 0
+	return nil
 }
 
-func (x *EventUser) GetRanking() uint64 {
-	if x != nil {
-		return x.Ranking
+func (x *EventTemplate) GetCronSchedule() string {
+	if x != nil && x.CronSchedule != nil {
+		return *x.CronSchedule
 	}
-	return 0
+	return ""
 }
 
-func (x *EventUser) GetData() *structpb.Struct {
+func (x *EventTemplate) GetLeadTimeSeconds() uint32 {
 	if x != nil {
-		return x.Data
+		return x.LeadTimeSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *EventUser) GetCreatedAt() *timestamppb.Timestamp {
+func (x *EventTemplate) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *EventUser) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *EventTemplate) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-type EventRoundUser struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	EventUserId   uint64                 `protobuf:"varint,2,opt,name=eventUserId,proto3" json:"eventUserId,omitempty"`
-	ClientUserId  uint64                 `protobuf:"varint,3,opt,name=clientUserId,proto3" json:"clientUserId,omitempty"`
-	EventRoundId  uint64                 `protobuf:"varint,4,opt,name=eventRoundId,proto3" json:"eventRoundId,omitempty"`
-	Result        uint64                 `protobuf:"varint,5,opt,name=result,proto3" json:"result,omitempty"`
-	Ranking       uint64                 `protobuf:"varint,6,opt,name=ranking,proto3" json:"ranking,omitempty"`
-	Data          *structpb.Struct       `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *EventRoundUser) Reset() {
-	*x = EventRoundUser{}
-	mi := &file_event_proto_msgTypes[29]
+// A round of a template, which ends the given number of seconds after the event starts
+type EventTemplateRound struct {
+	state                protoimpl.MessageState      `protogen:"open.v1"`
+	Name                 string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data                 *structpb.Struct            `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	EndedAfterSeconds    uint64                      `protobuf:"varint,3,opt,name=endedAfterSeconds,proto3" json:"endedAfterSeconds,omitempty"`
+	Scoring              []uint64                    `protobuf:"varint,4,rep,packed,name=scoring,proto3" json:"scoring,omitempty"`
+	ResultOrder          EventRoundResultOrder       `protobuf:"varint,5,opt,name=resultOrder,proto3,enum=api.EventRoundResultOrder" json:"resultOrder,omitempty"`
+	ScoringFormula       *EventRoundScoringFormula   `protobuf:"bytes,6,opt,name=scoringFormula,proto3,oneof" json:"scoringFormula,omitempty"`
+	ResultAggregation    EventRoundResultAggregation `protobuf:"varint,7,opt,name=resultAggregation,proto3,enum=api.EventRoundResultAggregation" json:"resultAggregation,omitempty"`
+	ResultAggregationTop *uint32                     `protobuf:"varint,8,opt,name=resultAggregationTop,proto3,oneof" json:"resultAggregationTop,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *EventTemplateRound) Reset() {
+	*x = EventTemplateRound{}
+	mi := &file_event_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventRoundUser) String() string {
+func (x *EventTemplateRound) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventRoundUser) ProtoMessage() {}
+func (*EventTemplateRound) ProtoMessage() {}
 
-func (x *EventRoundUser) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[29]
+func (x *EventTemplateRound) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2627,72 +6077,65 @@ func (x *EventRoundUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventRoundUser.ProtoReflect.Descriptor instead.
-func (*EventRoundUser) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *EventRoundUser) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use EventTemplateRound.ProtoReflect.Descriptor instead.
+func (*EventTemplateRound) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *EventRoundUser) GetEventUserId() uint64 {
+func (x *EventTemplateRound) GetName() string {
 	if x != nil {
-		return x.EventUserId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *EventRoundUser) GetClientUserId() uint64 {
+func (x *EventTemplateRound) GetData() *structpb.Struct {
 	if x != nil {
-		return x.ClientUserId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *EventRoundUser) GetEventRoundId() uint64 {
+func (x *EventTemplateRound) GetEndedAfterSeconds() uint64 {
 	if x != nil {
-		return x.EventRoundId
+		return x.EndedAfterSeconds
 	}
 	return 0
 }
 
-func (x *EventRoundUser) GetResult() uint64 {
+func (x *EventTemplateRound) GetScoring() []uint64 {
 	if x != nil {
-		return x.Result
+		return x.Scoring
 	}
-	return 0
+	return nil
 }
 
-func (x *EventRoundUser) GetRanking() uint64 {
+func (x *EventTemplateRound) GetResultOrder() EventRoundResultOrder {
 	if x != nil {
-		return x.Ranking
+		return x.ResultOrder
 	}
-	return 0
+	return EventRoundResultOrder_ASC
 }
 
-func (x *EventRoundUser) GetData() *structpb.Struct {
+func (x *EventTemplateRound) GetScoringFormula() *EventRoundScoringFormula {
 	if x != nil {
-		return x.Data
+		return x.ScoringFormula
 	}
 	return nil
 }
 
-func (x *EventRoundUser) GetCreatedAt() *timestamppb.Timestamp {
+func (x *EventTemplateRound) GetResultAggregation() EventRoundResultAggregation {
 	if x != nil {
-		return x.CreatedAt
+		return x.ResultAggregation
 	}
-	return nil
+	return EventRoundResultAggregation_LATEST
 }
 
-func (x *EventRoundUser) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
+func (x *EventTemplateRound) GetResultAggregationTop() uint32 {
+	if x != nil && x.ResultAggregationTop != nil {
+		return *x.ResultAggregationTop
 	}
-	return nil
+	return 0
 }
 
 var File_event_proto protoreflect.FileDescriptor
@@ -2703,8 +6146,8 @@ var file_event_proto_rawDesc = string([]byte{
 	0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa3,
-	0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f,
+	0x6f, 0x1a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2,
+	0x03, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f,
 	0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
@@ -2714,326 +6157,822 @@ var file_event_proto_rawDesc = string([]byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63,
 	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x73, 0x63, 0x6f,
-	0x72, 0x69, 0x6e, 0x67, 0x22, 0xbe, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x09,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x72, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x4a, 0x0a, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x63, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x88, 0x01, 0x01, 0x12, 0x4e,
+	0x0a, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37,
+	0x0a, 0x14, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x14,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x70, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x70, 0x22, 0xe1, 0x04, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x51,
+	0x0a, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70,
+	0x65, 0x6e, 0x73, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x53, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0xa5, 0x04, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x14, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x02, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0b, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x73, 0x41, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x22, 0xb0, 0x05, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x34,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0xac, 0x04, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08,
+	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x11, 0x0a, 0x0d, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x48, 0x45,
+	0x5f, 0x50, 0x41, 0x53, 0x54, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x4f, 0x55, 0x4e, 0x44,
+	0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f,
+	0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x53, 0x10,
+	0x07, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x08, 0x12, 0x17, 0x0a, 0x13, 0x52,
+	0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f,
+	0x4e, 0x47, 0x10, 0x09, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x1b, 0x0a,
+	0x17, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x42, 0x45, 0x46,
+	0x4f, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x0c,
+	0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e,
+	0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x12, 0x0a, 0x0e,
+	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x0e,
+	0x12, 0x18, 0x0a, 0x14, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x0f, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x55,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x4e,
+	0x44, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x10, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x4f, 0x55, 0x4e,
+	0x44, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x10, 0x11, 0x12, 0x29, 0x0a, 0x25, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x53,
+	0x55, 0x4c, 0x54, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x12, 0x12, 0x2a,
+	0x0a, 0x26, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43,
+	0x4c, 0x4f, 0x53, 0x45, 0x53, 0x5f, 0x41, 0x54, 0x5f, 0x42, 0x45, 0x46, 0x4f, 0x52, 0x45, 0x5f,
+	0x4f, 0x50, 0x45, 0x4e, 0x53, 0x5f, 0x41, 0x54, 0x10, 0x13, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4c,
+	0x49, 0x47, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x10, 0x14, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x0c, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0f, 0x74,
+	0x65, 0x61, 0x6d, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x74, 0x65, 0x61, 0x6d, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc8, 0x03, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x0b, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x0b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x38, 0x0a, 0x0f,
+	0x74, 0x65, 0x61, 0x6d, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x0f, 0x74, 0x65, 0x61, 0x6d, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x51, 0x0a, 0x12, 0x74, 0x68, 0x69, 0x72, 0x64, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68,
+	0x69, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x48, 0x01, 0x52, 0x12, 0x74, 0x68, 0x69, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x60, 0x0a, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52,
+	0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x68, 0x69,
+	0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x22,
+	0xb4, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x12, 0x43, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x34,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6a, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xda, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
 	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0xa1, 0x03, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04,
-	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54,
-	0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41,
-	0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x11, 0x0a,
-	0x0d, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03,
-	0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x52,
-	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41,
-	0x52, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x50,
-	0x41, 0x53, 0x54, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x53, 0x5f,
-	0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f,
-	0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x53, 0x10, 0x07, 0x12,
-	0x18, 0x0a, 0x14, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f,
-	0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x08, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x55,
-	0x4e, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47,
-	0x10, 0x09, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x44, 0x41, 0x54, 0x41,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x1b, 0x0a, 0x17, 0x52,
-	0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x52, 0x45,
-	0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x4f, 0x55, 0x4e,
-	0x44, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x42, 0x45, 0x46, 0x4f, 0x52,
-	0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x0c, 0x12, 0x1a,
-	0x0a, 0x16, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f,
-	0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c,
-	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x0e, 0x12, 0x18,
-	0x0a, 0x14, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x4e,
-	0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x0f, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x55, 0x50, 0x4c,
-	0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x4e, 0x44, 0x45,
-	0x44, 0x5f, 0x41, 0x54, 0x10, 0x10, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x4c, 0x0a,
-	0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f,
-	0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
-	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x73, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f,
+	0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f,
+	0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52,
+	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x11, 0x0a, 0x0d, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x05, 0x22, 0xbb, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x60,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48,
+	0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f,
+	0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f,
+	0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04,
+	0x22, 0x6f, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x22, 0xd1, 0x04, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc3, 0x03, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e,
+	0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x18, 0x0a, 0x14, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54,
+	0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e,
+	0x47, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x44, 0x41, 0x54,
+	0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x08, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x4f, 0x55,
+	0x4e, 0x44, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x54,
+	0x48, 0x45, 0x5f, 0x50, 0x41, 0x53, 0x54, 0x10, 0x09, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x4f, 0x55,
+	0x4e, 0x44, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x42, 0x45, 0x46, 0x4f,
+	0x52, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44,
+	0x5f, 0x41, 0x54, 0x10, 0x0a, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x53,
+	0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x0b, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0c,
+	0x12, 0x18, 0x0a, 0x14, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x0d, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x55,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x4e,
+	0x44, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x0e, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x4f, 0x55, 0x4e,
+	0x44, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x10, 0x0f, 0x12, 0x29, 0x0a, 0x25, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x53,
+	0x55, 0x4c, 0x54, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x10, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x2c, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x01, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x89, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a,
 	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x02, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x30, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x60, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41,
-	0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11,
-	0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10,
-	0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
-	0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f,
-	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x22, 0x6a, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd1, 0x02, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x2a, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x48, 0x00, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x80, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x22, 0x8b, 0x04, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x73,
+	0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x0e, 0x73, 0x63, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x48, 0x02, 0x52, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x03, 0x52, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x14, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x14, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x42,
+	0x14, 0x0a, 0x12, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x22, 0xc5,
+	0x02, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xd3, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f,
+	0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49,
+	0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x4f, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0d, 0x0a,
+	0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
+	0x07, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x08, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x2c, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x01, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x27,
+	0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x29, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xe7, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x9c, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x49, 0x45, 0x4e,
+	0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x06, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x22, 0x70, 0x0a, 0x16, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9f, 0x02,
+	0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xaf, 0x01,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48,
+	0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f,
+	0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f,
+	0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1d,
+	0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x5f,
+	0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x11, 0x0a,
+	0x0d, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06,
+	0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x07, 0x22,
+	0xf3, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x32, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x8f, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x49, 0x45, 0x4e,
+	0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x53, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x06, 0x22, 0xf0, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x0d, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0xda, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x73, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0xcf, 0x03, 0x0a, 0x16, 0x41, 0x64, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe1, 0x02, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
 	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41,
 	0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11,
 	0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10,
 	0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
-	0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x41,
-	0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a,
-	0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x22, 0xbb, 0x01, 0x0a,
-	0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x60, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c,
+	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x53, 0x55, 0x4c,
+	0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44,
+	0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x55, 0x53,
+	0x45, 0x44, 0x10, 0x0a, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x54,
+	0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x17, 0x0a,
+	0x13, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c,
+	0x4f, 0x53, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x46, 0x55, 0x4c, 0x4c, 0x10, 0x0e, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4c,
+	0x49, 0x47, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x0f, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x53, 0x51,
+	0x55, 0x41, 0x4c, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x10, 0x22, 0x80, 0x02, 0x0a, 0x12, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x9a, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f,
+	0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49,
+	0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x22, 0xd7, 0x01,
+	0x0a, 0x13, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x34, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x70, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08,
+	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x54, 0x5f, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x05, 0x22, 0xf0, 0x01, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x88, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44,
+	0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x06, 0x22, 0x93, 0x01, 0x0a, 0x17, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x97, 0x03, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x89, 0x02, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
 	0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e,
 	0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12,
 	0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47,
 	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x22, 0x6f, 0x0a, 0x17, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b,
-	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x8b, 0x04, 0x0a, 0x18,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
-	0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43,
+	0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x41, 0x54, 0x41,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4c,
+	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44,
+	0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x4e,
+	0x4f, 0x54, 0x5f, 0x45, 0x4c, 0x49, 0x47, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x0a, 0x12, 0x0f, 0x0a,
+	0x0b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x13,
+	0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
+	0x44, 0x10, 0x0c, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0xd0, 0x02, 0x0a, 0x1b, 0x55,
+	0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0xfd, 0x02, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04,
+	0x6f, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04,
 	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54,
 	0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41,
 	0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a,
 	0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55,
-	0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f,
-	0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x4f,
-	0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f,
-	0x52, 0x54, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41,
-	0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x17, 0x0a,
-	0x13, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55,
-	0x49, 0x52, 0x45, 0x44, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f,
-	0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0x08, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x4e, 0x44,
-	0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x50, 0x41, 0x53,
-	0x54, 0x10, 0x09, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x4e, 0x44,
-	0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x42, 0x45, 0x46, 0x4f, 0x52, 0x45, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x0a, 0x12,
-	0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0c, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x55,
-	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41,
-	0x4d, 0x45, 0x10, 0x0d, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
-	0x45, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x41, 0x54,
-	0x10, 0x0e, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69,
-	0x64, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x01, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61,
-	0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06,
-	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
-	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0xd1, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
-	0x36, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x80, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e,
-	0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12,
-	0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47,
-	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x44,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x04, 0x52, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x8b, 0x02, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x99, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d,
-	0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a,
-	0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02,
-	0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52,
-	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52,
-	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x4f, 0x5f,
-	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54,
+	0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
 	0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
-	0x06, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x05, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x01, 0x52,
-	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48,
-	0x02, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88,
-	0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe7, 0x02, 0x0a,
-	0x14, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x06, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49,
+	0x53, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b,
+	0x48, 0x41, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x53, 0x10, 0x09, 0x22, 0x7d, 0x0a,
+	0x1a, 0x44, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xf3, 0x02, 0x0a,
+	0x1b, 0x44, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x73, 0x71,
+	0x75, 0x61, 0x6c, 0x69, 0x66, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0xfb, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08,
+	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x49, 0x45,
+	0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49,
+	0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x4f, 0x44,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x07, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x54,
+	0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x09, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x4c, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x0a, 0x22, 0x7c, 0x0a, 0x19, 0x52, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x22, 0xed, 0x02, 0x0a, 0x1a, 0x52, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf7, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41,
+	0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c,
+	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x4d,
+	0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x4f,
+	0x54, 0x5f, 0x44, 0x49, 0x53, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x0a,
+	0x22, 0x86, 0x01, 0x0a, 0x21, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x69,
+	0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x38, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x69,
+	0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x22, 0xa6, 0x02, 0x0a, 0x22, 0x53, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x69, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x69, 0x72, 0x64, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0xa0, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44,
+	0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x4f,
+	0x50, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x52, 0x49, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x07, 0x22, 0xf2, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x72, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0f,
+	0x6c, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6c, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x72, 0x6f, 0x6e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xe9, 0x04, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0xd5, 0x03, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08,
+	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x11, 0x0a, 0x0d, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d,
+	0x41, 0x4e, 0x59, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x53, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53,
+	0x48, 0x4f, 0x52, 0x54, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x07, 0x12,
+	0x17, 0x0a, 0x13, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x08, 0x12, 0x26, 0x0a, 0x22, 0x52, 0x4f, 0x55, 0x4e,
+	0x44, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x45,
+	0x43, 0x4f, 0x4e, 0x44, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x09,
+	0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e,
+	0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x19, 0x0a, 0x15,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x0b, 0x12, 0x29, 0x0a, 0x25, 0x52, 0x4f, 0x55, 0x4e, 0x44,
+	0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x0c, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x0d, 0x12, 0x27, 0x0a, 0x23,
+	0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f,
+	0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x43, 0x4f,
+	0x4e, 0x44, 0x53, 0x10, 0x0e, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x52, 0x4f, 0x4e, 0x5f, 0x53, 0x43,
+	0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x0f,
+	0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53,
+	0x54, 0x53, 0x10, 0x10, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x54, 0x49, 0x4d,
+	0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x11, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x93, 0x02, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x33, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x60, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44,
+	0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x04, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22,
+	0xcb, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x60, 0x0a, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c,
+	0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d,
+	0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x22, 0x91, 0x01,
+	0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f,
+	0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x35, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xe7, 0x02, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x27, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x9c,
-	0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
-	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53,
-	0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54,
-	0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f,
-	0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
-	0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45,
-	0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12,
-	0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52,
-	0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0d,
-	0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x22, 0x70, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x29, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9f, 0x02, 0x0a, 0x17, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x38,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xaf, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
-	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01,
-	0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e,
-	0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d,
-	0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17,
-	0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52,
-	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45,
-	0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x41, 0x54, 0x41,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x07, 0x22, 0xe0, 0x01, 0x0a, 0x11, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x72,
+	0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0xa9, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44,
+	0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x41,
+	0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16,
+	0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x48,
+	0x45, 0x5f, 0x50, 0x41, 0x53, 0x54, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x07, 0x42, 0x05, 0x0a, 0x03, 0x5f,
+	0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x49,
+	0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x22, 0xbf, 0x01, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xff,
+	0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7d,
-	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
-	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48,
-	0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f,
-	0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x4f,
-	0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
-	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52,
-	0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d,
-	0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x22, 0xf0, 0x01,
-	0x0a, 0x15, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x3d, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
-	0x22, 0xc7, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd9,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x3e,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xcf,
 	0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
 	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53,
 	0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54,
@@ -3041,55 +6980,90 @@ var file_event_proto_rawDesc = string([]byte{
 	0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
 	0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45,
 	0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12,
-	0x13, 0x0a, 0x0f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
-	0x45, 0x44, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54,
-	0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18,
-	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
-	0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f,
-	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x09, 0x22, 0x27, 0x0a, 0x15, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x44,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x22, 0xb5, 0x03, 0x0a, 0x05,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x0e, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x01, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x38, 0x0a,
-	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52,
+	0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x18,
+	0x0a, 0x14, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f,
+	0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x55, 0x4e,
+	0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10,
+	0x07, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x08,
+	0x22, 0xd0, 0x08, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
+	0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x10, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x08, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x08, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x13, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x05, 0x52, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x14,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x73, 0x41, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x42, 0x13,
-	0x0a, 0x11, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0xbb, 0x02, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x06, 0x52, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x07, 0x52, 0x0f, 0x6d, 0x61,
+	0x78, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x0b, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64,
+	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x42, 0x16,
+	0x0a, 0x14, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x22, 0x7e, 0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a,
+	0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52,
+	0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x22, 0xfa, 0x04, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
 	0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
@@ -3109,102 +7083,361 @@ var file_event_proto_rawDesc = string([]byte{
 	0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0xaa, 0x02, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdd,
-	0x02, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0xdc,
-	0x06, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x40, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12,
-	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c,
-	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x40, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x49, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x11,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x5a,
-	0x05, 0x2e, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x4a, 0x0a, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x11, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x14, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x14, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x70, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70,
+	0x22, 0x9e, 0x04, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a,
+	0x0e, 0x64, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x16, 0x64, 0x69, 0x73, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x16, 0x64, 0x69, 0x73, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0e, 0x64,
+	0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x41, 0x74, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x64, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x64, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42,
+	0x79, 0x22, 0x94, 0x03, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x25, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe8, 0x01, 0x0a,
+	0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x52, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x42,
+	0x61, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xa5, 0x02, 0x0a, 0x18,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f,
+	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x47, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x42, 0x61, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x63, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x64, 0x65, 0x63, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x22, 0xc9, 0x01, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x69,
+	0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12,
+	0x26, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x74, 0x6f, 0x70,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x69, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x03, 0x75, 0x72, 0x69, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74,
+	0x6f, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x69, 0x22,
+	0xe9, 0x02, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x72, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0f,
+	0x6c, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6c, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63,
+	0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xdc, 0x03, 0x0a, 0x12,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x07, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0b, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x0e, 0x73, 0x63, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x48, 0x00, 0x52, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x14, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x88, 0x01, 0x01, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x2a, 0x57, 0x0a, 0x0b, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x52, 0x41,
+	0x46, 0x54, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4e, 0x44,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
+	0x44, 0x10, 0x05, 0x2a, 0x2a, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x07, 0x0a, 0x03,
+	0x41, 0x53, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x2a,
+	0x50, 0x0a, 0x1b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a,
+	0x0a, 0x06, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x45,
+	0x53, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x54, 0x4f, 0x50, 0x10,
+	0x03, 0x2a, 0x62, 0x0a, 0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53,
+	0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x49, 0x4c, 0x45, 0x5f,
+	0x42, 0x41, 0x4e, 0x44, 0x53, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x45, 0x41,
+	0x52, 0x5f, 0x44, 0x45, 0x43, 0x41, 0x59, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f,
+	0x50, 0x4f, 0x52, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x53,
+	0x55, 0x4c, 0x54, 0x10, 0x02, 0x2a, 0xa9, 0x01, 0x0a, 0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x68, 0x69, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x10, 0x02, 0x12,
+	0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49,
+	0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x12,
+	0x09, 0x0a, 0x05, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x41,
+	0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x41, 0x10,
+	0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10,
+	0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10,
+	0x09, 0x32, 0xc7, 0x0f, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4f, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4c, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x46, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
+	0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4e, 0x0a, 0x13, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x72, 0x6f,
+	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x72,
+	0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x58, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x79, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x73,
+	0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69,
+	0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x52, 0x65, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6d, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x69, 0x72,
+	0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x26,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x69,
+	0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x69, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x58, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2e,
+	0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -3219,142 +7452,288 @@ func file_event_proto_rawDescGZIP() []byte {
 	return file_event_proto_rawDescData
 }
 
-var file_event_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
-var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_event_proto_enumTypes = make([]protoimpl.EnumInfo, 30)
+var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_event_proto_goTypes = []any{
-	(CreateEventResponse_Error)(0),       // 0: api.CreateEventResponse.Error
-	(GetEventResponse_Error)(0),          // 1: api.GetEventResponse.Error
-	(UpdateEventResponse_Error)(0),       // 2: api.UpdateEventResponse.Error
-	(EventResponse_Error)(0),             // 3: api.EventResponse.Error
-	(CreateEventRoundResponse_Error)(0),  // 4: api.CreateEventRoundResponse.Error
-	(GetEventRoundResponse_Error)(0),     // 5: api.GetEventRoundResponse.Error
-	(UpdateEventRoundResponse_Error)(0),  // 6: api.UpdateEventRoundResponse.Error
-	(GetEventUserResponse_Error)(0),      // 7: api.GetEventUserResponse.Error
-	(UpdateEventUserResponse_Error)(0),   // 8: api.UpdateEventUserResponse.Error
-	(EventUserResponse_Error)(0),         // 9: api.EventUserResponse.Error
-	(AddEventResultResponse_Error)(0),    // 10: api.AddEventResultResponse.Error
-	(RemoveEventResultResponse_Error)(0), // 11: api.RemoveEventResultResponse.Error
-	(*CreateEventRound)(nil),             // 12: api.CreateEventRound
-	(*CreateEventRequest)(nil),           // 13: api.CreateEventRequest
-	(*CreateEventResponse)(nil),          // 14: api.CreateEventResponse
-	(*EventRequest)(nil),                 // 15: api.EventRequest
-	(*GetEventRequest)(nil),              // 16: api.GetEventRequest
-	(*GetEventResponse)(nil),             // 17: api.GetEventResponse
-	(*UpdateEventRequest)(nil),           // 18: api.UpdateEventRequest
-	(*UpdateEventResponse)(nil),          // 19: api.UpdateEventResponse
-	(*EventResponse)(nil),                // 20: api.EventResponse
-	(*CreateEventRoundRequest)(nil),      // 21: api.CreateEventRoundRequest
-	(*CreateEventRoundResponse)(nil),     // 22: api.CreateEventRoundResponse
-	(*EventRoundRequest)(nil),            // 23: api.EventRoundRequest
-	(*GetEventRoundRequest)(nil),         // 24: api.GetEventRoundRequest
-	(*GetEventRoundResponse)(nil),        // 25: api.GetEventRoundResponse
-	(*UpdateEventRoundRequest)(nil),      // 26: api.UpdateEventRoundRequest
-	(*UpdateEventRoundResponse)(nil),     // 27: api.UpdateEventRoundResponse
-	(*EventUserRequest)(nil),             // 28: api.EventUserRequest
-	(*GetEventUserRequest)(nil),          // 29: api.GetEventUserRequest
-	(*GetEventUserResponse)(nil),         // 30: api.GetEventUserResponse
-	(*UpdateEventUserRequest)(nil),       // 31: api.UpdateEventUserRequest
-	(*UpdateEventUserResponse)(nil),      // 32: api.UpdateEventUserResponse
-	(*EventUserResponse)(nil),            // 33: api.EventUserResponse
-	(*AddEventResultRequest)(nil),        // 34: api.AddEventResultRequest
-	(*AddEventResultResponse)(nil),       // 35: api.AddEventResultResponse
-	(*EventRoundUserRequest)(nil),        // 36: api.EventRoundUserRequest
-	(*RemoveEventResultResponse)(nil),    // 37: api.RemoveEventResultResponse
-	(*Event)(nil),                        // 38: api.Event
-	(*EventRound)(nil),                   // 39: api.EventRound
-	(*EventUser)(nil),                    // 40: api.EventUser
-	(*EventRoundUser)(nil),               // 41: api.EventRoundUser
-	(*structpb.Struct)(nil),              // 42: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),        // 43: google.protobuf.Timestamp
-	(*Pagination)(nil),                   // 44: api.Pagination
+	(EventStatus)(0),                              // 0: api.EventStatus
+	(EventRoundResultOrder)(0),                    // 1: api.EventRoundResultOrder
+	(EventRoundResultAggregation)(0),              // 2: api.EventRoundResultAggregation
+	(EventRoundScoringFormulaType)(0),             // 3: api.EventRoundScoringFormulaType
+	(EventThirdPartyDeliveryField)(0),             // 4: api.EventThirdPartyDeliveryField
+	(CreateEventResponse_Error)(0),                // 5: api.CreateEventResponse.Error
+	(GetEventResponse_Error)(0),                   // 6: api.GetEventResponse.Error
+	(UpdateEventResponse_Error)(0),                // 7: api.UpdateEventResponse.Error
+	(EventResponse_Error)(0),                      // 8: api.EventResponse.Error
+	(CreateEventRoundResponse_Error)(0),           // 9: api.CreateEventRoundResponse.Error
+	(GetEventRoundResponse_Error)(0),              // 10: api.GetEventRoundResponse.Error
+	(UpdateEventRoundResponse_Error)(0),           // 11: api.UpdateEventRoundResponse.Error
+	(GetEventUserResponse_Error)(0),               // 12: api.GetEventUserResponse.Error
+	(UpdateEventUserResponse_Error)(0),            // 13: api.UpdateEventUserResponse.Error
+	(EventUserResponse_Error)(0),                  // 14: api.EventUserResponse.Error
+	(AddEventResultResponse_Error)(0),             // 15: api.AddEventResultResponse.Error
+	(PauseEventResponse_Error)(0),                 // 16: api.PauseEventResponse.Error
+	(ResumeEventResponse_Error)(0),                // 17: api.ResumeEventResponse.Error
+	(CancelEventResponse_Error)(0),                // 18: api.CancelEventResponse.Error
+	(RegisterForEventResponse_Error)(0),           // 19: api.RegisterForEventResponse.Error
+	(UnregisterFromEventResponse_Error)(0),        // 20: api.UnregisterFromEventResponse.Error
+	(DisqualifyEventUserResponse_Error)(0),        // 21: api.DisqualifyEventUserResponse.Error
+	(ReinstateEventUserResponse_Error)(0),         // 22: api.ReinstateEventUserResponse.Error
+	(SetEventThirdPartyDeliveryResponse_Error)(0), // 23: api.SetEventThirdPartyDeliveryResponse.Error
+	(CreateEventTemplateResponse_Error)(0),        // 24: api.CreateEventTemplateResponse.Error
+	(GetEventTemplateResponse_Error)(0),           // 25: api.GetEventTemplateResponse.Error
+	(EventTemplateResponse_Error)(0),              // 26: api.EventTemplateResponse.Error
+	(CreateEventFromTemplateResponse_Error)(0),    // 27: api.CreateEventFromTemplateResponse.Error
+	(RemoveEventResultResponse_Error)(0),          // 28: api.RemoveEventResultResponse.Error
+	(GetEventRoundAttemptsResponse_Error)(0),      // 29: api.GetEventRoundAttemptsResponse.Error
+	(*CreateEventRound)(nil),                      // 30: api.CreateEventRound
+	(*CreateEventRequest)(nil),                    // 31: api.CreateEventRequest
+	(*CreateEventResponse)(nil),                   // 32: api.CreateEventResponse
+	(*EventRequest)(nil),                          // 33: api.EventRequest
+	(*GetEventRequest)(nil),                       // 34: api.GetEventRequest
+	(*GetEventResponse)(nil),                      // 35: api.GetEventResponse
+	(*GetEventsRequest)(nil),                      // 36: api.GetEventsRequest
+	(*GetEventsResponse)(nil),                     // 37: api.GetEventsResponse
+	(*UpdateEventRequest)(nil),                    // 38: api.UpdateEventRequest
+	(*UpdateEventResponse)(nil),                   // 39: api.UpdateEventResponse
+	(*EventResponse)(nil),                         // 40: api.EventResponse
+	(*CreateEventRoundRequest)(nil),               // 41: api.CreateEventRoundRequest
+	(*CreateEventRoundResponse)(nil),              // 42: api.CreateEventRoundResponse
+	(*EventRoundRequest)(nil),                     // 43: api.EventRoundRequest
+	(*GetEventRoundRequest)(nil),                  // 44: api.GetEventRoundRequest
+	(*GetEventRoundResponse)(nil),                 // 45: api.GetEventRoundResponse
+	(*UpdateEventRoundRequest)(nil),               // 46: api.UpdateEventRoundRequest
+	(*UpdateEventRoundResponse)(nil),              // 47: api.UpdateEventRoundResponse
+	(*EventUserRequest)(nil),                      // 48: api.EventUserRequest
+	(*GetEventUserRequest)(nil),                   // 49: api.GetEventUserRequest
+	(*GetEventUserResponse)(nil),                  // 50: api.GetEventUserResponse
+	(*UpdateEventUserRequest)(nil),                // 51: api.UpdateEventUserRequest
+	(*UpdateEventUserResponse)(nil),               // 52: api.UpdateEventUserResponse
+	(*EventUserResponse)(nil),                     // 53: api.EventUserResponse
+	(*AddEventResultRequest)(nil),                 // 54: api.AddEventResultRequest
+	(*AddEventResultResponse)(nil),                // 55: api.AddEventResultResponse
+	(*PauseEventResponse)(nil),                    // 56: api.PauseEventResponse
+	(*ResumeEventResponse)(nil),                   // 57: api.ResumeEventResponse
+	(*CancelEventResponse)(nil),                   // 58: api.CancelEventResponse
+	(*RegisterForEventRequest)(nil),               // 59: api.RegisterForEventRequest
+	(*RegisterForEventResponse)(nil),              // 60: api.RegisterForEventResponse
+	(*UnregisterFromEventResponse)(nil),           // 61: api.UnregisterFromEventResponse
+	(*DisqualifyEventUserRequest)(nil),            // 62: api.DisqualifyEventUserRequest
+	(*DisqualifyEventUserResponse)(nil),           // 63: api.DisqualifyEventUserResponse
+	(*ReinstateEventUserRequest)(nil),             // 64: api.ReinstateEventUserRequest
+	(*ReinstateEventUserResponse)(nil),            // 65: api.ReinstateEventUserResponse
+	(*SetEventThirdPartyDeliveryRequest)(nil),     // 66: api.SetEventThirdPartyDeliveryRequest
+	(*SetEventThirdPartyDeliveryResponse)(nil),    // 67: api.SetEventThirdPartyDeliveryResponse
+	(*CreateEventTemplateRequest)(nil),            // 68: api.CreateEventTemplateRequest
+	(*CreateEventTemplateResponse)(nil),           // 69: api.CreateEventTemplateResponse
+	(*EventTemplateRequest)(nil),                  // 70: api.EventTemplateRequest
+	(*GetEventTemplateResponse)(nil),              // 71: api.GetEventTemplateResponse
+	(*EventTemplateResponse)(nil),                 // 72: api.EventTemplateResponse
+	(*CreateEventFromTemplateRequest)(nil),        // 73: api.CreateEventFromTemplateRequest
+	(*CreateEventFromTemplateResponse)(nil),       // 74: api.CreateEventFromTemplateResponse
+	(*EventRoundUserRequest)(nil),                 // 75: api.EventRoundUserRequest
+	(*RemoveEventResultResponse)(nil),             // 76: api.RemoveEventResultResponse
+	(*GetEventRoundAttemptsRequest)(nil),          // 77: api.GetEventRoundAttemptsRequest
+	(*GetEventRoundAttemptsResponse)(nil),         // 78: api.GetEventRoundAttemptsResponse
+	(*Event)(nil),                                 // 79: api.Event
+	(*EventEligibilityRule)(nil),                  // 80: api.EventEligibilityRule
+	(*EventRound)(nil),                            // 81: api.EventRound
+	(*EventUser)(nil),                             // 82: api.EventUser
+	(*EventRoundUser)(nil),                        // 83: api.EventRoundUser
+	(*EventTeam)(nil),                             // 84: api.EventTeam
+	(*EventRoundAttempt)(nil),                     // 85: api.EventRoundAttempt
+	(*EventRoundPercentileBand)(nil),              // 86: api.EventRoundPercentileBand
+	(*EventRoundScoringFormula)(nil),              // 87: api.EventRoundScoringFormula
+	(*EventThirdPartyDelivery)(nil),               // 88: api.EventThirdPartyDelivery
+	(*EventTemplate)(nil),                         // 89: api.EventTemplate
+	(*EventTemplateRound)(nil),                    // 90: api.EventTemplateRound
+	(*structpb.Struct)(nil),                       // 91: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),                 // 92: google.protobuf.Timestamp
+	(*Pagination)(nil),                            // 93: api.Pagination
 }
 var file_event_proto_depIdxs = []int32{
-	42, // 0: api.CreateEventRound.data:type_name -> google.protobuf.Struct
-	43, // 1: api.CreateEventRound.endedAt:type_name -> google.protobuf.Timestamp
-	42, // 2: api.CreateEventRequest.data:type_name -> google.protobuf.Struct
-	43, // 3: api.CreateEventRequest.startedAt:type_name -> google.protobuf.Timestamp
-	12, // 4: api.CreateEventRequest.rounds:type_name -> api.CreateEventRound
-	0,  // 5: api.CreateEventResponse.error:type_name -> api.CreateEventResponse.Error
-	15, // 6: api.GetEventRequest.event:type_name -> api.EventRequest
-	44, // 7: api.GetEventRequest.pagination:type_name -> api.Pagination
-	38, // 8: api.GetEventResponse.event:type_name -> api.Event
-	40, // 9: api.GetEventResponse.leaderboard:type_name -> api.EventUser
-	1,  // 10: api.GetEventResponse.error:type_name -> api.GetEventResponse.Error
-	15, // 11: api.UpdateEventRequest.event:type_name -> api.EventRequest
-	42, // 12: api.UpdateEventRequest.data:type_name -> google.protobuf.Struct
-	2,  // 13: api.UpdateEventResponse.error:type_name -> api.UpdateEventResponse.Error
-	3,  // 14: api.EventResponse.error:type_name -> api.EventResponse.Error
-	15, // 15: api.CreateEventRoundRequest.event:type_name -> api.EventRequest
-	12, // 16: api.CreateEventRoundRequest.round:type_name -> api.CreateEventRound
-	4,  // 17: api.CreateEventRoundResponse.error:type_name -> api.CreateEventRoundResponse.Error
-	15, // 18: api.EventRoundRequest.event:type_name -> api.EventRequest
-	23, // 19: api.GetEventRoundRequest.round:type_name -> api.EventRoundRequest
-	44, // 20: api.GetEventRoundRequest.pagination:type_name -> api.Pagination
-	39, // 21: api.GetEventRoundResponse.round:type_name -> api.EventRound
-	41, // 22: api.GetEventRoundResponse.results:type_name -> api.EventRoundUser
-	5,  // 23: api.GetEventRoundResponse.error:type_name -> api.GetEventRoundResponse.Error
-	23, // 24: api.UpdateEventRoundRequest.round:type_name -> api.EventRoundRequest
-	42, // 25: api.UpdateEventRoundRequest.data:type_name -> google.protobuf.Struct
-	6,  // 26: api.UpdateEventRoundResponse.error:type_name -> api.UpdateEventRoundResponse.Error
-	15, // 27: api.EventUserRequest.event:type_name -> api.EventRequest
-	28, // 28: api.GetEventUserRequest.user:type_name -> api.EventUserRequest
-	44, // 29: api.GetEventUserRequest.pagination:type_name -> api.Pagination
-	40, // 30: api.GetEventUserResponse.user:type_name -> api.EventUser
-	41, // 31: api.GetEventUserResponse.results:type_name -> api.EventRoundUser
-	7,  // 32: api.GetEventUserResponse.error:type_name -> api.GetEventUserResponse.Error
-	28, // 33: api.UpdateEventUserRequest.user:type_name -> api.EventUserRequest
-	42, // 34: api.UpdateEventUserRequest.data:type_name -> google.protobuf.Struct
-	8,  // 35: api.UpdateEventUserResponse.error:type_name -> api.UpdateEventUserResponse.Error
-	9,  // 36: api.EventUserResponse.error:type_name -> api.EventUserResponse.Error
-	15, // 37: api.AddEventResultRequest.event:type_name -> api.EventRequest
-	42, // 38: api.AddEventResultRequest.userData:type_name -> google.protobuf.Struct
-	42, // 39: api.AddEventResultRequest.roundUserData:type_name -> google.protobuf.Struct
-	10, // 40: api.AddEventResultResponse.error:type_name -> api.AddEventResultResponse.Error
-	11, // 41: api.RemoveEventResultResponse.error:type_name -> api.RemoveEventResultResponse.Error
-	42, // 42: api.Event.data:type_name -> google.protobuf.Struct
-	39, // 43: api.Event.rounds:type_name -> api.EventRound
-	43, // 44: api.Event.startedAt:type_name -> google.protobuf.Timestamp
-	43, // 45: api.Event.createdAt:type_name -> google.protobuf.Timestamp
-	43, // 46: api.Event.updatedAt:type_name -> google.protobuf.Timestamp
-	42, // 47: api.EventRound.data:type_name -> google.protobuf.Struct
-	43, // 48: api.EventRound.endedAt:type_name -> google.protobuf.Timestamp
-	43, // 49: api.EventRound.createdAt:type_name -> google.protobuf.Timestamp
-	43, // 50: api.EventRound.updatedAt:type_name -> google.protobuf.Timestamp
-	42, // 51: api.EventUser.data:type_name -> google.protobuf.Struct
-	43, // 52: api.EventUser.createdAt:type_name -> google.protobuf.Timestamp
-	43, // 53: api.EventUser.updatedAt:type_name -> google.protobuf.Timestamp
-	42, // 54: api.EventRoundUser.data:type_name -> google.protobuf.Struct
-	43, // 55: api.EventRoundUser.createdAt:type_name -> google.protobuf.Timestamp
-	43, // 56: api.EventRoundUser.updatedAt:type_name -> google.protobuf.Timestamp
-	13, // 57: api.EventService.CreateEvent:input_type -> api.CreateEventRequest
-	16, // 58: api.EventService.GetEvent:input_type -> api.GetEventRequest
-	18, // 59: api.EventService.UpdateEvent:input_type -> api.UpdateEventRequest
-	15, // 60: api.EventService.DeleteEvent:input_type -> api.EventRequest
-	21, // 61: api.EventService.CreateEventRound:input_type -> api.CreateEventRoundRequest
-	24, // 62: api.EventService.GetEventRound:input_type -> api.GetEventRoundRequest
-	26, // 63: api.EventService.UpdateEventRound:input_type -> api.UpdateEventRoundRequest
-	29, // 64: api.EventService.GetEventUser:input_type -> api.GetEventUserRequest
-	31, // 65: api.EventService.UpdateEventUser:input_type -> api.UpdateEventUserRequest
-	28, // 66: api.EventService.DeleteEventUser:input_type -> api.EventUserRequest
-	34, // 67: api.EventService.AddEventResult:input_type -> api.AddEventResultRequest
-	36, // 68: api.EventService.RemoveEventResult:input_type -> api.EventRoundUserRequest
-	14, // 69: api.EventService.CreateEvent:output_type -> api.CreateEventResponse
-	17, // 70: api.EventService.GetEvent:output_type -> api.GetEventResponse
-	19, // 71: api.EventService.UpdateEvent:output_type -> api.UpdateEventResponse
-	20, // 72: api.EventService.DeleteEvent:output_type -> api.EventResponse
-	22, // 73: api.EventService.CreateEventRound:output_type -> api.CreateEventRoundResponse
-	25, // 74: api.EventService.GetEventRound:output_type -> api.GetEventRoundResponse
-	27, // 75: api.EventService.UpdateEventRound:output_type -> api.UpdateEventRoundResponse
-	30, // 76: api.EventService.GetEventUser:output_type -> api.GetEventUserResponse
-	32, // 77: api.EventService.UpdateEventUser:output_type -> api.UpdateEventUserResponse
-	33, // 78: api.EventService.DeleteEventUser:output_type -> api.EventUserResponse
-	35, // 79: api.EventService.AddEventResult:output_type -> api.AddEventResultResponse
-	37, // 80: api.EventService.RemoveEventResult:output_type -> api.RemoveEventResultResponse
-	69, // [69:81] is the sub-list for method output_type
-	57, // [57:69] is the sub-list for method input_type
-	57, // [57:57] is the sub-list for extension type_name
-	57, // [57:57] is the sub-list for extension extendee
-	0,  // [0:57] is the sub-list for field type_name
+	91,  // 0: api.CreateEventRound.data:type_name -> google.protobuf.Struct
+	92,  // 1: api.CreateEventRound.endedAt:type_name -> google.protobuf.Timestamp
+	1,   // 2: api.CreateEventRound.resultOrder:type_name -> api.EventRoundResultOrder
+	87,  // 3: api.CreateEventRound.scoringFormula:type_name -> api.EventRoundScoringFormula
+	2,   // 4: api.CreateEventRound.resultAggregation:type_name -> api.EventRoundResultAggregation
+	91,  // 5: api.CreateEventRequest.data:type_name -> google.protobuf.Struct
+	92,  // 6: api.CreateEventRequest.startedAt:type_name -> google.protobuf.Timestamp
+	30,  // 7: api.CreateEventRequest.rounds:type_name -> api.CreateEventRound
+	92,  // 8: api.CreateEventRequest.registrationOpensAt:type_name -> google.protobuf.Timestamp
+	92,  // 9: api.CreateEventRequest.registrationClosesAt:type_name -> google.protobuf.Timestamp
+	80,  // 10: api.CreateEventRequest.eligibility:type_name -> api.EventEligibilityRule
+	5,   // 11: api.CreateEventResponse.error:type_name -> api.CreateEventResponse.Error
+	33,  // 12: api.GetEventRequest.event:type_name -> api.EventRequest
+	93,  // 13: api.GetEventRequest.pagination:type_name -> api.Pagination
+	79,  // 14: api.GetEventResponse.event:type_name -> api.Event
+	82,  // 15: api.GetEventResponse.leaderboard:type_name -> api.EventUser
+	84,  // 16: api.GetEventResponse.teamLeaderboard:type_name -> api.EventTeam
+	88,  // 17: api.GetEventResponse.thirdPartyDelivery:type_name -> api.EventThirdPartyDelivery
+	6,   // 18: api.GetEventResponse.error:type_name -> api.GetEventResponse.Error
+	0,   // 19: api.GetEventsRequest.statuses:type_name -> api.EventStatus
+	92,  // 20: api.GetEventsRequest.startedAfter:type_name -> google.protobuf.Timestamp
+	92,  // 21: api.GetEventsRequest.startedBefore:type_name -> google.protobuf.Timestamp
+	93,  // 22: api.GetEventsRequest.pagination:type_name -> api.Pagination
+	79,  // 23: api.GetEventsResponse.events:type_name -> api.Event
+	33,  // 24: api.UpdateEventRequest.event:type_name -> api.EventRequest
+	91,  // 25: api.UpdateEventRequest.data:type_name -> google.protobuf.Struct
+	7,   // 26: api.UpdateEventResponse.error:type_name -> api.UpdateEventResponse.Error
+	8,   // 27: api.EventResponse.error:type_name -> api.EventResponse.Error
+	33,  // 28: api.CreateEventRoundRequest.event:type_name -> api.EventRequest
+	30,  // 29: api.CreateEventRoundRequest.round:type_name -> api.CreateEventRound
+	9,   // 30: api.CreateEventRoundResponse.error:type_name -> api.CreateEventRoundResponse.Error
+	33,  // 31: api.EventRoundRequest.event:type_name -> api.EventRequest
+	43,  // 32: api.GetEventRoundRequest.round:type_name -> api.EventRoundRequest
+	93,  // 33: api.GetEventRoundRequest.pagination:type_name -> api.Pagination
+	81,  // 34: api.GetEventRoundResponse.round:type_name -> api.EventRound
+	83,  // 35: api.GetEventRoundResponse.results:type_name -> api.EventRoundUser
+	10,  // 36: api.GetEventRoundResponse.error:type_name -> api.GetEventRoundResponse.Error
+	43,  // 37: api.UpdateEventRoundRequest.round:type_name -> api.EventRoundRequest
+	91,  // 38: api.UpdateEventRoundRequest.data:type_name -> google.protobuf.Struct
+	1,   // 39: api.UpdateEventRoundRequest.resultOrder:type_name -> api.EventRoundResultOrder
+	87,  // 40: api.UpdateEventRoundRequest.scoringFormula:type_name -> api.EventRoundScoringFormula
+	2,   // 41: api.UpdateEventRoundRequest.resultAggregation:type_name -> api.EventRoundResultAggregation
+	11,  // 42: api.UpdateEventRoundResponse.error:type_name -> api.UpdateEventRoundResponse.Error
+	33,  // 43: api.EventUserRequest.event:type_name -> api.EventRequest
+	48,  // 44: api.GetEventUserRequest.user:type_name -> api.EventUserRequest
+	93,  // 45: api.GetEventUserRequest.pagination:type_name -> api.Pagination
+	82,  // 46: api.GetEventUserResponse.user:type_name -> api.EventUser
+	83,  // 47: api.GetEventUserResponse.results:type_name -> api.EventRoundUser
+	12,  // 48: api.GetEventUserResponse.error:type_name -> api.GetEventUserResponse.Error
+	48,  // 49: api.UpdateEventUserRequest.user:type_name -> api.EventUserRequest
+	91,  // 50: api.UpdateEventUserRequest.data:type_name -> google.protobuf.Struct
+	13,  // 51: api.UpdateEventUserResponse.error:type_name -> api.UpdateEventUserResponse.Error
+	14,  // 52: api.EventUserResponse.error:type_name -> api.EventUserResponse.Error
+	33,  // 53: api.AddEventResultRequest.event:type_name -> api.EventRequest
+	91,  // 54: api.AddEventResultRequest.userData:type_name -> google.protobuf.Struct
+	91,  // 55: api.AddEventResultRequest.roundUserData:type_name -> google.protobuf.Struct
+	15,  // 56: api.AddEventResultResponse.error:type_name -> api.AddEventResultResponse.Error
+	16,  // 57: api.PauseEventResponse.error:type_name -> api.PauseEventResponse.Error
+	17,  // 58: api.ResumeEventResponse.error:type_name -> api.ResumeEventResponse.Error
+	18,  // 59: api.CancelEventResponse.error:type_name -> api.CancelEventResponse.Error
+	33,  // 60: api.RegisterForEventRequest.event:type_name -> api.EventRequest
+	91,  // 61: api.RegisterForEventRequest.data:type_name -> google.protobuf.Struct
+	19,  // 62: api.RegisterForEventResponse.error:type_name -> api.RegisterForEventResponse.Error
+	20,  // 63: api.UnregisterFromEventResponse.error:type_name -> api.UnregisterFromEventResponse.Error
+	48,  // 64: api.DisqualifyEventUserRequest.user:type_name -> api.EventUserRequest
+	21,  // 65: api.DisqualifyEventUserResponse.error:type_name -> api.DisqualifyEventUserResponse.Error
+	48,  // 66: api.ReinstateEventUserRequest.user:type_name -> api.EventUserRequest
+	22,  // 67: api.ReinstateEventUserResponse.error:type_name -> api.ReinstateEventUserResponse.Error
+	33,  // 68: api.SetEventThirdPartyDeliveryRequest.event:type_name -> api.EventRequest
+	88,  // 69: api.SetEventThirdPartyDeliveryRequest.delivery:type_name -> api.EventThirdPartyDelivery
+	23,  // 70: api.SetEventThirdPartyDeliveryResponse.error:type_name -> api.SetEventThirdPartyDeliveryResponse.Error
+	91,  // 71: api.CreateEventTemplateRequest.data:type_name -> google.protobuf.Struct
+	90,  // 72: api.CreateEventTemplateRequest.rounds:type_name -> api.EventTemplateRound
+	24,  // 73: api.CreateEventTemplateResponse.error:type_name -> api.CreateEventTemplateResponse.Error
+	89,  // 74: api.GetEventTemplateResponse.template:type_name -> api.EventTemplate
+	25,  // 75: api.GetEventTemplateResponse.error:type_name -> api.GetEventTemplateResponse.Error
+	26,  // 76: api.EventTemplateResponse.error:type_name -> api.EventTemplateResponse.Error
+	70,  // 77: api.CreateEventFromTemplateRequest.template:type_name -> api.EventTemplateRequest
+	92,  // 78: api.CreateEventFromTemplateRequest.startedAt:type_name -> google.protobuf.Timestamp
+	27,  // 79: api.CreateEventFromTemplateResponse.error:type_name -> api.CreateEventFromTemplateResponse.Error
+	28,  // 80: api.RemoveEventResultResponse.error:type_name -> api.RemoveEventResultResponse.Error
+	48,  // 81: api.GetEventRoundAttemptsRequest.user:type_name -> api.EventUserRequest
+	93,  // 82: api.GetEventRoundAttemptsRequest.pagination:type_name -> api.Pagination
+	85,  // 83: api.GetEventRoundAttemptsResponse.attempts:type_name -> api.EventRoundAttempt
+	29,  // 84: api.GetEventRoundAttemptsResponse.error:type_name -> api.GetEventRoundAttemptsResponse.Error
+	91,  // 85: api.Event.data:type_name -> google.protobuf.Struct
+	81,  // 86: api.Event.rounds:type_name -> api.EventRound
+	92,  // 87: api.Event.startedAt:type_name -> google.protobuf.Timestamp
+	92,  // 88: api.Event.createdAt:type_name -> google.protobuf.Timestamp
+	92,  // 89: api.Event.updatedAt:type_name -> google.protobuf.Timestamp
+	0,   // 90: api.Event.status:type_name -> api.EventStatus
+	92,  // 91: api.Event.endedAt:type_name -> google.protobuf.Timestamp
+	92,  // 92: api.Event.pausedAt:type_name -> google.protobuf.Timestamp
+	92,  // 93: api.Event.cancelledAt:type_name -> google.protobuf.Timestamp
+	92,  // 94: api.Event.registrationOpensAt:type_name -> google.protobuf.Timestamp
+	92,  // 95: api.Event.registrationClosesAt:type_name -> google.protobuf.Timestamp
+	80,  // 96: api.Event.eligibility:type_name -> api.EventEligibilityRule
+	91,  // 97: api.EventRound.data:type_name -> google.protobuf.Struct
+	92,  // 98: api.EventRound.endedAt:type_name -> google.protobuf.Timestamp
+	92,  // 99: api.EventRound.createdAt:type_name -> google.protobuf.Timestamp
+	92,  // 100: api.EventRound.updatedAt:type_name -> google.protobuf.Timestamp
+	1,   // 101: api.EventRound.resultOrder:type_name -> api.EventRoundResultOrder
+	87,  // 102: api.EventRound.scoringFormula:type_name -> api.EventRoundScoringFormula
+	2,   // 103: api.EventRound.resultAggregation:type_name -> api.EventRoundResultAggregation
+	91,  // 104: api.EventUser.data:type_name -> google.protobuf.Struct
+	92,  // 105: api.EventUser.createdAt:type_name -> google.protobuf.Timestamp
+	92,  // 106: api.EventUser.updatedAt:type_name -> google.protobuf.Timestamp
+	92,  // 107: api.EventUser.disqualifiedAt:type_name -> google.protobuf.Timestamp
+	91,  // 108: api.EventRoundUser.data:type_name -> google.protobuf.Struct
+	92,  // 109: api.EventRoundUser.createdAt:type_name -> google.protobuf.Timestamp
+	92,  // 110: api.EventRoundUser.updatedAt:type_name -> google.protobuf.Timestamp
+	92,  // 111: api.EventTeam.createdAt:type_name -> google.protobuf.Timestamp
+	92,  // 112: api.EventTeam.updatedAt:type_name -> google.protobuf.Timestamp
+	91,  // 113: api.EventRoundAttempt.data:type_name -> google.protobuf.Struct
+	92,  // 114: api.EventRoundAttempt.createdAt:type_name -> google.protobuf.Timestamp
+	3,   // 115: api.EventRoundScoringFormula.type:type_name -> api.EventRoundScoringFormulaType
+	86,  // 116: api.EventRoundScoringFormula.percentileBands:type_name -> api.EventRoundPercentileBand
+	4,   // 117: api.EventThirdPartyDelivery.fields:type_name -> api.EventThirdPartyDeliveryField
+	91,  // 118: api.EventTemplate.data:type_name -> google.protobuf.Struct
+	90,  // 119: api.EventTemplate.rounds:type_name -> api.EventTemplateRound
+	92,  // 120: api.EventTemplate.createdAt:type_name -> google.protobuf.Timestamp
+	92,  // 121: api.EventTemplate.updatedAt:type_name -> google.protobuf.Timestamp
+	91,  // 122: api.EventTemplateRound.data:type_name -> google.protobuf.Struct
+	1,   // 123: api.EventTemplateRound.resultOrder:type_name -> api.EventRoundResultOrder
+	87,  // 124: api.EventTemplateRound.scoringFormula:type_name -> api.EventRoundScoringFormula
+	2,   // 125: api.EventTemplateRound.resultAggregation:type_name -> api.EventRoundResultAggregation
+	31,  // 126: api.EventService.CreateEvent:input_type -> api.CreateEventRequest
+	34,  // 127: api.EventService.GetEvent:input_type -> api.GetEventRequest
+	36,  // 128: api.EventService.GetEvents:input_type -> api.GetEventsRequest
+	38,  // 129: api.EventService.UpdateEvent:input_type -> api.UpdateEventRequest
+	33,  // 130: api.EventService.DeleteEvent:input_type -> api.EventRequest
+	41,  // 131: api.EventService.CreateEventRound:input_type -> api.CreateEventRoundRequest
+	44,  // 132: api.EventService.GetEventRound:input_type -> api.GetEventRoundRequest
+	46,  // 133: api.EventService.UpdateEventRound:input_type -> api.UpdateEventRoundRequest
+	49,  // 134: api.EventService.GetEventUser:input_type -> api.GetEventUserRequest
+	51,  // 135: api.EventService.UpdateEventUser:input_type -> api.UpdateEventUserRequest
+	48,  // 136: api.EventService.DeleteEventUser:input_type -> api.EventUserRequest
+	54,  // 137: api.EventService.AddEventResult:input_type -> api.AddEventResultRequest
+	75,  // 138: api.EventService.RemoveEventResult:input_type -> api.EventRoundUserRequest
+	77,  // 139: api.EventService.GetEventRoundAttempts:input_type -> api.GetEventRoundAttemptsRequest
+	33,  // 140: api.EventService.PauseEvent:input_type -> api.EventRequest
+	33,  // 141: api.EventService.ResumeEvent:input_type -> api.EventRequest
+	33,  // 142: api.EventService.CancelEvent:input_type -> api.EventRequest
+	59,  // 143: api.EventService.RegisterForEvent:input_type -> api.RegisterForEventRequest
+	48,  // 144: api.EventService.UnregisterFromEvent:input_type -> api.EventUserRequest
+	62,  // 145: api.EventService.DisqualifyEventUser:input_type -> api.DisqualifyEventUserRequest
+	64,  // 146: api.EventService.ReinstateEventUser:input_type -> api.ReinstateEventUserRequest
+	66,  // 147: api.EventService.SetEventThirdPartyDelivery:input_type -> api.SetEventThirdPartyDeliveryRequest
+	68,  // 148: api.EventService.CreateEventTemplate:input_type -> api.CreateEventTemplateRequest
+	70,  // 149: api.EventService.GetEventTemplate:input_type -> api.EventTemplateRequest
+	70,  // 150: api.EventService.DeleteEventTemplate:input_type -> api.EventTemplateRequest
+	73,  // 151: api.EventService.CreateEventFromTemplate:input_type -> api.CreateEventFromTemplateRequest
+	32,  // 152: api.EventService.CreateEvent:output_type -> api.CreateEventResponse
+	35,  // 153: api.EventService.GetEvent:output_type -> api.GetEventResponse
+	37,  // 154: api.EventService.GetEvents:output_type -> api.GetEventsResponse
+	39,  // 155: api.EventService.UpdateEvent:output_type -> api.UpdateEventResponse
+	40,  // 156: api.EventService.DeleteEvent:output_type -> api.EventResponse
+	42,  // 157: api.EventService.CreateEventRound:output_type -> api.CreateEventRoundResponse
+	45,  // 158: api.EventService.GetEventRound:output_type -> api.GetEventRoundResponse
+	47,  // 159: api.EventService.UpdateEventRound:output_type -> api.UpdateEventRoundResponse
+	50,  // 160: api.EventService.GetEventUser:output_type -> api.GetEventUserResponse
+	52,  // 161: api.EventService.UpdateEventUser:output_type -> api.UpdateEventUserResponse
+	53,  // 162: api.EventService.DeleteEventUser:output_type -> api.EventUserResponse
+	55,  // 163: api.EventService.AddEventResult:output_type -> api.AddEventResultResponse
+	76,  // 164: api.EventService.RemoveEventResult:output_type -> api.RemoveEventResultResponse
+	78,  // 165: api.EventService.GetEventRoundAttempts:output_type -> api.GetEventRoundAttemptsResponse
+	56,  // 166: api.EventService.PauseEvent:output_type -> api.PauseEventResponse
+	57,  // 167: api.EventService.ResumeEvent:output_type -> api.ResumeEventResponse
+	58,  // 168: api.EventService.CancelEvent:output_type -> api.CancelEventResponse
+	60,  // 169: api.EventService.RegisterForEvent:output_type -> api.RegisterForEventResponse
+	61,  // 170: api.EventService.UnregisterFromEvent:output_type -> api.UnregisterFromEventResponse
+	63,  // 171: api.EventService.DisqualifyEventUser:output_type -> api.DisqualifyEventUserResponse
+	65,  // 172: api.EventService.ReinstateEventUser:output_type -> api.ReinstateEventUserResponse
+	67,  // 173: api.EventService.SetEventThirdPartyDelivery:output_type -> api.SetEventThirdPartyDeliveryResponse
+	69,  // 174: api.EventService.CreateEventTemplate:output_type -> api.CreateEventTemplateResponse
+	71,  // 175: api.EventService.GetEventTemplate:output_type -> api.GetEventTemplateResponse
+	72,  // 176: api.EventService.DeleteEventTemplate:output_type -> api.EventTemplateResponse
+	74,  // 177: api.EventService.CreateEventFromTemplate:output_type -> api.CreateEventFromTemplateResponse
+	152, // [152:178] is the sub-list for method output_type
+	126, // [126:152] is the sub-list for method input_type
+	126, // [126:126] is the sub-list for extension type_name
+	126, // [126:126] is the sub-list for extension extendee
+	0,   // [0:126] is the sub-list for field type_name
 }
 
 func init() { file_event_proto_init() }
@@ -3363,26 +7742,44 @@ func file_event_proto_init() {
 		return
 	}
 	file_types_proto_init()
+	file_event_proto_msgTypes[0].OneofWrappers = []any{}
+	file_event_proto_msgTypes[1].OneofWrappers = []any{}
 	file_event_proto_msgTypes[2].OneofWrappers = []any{}
 	file_event_proto_msgTypes[3].OneofWrappers = []any{}
 	file_event_proto_msgTypes[4].OneofWrappers = []any{}
 	file_event_proto_msgTypes[5].OneofWrappers = []any{}
-	file_event_proto_msgTypes[10].OneofWrappers = []any{}
-	file_event_proto_msgTypes[11].OneofWrappers = []any{}
+	file_event_proto_msgTypes[6].OneofWrappers = []any{}
 	file_event_proto_msgTypes[12].OneofWrappers = []any{}
 	file_event_proto_msgTypes[13].OneofWrappers = []any{}
 	file_event_proto_msgTypes[14].OneofWrappers = []any{}
+	file_event_proto_msgTypes[15].OneofWrappers = []any{}
 	file_event_proto_msgTypes[16].OneofWrappers = []any{}
-	file_event_proto_msgTypes[17].OneofWrappers = []any{}
 	file_event_proto_msgTypes[18].OneofWrappers = []any{}
-	file_event_proto_msgTypes[26].OneofWrappers = []any{}
+	file_event_proto_msgTypes[19].OneofWrappers = []any{}
+	file_event_proto_msgTypes[20].OneofWrappers = []any{}
+	file_event_proto_msgTypes[30].OneofWrappers = []any{}
+	file_event_proto_msgTypes[38].OneofWrappers = []any{}
+	file_event_proto_msgTypes[39].OneofWrappers = []any{}
+	file_event_proto_msgTypes[40].OneofWrappers = []any{}
+	file_event_proto_msgTypes[41].OneofWrappers = []any{}
+	file_event_proto_msgTypes[44].OneofWrappers = []any{}
+	file_event_proto_msgTypes[47].OneofWrappers = []any{}
+	file_event_proto_msgTypes[49].OneofWrappers = []any{}
+	file_event_proto_msgTypes[50].OneofWrappers = []any{}
+	file_event_proto_msgTypes[51].OneofWrappers = []any{}
+	file_event_proto_msgTypes[52].OneofWrappers = []any{}
+	file_event_proto_msgTypes[53].OneofWrappers = []any{}
+	file_event_proto_msgTypes[57].OneofWrappers = []any{}
+	file_event_proto_msgTypes[58].OneofWrappers = []any{}
+	file_event_proto_msgTypes[59].OneofWrappers = []any{}
+	file_event_proto_msgTypes[60].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_proto_rawDesc), len(file_event_proto_rawDesc)),
-			NumEnums:      12,
-			NumMessages:   30,
+			NumEnums:      30,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
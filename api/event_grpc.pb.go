@@ -24,6 +24,7 @@ const _ = grpc.SupportPackageIsVersion7
 type EventServiceClient interface {
 	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateEventResponse, error)
 	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
 	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*UpdateEventResponse, error)
 	DeleteEvent(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*EventResponse, error)
 	CreateEventRound(ctx context.Context, in *CreateEventRoundRequest, opts ...grpc.CallOption) (*CreateEventRoundResponse, error)
@@ -34,6 +35,19 @@ type EventServiceClient interface {
 	DeleteEventUser(ctx context.Context, in *EventUserRequest, opts ...grpc.CallOption) (*EventUserResponse, error)
 	AddEventResult(ctx context.Context, in *AddEventResultRequest, opts ...grpc.CallOption) (*AddEventResultResponse, error)
 	RemoveEventResult(ctx context.Context, in *EventRoundUserRequest, opts ...grpc.CallOption) (*RemoveEventResultResponse, error)
+	GetEventRoundAttempts(ctx context.Context, in *GetEventRoundAttemptsRequest, opts ...grpc.CallOption) (*GetEventRoundAttemptsResponse, error)
+	PauseEvent(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*PauseEventResponse, error)
+	ResumeEvent(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*ResumeEventResponse, error)
+	CancelEvent(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*CancelEventResponse, error)
+	RegisterForEvent(ctx context.Context, in *RegisterForEventRequest, opts ...grpc.CallOption) (*RegisterForEventResponse, error)
+	UnregisterFromEvent(ctx context.Context, in *EventUserRequest, opts ...grpc.CallOption) (*UnregisterFromEventResponse, error)
+	DisqualifyEventUser(ctx context.Context, in *DisqualifyEventUserRequest, opts ...grpc.CallOption) (*DisqualifyEventUserResponse, error)
+	ReinstateEventUser(ctx context.Context, in *ReinstateEventUserRequest, opts ...grpc.CallOption) (*ReinstateEventUserResponse, error)
+	SetEventThirdPartyDelivery(ctx context.Context, in *SetEventThirdPartyDeliveryRequest, opts ...grpc.CallOption) (*SetEventThirdPartyDeliveryResponse, error)
+	CreateEventTemplate(ctx context.Context, in *CreateEventTemplateRequest, opts ...grpc.CallOption) (*CreateEventTemplateResponse, error)
+	GetEventTemplate(ctx context.Context, in *EventTemplateRequest, opts ...grpc.CallOption) (*GetEventTemplateResponse, error)
+	DeleteEventTemplate(ctx context.Context, in *EventTemplateRequest, opts ...grpc.CallOption) (*EventTemplateResponse, error)
+	CreateEventFromTemplate(ctx context.Context, in *CreateEventFromTemplateRequest, opts ...grpc.CallOption) (*CreateEventFromTemplateResponse, error)
 }
 
 type eventServiceClient struct {
@@ -62,6 +76,15 @@ func (c *eventServiceClient) GetEvent(ctx context.Context, in *GetEventRequest,
 	return out, nil
 }
 
+func (c *eventServiceClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*UpdateEventResponse, error) {
 	out := new(UpdateEventResponse)
 	err := c.cc.Invoke(ctx, "/api.EventService/UpdateEvent", in, out, opts...)
@@ -152,12 +175,130 @@ func (c *eventServiceClient) RemoveEventResult(ctx context.Context, in *EventRou
 	return out, nil
 }
 
+func (c *eventServiceClient) GetEventRoundAttempts(ctx context.Context, in *GetEventRoundAttemptsRequest, opts ...grpc.CallOption) (*GetEventRoundAttemptsResponse, error) {
+	out := new(GetEventRoundAttemptsResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/GetEventRoundAttempts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) PauseEvent(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*PauseEventResponse, error) {
+	out := new(PauseEventResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/PauseEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ResumeEvent(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*ResumeEventResponse, error) {
+	out := new(ResumeEventResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/ResumeEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelEvent(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*CancelEventResponse, error) {
+	out := new(CancelEventResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/CancelEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RegisterForEvent(ctx context.Context, in *RegisterForEventRequest, opts ...grpc.CallOption) (*RegisterForEventResponse, error) {
+	out := new(RegisterForEventResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/RegisterForEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UnregisterFromEvent(ctx context.Context, in *EventUserRequest, opts ...grpc.CallOption) (*UnregisterFromEventResponse, error) {
+	out := new(UnregisterFromEventResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/UnregisterFromEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DisqualifyEventUser(ctx context.Context, in *DisqualifyEventUserRequest, opts ...grpc.CallOption) (*DisqualifyEventUserResponse, error) {
+	out := new(DisqualifyEventUserResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/DisqualifyEventUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ReinstateEventUser(ctx context.Context, in *ReinstateEventUserRequest, opts ...grpc.CallOption) (*ReinstateEventUserResponse, error) {
+	out := new(ReinstateEventUserResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/ReinstateEventUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetEventThirdPartyDelivery(ctx context.Context, in *SetEventThirdPartyDeliveryRequest, opts ...grpc.CallOption) (*SetEventThirdPartyDeliveryResponse, error) {
+	out := new(SetEventThirdPartyDeliveryResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/SetEventThirdPartyDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateEventTemplate(ctx context.Context, in *CreateEventTemplateRequest, opts ...grpc.CallOption) (*CreateEventTemplateResponse, error) {
+	out := new(CreateEventTemplateResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/CreateEventTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventTemplate(ctx context.Context, in *EventTemplateRequest, opts ...grpc.CallOption) (*GetEventTemplateResponse, error) {
+	out := new(GetEventTemplateResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/GetEventTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteEventTemplate(ctx context.Context, in *EventTemplateRequest, opts ...grpc.CallOption) (*EventTemplateResponse, error) {
+	out := new(EventTemplateResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/DeleteEventTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateEventFromTemplate(ctx context.Context, in *CreateEventFromTemplateRequest, opts ...grpc.CallOption) (*CreateEventFromTemplateResponse, error) {
+	out := new(CreateEventFromTemplateResponse)
+	err := c.cc.Invoke(ctx, "/api.EventService/CreateEventFromTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventServiceServer is the server API for EventService service.
 // All implementations must embed UnimplementedEventServiceServer
 // for forward compatibility
 type EventServiceServer interface {
 	CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error)
 	GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error)
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
 	UpdateEvent(context.Context, *UpdateEventRequest) (*UpdateEventResponse, error)
 	DeleteEvent(context.Context, *EventRequest) (*EventResponse, error)
 	CreateEventRound(context.Context, *CreateEventRoundRequest) (*CreateEventRoundResponse, error)
@@ -168,6 +309,19 @@ type EventServiceServer interface {
 	DeleteEventUser(context.Context, *EventUserRequest) (*EventUserResponse, error)
 	AddEventResult(context.Context, *AddEventResultRequest) (*AddEventResultResponse, error)
 	RemoveEventResult(context.Context, *EventRoundUserRequest) (*RemoveEventResultResponse, error)
+	GetEventRoundAttempts(context.Context, *GetEventRoundAttemptsRequest) (*GetEventRoundAttemptsResponse, error)
+	PauseEvent(context.Context, *EventRequest) (*PauseEventResponse, error)
+	ResumeEvent(context.Context, *EventRequest) (*ResumeEventResponse, error)
+	CancelEvent(context.Context, *EventRequest) (*CancelEventResponse, error)
+	RegisterForEvent(context.Context, *RegisterForEventRequest) (*RegisterForEventResponse, error)
+	UnregisterFromEvent(context.Context, *EventUserRequest) (*UnregisterFromEventResponse, error)
+	DisqualifyEventUser(context.Context, *DisqualifyEventUserRequest) (*DisqualifyEventUserResponse, error)
+	ReinstateEventUser(context.Context, *ReinstateEventUserRequest) (*ReinstateEventUserResponse, error)
+	SetEventThirdPartyDelivery(context.Context, *SetEventThirdPartyDeliveryRequest) (*SetEventThirdPartyDeliveryResponse, error)
+	CreateEventTemplate(context.Context, *CreateEventTemplateRequest) (*CreateEventTemplateResponse, error)
+	GetEventTemplate(context.Context, *EventTemplateRequest) (*GetEventTemplateResponse, error)
+	DeleteEventTemplate(context.Context, *EventTemplateRequest) (*EventTemplateResponse, error)
+	CreateEventFromTemplate(context.Context, *CreateEventFromTemplateRequest) (*CreateEventFromTemplateResponse, error)
 	mustEmbedUnimplementedEventServiceServer()
 }
 
@@ -181,6 +335,9 @@ func (UnimplementedEventServiceServer) CreateEvent(context.Context, *CreateEvent
 func (UnimplementedEventServiceServer) GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
 }
+func (UnimplementedEventServiceServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
 func (UnimplementedEventServiceServer) UpdateEvent(context.Context, *UpdateEventRequest) (*UpdateEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvent not implemented")
 }
@@ -211,6 +368,45 @@ func (UnimplementedEventServiceServer) AddEventResult(context.Context, *AddEvent
 func (UnimplementedEventServiceServer) RemoveEventResult(context.Context, *EventRoundUserRequest) (*RemoveEventResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveEventResult not implemented")
 }
+func (UnimplementedEventServiceServer) GetEventRoundAttempts(context.Context, *GetEventRoundAttemptsRequest) (*GetEventRoundAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventRoundAttempts not implemented")
+}
+func (UnimplementedEventServiceServer) PauseEvent(context.Context, *EventRequest) (*PauseEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseEvent not implemented")
+}
+func (UnimplementedEventServiceServer) ResumeEvent(context.Context, *EventRequest) (*ResumeEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeEvent not implemented")
+}
+func (UnimplementedEventServiceServer) CancelEvent(context.Context, *EventRequest) (*CancelEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEvent not implemented")
+}
+func (UnimplementedEventServiceServer) RegisterForEvent(context.Context, *RegisterForEventRequest) (*RegisterForEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterForEvent not implemented")
+}
+func (UnimplementedEventServiceServer) UnregisterFromEvent(context.Context, *EventUserRequest) (*UnregisterFromEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterFromEvent not implemented")
+}
+func (UnimplementedEventServiceServer) DisqualifyEventUser(context.Context, *DisqualifyEventUserRequest) (*DisqualifyEventUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisqualifyEventUser not implemented")
+}
+func (UnimplementedEventServiceServer) ReinstateEventUser(context.Context, *ReinstateEventUserRequest) (*ReinstateEventUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReinstateEventUser not implemented")
+}
+func (UnimplementedEventServiceServer) SetEventThirdPartyDelivery(context.Context, *SetEventThirdPartyDeliveryRequest) (*SetEventThirdPartyDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventThirdPartyDelivery not implemented")
+}
+func (UnimplementedEventServiceServer) CreateEventTemplate(context.Context, *CreateEventTemplateRequest) (*CreateEventTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEventTemplate not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventTemplate(context.Context, *EventTemplateRequest) (*GetEventTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventTemplate not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteEventTemplate(context.Context, *EventTemplateRequest) (*EventTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEventTemplate not implemented")
+}
+func (UnimplementedEventServiceServer) CreateEventFromTemplate(context.Context, *CreateEventFromTemplateRequest) (*CreateEventFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEventFromTemplate not implemented")
+}
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 
 // UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -260,6 +456,24 @@ func _EventService_GetEvent_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/GetEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateEventRequest)
 	if err := dec(in); err != nil {
@@ -440,6 +654,240 @@ func _EventService_RemoveEventResult_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_GetEventRoundAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRoundAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventRoundAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/GetEventRoundAttempts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventRoundAttempts(ctx, req.(*GetEventRoundAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_PauseEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).PauseEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/PauseEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).PauseEvent(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ResumeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ResumeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/ResumeEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ResumeEvent(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/CancelEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelEvent(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RegisterForEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterForEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RegisterForEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/RegisterForEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RegisterForEvent(ctx, req.(*RegisterForEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UnregisterFromEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UnregisterFromEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/UnregisterFromEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UnregisterFromEvent(ctx, req.(*EventUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DisqualifyEventUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisqualifyEventUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DisqualifyEventUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/DisqualifyEventUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DisqualifyEventUser(ctx, req.(*DisqualifyEventUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ReinstateEventUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinstateEventUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ReinstateEventUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/ReinstateEventUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ReinstateEventUser(ctx, req.(*ReinstateEventUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetEventThirdPartyDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEventThirdPartyDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetEventThirdPartyDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/SetEventThirdPartyDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetEventThirdPartyDelivery(ctx, req.(*SetEventThirdPartyDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateEventTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateEventTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/CreateEventTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateEventTemplate(ctx, req.(*CreateEventTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/GetEventTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventTemplate(ctx, req.(*EventTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteEventTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteEventTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/DeleteEventTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteEventTemplate(ctx, req.(*EventTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateEventFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateEventFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventService/CreateEventFromTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateEventFromTemplate(ctx, req.(*CreateEventFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -455,6 +903,10 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEvent",
 			Handler:    _EventService_GetEvent_Handler,
 		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _EventService_GetEvents_Handler,
+		},
 		{
 			MethodName: "UpdateEvent",
 			Handler:    _EventService_UpdateEvent_Handler,
@@ -495,6 +947,58 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveEventResult",
 			Handler:    _EventService_RemoveEventResult_Handler,
 		},
+		{
+			MethodName: "GetEventRoundAttempts",
+			Handler:    _EventService_GetEventRoundAttempts_Handler,
+		},
+		{
+			MethodName: "PauseEvent",
+			Handler:    _EventService_PauseEvent_Handler,
+		},
+		{
+			MethodName: "ResumeEvent",
+			Handler:    _EventService_ResumeEvent_Handler,
+		},
+		{
+			MethodName: "CancelEvent",
+			Handler:    _EventService_CancelEvent_Handler,
+		},
+		{
+			MethodName: "RegisterForEvent",
+			Handler:    _EventService_RegisterForEvent_Handler,
+		},
+		{
+			MethodName: "UnregisterFromEvent",
+			Handler:    _EventService_UnregisterFromEvent_Handler,
+		},
+		{
+			MethodName: "DisqualifyEventUser",
+			Handler:    _EventService_DisqualifyEventUser_Handler,
+		},
+		{
+			MethodName: "ReinstateEventUser",
+			Handler:    _EventService_ReinstateEventUser_Handler,
+		},
+		{
+			MethodName: "SetEventThirdPartyDelivery",
+			Handler:    _EventService_SetEventThirdPartyDelivery_Handler,
+		},
+		{
+			MethodName: "CreateEventTemplate",
+			Handler:    _EventService_CreateEventTemplate_Handler,
+		},
+		{
+			MethodName: "GetEventTemplate",
+			Handler:    _EventService_GetEventTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteEventTemplate",
+			Handler:    _EventService_DeleteEventTemplate_Handler,
+		},
+		{
+			MethodName: "CreateEventFromTemplate",
+			Handler:    _EventService_CreateEventFromTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event.proto",
@@ -36,6 +36,7 @@ type MatchmakingServiceClient interface {
 	GetMatchmakingTickets(ctx context.Context, in *GetMatchmakingTicketsRequest, opts ...grpc.CallOption) (*GetMatchmakingTicketsResponse, error)
 	UpdateMatchmakingTicket(ctx context.Context, in *UpdateMatchmakingTicketRequest, opts ...grpc.CallOption) (*UpdateMatchmakingTicketResponse, error)
 	DeleteMatchmakingTicket(ctx context.Context, in *MatchmakingTicketRequest, opts ...grpc.CallOption) (*DeleteMatchmakingTicketResponse, error)
+	CreateMatch(ctx context.Context, in *CreateMatchRequest, opts ...grpc.CallOption) (*CreateMatchResponse, error)
 	StartMatch(ctx context.Context, in *StartMatchRequest, opts ...grpc.CallOption) (*StartMatchResponse, error)
 	EndMatch(ctx context.Context, in *EndMatchRequest, opts ...grpc.CallOption) (*EndMatchResponse, error)
 	GetMatch(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*GetMatchResponse, error)
@@ -179,6 +180,15 @@ func (c *matchmakingServiceClient) DeleteMatchmakingTicket(ctx context.Context,
 	return out, nil
 }
 
+func (c *matchmakingServiceClient) CreateMatch(ctx context.Context, in *CreateMatchRequest, opts ...grpc.CallOption) (*CreateMatchResponse, error) {
+	out := new(CreateMatchResponse)
+	err := c.cc.Invoke(ctx, "/api.MatchmakingService/CreateMatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchmakingServiceClient) StartMatch(ctx context.Context, in *StartMatchRequest, opts ...grpc.CallOption) (*StartMatchResponse, error) {
 	out := new(StartMatchResponse)
 	err := c.cc.Invoke(ctx, "/api.MatchmakingService/StartMatch", in, out, opts...)
@@ -260,6 +270,7 @@ type MatchmakingServiceServer interface {
 	GetMatchmakingTickets(context.Context, *GetMatchmakingTicketsRequest) (*GetMatchmakingTicketsResponse, error)
 	UpdateMatchmakingTicket(context.Context, *UpdateMatchmakingTicketRequest) (*UpdateMatchmakingTicketResponse, error)
 	DeleteMatchmakingTicket(context.Context, *MatchmakingTicketRequest) (*DeleteMatchmakingTicketResponse, error)
+	CreateMatch(context.Context, *CreateMatchRequest) (*CreateMatchResponse, error)
 	StartMatch(context.Context, *StartMatchRequest) (*StartMatchResponse, error)
 	EndMatch(context.Context, *EndMatchRequest) (*EndMatchResponse, error)
 	GetMatch(context.Context, *GetMatchRequest) (*GetMatchResponse, error)
@@ -316,6 +327,9 @@ func (UnimplementedMatchmakingServiceServer) UpdateMatchmakingTicket(context.Con
 func (UnimplementedMatchmakingServiceServer) DeleteMatchmakingTicket(context.Context, *MatchmakingTicketRequest) (*DeleteMatchmakingTicketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMatchmakingTicket not implemented")
 }
+func (UnimplementedMatchmakingServiceServer) CreateMatch(context.Context, *CreateMatchRequest) (*CreateMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMatch not implemented")
+}
 func (UnimplementedMatchmakingServiceServer) StartMatch(context.Context, *StartMatchRequest) (*StartMatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartMatch not implemented")
 }
@@ -602,6 +616,24 @@ func _MatchmakingService_DeleteMatchmakingTicket_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchmakingService_CreateMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchmakingServiceServer).CreateMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MatchmakingService/CreateMatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchmakingServiceServer).CreateMatch(ctx, req.(*CreateMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchmakingService_StartMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartMatchRequest)
 	if err := dec(in); err != nil {
@@ -791,6 +823,10 @@ var MatchmakingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMatchmakingTicket",
 			Handler:    _MatchmakingService_DeleteMatchmakingTicket_Handler,
 		},
+		{
+			MethodName: "CreateMatch",
+			Handler:    _MatchmakingService_CreateMatch_Handler,
+		},
 		{
 			MethodName: "StartMatch",
 			Handler:    _MatchmakingService_StartMatch_Handler,
@@ -30,8 +30,15 @@ type TeamServiceClient interface {
 	UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*UpdateTeamResponse, error)
 	UpdateTeamMember(ctx context.Context, in *UpdateTeamMemberRequest, opts ...grpc.CallOption) (*UpdateTeamMemberResponse, error)
 	DeleteTeam(ctx context.Context, in *TeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
+	RestoreTeam(ctx context.Context, in *TeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
 	JoinTeam(ctx context.Context, in *JoinTeamRequest, opts ...grpc.CallOption) (*JoinTeamResponse, error)
 	LeaveTeam(ctx context.Context, in *TeamMemberRequest, opts ...grpc.CallOption) (*LeaveTeamResponse, error)
+	PostTeamMessage(ctx context.Context, in *PostTeamMessageRequest, opts ...grpc.CallOption) (*PostTeamMessageResponse, error)
+	GetTeamMessages(ctx context.Context, in *GetTeamMessagesRequest, opts ...grpc.CallOption) (*GetTeamMessagesResponse, error)
+	UpdateTeamMessage(ctx context.Context, in *UpdateTeamMessageRequest, opts ...grpc.CallOption) (*UpdateTeamMessageResponse, error)
+	DeleteTeamMessage(ctx context.Context, in *DeleteTeamMessageRequest, opts ...grpc.CallOption) (*TeamMessageResponse, error)
+	ResetTeamScores(ctx context.Context, in *ResetTeamScoresRequest, opts ...grpc.CallOption) (*ResetTeamScoresResponse, error)
+	GetTeamSeasonHistory(ctx context.Context, in *GetTeamSeasonHistoryRequest, opts ...grpc.CallOption) (*GetTeamSeasonHistoryResponse, error)
 }
 
 type teamServiceClient struct {
@@ -114,6 +121,15 @@ func (c *teamServiceClient) DeleteTeam(ctx context.Context, in *TeamRequest, opt
 	return out, nil
 }
 
+func (c *teamServiceClient) RestoreTeam(ctx context.Context, in *TeamRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
+	out := new(TeamResponse)
+	err := c.cc.Invoke(ctx, "/api.TeamService/RestoreTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *teamServiceClient) JoinTeam(ctx context.Context, in *JoinTeamRequest, opts ...grpc.CallOption) (*JoinTeamResponse, error) {
 	out := new(JoinTeamResponse)
 	err := c.cc.Invoke(ctx, "/api.TeamService/JoinTeam", in, out, opts...)
@@ -132,6 +148,60 @@ func (c *teamServiceClient) LeaveTeam(ctx context.Context, in *TeamMemberRequest
 	return out, nil
 }
 
+func (c *teamServiceClient) PostTeamMessage(ctx context.Context, in *PostTeamMessageRequest, opts ...grpc.CallOption) (*PostTeamMessageResponse, error) {
+	out := new(PostTeamMessageResponse)
+	err := c.cc.Invoke(ctx, "/api.TeamService/PostTeamMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) GetTeamMessages(ctx context.Context, in *GetTeamMessagesRequest, opts ...grpc.CallOption) (*GetTeamMessagesResponse, error) {
+	out := new(GetTeamMessagesResponse)
+	err := c.cc.Invoke(ctx, "/api.TeamService/GetTeamMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) UpdateTeamMessage(ctx context.Context, in *UpdateTeamMessageRequest, opts ...grpc.CallOption) (*UpdateTeamMessageResponse, error) {
+	out := new(UpdateTeamMessageResponse)
+	err := c.cc.Invoke(ctx, "/api.TeamService/UpdateTeamMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) DeleteTeamMessage(ctx context.Context, in *DeleteTeamMessageRequest, opts ...grpc.CallOption) (*TeamMessageResponse, error) {
+	out := new(TeamMessageResponse)
+	err := c.cc.Invoke(ctx, "/api.TeamService/DeleteTeamMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) ResetTeamScores(ctx context.Context, in *ResetTeamScoresRequest, opts ...grpc.CallOption) (*ResetTeamScoresResponse, error) {
+	out := new(ResetTeamScoresResponse)
+	err := c.cc.Invoke(ctx, "/api.TeamService/ResetTeamScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) GetTeamSeasonHistory(ctx context.Context, in *GetTeamSeasonHistoryRequest, opts ...grpc.CallOption) (*GetTeamSeasonHistoryResponse, error) {
+	out := new(GetTeamSeasonHistoryResponse)
+	err := c.cc.Invoke(ctx, "/api.TeamService/GetTeamSeasonHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TeamServiceServer is the server API for TeamService service.
 // All implementations must embed UnimplementedTeamServiceServer
 // for forward compatibility
@@ -144,8 +214,15 @@ type TeamServiceServer interface {
 	UpdateTeam(context.Context, *UpdateTeamRequest) (*UpdateTeamResponse, error)
 	UpdateTeamMember(context.Context, *UpdateTeamMemberRequest) (*UpdateTeamMemberResponse, error)
 	DeleteTeam(context.Context, *TeamRequest) (*TeamResponse, error)
+	RestoreTeam(context.Context, *TeamRequest) (*TeamResponse, error)
 	JoinTeam(context.Context, *JoinTeamRequest) (*JoinTeamResponse, error)
 	LeaveTeam(context.Context, *TeamMemberRequest) (*LeaveTeamResponse, error)
+	PostTeamMessage(context.Context, *PostTeamMessageRequest) (*PostTeamMessageResponse, error)
+	GetTeamMessages(context.Context, *GetTeamMessagesRequest) (*GetTeamMessagesResponse, error)
+	UpdateTeamMessage(context.Context, *UpdateTeamMessageRequest) (*UpdateTeamMessageResponse, error)
+	DeleteTeamMessage(context.Context, *DeleteTeamMessageRequest) (*TeamMessageResponse, error)
+	ResetTeamScores(context.Context, *ResetTeamScoresRequest) (*ResetTeamScoresResponse, error)
+	GetTeamSeasonHistory(context.Context, *GetTeamSeasonHistoryRequest) (*GetTeamSeasonHistoryResponse, error)
 	mustEmbedUnimplementedTeamServiceServer()
 }
 
@@ -177,12 +254,33 @@ func (UnimplementedTeamServiceServer) UpdateTeamMember(context.Context, *UpdateT
 func (UnimplementedTeamServiceServer) DeleteTeam(context.Context, *TeamRequest) (*TeamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
 }
+func (UnimplementedTeamServiceServer) RestoreTeam(context.Context, *TeamRequest) (*TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTeam not implemented")
+}
 func (UnimplementedTeamServiceServer) JoinTeam(context.Context, *JoinTeamRequest) (*JoinTeamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinTeam not implemented")
 }
 func (UnimplementedTeamServiceServer) LeaveTeam(context.Context, *TeamMemberRequest) (*LeaveTeamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveTeam not implemented")
 }
+func (UnimplementedTeamServiceServer) PostTeamMessage(context.Context, *PostTeamMessageRequest) (*PostTeamMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostTeamMessage not implemented")
+}
+func (UnimplementedTeamServiceServer) GetTeamMessages(context.Context, *GetTeamMessagesRequest) (*GetTeamMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamMessages not implemented")
+}
+func (UnimplementedTeamServiceServer) UpdateTeamMessage(context.Context, *UpdateTeamMessageRequest) (*UpdateTeamMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamMessage not implemented")
+}
+func (UnimplementedTeamServiceServer) DeleteTeamMessage(context.Context, *DeleteTeamMessageRequest) (*TeamMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeamMessage not implemented")
+}
+func (UnimplementedTeamServiceServer) ResetTeamScores(context.Context, *ResetTeamScoresRequest) (*ResetTeamScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetTeamScores not implemented")
+}
+func (UnimplementedTeamServiceServer) GetTeamSeasonHistory(context.Context, *GetTeamSeasonHistoryRequest) (*GetTeamSeasonHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamSeasonHistory not implemented")
+}
 func (UnimplementedTeamServiceServer) mustEmbedUnimplementedTeamServiceServer() {}
 
 // UnsafeTeamServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -340,6 +438,24 @@ func _TeamService_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TeamService_RestoreTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).RestoreTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TeamService/RestoreTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).RestoreTeam(ctx, req.(*TeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TeamService_JoinTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JoinTeamRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +492,114 @@ func _TeamService_LeaveTeam_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TeamService_PostTeamMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostTeamMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).PostTeamMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TeamService/PostTeamMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).PostTeamMessage(ctx, req.(*PostTeamMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_GetTeamMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).GetTeamMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TeamService/GetTeamMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).GetTeamMessages(ctx, req.(*GetTeamMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_UpdateTeamMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).UpdateTeamMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TeamService/UpdateTeamMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).UpdateTeamMessage(ctx, req.(*UpdateTeamMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_DeleteTeamMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).DeleteTeamMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TeamService/DeleteTeamMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).DeleteTeamMessage(ctx, req.(*DeleteTeamMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_ResetTeamScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetTeamScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).ResetTeamScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TeamService/ResetTeamScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).ResetTeamScores(ctx, req.(*ResetTeamScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_GetTeamSeasonHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamSeasonHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).GetTeamSeasonHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TeamService/GetTeamSeasonHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).GetTeamSeasonHistory(ctx, req.(*GetTeamSeasonHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TeamService_ServiceDesc is the grpc.ServiceDesc for TeamService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -415,6 +639,10 @@ var TeamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTeam",
 			Handler:    _TeamService_DeleteTeam_Handler,
 		},
+		{
+			MethodName: "RestoreTeam",
+			Handler:    _TeamService_RestoreTeam_Handler,
+		},
 		{
 			MethodName: "JoinTeam",
 			Handler:    _TeamService_JoinTeam_Handler,
@@ -423,6 +651,30 @@ var TeamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveTeam",
 			Handler:    _TeamService_LeaveTeam_Handler,
 		},
+		{
+			MethodName: "PostTeamMessage",
+			Handler:    _TeamService_PostTeamMessage_Handler,
+		},
+		{
+			MethodName: "GetTeamMessages",
+			Handler:    _TeamService_GetTeamMessages_Handler,
+		},
+		{
+			MethodName: "UpdateTeamMessage",
+			Handler:    _TeamService_UpdateTeamMessage_Handler,
+		},
+		{
+			MethodName: "DeleteTeamMessage",
+			Handler:    _TeamService_DeleteTeamMessage_Handler,
+		},
+		{
+			MethodName: "ResetTeamScores",
+			Handler:    _TeamService_ResetTeamScores_Handler,
+		},
+		{
+			MethodName: "GetTeamSeasonHistory",
+			Handler:    _TeamService_GetTeamSeasonHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "team.proto",
@@ -30,6 +30,7 @@ const (
 	TournamentInterval_WEEKLY    TournamentInterval = 1
 	TournamentInterval_MONTHLY   TournamentInterval = 2
 	TournamentInterval_UNLIMITED TournamentInterval = 3
+	TournamentInterval_CUSTOM    TournamentInterval = 4
 )
 
 // Enum value maps for TournamentInterval.
@@ -39,12 +40,14 @@ var (
 		1: "WEEKLY",
 		2: "MONTHLY",
 		3: "UNLIMITED",
+		4: "CUSTOM",
 	}
 	TournamentInterval_value = map[string]int32{
 		"DAILY":     0,
 		"WEEKLY":    1,
 		"MONTHLY":   2,
 		"UNLIMITED": 3,
+		"CUSTOM":    4,
 	}
 )
 
@@ -75,6 +78,297 @@ func (TournamentInterval) EnumDescriptor() ([]byte, []int) {
 	return file_tournament_proto_rawDescGZIP(), []int{0}
 }
 
+type TournamentScoreMode int32
+
+const (
+	TournamentScoreMode_SET       TournamentScoreMode = 0
+	TournamentScoreMode_INCREMENT TournamentScoreMode = 1
+	TournamentScoreMode_MAX       TournamentScoreMode = 2
+	TournamentScoreMode_MIN       TournamentScoreMode = 3
+)
+
+// Enum value maps for TournamentScoreMode.
+var (
+	TournamentScoreMode_name = map[int32]string{
+		0: "SET",
+		1: "INCREMENT",
+		2: "MAX",
+		3: "MIN",
+	}
+	TournamentScoreMode_value = map[string]int32{
+		"SET":       0,
+		"INCREMENT": 1,
+		"MAX":       2,
+		"MIN":       3,
+	}
+)
+
+func (x TournamentScoreMode) Enum() *TournamentScoreMode {
+	p := new(TournamentScoreMode)
+	*p = x
+	return p
+}
+
+func (x TournamentScoreMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentScoreMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[1].Descriptor()
+}
+
+func (TournamentScoreMode) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[1]
+}
+
+func (x TournamentScoreMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentScoreMode.Descriptor instead.
+func (TournamentScoreMode) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{1}
+}
+
+type TournamentBracketFormat int32
+
+const (
+	TournamentBracketFormat_SINGLE_ELIMINATION TournamentBracketFormat = 0
+	TournamentBracketFormat_DOUBLE_ELIMINATION TournamentBracketFormat = 1
+)
+
+// Enum value maps for TournamentBracketFormat.
+var (
+	TournamentBracketFormat_name = map[int32]string{
+		0: "SINGLE_ELIMINATION",
+		1: "DOUBLE_ELIMINATION",
+	}
+	TournamentBracketFormat_value = map[string]int32{
+		"SINGLE_ELIMINATION": 0,
+		"DOUBLE_ELIMINATION": 1,
+	}
+)
+
+func (x TournamentBracketFormat) Enum() *TournamentBracketFormat {
+	p := new(TournamentBracketFormat)
+	*p = x
+	return p
+}
+
+func (x TournamentBracketFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentBracketFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[2].Descriptor()
+}
+
+func (TournamentBracketFormat) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[2]
+}
+
+func (x TournamentBracketFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentBracketFormat.Descriptor instead.
+func (TournamentBracketFormat) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{2}
+}
+
+type TournamentBracketSeeding int32
+
+const (
+	TournamentBracketSeeding_GIVEN_ORDER      TournamentBracketSeeding = 0
+	TournamentBracketSeeding_TOURNAMENT_SCORE TournamentBracketSeeding = 1
+	TournamentBracketSeeding_MATCHMAKING_ELO  TournamentBracketSeeding = 2
+)
+
+// Enum value maps for TournamentBracketSeeding.
+var (
+	TournamentBracketSeeding_name = map[int32]string{
+		0: "GIVEN_ORDER",
+		1: "TOURNAMENT_SCORE",
+		2: "MATCHMAKING_ELO",
+	}
+	TournamentBracketSeeding_value = map[string]int32{
+		"GIVEN_ORDER":      0,
+		"TOURNAMENT_SCORE": 1,
+		"MATCHMAKING_ELO":  2,
+	}
+)
+
+func (x TournamentBracketSeeding) Enum() *TournamentBracketSeeding {
+	p := new(TournamentBracketSeeding)
+	*p = x
+	return p
+}
+
+func (x TournamentBracketSeeding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentBracketSeeding) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[3].Descriptor()
+}
+
+func (TournamentBracketSeeding) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[3]
+}
+
+func (x TournamentBracketSeeding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentBracketSeeding.Descriptor instead.
+func (TournamentBracketSeeding) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{3}
+}
+
+type TournamentBracketSide int32
+
+const (
+	TournamentBracketSide_WINNERS     TournamentBracketSide = 0
+	TournamentBracketSide_LOSERS      TournamentBracketSide = 1
+	TournamentBracketSide_GRAND_FINAL TournamentBracketSide = 2
+)
+
+// Enum value maps for TournamentBracketSide.
+var (
+	TournamentBracketSide_name = map[int32]string{
+		0: "WINNERS",
+		1: "LOSERS",
+		2: "GRAND_FINAL",
+	}
+	TournamentBracketSide_value = map[string]int32{
+		"WINNERS":     0,
+		"LOSERS":      1,
+		"GRAND_FINAL": 2,
+	}
+)
+
+func (x TournamentBracketSide) Enum() *TournamentBracketSide {
+	p := new(TournamentBracketSide)
+	*p = x
+	return p
+}
+
+func (x TournamentBracketSide) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentBracketSide) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[4].Descriptor()
+}
+
+func (TournamentBracketSide) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[4]
+}
+
+func (x TournamentBracketSide) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentBracketSide.Descriptor instead.
+func (TournamentBracketSide) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{4}
+}
+
+type TournamentPairingFormat int32
+
+const (
+	TournamentPairingFormat_SWISS       TournamentPairingFormat = 0
+	TournamentPairingFormat_ROUND_ROBIN TournamentPairingFormat = 1
+)
+
+// Enum value maps for TournamentPairingFormat.
+var (
+	TournamentPairingFormat_name = map[int32]string{
+		0: "SWISS",
+		1: "ROUND_ROBIN",
+	}
+	TournamentPairingFormat_value = map[string]int32{
+		"SWISS":       0,
+		"ROUND_ROBIN": 1,
+	}
+)
+
+func (x TournamentPairingFormat) Enum() *TournamentPairingFormat {
+	p := new(TournamentPairingFormat)
+	*p = x
+	return p
+}
+
+func (x TournamentPairingFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentPairingFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[5].Descriptor()
+}
+
+func (TournamentPairingFormat) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[5]
+}
+
+func (x TournamentPairingFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentPairingFormat.Descriptor instead.
+func (TournamentPairingFormat) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{5}
+}
+
+type TournamentPairingResult int32
+
+const (
+	TournamentPairingResult_FIRST_WON  TournamentPairingResult = 0
+	TournamentPairingResult_SECOND_WON TournamentPairingResult = 1
+	TournamentPairingResult_DRAW       TournamentPairingResult = 2
+)
+
+// Enum value maps for TournamentPairingResult.
+var (
+	TournamentPairingResult_name = map[int32]string{
+		0: "FIRST_WON",
+		1: "SECOND_WON",
+		2: "DRAW",
+	}
+	TournamentPairingResult_value = map[string]int32{
+		"FIRST_WON":  0,
+		"SECOND_WON": 1,
+		"DRAW":       2,
+	}
+)
+
+func (x TournamentPairingResult) Enum() *TournamentPairingResult {
+	p := new(TournamentPairingResult)
+	*p = x
+	return p
+}
+
+func (x TournamentPairingResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentPairingResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[6].Descriptor()
+}
+
+func (TournamentPairingResult) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[6]
+}
+
+func (x TournamentPairingResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentPairingResult.Descriptor instead.
+func (TournamentPairingResult) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{6}
+}
+
 type CreateTournamentUserResponse_Error int32
 
 const (
@@ -84,6 +378,9 @@ const (
 	CreateTournamentUserResponse_USER_ID_REQUIRED          CreateTournamentUserResponse_Error = 3
 	CreateTournamentUserResponse_DATA_REQUIRED             CreateTournamentUserResponse_Error = 4
 	CreateTournamentUserResponse_ALREADY_EXISTS            CreateTournamentUserResponse_Error = 5
+	CreateTournamentUserResponse_DEFINITION_NOT_FOUND      CreateTournamentUserResponse_Error = 6
+	CreateTournamentUserResponse_TOURNAMENT_NOT_ACTIVE     CreateTournamentUserResponse_Error = 7
+	CreateTournamentUserResponse_NOT_A_TEAM_MEMBER         CreateTournamentUserResponse_Error = 8
 )
 
 // Enum value maps for CreateTournamentUserResponse_Error.
@@ -95,6 +392,9 @@ var (
 		3: "USER_ID_REQUIRED",
 		4: "DATA_REQUIRED",
 		5: "ALREADY_EXISTS",
+		6: "DEFINITION_NOT_FOUND",
+		7: "TOURNAMENT_NOT_ACTIVE",
+		8: "NOT_A_TEAM_MEMBER",
 	}
 	CreateTournamentUserResponse_Error_value = map[string]int32{
 		"NONE":                      0,
@@ -103,6 +403,9 @@ var (
 		"USER_ID_REQUIRED":          3,
 		"DATA_REQUIRED":             4,
 		"ALREADY_EXISTS":            5,
+		"DEFINITION_NOT_FOUND":      6,
+		"TOURNAMENT_NOT_ACTIVE":     7,
+		"NOT_A_TEAM_MEMBER":         8,
 	}
 )
 
@@ -117,11 +420,11 @@ func (x CreateTournamentUserResponse_Error) String() string {
 }
 
 func (CreateTournamentUserResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_tournament_proto_enumTypes[1].Descriptor()
+	return file_tournament_proto_enumTypes[7].Descriptor()
 }
 
 func (CreateTournamentUserResponse_Error) Type() protoreflect.EnumType {
-	return &file_tournament_proto_enumTypes[1]
+	return &file_tournament_proto_enumTypes[7]
 }
 
 func (x CreateTournamentUserResponse_Error) Number() protoreflect.EnumNumber {
@@ -175,11 +478,11 @@ func (x GetTournamentUserResponse_Error) String() string {
 }
 
 func (GetTournamentUserResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_tournament_proto_enumTypes[2].Descriptor()
+	return file_tournament_proto_enumTypes[8].Descriptor()
 }
 
 func (GetTournamentUserResponse_Error) Type() protoreflect.EnumType {
-	return &file_tournament_proto_enumTypes[2]
+	return &file_tournament_proto_enumTypes[8]
 }
 
 func (x GetTournamentUserResponse_Error) Number() protoreflect.EnumNumber {
@@ -233,11 +536,11 @@ func (x TournamentUserResponse_Error) String() string {
 }
 
 func (TournamentUserResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_tournament_proto_enumTypes[3].Descriptor()
+	return file_tournament_proto_enumTypes[9].Descriptor()
 }
 
 func (TournamentUserResponse_Error) Type() protoreflect.EnumType {
-	return &file_tournament_proto_enumTypes[3]
+	return &file_tournament_proto_enumTypes[9]
 }
 
 func (x TournamentUserResponse_Error) Number() protoreflect.EnumNumber {
@@ -255,6 +558,8 @@ const (
 	GetTournamentUsersResponse_NONE                      GetTournamentUsersResponse_Error = 0
 	GetTournamentUsersResponse_TOURNAMENT_NAME_TOO_SHORT GetTournamentUsersResponse_Error = 1
 	GetTournamentUsersResponse_TOURNAMENT_NAME_TOO_LONG  GetTournamentUsersResponse_Error = 2
+	GetTournamentUsersResponse_INVALID_CURSOR            GetTournamentUsersResponse_Error = 3
+	GetTournamentUsersResponse_TOURNAMENT_NAME_REQUIRED  GetTournamentUsersResponse_Error = 4
 )
 
 // Enum value maps for GetTournamentUsersResponse_Error.
@@ -263,11 +568,15 @@ var (
 		0: "NONE",
 		1: "TOURNAMENT_NAME_TOO_SHORT",
 		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "INVALID_CURSOR",
+		4: "TOURNAMENT_NAME_REQUIRED",
 	}
 	GetTournamentUsersResponse_Error_value = map[string]int32{
 		"NONE":                      0,
 		"TOURNAMENT_NAME_TOO_SHORT": 1,
 		"TOURNAMENT_NAME_TOO_LONG":  2,
+		"INVALID_CURSOR":            3,
+		"TOURNAMENT_NAME_REQUIRED":  4,
 	}
 )
 
@@ -282,11 +591,11 @@ func (x GetTournamentUsersResponse_Error) String() string {
 }
 
 func (GetTournamentUsersResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_tournament_proto_enumTypes[4].Descriptor()
+	return file_tournament_proto_enumTypes[10].Descriptor()
 }
 
 func (GetTournamentUsersResponse_Error) Type() protoreflect.EnumType {
-	return &file_tournament_proto_enumTypes[4]
+	return &file_tournament_proto_enumTypes[10]
 }
 
 func (x GetTournamentUsersResponse_Error) Number() protoreflect.EnumNumber {
@@ -298,6 +607,58 @@ func (GetTournamentUsersResponse_Error) EnumDescriptor() ([]byte, []int) {
 	return file_tournament_proto_rawDescGZIP(), []int{7, 0}
 }
 
+type GetTournamentUserHistoryResponse_Error int32
+
+const (
+	GetTournamentUserHistoryResponse_NONE                      GetTournamentUserHistoryResponse_Error = 0
+	GetTournamentUserHistoryResponse_USER_ID_REQUIRED          GetTournamentUserHistoryResponse_Error = 1
+	GetTournamentUserHistoryResponse_TOURNAMENT_NAME_TOO_SHORT GetTournamentUserHistoryResponse_Error = 2
+	GetTournamentUserHistoryResponse_TOURNAMENT_NAME_TOO_LONG  GetTournamentUserHistoryResponse_Error = 3
+)
+
+// Enum value maps for GetTournamentUserHistoryResponse_Error.
+var (
+	GetTournamentUserHistoryResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "USER_ID_REQUIRED",
+		2: "TOURNAMENT_NAME_TOO_SHORT",
+		3: "TOURNAMENT_NAME_TOO_LONG",
+	}
+	GetTournamentUserHistoryResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"USER_ID_REQUIRED":          1,
+		"TOURNAMENT_NAME_TOO_SHORT": 2,
+		"TOURNAMENT_NAME_TOO_LONG":  3,
+	}
+)
+
+func (x GetTournamentUserHistoryResponse_Error) Enum() *GetTournamentUserHistoryResponse_Error {
+	p := new(GetTournamentUserHistoryResponse_Error)
+	*p = x
+	return p
+}
+
+func (x GetTournamentUserHistoryResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTournamentUserHistoryResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[11].Descriptor()
+}
+
+func (GetTournamentUserHistoryResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[11]
+}
+
+func (x GetTournamentUserHistoryResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTournamentUserHistoryResponse_Error.Descriptor instead.
+func (GetTournamentUserHistoryResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{9, 0}
+}
+
 type UpdateTournamentUserResponse_Error int32
 
 const (
@@ -346,11 +707,11 @@ func (x UpdateTournamentUserResponse_Error) String() string {
 }
 
 func (UpdateTournamentUserResponse_Error) Descriptor() protoreflect.EnumDescriptor {
-	return file_tournament_proto_enumTypes[5].Descriptor()
+	return file_tournament_proto_enumTypes[12].Descriptor()
 }
 
 func (UpdateTournamentUserResponse_Error) Type() protoreflect.EnumType {
-	return &file_tournament_proto_enumTypes[5]
+	return &file_tournament_proto_enumTypes[12]
 }
 
 func (x UpdateTournamentUserResponse_Error) Number() protoreflect.EnumNumber {
@@ -359,169 +720,5665 @@ func (x UpdateTournamentUserResponse_Error) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UpdateTournamentUserResponse_Error.Descriptor instead.
 func (UpdateTournamentUserResponse_Error) EnumDescriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{9, 0}
+	return file_tournament_proto_rawDescGZIP(), []int{11, 0}
 }
 
-type CreateTournamentUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tournament    string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
-	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
-	UserId        uint64                 `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
-	Score         *int64                 `protobuf:"varint,4,opt,name=score,proto3,oneof" json:"score,omitempty"`
-	Data          *structpb.Struct       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateTournamentTeamResponse_Error int32
+
+const (
+	CreateTournamentTeamResponse_NONE                      CreateTournamentTeamResponse_Error = 0
+	CreateTournamentTeamResponse_TOURNAMENT_NAME_TOO_SHORT CreateTournamentTeamResponse_Error = 1
+	CreateTournamentTeamResponse_TOURNAMENT_NAME_TOO_LONG  CreateTournamentTeamResponse_Error = 2
+	CreateTournamentTeamResponse_TEAM_ID_REQUIRED          CreateTournamentTeamResponse_Error = 3
+	CreateTournamentTeamResponse_DATA_REQUIRED             CreateTournamentTeamResponse_Error = 4
+	CreateTournamentTeamResponse_ALREADY_EXISTS            CreateTournamentTeamResponse_Error = 5
+	CreateTournamentTeamResponse_DEFINITION_NOT_FOUND      CreateTournamentTeamResponse_Error = 6
+	CreateTournamentTeamResponse_TOURNAMENT_NOT_ACTIVE     CreateTournamentTeamResponse_Error = 7
+)
+
+// Enum value maps for CreateTournamentTeamResponse_Error.
+var (
+	CreateTournamentTeamResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "TEAM_ID_REQUIRED",
+		4: "DATA_REQUIRED",
+		5: "ALREADY_EXISTS",
+		6: "DEFINITION_NOT_FOUND",
+		7: "TOURNAMENT_NOT_ACTIVE",
+	}
+	CreateTournamentTeamResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"TOURNAMENT_NAME_TOO_SHORT": 1,
+		"TOURNAMENT_NAME_TOO_LONG":  2,
+		"TEAM_ID_REQUIRED":          3,
+		"DATA_REQUIRED":             4,
+		"ALREADY_EXISTS":            5,
+		"DEFINITION_NOT_FOUND":      6,
+		"TOURNAMENT_NOT_ACTIVE":     7,
+	}
+)
+
+func (x CreateTournamentTeamResponse_Error) Enum() *CreateTournamentTeamResponse_Error {
+	p := new(CreateTournamentTeamResponse_Error)
+	*p = x
+	return p
 }
 
-func (x *CreateTournamentUserRequest) Reset() {
-	*x = CreateTournamentUserRequest{}
-	mi := &file_tournament_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x CreateTournamentTeamResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CreateTournamentUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (CreateTournamentTeamResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[13].Descriptor()
 }
 
-func (*CreateTournamentUserRequest) ProtoMessage() {}
+func (CreateTournamentTeamResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[13]
+}
 
-func (x *CreateTournamentUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
+func (x CreateTournamentTeamResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateTournamentTeamResponse_Error.Descriptor instead.
+func (CreateTournamentTeamResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type GetTournamentTeamResponse_Error int32
+
+const (
+	GetTournamentTeamResponse_NONE                                       GetTournamentTeamResponse_Error = 0
+	GetTournamentTeamResponse_ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED GetTournamentTeamResponse_Error = 1
+	GetTournamentTeamResponse_TOURNAMENT_NAME_TOO_SHORT                  GetTournamentTeamResponse_Error = 2
+	GetTournamentTeamResponse_TOURNAMENT_NAME_TOO_LONG                   GetTournamentTeamResponse_Error = 3
+	GetTournamentTeamResponse_TEAM_ID_REQUIRED                           GetTournamentTeamResponse_Error = 4
+	GetTournamentTeamResponse_NOT_FOUND                                  GetTournamentTeamResponse_Error = 5
+)
+
+// Enum value maps for GetTournamentTeamResponse_Error.
+var (
+	GetTournamentTeamResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED",
+		2: "TOURNAMENT_NAME_TOO_SHORT",
+		3: "TOURNAMENT_NAME_TOO_LONG",
+		4: "TEAM_ID_REQUIRED",
+		5: "NOT_FOUND",
+	}
+	GetTournamentTeamResponse_Error_value = map[string]int32{
+		"NONE": 0,
+		"ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED": 1,
+		"TOURNAMENT_NAME_TOO_SHORT":                  2,
+		"TOURNAMENT_NAME_TOO_LONG":                   3,
+		"TEAM_ID_REQUIRED":                           4,
+		"NOT_FOUND":                                  5,
+	}
+)
+
+func (x GetTournamentTeamResponse_Error) Enum() *GetTournamentTeamResponse_Error {
+	p := new(GetTournamentTeamResponse_Error)
+	*p = x
+	return p
+}
+
+func (x GetTournamentTeamResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTournamentTeamResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[14].Descriptor()
+}
+
+func (GetTournamentTeamResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[14]
+}
+
+func (x GetTournamentTeamResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTournamentTeamResponse_Error.Descriptor instead.
+func (GetTournamentTeamResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type TournamentTeamResponse_Error int32
+
+const (
+	TournamentTeamResponse_NONE                                       TournamentTeamResponse_Error = 0
+	TournamentTeamResponse_ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED TournamentTeamResponse_Error = 1
+	TournamentTeamResponse_TOURNAMENT_NAME_TOO_SHORT                  TournamentTeamResponse_Error = 2
+	TournamentTeamResponse_TOURNAMENT_NAME_TOO_LONG                   TournamentTeamResponse_Error = 3
+	TournamentTeamResponse_TEAM_ID_REQUIRED                           TournamentTeamResponse_Error = 4
+	TournamentTeamResponse_NOT_FOUND                                  TournamentTeamResponse_Error = 5
+)
+
+// Enum value maps for TournamentTeamResponse_Error.
+var (
+	TournamentTeamResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED",
+		2: "TOURNAMENT_NAME_TOO_SHORT",
+		3: "TOURNAMENT_NAME_TOO_LONG",
+		4: "TEAM_ID_REQUIRED",
+		5: "NOT_FOUND",
+	}
+	TournamentTeamResponse_Error_value = map[string]int32{
+		"NONE": 0,
+		"ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED": 1,
+		"TOURNAMENT_NAME_TOO_SHORT":                  2,
+		"TOURNAMENT_NAME_TOO_LONG":                   3,
+		"TEAM_ID_REQUIRED":                           4,
+		"NOT_FOUND":                                  5,
+	}
+)
+
+func (x TournamentTeamResponse_Error) Enum() *TournamentTeamResponse_Error {
+	p := new(TournamentTeamResponse_Error)
+	*p = x
+	return p
+}
+
+func (x TournamentTeamResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentTeamResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[15].Descriptor()
+}
+
+func (TournamentTeamResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[15]
+}
+
+func (x TournamentTeamResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentTeamResponse_Error.Descriptor instead.
+func (TournamentTeamResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{18, 0}
+}
+
+type GetTournamentTeamsResponse_Error int32
+
+const (
+	GetTournamentTeamsResponse_NONE                      GetTournamentTeamsResponse_Error = 0
+	GetTournamentTeamsResponse_TOURNAMENT_NAME_TOO_SHORT GetTournamentTeamsResponse_Error = 1
+	GetTournamentTeamsResponse_TOURNAMENT_NAME_TOO_LONG  GetTournamentTeamsResponse_Error = 2
+	GetTournamentTeamsResponse_INVALID_CURSOR            GetTournamentTeamsResponse_Error = 3
+	GetTournamentTeamsResponse_TOURNAMENT_NAME_REQUIRED  GetTournamentTeamsResponse_Error = 4
+)
+
+// Enum value maps for GetTournamentTeamsResponse_Error.
+var (
+	GetTournamentTeamsResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "INVALID_CURSOR",
+		4: "TOURNAMENT_NAME_REQUIRED",
+	}
+	GetTournamentTeamsResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"TOURNAMENT_NAME_TOO_SHORT": 1,
+		"TOURNAMENT_NAME_TOO_LONG":  2,
+		"INVALID_CURSOR":            3,
+		"TOURNAMENT_NAME_REQUIRED":  4,
+	}
+)
+
+func (x GetTournamentTeamsResponse_Error) Enum() *GetTournamentTeamsResponse_Error {
+	p := new(GetTournamentTeamsResponse_Error)
+	*p = x
+	return p
+}
+
+func (x GetTournamentTeamsResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTournamentTeamsResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[16].Descriptor()
+}
+
+func (GetTournamentTeamsResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[16]
+}
+
+func (x GetTournamentTeamsResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTournamentTeamsResponse_Error.Descriptor instead.
+func (GetTournamentTeamsResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type UpdateTournamentTeamResponse_Error int32
+
+const (
+	UpdateTournamentTeamResponse_NONE                                       UpdateTournamentTeamResponse_Error = 0
+	UpdateTournamentTeamResponse_ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED UpdateTournamentTeamResponse_Error = 1
+	UpdateTournamentTeamResponse_TOURNAMENT_NAME_TOO_SHORT                  UpdateTournamentTeamResponse_Error = 2
+	UpdateTournamentTeamResponse_TOURNAMENT_NAME_TOO_LONG                   UpdateTournamentTeamResponse_Error = 3
+	UpdateTournamentTeamResponse_TEAM_ID_REQUIRED                           UpdateTournamentTeamResponse_Error = 4
+	UpdateTournamentTeamResponse_NOT_FOUND                                  UpdateTournamentTeamResponse_Error = 5
+	UpdateTournamentTeamResponse_NO_UPDATE_SPECIFIED                        UpdateTournamentTeamResponse_Error = 6
+	UpdateTournamentTeamResponse_INCREMENT_SCORE_NOT_SPECIFIED              UpdateTournamentTeamResponse_Error = 7
+)
+
+// Enum value maps for UpdateTournamentTeamResponse_Error.
+var (
+	UpdateTournamentTeamResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED",
+		2: "TOURNAMENT_NAME_TOO_SHORT",
+		3: "TOURNAMENT_NAME_TOO_LONG",
+		4: "TEAM_ID_REQUIRED",
+		5: "NOT_FOUND",
+		6: "NO_UPDATE_SPECIFIED",
+		7: "INCREMENT_SCORE_NOT_SPECIFIED",
+	}
+	UpdateTournamentTeamResponse_Error_value = map[string]int32{
+		"NONE": 0,
+		"ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED": 1,
+		"TOURNAMENT_NAME_TOO_SHORT":                  2,
+		"TOURNAMENT_NAME_TOO_LONG":                   3,
+		"TEAM_ID_REQUIRED":                           4,
+		"NOT_FOUND":                                  5,
+		"NO_UPDATE_SPECIFIED":                        6,
+		"INCREMENT_SCORE_NOT_SPECIFIED":              7,
+	}
+)
+
+func (x UpdateTournamentTeamResponse_Error) Enum() *UpdateTournamentTeamResponse_Error {
+	p := new(UpdateTournamentTeamResponse_Error)
+	*p = x
+	return p
+}
+
+func (x UpdateTournamentTeamResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateTournamentTeamResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[17].Descriptor()
+}
+
+func (UpdateTournamentTeamResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[17]
+}
+
+func (x UpdateTournamentTeamResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateTournamentTeamResponse_Error.Descriptor instead.
+func (UpdateTournamentTeamResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{22, 0}
+}
+
+type CreateTournamentDefinitionResponse_Error int32
+
+const (
+	CreateTournamentDefinitionResponse_NONE                      CreateTournamentDefinitionResponse_Error = 0
+	CreateTournamentDefinitionResponse_TOURNAMENT_NAME_TOO_SHORT CreateTournamentDefinitionResponse_Error = 1
+	CreateTournamentDefinitionResponse_TOURNAMENT_NAME_TOO_LONG  CreateTournamentDefinitionResponse_Error = 2
+	CreateTournamentDefinitionResponse_SCHEDULE_REQUIRED         CreateTournamentDefinitionResponse_Error = 3
+	CreateTournamentDefinitionResponse_INVALID_CRON_SCHEDULE     CreateTournamentDefinitionResponse_Error = 4
+	CreateTournamentDefinitionResponse_INVALID_TIME_ZONE         CreateTournamentDefinitionResponse_Error = 5
+	CreateTournamentDefinitionResponse_STARTS_AT_REQUIRED        CreateTournamentDefinitionResponse_Error = 6
+	CreateTournamentDefinitionResponse_ENDS_AT_BEFORE_STARTS_AT  CreateTournamentDefinitionResponse_Error = 7
+	CreateTournamentDefinitionResponse_DATA_REQUIRED             CreateTournamentDefinitionResponse_Error = 8
+	CreateTournamentDefinitionResponse_ALREADY_EXISTS            CreateTournamentDefinitionResponse_Error = 9
+)
+
+// Enum value maps for CreateTournamentDefinitionResponse_Error.
+var (
+	CreateTournamentDefinitionResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "SCHEDULE_REQUIRED",
+		4: "INVALID_CRON_SCHEDULE",
+		5: "INVALID_TIME_ZONE",
+		6: "STARTS_AT_REQUIRED",
+		7: "ENDS_AT_BEFORE_STARTS_AT",
+		8: "DATA_REQUIRED",
+		9: "ALREADY_EXISTS",
+	}
+	CreateTournamentDefinitionResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"TOURNAMENT_NAME_TOO_SHORT": 1,
+		"TOURNAMENT_NAME_TOO_LONG":  2,
+		"SCHEDULE_REQUIRED":         3,
+		"INVALID_CRON_SCHEDULE":     4,
+		"INVALID_TIME_ZONE":         5,
+		"STARTS_AT_REQUIRED":        6,
+		"ENDS_AT_BEFORE_STARTS_AT":  7,
+		"DATA_REQUIRED":             8,
+		"ALREADY_EXISTS":            9,
+	}
+)
+
+func (x CreateTournamentDefinitionResponse_Error) Enum() *CreateTournamentDefinitionResponse_Error {
+	p := new(CreateTournamentDefinitionResponse_Error)
+	*p = x
+	return p
+}
+
+func (x CreateTournamentDefinitionResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateTournamentDefinitionResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[18].Descriptor()
+}
+
+func (CreateTournamentDefinitionResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[18]
+}
+
+func (x CreateTournamentDefinitionResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateTournamentDefinitionResponse_Error.Descriptor instead.
+func (CreateTournamentDefinitionResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{25, 0}
+}
+
+type GetTournamentDefinitionResponse_Error int32
+
+const (
+	GetTournamentDefinitionResponse_NONE                      GetTournamentDefinitionResponse_Error = 0
+	GetTournamentDefinitionResponse_TOURNAMENT_NAME_TOO_SHORT GetTournamentDefinitionResponse_Error = 1
+	GetTournamentDefinitionResponse_TOURNAMENT_NAME_TOO_LONG  GetTournamentDefinitionResponse_Error = 2
+	GetTournamentDefinitionResponse_NOT_FOUND                 GetTournamentDefinitionResponse_Error = 3
+)
+
+// Enum value maps for GetTournamentDefinitionResponse_Error.
+var (
+	GetTournamentDefinitionResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "NOT_FOUND",
+	}
+	GetTournamentDefinitionResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"TOURNAMENT_NAME_TOO_SHORT": 1,
+		"TOURNAMENT_NAME_TOO_LONG":  2,
+		"NOT_FOUND":                 3,
+	}
+)
+
+func (x GetTournamentDefinitionResponse_Error) Enum() *GetTournamentDefinitionResponse_Error {
+	p := new(GetTournamentDefinitionResponse_Error)
+	*p = x
+	return p
+}
+
+func (x GetTournamentDefinitionResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTournamentDefinitionResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[19].Descriptor()
+}
+
+func (GetTournamentDefinitionResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[19]
+}
+
+func (x GetTournamentDefinitionResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTournamentDefinitionResponse_Error.Descriptor instead.
+func (GetTournamentDefinitionResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type TournamentDefinitionResponse_Error int32
+
+const (
+	TournamentDefinitionResponse_NONE                      TournamentDefinitionResponse_Error = 0
+	TournamentDefinitionResponse_TOURNAMENT_NAME_TOO_SHORT TournamentDefinitionResponse_Error = 1
+	TournamentDefinitionResponse_TOURNAMENT_NAME_TOO_LONG  TournamentDefinitionResponse_Error = 2
+	TournamentDefinitionResponse_NOT_FOUND                 TournamentDefinitionResponse_Error = 3
+)
+
+// Enum value maps for TournamentDefinitionResponse_Error.
+var (
+	TournamentDefinitionResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "NOT_FOUND",
+	}
+	TournamentDefinitionResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"TOURNAMENT_NAME_TOO_SHORT": 1,
+		"TOURNAMENT_NAME_TOO_LONG":  2,
+		"NOT_FOUND":                 3,
+	}
+)
+
+func (x TournamentDefinitionResponse_Error) Enum() *TournamentDefinitionResponse_Error {
+	p := new(TournamentDefinitionResponse_Error)
+	*p = x
+	return p
+}
+
+func (x TournamentDefinitionResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentDefinitionResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[20].Descriptor()
+}
+
+func (TournamentDefinitionResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[20]
+}
+
+func (x TournamentDefinitionResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentDefinitionResponse_Error.Descriptor instead.
+func (TournamentDefinitionResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{28, 0}
+}
+
+type SetTournamentWipeTimeResponse_Error int32
+
+const (
+	SetTournamentWipeTimeResponse_NONE                           SetTournamentWipeTimeResponse_Error = 0
+	SetTournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_SHORT      SetTournamentWipeTimeResponse_Error = 1
+	SetTournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_LONG       SetTournamentWipeTimeResponse_Error = 2
+	SetTournamentWipeTimeResponse_INVALID_MINUTE                 SetTournamentWipeTimeResponse_Error = 3
+	SetTournamentWipeTimeResponse_INVALID_WEEKLY_TOURNAMENT_DAY  SetTournamentWipeTimeResponse_Error = 4
+	SetTournamentWipeTimeResponse_INVALID_MONTHLY_TOURNAMENT_DAY SetTournamentWipeTimeResponse_Error = 5
+	SetTournamentWipeTimeResponse_INVALID_TIME_ZONE              SetTournamentWipeTimeResponse_Error = 6
+)
+
+// Enum value maps for SetTournamentWipeTimeResponse_Error.
+var (
+	SetTournamentWipeTimeResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "INVALID_MINUTE",
+		4: "INVALID_WEEKLY_TOURNAMENT_DAY",
+		5: "INVALID_MONTHLY_TOURNAMENT_DAY",
+		6: "INVALID_TIME_ZONE",
+	}
+	SetTournamentWipeTimeResponse_Error_value = map[string]int32{
+		"NONE":                           0,
+		"TOURNAMENT_NAME_TOO_SHORT":      1,
+		"TOURNAMENT_NAME_TOO_LONG":       2,
+		"INVALID_MINUTE":                 3,
+		"INVALID_WEEKLY_TOURNAMENT_DAY":  4,
+		"INVALID_MONTHLY_TOURNAMENT_DAY": 5,
+		"INVALID_TIME_ZONE":              6,
+	}
+)
+
+func (x SetTournamentWipeTimeResponse_Error) Enum() *SetTournamentWipeTimeResponse_Error {
+	p := new(SetTournamentWipeTimeResponse_Error)
+	*p = x
+	return p
+}
+
+func (x SetTournamentWipeTimeResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SetTournamentWipeTimeResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[21].Descriptor()
+}
+
+func (SetTournamentWipeTimeResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[21]
+}
+
+func (x SetTournamentWipeTimeResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SetTournamentWipeTimeResponse_Error.Descriptor instead.
+func (SetTournamentWipeTimeResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{31, 0}
+}
+
+type GetTournamentWipeTimeResponse_Error int32
+
+const (
+	GetTournamentWipeTimeResponse_NONE                      GetTournamentWipeTimeResponse_Error = 0
+	GetTournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_SHORT GetTournamentWipeTimeResponse_Error = 1
+	GetTournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_LONG  GetTournamentWipeTimeResponse_Error = 2
+	GetTournamentWipeTimeResponse_NOT_FOUND                 GetTournamentWipeTimeResponse_Error = 3
+)
+
+// Enum value maps for GetTournamentWipeTimeResponse_Error.
+var (
+	GetTournamentWipeTimeResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "NOT_FOUND",
+	}
+	GetTournamentWipeTimeResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"TOURNAMENT_NAME_TOO_SHORT": 1,
+		"TOURNAMENT_NAME_TOO_LONG":  2,
+		"NOT_FOUND":                 3,
+	}
+)
+
+func (x GetTournamentWipeTimeResponse_Error) Enum() *GetTournamentWipeTimeResponse_Error {
+	p := new(GetTournamentWipeTimeResponse_Error)
+	*p = x
+	return p
+}
+
+func (x GetTournamentWipeTimeResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTournamentWipeTimeResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[22].Descriptor()
+}
+
+func (GetTournamentWipeTimeResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[22]
+}
+
+func (x GetTournamentWipeTimeResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTournamentWipeTimeResponse_Error.Descriptor instead.
+func (GetTournamentWipeTimeResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{33, 0}
+}
+
+type TournamentWipeTimeResponse_Error int32
+
+const (
+	TournamentWipeTimeResponse_NONE                      TournamentWipeTimeResponse_Error = 0
+	TournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_SHORT TournamentWipeTimeResponse_Error = 1
+	TournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_LONG  TournamentWipeTimeResponse_Error = 2
+	TournamentWipeTimeResponse_NOT_FOUND                 TournamentWipeTimeResponse_Error = 3
+)
+
+// Enum value maps for TournamentWipeTimeResponse_Error.
+var (
+	TournamentWipeTimeResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "NOT_FOUND",
+	}
+	TournamentWipeTimeResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"TOURNAMENT_NAME_TOO_SHORT": 1,
+		"TOURNAMENT_NAME_TOO_LONG":  2,
+		"NOT_FOUND":                 3,
+	}
+)
+
+func (x TournamentWipeTimeResponse_Error) Enum() *TournamentWipeTimeResponse_Error {
+	p := new(TournamentWipeTimeResponse_Error)
+	*p = x
+	return p
+}
+
+func (x TournamentWipeTimeResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentWipeTimeResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[23].Descriptor()
+}
+
+func (TournamentWipeTimeResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[23]
+}
+
+func (x TournamentWipeTimeResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentWipeTimeResponse_Error.Descriptor instead.
+func (TournamentWipeTimeResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{34, 0}
+}
+
+type CreateTournamentRewardTierResponse_Error int32
+
+const (
+	CreateTournamentRewardTierResponse_NONE                           CreateTournamentRewardTierResponse_Error = 0
+	CreateTournamentRewardTierResponse_TOURNAMENT_NAME_TOO_SHORT      CreateTournamentRewardTierResponse_Error = 1
+	CreateTournamentRewardTierResponse_TOURNAMENT_NAME_TOO_LONG       CreateTournamentRewardTierResponse_Error = 2
+	CreateTournamentRewardTierResponse_RANKING_OR_PERCENTAGE_REQUIRED CreateTournamentRewardTierResponse_Error = 3
+	CreateTournamentRewardTierResponse_INVALID_RANKING                CreateTournamentRewardTierResponse_Error = 4
+	CreateTournamentRewardTierResponse_INVALID_PERCENTAGE             CreateTournamentRewardTierResponse_Error = 5
+	CreateTournamentRewardTierResponse_DATA_REQUIRED                  CreateTournamentRewardTierResponse_Error = 6
+)
+
+// Enum value maps for CreateTournamentRewardTierResponse_Error.
+var (
+	CreateTournamentRewardTierResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "RANKING_OR_PERCENTAGE_REQUIRED",
+		4: "INVALID_RANKING",
+		5: "INVALID_PERCENTAGE",
+		6: "DATA_REQUIRED",
+	}
+	CreateTournamentRewardTierResponse_Error_value = map[string]int32{
+		"NONE":                           0,
+		"TOURNAMENT_NAME_TOO_SHORT":      1,
+		"TOURNAMENT_NAME_TOO_LONG":       2,
+		"RANKING_OR_PERCENTAGE_REQUIRED": 3,
+		"INVALID_RANKING":                4,
+		"INVALID_PERCENTAGE":             5,
+		"DATA_REQUIRED":                  6,
+	}
+)
+
+func (x CreateTournamentRewardTierResponse_Error) Enum() *CreateTournamentRewardTierResponse_Error {
+	p := new(CreateTournamentRewardTierResponse_Error)
+	*p = x
+	return p
+}
+
+func (x CreateTournamentRewardTierResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateTournamentRewardTierResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[24].Descriptor()
+}
+
+func (CreateTournamentRewardTierResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[24]
+}
+
+func (x CreateTournamentRewardTierResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateTournamentRewardTierResponse_Error.Descriptor instead.
+func (CreateTournamentRewardTierResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{37, 0}
+}
+
+type GetTournamentRewardTiersResponse_Error int32
+
+const (
+	GetTournamentRewardTiersResponse_NONE                      GetTournamentRewardTiersResponse_Error = 0
+	GetTournamentRewardTiersResponse_TOURNAMENT_NAME_TOO_SHORT GetTournamentRewardTiersResponse_Error = 1
+	GetTournamentRewardTiersResponse_TOURNAMENT_NAME_TOO_LONG  GetTournamentRewardTiersResponse_Error = 2
+)
+
+// Enum value maps for GetTournamentRewardTiersResponse_Error.
+var (
+	GetTournamentRewardTiersResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+	}
+	GetTournamentRewardTiersResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"TOURNAMENT_NAME_TOO_SHORT": 1,
+		"TOURNAMENT_NAME_TOO_LONG":  2,
+	}
+)
+
+func (x GetTournamentRewardTiersResponse_Error) Enum() *GetTournamentRewardTiersResponse_Error {
+	p := new(GetTournamentRewardTiersResponse_Error)
+	*p = x
+	return p
+}
+
+func (x GetTournamentRewardTiersResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTournamentRewardTiersResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[25].Descriptor()
+}
+
+func (GetTournamentRewardTiersResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[25]
+}
+
+func (x GetTournamentRewardTiersResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTournamentRewardTiersResponse_Error.Descriptor instead.
+func (GetTournamentRewardTiersResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{39, 0}
+}
+
+type TournamentRewardTierResponse_Error int32
+
+const (
+	TournamentRewardTierResponse_NONE        TournamentRewardTierResponse_Error = 0
+	TournamentRewardTierResponse_ID_REQUIRED TournamentRewardTierResponse_Error = 1
+	TournamentRewardTierResponse_NOT_FOUND   TournamentRewardTierResponse_Error = 2
+)
+
+// Enum value maps for TournamentRewardTierResponse_Error.
+var (
+	TournamentRewardTierResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "ID_REQUIRED",
+		2: "NOT_FOUND",
+	}
+	TournamentRewardTierResponse_Error_value = map[string]int32{
+		"NONE":        0,
+		"ID_REQUIRED": 1,
+		"NOT_FOUND":   2,
+	}
+)
+
+func (x TournamentRewardTierResponse_Error) Enum() *TournamentRewardTierResponse_Error {
+	p := new(TournamentRewardTierResponse_Error)
+	*p = x
+	return p
+}
+
+func (x TournamentRewardTierResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentRewardTierResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[26].Descriptor()
+}
+
+func (TournamentRewardTierResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[26]
+}
+
+func (x TournamentRewardTierResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentRewardTierResponse_Error.Descriptor instead.
+func (TournamentRewardTierResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{41, 0}
+}
+
+type GetTournamentRewardsResponse_Error int32
+
+const (
+	GetTournamentRewardsResponse_NONE             GetTournamentRewardsResponse_Error = 0
+	GetTournamentRewardsResponse_USER_ID_REQUIRED GetTournamentRewardsResponse_Error = 1
+)
+
+// Enum value maps for GetTournamentRewardsResponse_Error.
+var (
+	GetTournamentRewardsResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "USER_ID_REQUIRED",
+	}
+	GetTournamentRewardsResponse_Error_value = map[string]int32{
+		"NONE":             0,
+		"USER_ID_REQUIRED": 1,
+	}
+)
+
+func (x GetTournamentRewardsResponse_Error) Enum() *GetTournamentRewardsResponse_Error {
+	p := new(GetTournamentRewardsResponse_Error)
+	*p = x
+	return p
+}
+
+func (x GetTournamentRewardsResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTournamentRewardsResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[27].Descriptor()
+}
+
+func (GetTournamentRewardsResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[27]
+}
+
+func (x GetTournamentRewardsResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTournamentRewardsResponse_Error.Descriptor instead.
+func (GetTournamentRewardsResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{44, 0}
+}
+
+type ClaimTournamentRewardResponse_Error int32
+
+const (
+	ClaimTournamentRewardResponse_NONE             ClaimTournamentRewardResponse_Error = 0
+	ClaimTournamentRewardResponse_ID_REQUIRED      ClaimTournamentRewardResponse_Error = 1
+	ClaimTournamentRewardResponse_USER_ID_REQUIRED ClaimTournamentRewardResponse_Error = 2
+	ClaimTournamentRewardResponse_NOT_FOUND        ClaimTournamentRewardResponse_Error = 3
+	ClaimTournamentRewardResponse_ALREADY_CLAIMED  ClaimTournamentRewardResponse_Error = 4
+)
+
+// Enum value maps for ClaimTournamentRewardResponse_Error.
+var (
+	ClaimTournamentRewardResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "ID_REQUIRED",
+		2: "USER_ID_REQUIRED",
+		3: "NOT_FOUND",
+		4: "ALREADY_CLAIMED",
+	}
+	ClaimTournamentRewardResponse_Error_value = map[string]int32{
+		"NONE":             0,
+		"ID_REQUIRED":      1,
+		"USER_ID_REQUIRED": 2,
+		"NOT_FOUND":        3,
+		"ALREADY_CLAIMED":  4,
+	}
+)
+
+func (x ClaimTournamentRewardResponse_Error) Enum() *ClaimTournamentRewardResponse_Error {
+	p := new(ClaimTournamentRewardResponse_Error)
+	*p = x
+	return p
+}
+
+func (x ClaimTournamentRewardResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClaimTournamentRewardResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[28].Descriptor()
+}
+
+func (ClaimTournamentRewardResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[28]
+}
+
+func (x ClaimTournamentRewardResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClaimTournamentRewardResponse_Error.Descriptor instead.
+func (ClaimTournamentRewardResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{46, 0}
+}
+
+type SubmitTournamentScoreResponse_Error int32
+
+const (
+	SubmitTournamentScoreResponse_NONE                      SubmitTournamentScoreResponse_Error = 0
+	SubmitTournamentScoreResponse_TOURNAMENT_NAME_TOO_SHORT SubmitTournamentScoreResponse_Error = 1
+	SubmitTournamentScoreResponse_TOURNAMENT_NAME_TOO_LONG  SubmitTournamentScoreResponse_Error = 2
+	SubmitTournamentScoreResponse_USER_ID_REQUIRED          SubmitTournamentScoreResponse_Error = 3
+	SubmitTournamentScoreResponse_DEFINITION_NOT_FOUND      SubmitTournamentScoreResponse_Error = 4
+	SubmitTournamentScoreResponse_TOURNAMENT_NOT_ACTIVE     SubmitTournamentScoreResponse_Error = 5
+	SubmitTournamentScoreResponse_NOT_A_TEAM_MEMBER         SubmitTournamentScoreResponse_Error = 6
+	SubmitTournamentScoreResponse_SCORE_MODE_INVALID        SubmitTournamentScoreResponse_Error = 7
+)
+
+// Enum value maps for SubmitTournamentScoreResponse_Error.
+var (
+	SubmitTournamentScoreResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "USER_ID_REQUIRED",
+		4: "DEFINITION_NOT_FOUND",
+		5: "TOURNAMENT_NOT_ACTIVE",
+		6: "NOT_A_TEAM_MEMBER",
+		7: "SCORE_MODE_INVALID",
+	}
+	SubmitTournamentScoreResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"TOURNAMENT_NAME_TOO_SHORT": 1,
+		"TOURNAMENT_NAME_TOO_LONG":  2,
+		"USER_ID_REQUIRED":          3,
+		"DEFINITION_NOT_FOUND":      4,
+		"TOURNAMENT_NOT_ACTIVE":     5,
+		"NOT_A_TEAM_MEMBER":         6,
+		"SCORE_MODE_INVALID":        7,
+	}
+)
+
+func (x SubmitTournamentScoreResponse_Error) Enum() *SubmitTournamentScoreResponse_Error {
+	p := new(SubmitTournamentScoreResponse_Error)
+	*p = x
+	return p
+}
+
+func (x SubmitTournamentScoreResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubmitTournamentScoreResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[29].Descriptor()
+}
+
+func (SubmitTournamentScoreResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[29]
+}
+
+func (x SubmitTournamentScoreResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubmitTournamentScoreResponse_Error.Descriptor instead.
+func (SubmitTournamentScoreResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{49, 0}
+}
+
+type CreateTournamentBracketResponse_Error int32
+
+const (
+	CreateTournamentBracketResponse_NONE                    CreateTournamentBracketResponse_Error = 0
+	CreateTournamentBracketResponse_NAME_TOO_SHORT          CreateTournamentBracketResponse_Error = 1
+	CreateTournamentBracketResponse_NAME_TOO_LONG           CreateTournamentBracketResponse_Error = 2
+	CreateTournamentBracketResponse_NOT_ENOUGH_PARTICIPANTS CreateTournamentBracketResponse_Error = 3
+	CreateTournamentBracketResponse_TOO_MANY_PARTICIPANTS   CreateTournamentBracketResponse_Error = 4
+	CreateTournamentBracketResponse_DUPLICATE_PARTICIPANT   CreateTournamentBracketResponse_Error = 5
+	CreateTournamentBracketResponse_TOURNAMENT_REQUIRED     CreateTournamentBracketResponse_Error = 6
+	CreateTournamentBracketResponse_DEFINITION_NOT_FOUND    CreateTournamentBracketResponse_Error = 7
+	CreateTournamentBracketResponse_TOURNAMENT_NOT_ACTIVE   CreateTournamentBracketResponse_Error = 8
+	CreateTournamentBracketResponse_ARENA_NOT_FOUND         CreateTournamentBracketResponse_Error = 9
+	CreateTournamentBracketResponse_DATA_REQUIRED           CreateTournamentBracketResponse_Error = 10
+	CreateTournamentBracketResponse_ALREADY_EXISTS          CreateTournamentBracketResponse_Error = 11
+)
+
+// Enum value maps for CreateTournamentBracketResponse_Error.
+var (
+	CreateTournamentBracketResponse_Error_name = map[int32]string{
+		0:  "NONE",
+		1:  "NAME_TOO_SHORT",
+		2:  "NAME_TOO_LONG",
+		3:  "NOT_ENOUGH_PARTICIPANTS",
+		4:  "TOO_MANY_PARTICIPANTS",
+		5:  "DUPLICATE_PARTICIPANT",
+		6:  "TOURNAMENT_REQUIRED",
+		7:  "DEFINITION_NOT_FOUND",
+		8:  "TOURNAMENT_NOT_ACTIVE",
+		9:  "ARENA_NOT_FOUND",
+		10: "DATA_REQUIRED",
+		11: "ALREADY_EXISTS",
+	}
+	CreateTournamentBracketResponse_Error_value = map[string]int32{
+		"NONE":                    0,
+		"NAME_TOO_SHORT":          1,
+		"NAME_TOO_LONG":           2,
+		"NOT_ENOUGH_PARTICIPANTS": 3,
+		"TOO_MANY_PARTICIPANTS":   4,
+		"DUPLICATE_PARTICIPANT":   5,
+		"TOURNAMENT_REQUIRED":     6,
+		"DEFINITION_NOT_FOUND":    7,
+		"TOURNAMENT_NOT_ACTIVE":   8,
+		"ARENA_NOT_FOUND":         9,
+		"DATA_REQUIRED":           10,
+		"ALREADY_EXISTS":          11,
+	}
+)
+
+func (x CreateTournamentBracketResponse_Error) Enum() *CreateTournamentBracketResponse_Error {
+	p := new(CreateTournamentBracketResponse_Error)
+	*p = x
+	return p
+}
+
+func (x CreateTournamentBracketResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateTournamentBracketResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[30].Descriptor()
+}
+
+func (CreateTournamentBracketResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[30]
+}
+
+func (x CreateTournamentBracketResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateTournamentBracketResponse_Error.Descriptor instead.
+func (CreateTournamentBracketResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{51, 0}
+}
+
+type GetTournamentBracketResponse_Error int32
+
+const (
+	GetTournamentBracketResponse_NONE        GetTournamentBracketResponse_Error = 0
+	GetTournamentBracketResponse_ID_REQUIRED GetTournamentBracketResponse_Error = 1
+	GetTournamentBracketResponse_NOT_FOUND   GetTournamentBracketResponse_Error = 2
+)
+
+// Enum value maps for GetTournamentBracketResponse_Error.
+var (
+	GetTournamentBracketResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "ID_REQUIRED",
+		2: "NOT_FOUND",
+	}
+	GetTournamentBracketResponse_Error_value = map[string]int32{
+		"NONE":        0,
+		"ID_REQUIRED": 1,
+		"NOT_FOUND":   2,
+	}
+)
+
+func (x GetTournamentBracketResponse_Error) Enum() *GetTournamentBracketResponse_Error {
+	p := new(GetTournamentBracketResponse_Error)
+	*p = x
+	return p
+}
+
+func (x GetTournamentBracketResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTournamentBracketResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[31].Descriptor()
+}
+
+func (GetTournamentBracketResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[31]
+}
+
+func (x GetTournamentBracketResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTournamentBracketResponse_Error.Descriptor instead.
+func (GetTournamentBracketResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{53, 0}
+}
+
+type TournamentBracketResponse_Error int32
+
+const (
+	TournamentBracketResponse_NONE        TournamentBracketResponse_Error = 0
+	TournamentBracketResponse_ID_REQUIRED TournamentBracketResponse_Error = 1
+	TournamentBracketResponse_NOT_FOUND   TournamentBracketResponse_Error = 2
+)
+
+// Enum value maps for TournamentBracketResponse_Error.
+var (
+	TournamentBracketResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "ID_REQUIRED",
+		2: "NOT_FOUND",
+	}
+	TournamentBracketResponse_Error_value = map[string]int32{
+		"NONE":        0,
+		"ID_REQUIRED": 1,
+		"NOT_FOUND":   2,
+	}
+)
+
+func (x TournamentBracketResponse_Error) Enum() *TournamentBracketResponse_Error {
+	p := new(TournamentBracketResponse_Error)
+	*p = x
+	return p
+}
+
+func (x TournamentBracketResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentBracketResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[32].Descriptor()
+}
+
+func (TournamentBracketResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[32]
+}
+
+func (x TournamentBracketResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentBracketResponse_Error.Descriptor instead.
+func (TournamentBracketResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{54, 0}
+}
+
+type ReportTournamentBracketMatchResultResponse_Error int32
+
+const (
+	ReportTournamentBracketMatchResultResponse_NONE                    ReportTournamentBracketMatchResultResponse_Error = 0
+	ReportTournamentBracketMatchResultResponse_MATCH_ID_REQUIRED       ReportTournamentBracketMatchResultResponse_Error = 1
+	ReportTournamentBracketMatchResultResponse_WINNER_USER_ID_REQUIRED ReportTournamentBracketMatchResultResponse_Error = 2
+	ReportTournamentBracketMatchResultResponse_NOT_FOUND               ReportTournamentBracketMatchResultResponse_Error = 3
+	ReportTournamentBracketMatchResultResponse_MATCH_NOT_READY         ReportTournamentBracketMatchResultResponse_Error = 4
+	ReportTournamentBracketMatchResultResponse_ALREADY_REPORTED        ReportTournamentBracketMatchResultResponse_Error = 5
+	ReportTournamentBracketMatchResultResponse_WINNER_NOT_IN_MATCH     ReportTournamentBracketMatchResultResponse_Error = 6
+)
+
+// Enum value maps for ReportTournamentBracketMatchResultResponse_Error.
+var (
+	ReportTournamentBracketMatchResultResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "MATCH_ID_REQUIRED",
+		2: "WINNER_USER_ID_REQUIRED",
+		3: "NOT_FOUND",
+		4: "MATCH_NOT_READY",
+		5: "ALREADY_REPORTED",
+		6: "WINNER_NOT_IN_MATCH",
+	}
+	ReportTournamentBracketMatchResultResponse_Error_value = map[string]int32{
+		"NONE":                    0,
+		"MATCH_ID_REQUIRED":       1,
+		"WINNER_USER_ID_REQUIRED": 2,
+		"NOT_FOUND":               3,
+		"MATCH_NOT_READY":         4,
+		"ALREADY_REPORTED":        5,
+		"WINNER_NOT_IN_MATCH":     6,
+	}
+)
+
+func (x ReportTournamentBracketMatchResultResponse_Error) Enum() *ReportTournamentBracketMatchResultResponse_Error {
+	p := new(ReportTournamentBracketMatchResultResponse_Error)
+	*p = x
+	return p
+}
+
+func (x ReportTournamentBracketMatchResultResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportTournamentBracketMatchResultResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[33].Descriptor()
+}
+
+func (ReportTournamentBracketMatchResultResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[33]
+}
+
+func (x ReportTournamentBracketMatchResultResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportTournamentBracketMatchResultResponse_Error.Descriptor instead.
+func (ReportTournamentBracketMatchResultResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{56, 0}
+}
+
+type CreateTournamentRoundResponse_Error int32
+
+const (
+	CreateTournamentRoundResponse_NONE                      CreateTournamentRoundResponse_Error = 0
+	CreateTournamentRoundResponse_TOURNAMENT_NAME_TOO_SHORT CreateTournamentRoundResponse_Error = 1
+	CreateTournamentRoundResponse_TOURNAMENT_NAME_TOO_LONG  CreateTournamentRoundResponse_Error = 2
+	CreateTournamentRoundResponse_DEFINITION_NOT_FOUND      CreateTournamentRoundResponse_Error = 3
+	CreateTournamentRoundResponse_TOURNAMENT_NOT_ACTIVE     CreateTournamentRoundResponse_Error = 4
+	CreateTournamentRoundResponse_NOT_ENOUGH_PARTICIPANTS   CreateTournamentRoundResponse_Error = 5
+	CreateTournamentRoundResponse_FORMAT_MISMATCH           CreateTournamentRoundResponse_Error = 6
+	CreateTournamentRoundResponse_ROUND_NOT_COMPLETE        CreateTournamentRoundResponse_Error = 7
+	CreateTournamentRoundResponse_ALL_ROUNDS_PLAYED         CreateTournamentRoundResponse_Error = 8
+	CreateTournamentRoundResponse_NO_PAIRING_POSSIBLE       CreateTournamentRoundResponse_Error = 9
+	CreateTournamentRoundResponse_TOO_MANY_PARTICIPANTS     CreateTournamentRoundResponse_Error = 10
+)
+
+// Enum value maps for CreateTournamentRoundResponse_Error.
+var (
+	CreateTournamentRoundResponse_Error_name = map[int32]string{
+		0:  "NONE",
+		1:  "TOURNAMENT_NAME_TOO_SHORT",
+		2:  "TOURNAMENT_NAME_TOO_LONG",
+		3:  "DEFINITION_NOT_FOUND",
+		4:  "TOURNAMENT_NOT_ACTIVE",
+		5:  "NOT_ENOUGH_PARTICIPANTS",
+		6:  "FORMAT_MISMATCH",
+		7:  "ROUND_NOT_COMPLETE",
+		8:  "ALL_ROUNDS_PLAYED",
+		9:  "NO_PAIRING_POSSIBLE",
+		10: "TOO_MANY_PARTICIPANTS",
+	}
+	CreateTournamentRoundResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"TOURNAMENT_NAME_TOO_SHORT": 1,
+		"TOURNAMENT_NAME_TOO_LONG":  2,
+		"DEFINITION_NOT_FOUND":      3,
+		"TOURNAMENT_NOT_ACTIVE":     4,
+		"NOT_ENOUGH_PARTICIPANTS":   5,
+		"FORMAT_MISMATCH":           6,
+		"ROUND_NOT_COMPLETE":        7,
+		"ALL_ROUNDS_PLAYED":         8,
+		"NO_PAIRING_POSSIBLE":       9,
+		"TOO_MANY_PARTICIPANTS":     10,
+	}
+)
+
+func (x CreateTournamentRoundResponse_Error) Enum() *CreateTournamentRoundResponse_Error {
+	p := new(CreateTournamentRoundResponse_Error)
+	*p = x
+	return p
+}
+
+func (x CreateTournamentRoundResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateTournamentRoundResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[34].Descriptor()
+}
+
+func (CreateTournamentRoundResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[34]
+}
+
+func (x CreateTournamentRoundResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateTournamentRoundResponse_Error.Descriptor instead.
+func (CreateTournamentRoundResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{61, 0}
+}
+
+type GetTournamentPairingsResponse_Error int32
+
+const (
+	GetTournamentPairingsResponse_NONE                      GetTournamentPairingsResponse_Error = 0
+	GetTournamentPairingsResponse_TOURNAMENT_NAME_TOO_SHORT GetTournamentPairingsResponse_Error = 1
+	GetTournamentPairingsResponse_TOURNAMENT_NAME_TOO_LONG  GetTournamentPairingsResponse_Error = 2
+	GetTournamentPairingsResponse_DEFINITION_NOT_FOUND      GetTournamentPairingsResponse_Error = 3
+	GetTournamentPairingsResponse_TOURNAMENT_NOT_ACTIVE     GetTournamentPairingsResponse_Error = 4
+)
+
+// Enum value maps for GetTournamentPairingsResponse_Error.
+var (
+	GetTournamentPairingsResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "DEFINITION_NOT_FOUND",
+		4: "TOURNAMENT_NOT_ACTIVE",
+	}
+	GetTournamentPairingsResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"TOURNAMENT_NAME_TOO_SHORT": 1,
+		"TOURNAMENT_NAME_TOO_LONG":  2,
+		"DEFINITION_NOT_FOUND":      3,
+		"TOURNAMENT_NOT_ACTIVE":     4,
+	}
+)
+
+func (x GetTournamentPairingsResponse_Error) Enum() *GetTournamentPairingsResponse_Error {
+	p := new(GetTournamentPairingsResponse_Error)
+	*p = x
+	return p
+}
+
+func (x GetTournamentPairingsResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTournamentPairingsResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[35].Descriptor()
+}
+
+func (GetTournamentPairingsResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[35]
+}
+
+func (x GetTournamentPairingsResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTournamentPairingsResponse_Error.Descriptor instead.
+func (GetTournamentPairingsResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{63, 0}
+}
+
+type RecordTournamentPairingResultResponse_Error int32
+
+const (
+	RecordTournamentPairingResultResponse_NONE             RecordTournamentPairingResultResponse_Error = 0
+	RecordTournamentPairingResultResponse_ID_REQUIRED      RecordTournamentPairingResultResponse_Error = 1
+	RecordTournamentPairingResultResponse_NOT_FOUND        RecordTournamentPairingResultResponse_Error = 2
+	RecordTournamentPairingResultResponse_ALREADY_RECORDED RecordTournamentPairingResultResponse_Error = 3
+)
+
+// Enum value maps for RecordTournamentPairingResultResponse_Error.
+var (
+	RecordTournamentPairingResultResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "ID_REQUIRED",
+		2: "NOT_FOUND",
+		3: "ALREADY_RECORDED",
+	}
+	RecordTournamentPairingResultResponse_Error_value = map[string]int32{
+		"NONE":             0,
+		"ID_REQUIRED":      1,
+		"NOT_FOUND":        2,
+		"ALREADY_RECORDED": 3,
+	}
+)
+
+func (x RecordTournamentPairingResultResponse_Error) Enum() *RecordTournamentPairingResultResponse_Error {
+	p := new(RecordTournamentPairingResultResponse_Error)
+	*p = x
+	return p
+}
+
+func (x RecordTournamentPairingResultResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecordTournamentPairingResultResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[36].Descriptor()
+}
+
+func (RecordTournamentPairingResultResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[36]
+}
+
+func (x RecordTournamentPairingResultResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecordTournamentPairingResultResponse_Error.Descriptor instead.
+func (RecordTournamentPairingResultResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{65, 0}
+}
+
+type GetTournamentStandingsResponse_Error int32
+
+const (
+	GetTournamentStandingsResponse_NONE                      GetTournamentStandingsResponse_Error = 0
+	GetTournamentStandingsResponse_TOURNAMENT_NAME_TOO_SHORT GetTournamentStandingsResponse_Error = 1
+	GetTournamentStandingsResponse_TOURNAMENT_NAME_TOO_LONG  GetTournamentStandingsResponse_Error = 2
+	GetTournamentStandingsResponse_DEFINITION_NOT_FOUND      GetTournamentStandingsResponse_Error = 3
+	GetTournamentStandingsResponse_TOURNAMENT_NOT_ACTIVE     GetTournamentStandingsResponse_Error = 4
+)
+
+// Enum value maps for GetTournamentStandingsResponse_Error.
+var (
+	GetTournamentStandingsResponse_Error_name = map[int32]string{
+		0: "NONE",
+		1: "TOURNAMENT_NAME_TOO_SHORT",
+		2: "TOURNAMENT_NAME_TOO_LONG",
+		3: "DEFINITION_NOT_FOUND",
+		4: "TOURNAMENT_NOT_ACTIVE",
+	}
+	GetTournamentStandingsResponse_Error_value = map[string]int32{
+		"NONE":                      0,
+		"TOURNAMENT_NAME_TOO_SHORT": 1,
+		"TOURNAMENT_NAME_TOO_LONG":  2,
+		"DEFINITION_NOT_FOUND":      3,
+		"TOURNAMENT_NOT_ACTIVE":     4,
+	}
+)
+
+func (x GetTournamentStandingsResponse_Error) Enum() *GetTournamentStandingsResponse_Error {
+	p := new(GetTournamentStandingsResponse_Error)
+	*p = x
+	return p
+}
+
+func (x GetTournamentStandingsResponse_Error) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTournamentStandingsResponse_Error) Descriptor() protoreflect.EnumDescriptor {
+	return file_tournament_proto_enumTypes[37].Descriptor()
+}
+
+func (GetTournamentStandingsResponse_Error) Type() protoreflect.EnumType {
+	return &file_tournament_proto_enumTypes[37]
+}
+
+func (x GetTournamentStandingsResponse_Error) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTournamentStandingsResponse_Error.Descriptor instead.
+func (GetTournamentStandingsResponse_Error) EnumDescriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{67, 0}
+}
+
+type CreateTournamentUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	Score         *int64                 `protobuf:"varint,4,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	TeamId        *uint64                `protobuf:"varint,6,opt,name=teamId,proto3,oneof" json:"teamId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTournamentUserRequest) Reset() {
+	*x = CreateTournamentUserRequest{}
+	mi := &file_tournament_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentUserRequest) ProtoMessage() {}
+
+func (x *CreateTournamentUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentUserRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateTournamentUserRequest) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *CreateTournamentUserRequest) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *CreateTournamentUserRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateTournamentUserRequest) GetScore() int64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *CreateTournamentUserRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateTournamentUserRequest) GetTeamId() uint64 {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return 0
+}
+
+type CreateTournamentUserResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Success       bool                               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            *uint64                            `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Error         CreateTournamentUserResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.CreateTournamentUserResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTournamentUserResponse) Reset() {
+	*x = CreateTournamentUserResponse{}
+	mi := &file_tournament_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentUserResponse) ProtoMessage() {}
+
+func (x *CreateTournamentUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateTournamentUserResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTournamentUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateTournamentUserResponse) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *CreateTournamentUserResponse) GetError() CreateTournamentUserResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return CreateTournamentUserResponse_NONE
+}
+
+type TournamentIntervalUserId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentIntervalUserId) Reset() {
+	*x = TournamentIntervalUserId{}
+	mi := &file_tournament_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentIntervalUserId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentIntervalUserId) ProtoMessage() {}
+
+func (x *TournamentIntervalUserId) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentIntervalUserId.ProtoReflect.Descriptor instead.
+func (*TournamentIntervalUserId) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TournamentIntervalUserId) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *TournamentIntervalUserId) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *TournamentIntervalUserId) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type TournamentUserRequest struct {
+	state                    protoimpl.MessageState    `protogen:"open.v1"`
+	Id                       *uint64                   `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	TournamentIntervalUserId *TournamentIntervalUserId `protobuf:"bytes,2,opt,name=tournamentIntervalUserId,proto3,oneof" json:"tournamentIntervalUserId,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *TournamentUserRequest) Reset() {
+	*x = TournamentUserRequest{}
+	mi := &file_tournament_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentUserRequest) ProtoMessage() {}
+
+func (x *TournamentUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentUserRequest.ProtoReflect.Descriptor instead.
+func (*TournamentUserRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TournamentUserRequest) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *TournamentUserRequest) GetTournamentIntervalUserId() *TournamentIntervalUserId {
+	if x != nil {
+		return x.TournamentIntervalUserId
+	}
+	return nil
+}
+
+type GetTournamentUserResponse struct {
+	state          protoimpl.MessageState          `protogen:"open.v1"`
+	Success        bool                            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TournamentUser *TournamentUser                 `protobuf:"bytes,2,opt,name=tournamentUser,proto3,oneof" json:"tournamentUser,omitempty"`
+	Error          GetTournamentUserResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentUserResponse_Error" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTournamentUserResponse) Reset() {
+	*x = GetTournamentUserResponse{}
+	mi := &file_tournament_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentUserResponse) ProtoMessage() {}
+
+func (x *GetTournamentUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentUserResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentUserResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTournamentUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTournamentUserResponse) GetTournamentUser() *TournamentUser {
+	if x != nil {
+		return x.TournamentUser
+	}
+	return nil
+}
+
+func (x *GetTournamentUserResponse) GetError() GetTournamentUserResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetTournamentUserResponse_NONE
+}
+
+type TournamentUserResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Success       bool                         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         TournamentUserResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.TournamentUserResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentUserResponse) Reset() {
+	*x = TournamentUserResponse{}
+	mi := &file_tournament_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentUserResponse) ProtoMessage() {}
+
+func (x *TournamentUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentUserResponse.ProtoReflect.Descriptor instead.
+func (*TournamentUserResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TournamentUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TournamentUserResponse) GetError() TournamentUserResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return TournamentUserResponse_NONE
+}
+
+type GetTournamentUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    *string                `protobuf:"bytes,1,opt,name=tournament,proto3,oneof" json:"tournament,omitempty"`
+	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	UserId        *uint64                `protobuf:"varint,3,opt,name=userId,proto3,oneof" json:"userId,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,4,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTournamentUsersRequest) Reset() {
+	*x = GetTournamentUsersRequest{}
+	mi := &file_tournament_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentUsersRequest) ProtoMessage() {}
+
+func (x *GetTournamentUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentUsersRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTournamentUsersRequest) GetTournament() string {
+	if x != nil && x.Tournament != nil {
+		return *x.Tournament
+	}
+	return ""
+}
+
+func (x *GetTournamentUsersRequest) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *GetTournamentUsersRequest) GetUserId() uint64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *GetTournamentUsersRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetTournamentUsersResponse struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Success         bool                             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TournamentUsers []*TournamentUser                `protobuf:"bytes,2,rep,name=tournamentUsers,proto3" json:"tournamentUsers,omitempty"`
+	Error           GetTournamentUsersResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentUsersResponse_Error" json:"error,omitempty"`
+	NextCursor      *string                          `protobuf:"bytes,4,opt,name=nextCursor,proto3,oneof" json:"nextCursor,omitempty"`
+	PreviousCursor  *string                          `protobuf:"bytes,5,opt,name=previousCursor,proto3,oneof" json:"previousCursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTournamentUsersResponse) Reset() {
+	*x = GetTournamentUsersResponse{}
+	mi := &file_tournament_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentUsersResponse) ProtoMessage() {}
+
+func (x *GetTournamentUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentUsersResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTournamentUsersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTournamentUsersResponse) GetTournamentUsers() []*TournamentUser {
+	if x != nil {
+		return x.TournamentUsers
+	}
+	return nil
+}
+
+func (x *GetTournamentUsersResponse) GetError() GetTournamentUsersResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetTournamentUsersResponse_NONE
+}
+
+func (x *GetTournamentUsersResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetTournamentUsersResponse) GetPreviousCursor() string {
+	if x != nil && x.PreviousCursor != nil {
+		return *x.PreviousCursor
+	}
+	return ""
+}
+
+type GetTournamentUserHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Tournament    *string                `protobuf:"bytes,2,opt,name=tournament,proto3,oneof" json:"tournament,omitempty"`
+	Interval      *TournamentInterval    `protobuf:"varint,3,opt,name=interval,proto3,enum=api.TournamentInterval,oneof" json:"interval,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,4,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTournamentUserHistoryRequest) Reset() {
+	*x = GetTournamentUserHistoryRequest{}
+	mi := &file_tournament_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentUserHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentUserHistoryRequest) ProtoMessage() {}
+
+func (x *GetTournamentUserHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentUserHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentUserHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTournamentUserHistoryRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetTournamentUserHistoryRequest) GetTournament() string {
+	if x != nil && x.Tournament != nil {
+		return *x.Tournament
+	}
+	return ""
+}
+
+func (x *GetTournamentUserHistoryRequest) GetInterval() TournamentInterval {
+	if x != nil && x.Interval != nil {
+		return *x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *GetTournamentUserHistoryRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetTournamentUserHistoryResponse struct {
+	state           protoimpl.MessageState                 `protogen:"open.v1"`
+	Success         bool                                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TournamentUsers []*TournamentUser                      `protobuf:"bytes,2,rep,name=tournamentUsers,proto3" json:"tournamentUsers,omitempty"`
+	Error           GetTournamentUserHistoryResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentUserHistoryResponse_Error" json:"error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTournamentUserHistoryResponse) Reset() {
+	*x = GetTournamentUserHistoryResponse{}
+	mi := &file_tournament_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentUserHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentUserHistoryResponse) ProtoMessage() {}
+
+func (x *GetTournamentUserHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentUserHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentUserHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTournamentUserHistoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTournamentUserHistoryResponse) GetTournamentUsers() []*TournamentUser {
+	if x != nil {
+		return x.TournamentUsers
+	}
+	return nil
+}
+
+func (x *GetTournamentUserHistoryResponse) GetError() GetTournamentUserHistoryResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetTournamentUserHistoryResponse_NONE
+}
+
+type UpdateTournamentUserRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Tournament     *TournamentUserRequest `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Data           *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	Score          *int64                 `protobuf:"varint,3,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	IncrementScore *bool                  `protobuf:"varint,4,opt,name=incrementScore,proto3,oneof" json:"incrementScore,omitempty"`
+	ScoreMode      *TournamentScoreMode   `protobuf:"varint,5,opt,name=scoreMode,proto3,enum=api.TournamentScoreMode,oneof" json:"scoreMode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateTournamentUserRequest) Reset() {
+	*x = UpdateTournamentUserRequest{}
+	mi := &file_tournament_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTournamentUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTournamentUserRequest) ProtoMessage() {}
+
+func (x *UpdateTournamentUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTournamentUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTournamentUserRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateTournamentUserRequest) GetTournament() *TournamentUserRequest {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+func (x *UpdateTournamentUserRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateTournamentUserRequest) GetScore() int64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *UpdateTournamentUserRequest) GetIncrementScore() bool {
+	if x != nil && x.IncrementScore != nil {
+		return *x.IncrementScore
+	}
+	return false
+}
+
+func (x *UpdateTournamentUserRequest) GetScoreMode() TournamentScoreMode {
+	if x != nil && x.ScoreMode != nil {
+		return *x.ScoreMode
+	}
+	return TournamentScoreMode_SET
+}
+
+type UpdateTournamentUserResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Success       bool                               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         UpdateTournamentUserResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.UpdateTournamentUserResponse_Error" json:"error,omitempty"`
+	ScoreChanged  bool                               `protobuf:"varint,3,opt,name=scoreChanged,proto3" json:"scoreChanged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTournamentUserResponse) Reset() {
+	*x = UpdateTournamentUserResponse{}
+	mi := &file_tournament_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTournamentUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTournamentUserResponse) ProtoMessage() {}
+
+func (x *UpdateTournamentUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTournamentUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTournamentUserResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateTournamentUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateTournamentUserResponse) GetError() UpdateTournamentUserResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return UpdateTournamentUserResponse_NONE
+}
+
+func (x *UpdateTournamentUserResponse) GetScoreChanged() bool {
+	if x != nil {
+		return x.ScoreChanged
+	}
+	return false
+}
+
+type TournamentUser struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tournament          string                 `protobuf:"bytes,2,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	UserId              uint64                 `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	Interval            TournamentInterval     `protobuf:"varint,4,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	Score               int64                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	Ranking             uint64                 `protobuf:"varint,6,opt,name=ranking,proto3" json:"ranking,omitempty"`
+	Data                *structpb.Struct       `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	TournamentStartedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=tournamentStartedAt,proto3" json:"tournamentStartedAt,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	TeamId              *uint64                `protobuf:"varint,11,opt,name=teamId,proto3,oneof" json:"teamId,omitempty"`
+	SentToThirdPartyAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=sentToThirdPartyAt,proto3,oneof" json:"sentToThirdPartyAt,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TournamentUser) Reset() {
+	*x = TournamentUser{}
+	mi := &file_tournament_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentUser) ProtoMessage() {}
+
+func (x *TournamentUser) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentUser.ProtoReflect.Descriptor instead.
+func (*TournamentUser) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TournamentUser) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TournamentUser) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *TournamentUser) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TournamentUser) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *TournamentUser) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TournamentUser) GetRanking() uint64 {
+	if x != nil {
+		return x.Ranking
+	}
+	return 0
+}
+
+func (x *TournamentUser) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TournamentUser) GetTournamentStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TournamentStartedAt
+	}
+	return nil
+}
+
+func (x *TournamentUser) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TournamentUser) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TournamentUser) GetTeamId() uint64 {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return 0
+}
+
+func (x *TournamentUser) GetSentToThirdPartyAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentToThirdPartyAt
+	}
+	return nil
+}
+
+type CreateTournamentTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	TeamId        uint64                 `protobuf:"varint,3,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Score         *int64                 `protobuf:"varint,4,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTournamentTeamRequest) Reset() {
+	*x = CreateTournamentTeamRequest{}
+	mi := &file_tournament_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentTeamRequest) ProtoMessage() {}
+
+func (x *CreateTournamentTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentTeamRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateTournamentTeamRequest) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *CreateTournamentTeamRequest) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *CreateTournamentTeamRequest) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *CreateTournamentTeamRequest) GetScore() int64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *CreateTournamentTeamRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateTournamentTeamResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Success       bool                               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            *uint64                            `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Error         CreateTournamentTeamResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.CreateTournamentTeamResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTournamentTeamResponse) Reset() {
+	*x = CreateTournamentTeamResponse{}
+	mi := &file_tournament_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentTeamResponse) ProtoMessage() {}
+
+func (x *CreateTournamentTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentTeamResponse.ProtoReflect.Descriptor instead.
+func (*CreateTournamentTeamResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateTournamentTeamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateTournamentTeamResponse) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *CreateTournamentTeamResponse) GetError() CreateTournamentTeamResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return CreateTournamentTeamResponse_NONE
+}
+
+type TournamentIntervalTeamId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	TeamId        uint64                 `protobuf:"varint,3,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentIntervalTeamId) Reset() {
+	*x = TournamentIntervalTeamId{}
+	mi := &file_tournament_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentIntervalTeamId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentIntervalTeamId) ProtoMessage() {}
+
+func (x *TournamentIntervalTeamId) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentIntervalTeamId.ProtoReflect.Descriptor instead.
+func (*TournamentIntervalTeamId) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TournamentIntervalTeamId) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *TournamentIntervalTeamId) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *TournamentIntervalTeamId) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+type TournamentTeamRequest struct {
+	state                    protoimpl.MessageState    `protogen:"open.v1"`
+	Id                       *uint64                   `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	TournamentIntervalTeamId *TournamentIntervalTeamId `protobuf:"bytes,2,opt,name=tournamentIntervalTeamId,proto3,oneof" json:"tournamentIntervalTeamId,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *TournamentTeamRequest) Reset() {
+	*x = TournamentTeamRequest{}
+	mi := &file_tournament_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentTeamRequest) ProtoMessage() {}
+
+func (x *TournamentTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentTeamRequest.ProtoReflect.Descriptor instead.
+func (*TournamentTeamRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TournamentTeamRequest) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *TournamentTeamRequest) GetTournamentIntervalTeamId() *TournamentIntervalTeamId {
+	if x != nil {
+		return x.TournamentIntervalTeamId
+	}
+	return nil
+}
+
+type GetTournamentTeamResponse struct {
+	state          protoimpl.MessageState          `protogen:"open.v1"`
+	Success        bool                            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TournamentTeam *TournamentTeam                 `protobuf:"bytes,2,opt,name=tournamentTeam,proto3,oneof" json:"tournamentTeam,omitempty"`
+	Error          GetTournamentTeamResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentTeamResponse_Error" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTournamentTeamResponse) Reset() {
+	*x = GetTournamentTeamResponse{}
+	mi := &file_tournament_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentTeamResponse) ProtoMessage() {}
+
+func (x *GetTournamentTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentTeamResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetTournamentTeamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTournamentTeamResponse) GetTournamentTeam() *TournamentTeam {
+	if x != nil {
+		return x.TournamentTeam
+	}
+	return nil
+}
+
+func (x *GetTournamentTeamResponse) GetError() GetTournamentTeamResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetTournamentTeamResponse_NONE
+}
+
+type TournamentTeamResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Success       bool                         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         TournamentTeamResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.TournamentTeamResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentTeamResponse) Reset() {
+	*x = TournamentTeamResponse{}
+	mi := &file_tournament_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentTeamResponse) ProtoMessage() {}
+
+func (x *TournamentTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentTeamResponse.ProtoReflect.Descriptor instead.
+func (*TournamentTeamResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TournamentTeamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TournamentTeamResponse) GetError() TournamentTeamResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return TournamentTeamResponse_NONE
+}
+
+type GetTournamentTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    *string                `protobuf:"bytes,1,opt,name=tournament,proto3,oneof" json:"tournament,omitempty"`
+	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	TeamId        *uint64                `protobuf:"varint,3,opt,name=teamId,proto3,oneof" json:"teamId,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,4,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTournamentTeamsRequest) Reset() {
+	*x = GetTournamentTeamsRequest{}
+	mi := &file_tournament_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentTeamsRequest) ProtoMessage() {}
+
+func (x *GetTournamentTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentTeamsRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTournamentTeamsRequest) GetTournament() string {
+	if x != nil && x.Tournament != nil {
+		return *x.Tournament
+	}
+	return ""
+}
+
+func (x *GetTournamentTeamsRequest) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *GetTournamentTeamsRequest) GetTeamId() uint64 {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return 0
+}
+
+func (x *GetTournamentTeamsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetTournamentTeamsResponse struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Success         bool                             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TournamentTeams []*TournamentTeam                `protobuf:"bytes,2,rep,name=tournamentTeams,proto3" json:"tournamentTeams,omitempty"`
+	Error           GetTournamentTeamsResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentTeamsResponse_Error" json:"error,omitempty"`
+	NextCursor      *string                          `protobuf:"bytes,4,opt,name=nextCursor,proto3,oneof" json:"nextCursor,omitempty"`
+	PreviousCursor  *string                          `protobuf:"bytes,5,opt,name=previousCursor,proto3,oneof" json:"previousCursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTournamentTeamsResponse) Reset() {
+	*x = GetTournamentTeamsResponse{}
+	mi := &file_tournament_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentTeamsResponse) ProtoMessage() {}
+
+func (x *GetTournamentTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentTeamsResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTournamentTeamsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTournamentTeamsResponse) GetTournamentTeams() []*TournamentTeam {
+	if x != nil {
+		return x.TournamentTeams
+	}
+	return nil
+}
+
+func (x *GetTournamentTeamsResponse) GetError() GetTournamentTeamsResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetTournamentTeamsResponse_NONE
+}
+
+func (x *GetTournamentTeamsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetTournamentTeamsResponse) GetPreviousCursor() string {
+	if x != nil && x.PreviousCursor != nil {
+		return *x.PreviousCursor
+	}
+	return ""
+}
+
+type UpdateTournamentTeamRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Tournament     *TournamentTeamRequest `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Data           *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	Score          *int64                 `protobuf:"varint,3,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	IncrementScore *bool                  `protobuf:"varint,4,opt,name=incrementScore,proto3,oneof" json:"incrementScore,omitempty"`
+	ScoreMode      *TournamentScoreMode   `protobuf:"varint,5,opt,name=scoreMode,proto3,enum=api.TournamentScoreMode,oneof" json:"scoreMode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateTournamentTeamRequest) Reset() {
+	*x = UpdateTournamentTeamRequest{}
+	mi := &file_tournament_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTournamentTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTournamentTeamRequest) ProtoMessage() {}
+
+func (x *UpdateTournamentTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTournamentTeamRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTournamentTeamRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateTournamentTeamRequest) GetTournament() *TournamentTeamRequest {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+func (x *UpdateTournamentTeamRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateTournamentTeamRequest) GetScore() int64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *UpdateTournamentTeamRequest) GetIncrementScore() bool {
+	if x != nil && x.IncrementScore != nil {
+		return *x.IncrementScore
+	}
+	return false
+}
+
+func (x *UpdateTournamentTeamRequest) GetScoreMode() TournamentScoreMode {
+	if x != nil && x.ScoreMode != nil {
+		return *x.ScoreMode
+	}
+	return TournamentScoreMode_SET
+}
+
+type UpdateTournamentTeamResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Success       bool                               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         UpdateTournamentTeamResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.UpdateTournamentTeamResponse_Error" json:"error,omitempty"`
+	ScoreChanged  bool                               `protobuf:"varint,3,opt,name=scoreChanged,proto3" json:"scoreChanged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTournamentTeamResponse) Reset() {
+	*x = UpdateTournamentTeamResponse{}
+	mi := &file_tournament_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTournamentTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTournamentTeamResponse) ProtoMessage() {}
+
+func (x *UpdateTournamentTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTournamentTeamResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTournamentTeamResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateTournamentTeamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateTournamentTeamResponse) GetError() UpdateTournamentTeamResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return UpdateTournamentTeamResponse_NONE
+}
+
+func (x *UpdateTournamentTeamResponse) GetScoreChanged() bool {
+	if x != nil {
+		return x.ScoreChanged
+	}
+	return false
+}
+
+type TournamentTeam struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tournament          string                 `protobuf:"bytes,2,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	TeamId              uint64                 `protobuf:"varint,3,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Interval            TournamentInterval     `protobuf:"varint,4,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	Score               int64                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	Ranking             uint64                 `protobuf:"varint,6,opt,name=ranking,proto3" json:"ranking,omitempty"`
+	Data                *structpb.Struct       `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	TournamentStartedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=tournamentStartedAt,proto3" json:"tournamentStartedAt,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TournamentTeam) Reset() {
+	*x = TournamentTeam{}
+	mi := &file_tournament_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentTeam) ProtoMessage() {}
+
+func (x *TournamentTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentTeam.ProtoReflect.Descriptor instead.
+func (*TournamentTeam) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TournamentTeam) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TournamentTeam) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *TournamentTeam) GetTeamId() uint64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TournamentTeam) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *TournamentTeam) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TournamentTeam) GetRanking() uint64 {
+	if x != nil {
+		return x.Ranking
+	}
+	return 0
+}
+
+func (x *TournamentTeam) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TournamentTeam) GetTournamentStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TournamentStartedAt
+	}
+	return nil
+}
+
+func (x *TournamentTeam) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TournamentTeam) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateTournamentDefinitionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CronSchedule    *string                `protobuf:"bytes,2,opt,name=cronSchedule,proto3,oneof" json:"cronSchedule,omitempty"`
+	DurationSeconds *uint64                `protobuf:"varint,3,opt,name=durationSeconds,proto3,oneof" json:"durationSeconds,omitempty"`
+	StartsAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=endsAt,proto3,oneof" json:"endsAt,omitempty"`
+	TimeZone        *string                `protobuf:"bytes,6,opt,name=timeZone,proto3,oneof" json:"timeZone,omitempty"`
+	Data            *structpb.Struct       `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTournamentDefinitionRequest) Reset() {
+	*x = CreateTournamentDefinitionRequest{}
+	mi := &file_tournament_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentDefinitionRequest) ProtoMessage() {}
+
+func (x *CreateTournamentDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateTournamentDefinitionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTournamentDefinitionRequest) GetCronSchedule() string {
+	if x != nil && x.CronSchedule != nil {
+		return *x.CronSchedule
+	}
+	return ""
+}
+
+func (x *CreateTournamentDefinitionRequest) GetDurationSeconds() uint64 {
+	if x != nil && x.DurationSeconds != nil {
+		return *x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CreateTournamentDefinitionRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreateTournamentDefinitionRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreateTournamentDefinitionRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateTournamentDefinitionRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateTournamentDefinitionResponse struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Success       bool                                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            *uint64                                  `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Error         CreateTournamentDefinitionResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.CreateTournamentDefinitionResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTournamentDefinitionResponse) Reset() {
+	*x = CreateTournamentDefinitionResponse{}
+	mi := &file_tournament_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentDefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentDefinitionResponse) ProtoMessage() {}
+
+func (x *CreateTournamentDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*CreateTournamentDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateTournamentDefinitionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateTournamentDefinitionResponse) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *CreateTournamentDefinitionResponse) GetError() CreateTournamentDefinitionResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return CreateTournamentDefinitionResponse_NONE
+}
+
+type TournamentDefinitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentDefinitionRequest) Reset() {
+	*x = TournamentDefinitionRequest{}
+	mi := &file_tournament_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentDefinitionRequest) ProtoMessage() {}
+
+func (x *TournamentDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*TournamentDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TournamentDefinitionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetTournamentDefinitionResponse struct {
+	state                protoimpl.MessageState                `protogen:"open.v1"`
+	Success              bool                                  `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TournamentDefinition *TournamentDefinition                 `protobuf:"bytes,2,opt,name=tournamentDefinition,proto3,oneof" json:"tournamentDefinition,omitempty"`
+	Error                GetTournamentDefinitionResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentDefinitionResponse_Error" json:"error,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetTournamentDefinitionResponse) Reset() {
+	*x = GetTournamentDefinitionResponse{}
+	mi := &file_tournament_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentDefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentDefinitionResponse) ProtoMessage() {}
+
+func (x *GetTournamentDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTournamentDefinitionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTournamentDefinitionResponse) GetTournamentDefinition() *TournamentDefinition {
+	if x != nil {
+		return x.TournamentDefinition
+	}
+	return nil
+}
+
+func (x *GetTournamentDefinitionResponse) GetError() GetTournamentDefinitionResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetTournamentDefinitionResponse_NONE
+}
+
+type TournamentDefinitionResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Success       bool                               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         TournamentDefinitionResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.TournamentDefinitionResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentDefinitionResponse) Reset() {
+	*x = TournamentDefinitionResponse{}
+	mi := &file_tournament_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentDefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentDefinitionResponse) ProtoMessage() {}
+
+func (x *TournamentDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*TournamentDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TournamentDefinitionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TournamentDefinitionResponse) GetError() TournamentDefinitionResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return TournamentDefinitionResponse_NONE
+}
+
+type TournamentDefinition struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CronSchedule    *string                `protobuf:"bytes,3,opt,name=cronSchedule,proto3,oneof" json:"cronSchedule,omitempty"`
+	DurationSeconds *uint64                `protobuf:"varint,4,opt,name=durationSeconds,proto3,oneof" json:"durationSeconds,omitempty"`
+	StartsAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=endsAt,proto3,oneof" json:"endsAt,omitempty"`
+	TimeZone        string                 `protobuf:"bytes,7,opt,name=timeZone,proto3" json:"timeZone,omitempty"`
+	Data            *structpb.Struct       `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TournamentDefinition) Reset() {
+	*x = TournamentDefinition{}
+	mi := &file_tournament_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentDefinition) ProtoMessage() {}
+
+func (x *TournamentDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentDefinition.ProtoReflect.Descriptor instead.
+func (*TournamentDefinition) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TournamentDefinition) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TournamentDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentDefinition) GetCronSchedule() string {
+	if x != nil && x.CronSchedule != nil {
+		return *x.CronSchedule
+	}
+	return ""
+}
+
+func (x *TournamentDefinition) GetDurationSeconds() uint64 {
+	if x != nil && x.DurationSeconds != nil {
+		return *x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *TournamentDefinition) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *TournamentDefinition) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *TournamentDefinition) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *TournamentDefinition) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TournamentDefinition) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TournamentDefinition) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetTournamentWipeTimeRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Tournament              string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	DailyTournamentMinute   *uint32                `protobuf:"varint,2,opt,name=dailyTournamentMinute,proto3,oneof" json:"dailyTournamentMinute,omitempty"`
+	WeeklyTournamentMinute  *uint32                `protobuf:"varint,3,opt,name=weeklyTournamentMinute,proto3,oneof" json:"weeklyTournamentMinute,omitempty"`
+	WeeklyTournamentDay     *uint32                `protobuf:"varint,4,opt,name=weeklyTournamentDay,proto3,oneof" json:"weeklyTournamentDay,omitempty"`
+	MonthlyTournamentMinute *uint32                `protobuf:"varint,5,opt,name=monthlyTournamentMinute,proto3,oneof" json:"monthlyTournamentMinute,omitempty"`
+	MonthlyTournamentDay    *uint32                `protobuf:"varint,6,opt,name=monthlyTournamentDay,proto3,oneof" json:"monthlyTournamentDay,omitempty"`
+	TimeZone                *string                `protobuf:"bytes,7,opt,name=timeZone,proto3,oneof" json:"timeZone,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *SetTournamentWipeTimeRequest) Reset() {
+	*x = SetTournamentWipeTimeRequest{}
+	mi := &file_tournament_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTournamentWipeTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTournamentWipeTimeRequest) ProtoMessage() {}
+
+func (x *SetTournamentWipeTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTournamentWipeTimeRequest.ProtoReflect.Descriptor instead.
+func (*SetTournamentWipeTimeRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetTournamentWipeTimeRequest) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *SetTournamentWipeTimeRequest) GetDailyTournamentMinute() uint32 {
+	if x != nil && x.DailyTournamentMinute != nil {
+		return *x.DailyTournamentMinute
+	}
+	return 0
+}
+
+func (x *SetTournamentWipeTimeRequest) GetWeeklyTournamentMinute() uint32 {
+	if x != nil && x.WeeklyTournamentMinute != nil {
+		return *x.WeeklyTournamentMinute
+	}
+	return 0
+}
+
+func (x *SetTournamentWipeTimeRequest) GetWeeklyTournamentDay() uint32 {
+	if x != nil && x.WeeklyTournamentDay != nil {
+		return *x.WeeklyTournamentDay
+	}
+	return 0
+}
+
+func (x *SetTournamentWipeTimeRequest) GetMonthlyTournamentMinute() uint32 {
+	if x != nil && x.MonthlyTournamentMinute != nil {
+		return *x.MonthlyTournamentMinute
+	}
+	return 0
+}
+
+func (x *SetTournamentWipeTimeRequest) GetMonthlyTournamentDay() uint32 {
+	if x != nil && x.MonthlyTournamentDay != nil {
+		return *x.MonthlyTournamentDay
+	}
+	return 0
+}
+
+func (x *SetTournamentWipeTimeRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+type SetTournamentWipeTimeResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Success       bool                                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         SetTournamentWipeTimeResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.SetTournamentWipeTimeResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTournamentWipeTimeResponse) Reset() {
+	*x = SetTournamentWipeTimeResponse{}
+	mi := &file_tournament_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTournamentWipeTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTournamentWipeTimeResponse) ProtoMessage() {}
+
+func (x *SetTournamentWipeTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTournamentWipeTimeResponse.ProtoReflect.Descriptor instead.
+func (*SetTournamentWipeTimeResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetTournamentWipeTimeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetTournamentWipeTimeResponse) GetError() SetTournamentWipeTimeResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return SetTournamentWipeTimeResponse_NONE
+}
+
+type TournamentWipeTimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentWipeTimeRequest) Reset() {
+	*x = TournamentWipeTimeRequest{}
+	mi := &file_tournament_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentWipeTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentWipeTimeRequest) ProtoMessage() {}
+
+func (x *TournamentWipeTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentWipeTimeRequest.ProtoReflect.Descriptor instead.
+func (*TournamentWipeTimeRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TournamentWipeTimeRequest) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+type GetTournamentWipeTimeResponse struct {
+	state              protoimpl.MessageState              `protogen:"open.v1"`
+	Success            bool                                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TournamentWipeTime *TournamentWipeTime                 `protobuf:"bytes,2,opt,name=tournamentWipeTime,proto3,oneof" json:"tournamentWipeTime,omitempty"`
+	Error              GetTournamentWipeTimeResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentWipeTimeResponse_Error" json:"error,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetTournamentWipeTimeResponse) Reset() {
+	*x = GetTournamentWipeTimeResponse{}
+	mi := &file_tournament_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentWipeTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentWipeTimeResponse) ProtoMessage() {}
+
+func (x *GetTournamentWipeTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentWipeTimeResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentWipeTimeResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTournamentWipeTimeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTournamentWipeTimeResponse) GetTournamentWipeTime() *TournamentWipeTime {
+	if x != nil {
+		return x.TournamentWipeTime
+	}
+	return nil
+}
+
+func (x *GetTournamentWipeTimeResponse) GetError() GetTournamentWipeTimeResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetTournamentWipeTimeResponse_NONE
+}
+
+type TournamentWipeTimeResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Success       bool                             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         TournamentWipeTimeResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.TournamentWipeTimeResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentWipeTimeResponse) Reset() {
+	*x = TournamentWipeTimeResponse{}
+	mi := &file_tournament_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentWipeTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentWipeTimeResponse) ProtoMessage() {}
+
+func (x *TournamentWipeTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentWipeTimeResponse.ProtoReflect.Descriptor instead.
+func (*TournamentWipeTimeResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TournamentWipeTimeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TournamentWipeTimeResponse) GetError() TournamentWipeTimeResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return TournamentWipeTimeResponse_NONE
+}
+
+type TournamentWipeTime struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tournament              string                 `protobuf:"bytes,2,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	DailyTournamentMinute   uint32                 `protobuf:"varint,3,opt,name=dailyTournamentMinute,proto3" json:"dailyTournamentMinute,omitempty"`
+	WeeklyTournamentMinute  uint32                 `protobuf:"varint,4,opt,name=weeklyTournamentMinute,proto3" json:"weeklyTournamentMinute,omitempty"`
+	WeeklyTournamentDay     uint32                 `protobuf:"varint,5,opt,name=weeklyTournamentDay,proto3" json:"weeklyTournamentDay,omitempty"`
+	MonthlyTournamentMinute uint32                 `protobuf:"varint,6,opt,name=monthlyTournamentMinute,proto3" json:"monthlyTournamentMinute,omitempty"`
+	MonthlyTournamentDay    uint32                 `protobuf:"varint,7,opt,name=monthlyTournamentDay,proto3" json:"monthlyTournamentDay,omitempty"`
+	TimeZone                string                 `protobuf:"bytes,8,opt,name=timeZone,proto3" json:"timeZone,omitempty"`
+	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt               *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *TournamentWipeTime) Reset() {
+	*x = TournamentWipeTime{}
+	mi := &file_tournament_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentWipeTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentWipeTime) ProtoMessage() {}
+
+func (x *TournamentWipeTime) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentWipeTime.ProtoReflect.Descriptor instead.
+func (*TournamentWipeTime) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TournamentWipeTime) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TournamentWipeTime) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *TournamentWipeTime) GetDailyTournamentMinute() uint32 {
+	if x != nil {
+		return x.DailyTournamentMinute
+	}
+	return 0
+}
+
+func (x *TournamentWipeTime) GetWeeklyTournamentMinute() uint32 {
+	if x != nil {
+		return x.WeeklyTournamentMinute
+	}
+	return 0
+}
+
+func (x *TournamentWipeTime) GetWeeklyTournamentDay() uint32 {
+	if x != nil {
+		return x.WeeklyTournamentDay
+	}
+	return 0
+}
+
+func (x *TournamentWipeTime) GetMonthlyTournamentMinute() uint32 {
+	if x != nil {
+		return x.MonthlyTournamentMinute
+	}
+	return 0
+}
+
+func (x *TournamentWipeTime) GetMonthlyTournamentDay() uint32 {
+	if x != nil {
+		return x.MonthlyTournamentDay
+	}
+	return 0
+}
+
+func (x *TournamentWipeTime) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *TournamentWipeTime) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TournamentWipeTime) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateTournamentRewardTierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	MinRanking    *uint64                `protobuf:"varint,3,opt,name=minRanking,proto3,oneof" json:"minRanking,omitempty"`
+	MaxRanking    *uint64                `protobuf:"varint,4,opt,name=maxRanking,proto3,oneof" json:"maxRanking,omitempty"`
+	TopPercentage *float64               `protobuf:"fixed64,5,opt,name=topPercentage,proto3,oneof" json:"topPercentage,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTournamentRewardTierRequest) Reset() {
+	*x = CreateTournamentRewardTierRequest{}
+	mi := &file_tournament_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentRewardTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentRewardTierRequest) ProtoMessage() {}
+
+func (x *CreateTournamentRewardTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentRewardTierRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRewardTierRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateTournamentRewardTierRequest) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *CreateTournamentRewardTierRequest) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *CreateTournamentRewardTierRequest) GetMinRanking() uint64 {
+	if x != nil && x.MinRanking != nil {
+		return *x.MinRanking
+	}
+	return 0
+}
+
+func (x *CreateTournamentRewardTierRequest) GetMaxRanking() uint64 {
+	if x != nil && x.MaxRanking != nil {
+		return *x.MaxRanking
+	}
+	return 0
+}
+
+func (x *CreateTournamentRewardTierRequest) GetTopPercentage() float64 {
+	if x != nil && x.TopPercentage != nil {
+		return *x.TopPercentage
+	}
+	return 0
+}
+
+func (x *CreateTournamentRewardTierRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateTournamentRewardTierResponse struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Success       bool                                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            *uint64                                  `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Error         CreateTournamentRewardTierResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.CreateTournamentRewardTierResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTournamentRewardTierResponse) Reset() {
+	*x = CreateTournamentRewardTierResponse{}
+	mi := &file_tournament_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentRewardTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentRewardTierResponse) ProtoMessage() {}
+
+func (x *CreateTournamentRewardTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentRewardTierResponse.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRewardTierResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateTournamentRewardTierResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateTournamentRewardTierResponse) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *CreateTournamentRewardTierResponse) GetError() CreateTournamentRewardTierResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return CreateTournamentRewardTierResponse_NONE
+}
+
+type GetTournamentRewardTiersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTournamentRewardTiersRequest) Reset() {
+	*x = GetTournamentRewardTiersRequest{}
+	mi := &file_tournament_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentRewardTiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentRewardTiersRequest) ProtoMessage() {}
+
+func (x *GetTournamentRewardTiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentRewardTiersRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentRewardTiersRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetTournamentRewardTiersRequest) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *GetTournamentRewardTiersRequest) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+type GetTournamentRewardTiersResponse struct {
+	state                 protoimpl.MessageState                 `protogen:"open.v1"`
+	Success               bool                                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TournamentRewardTiers []*TournamentRewardTier                `protobuf:"bytes,2,rep,name=tournamentRewardTiers,proto3" json:"tournamentRewardTiers,omitempty"`
+	Error                 GetTournamentRewardTiersResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentRewardTiersResponse_Error" json:"error,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetTournamentRewardTiersResponse) Reset() {
+	*x = GetTournamentRewardTiersResponse{}
+	mi := &file_tournament_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentRewardTiersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentRewardTiersResponse) ProtoMessage() {}
+
+func (x *GetTournamentRewardTiersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentRewardTiersResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentRewardTiersResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTournamentRewardTiersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTournamentRewardTiersResponse) GetTournamentRewardTiers() []*TournamentRewardTier {
+	if x != nil {
+		return x.TournamentRewardTiers
+	}
+	return nil
+}
+
+func (x *GetTournamentRewardTiersResponse) GetError() GetTournamentRewardTiersResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetTournamentRewardTiersResponse_NONE
+}
+
+type TournamentRewardTierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentRewardTierRequest) Reset() {
+	*x = TournamentRewardTierRequest{}
+	mi := &file_tournament_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentRewardTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentRewardTierRequest) ProtoMessage() {}
+
+func (x *TournamentRewardTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentRewardTierRequest.ProtoReflect.Descriptor instead.
+func (*TournamentRewardTierRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TournamentRewardTierRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type TournamentRewardTierResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Success       bool                               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         TournamentRewardTierResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.TournamentRewardTierResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentRewardTierResponse) Reset() {
+	*x = TournamentRewardTierResponse{}
+	mi := &file_tournament_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentRewardTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentRewardTierResponse) ProtoMessage() {}
+
+func (x *TournamentRewardTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentRewardTierResponse.ProtoReflect.Descriptor instead.
+func (*TournamentRewardTierResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TournamentRewardTierResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TournamentRewardTierResponse) GetError() TournamentRewardTierResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return TournamentRewardTierResponse_NONE
+}
+
+type TournamentRewardTier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tournament    string                 `protobuf:"bytes,2,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval      TournamentInterval     `protobuf:"varint,3,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	MinRanking    *uint64                `protobuf:"varint,4,opt,name=minRanking,proto3,oneof" json:"minRanking,omitempty"`
+	MaxRanking    *uint64                `protobuf:"varint,5,opt,name=maxRanking,proto3,oneof" json:"maxRanking,omitempty"`
+	TopPercentage *float64               `protobuf:"fixed64,6,opt,name=topPercentage,proto3,oneof" json:"topPercentage,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentRewardTier) Reset() {
+	*x = TournamentRewardTier{}
+	mi := &file_tournament_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentRewardTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentRewardTier) ProtoMessage() {}
+
+func (x *TournamentRewardTier) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentRewardTier.ProtoReflect.Descriptor instead.
+func (*TournamentRewardTier) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TournamentRewardTier) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TournamentRewardTier) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *TournamentRewardTier) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *TournamentRewardTier) GetMinRanking() uint64 {
+	if x != nil && x.MinRanking != nil {
+		return *x.MinRanking
+	}
+	return 0
+}
+
+func (x *TournamentRewardTier) GetMaxRanking() uint64 {
+	if x != nil && x.MaxRanking != nil {
+		return *x.MaxRanking
+	}
+	return 0
+}
+
+func (x *TournamentRewardTier) GetTopPercentage() float64 {
+	if x != nil && x.TopPercentage != nil {
+		return *x.TopPercentage
+	}
+	return 0
+}
+
+func (x *TournamentRewardTier) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TournamentRewardTier) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TournamentRewardTier) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetTournamentRewardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Claimed       *bool                  `protobuf:"varint,2,opt,name=claimed,proto3,oneof" json:"claimed,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,3,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTournamentRewardsRequest) Reset() {
+	*x = GetTournamentRewardsRequest{}
+	mi := &file_tournament_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentRewardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentRewardsRequest) ProtoMessage() {}
+
+func (x *GetTournamentRewardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentRewardsRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentRewardsRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetTournamentRewardsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetTournamentRewardsRequest) GetClaimed() bool {
+	if x != nil && x.Claimed != nil {
+		return *x.Claimed
+	}
+	return false
+}
+
+func (x *GetTournamentRewardsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetTournamentRewardsResponse struct {
+	state             protoimpl.MessageState             `protogen:"open.v1"`
+	Success           bool                               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TournamentRewards []*TournamentReward                `protobuf:"bytes,2,rep,name=tournamentRewards,proto3" json:"tournamentRewards,omitempty"`
+	Error             GetTournamentRewardsResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentRewardsResponse_Error" json:"error,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetTournamentRewardsResponse) Reset() {
+	*x = GetTournamentRewardsResponse{}
+	mi := &file_tournament_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentRewardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentRewardsResponse) ProtoMessage() {}
+
+func (x *GetTournamentRewardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentRewardsResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentRewardsResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetTournamentRewardsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTournamentRewardsResponse) GetTournamentRewards() []*TournamentReward {
+	if x != nil {
+		return x.TournamentRewards
+	}
+	return nil
+}
+
+func (x *GetTournamentRewardsResponse) GetError() GetTournamentRewardsResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetTournamentRewardsResponse_NONE
+}
+
+type ClaimTournamentRewardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimTournamentRewardRequest) Reset() {
+	*x = ClaimTournamentRewardRequest{}
+	mi := &file_tournament_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimTournamentRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimTournamentRewardRequest) ProtoMessage() {}
+
+func (x *ClaimTournamentRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimTournamentRewardRequest.ProtoReflect.Descriptor instead.
+func (*ClaimTournamentRewardRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ClaimTournamentRewardRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ClaimTournamentRewardRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ClaimTournamentRewardResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Success       bool                                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         ClaimTournamentRewardResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.ClaimTournamentRewardResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimTournamentRewardResponse) Reset() {
+	*x = ClaimTournamentRewardResponse{}
+	mi := &file_tournament_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimTournamentRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimTournamentRewardResponse) ProtoMessage() {}
+
+func (x *ClaimTournamentRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimTournamentRewardResponse.ProtoReflect.Descriptor instead.
+func (*ClaimTournamentRewardResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ClaimTournamentRewardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ClaimTournamentRewardResponse) GetError() ClaimTournamentRewardResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return ClaimTournamentRewardResponse_NONE
+}
+
+type TournamentReward struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TierId              uint64                 `protobuf:"varint,2,opt,name=tierId,proto3" json:"tierId,omitempty"`
+	Tournament          string                 `protobuf:"bytes,3,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval            TournamentInterval     `protobuf:"varint,4,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	TournamentStartedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=tournamentStartedAt,proto3" json:"tournamentStartedAt,omitempty"`
+	UserId              uint64                 `protobuf:"varint,6,opt,name=userId,proto3" json:"userId,omitempty"`
+	Ranking             uint64                 `protobuf:"varint,7,opt,name=ranking,proto3" json:"ranking,omitempty"`
+	Score               int64                  `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`
+	Data                *structpb.Struct       `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	ClaimedAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=claimedAt,proto3,oneof" json:"claimedAt,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TournamentReward) Reset() {
+	*x = TournamentReward{}
+	mi := &file_tournament_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentReward) ProtoMessage() {}
+
+func (x *TournamentReward) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentReward.ProtoReflect.Descriptor instead.
+func (*TournamentReward) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TournamentReward) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TournamentReward) GetTierId() uint64 {
+	if x != nil {
+		return x.TierId
+	}
+	return 0
+}
+
+func (x *TournamentReward) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *TournamentReward) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *TournamentReward) GetTournamentStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TournamentStartedAt
+	}
+	return nil
+}
+
+func (x *TournamentReward) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TournamentReward) GetRanking() uint64 {
+	if x != nil {
+		return x.Ranking
+	}
+	return 0
+}
+
+func (x *TournamentReward) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TournamentReward) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TournamentReward) GetClaimedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClaimedAt
+	}
+	return nil
+}
+
+func (x *TournamentReward) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SubmitTournamentScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	Score         int64                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	ScoreMode     TournamentScoreMode    `protobuf:"varint,5,opt,name=scoreMode,proto3,enum=api.TournamentScoreMode" json:"scoreMode,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,6,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	TeamId        *uint64                `protobuf:"varint,7,opt,name=teamId,proto3,oneof" json:"teamId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTournamentScoreRequest) Reset() {
+	*x = SubmitTournamentScoreRequest{}
+	mi := &file_tournament_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTournamentScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTournamentScoreRequest) ProtoMessage() {}
+
+func (x *SubmitTournamentScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTournamentScoreRequest.ProtoReflect.Descriptor instead.
+func (*SubmitTournamentScoreRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SubmitTournamentScoreRequest) GetTournament() string {
+	if x != nil {
+		return x.Tournament
+	}
+	return ""
+}
+
+func (x *SubmitTournamentScoreRequest) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *SubmitTournamentScoreRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubmitTournamentScoreRequest) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SubmitTournamentScoreRequest) GetScoreMode() TournamentScoreMode {
+	if x != nil {
+		return x.ScoreMode
+	}
+	return TournamentScoreMode_SET
+}
+
+func (x *SubmitTournamentScoreRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SubmitTournamentScoreRequest) GetTeamId() uint64 {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return 0
+}
+
+type SubmitTournamentScoreResponse struct {
+	state          protoimpl.MessageState              `protogen:"open.v1"`
+	Success        bool                                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TournamentUser *TournamentUser                     `protobuf:"bytes,2,opt,name=tournamentUser,proto3,oneof" json:"tournamentUser,omitempty"`
+	Error          SubmitTournamentScoreResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.SubmitTournamentScoreResponse_Error" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitTournamentScoreResponse) Reset() {
+	*x = SubmitTournamentScoreResponse{}
+	mi := &file_tournament_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTournamentScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTournamentScoreResponse) ProtoMessage() {}
+
+func (x *SubmitTournamentScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTournamentScoreResponse.ProtoReflect.Descriptor instead.
+func (*SubmitTournamentScoreResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SubmitTournamentScoreResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SubmitTournamentScoreResponse) GetTournamentUser() *TournamentUser {
+	if x != nil {
+		return x.TournamentUser
+	}
+	return nil
+}
+
+func (x *SubmitTournamentScoreResponse) GetError() SubmitTournamentScoreResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return SubmitTournamentScoreResponse_NONE
+}
+
+type CreateTournamentBracketRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Name               string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Format             TournamentBracketFormat  `protobuf:"varint,2,opt,name=format,proto3,enum=api.TournamentBracketFormat" json:"format,omitempty"`
+	UserIds            []uint64                 `protobuf:"varint,3,rep,packed,name=userIds,proto3" json:"userIds,omitempty"`
+	Seeding            TournamentBracketSeeding `protobuf:"varint,4,opt,name=seeding,proto3,enum=api.TournamentBracketSeeding" json:"seeding,omitempty"`
+	Tournament         *string                  `protobuf:"bytes,5,opt,name=tournament,proto3,oneof" json:"tournament,omitempty"`
+	Interval           TournamentInterval       `protobuf:"varint,6,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	MatchmakingArenaId *uint64                  `protobuf:"varint,7,opt,name=matchmakingArenaId,proto3,oneof" json:"matchmakingArenaId,omitempty"`
+	Data               *structpb.Struct         `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateTournamentBracketRequest) Reset() {
+	*x = CreateTournamentBracketRequest{}
+	mi := &file_tournament_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentBracketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentBracketRequest) ProtoMessage() {}
+
+func (x *CreateTournamentBracketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentBracketRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentBracketRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateTournamentBracketRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTournamentBracketRequest) GetFormat() TournamentBracketFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TournamentBracketFormat_SINGLE_ELIMINATION
+}
+
+func (x *CreateTournamentBracketRequest) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *CreateTournamentBracketRequest) GetSeeding() TournamentBracketSeeding {
+	if x != nil {
+		return x.Seeding
+	}
+	return TournamentBracketSeeding_GIVEN_ORDER
+}
+
+func (x *CreateTournamentBracketRequest) GetTournament() string {
+	if x != nil && x.Tournament != nil {
+		return *x.Tournament
+	}
+	return ""
+}
+
+func (x *CreateTournamentBracketRequest) GetInterval() TournamentInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return TournamentInterval_DAILY
+}
+
+func (x *CreateTournamentBracketRequest) GetMatchmakingArenaId() uint64 {
+	if x != nil && x.MatchmakingArenaId != nil {
+		return *x.MatchmakingArenaId
+	}
+	return 0
+}
+
+func (x *CreateTournamentBracketRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateTournamentBracketResponse struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Success       bool                                  `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            *uint64                               `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Error         CreateTournamentBracketResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.CreateTournamentBracketResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTournamentBracketResponse) Reset() {
+	*x = CreateTournamentBracketResponse{}
+	mi := &file_tournament_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentBracketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentBracketResponse) ProtoMessage() {}
+
+func (x *CreateTournamentBracketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentBracketResponse.ProtoReflect.Descriptor instead.
+func (*CreateTournamentBracketResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateTournamentBracketResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateTournamentBracketResponse) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *CreateTournamentBracketResponse) GetError() CreateTournamentBracketResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return CreateTournamentBracketResponse_NONE
+}
+
+type TournamentBracketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentBracketRequest) Reset() {
+	*x = TournamentBracketRequest{}
+	mi := &file_tournament_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentBracketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentBracketRequest) ProtoMessage() {}
+
+func (x *TournamentBracketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentBracketRequest.ProtoReflect.Descriptor instead.
+func (*TournamentBracketRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TournamentBracketRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTournamentBracketResponse struct {
+	state             protoimpl.MessageState             `protogen:"open.v1"`
+	Success           bool                               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TournamentBracket *TournamentBracket                 `protobuf:"bytes,2,opt,name=tournamentBracket,proto3,oneof" json:"tournamentBracket,omitempty"`
+	Error             GetTournamentBracketResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentBracketResponse_Error" json:"error,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetTournamentBracketResponse) Reset() {
+	*x = GetTournamentBracketResponse{}
+	mi := &file_tournament_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentBracketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentBracketResponse) ProtoMessage() {}
+
+func (x *GetTournamentBracketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentBracketResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentBracketResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetTournamentBracketResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTournamentBracketResponse) GetTournamentBracket() *TournamentBracket {
+	if x != nil {
+		return x.TournamentBracket
+	}
+	return nil
+}
+
+func (x *GetTournamentBracketResponse) GetError() GetTournamentBracketResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetTournamentBracketResponse_NONE
+}
+
+type TournamentBracketResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Success       bool                            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         TournamentBracketResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.TournamentBracketResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentBracketResponse) Reset() {
+	*x = TournamentBracketResponse{}
+	mi := &file_tournament_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentBracketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentBracketResponse) ProtoMessage() {}
+
+func (x *TournamentBracketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTournamentUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateTournamentUserRequest) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use TournamentBracketResponse.ProtoReflect.Descriptor instead.
+func (*TournamentBracketResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CreateTournamentUserRequest) GetTournament() string {
+func (x *TournamentBracketResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Tournament
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *CreateTournamentUserRequest) GetInterval() TournamentInterval {
+func (x *TournamentBracketResponse) GetError() TournamentBracketResponse_Error {
 	if x != nil {
-		return x.Interval
+		return x.Error
 	}
-	return TournamentInterval_DAILY
+	return TournamentBracketResponse_NONE
 }
 
-func (x *CreateTournamentUserRequest) GetUserId() uint64 {
+type ReportTournamentBracketMatchResultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       uint64                 `protobuf:"varint,1,opt,name=matchId,proto3" json:"matchId,omitempty"`
+	WinnerUserId  uint64                 `protobuf:"varint,2,opt,name=winnerUserId,proto3" json:"winnerUserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTournamentBracketMatchResultRequest) Reset() {
+	*x = ReportTournamentBracketMatchResultRequest{}
+	mi := &file_tournament_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTournamentBracketMatchResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTournamentBracketMatchResultRequest) ProtoMessage() {}
+
+func (x *ReportTournamentBracketMatchResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[55]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTournamentBracketMatchResultRequest.ProtoReflect.Descriptor instead.
+func (*ReportTournamentBracketMatchResultRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReportTournamentBracketMatchResultRequest) GetMatchId() uint64 {
+	if x != nil {
+		return x.MatchId
 	}
 	return 0
 }
 
-func (x *CreateTournamentUserRequest) GetScore() int64 {
-	if x != nil && x.Score != nil {
-		return *x.Score
+func (x *ReportTournamentBracketMatchResultRequest) GetWinnerUserId() uint64 {
+	if x != nil {
+		return x.WinnerUserId
 	}
 	return 0
 }
 
-func (x *CreateTournamentUserRequest) GetData() *structpb.Struct {
+type ReportTournamentBracketMatchResultResponse struct {
+	state         protoimpl.MessageState                           `protogen:"open.v1"`
+	Success       bool                                             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	BracketEnded  bool                                             `protobuf:"varint,2,opt,name=bracketEnded,proto3" json:"bracketEnded,omitempty"`
+	Error         ReportTournamentBracketMatchResultResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.ReportTournamentBracketMatchResultResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTournamentBracketMatchResultResponse) Reset() {
+	*x = ReportTournamentBracketMatchResultResponse{}
+	mi := &file_tournament_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTournamentBracketMatchResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTournamentBracketMatchResultResponse) ProtoMessage() {}
+
+func (x *ReportTournamentBracketMatchResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTournamentBracketMatchResultResponse.ProtoReflect.Descriptor instead.
+func (*ReportTournamentBracketMatchResultResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReportTournamentBracketMatchResultResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReportTournamentBracketMatchResultResponse) GetBracketEnded() bool {
+	if x != nil {
+		return x.BracketEnded
+	}
+	return false
+}
+
+func (x *ReportTournamentBracketMatchResultResponse) GetError() ReportTournamentBracketMatchResultResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return ReportTournamentBracketMatchResultResponse_NONE
+}
+
+type TournamentBracket struct {
+	state              protoimpl.MessageState          `protogen:"open.v1"`
+	Id                 uint64                          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Format             TournamentBracketFormat         `protobuf:"varint,3,opt,name=format,proto3,enum=api.TournamentBracketFormat" json:"format,omitempty"`
+	MatchmakingArenaId *uint64                         `protobuf:"varint,4,opt,name=matchmakingArenaId,proto3,oneof" json:"matchmakingArenaId,omitempty"`
+	WinnerUserId       *uint64                         `protobuf:"varint,5,opt,name=winnerUserId,proto3,oneof" json:"winnerUserId,omitempty"`
+	Participants       []*TournamentBracketParticipant `protobuf:"bytes,6,rep,name=participants,proto3" json:"participants,omitempty"`
+	Matches            []*TournamentBracketMatch       `protobuf:"bytes,7,rep,name=matches,proto3" json:"matches,omitempty"`
+	Data               *structpb.Struct                `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	EndedAt            *timestamppb.Timestamp          `protobuf:"bytes,9,opt,name=endedAt,proto3,oneof" json:"endedAt,omitempty"`
+	CreatedAt          *timestamppb.Timestamp          `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp          `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TournamentBracket) Reset() {
+	*x = TournamentBracket{}
+	mi := &file_tournament_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentBracket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentBracket) ProtoMessage() {}
+
+func (x *TournamentBracket) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentBracket.ProtoReflect.Descriptor instead.
+func (*TournamentBracket) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TournamentBracket) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TournamentBracket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentBracket) GetFormat() TournamentBracketFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TournamentBracketFormat_SINGLE_ELIMINATION
+}
+
+func (x *TournamentBracket) GetMatchmakingArenaId() uint64 {
+	if x != nil && x.MatchmakingArenaId != nil {
+		return *x.MatchmakingArenaId
+	}
+	return 0
+}
+
+func (x *TournamentBracket) GetWinnerUserId() uint64 {
+	if x != nil && x.WinnerUserId != nil {
+		return *x.WinnerUserId
+	}
+	return 0
+}
+
+func (x *TournamentBracket) GetParticipants() []*TournamentBracketParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *TournamentBracket) GetMatches() []*TournamentBracketMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *TournamentBracket) GetData() *structpb.Struct {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type CreateTournamentUserResponse struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	Success       bool                               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Id            *uint64                            `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Error         CreateTournamentUserResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.CreateTournamentUserResponse_Error" json:"error,omitempty"`
+func (x *TournamentBracket) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *TournamentBracket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TournamentBracket) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type TournamentBracketParticipant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Seed          uint32                 `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	SeedValue     *int64                 `protobuf:"varint,3,opt,name=seedValue,proto3,oneof" json:"seedValue,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTournamentUserResponse) Reset() {
-	*x = CreateTournamentUserResponse{}
-	mi := &file_tournament_proto_msgTypes[1]
+func (x *TournamentBracketParticipant) Reset() {
+	*x = TournamentBracketParticipant{}
+	mi := &file_tournament_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentBracketParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentBracketParticipant) ProtoMessage() {}
+
+func (x *TournamentBracketParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentBracketParticipant.ProtoReflect.Descriptor instead.
+func (*TournamentBracketParticipant) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *TournamentBracketParticipant) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TournamentBracketParticipant) GetSeed() uint32 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *TournamentBracketParticipant) GetSeedValue() int64 {
+	if x != nil && x.SeedValue != nil {
+		return *x.SeedValue
+	}
+	return 0
+}
+
+type TournamentBracketMatch struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Side               TournamentBracketSide  `protobuf:"varint,2,opt,name=side,proto3,enum=api.TournamentBracketSide" json:"side,omitempty"`
+	Round              uint32                 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Position           uint32                 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	FirstUserId        *uint64                `protobuf:"varint,5,opt,name=firstUserId,proto3,oneof" json:"firstUserId,omitempty"`
+	SecondUserId       *uint64                `protobuf:"varint,6,opt,name=secondUserId,proto3,oneof" json:"secondUserId,omitempty"`
+	WinnerUserId       *uint64                `protobuf:"varint,7,opt,name=winnerUserId,proto3,oneof" json:"winnerUserId,omitempty"`
+	WinnerMatchId      *uint64                `protobuf:"varint,8,opt,name=winnerMatchId,proto3,oneof" json:"winnerMatchId,omitempty"`
+	LoserMatchId       *uint64                `protobuf:"varint,9,opt,name=loserMatchId,proto3,oneof" json:"loserMatchId,omitempty"`
+	MatchmakingMatchId *uint64                `protobuf:"varint,10,opt,name=matchmakingMatchId,proto3,oneof" json:"matchmakingMatchId,omitempty"`
+	CompletedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=completedAt,proto3,oneof" json:"completedAt,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TournamentBracketMatch) Reset() {
+	*x = TournamentBracketMatch{}
+	mi := &file_tournament_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTournamentUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TournamentBracketMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentBracketMatch) ProtoMessage() {}
+
+func (x *TournamentBracketMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentBracketMatch.ProtoReflect.Descriptor instead.
+func (*TournamentBracketMatch) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TournamentBracketMatch) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TournamentBracketMatch) GetSide() TournamentBracketSide {
+	if x != nil {
+		return x.Side
+	}
+	return TournamentBracketSide_WINNERS
+}
+
+func (x *TournamentBracketMatch) GetRound() uint32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *TournamentBracketMatch) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *TournamentBracketMatch) GetFirstUserId() uint64 {
+	if x != nil && x.FirstUserId != nil {
+		return *x.FirstUserId
+	}
+	return 0
+}
+
+func (x *TournamentBracketMatch) GetSecondUserId() uint64 {
+	if x != nil && x.SecondUserId != nil {
+		return *x.SecondUserId
+	}
+	return 0
 }
 
-func (*CreateTournamentUserResponse) ProtoMessage() {}
-
-func (x *CreateTournamentUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TournamentBracketMatch) GetWinnerUserId() uint64 {
+	if x != nil && x.WinnerUserId != nil {
+		return *x.WinnerUserId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateTournamentUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateTournamentUserResponse) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{1}
+func (x *TournamentBracketMatch) GetWinnerMatchId() uint64 {
+	if x != nil && x.WinnerMatchId != nil {
+		return *x.WinnerMatchId
+	}
+	return 0
 }
 
-func (x *CreateTournamentUserResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
+func (x *TournamentBracketMatch) GetLoserMatchId() uint64 {
+	if x != nil && x.LoserMatchId != nil {
+		return *x.LoserMatchId
 	}
-	return false
+	return 0
 }
 
-func (x *CreateTournamentUserResponse) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *TournamentBracketMatch) GetMatchmakingMatchId() uint64 {
+	if x != nil && x.MatchmakingMatchId != nil {
+		return *x.MatchmakingMatchId
 	}
 	return 0
 }
 
-func (x *CreateTournamentUserResponse) GetError() CreateTournamentUserResponse_Error {
+func (x *TournamentBracketMatch) GetCompletedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Error
+		return x.CompletedAt
 	}
-	return CreateTournamentUserResponse_NONE
+	return nil
 }
 
-type TournamentIntervalUserId struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tournament    string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
-	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
-	UserId        uint64                 `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
+type CreateTournamentRoundRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Tournament    string                  `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval      TournamentInterval      `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	Format        TournamentPairingFormat `protobuf:"varint,3,opt,name=format,proto3,enum=api.TournamentPairingFormat" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TournamentIntervalUserId) Reset() {
-	*x = TournamentIntervalUserId{}
-	mi := &file_tournament_proto_msgTypes[2]
+func (x *CreateTournamentRoundRequest) Reset() {
+	*x = CreateTournamentRoundRequest{}
+	mi := &file_tournament_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentIntervalUserId) String() string {
+func (x *CreateTournamentRoundRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentIntervalUserId) ProtoMessage() {}
+func (*CreateTournamentRoundRequest) ProtoMessage() {}
 
-func (x *TournamentIntervalUserId) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[2]
+func (x *CreateTournamentRoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,55 +6389,57 @@ func (x *TournamentIntervalUserId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentIntervalUserId.ProtoReflect.Descriptor instead.
-func (*TournamentIntervalUserId) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CreateTournamentRoundRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRoundRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *TournamentIntervalUserId) GetTournament() string {
+func (x *CreateTournamentRoundRequest) GetTournament() string {
 	if x != nil {
 		return x.Tournament
 	}
 	return ""
 }
 
-func (x *TournamentIntervalUserId) GetInterval() TournamentInterval {
+func (x *CreateTournamentRoundRequest) GetInterval() TournamentInterval {
 	if x != nil {
 		return x.Interval
 	}
 	return TournamentInterval_DAILY
 }
 
-func (x *TournamentIntervalUserId) GetUserId() uint64 {
+func (x *CreateTournamentRoundRequest) GetFormat() TournamentPairingFormat {
 	if x != nil {
-		return x.UserId
+		return x.Format
 	}
-	return 0
+	return TournamentPairingFormat_SWISS
 }
 
-type TournamentUserRequest struct {
-	state                    protoimpl.MessageState    `protogen:"open.v1"`
-	Id                       *uint64                   `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	TournamentIntervalUserId *TournamentIntervalUserId `protobuf:"bytes,2,opt,name=tournamentIntervalUserId,proto3,oneof" json:"tournamentIntervalUserId,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type CreateTournamentRoundResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Success       bool                                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Round         *uint32                             `protobuf:"varint,2,opt,name=round,proto3,oneof" json:"round,omitempty"`
+	Pairings      []*TournamentPairing                `protobuf:"bytes,3,rep,name=pairings,proto3" json:"pairings,omitempty"`
+	Error         CreateTournamentRoundResponse_Error `protobuf:"varint,4,opt,name=error,proto3,enum=api.CreateTournamentRoundResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TournamentUserRequest) Reset() {
-	*x = TournamentUserRequest{}
-	mi := &file_tournament_proto_msgTypes[3]
+func (x *CreateTournamentRoundResponse) Reset() {
+	*x = CreateTournamentRoundResponse{}
+	mi := &file_tournament_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentUserRequest) String() string {
+func (x *CreateTournamentRoundResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentUserRequest) ProtoMessage() {}
+func (*CreateTournamentRoundResponse) ProtoMessage() {}
 
-func (x *TournamentUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[3]
+func (x *CreateTournamentRoundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,49 +6450,63 @@ func (x *TournamentUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentUserRequest.ProtoReflect.Descriptor instead.
-func (*TournamentUserRequest) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CreateTournamentRoundResponse.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRoundResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *TournamentUserRequest) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *CreateTournamentRoundResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateTournamentRoundResponse) GetRound() uint32 {
+	if x != nil && x.Round != nil {
+		return *x.Round
 	}
 	return 0
 }
 
-func (x *TournamentUserRequest) GetTournamentIntervalUserId() *TournamentIntervalUserId {
+func (x *CreateTournamentRoundResponse) GetPairings() []*TournamentPairing {
 	if x != nil {
-		return x.TournamentIntervalUserId
+		return x.Pairings
 	}
 	return nil
 }
 
-type GetTournamentUserResponse struct {
-	state          protoimpl.MessageState          `protogen:"open.v1"`
-	Success        bool                            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	TournamentUser *TournamentUser                 `protobuf:"bytes,2,opt,name=tournamentUser,proto3,oneof" json:"tournamentUser,omitempty"`
-	Error          GetTournamentUserResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentUserResponse_Error" json:"error,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *CreateTournamentRoundResponse) GetError() CreateTournamentRoundResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return CreateTournamentRoundResponse_NONE
 }
 
-func (x *GetTournamentUserResponse) Reset() {
-	*x = GetTournamentUserResponse{}
-	mi := &file_tournament_proto_msgTypes[4]
+type GetTournamentPairingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	Round         *uint32                `protobuf:"varint,3,opt,name=round,proto3,oneof" json:"round,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTournamentPairingsRequest) Reset() {
+	*x = GetTournamentPairingsRequest{}
+	mi := &file_tournament_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTournamentUserResponse) String() string {
+func (x *GetTournamentPairingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTournamentUserResponse) ProtoMessage() {}
+func (*GetTournamentPairingsRequest) ProtoMessage() {}
 
-func (x *GetTournamentUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[4]
+func (x *GetTournamentPairingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,55 +6517,56 @@ func (x *GetTournamentUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTournamentUserResponse.ProtoReflect.Descriptor instead.
-func (*GetTournamentUserResponse) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetTournamentPairingsRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentPairingsRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetTournamentUserResponse) GetSuccess() bool {
+func (x *GetTournamentPairingsRequest) GetTournament() string {
 	if x != nil {
-		return x.Success
+		return x.Tournament
 	}
-	return false
+	return ""
 }
 
-func (x *GetTournamentUserResponse) GetTournamentUser() *TournamentUser {
+func (x *GetTournamentPairingsRequest) GetInterval() TournamentInterval {
 	if x != nil {
-		return x.TournamentUser
+		return x.Interval
 	}
-	return nil
+	return TournamentInterval_DAILY
 }
 
-func (x *GetTournamentUserResponse) GetError() GetTournamentUserResponse_Error {
-	if x != nil {
-		return x.Error
+func (x *GetTournamentPairingsRequest) GetRound() uint32 {
+	if x != nil && x.Round != nil {
+		return *x.Round
 	}
-	return GetTournamentUserResponse_NONE
+	return 0
 }
 
-type TournamentUserResponse struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Success       bool                         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         TournamentUserResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.TournamentUserResponse_Error" json:"error,omitempty"`
+type GetTournamentPairingsResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Success       bool                                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Pairings      []*TournamentPairing                `protobuf:"bytes,2,rep,name=pairings,proto3" json:"pairings,omitempty"`
+	Error         GetTournamentPairingsResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentPairingsResponse_Error" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TournamentUserResponse) Reset() {
-	*x = TournamentUserResponse{}
-	mi := &file_tournament_proto_msgTypes[5]
+func (x *GetTournamentPairingsResponse) Reset() {
+	*x = GetTournamentPairingsResponse{}
+	mi := &file_tournament_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentUserResponse) String() string {
+func (x *GetTournamentPairingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentUserResponse) ProtoMessage() {}
+func (*GetTournamentPairingsResponse) ProtoMessage() {}
 
-func (x *TournamentUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[5]
+func (x *GetTournamentPairingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,50 +6577,55 @@ func (x *TournamentUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentUserResponse.ProtoReflect.Descriptor instead.
-func (*TournamentUserResponse) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetTournamentPairingsResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentPairingsResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *TournamentUserResponse) GetSuccess() bool {
+func (x *GetTournamentPairingsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *TournamentUserResponse) GetError() TournamentUserResponse_Error {
+func (x *GetTournamentPairingsResponse) GetPairings() []*TournamentPairing {
+	if x != nil {
+		return x.Pairings
+	}
+	return nil
+}
+
+func (x *GetTournamentPairingsResponse) GetError() GetTournamentPairingsResponse_Error {
 	if x != nil {
 		return x.Error
 	}
-	return TournamentUserResponse_NONE
+	return GetTournamentPairingsResponse_NONE
 }
 
-type GetTournamentUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tournament    *string                `protobuf:"bytes,1,opt,name=tournament,proto3,oneof" json:"tournament,omitempty"`
-	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
-	UserId        *uint64                `protobuf:"varint,3,opt,name=userId,proto3,oneof" json:"userId,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,4,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+type RecordTournamentPairingResultRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Result        TournamentPairingResult `protobuf:"varint,2,opt,name=result,proto3,enum=api.TournamentPairingResult" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTournamentUsersRequest) Reset() {
-	*x = GetTournamentUsersRequest{}
-	mi := &file_tournament_proto_msgTypes[6]
+func (x *RecordTournamentPairingResultRequest) Reset() {
+	*x = RecordTournamentPairingResultRequest{}
+	mi := &file_tournament_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTournamentUsersRequest) String() string {
+func (x *RecordTournamentPairingResultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTournamentUsersRequest) ProtoMessage() {}
+func (*RecordTournamentPairingResultRequest) ProtoMessage() {}
 
-func (x *GetTournamentUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[6]
+func (x *RecordTournamentPairingResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -757,63 +6636,48 @@ func (x *GetTournamentUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTournamentUsersRequest.ProtoReflect.Descriptor instead.
-func (*GetTournamentUsersRequest) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *GetTournamentUsersRequest) GetTournament() string {
-	if x != nil && x.Tournament != nil {
-		return *x.Tournament
-	}
-	return ""
+// Deprecated: Use RecordTournamentPairingResultRequest.ProtoReflect.Descriptor instead.
+func (*RecordTournamentPairingResultRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetTournamentUsersRequest) GetInterval() TournamentInterval {
+func (x *RecordTournamentPairingResultRequest) GetId() uint64 {
 	if x != nil {
-		return x.Interval
-	}
-	return TournamentInterval_DAILY
-}
-
-func (x *GetTournamentUsersRequest) GetUserId() uint64 {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetTournamentUsersRequest) GetPagination() *Pagination {
+func (x *RecordTournamentPairingResultRequest) GetResult() TournamentPairingResult {
 	if x != nil {
-		return x.Pagination
+		return x.Result
 	}
-	return nil
+	return TournamentPairingResult_FIRST_WON
 }
 
-type GetTournamentUsersResponse struct {
-	state           protoimpl.MessageState           `protogen:"open.v1"`
-	Success         bool                             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	TournamentUsers []*TournamentUser                `protobuf:"bytes,2,rep,name=tournamentUsers,proto3" json:"tournamentUsers,omitempty"`
-	Error           GetTournamentUsersResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentUsersResponse_Error" json:"error,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type RecordTournamentPairingResultResponse struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Success       bool                                        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         RecordTournamentPairingResultResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.RecordTournamentPairingResultResponse_Error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTournamentUsersResponse) Reset() {
-	*x = GetTournamentUsersResponse{}
-	mi := &file_tournament_proto_msgTypes[7]
+func (x *RecordTournamentPairingResultResponse) Reset() {
+	*x = RecordTournamentPairingResultResponse{}
+	mi := &file_tournament_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTournamentUsersResponse) String() string {
+func (x *RecordTournamentPairingResultResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTournamentUsersResponse) ProtoMessage() {}
+func (*RecordTournamentPairingResultResponse) ProtoMessage() {}
 
-func (x *GetTournamentUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[7]
+func (x *RecordTournamentPairingResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,57 +6688,48 @@ func (x *GetTournamentUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTournamentUsersResponse.ProtoReflect.Descriptor instead.
-func (*GetTournamentUsersResponse) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RecordTournamentPairingResultResponse.ProtoReflect.Descriptor instead.
+func (*RecordTournamentPairingResultResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetTournamentUsersResponse) GetSuccess() bool {
+func (x *RecordTournamentPairingResultResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetTournamentUsersResponse) GetTournamentUsers() []*TournamentUser {
-	if x != nil {
-		return x.TournamentUsers
-	}
-	return nil
-}
-
-func (x *GetTournamentUsersResponse) GetError() GetTournamentUsersResponse_Error {
+func (x *RecordTournamentPairingResultResponse) GetError() RecordTournamentPairingResultResponse_Error {
 	if x != nil {
 		return x.Error
 	}
-	return GetTournamentUsersResponse_NONE
+	return RecordTournamentPairingResultResponse_NONE
 }
 
-type UpdateTournamentUserRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Tournament     *TournamentUserRequest `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
-	Data           *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3,oneof" json:"data,omitempty"`
-	Score          *int64                 `protobuf:"varint,3,opt,name=score,proto3,oneof" json:"score,omitempty"`
-	IncrementScore *bool                  `protobuf:"varint,4,opt,name=incrementScore,proto3,oneof" json:"incrementScore,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetTournamentStandingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    string                 `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval      TournamentInterval     `protobuf:"varint,2,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTournamentUserRequest) Reset() {
-	*x = UpdateTournamentUserRequest{}
-	mi := &file_tournament_proto_msgTypes[8]
+func (x *GetTournamentStandingsRequest) Reset() {
+	*x = GetTournamentStandingsRequest{}
+	mi := &file_tournament_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTournamentUserRequest) String() string {
+func (x *GetTournamentStandingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTournamentUserRequest) ProtoMessage() {}
+func (*GetTournamentStandingsRequest) ProtoMessage() {}
 
-func (x *UpdateTournamentUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[8]
+func (x *GetTournamentStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,62 +6740,49 @@ func (x *UpdateTournamentUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTournamentUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTournamentUserRequest) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetTournamentStandingsRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *UpdateTournamentUserRequest) GetTournament() *TournamentUserRequest {
+func (x *GetTournamentStandingsRequest) GetTournament() string {
 	if x != nil {
 		return x.Tournament
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateTournamentUserRequest) GetData() *structpb.Struct {
+func (x *GetTournamentStandingsRequest) GetInterval() TournamentInterval {
 	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *UpdateTournamentUserRequest) GetScore() int64 {
-	if x != nil && x.Score != nil {
-		return *x.Score
-	}
-	return 0
-}
-
-func (x *UpdateTournamentUserRequest) GetIncrementScore() bool {
-	if x != nil && x.IncrementScore != nil {
-		return *x.IncrementScore
+		return x.Interval
 	}
-	return false
+	return TournamentInterval_DAILY
 }
 
-type UpdateTournamentUserResponse struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	Success       bool                               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         UpdateTournamentUserResponse_Error `protobuf:"varint,2,opt,name=error,proto3,enum=api.UpdateTournamentUserResponse_Error" json:"error,omitempty"`
+type GetTournamentStandingsResponse struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Success       bool                                 `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Standings     []*TournamentStanding                `protobuf:"bytes,2,rep,name=standings,proto3" json:"standings,omitempty"`
+	Error         GetTournamentStandingsResponse_Error `protobuf:"varint,3,opt,name=error,proto3,enum=api.GetTournamentStandingsResponse_Error" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTournamentUserResponse) Reset() {
-	*x = UpdateTournamentUserResponse{}
-	mi := &file_tournament_proto_msgTypes[9]
+func (x *GetTournamentStandingsResponse) Reset() {
+	*x = GetTournamentStandingsResponse{}
+	mi := &file_tournament_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTournamentUserResponse) String() string {
+func (x *GetTournamentStandingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTournamentUserResponse) ProtoMessage() {}
+func (*GetTournamentStandingsResponse) ProtoMessage() {}
 
-func (x *UpdateTournamentUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[9]
+func (x *GetTournamentStandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -951,56 +6793,66 @@ func (x *UpdateTournamentUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTournamentUserResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTournamentUserResponse) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetTournamentStandingsResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentStandingsResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *UpdateTournamentUserResponse) GetSuccess() bool {
+func (x *GetTournamentStandingsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpdateTournamentUserResponse) GetError() UpdateTournamentUserResponse_Error {
+func (x *GetTournamentStandingsResponse) GetStandings() []*TournamentStanding {
 	if x != nil {
-		return x.Error
+		return x.Standings
 	}
-	return UpdateTournamentUserResponse_NONE
+	return nil
 }
 
-type TournamentUser struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Tournament          string                 `protobuf:"bytes,2,opt,name=tournament,proto3" json:"tournament,omitempty"`
-	UserId              uint64                 `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
-	Interval            TournamentInterval     `protobuf:"varint,4,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
-	Score               int64                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
-	Ranking             uint64                 `protobuf:"varint,6,opt,name=ranking,proto3" json:"ranking,omitempty"`
-	Data                *structpb.Struct       `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
-	TournamentStartedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=tournamentStartedAt,proto3" json:"tournamentStartedAt,omitempty"`
-	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+func (x *GetTournamentStandingsResponse) GetError() GetTournamentStandingsResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return GetTournamentStandingsResponse_NONE
+}
+
+type TournamentPairing struct {
+	state               protoimpl.MessageState   `protogen:"open.v1"`
+	Id                  uint64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tournament          string                   `protobuf:"bytes,2,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Interval            TournamentInterval       `protobuf:"varint,3,opt,name=interval,proto3,enum=api.TournamentInterval" json:"interval,omitempty"`
+	TournamentStartedAt *timestamppb.Timestamp   `protobuf:"bytes,4,opt,name=tournamentStartedAt,proto3" json:"tournamentStartedAt,omitempty"`
+	Format              TournamentPairingFormat  `protobuf:"varint,5,opt,name=format,proto3,enum=api.TournamentPairingFormat" json:"format,omitempty"`
+	Round               uint32                   `protobuf:"varint,6,opt,name=round,proto3" json:"round,omitempty"`
+	Position            uint32                   `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	FirstUserId         uint64                   `protobuf:"varint,8,opt,name=firstUserId,proto3" json:"firstUserId,omitempty"`
+	SecondUserId        *uint64                  `protobuf:"varint,9,opt,name=secondUserId,proto3,oneof" json:"secondUserId,omitempty"`
+	Result              *TournamentPairingResult `protobuf:"varint,10,opt,name=result,proto3,enum=api.TournamentPairingResult,oneof" json:"result,omitempty"`
+	CompletedAt         *timestamppb.Timestamp   `protobuf:"bytes,11,opt,name=completedAt,proto3,oneof" json:"completedAt,omitempty"`
+	CreatedAt           *timestamppb.Timestamp   `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp   `protobuf:"bytes,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *TournamentUser) Reset() {
-	*x = TournamentUser{}
-	mi := &file_tournament_proto_msgTypes[10]
+func (x *TournamentPairing) Reset() {
+	*x = TournamentPairing{}
+	mi := &file_tournament_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentUser) String() string {
+func (x *TournamentPairing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentUser) ProtoMessage() {}
+func (*TournamentPairing) ProtoMessage() {}
 
-func (x *TournamentUser) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[10]
+func (x *TournamentPairing) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,81 +6863,202 @@ func (x *TournamentUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentUser.ProtoReflect.Descriptor instead.
-func (*TournamentUser) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use TournamentPairing.ProtoReflect.Descriptor instead.
+func (*TournamentPairing) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *TournamentUser) GetId() uint64 {
+func (x *TournamentPairing) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *TournamentUser) GetTournament() string {
+func (x *TournamentPairing) GetTournament() string {
 	if x != nil {
 		return x.Tournament
 	}
 	return ""
 }
 
-func (x *TournamentUser) GetUserId() uint64 {
+func (x *TournamentPairing) GetInterval() TournamentInterval {
 	if x != nil {
-		return x.UserId
+		return x.Interval
 	}
-	return 0
+	return TournamentInterval_DAILY
 }
 
-func (x *TournamentUser) GetInterval() TournamentInterval {
+func (x *TournamentPairing) GetTournamentStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Interval
+		return x.TournamentStartedAt
 	}
-	return TournamentInterval_DAILY
+	return nil
 }
 
-func (x *TournamentUser) GetScore() int64 {
+func (x *TournamentPairing) GetFormat() TournamentPairingFormat {
 	if x != nil {
-		return x.Score
+		return x.Format
+	}
+	return TournamentPairingFormat_SWISS
+}
+
+func (x *TournamentPairing) GetRound() uint32 {
+	if x != nil {
+		return x.Round
 	}
 	return 0
 }
 
-func (x *TournamentUser) GetRanking() uint64 {
+func (x *TournamentPairing) GetPosition() uint32 {
 	if x != nil {
-		return x.Ranking
+		return x.Position
 	}
 	return 0
 }
 
-func (x *TournamentUser) GetData() *structpb.Struct {
+func (x *TournamentPairing) GetFirstUserId() uint64 {
 	if x != nil {
-		return x.Data
+		return x.FirstUserId
 	}
-	return nil
+	return 0
 }
 
-func (x *TournamentUser) GetTournamentStartedAt() *timestamppb.Timestamp {
+func (x *TournamentPairing) GetSecondUserId() uint64 {
+	if x != nil && x.SecondUserId != nil {
+		return *x.SecondUserId
+	}
+	return 0
+}
+
+func (x *TournamentPairing) GetResult() TournamentPairingResult {
+	if x != nil && x.Result != nil {
+		return *x.Result
+	}
+	return TournamentPairingResult_FIRST_WON
+}
+
+func (x *TournamentPairing) GetCompletedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TournamentStartedAt
+		return x.CompletedAt
 	}
 	return nil
 }
 
-func (x *TournamentUser) GetCreatedAt() *timestamppb.Timestamp {
+func (x *TournamentPairing) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *TournamentUser) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *TournamentPairing) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
+type TournamentStanding struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Points          float64                `protobuf:"fixed64,2,opt,name=points,proto3" json:"points,omitempty"`
+	Buchholz        float64                `protobuf:"fixed64,3,opt,name=buchholz,proto3" json:"buchholz,omitempty"`
+	SonnebornBerger float64                `protobuf:"fixed64,4,opt,name=sonnebornBerger,proto3" json:"sonnebornBerger,omitempty"`
+	Wins            uint32                 `protobuf:"varint,5,opt,name=wins,proto3" json:"wins,omitempty"`
+	Draws           uint32                 `protobuf:"varint,6,opt,name=draws,proto3" json:"draws,omitempty"`
+	Losses          uint32                 `protobuf:"varint,7,opt,name=losses,proto3" json:"losses,omitempty"`
+	Ranking         uint64                 `protobuf:"varint,8,opt,name=ranking,proto3" json:"ranking,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TournamentStanding) Reset() {
+	*x = TournamentStanding{}
+	mi := &file_tournament_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentStanding) ProtoMessage() {}
+
+func (x *TournamentStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentStanding.ProtoReflect.Descriptor instead.
+func (*TournamentStanding) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *TournamentStanding) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetBuchholz() float64 {
+	if x != nil {
+		return x.Buchholz
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetSonnebornBerger() float64 {
+	if x != nil {
+		return x.SonnebornBerger
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetWins() uint32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetDraws() uint32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetLosses() uint32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetRanking() uint64 {
+	if x != nil {
+		return x.Ranking
+	}
+	return 0
+}
+
 var File_tournament_proto protoreflect.FileDescriptor
 
 var file_tournament_proto_rawDesc = string([]byte{
@@ -1095,7 +7068,7 @@ var file_tournament_proto_rawDesc = string([]byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xdc, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x6f, 0x74, 0x6f, 0x22, 0x84, 0x02, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f,
 	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
 	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
@@ -1108,216 +7081,1516 @@ var file_tournament_proto_rawDesc = string([]byte{
 	0x00, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x04, 0x64,
 	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0xed, 0x02, 0x0a, 0x1c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd7, 0x01, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a,
+	0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x11, 0x0a, 0x0d, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45,
+	0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x46, 0x49, 0x4e,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x06, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11,
+	0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45,
+	0x52, 0x10, 0x08, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x18, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x15, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x5e, 0x0a, 0x18, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x48, 0x01, 0x52, 0x18, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xec, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x40, 0x0a, 0x0e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa3, 0x01,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x54, 0x4f, 0x55, 0x52, 0x4e,
+	0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x02,
+	0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x14,
+	0x0a, 0x10, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x05, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x22, 0x91, 0x02, 0x0a, 0x16, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0xa3, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08,
+	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x49, 0x44, 0x5f, 0x4f,
+	0x52, 0x5f, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52,
+	0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e,
+	0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c,
+	0x4f, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x22, 0xf1, 0x01, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa9,
+	0x03, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x88, 0x01, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55,
+	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f,
+	0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52,
+	0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x43, 0x55, 0x52, 0x53, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f,
+	0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xf9, 0x01, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x48, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x02, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x0f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x64, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x12,
+	0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41,
+	0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x03, 0x22, 0xc4, 0x02,
+	0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
+	0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02,
+	0x52, 0x0e, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x48, 0x03, 0x52, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x22, 0xfd, 0x02, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x3d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x22, 0xdf, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f,
+	0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x56, 0x41, 0x4c, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48,
+	0x4f, 0x52, 0x54, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e,
+	0x47, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x4f, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x06, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x43, 0x4f, 0x52, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x07, 0x22, 0xbc, 0x04, 0x0a, 0x0e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x4c, 0x0a, 0x13, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x4f, 0x0a, 0x12, 0x73, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x68, 0x69, 0x72, 0x64, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x12, 0x73, 0x65, 0x6e, 0x74,
+	0x54, 0x6f, 0x54, 0x68, 0x69, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x42, 0x15, 0x0a, 0x13,
+	0x5f, 0x73, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x68, 0x69, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x41, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
 	0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x22, 0xa1, 0x02, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x72, 0x65, 0x22, 0xd6, 0x02, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x13, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88,
 	0x01, 0x01, 0x12, 0x3d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0e, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0x8b, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x72, 0x22, 0xc0, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e,
 	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d,
 	0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f,
 	0x52, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45,
 	0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47,
-	0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45,
+	0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45,
 	0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x41, 0x54, 0x41,
 	0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x41,
-	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x05, 0x42,
-	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x18, 0x54, 0x6f, 0x75, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0xb0, 0x01, 0x0a, 0x15, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12,
-	0x5e, 0x0a, 0x18, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x48, 0x01, 0x52, 0x18, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
-	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0xec, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x74,
-	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa3, 0x01, 0x0a, 0x05, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x2e, 0x0a,
-	0x2a, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e,
-	0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f,
-	0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a,
-	0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
-	0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18,
-	0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
-	0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53,
-	0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04,
-	0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x42,
-	0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x22, 0x91, 0x02, 0x0a, 0x16, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0xa3, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x05, 0x12,
+	0x18, 0x0a, 0x14, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4f, 0x55,
+	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x07, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x18,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x15, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x5e, 0x0a, 0x18, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x54,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x48, 0x01, 0x52, 0x18, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x1b, 0x0a, 0x19, 0x5f,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0xec, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x40, 0x0a, 0x0e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52,
+	0x0e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x88,
+	0x01, 0x01, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa3,
+	0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x54, 0x4f, 0x55, 0x52,
+	0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f,
+	0x54, 0x45, 0x41, 0x4d, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10,
+	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x03, 0x12,
+	0x14, 0x0a, 0x10, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49,
+	0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x05, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x22, 0x91, 0x02, 0x0a, 0x16, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa3, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x49, 0x44, 0x5f,
+	0x4f, 0x52, 0x5f, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x49, 0x44, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55,
+	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f,
+	0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52,
+	0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x49,
+	0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x22, 0xf1, 0x01, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xa9, 0x03, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x0f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f,
+	0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f,
+	0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55,
+	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f,
+	0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x43, 0x55, 0x52, 0x53, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x54,
+	0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xc4, 0x02, 0x0a, 0x1b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0e,
+	0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x03,
+	0x52, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x22, 0xfd, 0x02, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x22, 0xdf, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
 	0x4e, 0x45, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x49, 0x44, 0x5f, 0x4f, 0x52, 0x5f, 0x54, 0x4f,
 	0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41,
-	0x4c, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x4c, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
 	0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45,
 	0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52,
 	0x54, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e,
 	0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10,
-	0x03, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51,
+	0x03, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51,
 	0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
-	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x22, 0xf1, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1b,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x02, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
-	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
-	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x82, 0x02, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x0f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x12, 0x3b, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x4f, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x06, 0x12,
+	0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x4f,
+	0x52, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x07, 0x22, 0xac, 0x03, 0x0a, 0x0e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x33, 0x0a,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x4c, 0x0a, 0x13, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x8b, 0x03, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0c, 0x63,
+	0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52,
+	0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x65,
+	0x6e, 0x64, 0x73, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x65, 0x6e, 0x64, 0x73,
+	0x41, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22,
+	0x96, 0x03, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf4, 0x01, 0x0a, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a,
+	0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x53,
+	0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x52,
+	0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x15, 0x0a,
+	0x11, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x5a, 0x4f,
+	0x4e, 0x45, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x52, 0x54, 0x53, 0x5f, 0x41,
+	0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18,
+	0x45, 0x4e, 0x44, 0x53, 0x5f, 0x41, 0x54, 0x5f, 0x42, 0x45, 0x46, 0x4f, 0x52, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x53, 0x5f, 0x41, 0x54, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x41,
+	0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x08, 0x12, 0x12, 0x0a,
+	0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10,
+	0x09, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x1b, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc9, 0x02, 0x0a, 0x1f,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x52, 0x0a, 0x14, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x14, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x4e, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
+	0x5d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
 	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54,
 	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10,
 	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
-	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x22,
-	0xf9, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3a, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
-	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x72,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x02, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x63,
-	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xd9, 0x02, 0x0a, 0x1c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xdf, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x49, 0x44, 0x5f,
-	0x4f, 0x52, 0x5f, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e,
-	0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52,
-	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55,
-	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f,
-	0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52,
-	0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
-	0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49,
-	0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09,
-	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4e,
-	0x4f, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x06, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e,
-	0x54, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x07, 0x22, 0xac, 0x03, 0x0a, 0x0e, 0x54, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x13, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x42, 0x17,
+	0x0a, 0x15, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd6, 0x01, 0x0a, 0x1c, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x3d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x5d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52,
+	0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03,
+	0x22, 0xf0, 0x03, 0x0a, 0x14, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
+	0x0c, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x01, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x37, 0x0a,
+	0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x06, 0x65, 0x6e, 0x64,
+	0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x65, 0x6e, 0x64,
+	0x73, 0x41, 0x74, 0x22, 0x95, 0x04, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x15, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x15, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x3b, 0x0a, 0x16, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x01, 0x52, 0x16, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x13,
+	0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x44, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x13, 0x77, 0x65, 0x65,
+	0x6b, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x17, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x17, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x37, 0x0a, 0x14, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x04, 0x52, 0x14, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16,
+	0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x6c,
+	0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x79, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c,
+	0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x79, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x1d,
+	0x53, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc0, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54,
+	0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54,
+	0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f,
+	0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f,
+	0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x5f, 0x54,
+	0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x04, 0x12,
+	0x22, 0x0a, 0x1e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48,
+	0x4c, 0x59, 0x5f, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41,
+	0x59, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x5f, 0x5a, 0x4f, 0x4e, 0x45, 0x10, 0x06, 0x22, 0x3b, 0x0a, 0x19, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xbd, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x4c, 0x0a, 0x12, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x12, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x3e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x5d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52,
+	0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03,
+	0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57,
+	0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x1a, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x3b, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5d, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12,
+	0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41,
+	0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0d, 0x0a,
+	0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x22, 0xe2, 0x03, 0x0a,
+	0x12, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x15, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x77, 0x65, 0x65,
+	0x6b, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x77, 0x65, 0x65, 0x6b, 0x6c,
+	0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x12, 0x30, 0x0a, 0x13, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x44, 0x61, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x32, 0x0a,
+	0x14, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x61, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x6c, 0x79, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xca, 0x02, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0a,
+	0x6d, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01,
+	0x01, 0x12, 0x23, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52,
+	0x0d, 0x74, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x74, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xd4,
+	0x02, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb2, 0x01, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a,
+	0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x41,
+	0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x52, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54,
+	0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x13,
+	0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e,
+	0x47, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50,
+	0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x76, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0xa0, 0x02,
+	0x0a, 0x20, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x4f, 0x0a, 0x15,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x54, 0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x54, 0x69, 0x65, 0x72, 0x52, 0x15, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x4e, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02,
+	0x22, 0x2d, 0x0a, 0x1b, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0xaa, 0x01, 0x0a, 0x1c, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a,
+	0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22, 0xc1, 0x03, 0x0a,
+	0x14, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x54, 0x69, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0a, 0x6d, 0x69,
+	0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
+	0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12,
+	0x23, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x0d, 0x74,
+	0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x74, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x22, 0xa5, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe5, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x11, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x11, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x27, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01,
+	0x22, 0x46, 0x0a, 0x1c, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd7, 0x01, 0x0a, 0x1d, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x5c, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x44, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x13, 0x0a,
+	0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x45, 0x44,
+	0x10, 0x04, 0x22, 0xd9, 0x03, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x69, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x4c, 0x0a, 0x13, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x74,
 	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x74, 0x22, 0xbc,
+	0x02, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
+	0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x06,
+	0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x99, 0x03,
+	0x0a, 0x1d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc8, 0x01, 0x0a, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41,
+	0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x1c,
+	0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15,
+	0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x4f, 0x54, 0x5f, 0x41,
+	0x5f, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x06, 0x12, 0x16,
+	0x0a, 0x12, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x10, 0x07, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x22, 0x9f, 0x03, 0x0a, 0x1e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x12, 0x37, 0x0a, 0x07, 0x73, 0x65, 0x65, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x73, 0x65, 0x65, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0a, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x12, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x01, 0x52, 0x12, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x72,
+	0x65, 0x6e, 0x61, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x49, 0x64, 0x22, 0xb1, 0x03, 0x0a, 0x1f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x40,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x95, 0x02, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f,
+	0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4e,
+	0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x4f, 0x55, 0x47, 0x48, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43,
+	0x49, 0x50, 0x41, 0x4e, 0x54, 0x53, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4f, 0x4f, 0x5f,
+	0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e, 0x54,
+	0x53, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45,
+	0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x17,
+	0x0a, 0x13, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x46, 0x49, 0x4e,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x07, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x08, 0x12, 0x13, 0x0a, 0x0f,
+	0x41, 0x52, 0x45, 0x4e, 0x41, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x09, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x0b, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22,
+	0x2a, 0x0a, 0x18, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8b, 0x02, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x49, 0x0a, 0x11, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x00, 0x52, 0x11, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x3d, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x31, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x02, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x19, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x31, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02,
+	0x22, 0x69, 0x0a, 0x29, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd2, 0x02, 0x0a, 0x2a,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x45,
+	0x6e, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x62, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x98, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x1b, 0x0a, 0x17, 0x57, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a,
+	0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10,
+	0x04, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x50,
+	0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x57, 0x49, 0x4e, 0x4e, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x06,
+	0x22, 0xd9, 0x04, 0x0a, 0x11, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x33, 0x0a, 0x12, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x41,
+	0x72, 0x65, 0x6e, 0x61, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x12,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x65, 0x6e, 0x61,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0c, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x45,
+	0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x07, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x49, 0x64, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7b, 0x0a, 0x1c,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x64,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x73,
+	0x65, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x73, 0x65, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xcb, 0x04, 0x0a, 0x16, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04,
+	0x73, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0b, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a,
+	0x0c, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x0c,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x29, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x6c, 0x6f,
+	0x73, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x04, 0x52, 0x0c, 0x6c, 0x6f, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x12, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x05, 0x52, 0x12, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x06, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x6c, 0x6f, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64,
+	0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x34, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x22, 0xed, 0x03, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x19, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3e,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x98,
+	0x02, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x18, 0x0a, 0x14, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4f, 0x55,
+	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x4f, 0x55,
+	0x47, 0x48, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e, 0x54, 0x53, 0x10,
+	0x05, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x07, 0x12, 0x15,
+	0x0a, 0x11, 0x41, 0x4c, 0x4c, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x50, 0x4c, 0x41,
+	0x59, 0x45, 0x44, 0x10, 0x08, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x4f, 0x5f, 0x50, 0x41, 0x49, 0x52,
+	0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x53, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x09, 0x12, 0x19,
+	0x0a, 0x15, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49,
+	0x43, 0x49, 0x50, 0x41, 0x4e, 0x54, 0x53, 0x10, 0x0a, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xb3,
+	0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3e,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x83,
+	0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x18, 0x0a, 0x14, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4f, 0x55,
+	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x04, 0x22, 0x6c, 0x0a, 0x24, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0xd2, 0x01, 0x0a, 0x25, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x47,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x02, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x43,
+	0x4f, 0x52, 0x44, 0x45, 0x44, 0x10, 0x03, 0x22, 0x74, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0xb8, 0x02,
+	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x3f, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x83, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48,
+	0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e,
+	0x47, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a,
+	0x15, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x04, 0x22, 0x97, 0x05, 0x0a, 0x11, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x4c, 0x0a, 0x13, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0c, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x48, 0x01, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x41, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x02, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x47, 0x0a, 0x12, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x09, 0x0a, 0x05,
-	0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x45, 0x45, 0x4b, 0x4c,
-	0x59, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10, 0x02,
-	0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x03, 0x32,
-	0xc6, 0x03, 0x0a, 0x11, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x12, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x63,
+	0x68, 0x68, 0x6f, 0x6c, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x75, 0x63,
+	0x68, 0x68, 0x6f, 0x6c, 0x7a, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x6e, 0x6e, 0x65, 0x62, 0x6f,
+	0x72, 0x6e, 0x42, 0x65, 0x72, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x73, 0x6f, 0x6e, 0x6e, 0x65, 0x62, 0x6f, 0x72, 0x6e, 0x42, 0x65, 0x72, 0x67, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x77,
+	0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x2a, 0x53, 0x0a, 0x12, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x4e, 0x54,
+	0x48, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x04,
+	0x2a, 0x3f, 0x0a, 0x13, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x45, 0x54, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12,
+	0x07, 0x0a, 0x03, 0x4d, 0x41, 0x58, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x49, 0x4e, 0x10,
+	0x03, 0x2a, 0x49, 0x0a, 0x17, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x4c, 0x49, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x45,
+	0x4c, 0x49, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x56, 0x0a, 0x18,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x53, 0x65, 0x65, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x49, 0x56, 0x45,
+	0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x4f, 0x55,
+	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x4d, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x45,
+	0x4c, 0x4f, 0x10, 0x02, 0x2a, 0x41, 0x0a, 0x15, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x69, 0x64, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x57, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x53, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f,
+	0x53, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x52, 0x41, 0x4e, 0x44, 0x5f,
+	0x46, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x02, 0x2a, 0x35, 0x0a, 0x17, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x57, 0x49, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x2a, 0x42,
+	0x0a, 0x17, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x49, 0x52,
+	0x53, 0x54, 0x5f, 0x57, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x45, 0x43, 0x4f,
+	0x4e, 0x44, 0x5f, 0x57, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x41, 0x57,
+	0x10, 0x02, 0x32, 0xc6, 0x17, 0x0a, 0x11, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x14, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70,
+	0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x3b, 0x61, 0x70,
-	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61,
+	0x6d, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x1a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x1a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x15,
+	0x53, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x18, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x70, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x54, 0x69, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72,
+	0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x54, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
+	0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e,
+	0x0a, 0x15, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e,
+	0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64,
+	0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85,
+	0x01, 0x0a, 0x22, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5e, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x76, 0x0a, 0x1d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2e,
+	0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1332,66 +8605,316 @@ func file_tournament_proto_rawDescGZIP() []byte {
 	return file_tournament_proto_rawDescData
 }
 
-var file_tournament_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_tournament_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_tournament_proto_enumTypes = make([]protoimpl.EnumInfo, 38)
+var file_tournament_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
 var file_tournament_proto_goTypes = []any{
-	(TournamentInterval)(0),                 // 0: api.TournamentInterval
-	(CreateTournamentUserResponse_Error)(0), // 1: api.CreateTournamentUserResponse.Error
-	(GetTournamentUserResponse_Error)(0),    // 2: api.GetTournamentUserResponse.Error
-	(TournamentUserResponse_Error)(0),       // 3: api.TournamentUserResponse.Error
-	(GetTournamentUsersResponse_Error)(0),   // 4: api.GetTournamentUsersResponse.Error
-	(UpdateTournamentUserResponse_Error)(0), // 5: api.UpdateTournamentUserResponse.Error
-	(*CreateTournamentUserRequest)(nil),     // 6: api.CreateTournamentUserRequest
-	(*CreateTournamentUserResponse)(nil),    // 7: api.CreateTournamentUserResponse
-	(*TournamentIntervalUserId)(nil),        // 8: api.TournamentIntervalUserId
-	(*TournamentUserRequest)(nil),           // 9: api.TournamentUserRequest
-	(*GetTournamentUserResponse)(nil),       // 10: api.GetTournamentUserResponse
-	(*TournamentUserResponse)(nil),          // 11: api.TournamentUserResponse
-	(*GetTournamentUsersRequest)(nil),       // 12: api.GetTournamentUsersRequest
-	(*GetTournamentUsersResponse)(nil),      // 13: api.GetTournamentUsersResponse
-	(*UpdateTournamentUserRequest)(nil),     // 14: api.UpdateTournamentUserRequest
-	(*UpdateTournamentUserResponse)(nil),    // 15: api.UpdateTournamentUserResponse
-	(*TournamentUser)(nil),                  // 16: api.TournamentUser
-	(*structpb.Struct)(nil),                 // 17: google.protobuf.Struct
-	(*Pagination)(nil),                      // 18: api.Pagination
-	(*timestamppb.Timestamp)(nil),           // 19: google.protobuf.Timestamp
+	(TournamentInterval)(0),                               // 0: api.TournamentInterval
+	(TournamentScoreMode)(0),                              // 1: api.TournamentScoreMode
+	(TournamentBracketFormat)(0),                          // 2: api.TournamentBracketFormat
+	(TournamentBracketSeeding)(0),                         // 3: api.TournamentBracketSeeding
+	(TournamentBracketSide)(0),                            // 4: api.TournamentBracketSide
+	(TournamentPairingFormat)(0),                          // 5: api.TournamentPairingFormat
+	(TournamentPairingResult)(0),                          // 6: api.TournamentPairingResult
+	(CreateTournamentUserResponse_Error)(0),               // 7: api.CreateTournamentUserResponse.Error
+	(GetTournamentUserResponse_Error)(0),                  // 8: api.GetTournamentUserResponse.Error
+	(TournamentUserResponse_Error)(0),                     // 9: api.TournamentUserResponse.Error
+	(GetTournamentUsersResponse_Error)(0),                 // 10: api.GetTournamentUsersResponse.Error
+	(GetTournamentUserHistoryResponse_Error)(0),           // 11: api.GetTournamentUserHistoryResponse.Error
+	(UpdateTournamentUserResponse_Error)(0),               // 12: api.UpdateTournamentUserResponse.Error
+	(CreateTournamentTeamResponse_Error)(0),               // 13: api.CreateTournamentTeamResponse.Error
+	(GetTournamentTeamResponse_Error)(0),                  // 14: api.GetTournamentTeamResponse.Error
+	(TournamentTeamResponse_Error)(0),                     // 15: api.TournamentTeamResponse.Error
+	(GetTournamentTeamsResponse_Error)(0),                 // 16: api.GetTournamentTeamsResponse.Error
+	(UpdateTournamentTeamResponse_Error)(0),               // 17: api.UpdateTournamentTeamResponse.Error
+	(CreateTournamentDefinitionResponse_Error)(0),         // 18: api.CreateTournamentDefinitionResponse.Error
+	(GetTournamentDefinitionResponse_Error)(0),            // 19: api.GetTournamentDefinitionResponse.Error
+	(TournamentDefinitionResponse_Error)(0),               // 20: api.TournamentDefinitionResponse.Error
+	(SetTournamentWipeTimeResponse_Error)(0),              // 21: api.SetTournamentWipeTimeResponse.Error
+	(GetTournamentWipeTimeResponse_Error)(0),              // 22: api.GetTournamentWipeTimeResponse.Error
+	(TournamentWipeTimeResponse_Error)(0),                 // 23: api.TournamentWipeTimeResponse.Error
+	(CreateTournamentRewardTierResponse_Error)(0),         // 24: api.CreateTournamentRewardTierResponse.Error
+	(GetTournamentRewardTiersResponse_Error)(0),           // 25: api.GetTournamentRewardTiersResponse.Error
+	(TournamentRewardTierResponse_Error)(0),               // 26: api.TournamentRewardTierResponse.Error
+	(GetTournamentRewardsResponse_Error)(0),               // 27: api.GetTournamentRewardsResponse.Error
+	(ClaimTournamentRewardResponse_Error)(0),              // 28: api.ClaimTournamentRewardResponse.Error
+	(SubmitTournamentScoreResponse_Error)(0),              // 29: api.SubmitTournamentScoreResponse.Error
+	(CreateTournamentBracketResponse_Error)(0),            // 30: api.CreateTournamentBracketResponse.Error
+	(GetTournamentBracketResponse_Error)(0),               // 31: api.GetTournamentBracketResponse.Error
+	(TournamentBracketResponse_Error)(0),                  // 32: api.TournamentBracketResponse.Error
+	(ReportTournamentBracketMatchResultResponse_Error)(0), // 33: api.ReportTournamentBracketMatchResultResponse.Error
+	(CreateTournamentRoundResponse_Error)(0),              // 34: api.CreateTournamentRoundResponse.Error
+	(GetTournamentPairingsResponse_Error)(0),              // 35: api.GetTournamentPairingsResponse.Error
+	(RecordTournamentPairingResultResponse_Error)(0),      // 36: api.RecordTournamentPairingResultResponse.Error
+	(GetTournamentStandingsResponse_Error)(0),             // 37: api.GetTournamentStandingsResponse.Error
+	(*CreateTournamentUserRequest)(nil),                   // 38: api.CreateTournamentUserRequest
+	(*CreateTournamentUserResponse)(nil),                  // 39: api.CreateTournamentUserResponse
+	(*TournamentIntervalUserId)(nil),                      // 40: api.TournamentIntervalUserId
+	(*TournamentUserRequest)(nil),                         // 41: api.TournamentUserRequest
+	(*GetTournamentUserResponse)(nil),                     // 42: api.GetTournamentUserResponse
+	(*TournamentUserResponse)(nil),                        // 43: api.TournamentUserResponse
+	(*GetTournamentUsersRequest)(nil),                     // 44: api.GetTournamentUsersRequest
+	(*GetTournamentUsersResponse)(nil),                    // 45: api.GetTournamentUsersResponse
+	(*GetTournamentUserHistoryRequest)(nil),               // 46: api.GetTournamentUserHistoryRequest
+	(*GetTournamentUserHistoryResponse)(nil),              // 47: api.GetTournamentUserHistoryResponse
+	(*UpdateTournamentUserRequest)(nil),                   // 48: api.UpdateTournamentUserRequest
+	(*UpdateTournamentUserResponse)(nil),                  // 49: api.UpdateTournamentUserResponse
+	(*TournamentUser)(nil),                                // 50: api.TournamentUser
+	(*CreateTournamentTeamRequest)(nil),                   // 51: api.CreateTournamentTeamRequest
+	(*CreateTournamentTeamResponse)(nil),                  // 52: api.CreateTournamentTeamResponse
+	(*TournamentIntervalTeamId)(nil),                      // 53: api.TournamentIntervalTeamId
+	(*TournamentTeamRequest)(nil),                         // 54: api.TournamentTeamRequest
+	(*GetTournamentTeamResponse)(nil),                     // 55: api.GetTournamentTeamResponse
+	(*TournamentTeamResponse)(nil),                        // 56: api.TournamentTeamResponse
+	(*GetTournamentTeamsRequest)(nil),                     // 57: api.GetTournamentTeamsRequest
+	(*GetTournamentTeamsResponse)(nil),                    // 58: api.GetTournamentTeamsResponse
+	(*UpdateTournamentTeamRequest)(nil),                   // 59: api.UpdateTournamentTeamRequest
+	(*UpdateTournamentTeamResponse)(nil),                  // 60: api.UpdateTournamentTeamResponse
+	(*TournamentTeam)(nil),                                // 61: api.TournamentTeam
+	(*CreateTournamentDefinitionRequest)(nil),             // 62: api.CreateTournamentDefinitionRequest
+	(*CreateTournamentDefinitionResponse)(nil),            // 63: api.CreateTournamentDefinitionResponse
+	(*TournamentDefinitionRequest)(nil),                   // 64: api.TournamentDefinitionRequest
+	(*GetTournamentDefinitionResponse)(nil),               // 65: api.GetTournamentDefinitionResponse
+	(*TournamentDefinitionResponse)(nil),                  // 66: api.TournamentDefinitionResponse
+	(*TournamentDefinition)(nil),                          // 67: api.TournamentDefinition
+	(*SetTournamentWipeTimeRequest)(nil),                  // 68: api.SetTournamentWipeTimeRequest
+	(*SetTournamentWipeTimeResponse)(nil),                 // 69: api.SetTournamentWipeTimeResponse
+	(*TournamentWipeTimeRequest)(nil),                     // 70: api.TournamentWipeTimeRequest
+	(*GetTournamentWipeTimeResponse)(nil),                 // 71: api.GetTournamentWipeTimeResponse
+	(*TournamentWipeTimeResponse)(nil),                    // 72: api.TournamentWipeTimeResponse
+	(*TournamentWipeTime)(nil),                            // 73: api.TournamentWipeTime
+	(*CreateTournamentRewardTierRequest)(nil),             // 74: api.CreateTournamentRewardTierRequest
+	(*CreateTournamentRewardTierResponse)(nil),            // 75: api.CreateTournamentRewardTierResponse
+	(*GetTournamentRewardTiersRequest)(nil),               // 76: api.GetTournamentRewardTiersRequest
+	(*GetTournamentRewardTiersResponse)(nil),              // 77: api.GetTournamentRewardTiersResponse
+	(*TournamentRewardTierRequest)(nil),                   // 78: api.TournamentRewardTierRequest
+	(*TournamentRewardTierResponse)(nil),                  // 79: api.TournamentRewardTierResponse
+	(*TournamentRewardTier)(nil),                          // 80: api.TournamentRewardTier
+	(*GetTournamentRewardsRequest)(nil),                   // 81: api.GetTournamentRewardsRequest
+	(*GetTournamentRewardsResponse)(nil),                  // 82: api.GetTournamentRewardsResponse
+	(*ClaimTournamentRewardRequest)(nil),                  // 83: api.ClaimTournamentRewardRequest
+	(*ClaimTournamentRewardResponse)(nil),                 // 84: api.ClaimTournamentRewardResponse
+	(*TournamentReward)(nil),                              // 85: api.TournamentReward
+	(*SubmitTournamentScoreRequest)(nil),                  // 86: api.SubmitTournamentScoreRequest
+	(*SubmitTournamentScoreResponse)(nil),                 // 87: api.SubmitTournamentScoreResponse
+	(*CreateTournamentBracketRequest)(nil),                // 88: api.CreateTournamentBracketRequest
+	(*CreateTournamentBracketResponse)(nil),               // 89: api.CreateTournamentBracketResponse
+	(*TournamentBracketRequest)(nil),                      // 90: api.TournamentBracketRequest
+	(*GetTournamentBracketResponse)(nil),                  // 91: api.GetTournamentBracketResponse
+	(*TournamentBracketResponse)(nil),                     // 92: api.TournamentBracketResponse
+	(*ReportTournamentBracketMatchResultRequest)(nil),     // 93: api.ReportTournamentBracketMatchResultRequest
+	(*ReportTournamentBracketMatchResultResponse)(nil),    // 94: api.ReportTournamentBracketMatchResultResponse
+	(*TournamentBracket)(nil),                             // 95: api.TournamentBracket
+	(*TournamentBracketParticipant)(nil),                  // 96: api.TournamentBracketParticipant
+	(*TournamentBracketMatch)(nil),                        // 97: api.TournamentBracketMatch
+	(*CreateTournamentRoundRequest)(nil),                  // 98: api.CreateTournamentRoundRequest
+	(*CreateTournamentRoundResponse)(nil),                 // 99: api.CreateTournamentRoundResponse
+	(*GetTournamentPairingsRequest)(nil),                  // 100: api.GetTournamentPairingsRequest
+	(*GetTournamentPairingsResponse)(nil),                 // 101: api.GetTournamentPairingsResponse
+	(*RecordTournamentPairingResultRequest)(nil),          // 102: api.RecordTournamentPairingResultRequest
+	(*RecordTournamentPairingResultResponse)(nil),         // 103: api.RecordTournamentPairingResultResponse
+	(*GetTournamentStandingsRequest)(nil),                 // 104: api.GetTournamentStandingsRequest
+	(*GetTournamentStandingsResponse)(nil),                // 105: api.GetTournamentStandingsResponse
+	(*TournamentPairing)(nil),                             // 106: api.TournamentPairing
+	(*TournamentStanding)(nil),                            // 107: api.TournamentStanding
+	(*structpb.Struct)(nil),                               // 108: google.protobuf.Struct
+	(*Pagination)(nil),                                    // 109: api.Pagination
+	(*timestamppb.Timestamp)(nil),                         // 110: google.protobuf.Timestamp
 }
 var file_tournament_proto_depIdxs = []int32{
-	0,  // 0: api.CreateTournamentUserRequest.interval:type_name -> api.TournamentInterval
-	17, // 1: api.CreateTournamentUserRequest.data:type_name -> google.protobuf.Struct
-	1,  // 2: api.CreateTournamentUserResponse.error:type_name -> api.CreateTournamentUserResponse.Error
-	0,  // 3: api.TournamentIntervalUserId.interval:type_name -> api.TournamentInterval
-	8,  // 4: api.TournamentUserRequest.tournamentIntervalUserId:type_name -> api.TournamentIntervalUserId
-	16, // 5: api.GetTournamentUserResponse.tournamentUser:type_name -> api.TournamentUser
-	2,  // 6: api.GetTournamentUserResponse.error:type_name -> api.GetTournamentUserResponse.Error
-	3,  // 7: api.TournamentUserResponse.error:type_name -> api.TournamentUserResponse.Error
-	0,  // 8: api.GetTournamentUsersRequest.interval:type_name -> api.TournamentInterval
-	18, // 9: api.GetTournamentUsersRequest.pagination:type_name -> api.Pagination
-	16, // 10: api.GetTournamentUsersResponse.tournamentUsers:type_name -> api.TournamentUser
-	4,  // 11: api.GetTournamentUsersResponse.error:type_name -> api.GetTournamentUsersResponse.Error
-	9,  // 12: api.UpdateTournamentUserRequest.tournament:type_name -> api.TournamentUserRequest
-	17, // 13: api.UpdateTournamentUserRequest.data:type_name -> google.protobuf.Struct
-	5,  // 14: api.UpdateTournamentUserResponse.error:type_name -> api.UpdateTournamentUserResponse.Error
-	0,  // 15: api.TournamentUser.interval:type_name -> api.TournamentInterval
-	17, // 16: api.TournamentUser.data:type_name -> google.protobuf.Struct
-	19, // 17: api.TournamentUser.tournamentStartedAt:type_name -> google.protobuf.Timestamp
-	19, // 18: api.TournamentUser.createdAt:type_name -> google.protobuf.Timestamp
-	19, // 19: api.TournamentUser.updatedAt:type_name -> google.protobuf.Timestamp
-	6,  // 20: api.TournamentService.CreateTournamentUser:input_type -> api.CreateTournamentUserRequest
-	9,  // 21: api.TournamentService.GetTournamentUser:input_type -> api.TournamentUserRequest
-	12, // 22: api.TournamentService.GetTournamentUsers:input_type -> api.GetTournamentUsersRequest
-	14, // 23: api.TournamentService.UpdateTournamentUser:input_type -> api.UpdateTournamentUserRequest
-	9,  // 24: api.TournamentService.DeleteTournamentUser:input_type -> api.TournamentUserRequest
-	7,  // 25: api.TournamentService.CreateTournamentUser:output_type -> api.CreateTournamentUserResponse
-	10, // 26: api.TournamentService.GetTournamentUser:output_type -> api.GetTournamentUserResponse
-	13, // 27: api.TournamentService.GetTournamentUsers:output_type -> api.GetTournamentUsersResponse
-	15, // 28: api.TournamentService.UpdateTournamentUser:output_type -> api.UpdateTournamentUserResponse
-	11, // 29: api.TournamentService.DeleteTournamentUser:output_type -> api.TournamentUserResponse
-	25, // [25:30] is the sub-list for method output_type
-	20, // [20:25] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	0,   // 0: api.CreateTournamentUserRequest.interval:type_name -> api.TournamentInterval
+	108, // 1: api.CreateTournamentUserRequest.data:type_name -> google.protobuf.Struct
+	7,   // 2: api.CreateTournamentUserResponse.error:type_name -> api.CreateTournamentUserResponse.Error
+	0,   // 3: api.TournamentIntervalUserId.interval:type_name -> api.TournamentInterval
+	40,  // 4: api.TournamentUserRequest.tournamentIntervalUserId:type_name -> api.TournamentIntervalUserId
+	50,  // 5: api.GetTournamentUserResponse.tournamentUser:type_name -> api.TournamentUser
+	8,   // 6: api.GetTournamentUserResponse.error:type_name -> api.GetTournamentUserResponse.Error
+	9,   // 7: api.TournamentUserResponse.error:type_name -> api.TournamentUserResponse.Error
+	0,   // 8: api.GetTournamentUsersRequest.interval:type_name -> api.TournamentInterval
+	109, // 9: api.GetTournamentUsersRequest.pagination:type_name -> api.Pagination
+	50,  // 10: api.GetTournamentUsersResponse.tournamentUsers:type_name -> api.TournamentUser
+	10,  // 11: api.GetTournamentUsersResponse.error:type_name -> api.GetTournamentUsersResponse.Error
+	0,   // 12: api.GetTournamentUserHistoryRequest.interval:type_name -> api.TournamentInterval
+	109, // 13: api.GetTournamentUserHistoryRequest.pagination:type_name -> api.Pagination
+	50,  // 14: api.GetTournamentUserHistoryResponse.tournamentUsers:type_name -> api.TournamentUser
+	11,  // 15: api.GetTournamentUserHistoryResponse.error:type_name -> api.GetTournamentUserHistoryResponse.Error
+	41,  // 16: api.UpdateTournamentUserRequest.tournament:type_name -> api.TournamentUserRequest
+	108, // 17: api.UpdateTournamentUserRequest.data:type_name -> google.protobuf.Struct
+	1,   // 18: api.UpdateTournamentUserRequest.scoreMode:type_name -> api.TournamentScoreMode
+	12,  // 19: api.UpdateTournamentUserResponse.error:type_name -> api.UpdateTournamentUserResponse.Error
+	0,   // 20: api.TournamentUser.interval:type_name -> api.TournamentInterval
+	108, // 21: api.TournamentUser.data:type_name -> google.protobuf.Struct
+	110, // 22: api.TournamentUser.tournamentStartedAt:type_name -> google.protobuf.Timestamp
+	110, // 23: api.TournamentUser.createdAt:type_name -> google.protobuf.Timestamp
+	110, // 24: api.TournamentUser.updatedAt:type_name -> google.protobuf.Timestamp
+	110, // 25: api.TournamentUser.sentToThirdPartyAt:type_name -> google.protobuf.Timestamp
+	0,   // 26: api.CreateTournamentTeamRequest.interval:type_name -> api.TournamentInterval
+	108, // 27: api.CreateTournamentTeamRequest.data:type_name -> google.protobuf.Struct
+	13,  // 28: api.CreateTournamentTeamResponse.error:type_name -> api.CreateTournamentTeamResponse.Error
+	0,   // 29: api.TournamentIntervalTeamId.interval:type_name -> api.TournamentInterval
+	53,  // 30: api.TournamentTeamRequest.tournamentIntervalTeamId:type_name -> api.TournamentIntervalTeamId
+	61,  // 31: api.GetTournamentTeamResponse.tournamentTeam:type_name -> api.TournamentTeam
+	14,  // 32: api.GetTournamentTeamResponse.error:type_name -> api.GetTournamentTeamResponse.Error
+	15,  // 33: api.TournamentTeamResponse.error:type_name -> api.TournamentTeamResponse.Error
+	0,   // 34: api.GetTournamentTeamsRequest.interval:type_name -> api.TournamentInterval
+	109, // 35: api.GetTournamentTeamsRequest.pagination:type_name -> api.Pagination
+	61,  // 36: api.GetTournamentTeamsResponse.tournamentTeams:type_name -> api.TournamentTeam
+	16,  // 37: api.GetTournamentTeamsResponse.error:type_name -> api.GetTournamentTeamsResponse.Error
+	54,  // 38: api.UpdateTournamentTeamRequest.tournament:type_name -> api.TournamentTeamRequest
+	108, // 39: api.UpdateTournamentTeamRequest.data:type_name -> google.protobuf.Struct
+	1,   // 40: api.UpdateTournamentTeamRequest.scoreMode:type_name -> api.TournamentScoreMode
+	17,  // 41: api.UpdateTournamentTeamResponse.error:type_name -> api.UpdateTournamentTeamResponse.Error
+	0,   // 42: api.TournamentTeam.interval:type_name -> api.TournamentInterval
+	108, // 43: api.TournamentTeam.data:type_name -> google.protobuf.Struct
+	110, // 44: api.TournamentTeam.tournamentStartedAt:type_name -> google.protobuf.Timestamp
+	110, // 45: api.TournamentTeam.createdAt:type_name -> google.protobuf.Timestamp
+	110, // 46: api.TournamentTeam.updatedAt:type_name -> google.protobuf.Timestamp
+	110, // 47: api.CreateTournamentDefinitionRequest.startsAt:type_name -> google.protobuf.Timestamp
+	110, // 48: api.CreateTournamentDefinitionRequest.endsAt:type_name -> google.protobuf.Timestamp
+	108, // 49: api.CreateTournamentDefinitionRequest.data:type_name -> google.protobuf.Struct
+	18,  // 50: api.CreateTournamentDefinitionResponse.error:type_name -> api.CreateTournamentDefinitionResponse.Error
+	67,  // 51: api.GetTournamentDefinitionResponse.tournamentDefinition:type_name -> api.TournamentDefinition
+	19,  // 52: api.GetTournamentDefinitionResponse.error:type_name -> api.GetTournamentDefinitionResponse.Error
+	20,  // 53: api.TournamentDefinitionResponse.error:type_name -> api.TournamentDefinitionResponse.Error
+	110, // 54: api.TournamentDefinition.startsAt:type_name -> google.protobuf.Timestamp
+	110, // 55: api.TournamentDefinition.endsAt:type_name -> google.protobuf.Timestamp
+	108, // 56: api.TournamentDefinition.data:type_name -> google.protobuf.Struct
+	110, // 57: api.TournamentDefinition.createdAt:type_name -> google.protobuf.Timestamp
+	110, // 58: api.TournamentDefinition.updatedAt:type_name -> google.protobuf.Timestamp
+	21,  // 59: api.SetTournamentWipeTimeResponse.error:type_name -> api.SetTournamentWipeTimeResponse.Error
+	73,  // 60: api.GetTournamentWipeTimeResponse.tournamentWipeTime:type_name -> api.TournamentWipeTime
+	22,  // 61: api.GetTournamentWipeTimeResponse.error:type_name -> api.GetTournamentWipeTimeResponse.Error
+	23,  // 62: api.TournamentWipeTimeResponse.error:type_name -> api.TournamentWipeTimeResponse.Error
+	110, // 63: api.TournamentWipeTime.createdAt:type_name -> google.protobuf.Timestamp
+	110, // 64: api.TournamentWipeTime.updatedAt:type_name -> google.protobuf.Timestamp
+	0,   // 65: api.CreateTournamentRewardTierRequest.interval:type_name -> api.TournamentInterval
+	108, // 66: api.CreateTournamentRewardTierRequest.data:type_name -> google.protobuf.Struct
+	24,  // 67: api.CreateTournamentRewardTierResponse.error:type_name -> api.CreateTournamentRewardTierResponse.Error
+	0,   // 68: api.GetTournamentRewardTiersRequest.interval:type_name -> api.TournamentInterval
+	80,  // 69: api.GetTournamentRewardTiersResponse.tournamentRewardTiers:type_name -> api.TournamentRewardTier
+	25,  // 70: api.GetTournamentRewardTiersResponse.error:type_name -> api.GetTournamentRewardTiersResponse.Error
+	26,  // 71: api.TournamentRewardTierResponse.error:type_name -> api.TournamentRewardTierResponse.Error
+	0,   // 72: api.TournamentRewardTier.interval:type_name -> api.TournamentInterval
+	108, // 73: api.TournamentRewardTier.data:type_name -> google.protobuf.Struct
+	110, // 74: api.TournamentRewardTier.createdAt:type_name -> google.protobuf.Timestamp
+	110, // 75: api.TournamentRewardTier.updatedAt:type_name -> google.protobuf.Timestamp
+	109, // 76: api.GetTournamentRewardsRequest.pagination:type_name -> api.Pagination
+	85,  // 77: api.GetTournamentRewardsResponse.tournamentRewards:type_name -> api.TournamentReward
+	27,  // 78: api.GetTournamentRewardsResponse.error:type_name -> api.GetTournamentRewardsResponse.Error
+	28,  // 79: api.ClaimTournamentRewardResponse.error:type_name -> api.ClaimTournamentRewardResponse.Error
+	0,   // 80: api.TournamentReward.interval:type_name -> api.TournamentInterval
+	110, // 81: api.TournamentReward.tournamentStartedAt:type_name -> google.protobuf.Timestamp
+	108, // 82: api.TournamentReward.data:type_name -> google.protobuf.Struct
+	110, // 83: api.TournamentReward.claimedAt:type_name -> google.protobuf.Timestamp
+	110, // 84: api.TournamentReward.createdAt:type_name -> google.protobuf.Timestamp
+	0,   // 85: api.SubmitTournamentScoreRequest.interval:type_name -> api.TournamentInterval
+	1,   // 86: api.SubmitTournamentScoreRequest.scoreMode:type_name -> api.TournamentScoreMode
+	108, // 87: api.SubmitTournamentScoreRequest.data:type_name -> google.protobuf.Struct
+	50,  // 88: api.SubmitTournamentScoreResponse.tournamentUser:type_name -> api.TournamentUser
+	29,  // 89: api.SubmitTournamentScoreResponse.error:type_name -> api.SubmitTournamentScoreResponse.Error
+	2,   // 90: api.CreateTournamentBracketRequest.format:type_name -> api.TournamentBracketFormat
+	3,   // 91: api.CreateTournamentBracketRequest.seeding:type_name -> api.TournamentBracketSeeding
+	0,   // 92: api.CreateTournamentBracketRequest.interval:type_name -> api.TournamentInterval
+	108, // 93: api.CreateTournamentBracketRequest.data:type_name -> google.protobuf.Struct
+	30,  // 94: api.CreateTournamentBracketResponse.error:type_name -> api.CreateTournamentBracketResponse.Error
+	95,  // 95: api.GetTournamentBracketResponse.tournamentBracket:type_name -> api.TournamentBracket
+	31,  // 96: api.GetTournamentBracketResponse.error:type_name -> api.GetTournamentBracketResponse.Error
+	32,  // 97: api.TournamentBracketResponse.error:type_name -> api.TournamentBracketResponse.Error
+	33,  // 98: api.ReportTournamentBracketMatchResultResponse.error:type_name -> api.ReportTournamentBracketMatchResultResponse.Error
+	2,   // 99: api.TournamentBracket.format:type_name -> api.TournamentBracketFormat
+	96,  // 100: api.TournamentBracket.participants:type_name -> api.TournamentBracketParticipant
+	97,  // 101: api.TournamentBracket.matches:type_name -> api.TournamentBracketMatch
+	108, // 102: api.TournamentBracket.data:type_name -> google.protobuf.Struct
+	110, // 103: api.TournamentBracket.endedAt:type_name -> google.protobuf.Timestamp
+	110, // 104: api.TournamentBracket.createdAt:type_name -> google.protobuf.Timestamp
+	110, // 105: api.TournamentBracket.updatedAt:type_name -> google.protobuf.Timestamp
+	4,   // 106: api.TournamentBracketMatch.side:type_name -> api.TournamentBracketSide
+	110, // 107: api.TournamentBracketMatch.completedAt:type_name -> google.protobuf.Timestamp
+	0,   // 108: api.CreateTournamentRoundRequest.interval:type_name -> api.TournamentInterval
+	5,   // 109: api.CreateTournamentRoundRequest.format:type_name -> api.TournamentPairingFormat
+	106, // 110: api.CreateTournamentRoundResponse.pairings:type_name -> api.TournamentPairing
+	34,  // 111: api.CreateTournamentRoundResponse.error:type_name -> api.CreateTournamentRoundResponse.Error
+	0,   // 112: api.GetTournamentPairingsRequest.interval:type_name -> api.TournamentInterval
+	106, // 113: api.GetTournamentPairingsResponse.pairings:type_name -> api.TournamentPairing
+	35,  // 114: api.GetTournamentPairingsResponse.error:type_name -> api.GetTournamentPairingsResponse.Error
+	6,   // 115: api.RecordTournamentPairingResultRequest.result:type_name -> api.TournamentPairingResult
+	36,  // 116: api.RecordTournamentPairingResultResponse.error:type_name -> api.RecordTournamentPairingResultResponse.Error
+	0,   // 117: api.GetTournamentStandingsRequest.interval:type_name -> api.TournamentInterval
+	107, // 118: api.GetTournamentStandingsResponse.standings:type_name -> api.TournamentStanding
+	37,  // 119: api.GetTournamentStandingsResponse.error:type_name -> api.GetTournamentStandingsResponse.Error
+	0,   // 120: api.TournamentPairing.interval:type_name -> api.TournamentInterval
+	110, // 121: api.TournamentPairing.tournamentStartedAt:type_name -> google.protobuf.Timestamp
+	5,   // 122: api.TournamentPairing.format:type_name -> api.TournamentPairingFormat
+	6,   // 123: api.TournamentPairing.result:type_name -> api.TournamentPairingResult
+	110, // 124: api.TournamentPairing.completedAt:type_name -> google.protobuf.Timestamp
+	110, // 125: api.TournamentPairing.createdAt:type_name -> google.protobuf.Timestamp
+	110, // 126: api.TournamentPairing.updatedAt:type_name -> google.protobuf.Timestamp
+	38,  // 127: api.TournamentService.CreateTournamentUser:input_type -> api.CreateTournamentUserRequest
+	41,  // 128: api.TournamentService.GetTournamentUser:input_type -> api.TournamentUserRequest
+	44,  // 129: api.TournamentService.GetTournamentUsers:input_type -> api.GetTournamentUsersRequest
+	46,  // 130: api.TournamentService.GetTournamentUserHistory:input_type -> api.GetTournamentUserHistoryRequest
+	48,  // 131: api.TournamentService.UpdateTournamentUser:input_type -> api.UpdateTournamentUserRequest
+	41,  // 132: api.TournamentService.DeleteTournamentUser:input_type -> api.TournamentUserRequest
+	51,  // 133: api.TournamentService.CreateTournamentTeam:input_type -> api.CreateTournamentTeamRequest
+	54,  // 134: api.TournamentService.GetTournamentTeam:input_type -> api.TournamentTeamRequest
+	57,  // 135: api.TournamentService.GetTournamentTeams:input_type -> api.GetTournamentTeamsRequest
+	59,  // 136: api.TournamentService.UpdateTournamentTeam:input_type -> api.UpdateTournamentTeamRequest
+	54,  // 137: api.TournamentService.DeleteTournamentTeam:input_type -> api.TournamentTeamRequest
+	62,  // 138: api.TournamentService.CreateTournamentDefinition:input_type -> api.CreateTournamentDefinitionRequest
+	64,  // 139: api.TournamentService.GetTournamentDefinition:input_type -> api.TournamentDefinitionRequest
+	64,  // 140: api.TournamentService.DeleteTournamentDefinition:input_type -> api.TournamentDefinitionRequest
+	68,  // 141: api.TournamentService.SetTournamentWipeTime:input_type -> api.SetTournamentWipeTimeRequest
+	70,  // 142: api.TournamentService.GetTournamentWipeTime:input_type -> api.TournamentWipeTimeRequest
+	70,  // 143: api.TournamentService.DeleteTournamentWipeTime:input_type -> api.TournamentWipeTimeRequest
+	74,  // 144: api.TournamentService.CreateTournamentRewardTier:input_type -> api.CreateTournamentRewardTierRequest
+	76,  // 145: api.TournamentService.GetTournamentRewardTiers:input_type -> api.GetTournamentRewardTiersRequest
+	78,  // 146: api.TournamentService.DeleteTournamentRewardTier:input_type -> api.TournamentRewardTierRequest
+	81,  // 147: api.TournamentService.GetTournamentRewards:input_type -> api.GetTournamentRewardsRequest
+	83,  // 148: api.TournamentService.ClaimTournamentReward:input_type -> api.ClaimTournamentRewardRequest
+	86,  // 149: api.TournamentService.SubmitTournamentScore:input_type -> api.SubmitTournamentScoreRequest
+	88,  // 150: api.TournamentService.CreateTournamentBracket:input_type -> api.CreateTournamentBracketRequest
+	90,  // 151: api.TournamentService.GetTournamentBracket:input_type -> api.TournamentBracketRequest
+	93,  // 152: api.TournamentService.ReportTournamentBracketMatchResult:input_type -> api.ReportTournamentBracketMatchResultRequest
+	90,  // 153: api.TournamentService.DeleteTournamentBracket:input_type -> api.TournamentBracketRequest
+	98,  // 154: api.TournamentService.CreateTournamentRound:input_type -> api.CreateTournamentRoundRequest
+	100, // 155: api.TournamentService.GetTournamentPairings:input_type -> api.GetTournamentPairingsRequest
+	102, // 156: api.TournamentService.RecordTournamentPairingResult:input_type -> api.RecordTournamentPairingResultRequest
+	104, // 157: api.TournamentService.GetTournamentStandings:input_type -> api.GetTournamentStandingsRequest
+	39,  // 158: api.TournamentService.CreateTournamentUser:output_type -> api.CreateTournamentUserResponse
+	42,  // 159: api.TournamentService.GetTournamentUser:output_type -> api.GetTournamentUserResponse
+	45,  // 160: api.TournamentService.GetTournamentUsers:output_type -> api.GetTournamentUsersResponse
+	47,  // 161: api.TournamentService.GetTournamentUserHistory:output_type -> api.GetTournamentUserHistoryResponse
+	49,  // 162: api.TournamentService.UpdateTournamentUser:output_type -> api.UpdateTournamentUserResponse
+	43,  // 163: api.TournamentService.DeleteTournamentUser:output_type -> api.TournamentUserResponse
+	52,  // 164: api.TournamentService.CreateTournamentTeam:output_type -> api.CreateTournamentTeamResponse
+	55,  // 165: api.TournamentService.GetTournamentTeam:output_type -> api.GetTournamentTeamResponse
+	58,  // 166: api.TournamentService.GetTournamentTeams:output_type -> api.GetTournamentTeamsResponse
+	60,  // 167: api.TournamentService.UpdateTournamentTeam:output_type -> api.UpdateTournamentTeamResponse
+	56,  // 168: api.TournamentService.DeleteTournamentTeam:output_type -> api.TournamentTeamResponse
+	63,  // 169: api.TournamentService.CreateTournamentDefinition:output_type -> api.CreateTournamentDefinitionResponse
+	65,  // 170: api.TournamentService.GetTournamentDefinition:output_type -> api.GetTournamentDefinitionResponse
+	66,  // 171: api.TournamentService.DeleteTournamentDefinition:output_type -> api.TournamentDefinitionResponse
+	69,  // 172: api.TournamentService.SetTournamentWipeTime:output_type -> api.SetTournamentWipeTimeResponse
+	71,  // 173: api.TournamentService.GetTournamentWipeTime:output_type -> api.GetTournamentWipeTimeResponse
+	72,  // 174: api.TournamentService.DeleteTournamentWipeTime:output_type -> api.TournamentWipeTimeResponse
+	75,  // 175: api.TournamentService.CreateTournamentRewardTier:output_type -> api.CreateTournamentRewardTierResponse
+	77,  // 176: api.TournamentService.GetTournamentRewardTiers:output_type -> api.GetTournamentRewardTiersResponse
+	79,  // 177: api.TournamentService.DeleteTournamentRewardTier:output_type -> api.TournamentRewardTierResponse
+	82,  // 178: api.TournamentService.GetTournamentRewards:output_type -> api.GetTournamentRewardsResponse
+	84,  // 179: api.TournamentService.ClaimTournamentReward:output_type -> api.ClaimTournamentRewardResponse
+	87,  // 180: api.TournamentService.SubmitTournamentScore:output_type -> api.SubmitTournamentScoreResponse
+	89,  // 181: api.TournamentService.CreateTournamentBracket:output_type -> api.CreateTournamentBracketResponse
+	91,  // 182: api.TournamentService.GetTournamentBracket:output_type -> api.GetTournamentBracketResponse
+	94,  // 183: api.TournamentService.ReportTournamentBracketMatchResult:output_type -> api.ReportTournamentBracketMatchResultResponse
+	92,  // 184: api.TournamentService.DeleteTournamentBracket:output_type -> api.TournamentBracketResponse
+	99,  // 185: api.TournamentService.CreateTournamentRound:output_type -> api.CreateTournamentRoundResponse
+	101, // 186: api.TournamentService.GetTournamentPairings:output_type -> api.GetTournamentPairingsResponse
+	103, // 187: api.TournamentService.RecordTournamentPairingResult:output_type -> api.RecordTournamentPairingResultResponse
+	105, // 188: api.TournamentService.GetTournamentStandings:output_type -> api.GetTournamentStandingsResponse
+	158, // [158:189] is the sub-list for method output_type
+	127, // [127:158] is the sub-list for method input_type
+	127, // [127:127] is the sub-list for extension type_name
+	127, // [127:127] is the sub-list for extension extendee
+	0,   // [0:127] is the sub-list for field type_name
 }
 
 func init() { file_tournament_proto_init() }
@@ -1405,14 +8928,46 @@ func file_tournament_proto_init() {
 	file_tournament_proto_msgTypes[3].OneofWrappers = []any{}
 	file_tournament_proto_msgTypes[4].OneofWrappers = []any{}
 	file_tournament_proto_msgTypes[6].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[7].OneofWrappers = []any{}
 	file_tournament_proto_msgTypes[8].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[10].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[12].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[13].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[14].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[16].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[17].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[19].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[20].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[21].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[24].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[25].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[27].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[29].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[30].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[33].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[36].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[37].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[42].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[43].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[47].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[48].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[49].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[50].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[51].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[53].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[57].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[58].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[59].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[61].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[62].OneofWrappers = []any{}
+	file_tournament_proto_msgTypes[68].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tournament_proto_rawDesc), len(file_tournament_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   11,
+			NumEnums:      38,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
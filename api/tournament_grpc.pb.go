@@ -25,8 +25,34 @@ type TournamentServiceClient interface {
 	CreateTournamentUser(ctx context.Context, in *CreateTournamentUserRequest, opts ...grpc.CallOption) (*CreateTournamentUserResponse, error)
 	GetTournamentUser(ctx context.Context, in *TournamentUserRequest, opts ...grpc.CallOption) (*GetTournamentUserResponse, error)
 	GetTournamentUsers(ctx context.Context, in *GetTournamentUsersRequest, opts ...grpc.CallOption) (*GetTournamentUsersResponse, error)
+	GetTournamentUserHistory(ctx context.Context, in *GetTournamentUserHistoryRequest, opts ...grpc.CallOption) (*GetTournamentUserHistoryResponse, error)
 	UpdateTournamentUser(ctx context.Context, in *UpdateTournamentUserRequest, opts ...grpc.CallOption) (*UpdateTournamentUserResponse, error)
 	DeleteTournamentUser(ctx context.Context, in *TournamentUserRequest, opts ...grpc.CallOption) (*TournamentUserResponse, error)
+	CreateTournamentTeam(ctx context.Context, in *CreateTournamentTeamRequest, opts ...grpc.CallOption) (*CreateTournamentTeamResponse, error)
+	GetTournamentTeam(ctx context.Context, in *TournamentTeamRequest, opts ...grpc.CallOption) (*GetTournamentTeamResponse, error)
+	GetTournamentTeams(ctx context.Context, in *GetTournamentTeamsRequest, opts ...grpc.CallOption) (*GetTournamentTeamsResponse, error)
+	UpdateTournamentTeam(ctx context.Context, in *UpdateTournamentTeamRequest, opts ...grpc.CallOption) (*UpdateTournamentTeamResponse, error)
+	DeleteTournamentTeam(ctx context.Context, in *TournamentTeamRequest, opts ...grpc.CallOption) (*TournamentTeamResponse, error)
+	CreateTournamentDefinition(ctx context.Context, in *CreateTournamentDefinitionRequest, opts ...grpc.CallOption) (*CreateTournamentDefinitionResponse, error)
+	GetTournamentDefinition(ctx context.Context, in *TournamentDefinitionRequest, opts ...grpc.CallOption) (*GetTournamentDefinitionResponse, error)
+	DeleteTournamentDefinition(ctx context.Context, in *TournamentDefinitionRequest, opts ...grpc.CallOption) (*TournamentDefinitionResponse, error)
+	SetTournamentWipeTime(ctx context.Context, in *SetTournamentWipeTimeRequest, opts ...grpc.CallOption) (*SetTournamentWipeTimeResponse, error)
+	GetTournamentWipeTime(ctx context.Context, in *TournamentWipeTimeRequest, opts ...grpc.CallOption) (*GetTournamentWipeTimeResponse, error)
+	DeleteTournamentWipeTime(ctx context.Context, in *TournamentWipeTimeRequest, opts ...grpc.CallOption) (*TournamentWipeTimeResponse, error)
+	CreateTournamentRewardTier(ctx context.Context, in *CreateTournamentRewardTierRequest, opts ...grpc.CallOption) (*CreateTournamentRewardTierResponse, error)
+	GetTournamentRewardTiers(ctx context.Context, in *GetTournamentRewardTiersRequest, opts ...grpc.CallOption) (*GetTournamentRewardTiersResponse, error)
+	DeleteTournamentRewardTier(ctx context.Context, in *TournamentRewardTierRequest, opts ...grpc.CallOption) (*TournamentRewardTierResponse, error)
+	GetTournamentRewards(ctx context.Context, in *GetTournamentRewardsRequest, opts ...grpc.CallOption) (*GetTournamentRewardsResponse, error)
+	ClaimTournamentReward(ctx context.Context, in *ClaimTournamentRewardRequest, opts ...grpc.CallOption) (*ClaimTournamentRewardResponse, error)
+	SubmitTournamentScore(ctx context.Context, in *SubmitTournamentScoreRequest, opts ...grpc.CallOption) (*SubmitTournamentScoreResponse, error)
+	CreateTournamentBracket(ctx context.Context, in *CreateTournamentBracketRequest, opts ...grpc.CallOption) (*CreateTournamentBracketResponse, error)
+	GetTournamentBracket(ctx context.Context, in *TournamentBracketRequest, opts ...grpc.CallOption) (*GetTournamentBracketResponse, error)
+	ReportTournamentBracketMatchResult(ctx context.Context, in *ReportTournamentBracketMatchResultRequest, opts ...grpc.CallOption) (*ReportTournamentBracketMatchResultResponse, error)
+	DeleteTournamentBracket(ctx context.Context, in *TournamentBracketRequest, opts ...grpc.CallOption) (*TournamentBracketResponse, error)
+	CreateTournamentRound(ctx context.Context, in *CreateTournamentRoundRequest, opts ...grpc.CallOption) (*CreateTournamentRoundResponse, error)
+	GetTournamentPairings(ctx context.Context, in *GetTournamentPairingsRequest, opts ...grpc.CallOption) (*GetTournamentPairingsResponse, error)
+	RecordTournamentPairingResult(ctx context.Context, in *RecordTournamentPairingResultRequest, opts ...grpc.CallOption) (*RecordTournamentPairingResultResponse, error)
+	GetTournamentStandings(ctx context.Context, in *GetTournamentStandingsRequest, opts ...grpc.CallOption) (*GetTournamentStandingsResponse, error)
 }
 
 type tournamentServiceClient struct {
@@ -64,6 +90,15 @@ func (c *tournamentServiceClient) GetTournamentUsers(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *tournamentServiceClient) GetTournamentUserHistory(ctx context.Context, in *GetTournamentUserHistoryRequest, opts ...grpc.CallOption) (*GetTournamentUserHistoryResponse, error) {
+	out := new(GetTournamentUserHistoryResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/GetTournamentUserHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tournamentServiceClient) UpdateTournamentUser(ctx context.Context, in *UpdateTournamentUserRequest, opts ...grpc.CallOption) (*UpdateTournamentUserResponse, error) {
 	out := new(UpdateTournamentUserResponse)
 	err := c.cc.Invoke(ctx, "/api.TournamentService/UpdateTournamentUser", in, out, opts...)
@@ -82,6 +117,231 @@ func (c *tournamentServiceClient) DeleteTournamentUser(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *tournamentServiceClient) CreateTournamentTeam(ctx context.Context, in *CreateTournamentTeamRequest, opts ...grpc.CallOption) (*CreateTournamentTeamResponse, error) {
+	out := new(CreateTournamentTeamResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/CreateTournamentTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetTournamentTeam(ctx context.Context, in *TournamentTeamRequest, opts ...grpc.CallOption) (*GetTournamentTeamResponse, error) {
+	out := new(GetTournamentTeamResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/GetTournamentTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetTournamentTeams(ctx context.Context, in *GetTournamentTeamsRequest, opts ...grpc.CallOption) (*GetTournamentTeamsResponse, error) {
+	out := new(GetTournamentTeamsResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/GetTournamentTeams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) UpdateTournamentTeam(ctx context.Context, in *UpdateTournamentTeamRequest, opts ...grpc.CallOption) (*UpdateTournamentTeamResponse, error) {
+	out := new(UpdateTournamentTeamResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/UpdateTournamentTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) DeleteTournamentTeam(ctx context.Context, in *TournamentTeamRequest, opts ...grpc.CallOption) (*TournamentTeamResponse, error) {
+	out := new(TournamentTeamResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/DeleteTournamentTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) CreateTournamentDefinition(ctx context.Context, in *CreateTournamentDefinitionRequest, opts ...grpc.CallOption) (*CreateTournamentDefinitionResponse, error) {
+	out := new(CreateTournamentDefinitionResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/CreateTournamentDefinition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetTournamentDefinition(ctx context.Context, in *TournamentDefinitionRequest, opts ...grpc.CallOption) (*GetTournamentDefinitionResponse, error) {
+	out := new(GetTournamentDefinitionResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/GetTournamentDefinition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) DeleteTournamentDefinition(ctx context.Context, in *TournamentDefinitionRequest, opts ...grpc.CallOption) (*TournamentDefinitionResponse, error) {
+	out := new(TournamentDefinitionResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/DeleteTournamentDefinition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) SetTournamentWipeTime(ctx context.Context, in *SetTournamentWipeTimeRequest, opts ...grpc.CallOption) (*SetTournamentWipeTimeResponse, error) {
+	out := new(SetTournamentWipeTimeResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/SetTournamentWipeTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetTournamentWipeTime(ctx context.Context, in *TournamentWipeTimeRequest, opts ...grpc.CallOption) (*GetTournamentWipeTimeResponse, error) {
+	out := new(GetTournamentWipeTimeResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/GetTournamentWipeTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) DeleteTournamentWipeTime(ctx context.Context, in *TournamentWipeTimeRequest, opts ...grpc.CallOption) (*TournamentWipeTimeResponse, error) {
+	out := new(TournamentWipeTimeResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/DeleteTournamentWipeTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) CreateTournamentRewardTier(ctx context.Context, in *CreateTournamentRewardTierRequest, opts ...grpc.CallOption) (*CreateTournamentRewardTierResponse, error) {
+	out := new(CreateTournamentRewardTierResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/CreateTournamentRewardTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetTournamentRewardTiers(ctx context.Context, in *GetTournamentRewardTiersRequest, opts ...grpc.CallOption) (*GetTournamentRewardTiersResponse, error) {
+	out := new(GetTournamentRewardTiersResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/GetTournamentRewardTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) DeleteTournamentRewardTier(ctx context.Context, in *TournamentRewardTierRequest, opts ...grpc.CallOption) (*TournamentRewardTierResponse, error) {
+	out := new(TournamentRewardTierResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/DeleteTournamentRewardTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetTournamentRewards(ctx context.Context, in *GetTournamentRewardsRequest, opts ...grpc.CallOption) (*GetTournamentRewardsResponse, error) {
+	out := new(GetTournamentRewardsResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/GetTournamentRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) ClaimTournamentReward(ctx context.Context, in *ClaimTournamentRewardRequest, opts ...grpc.CallOption) (*ClaimTournamentRewardResponse, error) {
+	out := new(ClaimTournamentRewardResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/ClaimTournamentReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) SubmitTournamentScore(ctx context.Context, in *SubmitTournamentScoreRequest, opts ...grpc.CallOption) (*SubmitTournamentScoreResponse, error) {
+	out := new(SubmitTournamentScoreResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/SubmitTournamentScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) CreateTournamentBracket(ctx context.Context, in *CreateTournamentBracketRequest, opts ...grpc.CallOption) (*CreateTournamentBracketResponse, error) {
+	out := new(CreateTournamentBracketResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/CreateTournamentBracket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetTournamentBracket(ctx context.Context, in *TournamentBracketRequest, opts ...grpc.CallOption) (*GetTournamentBracketResponse, error) {
+	out := new(GetTournamentBracketResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/GetTournamentBracket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) ReportTournamentBracketMatchResult(ctx context.Context, in *ReportTournamentBracketMatchResultRequest, opts ...grpc.CallOption) (*ReportTournamentBracketMatchResultResponse, error) {
+	out := new(ReportTournamentBracketMatchResultResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/ReportTournamentBracketMatchResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) DeleteTournamentBracket(ctx context.Context, in *TournamentBracketRequest, opts ...grpc.CallOption) (*TournamentBracketResponse, error) {
+	out := new(TournamentBracketResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/DeleteTournamentBracket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) CreateTournamentRound(ctx context.Context, in *CreateTournamentRoundRequest, opts ...grpc.CallOption) (*CreateTournamentRoundResponse, error) {
+	out := new(CreateTournamentRoundResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/CreateTournamentRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetTournamentPairings(ctx context.Context, in *GetTournamentPairingsRequest, opts ...grpc.CallOption) (*GetTournamentPairingsResponse, error) {
+	out := new(GetTournamentPairingsResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/GetTournamentPairings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) RecordTournamentPairingResult(ctx context.Context, in *RecordTournamentPairingResultRequest, opts ...grpc.CallOption) (*RecordTournamentPairingResultResponse, error) {
+	out := new(RecordTournamentPairingResultResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/RecordTournamentPairingResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetTournamentStandings(ctx context.Context, in *GetTournamentStandingsRequest, opts ...grpc.CallOption) (*GetTournamentStandingsResponse, error) {
+	out := new(GetTournamentStandingsResponse)
+	err := c.cc.Invoke(ctx, "/api.TournamentService/GetTournamentStandings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TournamentServiceServer is the server API for TournamentService service.
 // All implementations must embed UnimplementedTournamentServiceServer
 // for forward compatibility
@@ -89,8 +349,34 @@ type TournamentServiceServer interface {
 	CreateTournamentUser(context.Context, *CreateTournamentUserRequest) (*CreateTournamentUserResponse, error)
 	GetTournamentUser(context.Context, *TournamentUserRequest) (*GetTournamentUserResponse, error)
 	GetTournamentUsers(context.Context, *GetTournamentUsersRequest) (*GetTournamentUsersResponse, error)
+	GetTournamentUserHistory(context.Context, *GetTournamentUserHistoryRequest) (*GetTournamentUserHistoryResponse, error)
 	UpdateTournamentUser(context.Context, *UpdateTournamentUserRequest) (*UpdateTournamentUserResponse, error)
 	DeleteTournamentUser(context.Context, *TournamentUserRequest) (*TournamentUserResponse, error)
+	CreateTournamentTeam(context.Context, *CreateTournamentTeamRequest) (*CreateTournamentTeamResponse, error)
+	GetTournamentTeam(context.Context, *TournamentTeamRequest) (*GetTournamentTeamResponse, error)
+	GetTournamentTeams(context.Context, *GetTournamentTeamsRequest) (*GetTournamentTeamsResponse, error)
+	UpdateTournamentTeam(context.Context, *UpdateTournamentTeamRequest) (*UpdateTournamentTeamResponse, error)
+	DeleteTournamentTeam(context.Context, *TournamentTeamRequest) (*TournamentTeamResponse, error)
+	CreateTournamentDefinition(context.Context, *CreateTournamentDefinitionRequest) (*CreateTournamentDefinitionResponse, error)
+	GetTournamentDefinition(context.Context, *TournamentDefinitionRequest) (*GetTournamentDefinitionResponse, error)
+	DeleteTournamentDefinition(context.Context, *TournamentDefinitionRequest) (*TournamentDefinitionResponse, error)
+	SetTournamentWipeTime(context.Context, *SetTournamentWipeTimeRequest) (*SetTournamentWipeTimeResponse, error)
+	GetTournamentWipeTime(context.Context, *TournamentWipeTimeRequest) (*GetTournamentWipeTimeResponse, error)
+	DeleteTournamentWipeTime(context.Context, *TournamentWipeTimeRequest) (*TournamentWipeTimeResponse, error)
+	CreateTournamentRewardTier(context.Context, *CreateTournamentRewardTierRequest) (*CreateTournamentRewardTierResponse, error)
+	GetTournamentRewardTiers(context.Context, *GetTournamentRewardTiersRequest) (*GetTournamentRewardTiersResponse, error)
+	DeleteTournamentRewardTier(context.Context, *TournamentRewardTierRequest) (*TournamentRewardTierResponse, error)
+	GetTournamentRewards(context.Context, *GetTournamentRewardsRequest) (*GetTournamentRewardsResponse, error)
+	ClaimTournamentReward(context.Context, *ClaimTournamentRewardRequest) (*ClaimTournamentRewardResponse, error)
+	SubmitTournamentScore(context.Context, *SubmitTournamentScoreRequest) (*SubmitTournamentScoreResponse, error)
+	CreateTournamentBracket(context.Context, *CreateTournamentBracketRequest) (*CreateTournamentBracketResponse, error)
+	GetTournamentBracket(context.Context, *TournamentBracketRequest) (*GetTournamentBracketResponse, error)
+	ReportTournamentBracketMatchResult(context.Context, *ReportTournamentBracketMatchResultRequest) (*ReportTournamentBracketMatchResultResponse, error)
+	DeleteTournamentBracket(context.Context, *TournamentBracketRequest) (*TournamentBracketResponse, error)
+	CreateTournamentRound(context.Context, *CreateTournamentRoundRequest) (*CreateTournamentRoundResponse, error)
+	GetTournamentPairings(context.Context, *GetTournamentPairingsRequest) (*GetTournamentPairingsResponse, error)
+	RecordTournamentPairingResult(context.Context, *RecordTournamentPairingResultRequest) (*RecordTournamentPairingResultResponse, error)
+	GetTournamentStandings(context.Context, *GetTournamentStandingsRequest) (*GetTournamentStandingsResponse, error)
 	mustEmbedUnimplementedTournamentServiceServer()
 }
 
@@ -107,12 +393,90 @@ func (UnimplementedTournamentServiceServer) GetTournamentUser(context.Context, *
 func (UnimplementedTournamentServiceServer) GetTournamentUsers(context.Context, *GetTournamentUsersRequest) (*GetTournamentUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentUsers not implemented")
 }
+func (UnimplementedTournamentServiceServer) GetTournamentUserHistory(context.Context, *GetTournamentUserHistoryRequest) (*GetTournamentUserHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentUserHistory not implemented")
+}
 func (UnimplementedTournamentServiceServer) UpdateTournamentUser(context.Context, *UpdateTournamentUserRequest) (*UpdateTournamentUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTournamentUser not implemented")
 }
 func (UnimplementedTournamentServiceServer) DeleteTournamentUser(context.Context, *TournamentUserRequest) (*TournamentUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTournamentUser not implemented")
 }
+func (UnimplementedTournamentServiceServer) CreateTournamentTeam(context.Context, *CreateTournamentTeamRequest) (*CreateTournamentTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournamentTeam not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetTournamentTeam(context.Context, *TournamentTeamRequest) (*GetTournamentTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentTeam not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetTournamentTeams(context.Context, *GetTournamentTeamsRequest) (*GetTournamentTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentTeams not implemented")
+}
+func (UnimplementedTournamentServiceServer) UpdateTournamentTeam(context.Context, *UpdateTournamentTeamRequest) (*UpdateTournamentTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTournamentTeam not implemented")
+}
+func (UnimplementedTournamentServiceServer) DeleteTournamentTeam(context.Context, *TournamentTeamRequest) (*TournamentTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTournamentTeam not implemented")
+}
+func (UnimplementedTournamentServiceServer) CreateTournamentDefinition(context.Context, *CreateTournamentDefinitionRequest) (*CreateTournamentDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournamentDefinition not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetTournamentDefinition(context.Context, *TournamentDefinitionRequest) (*GetTournamentDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentDefinition not implemented")
+}
+func (UnimplementedTournamentServiceServer) DeleteTournamentDefinition(context.Context, *TournamentDefinitionRequest) (*TournamentDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTournamentDefinition not implemented")
+}
+func (UnimplementedTournamentServiceServer) SetTournamentWipeTime(context.Context, *SetTournamentWipeTimeRequest) (*SetTournamentWipeTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTournamentWipeTime not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetTournamentWipeTime(context.Context, *TournamentWipeTimeRequest) (*GetTournamentWipeTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentWipeTime not implemented")
+}
+func (UnimplementedTournamentServiceServer) DeleteTournamentWipeTime(context.Context, *TournamentWipeTimeRequest) (*TournamentWipeTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTournamentWipeTime not implemented")
+}
+func (UnimplementedTournamentServiceServer) CreateTournamentRewardTier(context.Context, *CreateTournamentRewardTierRequest) (*CreateTournamentRewardTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournamentRewardTier not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetTournamentRewardTiers(context.Context, *GetTournamentRewardTiersRequest) (*GetTournamentRewardTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentRewardTiers not implemented")
+}
+func (UnimplementedTournamentServiceServer) DeleteTournamentRewardTier(context.Context, *TournamentRewardTierRequest) (*TournamentRewardTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTournamentRewardTier not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetTournamentRewards(context.Context, *GetTournamentRewardsRequest) (*GetTournamentRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentRewards not implemented")
+}
+func (UnimplementedTournamentServiceServer) ClaimTournamentReward(context.Context, *ClaimTournamentRewardRequest) (*ClaimTournamentRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimTournamentReward not implemented")
+}
+func (UnimplementedTournamentServiceServer) SubmitTournamentScore(context.Context, *SubmitTournamentScoreRequest) (*SubmitTournamentScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTournamentScore not implemented")
+}
+func (UnimplementedTournamentServiceServer) CreateTournamentBracket(context.Context, *CreateTournamentBracketRequest) (*CreateTournamentBracketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournamentBracket not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetTournamentBracket(context.Context, *TournamentBracketRequest) (*GetTournamentBracketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentBracket not implemented")
+}
+func (UnimplementedTournamentServiceServer) ReportTournamentBracketMatchResult(context.Context, *ReportTournamentBracketMatchResultRequest) (*ReportTournamentBracketMatchResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportTournamentBracketMatchResult not implemented")
+}
+func (UnimplementedTournamentServiceServer) DeleteTournamentBracket(context.Context, *TournamentBracketRequest) (*TournamentBracketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTournamentBracket not implemented")
+}
+func (UnimplementedTournamentServiceServer) CreateTournamentRound(context.Context, *CreateTournamentRoundRequest) (*CreateTournamentRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournamentRound not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetTournamentPairings(context.Context, *GetTournamentPairingsRequest) (*GetTournamentPairingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentPairings not implemented")
+}
+func (UnimplementedTournamentServiceServer) RecordTournamentPairingResult(context.Context, *RecordTournamentPairingResultRequest) (*RecordTournamentPairingResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordTournamentPairingResult not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetTournamentStandings(context.Context, *GetTournamentStandingsRequest) (*GetTournamentStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentStandings not implemented")
+}
 func (UnimplementedTournamentServiceServer) mustEmbedUnimplementedTournamentServiceServer() {}
 
 // UnsafeTournamentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +544,24 @@ func _TournamentService_GetTournamentUsers_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TournamentService_GetTournamentUserHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentUserHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournamentUserHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/GetTournamentUserHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournamentUserHistory(ctx, req.(*GetTournamentUserHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TournamentService_UpdateTournamentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateTournamentUserRequest)
 	if err := dec(in); err != nil {
@@ -216,32 +598,586 @@ func _TournamentService_DeleteTournamentUser_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
-// TournamentService_ServiceDesc is the grpc.ServiceDesc for TournamentService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var TournamentService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "api.TournamentService",
-	HandlerType: (*TournamentServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTournamentUser",
-			Handler:    _TournamentService_CreateTournamentUser_Handler,
-		},
-		{
-			MethodName: "GetTournamentUser",
-			Handler:    _TournamentService_GetTournamentUser_Handler,
-		},
-		{
-			MethodName: "GetTournamentUsers",
-			Handler:    _TournamentService_GetTournamentUsers_Handler,
-		},
-		{
-			MethodName: "UpdateTournamentUser",
-			Handler:    _TournamentService_UpdateTournamentUser_Handler,
-		},
-		{
-			MethodName: "DeleteTournamentUser",
-			Handler:    _TournamentService_DeleteTournamentUser_Handler,
+func _TournamentService_CreateTournamentTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).CreateTournamentTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/CreateTournamentTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).CreateTournamentTeam(ctx, req.(*CreateTournamentTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetTournamentTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournamentTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/GetTournamentTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournamentTeam(ctx, req.(*TournamentTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetTournamentTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournamentTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/GetTournamentTeams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournamentTeams(ctx, req.(*GetTournamentTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_UpdateTournamentTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTournamentTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).UpdateTournamentTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/UpdateTournamentTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).UpdateTournamentTeam(ctx, req.(*UpdateTournamentTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_DeleteTournamentTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).DeleteTournamentTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/DeleteTournamentTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).DeleteTournamentTeam(ctx, req.(*TournamentTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_CreateTournamentDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).CreateTournamentDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/CreateTournamentDefinition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).CreateTournamentDefinition(ctx, req.(*CreateTournamentDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetTournamentDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournamentDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/GetTournamentDefinition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournamentDefinition(ctx, req.(*TournamentDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_DeleteTournamentDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).DeleteTournamentDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/DeleteTournamentDefinition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).DeleteTournamentDefinition(ctx, req.(*TournamentDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_SetTournamentWipeTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTournamentWipeTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).SetTournamentWipeTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/SetTournamentWipeTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).SetTournamentWipeTime(ctx, req.(*SetTournamentWipeTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetTournamentWipeTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentWipeTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournamentWipeTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/GetTournamentWipeTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournamentWipeTime(ctx, req.(*TournamentWipeTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_DeleteTournamentWipeTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentWipeTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).DeleteTournamentWipeTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/DeleteTournamentWipeTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).DeleteTournamentWipeTime(ctx, req.(*TournamentWipeTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_CreateTournamentRewardTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentRewardTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).CreateTournamentRewardTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/CreateTournamentRewardTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).CreateTournamentRewardTier(ctx, req.(*CreateTournamentRewardTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetTournamentRewardTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentRewardTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournamentRewardTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/GetTournamentRewardTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournamentRewardTiers(ctx, req.(*GetTournamentRewardTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_DeleteTournamentRewardTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRewardTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).DeleteTournamentRewardTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/DeleteTournamentRewardTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).DeleteTournamentRewardTier(ctx, req.(*TournamentRewardTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetTournamentRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournamentRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/GetTournamentRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournamentRewards(ctx, req.(*GetTournamentRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_ClaimTournamentReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimTournamentRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).ClaimTournamentReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/ClaimTournamentReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).ClaimTournamentReward(ctx, req.(*ClaimTournamentRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_SubmitTournamentScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTournamentScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).SubmitTournamentScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/SubmitTournamentScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).SubmitTournamentScore(ctx, req.(*SubmitTournamentScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_CreateTournamentBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentBracketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).CreateTournamentBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/CreateTournamentBracket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).CreateTournamentBracket(ctx, req.(*CreateTournamentBracketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetTournamentBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentBracketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournamentBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/GetTournamentBracket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournamentBracket(ctx, req.(*TournamentBracketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_ReportTournamentBracketMatchResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportTournamentBracketMatchResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).ReportTournamentBracketMatchResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/ReportTournamentBracketMatchResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).ReportTournamentBracketMatchResult(ctx, req.(*ReportTournamentBracketMatchResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_DeleteTournamentBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentBracketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).DeleteTournamentBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/DeleteTournamentBracket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).DeleteTournamentBracket(ctx, req.(*TournamentBracketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_CreateTournamentRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).CreateTournamentRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/CreateTournamentRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).CreateTournamentRound(ctx, req.(*CreateTournamentRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetTournamentPairings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentPairingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournamentPairings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/GetTournamentPairings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournamentPairings(ctx, req.(*GetTournamentPairingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_RecordTournamentPairingResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordTournamentPairingResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).RecordTournamentPairingResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/RecordTournamentPairingResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).RecordTournamentPairingResult(ctx, req.(*RecordTournamentPairingResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetTournamentStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournamentStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TournamentService/GetTournamentStandings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournamentStandings(ctx, req.(*GetTournamentStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TournamentService_ServiceDesc is the grpc.ServiceDesc for TournamentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TournamentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.TournamentService",
+	HandlerType: (*TournamentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTournamentUser",
+			Handler:    _TournamentService_CreateTournamentUser_Handler,
+		},
+		{
+			MethodName: "GetTournamentUser",
+			Handler:    _TournamentService_GetTournamentUser_Handler,
+		},
+		{
+			MethodName: "GetTournamentUsers",
+			Handler:    _TournamentService_GetTournamentUsers_Handler,
+		},
+		{
+			MethodName: "GetTournamentUserHistory",
+			Handler:    _TournamentService_GetTournamentUserHistory_Handler,
+		},
+		{
+			MethodName: "UpdateTournamentUser",
+			Handler:    _TournamentService_UpdateTournamentUser_Handler,
+		},
+		{
+			MethodName: "DeleteTournamentUser",
+			Handler:    _TournamentService_DeleteTournamentUser_Handler,
+		},
+		{
+			MethodName: "CreateTournamentTeam",
+			Handler:    _TournamentService_CreateTournamentTeam_Handler,
+		},
+		{
+			MethodName: "GetTournamentTeam",
+			Handler:    _TournamentService_GetTournamentTeam_Handler,
+		},
+		{
+			MethodName: "GetTournamentTeams",
+			Handler:    _TournamentService_GetTournamentTeams_Handler,
+		},
+		{
+			MethodName: "UpdateTournamentTeam",
+			Handler:    _TournamentService_UpdateTournamentTeam_Handler,
+		},
+		{
+			MethodName: "DeleteTournamentTeam",
+			Handler:    _TournamentService_DeleteTournamentTeam_Handler,
+		},
+		{
+			MethodName: "CreateTournamentDefinition",
+			Handler:    _TournamentService_CreateTournamentDefinition_Handler,
+		},
+		{
+			MethodName: "GetTournamentDefinition",
+			Handler:    _TournamentService_GetTournamentDefinition_Handler,
+		},
+		{
+			MethodName: "DeleteTournamentDefinition",
+			Handler:    _TournamentService_DeleteTournamentDefinition_Handler,
+		},
+		{
+			MethodName: "SetTournamentWipeTime",
+			Handler:    _TournamentService_SetTournamentWipeTime_Handler,
+		},
+		{
+			MethodName: "GetTournamentWipeTime",
+			Handler:    _TournamentService_GetTournamentWipeTime_Handler,
+		},
+		{
+			MethodName: "DeleteTournamentWipeTime",
+			Handler:    _TournamentService_DeleteTournamentWipeTime_Handler,
+		},
+		{
+			MethodName: "CreateTournamentRewardTier",
+			Handler:    _TournamentService_CreateTournamentRewardTier_Handler,
+		},
+		{
+			MethodName: "GetTournamentRewardTiers",
+			Handler:    _TournamentService_GetTournamentRewardTiers_Handler,
+		},
+		{
+			MethodName: "DeleteTournamentRewardTier",
+			Handler:    _TournamentService_DeleteTournamentRewardTier_Handler,
+		},
+		{
+			MethodName: "GetTournamentRewards",
+			Handler:    _TournamentService_GetTournamentRewards_Handler,
+		},
+		{
+			MethodName: "ClaimTournamentReward",
+			Handler:    _TournamentService_ClaimTournamentReward_Handler,
+		},
+		{
+			MethodName: "SubmitTournamentScore",
+			Handler:    _TournamentService_SubmitTournamentScore_Handler,
+		},
+		{
+			MethodName: "CreateTournamentBracket",
+			Handler:    _TournamentService_CreateTournamentBracket_Handler,
+		},
+		{
+			MethodName: "GetTournamentBracket",
+			Handler:    _TournamentService_GetTournamentBracket_Handler,
+		},
+		{
+			MethodName: "ReportTournamentBracketMatchResult",
+			Handler:    _TournamentService_ReportTournamentBracketMatchResult_Handler,
+		},
+		{
+			MethodName: "DeleteTournamentBracket",
+			Handler:    _TournamentService_DeleteTournamentBracket_Handler,
+		},
+		{
+			MethodName: "CreateTournamentRound",
+			Handler:    _TournamentService_CreateTournamentRound_Handler,
+		},
+		{
+			MethodName: "GetTournamentPairings",
+			Handler:    _TournamentService_GetTournamentPairings_Handler,
+		},
+		{
+			MethodName: "RecordTournamentPairingResult",
+			Handler:    _TournamentService_RecordTournamentPairingResult_Handler,
+		},
+		{
+			MethodName: "GetTournamentStandings",
+			Handler:    _TournamentService_GetTournamentStandings_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/MorhafAlshibly/coanda/internal/createEventsFromTemplates"
+	"github.com/MorhafAlshibly/coanda/internal/createEventsFromTemplates/model"
+	lambdaFunc "github.com/aws/aws-lambda-go/lambda"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/peterbourgon/ff/v4"
+	"github.com/peterbourgon/ff/v4/ffhelp"
+	"github.com/robfig/cron/v3"
+)
+
+var (
+	fs           = ff.NewFlagSet("createEventsFromTemplates")
+	lambda       = fs.BoolLong("lambda", "if running as a lambda function")
+	cronSchedule = fs.StringLong("cronSchedule", "*/5 * * * *", "the cron schedule to run the handler (not for lambda)")
+	dsn          = fs.StringLong("dsn", "root:password@tcp(localhost:3306)", "the data source name for the database")
+	catchUp      = fs.DurationLong("catchUp", time.Hour, "how long after its start time an event is still created, in case a run was missed")
+	limit        = fs.UintLong("limit", 100, "the limit of event templates per loop, tweak this based on performance")
+)
+
+func main() {
+	ctx := context.TODO()
+	err := ff.Parse(fs, os.Args[1:], ff.WithEnvVarPrefix("CREATE_EVENTS_FROM_TEMPLATES"), ff.WithConfigFileFlag("config"), ff.WithConfigFileParser(ff.PlainParser))
+	if err != nil {
+		fmt.Printf("%s\n", ffhelp.Flags(fs))
+		fmt.Printf("failed to parse flags: %v", err)
+		return
+	}
+	dbConn, err := sql.Open("mysql", *dsn)
+	if err != nil {
+		fmt.Printf("failed to open database: %v", err)
+		return
+	}
+	defer dbConn.Close()
+	db := model.New(dbConn)
+	// Create the app
+	app := createEventsFromTemplates.NewApp(
+		createEventsFromTemplates.WithSql(dbConn),
+		createEventsFromTemplates.WithDatabase(db),
+		createEventsFromTemplates.WithCatchUp(*catchUp),
+		createEventsFromTemplates.WithLimit(int32(*limit)),
+	)
+	if !*lambda {
+		// Run the handler on a cron job
+		c := cron.New(cron.WithLogger(cron.VerbosePrintfLogger(log.New(os.Stdout, "cron: ", log.LstdFlags))))
+		c.AddFunc(*cronSchedule, func() {
+			if err := app.Handler(ctx); err != nil {
+				fmt.Printf("failed to run handler: %v", err)
+				return
+			}
+		})
+		c.Start()
+		// Wait for a signal to stop the cron job
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+	} else {
+		// Run the lambda if not running on a cron job
+		lambdaFunc.Start(app.Handler)
+	}
+}
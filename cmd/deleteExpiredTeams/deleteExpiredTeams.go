@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/MorhafAlshibly/coanda/internal/deleteExpiredTeams"
+	"github.com/MorhafAlshibly/coanda/internal/deleteExpiredTeams/model"
+	lambdaFunc "github.com/aws/aws-lambda-go/lambda"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/peterbourgon/ff/v4"
+	"github.com/peterbourgon/ff/v4/ffhelp"
+)
+
+var (
+	fs             = ff.NewFlagSet("deleteExpiredTeams")
+	lambda         = fs.BoolLong("lambda", "if running as a lambda function")
+	tickerInterval = fs.DurationLong("tickerInterval", time.Hour, "the interval to run the handler (not for lambda)")
+	dsn            = fs.StringLong("dsn", "root:password@tcp(localhost:3306)", "the data source name for the database")
+	gracePeriod    = fs.DurationLong("gracePeriod", 7*24*time.Hour, "how long an empty team is kept before it is permanently deleted")
+	limit          = fs.UintLong("limit", 100, "the limit of teams deleted in each batch, tweak this based on performance")
+)
+
+func main() {
+	ctx := context.TODO()
+	err := ff.Parse(fs, os.Args[1:], ff.WithEnvVarPrefix("DELETE_EXPIRED_TEAMS"), ff.WithConfigFileFlag("config"), ff.WithConfigFileParser(ff.PlainParser))
+	if err != nil {
+		fmt.Printf("%s\n", ffhelp.Flags(fs))
+		fmt.Printf("failed to parse flags: %v", err)
+		return
+	}
+	dbConn, err := sql.Open("mysql", *dsn)
+	if err != nil {
+		fmt.Printf("failed to open database: %v", err)
+		return
+	}
+	defer dbConn.Close()
+	db := model.New(dbConn)
+	// Create the app
+	app := deleteExpiredTeams.NewApp(
+		deleteExpiredTeams.WithDatabase(db),
+		deleteExpiredTeams.WithGracePeriod(*gracePeriod),
+		deleteExpiredTeams.WithLimit(int32(*limit)),
+	)
+	if !*lambda {
+		ticker := time.NewTicker(*tickerInterval)
+		defer ticker.Stop() // Always stop ticker to release resources
+
+		for t := range ticker.C {
+			fmt.Printf("Running handler at %s\n", t)
+			if err := app.Handler(ctx); err != nil {
+				fmt.Printf("failed to run handler: %v\n", err)
+				return
+			}
+		}
+	} else {
+		// Run the lambda if not running on a cron job
+		lambdaFunc.Start(app.Handler)
+	}
+}
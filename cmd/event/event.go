@@ -21,22 +21,23 @@ import (
 
 var (
 	// Flags set from command line/environment variables
-	fs                   = ff.NewFlagSet("event")
-	service              = fs.String('s', "service", "event", "the name of the service")
-	port                 = fs.Uint('p', "port", 50055, "the default port to listen on")
-	metricPort           = fs.Uint('m', "metricPort", 8085, "the port to serve metric on")
-	dsn                  = fs.StringLong("dsn", "root:password@tcp(localhost:3306)", "the data source name for the database")
-	cacheHost            = fs.StringLong("cacheHost", "localhost:6379", "the connection string to the cache")
-	cachePassword        = fs.StringLong("cachePassword", "", "the password to the cache")
-	cacheDB              = fs.IntLong("cacheDB", 0, "the database to use in the cache")
-	cacheExpiration      = fs.DurationLong("cacheExpiration", 5*time.Second, "the expiration time for the cache")
-	minEventNameLength   = fs.UintLong("minEventNameLength", 3, "the min event name length")
-	maxEventNameLength   = fs.UintLong("maxEventNameLength", 20, "the max event name length")
-	minRoundNameLength   = fs.UintLong("minRoundNameLength", 3, "the min round name length")
-	maxRoundNameLength   = fs.UintLong("maxRoundNameLength", 20, "the max round name length")
-	maxNumberOfRounds    = fs.UintLong("maxNumberOfRounds", 10, "the max number of rounds")
-	defaultMaxPageLength = fs.UintLong("defaultMaxPageLength", 10, "the default max page length")
-	maxMaxPageLength     = fs.UintLong("maxMaxPageLength", 100, "the max max page length")
+	fs                              = ff.NewFlagSet("event")
+	service                         = fs.String('s', "service", "event", "the name of the service")
+	port                            = fs.Uint('p', "port", 50055, "the default port to listen on")
+	metricPort                      = fs.Uint('m', "metricPort", 8085, "the port to serve metric on")
+	dsn                             = fs.StringLong("dsn", "root:password@tcp(localhost:3306)", "the data source name for the database")
+	cacheHost                       = fs.StringLong("cacheHost", "localhost:6379", "the connection string to the cache")
+	cachePassword                   = fs.StringLong("cachePassword", "", "the password to the cache")
+	cacheDB                         = fs.IntLong("cacheDB", 0, "the database to use in the cache")
+	cacheExpiration                 = fs.DurationLong("cacheExpiration", 5*time.Second, "the expiration time for the cache, which is how long event statuses can lag behind")
+	minEventNameLength              = fs.UintLong("minEventNameLength", 3, "the min event name length")
+	maxEventNameLength              = fs.UintLong("maxEventNameLength", 20, "the max event name length")
+	minRoundNameLength              = fs.UintLong("minRoundNameLength", 3, "the min round name length")
+	maxRoundNameLength              = fs.UintLong("maxRoundNameLength", 20, "the max round name length")
+	maxNumberOfRounds               = fs.UintLong("maxNumberOfRounds", 10, "the max number of rounds")
+	defaultMaxPageLength            = fs.UintLong("defaultMaxPageLength", 10, "the default max page length")
+	maxMaxPageLength                = fs.UintLong("maxMaxPageLength", 100, "the max max page length")
+	maxEventTemplateLeadTimeSeconds = fs.UintLong("maxEventTemplateLeadTimeSeconds", 2592000, "the max lead time of an event template in seconds")
 )
 
 func main() {
@@ -78,6 +79,7 @@ func main() {
 		event.WithMaxNumberOfRounds(uint8(*maxNumberOfRounds)),
 		event.WithDefaultMaxPageLength(uint8(*defaultMaxPageLength)),
 		event.WithMaxMaxPageLength(uint8(*maxMaxPageLength)),
+		event.WithMaxEventTemplateLeadTimeSeconds(uint32(*maxEventTemplateLeadTimeSeconds)),
 	)
 	api.RegisterEventServiceServer(grpcServer, eventService)
 	if err := grpcServer.Serve(lis); err != nil {
@@ -23,6 +23,7 @@ var (
 	eloWindowIncrementPerSecond = fs.UintLong("eloWindowIncrementPerSecond", 10, "the elo window increment per second elapsed since creation of the ticket")
 	eloWindowMax                = fs.UintLong("eloWindowMax", 600, "the elo window max")
 	limit                       = fs.UintLong("limit", 100, "the limit of tickets handled each loop, tweak this based on performance")
+	closestMatchLimit           = fs.UintLong("closestMatchLimit", 10, "the limit of closest matches checked for room on a team for each ticket")
 )
 
 func main() {
@@ -47,6 +48,7 @@ func main() {
 		handleMatchmaking.WithEloWindowIncrementPerSecond(uint16(*eloWindowIncrementPerSecond)),
 		handleMatchmaking.WithEloWindowMax(uint16(*eloWindowMax)),
 		handleMatchmaking.WithLimit(int32(*limit)),
+		handleMatchmaking.WithClosestMatchLimit(int32(*closestMatchLimit)),
 	)
 	if !*lambda {
 		ticker := time.NewTicker(*tickerInterval)
@@ -33,6 +33,8 @@ var (
 	maxMembers           = fs.UintLong("maxMembers", 5, "the max members")
 	minTeamNameLength    = fs.UintLong("minTeamNameLength", 3, "the min team name length")
 	maxTeamNameLength    = fs.UintLong("maxTeamNameLength", 20, "the max team name length")
+	maxMessageLength     = fs.UintLong("maxMessageLength", 500, "the max team message length")
+	maxSeasonLabelLength = fs.UintLong("maxSeasonLabelLength", 64, "the max season label length")
 	defaultMaxPageLength = fs.UintLong("defaultMaxPageLength", 10, "the default max page length")
 	maxMaxPageLength     = fs.UintLong("maxMaxPageLength", 100, "the max max page length")
 )
@@ -72,6 +74,8 @@ func main() {
 		team.WithMaxMembers(uint8(*maxMembers)),
 		team.WithMinTeamNameLength(uint8(*minTeamNameLength)),
 		team.WithMaxTeamNameLength(uint8(*maxTeamNameLength)),
+		team.WithMaxMessageLength(uint16(*maxMessageLength)),
+		team.WithMaxSeasonLabelLength(uint8(*maxSeasonLabelLength)),
 		team.WithDefaultMaxPageLength(uint8(*defaultMaxPageLength)),
 		team.WithMaxMaxPageLength(uint8(*maxMaxPageLength)),
 	)
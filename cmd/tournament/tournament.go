@@ -17,6 +17,7 @@ import (
 	"github.com/peterbourgon/ff/v4/ffhelp"
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 var (
@@ -39,6 +40,7 @@ var (
 	maxTournamentNameLength = fs.UintLong("maxTournamentNameLength", 20, "the max tournament name length")
 	defaultMaxPageLength    = fs.UintLong("defaultMaxPageLength", 10, "the default max page length")
 	maxMaxPageLength        = fs.UintLong("maxMaxPageLength", 100, "the max max page length")
+	matchmakingHost         = fs.StringLong("matchmakingHost", "localhost:50056", "the endpoint of the matchmaking service")
 )
 
 func main() {
@@ -67,6 +69,12 @@ func main() {
 		fmt.Printf("failed to create metric: %v", err)
 		return
 	}
+	matchmakingConn, err := grpc.NewClient(*matchmakingHost, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fmt.Printf("did not connect: %v", err)
+		return
+	}
+	defer matchmakingConn.Close()
 	grpcServer := grpc.NewServer()
 	tournamentService := tournament.NewService(
 		tournament.WithSql(dbConn),
@@ -82,6 +90,7 @@ func main() {
 		tournament.WithMonthlyTournamentDay(uint8(*monthlyTournamentDay)),
 		tournament.WithDefaultMaxPageLength(uint8(*defaultMaxPageLength)),
 		tournament.WithMaxMaxPageLength(uint8(*maxMaxPageLength)),
+		tournament.WithMatchmakingClient(api.NewMatchmakingServiceClient(matchmakingConn)),
 	)
 	api.RegisterTournamentServiceServer(grpcServer, tournamentService)
 	if err := grpcServer.Serve(lis); err != nil {
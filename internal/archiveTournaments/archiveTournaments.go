@@ -0,0 +1,123 @@
+package archiveTournaments
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/MorhafAlshibly/coanda/internal/archiveTournaments/model"
+)
+
+type App struct {
+	database  *model.Queries
+	sql       *sql.DB
+	retention time.Duration
+	limit     int32
+}
+
+func WithDatabase(database *model.Queries) func(*App) {
+	return func(input *App) {
+		input.database = database
+	}
+}
+
+func WithSql(sql *sql.DB) func(*App) {
+	return func(input *App) {
+		input.sql = sql
+	}
+}
+
+func WithRetention(retention time.Duration) func(*App) {
+	return func(input *App) {
+		input.retention = retention
+	}
+}
+
+func WithLimit(limit int32) func(*App) {
+	return func(input *App) {
+		input.limit = limit
+	}
+}
+
+func NewApp(options ...func(*App)) *App {
+	app := &App{
+		retention: 30 * 24 * time.Hour,
+		limit:     100,
+	}
+	for _, option := range options {
+		option(app)
+	}
+	return app
+}
+
+func (a *App) Handler(ctx context.Context) error {
+	// Windows sent to the third party before this time have passed their retention
+	sentBefore := time.Now().UTC().Add(-a.retention)
+	for {
+		tournaments, err := a.database.GetArchivableTournaments(ctx, model.GetArchivableTournamentsParams{
+			SentToThirdPartyAt:   sql.NullTime{Time: sentBefore, Valid: true},
+			SentToThirdPartyAt_2: sql.NullTime{Time: sentBefore, Valid: true},
+			Limit:                a.limit,
+		})
+		if err != nil {
+			fmt.Printf("failed to get archivable tournaments: %v", err)
+			return err
+		}
+		for _, tournament := range tournaments {
+			err = a.archiveTournament(ctx, tournament)
+			if err != nil {
+				fmt.Printf("failed to archive tournament %s: %v", tournament.Name, err)
+				return err
+			}
+		}
+		if len(tournaments) < int(a.limit) {
+			break
+		}
+	}
+	return nil
+}
+
+// archiveTournament moves a whole window at once, as the ranking of the rows left behind would change if it was split
+func (a *App) archiveTournament(ctx context.Context, tournament model.GetArchivableTournamentsRow) error {
+	tx, err := a.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := a.database.WithTx(tx)
+	// Teams are archived first, as their score is summed from the members that are about to be archived
+	_, err = qtx.ArchiveTournamentTeams(ctx, model.ArchiveTournamentTeamsParams{
+		Name:                tournament.Name,
+		TournamentInterval:  model.TournamentTeamTournamentInterval(tournament.TournamentInterval),
+		TournamentStartedAt: tournament.TournamentStartedAt,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = qtx.ArchiveTournaments(ctx, model.ArchiveTournamentsParams{
+		Name:                tournament.Name,
+		TournamentInterval:  tournament.TournamentInterval,
+		TournamentStartedAt: tournament.TournamentStartedAt,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = qtx.DeleteTournamentTeams(ctx, model.DeleteTournamentTeamsParams{
+		Name:                tournament.Name,
+		TournamentInterval:  model.TournamentTeamTournamentInterval(tournament.TournamentInterval),
+		TournamentStartedAt: tournament.TournamentStartedAt,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = qtx.DeleteTournaments(ctx, model.DeleteTournamentsParams{
+		Name:                tournament.Name,
+		TournamentInterval:  tournament.TournamentInterval,
+		TournamentStartedAt: tournament.TournamentStartedAt,
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,87 @@
+-- name: GetArchivableTournaments :many
+-- Windows with only team entries are found through the teams
+SELECT name,
+    tournament_interval,
+    tournament_started_at
+FROM tournament
+WHERE tournament.sent_to_third_party_at < ?
+UNION
+SELECT name,
+    tournament_interval,
+    tournament_started_at
+FROM tournament_team
+WHERE tournament_team.sent_to_third_party_at < ?
+ORDER BY tournament_started_at ASC,
+    name ASC,
+    tournament_interval ASC
+LIMIT ?;
+-- name: ArchiveTournamentTeams :execresult
+INSERT INTO archived_tournament_team (
+        id,
+        name,
+        tournament_interval,
+        team_id,
+        score,
+        ranking,
+        data,
+        tournament_started_at,
+        sent_to_third_party_at,
+        created_at,
+        updated_at
+    )
+SELECT rtt.id,
+    rtt.name,
+    rtt.tournament_interval,
+    rtt.team_id,
+    rtt.score,
+    rtt.ranking,
+    rtt.data,
+    rtt.tournament_started_at,
+    rtt.sent_to_third_party_at,
+    rtt.created_at,
+    rtt.updated_at
+FROM ranked_tournament_team rtt
+WHERE rtt.name = sqlc.arg(name)
+    AND rtt.tournament_interval = sqlc.arg(tournament_interval)
+    AND rtt.tournament_started_at = sqlc.arg(tournament_started_at);
+-- name: ArchiveTournaments :execresult
+INSERT INTO archived_tournament (
+        id,
+        name,
+        tournament_interval,
+        user_id,
+        team_id,
+        score,
+        ranking,
+        data,
+        tournament_started_at,
+        sent_to_third_party_at,
+        created_at,
+        updated_at
+    )
+SELECT rt.id,
+    rt.name,
+    rt.tournament_interval,
+    rt.user_id,
+    rt.team_id,
+    rt.score,
+    rt.ranking,
+    rt.data,
+    rt.tournament_started_at,
+    rt.sent_to_third_party_at,
+    rt.created_at,
+    rt.updated_at
+FROM ranked_tournament rt
+WHERE rt.name = sqlc.arg(name)
+    AND rt.tournament_interval = sqlc.arg(tournament_interval)
+    AND rt.tournament_started_at = sqlc.arg(tournament_started_at);
+-- name: DeleteTournamentTeams :execresult
+DELETE FROM tournament_team
+WHERE name = ?
+    AND tournament_interval = ?
+    AND tournament_started_at = ?;
+-- name: DeleteTournaments :execresult
+DELETE FROM tournament
+WHERE name = ?
+    AND tournament_interval = ?
+    AND tournament_started_at = ?;
@@ -0,0 +1,184 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: queries.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const ArchiveTournamentTeams = `-- name: ArchiveTournamentTeams :execresult
+INSERT INTO archived_tournament_team (
+        id,
+        name,
+        tournament_interval,
+        team_id,
+        score,
+        ranking,
+        data,
+        tournament_started_at,
+        sent_to_third_party_at,
+        created_at,
+        updated_at
+    )
+SELECT rtt.id,
+    rtt.name,
+    rtt.tournament_interval,
+    rtt.team_id,
+    rtt.score,
+    rtt.ranking,
+    rtt.data,
+    rtt.tournament_started_at,
+    rtt.sent_to_third_party_at,
+    rtt.created_at,
+    rtt.updated_at
+FROM ranked_tournament_team rtt
+WHERE rtt.name = ?
+    AND rtt.tournament_interval = ?
+    AND rtt.tournament_started_at = ?
+`
+
+type ArchiveTournamentTeamsParams struct {
+	Name                string                           `db:"name"`
+	TournamentInterval  TournamentTeamTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                        `db:"tournament_started_at"`
+}
+
+func (q *Queries) ArchiveTournamentTeams(ctx context.Context, arg ArchiveTournamentTeamsParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, ArchiveTournamentTeams, arg.Name, arg.TournamentInterval, arg.TournamentStartedAt)
+}
+
+const ArchiveTournaments = `-- name: ArchiveTournaments :execresult
+INSERT INTO archived_tournament (
+        id,
+        name,
+        tournament_interval,
+        user_id,
+        team_id,
+        score,
+        ranking,
+        data,
+        tournament_started_at,
+        sent_to_third_party_at,
+        created_at,
+        updated_at
+    )
+SELECT rt.id,
+    rt.name,
+    rt.tournament_interval,
+    rt.user_id,
+    rt.team_id,
+    rt.score,
+    rt.ranking,
+    rt.data,
+    rt.tournament_started_at,
+    rt.sent_to_third_party_at,
+    rt.created_at,
+    rt.updated_at
+FROM ranked_tournament rt
+WHERE rt.name = ?
+    AND rt.tournament_interval = ?
+    AND rt.tournament_started_at = ?
+`
+
+type ArchiveTournamentsParams struct {
+	Name                string                       `db:"name"`
+	TournamentInterval  TournamentTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
+}
+
+func (q *Queries) ArchiveTournaments(ctx context.Context, arg ArchiveTournamentsParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, ArchiveTournaments, arg.Name, arg.TournamentInterval, arg.TournamentStartedAt)
+}
+
+const DeleteTournamentTeams = `-- name: DeleteTournamentTeams :execresult
+DELETE FROM tournament_team
+WHERE name = ?
+    AND tournament_interval = ?
+    AND tournament_started_at = ?
+`
+
+type DeleteTournamentTeamsParams struct {
+	Name                string                           `db:"name"`
+	TournamentInterval  TournamentTeamTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                        `db:"tournament_started_at"`
+}
+
+func (q *Queries) DeleteTournamentTeams(ctx context.Context, arg DeleteTournamentTeamsParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, DeleteTournamentTeams, arg.Name, arg.TournamentInterval, arg.TournamentStartedAt)
+}
+
+const DeleteTournaments = `-- name: DeleteTournaments :execresult
+DELETE FROM tournament
+WHERE name = ?
+    AND tournament_interval = ?
+    AND tournament_started_at = ?
+`
+
+type DeleteTournamentsParams struct {
+	Name                string                       `db:"name"`
+	TournamentInterval  TournamentTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
+}
+
+func (q *Queries) DeleteTournaments(ctx context.Context, arg DeleteTournamentsParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, DeleteTournaments, arg.Name, arg.TournamentInterval, arg.TournamentStartedAt)
+}
+
+const GetArchivableTournaments = `-- name: GetArchivableTournaments :many
+SELECT name,
+    tournament_interval,
+    tournament_started_at
+FROM tournament
+WHERE tournament.sent_to_third_party_at < ?
+UNION
+SELECT name,
+    tournament_interval,
+    tournament_started_at
+FROM tournament_team
+WHERE tournament_team.sent_to_third_party_at < ?
+ORDER BY tournament_started_at ASC,
+    name ASC,
+    tournament_interval ASC
+LIMIT ?
+`
+
+type GetArchivableTournamentsParams struct {
+	SentToThirdPartyAt   sql.NullTime `db:"sent_to_third_party_at"`
+	SentToThirdPartyAt_2 sql.NullTime `db:"sent_to_third_party_at_2"`
+	Limit                int32        `db:"limit"`
+}
+
+type GetArchivableTournamentsRow struct {
+	Name                string                       `db:"name"`
+	TournamentInterval  TournamentTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
+}
+
+// Windows with only team entries are found through the teams
+func (q *Queries) GetArchivableTournaments(ctx context.Context, arg GetArchivableTournamentsParams) ([]GetArchivableTournamentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, GetArchivableTournaments, arg.SentToThirdPartyAt, arg.SentToThirdPartyAt_2, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetArchivableTournamentsRow
+	for rows.Next() {
+		var i GetArchivableTournamentsRow
+		if err := rows.Scan(&i.Name, &i.TournamentInterval, &i.TournamentStartedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -31,6 +31,13 @@ const (
 	AddEventResultErrorRoundUserDataRequired AddEventResultError = "ROUND_USER_DATA_REQUIRED"
 	AddEventResultErrorNotFound              AddEventResultError = "NOT_FOUND"
 	AddEventResultErrorEventEnded            AddEventResultError = "EVENT_ENDED"
+	AddEventResultErrorEventPaused           AddEventResultError = "EVENT_PAUSED"
+	AddEventResultErrorEventCancelled        AddEventResultError = "EVENT_CANCELLED"
+	AddEventResultErrorNotRegistered         AddEventResultError = "NOT_REGISTERED"
+	AddEventResultErrorRegistrationClosed    AddEventResultError = "REGISTRATION_CLOSED"
+	AddEventResultErrorEventFull             AddEventResultError = "EVENT_FULL"
+	AddEventResultErrorNotEligible           AddEventResultError = "NOT_ELIGIBLE"
+	AddEventResultErrorDisqualified          AddEventResultError = "DISQUALIFIED"
 )
 
 var AllAddEventResultError = []AddEventResultError{
@@ -44,11 +51,18 @@ var AllAddEventResultError = []AddEventResultError{
 	AddEventResultErrorRoundUserDataRequired,
 	AddEventResultErrorNotFound,
 	AddEventResultErrorEventEnded,
+	AddEventResultErrorEventPaused,
+	AddEventResultErrorEventCancelled,
+	AddEventResultErrorNotRegistered,
+	AddEventResultErrorRegistrationClosed,
+	AddEventResultErrorEventFull,
+	AddEventResultErrorNotEligible,
+	AddEventResultErrorDisqualified,
 }
 
 func (e AddEventResultError) IsValid() bool {
 	switch e {
-	case AddEventResultErrorNone, AddEventResultErrorNameTooShort, AddEventResultErrorNameTooLong, AddEventResultErrorIDOrNameRequired, AddEventResultErrorClientUserIDRequired, AddEventResultErrorResultRequired, AddEventResultErrorUserDataRequired, AddEventResultErrorRoundUserDataRequired, AddEventResultErrorNotFound, AddEventResultErrorEventEnded:
+	case AddEventResultErrorNone, AddEventResultErrorNameTooShort, AddEventResultErrorNameTooLong, AddEventResultErrorIDOrNameRequired, AddEventResultErrorClientUserIDRequired, AddEventResultErrorResultRequired, AddEventResultErrorUserDataRequired, AddEventResultErrorRoundUserDataRequired, AddEventResultErrorNotFound, AddEventResultErrorEventEnded, AddEventResultErrorEventPaused, AddEventResultErrorEventCancelled, AddEventResultErrorNotRegistered, AddEventResultErrorRegistrationClosed, AddEventResultErrorEventFull, AddEventResultErrorNotEligible, AddEventResultErrorDisqualified:
 		return true
 	}
 	return false
@@ -89,6 +103,134 @@ func (e AddEventResultError) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Possible errors when cancelling an event.
+type CancelEventError string
+
+const (
+	CancelEventErrorNone             CancelEventError = "NONE"
+	CancelEventErrorNameTooShort     CancelEventError = "NAME_TOO_SHORT"
+	CancelEventErrorNameTooLong      CancelEventError = "NAME_TOO_LONG"
+	CancelEventErrorIDOrNameRequired CancelEventError = "ID_OR_NAME_REQUIRED"
+	CancelEventErrorNotFound         CancelEventError = "NOT_FOUND"
+	CancelEventErrorAlreadyCancelled CancelEventError = "ALREADY_CANCELLED"
+	CancelEventErrorEventEnded       CancelEventError = "EVENT_ENDED"
+)
+
+var AllCancelEventError = []CancelEventError{
+	CancelEventErrorNone,
+	CancelEventErrorNameTooShort,
+	CancelEventErrorNameTooLong,
+	CancelEventErrorIDOrNameRequired,
+	CancelEventErrorNotFound,
+	CancelEventErrorAlreadyCancelled,
+	CancelEventErrorEventEnded,
+}
+
+func (e CancelEventError) IsValid() bool {
+	switch e {
+	case CancelEventErrorNone, CancelEventErrorNameTooShort, CancelEventErrorNameTooLong, CancelEventErrorIDOrNameRequired, CancelEventErrorNotFound, CancelEventErrorAlreadyCancelled, CancelEventErrorEventEnded:
+		return true
+	}
+	return false
+}
+
+func (e CancelEventError) String() string {
+	return string(e)
+}
+
+func (e *CancelEventError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CancelEventError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CancelEventError", str)
+	}
+	return nil
+}
+
+func (e CancelEventError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CancelEventError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CancelEventError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when claiming a tournament reward.
+type ClaimTournamentRewardError string
+
+const (
+	ClaimTournamentRewardErrorNone           ClaimTournamentRewardError = "NONE"
+	ClaimTournamentRewardErrorIDRequired     ClaimTournamentRewardError = "ID_REQUIRED"
+	ClaimTournamentRewardErrorUserIDRequired ClaimTournamentRewardError = "USER_ID_REQUIRED"
+	ClaimTournamentRewardErrorNotFound       ClaimTournamentRewardError = "NOT_FOUND"
+	ClaimTournamentRewardErrorAlreadyClaimed ClaimTournamentRewardError = "ALREADY_CLAIMED"
+)
+
+var AllClaimTournamentRewardError = []ClaimTournamentRewardError{
+	ClaimTournamentRewardErrorNone,
+	ClaimTournamentRewardErrorIDRequired,
+	ClaimTournamentRewardErrorUserIDRequired,
+	ClaimTournamentRewardErrorNotFound,
+	ClaimTournamentRewardErrorAlreadyClaimed,
+}
+
+func (e ClaimTournamentRewardError) IsValid() bool {
+	switch e {
+	case ClaimTournamentRewardErrorNone, ClaimTournamentRewardErrorIDRequired, ClaimTournamentRewardErrorUserIDRequired, ClaimTournamentRewardErrorNotFound, ClaimTournamentRewardErrorAlreadyClaimed:
+		return true
+	}
+	return false
+}
+
+func (e ClaimTournamentRewardError) String() string {
+	return string(e)
+}
+
+func (e *ClaimTournamentRewardError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ClaimTournamentRewardError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ClaimTournamentRewardError", str)
+	}
+	return nil
+}
+
+func (e ClaimTournamentRewardError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ClaimTournamentRewardError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ClaimTournamentRewardError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Possible errors when completing an task.
 type CompleteTaskError string
 
@@ -165,6 +307,15 @@ const (
 	CreateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanMaxPlayers CreateArenaError = "MAX_PLAYERS_PER_TICKET_CANNOT_BE_GREATER_THAN_MAX_PLAYERS"
 	CreateArenaErrorDataRequired                                     CreateArenaError = "DATA_REQUIRED"
 	CreateArenaErrorAlreadyExists                                    CreateArenaError = "ALREADY_EXISTS"
+	CreateArenaErrorTeamCountRequired                                CreateArenaError = "TEAM_COUNT_REQUIRED"
+	CreateArenaErrorMaxPlayersMustBeDivisibleByTeamCount             CreateArenaError = "MAX_PLAYERS_MUST_BE_DIVISIBLE_BY_TEAM_COUNT"
+	CreateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanTeamSize   CreateArenaError = "MAX_PLAYERS_PER_TICKET_CANNOT_BE_GREATER_THAN_TEAM_SIZE"
+	CreateArenaErrorRoleDataKeyRequired                              CreateArenaError = "ROLE_DATA_KEY_REQUIRED"
+	CreateArenaErrorRolesRequired                                    CreateArenaError = "ROLES_REQUIRED"
+	CreateArenaErrorRoleNameRequired                                 CreateArenaError = "ROLE_NAME_REQUIRED"
+	CreateArenaErrorRoleCountRequired                                CreateArenaError = "ROLE_COUNT_REQUIRED"
+	CreateArenaErrorRoleNameDuplicate                                CreateArenaError = "ROLE_NAME_DUPLICATE"
+	CreateArenaErrorRoleCountsMustAddUpToTeamSize                    CreateArenaError = "ROLE_COUNTS_MUST_ADD_UP_TO_TEAM_SIZE"
 )
 
 var AllCreateArenaError = []CreateArenaError{
@@ -178,11 +329,20 @@ var AllCreateArenaError = []CreateArenaError{
 	CreateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanMaxPlayers,
 	CreateArenaErrorDataRequired,
 	CreateArenaErrorAlreadyExists,
+	CreateArenaErrorTeamCountRequired,
+	CreateArenaErrorMaxPlayersMustBeDivisibleByTeamCount,
+	CreateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanTeamSize,
+	CreateArenaErrorRoleDataKeyRequired,
+	CreateArenaErrorRolesRequired,
+	CreateArenaErrorRoleNameRequired,
+	CreateArenaErrorRoleCountRequired,
+	CreateArenaErrorRoleNameDuplicate,
+	CreateArenaErrorRoleCountsMustAddUpToTeamSize,
 }
 
 func (e CreateArenaError) IsValid() bool {
 	switch e {
-	case CreateArenaErrorNone, CreateArenaErrorNameTooShort, CreateArenaErrorNameTooLong, CreateArenaErrorMinPlayersRequired, CreateArenaErrorMaxPlayersPerTicketRequired, CreateArenaErrorMaxPlayersRequired, CreateArenaErrorMinPlayersCannotBeGreaterThanMaxPlayers, CreateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanMaxPlayers, CreateArenaErrorDataRequired, CreateArenaErrorAlreadyExists:
+	case CreateArenaErrorNone, CreateArenaErrorNameTooShort, CreateArenaErrorNameTooLong, CreateArenaErrorMinPlayersRequired, CreateArenaErrorMaxPlayersPerTicketRequired, CreateArenaErrorMaxPlayersRequired, CreateArenaErrorMinPlayersCannotBeGreaterThanMaxPlayers, CreateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanMaxPlayers, CreateArenaErrorDataRequired, CreateArenaErrorAlreadyExists, CreateArenaErrorTeamCountRequired, CreateArenaErrorMaxPlayersMustBeDivisibleByTeamCount, CreateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanTeamSize, CreateArenaErrorRoleDataKeyRequired, CreateArenaErrorRolesRequired, CreateArenaErrorRoleNameRequired, CreateArenaErrorRoleCountRequired, CreateArenaErrorRoleNameDuplicate, CreateArenaErrorRoleCountsMustAddUpToTeamSize:
 		return true
 	}
 	return false
@@ -227,23 +387,27 @@ func (e CreateArenaError) MarshalJSON() ([]byte, error) {
 type CreateEventError string
 
 const (
-	CreateEventErrorNone                        CreateEventError = "NONE"
-	CreateEventErrorNameTooShort                CreateEventError = "NAME_TOO_SHORT"
-	CreateEventErrorNameTooLong                 CreateEventError = "NAME_TOO_LONG"
-	CreateEventErrorDataRequired                CreateEventError = "DATA_REQUIRED"
-	CreateEventErrorStartedAtRequired           CreateEventError = "STARTED_AT_REQUIRED"
-	CreateEventErrorStartedAtInThePast          CreateEventError = "STARTED_AT_IN_THE_PAST"
-	CreateEventErrorRoundsRequired              CreateEventError = "ROUNDS_REQUIRED"
-	CreateEventErrorTooManyRounds               CreateEventError = "TOO_MANY_ROUNDS"
-	CreateEventErrorRoundNameTooShort           CreateEventError = "ROUND_NAME_TOO_SHORT"
-	CreateEventErrorRoundNameTooLong            CreateEventError = "ROUND_NAME_TOO_LONG"
-	CreateEventErrorRoundDataRequired           CreateEventError = "ROUND_DATA_REQUIRED"
-	CreateEventErrorRoundEndedAtRequired        CreateEventError = "ROUND_ENDED_AT_REQUIRED"
-	CreateEventErrorRoundEndedAtBeforeStartedAt CreateEventError = "ROUND_ENDED_AT_BEFORE_STARTED_AT"
-	CreateEventErrorRoundScoringRequired        CreateEventError = "ROUND_SCORING_REQUIRED"
-	CreateEventErrorAlreadyExists               CreateEventError = "ALREADY_EXISTS"
-	CreateEventErrorDuplicateRoundName          CreateEventError = "DUPLICATE_ROUND_NAME"
-	CreateEventErrorDuplicateRoundEndedAt       CreateEventError = "DUPLICATE_ROUND_ENDED_AT"
+	CreateEventErrorNone                              CreateEventError = "NONE"
+	CreateEventErrorNameTooShort                      CreateEventError = "NAME_TOO_SHORT"
+	CreateEventErrorNameTooLong                       CreateEventError = "NAME_TOO_LONG"
+	CreateEventErrorDataRequired                      CreateEventError = "DATA_REQUIRED"
+	CreateEventErrorStartedAtRequired                 CreateEventError = "STARTED_AT_REQUIRED"
+	CreateEventErrorStartedAtInThePast                CreateEventError = "STARTED_AT_IN_THE_PAST"
+	CreateEventErrorRoundsRequired                    CreateEventError = "ROUNDS_REQUIRED"
+	CreateEventErrorTooManyRounds                     CreateEventError = "TOO_MANY_ROUNDS"
+	CreateEventErrorRoundNameTooShort                 CreateEventError = "ROUND_NAME_TOO_SHORT"
+	CreateEventErrorRoundNameTooLong                  CreateEventError = "ROUND_NAME_TOO_LONG"
+	CreateEventErrorRoundDataRequired                 CreateEventError = "ROUND_DATA_REQUIRED"
+	CreateEventErrorRoundEndedAtRequired              CreateEventError = "ROUND_ENDED_AT_REQUIRED"
+	CreateEventErrorRoundEndedAtBeforeStartedAt       CreateEventError = "ROUND_ENDED_AT_BEFORE_STARTED_AT"
+	CreateEventErrorRoundScoringRequired              CreateEventError = "ROUND_SCORING_REQUIRED"
+	CreateEventErrorAlreadyExists                     CreateEventError = "ALREADY_EXISTS"
+	CreateEventErrorDuplicateRoundName                CreateEventError = "DUPLICATE_ROUND_NAME"
+	CreateEventErrorDuplicateRoundEndedAt             CreateEventError = "DUPLICATE_ROUND_ENDED_AT"
+	CreateEventErrorRoundScoringInvalid               CreateEventError = "ROUND_SCORING_INVALID"
+	CreateEventErrorRoundResultAggregationTopRequired CreateEventError = "ROUND_RESULT_AGGREGATION_TOP_REQUIRED"
+	CreateEventErrorRegistrationClosesAtBeforeOpensAt CreateEventError = "REGISTRATION_CLOSES_AT_BEFORE_OPENS_AT"
+	CreateEventErrorEligibilityInvalid                CreateEventError = "ELIGIBILITY_INVALID"
 )
 
 var AllCreateEventError = []CreateEventError{
@@ -264,11 +428,15 @@ var AllCreateEventError = []CreateEventError{
 	CreateEventErrorAlreadyExists,
 	CreateEventErrorDuplicateRoundName,
 	CreateEventErrorDuplicateRoundEndedAt,
+	CreateEventErrorRoundScoringInvalid,
+	CreateEventErrorRoundResultAggregationTopRequired,
+	CreateEventErrorRegistrationClosesAtBeforeOpensAt,
+	CreateEventErrorEligibilityInvalid,
 }
 
 func (e CreateEventError) IsValid() bool {
 	switch e {
-	case CreateEventErrorNone, CreateEventErrorNameTooShort, CreateEventErrorNameTooLong, CreateEventErrorDataRequired, CreateEventErrorStartedAtRequired, CreateEventErrorStartedAtInThePast, CreateEventErrorRoundsRequired, CreateEventErrorTooManyRounds, CreateEventErrorRoundNameTooShort, CreateEventErrorRoundNameTooLong, CreateEventErrorRoundDataRequired, CreateEventErrorRoundEndedAtRequired, CreateEventErrorRoundEndedAtBeforeStartedAt, CreateEventErrorRoundScoringRequired, CreateEventErrorAlreadyExists, CreateEventErrorDuplicateRoundName, CreateEventErrorDuplicateRoundEndedAt:
+	case CreateEventErrorNone, CreateEventErrorNameTooShort, CreateEventErrorNameTooLong, CreateEventErrorDataRequired, CreateEventErrorStartedAtRequired, CreateEventErrorStartedAtInThePast, CreateEventErrorRoundsRequired, CreateEventErrorTooManyRounds, CreateEventErrorRoundNameTooShort, CreateEventErrorRoundNameTooLong, CreateEventErrorRoundDataRequired, CreateEventErrorRoundEndedAtRequired, CreateEventErrorRoundEndedAtBeforeStartedAt, CreateEventErrorRoundScoringRequired, CreateEventErrorAlreadyExists, CreateEventErrorDuplicateRoundName, CreateEventErrorDuplicateRoundEndedAt, CreateEventErrorRoundScoringInvalid, CreateEventErrorRoundResultAggregationTopRequired, CreateEventErrorRegistrationClosesAtBeforeOpensAt, CreateEventErrorEligibilityInvalid:
 		return true
 	}
 	return false
@@ -309,25 +477,95 @@ func (e CreateEventError) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Possible errors when creating an event from a template.
+type CreateEventFromTemplateError string
+
+const (
+	CreateEventFromTemplateErrorNone               CreateEventFromTemplateError = "NONE"
+	CreateEventFromTemplateErrorNameTooShort       CreateEventFromTemplateError = "NAME_TOO_SHORT"
+	CreateEventFromTemplateErrorNameTooLong        CreateEventFromTemplateError = "NAME_TOO_LONG"
+	CreateEventFromTemplateErrorIDOrNameRequired   CreateEventFromTemplateError = "ID_OR_NAME_REQUIRED"
+	CreateEventFromTemplateErrorStartedAtRequired  CreateEventFromTemplateError = "STARTED_AT_REQUIRED"
+	CreateEventFromTemplateErrorStartedAtInThePast CreateEventFromTemplateError = "STARTED_AT_IN_THE_PAST"
+	CreateEventFromTemplateErrorNotFound           CreateEventFromTemplateError = "NOT_FOUND"
+	CreateEventFromTemplateErrorAlreadyExists      CreateEventFromTemplateError = "ALREADY_EXISTS"
+)
+
+var AllCreateEventFromTemplateError = []CreateEventFromTemplateError{
+	CreateEventFromTemplateErrorNone,
+	CreateEventFromTemplateErrorNameTooShort,
+	CreateEventFromTemplateErrorNameTooLong,
+	CreateEventFromTemplateErrorIDOrNameRequired,
+	CreateEventFromTemplateErrorStartedAtRequired,
+	CreateEventFromTemplateErrorStartedAtInThePast,
+	CreateEventFromTemplateErrorNotFound,
+	CreateEventFromTemplateErrorAlreadyExists,
+}
+
+func (e CreateEventFromTemplateError) IsValid() bool {
+	switch e {
+	case CreateEventFromTemplateErrorNone, CreateEventFromTemplateErrorNameTooShort, CreateEventFromTemplateErrorNameTooLong, CreateEventFromTemplateErrorIDOrNameRequired, CreateEventFromTemplateErrorStartedAtRequired, CreateEventFromTemplateErrorStartedAtInThePast, CreateEventFromTemplateErrorNotFound, CreateEventFromTemplateErrorAlreadyExists:
+		return true
+	}
+	return false
+}
+
+func (e CreateEventFromTemplateError) String() string {
+	return string(e)
+}
+
+func (e *CreateEventFromTemplateError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CreateEventFromTemplateError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CreateEventFromTemplateError", str)
+	}
+	return nil
+}
+
+func (e CreateEventFromTemplateError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CreateEventFromTemplateError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CreateEventFromTemplateError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Possible errors when creating an event round.
 type CreateEventRoundError string
 
 const (
-	CreateEventRoundErrorNone                             CreateEventRoundError = "NONE"
-	CreateEventRoundErrorNameTooShort                     CreateEventRoundError = "NAME_TOO_SHORT"
-	CreateEventRoundErrorNameTooLong                      CreateEventRoundError = "NAME_TOO_LONG"
-	CreateEventRoundErrorIDOrNameRequired                 CreateEventRoundError = "ID_OR_NAME_REQUIRED"
-	CreateEventRoundErrorRoundRequired                    CreateEventRoundError = "ROUND_REQUIRED"
-	CreateEventRoundErrorRoundNameTooShort                CreateEventRoundError = "ROUND_NAME_TOO_SHORT"
-	CreateEventRoundErrorRoundNameTooLong                 CreateEventRoundError = "ROUND_NAME_TOO_LONG"
-	CreateEventRoundErrorRoundDataRequired                CreateEventRoundError = "ROUND_DATA_REQUIRED"
-	CreateEventRoundErrorRoundEndedAtRequired             CreateEventRoundError = "ROUND_ENDED_AT_REQUIRED"
-	CreateEventRoundErrorRoundEndedAtInThePast            CreateEventRoundError = "ROUND_ENDED_AT_IN_THE_PAST"
-	CreateEventRoundErrorRoundEndedAtBeforeEventStartedAt CreateEventRoundError = "ROUND_ENDED_AT_BEFORE_EVENT_STARTED_AT"
-	CreateEventRoundErrorRoundScoringRequired             CreateEventRoundError = "ROUND_SCORING_REQUIRED"
-	CreateEventRoundErrorNotFound                         CreateEventRoundError = "NOT_FOUND"
-	CreateEventRoundErrorDuplicateRoundName               CreateEventRoundError = "DUPLICATE_ROUND_NAME"
-	CreateEventRoundErrorDuplicateRoundEndedAt            CreateEventRoundError = "DUPLICATE_ROUND_ENDED_AT"
+	CreateEventRoundErrorNone                              CreateEventRoundError = "NONE"
+	CreateEventRoundErrorNameTooShort                      CreateEventRoundError = "NAME_TOO_SHORT"
+	CreateEventRoundErrorNameTooLong                       CreateEventRoundError = "NAME_TOO_LONG"
+	CreateEventRoundErrorIDOrNameRequired                  CreateEventRoundError = "ID_OR_NAME_REQUIRED"
+	CreateEventRoundErrorRoundRequired                     CreateEventRoundError = "ROUND_REQUIRED"
+	CreateEventRoundErrorRoundNameTooShort                 CreateEventRoundError = "ROUND_NAME_TOO_SHORT"
+	CreateEventRoundErrorRoundNameTooLong                  CreateEventRoundError = "ROUND_NAME_TOO_LONG"
+	CreateEventRoundErrorRoundDataRequired                 CreateEventRoundError = "ROUND_DATA_REQUIRED"
+	CreateEventRoundErrorRoundEndedAtRequired              CreateEventRoundError = "ROUND_ENDED_AT_REQUIRED"
+	CreateEventRoundErrorRoundEndedAtInThePast             CreateEventRoundError = "ROUND_ENDED_AT_IN_THE_PAST"
+	CreateEventRoundErrorRoundEndedAtBeforeEventStartedAt  CreateEventRoundError = "ROUND_ENDED_AT_BEFORE_EVENT_STARTED_AT"
+	CreateEventRoundErrorRoundScoringRequired              CreateEventRoundError = "ROUND_SCORING_REQUIRED"
+	CreateEventRoundErrorNotFound                          CreateEventRoundError = "NOT_FOUND"
+	CreateEventRoundErrorDuplicateRoundName                CreateEventRoundError = "DUPLICATE_ROUND_NAME"
+	CreateEventRoundErrorDuplicateRoundEndedAt             CreateEventRoundError = "DUPLICATE_ROUND_ENDED_AT"
+	CreateEventRoundErrorRoundScoringInvalid               CreateEventRoundError = "ROUND_SCORING_INVALID"
+	CreateEventRoundErrorRoundResultAggregationTopRequired CreateEventRoundError = "ROUND_RESULT_AGGREGATION_TOP_REQUIRED"
 )
 
 var AllCreateEventRoundError = []CreateEventRoundError{
@@ -346,11 +584,13 @@ var AllCreateEventRoundError = []CreateEventRoundError{
 	CreateEventRoundErrorNotFound,
 	CreateEventRoundErrorDuplicateRoundName,
 	CreateEventRoundErrorDuplicateRoundEndedAt,
+	CreateEventRoundErrorRoundScoringInvalid,
+	CreateEventRoundErrorRoundResultAggregationTopRequired,
 }
 
 func (e CreateEventRoundError) IsValid() bool {
 	switch e {
-	case CreateEventRoundErrorNone, CreateEventRoundErrorNameTooShort, CreateEventRoundErrorNameTooLong, CreateEventRoundErrorIDOrNameRequired, CreateEventRoundErrorRoundRequired, CreateEventRoundErrorRoundNameTooShort, CreateEventRoundErrorRoundNameTooLong, CreateEventRoundErrorRoundDataRequired, CreateEventRoundErrorRoundEndedAtRequired, CreateEventRoundErrorRoundEndedAtInThePast, CreateEventRoundErrorRoundEndedAtBeforeEventStartedAt, CreateEventRoundErrorRoundScoringRequired, CreateEventRoundErrorNotFound, CreateEventRoundErrorDuplicateRoundName, CreateEventRoundErrorDuplicateRoundEndedAt:
+	case CreateEventRoundErrorNone, CreateEventRoundErrorNameTooShort, CreateEventRoundErrorNameTooLong, CreateEventRoundErrorIDOrNameRequired, CreateEventRoundErrorRoundRequired, CreateEventRoundErrorRoundNameTooShort, CreateEventRoundErrorRoundNameTooLong, CreateEventRoundErrorRoundDataRequired, CreateEventRoundErrorRoundEndedAtRequired, CreateEventRoundErrorRoundEndedAtInThePast, CreateEventRoundErrorRoundEndedAtBeforeEventStartedAt, CreateEventRoundErrorRoundScoringRequired, CreateEventRoundErrorNotFound, CreateEventRoundErrorDuplicateRoundName, CreateEventRoundErrorDuplicateRoundEndedAt, CreateEventRoundErrorRoundScoringInvalid, CreateEventRoundErrorRoundResultAggregationTopRequired:
 		return true
 	}
 	return false
@@ -391,6 +631,94 @@ func (e CreateEventRoundError) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Possible errors when creating an event template.
+type CreateEventTemplateError string
+
+const (
+	CreateEventTemplateErrorNone                              CreateEventTemplateError = "NONE"
+	CreateEventTemplateErrorNameTooShort                      CreateEventTemplateError = "NAME_TOO_SHORT"
+	CreateEventTemplateErrorNameTooLong                       CreateEventTemplateError = "NAME_TOO_LONG"
+	CreateEventTemplateErrorDataRequired                      CreateEventTemplateError = "DATA_REQUIRED"
+	CreateEventTemplateErrorRoundsRequired                    CreateEventTemplateError = "ROUNDS_REQUIRED"
+	CreateEventTemplateErrorTooManyRounds                     CreateEventTemplateError = "TOO_MANY_ROUNDS"
+	CreateEventTemplateErrorRoundNameTooShort                 CreateEventTemplateError = "ROUND_NAME_TOO_SHORT"
+	CreateEventTemplateErrorRoundNameTooLong                  CreateEventTemplateError = "ROUND_NAME_TOO_LONG"
+	CreateEventTemplateErrorRoundDataRequired                 CreateEventTemplateError = "ROUND_DATA_REQUIRED"
+	CreateEventTemplateErrorRoundEndedAfterSecondsRequired    CreateEventTemplateError = "ROUND_ENDED_AFTER_SECONDS_REQUIRED"
+	CreateEventTemplateErrorRoundScoringRequired              CreateEventTemplateError = "ROUND_SCORING_REQUIRED"
+	CreateEventTemplateErrorRoundScoringInvalid               CreateEventTemplateError = "ROUND_SCORING_INVALID"
+	CreateEventTemplateErrorRoundResultAggregationTopRequired CreateEventTemplateError = "ROUND_RESULT_AGGREGATION_TOP_REQUIRED"
+	CreateEventTemplateErrorDuplicateRoundName                CreateEventTemplateError = "DUPLICATE_ROUND_NAME"
+	CreateEventTemplateErrorDuplicateRoundEndedAfterSeconds   CreateEventTemplateError = "DUPLICATE_ROUND_ENDED_AFTER_SECONDS"
+	CreateEventTemplateErrorCronScheduleInvalid               CreateEventTemplateError = "CRON_SCHEDULE_INVALID"
+	CreateEventTemplateErrorAlreadyExists                     CreateEventTemplateError = "ALREADY_EXISTS"
+	CreateEventTemplateErrorLeadTimeTooLong                   CreateEventTemplateError = "LEAD_TIME_TOO_LONG"
+)
+
+var AllCreateEventTemplateError = []CreateEventTemplateError{
+	CreateEventTemplateErrorNone,
+	CreateEventTemplateErrorNameTooShort,
+	CreateEventTemplateErrorNameTooLong,
+	CreateEventTemplateErrorDataRequired,
+	CreateEventTemplateErrorRoundsRequired,
+	CreateEventTemplateErrorTooManyRounds,
+	CreateEventTemplateErrorRoundNameTooShort,
+	CreateEventTemplateErrorRoundNameTooLong,
+	CreateEventTemplateErrorRoundDataRequired,
+	CreateEventTemplateErrorRoundEndedAfterSecondsRequired,
+	CreateEventTemplateErrorRoundScoringRequired,
+	CreateEventTemplateErrorRoundScoringInvalid,
+	CreateEventTemplateErrorRoundResultAggregationTopRequired,
+	CreateEventTemplateErrorDuplicateRoundName,
+	CreateEventTemplateErrorDuplicateRoundEndedAfterSeconds,
+	CreateEventTemplateErrorCronScheduleInvalid,
+	CreateEventTemplateErrorAlreadyExists,
+	CreateEventTemplateErrorLeadTimeTooLong,
+}
+
+func (e CreateEventTemplateError) IsValid() bool {
+	switch e {
+	case CreateEventTemplateErrorNone, CreateEventTemplateErrorNameTooShort, CreateEventTemplateErrorNameTooLong, CreateEventTemplateErrorDataRequired, CreateEventTemplateErrorRoundsRequired, CreateEventTemplateErrorTooManyRounds, CreateEventTemplateErrorRoundNameTooShort, CreateEventTemplateErrorRoundNameTooLong, CreateEventTemplateErrorRoundDataRequired, CreateEventTemplateErrorRoundEndedAfterSecondsRequired, CreateEventTemplateErrorRoundScoringRequired, CreateEventTemplateErrorRoundScoringInvalid, CreateEventTemplateErrorRoundResultAggregationTopRequired, CreateEventTemplateErrorDuplicateRoundName, CreateEventTemplateErrorDuplicateRoundEndedAfterSeconds, CreateEventTemplateErrorCronScheduleInvalid, CreateEventTemplateErrorAlreadyExists, CreateEventTemplateErrorLeadTimeTooLong:
+		return true
+	}
+	return false
+}
+
+func (e CreateEventTemplateError) String() string {
+	return string(e)
+}
+
+func (e *CreateEventTemplateError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CreateEventTemplateError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CreateEventTemplateError", str)
+	}
+	return nil
+}
+
+func (e CreateEventTemplateError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CreateEventTemplateError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CreateEventTemplateError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Possible errors when creating an item.
 type CreateItemError string
 
@@ -453,6 +781,70 @@ func (e CreateItemError) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Possible errors when creating a match.
+type CreateMatchError string
+
+const (
+	CreateMatchErrorNone                  CreateMatchError = "NONE"
+	CreateMatchErrorArenaIDOrNameRequired CreateMatchError = "ARENA_ID_OR_NAME_REQUIRED"
+	CreateMatchErrorNameTooShort          CreateMatchError = "NAME_TOO_SHORT"
+	CreateMatchErrorNameTooLong           CreateMatchError = "NAME_TOO_LONG"
+	CreateMatchErrorArenaNotFound         CreateMatchError = "ARENA_NOT_FOUND"
+	CreateMatchErrorDataRequired          CreateMatchError = "DATA_REQUIRED"
+)
+
+var AllCreateMatchError = []CreateMatchError{
+	CreateMatchErrorNone,
+	CreateMatchErrorArenaIDOrNameRequired,
+	CreateMatchErrorNameTooShort,
+	CreateMatchErrorNameTooLong,
+	CreateMatchErrorArenaNotFound,
+	CreateMatchErrorDataRequired,
+}
+
+func (e CreateMatchError) IsValid() bool {
+	switch e {
+	case CreateMatchErrorNone, CreateMatchErrorArenaIDOrNameRequired, CreateMatchErrorNameTooShort, CreateMatchErrorNameTooLong, CreateMatchErrorArenaNotFound, CreateMatchErrorDataRequired:
+		return true
+	}
+	return false
+}
+
+func (e CreateMatchError) String() string {
+	return string(e)
+}
+
+func (e *CreateMatchError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CreateMatchError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CreateMatchError", str)
+	}
+	return nil
+}
+
+func (e CreateMatchError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CreateMatchError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CreateMatchError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Possible errors when creating a matchmaking ticket.
 type CreateMatchmakingTicketError string
 
@@ -465,6 +857,7 @@ const (
 	CreateMatchmakingTicketErrorUserNotFound             CreateMatchmakingTicketError = "USER_NOT_FOUND"
 	CreateMatchmakingTicketErrorArenaNotFound            CreateMatchmakingTicketError = "ARENA_NOT_FOUND"
 	CreateMatchmakingTicketErrorUserAlreadyInTicket      CreateMatchmakingTicketError = "USER_ALREADY_IN_TICKET"
+	CreateMatchmakingTicketErrorRolesCannotBeFilled      CreateMatchmakingTicketError = "ROLES_CANNOT_BE_FILLED"
 )
 
 var AllCreateMatchmakingTicketError = []CreateMatchmakingTicketError{
@@ -476,11 +869,12 @@ var AllCreateMatchmakingTicketError = []CreateMatchmakingTicketError{
 	CreateMatchmakingTicketErrorUserNotFound,
 	CreateMatchmakingTicketErrorArenaNotFound,
 	CreateMatchmakingTicketErrorUserAlreadyInTicket,
+	CreateMatchmakingTicketErrorRolesCannotBeFilled,
 }
 
 func (e CreateMatchmakingTicketError) IsValid() bool {
 	switch e {
-	case CreateMatchmakingTicketErrorNone, CreateMatchmakingTicketErrorMatchmakingUsersRequired, CreateMatchmakingTicketErrorArenasRequired, CreateMatchmakingTicketErrorDataRequired, CreateMatchmakingTicketErrorTooManyPlayers, CreateMatchmakingTicketErrorUserNotFound, CreateMatchmakingTicketErrorArenaNotFound, CreateMatchmakingTicketErrorUserAlreadyInTicket:
+	case CreateMatchmakingTicketErrorNone, CreateMatchmakingTicketErrorMatchmakingUsersRequired, CreateMatchmakingTicketErrorArenasRequired, CreateMatchmakingTicketErrorDataRequired, CreateMatchmakingTicketErrorTooManyPlayers, CreateMatchmakingTicketErrorUserNotFound, CreateMatchmakingTicketErrorArenaNotFound, CreateMatchmakingTicketErrorUserAlreadyInTicket, CreateMatchmakingTicketErrorRolesCannotBeFilled:
 		return true
 	}
 	return false
@@ -777,57 +1171,69 @@ func (e CreateTeamError) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-// Possible errors when creating a tournament user.
-type CreateTournamentUserError string
+// Possible errors when creating a tournament bracket.
+type CreateTournamentBracketError string
 
 const (
-	CreateTournamentUserErrorNone                   CreateTournamentUserError = "NONE"
-	CreateTournamentUserErrorTournamentNameTooShort CreateTournamentUserError = "TOURNAMENT_NAME_TOO_SHORT"
-	CreateTournamentUserErrorTournamentNameTooLong  CreateTournamentUserError = "TOURNAMENT_NAME_TOO_LONG"
-	CreateTournamentUserErrorUserIDRequired         CreateTournamentUserError = "USER_ID_REQUIRED"
-	CreateTournamentUserErrorDataRequired           CreateTournamentUserError = "DATA_REQUIRED"
-	CreateTournamentUserErrorAlreadyExists          CreateTournamentUserError = "ALREADY_EXISTS"
+	CreateTournamentBracketErrorNone                  CreateTournamentBracketError = "NONE"
+	CreateTournamentBracketErrorNameTooShort          CreateTournamentBracketError = "NAME_TOO_SHORT"
+	CreateTournamentBracketErrorNameTooLong           CreateTournamentBracketError = "NAME_TOO_LONG"
+	CreateTournamentBracketErrorNotEnoughParticipants CreateTournamentBracketError = "NOT_ENOUGH_PARTICIPANTS"
+	CreateTournamentBracketErrorTooManyParticipants   CreateTournamentBracketError = "TOO_MANY_PARTICIPANTS"
+	CreateTournamentBracketErrorDuplicateParticipant  CreateTournamentBracketError = "DUPLICATE_PARTICIPANT"
+	CreateTournamentBracketErrorTournamentRequired    CreateTournamentBracketError = "TOURNAMENT_REQUIRED"
+	CreateTournamentBracketErrorDefinitionNotFound    CreateTournamentBracketError = "DEFINITION_NOT_FOUND"
+	CreateTournamentBracketErrorTournamentNotActive   CreateTournamentBracketError = "TOURNAMENT_NOT_ACTIVE"
+	CreateTournamentBracketErrorArenaNotFound         CreateTournamentBracketError = "ARENA_NOT_FOUND"
+	CreateTournamentBracketErrorDataRequired          CreateTournamentBracketError = "DATA_REQUIRED"
+	CreateTournamentBracketErrorAlreadyExists         CreateTournamentBracketError = "ALREADY_EXISTS"
 )
 
-var AllCreateTournamentUserError = []CreateTournamentUserError{
-	CreateTournamentUserErrorNone,
-	CreateTournamentUserErrorTournamentNameTooShort,
-	CreateTournamentUserErrorTournamentNameTooLong,
-	CreateTournamentUserErrorUserIDRequired,
-	CreateTournamentUserErrorDataRequired,
-	CreateTournamentUserErrorAlreadyExists,
-}
-
-func (e CreateTournamentUserError) IsValid() bool {
+var AllCreateTournamentBracketError = []CreateTournamentBracketError{
+	CreateTournamentBracketErrorNone,
+	CreateTournamentBracketErrorNameTooShort,
+	CreateTournamentBracketErrorNameTooLong,
+	CreateTournamentBracketErrorNotEnoughParticipants,
+	CreateTournamentBracketErrorTooManyParticipants,
+	CreateTournamentBracketErrorDuplicateParticipant,
+	CreateTournamentBracketErrorTournamentRequired,
+	CreateTournamentBracketErrorDefinitionNotFound,
+	CreateTournamentBracketErrorTournamentNotActive,
+	CreateTournamentBracketErrorArenaNotFound,
+	CreateTournamentBracketErrorDataRequired,
+	CreateTournamentBracketErrorAlreadyExists,
+}
+
+func (e CreateTournamentBracketError) IsValid() bool {
 	switch e {
-	case CreateTournamentUserErrorNone, CreateTournamentUserErrorTournamentNameTooShort, CreateTournamentUserErrorTournamentNameTooLong, CreateTournamentUserErrorUserIDRequired, CreateTournamentUserErrorDataRequired, CreateTournamentUserErrorAlreadyExists:
+	case CreateTournamentBracketErrorNone, CreateTournamentBracketErrorNameTooShort, CreateTournamentBracketErrorNameTooLong, CreateTournamentBracketErrorNotEnoughParticipants, CreateTournamentBracketErrorTooManyParticipants, CreateTournamentBracketErrorDuplicateParticipant, CreateTournamentBracketErrorTournamentRequired, CreateTournamentBracketErrorDefinitionNotFound, CreateTournamentBracketErrorTournamentNotActive, CreateTournamentBracketErrorArenaNotFound, CreateTournamentBracketErrorDataRequired, CreateTournamentBracketErrorAlreadyExists:
 		return true
 	}
 	return false
 }
 
-func (e CreateTournamentUserError) String() string {
+func (e CreateTournamentBracketError) String() string {
 	return string(e)
 }
 
-func (e *CreateTournamentUserError) UnmarshalGQL(v any) error {
+func (e *CreateTournamentBracketError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = CreateTournamentUserError(str)
+	*e = CreateTournamentBracketError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid CreateTournamentUserError", str)
+		return fmt.Errorf("%s is not a valid CreateTournamentBracketError", str)
 	}
 	return nil
 }
 
-func (e CreateTournamentUserError) MarshalGQL(w io.Writer) {
+func (e CreateTournamentBracketError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *CreateTournamentUserError) UnmarshalJSON(b []byte) error {
+func (e *CreateTournamentBracketError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -835,61 +1241,71 @@ func (e *CreateTournamentUserError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e CreateTournamentUserError) MarshalJSON() ([]byte, error) {
+func (e CreateTournamentBracketError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when deleting a match.
-type DeleteMatchError string
+// Possible errors when creating a custom tournament definition.
+type CreateTournamentDefinitionError string
 
 const (
-	DeleteMatchErrorNone                                         DeleteMatchError = "NONE"
-	DeleteMatchErrorMatchIDOrMatchmakingTicketRequired           DeleteMatchError = "MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED"
-	DeleteMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired DeleteMatchError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
-	DeleteMatchErrorMatchmakingUserIDOrClientUserIDRequired      DeleteMatchError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
-	DeleteMatchErrorNotFound                                     DeleteMatchError = "NOT_FOUND"
+	CreateTournamentDefinitionErrorNone                   CreateTournamentDefinitionError = "NONE"
+	CreateTournamentDefinitionErrorTournamentNameTooShort CreateTournamentDefinitionError = "TOURNAMENT_NAME_TOO_SHORT"
+	CreateTournamentDefinitionErrorTournamentNameTooLong  CreateTournamentDefinitionError = "TOURNAMENT_NAME_TOO_LONG"
+	CreateTournamentDefinitionErrorScheduleRequired       CreateTournamentDefinitionError = "SCHEDULE_REQUIRED"
+	CreateTournamentDefinitionErrorInvalidCronSchedule    CreateTournamentDefinitionError = "INVALID_CRON_SCHEDULE"
+	CreateTournamentDefinitionErrorInvalidTimeZone        CreateTournamentDefinitionError = "INVALID_TIME_ZONE"
+	CreateTournamentDefinitionErrorStartsAtRequired       CreateTournamentDefinitionError = "STARTS_AT_REQUIRED"
+	CreateTournamentDefinitionErrorEndsAtBeforeStartsAt   CreateTournamentDefinitionError = "ENDS_AT_BEFORE_STARTS_AT"
+	CreateTournamentDefinitionErrorDataRequired           CreateTournamentDefinitionError = "DATA_REQUIRED"
+	CreateTournamentDefinitionErrorAlreadyExists          CreateTournamentDefinitionError = "ALREADY_EXISTS"
 )
 
-var AllDeleteMatchError = []DeleteMatchError{
-	DeleteMatchErrorNone,
-	DeleteMatchErrorMatchIDOrMatchmakingTicketRequired,
-	DeleteMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired,
-	DeleteMatchErrorMatchmakingUserIDOrClientUserIDRequired,
-	DeleteMatchErrorNotFound,
+var AllCreateTournamentDefinitionError = []CreateTournamentDefinitionError{
+	CreateTournamentDefinitionErrorNone,
+	CreateTournamentDefinitionErrorTournamentNameTooShort,
+	CreateTournamentDefinitionErrorTournamentNameTooLong,
+	CreateTournamentDefinitionErrorScheduleRequired,
+	CreateTournamentDefinitionErrorInvalidCronSchedule,
+	CreateTournamentDefinitionErrorInvalidTimeZone,
+	CreateTournamentDefinitionErrorStartsAtRequired,
+	CreateTournamentDefinitionErrorEndsAtBeforeStartsAt,
+	CreateTournamentDefinitionErrorDataRequired,
+	CreateTournamentDefinitionErrorAlreadyExists,
 }
 
-func (e DeleteMatchError) IsValid() bool {
+func (e CreateTournamentDefinitionError) IsValid() bool {
 	switch e {
-	case DeleteMatchErrorNone, DeleteMatchErrorMatchIDOrMatchmakingTicketRequired, DeleteMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired, DeleteMatchErrorMatchmakingUserIDOrClientUserIDRequired, DeleteMatchErrorNotFound:
+	case CreateTournamentDefinitionErrorNone, CreateTournamentDefinitionErrorTournamentNameTooShort, CreateTournamentDefinitionErrorTournamentNameTooLong, CreateTournamentDefinitionErrorScheduleRequired, CreateTournamentDefinitionErrorInvalidCronSchedule, CreateTournamentDefinitionErrorInvalidTimeZone, CreateTournamentDefinitionErrorStartsAtRequired, CreateTournamentDefinitionErrorEndsAtBeforeStartsAt, CreateTournamentDefinitionErrorDataRequired, CreateTournamentDefinitionErrorAlreadyExists:
 		return true
 	}
 	return false
 }
 
-func (e DeleteMatchError) String() string {
+func (e CreateTournamentDefinitionError) String() string {
 	return string(e)
 }
 
-func (e *DeleteMatchError) UnmarshalGQL(v any) error {
+func (e *CreateTournamentDefinitionError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = DeleteMatchError(str)
+	*e = CreateTournamentDefinitionError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid DeleteMatchError", str)
+		return fmt.Errorf("%s is not a valid CreateTournamentDefinitionError", str)
 	}
 	return nil
 }
 
-func (e DeleteMatchError) MarshalGQL(w io.Writer) {
+func (e CreateTournamentDefinitionError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *DeleteMatchError) UnmarshalJSON(b []byte) error {
+func (e *CreateTournamentDefinitionError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -897,61 +1313,65 @@ func (e *DeleteMatchError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e DeleteMatchError) MarshalJSON() ([]byte, error) {
+func (e CreateTournamentDefinitionError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when deleting a matchmaking ticket.
-type DeleteMatchmakingTicketError string
+// Possible errors when creating a tournament reward tier.
+type CreateTournamentRewardTierError string
 
 const (
-	DeleteMatchmakingTicketErrorNone                                         DeleteMatchmakingTicketError = "NONE"
-	DeleteMatchmakingTicketErrorMatchmakingTicketIDOrMatchmakingUserRequired DeleteMatchmakingTicketError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
-	DeleteMatchmakingTicketErrorMatchmakingUserIDOrClientUserIDRequired      DeleteMatchmakingTicketError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
-	DeleteMatchmakingTicketErrorNotFound                                     DeleteMatchmakingTicketError = "NOT_FOUND"
-	DeleteMatchmakingTicketErrorTicketCurrentlyInMatch                       DeleteMatchmakingTicketError = "TICKET_CURRENTLY_IN_MATCH"
+	CreateTournamentRewardTierErrorNone                        CreateTournamentRewardTierError = "NONE"
+	CreateTournamentRewardTierErrorTournamentNameTooShort      CreateTournamentRewardTierError = "TOURNAMENT_NAME_TOO_SHORT"
+	CreateTournamentRewardTierErrorTournamentNameTooLong       CreateTournamentRewardTierError = "TOURNAMENT_NAME_TOO_LONG"
+	CreateTournamentRewardTierErrorRankingOrPercentageRequired CreateTournamentRewardTierError = "RANKING_OR_PERCENTAGE_REQUIRED"
+	CreateTournamentRewardTierErrorInvalidRanking              CreateTournamentRewardTierError = "INVALID_RANKING"
+	CreateTournamentRewardTierErrorInvalidPercentage           CreateTournamentRewardTierError = "INVALID_PERCENTAGE"
+	CreateTournamentRewardTierErrorDataRequired                CreateTournamentRewardTierError = "DATA_REQUIRED"
 )
 
-var AllDeleteMatchmakingTicketError = []DeleteMatchmakingTicketError{
-	DeleteMatchmakingTicketErrorNone,
-	DeleteMatchmakingTicketErrorMatchmakingTicketIDOrMatchmakingUserRequired,
-	DeleteMatchmakingTicketErrorMatchmakingUserIDOrClientUserIDRequired,
-	DeleteMatchmakingTicketErrorNotFound,
-	DeleteMatchmakingTicketErrorTicketCurrentlyInMatch,
+var AllCreateTournamentRewardTierError = []CreateTournamentRewardTierError{
+	CreateTournamentRewardTierErrorNone,
+	CreateTournamentRewardTierErrorTournamentNameTooShort,
+	CreateTournamentRewardTierErrorTournamentNameTooLong,
+	CreateTournamentRewardTierErrorRankingOrPercentageRequired,
+	CreateTournamentRewardTierErrorInvalidRanking,
+	CreateTournamentRewardTierErrorInvalidPercentage,
+	CreateTournamentRewardTierErrorDataRequired,
 }
 
-func (e DeleteMatchmakingTicketError) IsValid() bool {
+func (e CreateTournamentRewardTierError) IsValid() bool {
 	switch e {
-	case DeleteMatchmakingTicketErrorNone, DeleteMatchmakingTicketErrorMatchmakingTicketIDOrMatchmakingUserRequired, DeleteMatchmakingTicketErrorMatchmakingUserIDOrClientUserIDRequired, DeleteMatchmakingTicketErrorNotFound, DeleteMatchmakingTicketErrorTicketCurrentlyInMatch:
+	case CreateTournamentRewardTierErrorNone, CreateTournamentRewardTierErrorTournamentNameTooShort, CreateTournamentRewardTierErrorTournamentNameTooLong, CreateTournamentRewardTierErrorRankingOrPercentageRequired, CreateTournamentRewardTierErrorInvalidRanking, CreateTournamentRewardTierErrorInvalidPercentage, CreateTournamentRewardTierErrorDataRequired:
 		return true
 	}
 	return false
 }
 
-func (e DeleteMatchmakingTicketError) String() string {
+func (e CreateTournamentRewardTierError) String() string {
 	return string(e)
 }
 
-func (e *DeleteMatchmakingTicketError) UnmarshalGQL(v any) error {
+func (e *CreateTournamentRewardTierError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = DeleteMatchmakingTicketError(str)
+	*e = CreateTournamentRewardTierError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid DeleteMatchmakingTicketError", str)
+		return fmt.Errorf("%s is not a valid CreateTournamentRewardTierError", str)
 	}
 	return nil
 }
 
-func (e DeleteMatchmakingTicketError) MarshalGQL(w io.Writer) {
+func (e CreateTournamentRewardTierError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *DeleteMatchmakingTicketError) UnmarshalJSON(b []byte) error {
+func (e *CreateTournamentRewardTierError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -959,61 +1379,73 @@ func (e *DeleteMatchmakingTicketError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e DeleteMatchmakingTicketError) MarshalJSON() ([]byte, error) {
+func (e CreateTournamentRewardTierError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when deleting a matchmaking user.
-type DeleteMatchmakingUserError string
+// Possible errors when creating the next round of a tournament.
+type CreateTournamentRoundError string
 
 const (
-	DeleteMatchmakingUserErrorNone                                    DeleteMatchmakingUserError = "NONE"
-	DeleteMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired DeleteMatchmakingUserError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
-	DeleteMatchmakingUserErrorNotFound                                DeleteMatchmakingUserError = "NOT_FOUND"
-	DeleteMatchmakingUserErrorUserCurrentlyInTicket                   DeleteMatchmakingUserError = "USER_CURRENTLY_IN_TICKET"
-	DeleteMatchmakingUserErrorUserCurrentlyInMatch                    DeleteMatchmakingUserError = "USER_CURRENTLY_IN_MATCH"
+	CreateTournamentRoundErrorNone                   CreateTournamentRoundError = "NONE"
+	CreateTournamentRoundErrorTournamentNameTooShort CreateTournamentRoundError = "TOURNAMENT_NAME_TOO_SHORT"
+	CreateTournamentRoundErrorTournamentNameTooLong  CreateTournamentRoundError = "TOURNAMENT_NAME_TOO_LONG"
+	CreateTournamentRoundErrorDefinitionNotFound     CreateTournamentRoundError = "DEFINITION_NOT_FOUND"
+	CreateTournamentRoundErrorTournamentNotActive    CreateTournamentRoundError = "TOURNAMENT_NOT_ACTIVE"
+	CreateTournamentRoundErrorNotEnoughParticipants  CreateTournamentRoundError = "NOT_ENOUGH_PARTICIPANTS"
+	CreateTournamentRoundErrorFormatMismatch         CreateTournamentRoundError = "FORMAT_MISMATCH"
+	CreateTournamentRoundErrorRoundNotComplete       CreateTournamentRoundError = "ROUND_NOT_COMPLETE"
+	CreateTournamentRoundErrorAllRoundsPlayed        CreateTournamentRoundError = "ALL_ROUNDS_PLAYED"
+	CreateTournamentRoundErrorNoPairingPossible      CreateTournamentRoundError = "NO_PAIRING_POSSIBLE"
+	CreateTournamentRoundErrorTooManyParticipants    CreateTournamentRoundError = "TOO_MANY_PARTICIPANTS"
 )
 
-var AllDeleteMatchmakingUserError = []DeleteMatchmakingUserError{
-	DeleteMatchmakingUserErrorNone,
-	DeleteMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired,
-	DeleteMatchmakingUserErrorNotFound,
-	DeleteMatchmakingUserErrorUserCurrentlyInTicket,
-	DeleteMatchmakingUserErrorUserCurrentlyInMatch,
-}
-
-func (e DeleteMatchmakingUserError) IsValid() bool {
+var AllCreateTournamentRoundError = []CreateTournamentRoundError{
+	CreateTournamentRoundErrorNone,
+	CreateTournamentRoundErrorTournamentNameTooShort,
+	CreateTournamentRoundErrorTournamentNameTooLong,
+	CreateTournamentRoundErrorDefinitionNotFound,
+	CreateTournamentRoundErrorTournamentNotActive,
+	CreateTournamentRoundErrorNotEnoughParticipants,
+	CreateTournamentRoundErrorFormatMismatch,
+	CreateTournamentRoundErrorRoundNotComplete,
+	CreateTournamentRoundErrorAllRoundsPlayed,
+	CreateTournamentRoundErrorNoPairingPossible,
+	CreateTournamentRoundErrorTooManyParticipants,
+}
+
+func (e CreateTournamentRoundError) IsValid() bool {
 	switch e {
-	case DeleteMatchmakingUserErrorNone, DeleteMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired, DeleteMatchmakingUserErrorNotFound, DeleteMatchmakingUserErrorUserCurrentlyInTicket, DeleteMatchmakingUserErrorUserCurrentlyInMatch:
+	case CreateTournamentRoundErrorNone, CreateTournamentRoundErrorTournamentNameTooShort, CreateTournamentRoundErrorTournamentNameTooLong, CreateTournamentRoundErrorDefinitionNotFound, CreateTournamentRoundErrorTournamentNotActive, CreateTournamentRoundErrorNotEnoughParticipants, CreateTournamentRoundErrorFormatMismatch, CreateTournamentRoundErrorRoundNotComplete, CreateTournamentRoundErrorAllRoundsPlayed, CreateTournamentRoundErrorNoPairingPossible, CreateTournamentRoundErrorTooManyParticipants:
 		return true
 	}
 	return false
 }
 
-func (e DeleteMatchmakingUserError) String() string {
+func (e CreateTournamentRoundError) String() string {
 	return string(e)
 }
 
-func (e *DeleteMatchmakingUserError) UnmarshalGQL(v any) error {
+func (e *CreateTournamentRoundError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = DeleteMatchmakingUserError(str)
+	*e = CreateTournamentRoundError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid DeleteMatchmakingUserError", str)
+		return fmt.Errorf("%s is not a valid CreateTournamentRoundError", str)
 	}
 	return nil
 }
 
-func (e DeleteMatchmakingUserError) MarshalGQL(w io.Writer) {
+func (e CreateTournamentRoundError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *DeleteMatchmakingUserError) UnmarshalJSON(b []byte) error {
+func (e *CreateTournamentRoundError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1021,63 +1453,67 @@ func (e *DeleteMatchmakingUserError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e DeleteMatchmakingUserError) MarshalJSON() ([]byte, error) {
+func (e CreateTournamentRoundError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when deleting a record.
-type DeleteRecordError string
+// Possible errors when creating a tournament team.
+type CreateTournamentTeamError string
 
 const (
-	DeleteRecordErrorNone                   DeleteRecordError = "NONE"
-	DeleteRecordErrorIDOrNameUserIDRequired DeleteRecordError = "ID_OR_NAME_USER_ID_REQUIRED"
-	DeleteRecordErrorNotFound               DeleteRecordError = "NOT_FOUND"
-	DeleteRecordErrorNameTooShort           DeleteRecordError = "NAME_TOO_SHORT"
-	DeleteRecordErrorNameTooLong            DeleteRecordError = "NAME_TOO_LONG"
-	DeleteRecordErrorUserIDRequired         DeleteRecordError = "USER_ID_REQUIRED"
+	CreateTournamentTeamErrorNone                   CreateTournamentTeamError = "NONE"
+	CreateTournamentTeamErrorTournamentNameTooShort CreateTournamentTeamError = "TOURNAMENT_NAME_TOO_SHORT"
+	CreateTournamentTeamErrorTournamentNameTooLong  CreateTournamentTeamError = "TOURNAMENT_NAME_TOO_LONG"
+	CreateTournamentTeamErrorTeamIDRequired         CreateTournamentTeamError = "TEAM_ID_REQUIRED"
+	CreateTournamentTeamErrorDataRequired           CreateTournamentTeamError = "DATA_REQUIRED"
+	CreateTournamentTeamErrorAlreadyExists          CreateTournamentTeamError = "ALREADY_EXISTS"
+	CreateTournamentTeamErrorDefinitionNotFound     CreateTournamentTeamError = "DEFINITION_NOT_FOUND"
+	CreateTournamentTeamErrorTournamentNotActive    CreateTournamentTeamError = "TOURNAMENT_NOT_ACTIVE"
 )
 
-var AllDeleteRecordError = []DeleteRecordError{
-	DeleteRecordErrorNone,
-	DeleteRecordErrorIDOrNameUserIDRequired,
-	DeleteRecordErrorNotFound,
-	DeleteRecordErrorNameTooShort,
-	DeleteRecordErrorNameTooLong,
-	DeleteRecordErrorUserIDRequired,
+var AllCreateTournamentTeamError = []CreateTournamentTeamError{
+	CreateTournamentTeamErrorNone,
+	CreateTournamentTeamErrorTournamentNameTooShort,
+	CreateTournamentTeamErrorTournamentNameTooLong,
+	CreateTournamentTeamErrorTeamIDRequired,
+	CreateTournamentTeamErrorDataRequired,
+	CreateTournamentTeamErrorAlreadyExists,
+	CreateTournamentTeamErrorDefinitionNotFound,
+	CreateTournamentTeamErrorTournamentNotActive,
 }
 
-func (e DeleteRecordError) IsValid() bool {
+func (e CreateTournamentTeamError) IsValid() bool {
 	switch e {
-	case DeleteRecordErrorNone, DeleteRecordErrorIDOrNameUserIDRequired, DeleteRecordErrorNotFound, DeleteRecordErrorNameTooShort, DeleteRecordErrorNameTooLong, DeleteRecordErrorUserIDRequired:
+	case CreateTournamentTeamErrorNone, CreateTournamentTeamErrorTournamentNameTooShort, CreateTournamentTeamErrorTournamentNameTooLong, CreateTournamentTeamErrorTeamIDRequired, CreateTournamentTeamErrorDataRequired, CreateTournamentTeamErrorAlreadyExists, CreateTournamentTeamErrorDefinitionNotFound, CreateTournamentTeamErrorTournamentNotActive:
 		return true
 	}
 	return false
 }
 
-func (e DeleteRecordError) String() string {
+func (e CreateTournamentTeamError) String() string {
 	return string(e)
 }
 
-func (e *DeleteRecordError) UnmarshalGQL(v any) error {
+func (e *CreateTournamentTeamError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = DeleteRecordError(str)
+	*e = CreateTournamentTeamError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid DeleteRecordError", str)
+		return fmt.Errorf("%s is not a valid CreateTournamentTeamError", str)
 	}
 	return nil
 }
 
-func (e DeleteRecordError) MarshalGQL(w io.Writer) {
+func (e CreateTournamentTeamError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *DeleteRecordError) UnmarshalJSON(b []byte) error {
+func (e *CreateTournamentTeamError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1085,69 +1521,69 @@ func (e *DeleteRecordError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e DeleteRecordError) MarshalJSON() ([]byte, error) {
+func (e CreateTournamentTeamError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when ending a match.
-type EndMatchError string
+// Possible errors when creating a tournament user.
+type CreateTournamentUserError string
 
 const (
-	EndMatchErrorNone                                         EndMatchError = "NONE"
-	EndMatchErrorMatchIDOrMatchmakingTicketRequired           EndMatchError = "MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED"
-	EndMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired EndMatchError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
-	EndMatchErrorMatchmakingUserIDOrClientUserIDRequired      EndMatchError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
-	EndMatchErrorEndTimeRequired                              EndMatchError = "END_TIME_REQUIRED"
-	EndMatchErrorNotFound                                     EndMatchError = "NOT_FOUND"
-	EndMatchErrorAlreadyEnded                                 EndMatchError = "ALREADY_ENDED"
-	EndMatchErrorEndTimeBeforeStartTime                       EndMatchError = "END_TIME_BEFORE_START_TIME"
-	EndMatchErrorStartTimeNotSet                              EndMatchError = "START_TIME_NOT_SET"
+	CreateTournamentUserErrorNone                   CreateTournamentUserError = "NONE"
+	CreateTournamentUserErrorTournamentNameTooShort CreateTournamentUserError = "TOURNAMENT_NAME_TOO_SHORT"
+	CreateTournamentUserErrorTournamentNameTooLong  CreateTournamentUserError = "TOURNAMENT_NAME_TOO_LONG"
+	CreateTournamentUserErrorUserIDRequired         CreateTournamentUserError = "USER_ID_REQUIRED"
+	CreateTournamentUserErrorDataRequired           CreateTournamentUserError = "DATA_REQUIRED"
+	CreateTournamentUserErrorAlreadyExists          CreateTournamentUserError = "ALREADY_EXISTS"
+	CreateTournamentUserErrorDefinitionNotFound     CreateTournamentUserError = "DEFINITION_NOT_FOUND"
+	CreateTournamentUserErrorTournamentNotActive    CreateTournamentUserError = "TOURNAMENT_NOT_ACTIVE"
+	CreateTournamentUserErrorNotATeamMember         CreateTournamentUserError = "NOT_A_TEAM_MEMBER"
 )
 
-var AllEndMatchError = []EndMatchError{
-	EndMatchErrorNone,
-	EndMatchErrorMatchIDOrMatchmakingTicketRequired,
-	EndMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired,
-	EndMatchErrorMatchmakingUserIDOrClientUserIDRequired,
-	EndMatchErrorEndTimeRequired,
-	EndMatchErrorNotFound,
-	EndMatchErrorAlreadyEnded,
-	EndMatchErrorEndTimeBeforeStartTime,
-	EndMatchErrorStartTimeNotSet,
+var AllCreateTournamentUserError = []CreateTournamentUserError{
+	CreateTournamentUserErrorNone,
+	CreateTournamentUserErrorTournamentNameTooShort,
+	CreateTournamentUserErrorTournamentNameTooLong,
+	CreateTournamentUserErrorUserIDRequired,
+	CreateTournamentUserErrorDataRequired,
+	CreateTournamentUserErrorAlreadyExists,
+	CreateTournamentUserErrorDefinitionNotFound,
+	CreateTournamentUserErrorTournamentNotActive,
+	CreateTournamentUserErrorNotATeamMember,
 }
 
-func (e EndMatchError) IsValid() bool {
+func (e CreateTournamentUserError) IsValid() bool {
 	switch e {
-	case EndMatchErrorNone, EndMatchErrorMatchIDOrMatchmakingTicketRequired, EndMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired, EndMatchErrorMatchmakingUserIDOrClientUserIDRequired, EndMatchErrorEndTimeRequired, EndMatchErrorNotFound, EndMatchErrorAlreadyEnded, EndMatchErrorEndTimeBeforeStartTime, EndMatchErrorStartTimeNotSet:
+	case CreateTournamentUserErrorNone, CreateTournamentUserErrorTournamentNameTooShort, CreateTournamentUserErrorTournamentNameTooLong, CreateTournamentUserErrorUserIDRequired, CreateTournamentUserErrorDataRequired, CreateTournamentUserErrorAlreadyExists, CreateTournamentUserErrorDefinitionNotFound, CreateTournamentUserErrorTournamentNotActive, CreateTournamentUserErrorNotATeamMember:
 		return true
 	}
 	return false
 }
 
-func (e EndMatchError) String() string {
+func (e CreateTournamentUserError) String() string {
 	return string(e)
 }
 
-func (e *EndMatchError) UnmarshalGQL(v any) error {
+func (e *CreateTournamentUserError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = EndMatchError(str)
+	*e = CreateTournamentUserError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid EndMatchError", str)
+		return fmt.Errorf("%s is not a valid CreateTournamentUserError", str)
 	}
 	return nil
 }
 
-func (e EndMatchError) MarshalGQL(w io.Writer) {
+func (e CreateTournamentUserError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *EndMatchError) UnmarshalJSON(b []byte) error {
+func (e *CreateTournamentUserError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1155,61 +1591,61 @@ func (e *EndMatchError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e EndMatchError) MarshalJSON() ([]byte, error) {
+func (e CreateTournamentUserError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when deleting an event.
-type EventError string
+// Possible errors when deleting a match.
+type DeleteMatchError string
 
 const (
-	EventErrorNone             EventError = "NONE"
-	EventErrorNameTooShort     EventError = "NAME_TOO_SHORT"
-	EventErrorNameTooLong      EventError = "NAME_TOO_LONG"
-	EventErrorIDOrNameRequired EventError = "ID_OR_NAME_REQUIRED"
-	EventErrorNotFound         EventError = "NOT_FOUND"
+	DeleteMatchErrorNone                                         DeleteMatchError = "NONE"
+	DeleteMatchErrorMatchIDOrMatchmakingTicketRequired           DeleteMatchError = "MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED"
+	DeleteMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired DeleteMatchError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
+	DeleteMatchErrorMatchmakingUserIDOrClientUserIDRequired      DeleteMatchError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
+	DeleteMatchErrorNotFound                                     DeleteMatchError = "NOT_FOUND"
 )
 
-var AllEventError = []EventError{
-	EventErrorNone,
-	EventErrorNameTooShort,
-	EventErrorNameTooLong,
-	EventErrorIDOrNameRequired,
-	EventErrorNotFound,
+var AllDeleteMatchError = []DeleteMatchError{
+	DeleteMatchErrorNone,
+	DeleteMatchErrorMatchIDOrMatchmakingTicketRequired,
+	DeleteMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired,
+	DeleteMatchErrorMatchmakingUserIDOrClientUserIDRequired,
+	DeleteMatchErrorNotFound,
 }
 
-func (e EventError) IsValid() bool {
+func (e DeleteMatchError) IsValid() bool {
 	switch e {
-	case EventErrorNone, EventErrorNameTooShort, EventErrorNameTooLong, EventErrorIDOrNameRequired, EventErrorNotFound:
+	case DeleteMatchErrorNone, DeleteMatchErrorMatchIDOrMatchmakingTicketRequired, DeleteMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired, DeleteMatchErrorMatchmakingUserIDOrClientUserIDRequired, DeleteMatchErrorNotFound:
 		return true
 	}
 	return false
 }
 
-func (e EventError) String() string {
+func (e DeleteMatchError) String() string {
 	return string(e)
 }
 
-func (e *EventError) UnmarshalGQL(v any) error {
+func (e *DeleteMatchError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = EventError(str)
+	*e = DeleteMatchError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid EventError", str)
+		return fmt.Errorf("%s is not a valid DeleteMatchError", str)
 	}
 	return nil
 }
 
-func (e EventError) MarshalGQL(w io.Writer) {
+func (e DeleteMatchError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *EventError) UnmarshalJSON(b []byte) error {
+func (e *DeleteMatchError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1217,63 +1653,61 @@ func (e *EventError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e EventError) MarshalJSON() ([]byte, error) {
+func (e DeleteMatchError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when deleting an event user.
-type EventUserError string
+// Possible errors when deleting a matchmaking ticket.
+type DeleteMatchmakingTicketError string
 
 const (
-	EventUserErrorNone                 EventUserError = "NONE"
-	EventUserErrorNameTooShort         EventUserError = "NAME_TOO_SHORT"
-	EventUserErrorNameTooLong          EventUserError = "NAME_TOO_LONG"
-	EventUserErrorIDOrNameRequired     EventUserError = "ID_OR_NAME_REQUIRED"
-	EventUserErrorClientUserIDRequired EventUserError = "CLIENT_USER_ID_REQUIRED"
-	EventUserErrorNotFound             EventUserError = "NOT_FOUND"
+	DeleteMatchmakingTicketErrorNone                                         DeleteMatchmakingTicketError = "NONE"
+	DeleteMatchmakingTicketErrorMatchmakingTicketIDOrMatchmakingUserRequired DeleteMatchmakingTicketError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
+	DeleteMatchmakingTicketErrorMatchmakingUserIDOrClientUserIDRequired      DeleteMatchmakingTicketError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
+	DeleteMatchmakingTicketErrorNotFound                                     DeleteMatchmakingTicketError = "NOT_FOUND"
+	DeleteMatchmakingTicketErrorTicketCurrentlyInMatch                       DeleteMatchmakingTicketError = "TICKET_CURRENTLY_IN_MATCH"
 )
 
-var AllEventUserError = []EventUserError{
-	EventUserErrorNone,
-	EventUserErrorNameTooShort,
-	EventUserErrorNameTooLong,
-	EventUserErrorIDOrNameRequired,
-	EventUserErrorClientUserIDRequired,
-	EventUserErrorNotFound,
+var AllDeleteMatchmakingTicketError = []DeleteMatchmakingTicketError{
+	DeleteMatchmakingTicketErrorNone,
+	DeleteMatchmakingTicketErrorMatchmakingTicketIDOrMatchmakingUserRequired,
+	DeleteMatchmakingTicketErrorMatchmakingUserIDOrClientUserIDRequired,
+	DeleteMatchmakingTicketErrorNotFound,
+	DeleteMatchmakingTicketErrorTicketCurrentlyInMatch,
 }
 
-func (e EventUserError) IsValid() bool {
+func (e DeleteMatchmakingTicketError) IsValid() bool {
 	switch e {
-	case EventUserErrorNone, EventUserErrorNameTooShort, EventUserErrorNameTooLong, EventUserErrorIDOrNameRequired, EventUserErrorClientUserIDRequired, EventUserErrorNotFound:
+	case DeleteMatchmakingTicketErrorNone, DeleteMatchmakingTicketErrorMatchmakingTicketIDOrMatchmakingUserRequired, DeleteMatchmakingTicketErrorMatchmakingUserIDOrClientUserIDRequired, DeleteMatchmakingTicketErrorNotFound, DeleteMatchmakingTicketErrorTicketCurrentlyInMatch:
 		return true
 	}
 	return false
 }
 
-func (e EventUserError) String() string {
+func (e DeleteMatchmakingTicketError) String() string {
 	return string(e)
 }
 
-func (e *EventUserError) UnmarshalGQL(v any) error {
+func (e *DeleteMatchmakingTicketError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = EventUserError(str)
+	*e = DeleteMatchmakingTicketError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid EventUserError", str)
+		return fmt.Errorf("%s is not a valid DeleteMatchmakingTicketError", str)
 	}
 	return nil
 }
 
-func (e EventUserError) MarshalGQL(w io.Writer) {
+func (e DeleteMatchmakingTicketError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *EventUserError) UnmarshalJSON(b []byte) error {
+func (e *DeleteMatchmakingTicketError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1281,61 +1715,61 @@ func (e *EventUserError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e EventUserError) MarshalJSON() ([]byte, error) {
+func (e DeleteMatchmakingTicketError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting an arena.
-type GetArenaError string
+// Possible errors when deleting a matchmaking user.
+type DeleteMatchmakingUserError string
 
 const (
-	GetArenaErrorNone                  GetArenaError = "NONE"
-	GetArenaErrorNameTooShort          GetArenaError = "NAME_TOO_SHORT"
-	GetArenaErrorNameTooLong           GetArenaError = "NAME_TOO_LONG"
-	GetArenaErrorArenaIDOrNameRequired GetArenaError = "ARENA_ID_OR_NAME_REQUIRED"
-	GetArenaErrorNotFound              GetArenaError = "NOT_FOUND"
+	DeleteMatchmakingUserErrorNone                                    DeleteMatchmakingUserError = "NONE"
+	DeleteMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired DeleteMatchmakingUserError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
+	DeleteMatchmakingUserErrorNotFound                                DeleteMatchmakingUserError = "NOT_FOUND"
+	DeleteMatchmakingUserErrorUserCurrentlyInTicket                   DeleteMatchmakingUserError = "USER_CURRENTLY_IN_TICKET"
+	DeleteMatchmakingUserErrorUserCurrentlyInMatch                    DeleteMatchmakingUserError = "USER_CURRENTLY_IN_MATCH"
 )
 
-var AllGetArenaError = []GetArenaError{
-	GetArenaErrorNone,
-	GetArenaErrorNameTooShort,
-	GetArenaErrorNameTooLong,
-	GetArenaErrorArenaIDOrNameRequired,
-	GetArenaErrorNotFound,
+var AllDeleteMatchmakingUserError = []DeleteMatchmakingUserError{
+	DeleteMatchmakingUserErrorNone,
+	DeleteMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired,
+	DeleteMatchmakingUserErrorNotFound,
+	DeleteMatchmakingUserErrorUserCurrentlyInTicket,
+	DeleteMatchmakingUserErrorUserCurrentlyInMatch,
 }
 
-func (e GetArenaError) IsValid() bool {
+func (e DeleteMatchmakingUserError) IsValid() bool {
 	switch e {
-	case GetArenaErrorNone, GetArenaErrorNameTooShort, GetArenaErrorNameTooLong, GetArenaErrorArenaIDOrNameRequired, GetArenaErrorNotFound:
+	case DeleteMatchmakingUserErrorNone, DeleteMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired, DeleteMatchmakingUserErrorNotFound, DeleteMatchmakingUserErrorUserCurrentlyInTicket, DeleteMatchmakingUserErrorUserCurrentlyInMatch:
 		return true
 	}
 	return false
 }
 
-func (e GetArenaError) String() string {
+func (e DeleteMatchmakingUserError) String() string {
 	return string(e)
 }
 
-func (e *GetArenaError) UnmarshalGQL(v any) error {
+func (e *DeleteMatchmakingUserError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetArenaError(str)
+	*e = DeleteMatchmakingUserError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetArenaError", str)
+		return fmt.Errorf("%s is not a valid DeleteMatchmakingUserError", str)
 	}
 	return nil
 }
 
-func (e GetArenaError) MarshalGQL(w io.Writer) {
+func (e DeleteMatchmakingUserError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetArenaError) UnmarshalJSON(b []byte) error {
+func (e *DeleteMatchmakingUserError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1343,23 +1777,483 @@ func (e *GetArenaError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetArenaError) MarshalJSON() ([]byte, error) {
+func (e DeleteMatchmakingUserError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting an event.
-type GetEventError string
+// Possible errors when deleting a record.
+type DeleteRecordError string
 
 const (
-	GetEventErrorNone             GetEventError = "NONE"
-	GetEventErrorNameTooShort     GetEventError = "NAME_TOO_SHORT"
-	GetEventErrorNameTooLong      GetEventError = "NAME_TOO_LONG"
-	GetEventErrorIDOrNameRequired GetEventError = "ID_OR_NAME_REQUIRED"
-	GetEventErrorNotFound         GetEventError = "NOT_FOUND"
-)
-
+	DeleteRecordErrorNone                   DeleteRecordError = "NONE"
+	DeleteRecordErrorIDOrNameUserIDRequired DeleteRecordError = "ID_OR_NAME_USER_ID_REQUIRED"
+	DeleteRecordErrorNotFound               DeleteRecordError = "NOT_FOUND"
+	DeleteRecordErrorNameTooShort           DeleteRecordError = "NAME_TOO_SHORT"
+	DeleteRecordErrorNameTooLong            DeleteRecordError = "NAME_TOO_LONG"
+	DeleteRecordErrorUserIDRequired         DeleteRecordError = "USER_ID_REQUIRED"
+)
+
+var AllDeleteRecordError = []DeleteRecordError{
+	DeleteRecordErrorNone,
+	DeleteRecordErrorIDOrNameUserIDRequired,
+	DeleteRecordErrorNotFound,
+	DeleteRecordErrorNameTooShort,
+	DeleteRecordErrorNameTooLong,
+	DeleteRecordErrorUserIDRequired,
+}
+
+func (e DeleteRecordError) IsValid() bool {
+	switch e {
+	case DeleteRecordErrorNone, DeleteRecordErrorIDOrNameUserIDRequired, DeleteRecordErrorNotFound, DeleteRecordErrorNameTooShort, DeleteRecordErrorNameTooLong, DeleteRecordErrorUserIDRequired:
+		return true
+	}
+	return false
+}
+
+func (e DeleteRecordError) String() string {
+	return string(e)
+}
+
+func (e *DeleteRecordError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DeleteRecordError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DeleteRecordError", str)
+	}
+	return nil
+}
+
+func (e DeleteRecordError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DeleteRecordError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DeleteRecordError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when disqualifying an event user.
+type DisqualifyEventUserError string
+
+const (
+	DisqualifyEventUserErrorNone                  DisqualifyEventUserError = "NONE"
+	DisqualifyEventUserErrorNameTooShort          DisqualifyEventUserError = "NAME_TOO_SHORT"
+	DisqualifyEventUserErrorNameTooLong           DisqualifyEventUserError = "NAME_TOO_LONG"
+	DisqualifyEventUserErrorIDOrNameRequired      DisqualifyEventUserError = "ID_OR_NAME_REQUIRED"
+	DisqualifyEventUserErrorClientUserIDRequired  DisqualifyEventUserError = "CLIENT_USER_ID_REQUIRED"
+	DisqualifyEventUserErrorEventUserOrIDRequired DisqualifyEventUserError = "EVENT_USER_OR_ID_REQUIRED"
+	DisqualifyEventUserErrorReasonRequired        DisqualifyEventUserError = "REASON_REQUIRED"
+	DisqualifyEventUserErrorModeratorRequired     DisqualifyEventUserError = "MODERATOR_REQUIRED"
+	DisqualifyEventUserErrorModeratorTooLong      DisqualifyEventUserError = "MODERATOR_TOO_LONG"
+	DisqualifyEventUserErrorNotFound              DisqualifyEventUserError = "NOT_FOUND"
+	DisqualifyEventUserErrorAlreadyDisqualified   DisqualifyEventUserError = "ALREADY_DISQUALIFIED"
+)
+
+var AllDisqualifyEventUserError = []DisqualifyEventUserError{
+	DisqualifyEventUserErrorNone,
+	DisqualifyEventUserErrorNameTooShort,
+	DisqualifyEventUserErrorNameTooLong,
+	DisqualifyEventUserErrorIDOrNameRequired,
+	DisqualifyEventUserErrorClientUserIDRequired,
+	DisqualifyEventUserErrorEventUserOrIDRequired,
+	DisqualifyEventUserErrorReasonRequired,
+	DisqualifyEventUserErrorModeratorRequired,
+	DisqualifyEventUserErrorModeratorTooLong,
+	DisqualifyEventUserErrorNotFound,
+	DisqualifyEventUserErrorAlreadyDisqualified,
+}
+
+func (e DisqualifyEventUserError) IsValid() bool {
+	switch e {
+	case DisqualifyEventUserErrorNone, DisqualifyEventUserErrorNameTooShort, DisqualifyEventUserErrorNameTooLong, DisqualifyEventUserErrorIDOrNameRequired, DisqualifyEventUserErrorClientUserIDRequired, DisqualifyEventUserErrorEventUserOrIDRequired, DisqualifyEventUserErrorReasonRequired, DisqualifyEventUserErrorModeratorRequired, DisqualifyEventUserErrorModeratorTooLong, DisqualifyEventUserErrorNotFound, DisqualifyEventUserErrorAlreadyDisqualified:
+		return true
+	}
+	return false
+}
+
+func (e DisqualifyEventUserError) String() string {
+	return string(e)
+}
+
+func (e *DisqualifyEventUserError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DisqualifyEventUserError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DisqualifyEventUserError", str)
+	}
+	return nil
+}
+
+func (e DisqualifyEventUserError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DisqualifyEventUserError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DisqualifyEventUserError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when ending a match.
+type EndMatchError string
+
+const (
+	EndMatchErrorNone                                         EndMatchError = "NONE"
+	EndMatchErrorMatchIDOrMatchmakingTicketRequired           EndMatchError = "MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED"
+	EndMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired EndMatchError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
+	EndMatchErrorMatchmakingUserIDOrClientUserIDRequired      EndMatchError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
+	EndMatchErrorEndTimeRequired                              EndMatchError = "END_TIME_REQUIRED"
+	EndMatchErrorNotFound                                     EndMatchError = "NOT_FOUND"
+	EndMatchErrorAlreadyEnded                                 EndMatchError = "ALREADY_ENDED"
+	EndMatchErrorEndTimeBeforeStartTime                       EndMatchError = "END_TIME_BEFORE_START_TIME"
+	EndMatchErrorStartTimeNotSet                              EndMatchError = "START_TIME_NOT_SET"
+)
+
+var AllEndMatchError = []EndMatchError{
+	EndMatchErrorNone,
+	EndMatchErrorMatchIDOrMatchmakingTicketRequired,
+	EndMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired,
+	EndMatchErrorMatchmakingUserIDOrClientUserIDRequired,
+	EndMatchErrorEndTimeRequired,
+	EndMatchErrorNotFound,
+	EndMatchErrorAlreadyEnded,
+	EndMatchErrorEndTimeBeforeStartTime,
+	EndMatchErrorStartTimeNotSet,
+}
+
+func (e EndMatchError) IsValid() bool {
+	switch e {
+	case EndMatchErrorNone, EndMatchErrorMatchIDOrMatchmakingTicketRequired, EndMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired, EndMatchErrorMatchmakingUserIDOrClientUserIDRequired, EndMatchErrorEndTimeRequired, EndMatchErrorNotFound, EndMatchErrorAlreadyEnded, EndMatchErrorEndTimeBeforeStartTime, EndMatchErrorStartTimeNotSet:
+		return true
+	}
+	return false
+}
+
+func (e EndMatchError) String() string {
+	return string(e)
+}
+
+func (e *EndMatchError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EndMatchError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EndMatchError", str)
+	}
+	return nil
+}
+
+func (e EndMatchError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EndMatchError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EndMatchError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when deleting an event.
+type EventError string
+
+const (
+	EventErrorNone             EventError = "NONE"
+	EventErrorNameTooShort     EventError = "NAME_TOO_SHORT"
+	EventErrorNameTooLong      EventError = "NAME_TOO_LONG"
+	EventErrorIDOrNameRequired EventError = "ID_OR_NAME_REQUIRED"
+	EventErrorNotFound         EventError = "NOT_FOUND"
+)
+
+var AllEventError = []EventError{
+	EventErrorNone,
+	EventErrorNameTooShort,
+	EventErrorNameTooLong,
+	EventErrorIDOrNameRequired,
+	EventErrorNotFound,
+}
+
+func (e EventError) IsValid() bool {
+	switch e {
+	case EventErrorNone, EventErrorNameTooShort, EventErrorNameTooLong, EventErrorIDOrNameRequired, EventErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e EventError) String() string {
+	return string(e)
+}
+
+func (e *EventError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventError", str)
+	}
+	return nil
+}
+
+func (e EventError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EventError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EventError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when deleting an event template.
+type EventTemplateError string
+
+const (
+	EventTemplateErrorNone             EventTemplateError = "NONE"
+	EventTemplateErrorNameTooShort     EventTemplateError = "NAME_TOO_SHORT"
+	EventTemplateErrorNameTooLong      EventTemplateError = "NAME_TOO_LONG"
+	EventTemplateErrorIDOrNameRequired EventTemplateError = "ID_OR_NAME_REQUIRED"
+	EventTemplateErrorNotFound         EventTemplateError = "NOT_FOUND"
+)
+
+var AllEventTemplateError = []EventTemplateError{
+	EventTemplateErrorNone,
+	EventTemplateErrorNameTooShort,
+	EventTemplateErrorNameTooLong,
+	EventTemplateErrorIDOrNameRequired,
+	EventTemplateErrorNotFound,
+}
+
+func (e EventTemplateError) IsValid() bool {
+	switch e {
+	case EventTemplateErrorNone, EventTemplateErrorNameTooShort, EventTemplateErrorNameTooLong, EventTemplateErrorIDOrNameRequired, EventTemplateErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e EventTemplateError) String() string {
+	return string(e)
+}
+
+func (e *EventTemplateError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventTemplateError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventTemplateError", str)
+	}
+	return nil
+}
+
+func (e EventTemplateError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EventTemplateError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EventTemplateError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when deleting an event user.
+type EventUserError string
+
+const (
+	EventUserErrorNone                 EventUserError = "NONE"
+	EventUserErrorNameTooShort         EventUserError = "NAME_TOO_SHORT"
+	EventUserErrorNameTooLong          EventUserError = "NAME_TOO_LONG"
+	EventUserErrorIDOrNameRequired     EventUserError = "ID_OR_NAME_REQUIRED"
+	EventUserErrorClientUserIDRequired EventUserError = "CLIENT_USER_ID_REQUIRED"
+	EventUserErrorNotFound             EventUserError = "NOT_FOUND"
+	EventUserErrorDisqualified         EventUserError = "DISQUALIFIED"
+)
+
+var AllEventUserError = []EventUserError{
+	EventUserErrorNone,
+	EventUserErrorNameTooShort,
+	EventUserErrorNameTooLong,
+	EventUserErrorIDOrNameRequired,
+	EventUserErrorClientUserIDRequired,
+	EventUserErrorNotFound,
+	EventUserErrorDisqualified,
+}
+
+func (e EventUserError) IsValid() bool {
+	switch e {
+	case EventUserErrorNone, EventUserErrorNameTooShort, EventUserErrorNameTooLong, EventUserErrorIDOrNameRequired, EventUserErrorClientUserIDRequired, EventUserErrorNotFound, EventUserErrorDisqualified:
+		return true
+	}
+	return false
+}
+
+func (e EventUserError) String() string {
+	return string(e)
+}
+
+func (e *EventUserError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventUserError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventUserError", str)
+	}
+	return nil
+}
+
+func (e EventUserError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EventUserError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EventUserError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting an arena.
+type GetArenaError string
+
+const (
+	GetArenaErrorNone                  GetArenaError = "NONE"
+	GetArenaErrorNameTooShort          GetArenaError = "NAME_TOO_SHORT"
+	GetArenaErrorNameTooLong           GetArenaError = "NAME_TOO_LONG"
+	GetArenaErrorArenaIDOrNameRequired GetArenaError = "ARENA_ID_OR_NAME_REQUIRED"
+	GetArenaErrorNotFound              GetArenaError = "NOT_FOUND"
+)
+
+var AllGetArenaError = []GetArenaError{
+	GetArenaErrorNone,
+	GetArenaErrorNameTooShort,
+	GetArenaErrorNameTooLong,
+	GetArenaErrorArenaIDOrNameRequired,
+	GetArenaErrorNotFound,
+}
+
+func (e GetArenaError) IsValid() bool {
+	switch e {
+	case GetArenaErrorNone, GetArenaErrorNameTooShort, GetArenaErrorNameTooLong, GetArenaErrorArenaIDOrNameRequired, GetArenaErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetArenaError) String() string {
+	return string(e)
+}
+
+func (e *GetArenaError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetArenaError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetArenaError", str)
+	}
+	return nil
+}
+
+func (e GetArenaError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetArenaError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetArenaError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting an event.
+type GetEventError string
+
+const (
+	GetEventErrorNone             GetEventError = "NONE"
+	GetEventErrorNameTooShort     GetEventError = "NAME_TOO_SHORT"
+	GetEventErrorNameTooLong      GetEventError = "NAME_TOO_LONG"
+	GetEventErrorIDOrNameRequired GetEventError = "ID_OR_NAME_REQUIRED"
+	GetEventErrorNotFound         GetEventError = "NOT_FOUND"
+)
+
 var AllGetEventError = []GetEventError{
 	GetEventErrorNone,
 	GetEventErrorNameTooShort,
@@ -1368,36 +2262,1864 @@ var AllGetEventError = []GetEventError{
 	GetEventErrorNotFound,
 }
 
-func (e GetEventError) IsValid() bool {
+func (e GetEventError) IsValid() bool {
+	switch e {
+	case GetEventErrorNone, GetEventErrorNameTooShort, GetEventErrorNameTooLong, GetEventErrorIDOrNameRequired, GetEventErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetEventError) String() string {
+	return string(e)
+}
+
+func (e *GetEventError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetEventError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetEventError", str)
+	}
+	return nil
+}
+
+func (e GetEventError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetEventError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetEventError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting the attempts of an event user.
+type GetEventRoundAttemptsError string
+
+const (
+	GetEventRoundAttemptsErrorNone                  GetEventRoundAttemptsError = "NONE"
+	GetEventRoundAttemptsErrorNameTooShort          GetEventRoundAttemptsError = "NAME_TOO_SHORT"
+	GetEventRoundAttemptsErrorNameTooLong           GetEventRoundAttemptsError = "NAME_TOO_LONG"
+	GetEventRoundAttemptsErrorIDOrNameRequired      GetEventRoundAttemptsError = "ID_OR_NAME_REQUIRED"
+	GetEventRoundAttemptsErrorClientUserIDRequired  GetEventRoundAttemptsError = "CLIENT_USER_ID_REQUIRED"
+	GetEventRoundAttemptsErrorEventUserOrIDRequired GetEventRoundAttemptsError = "EVENT_USER_OR_ID_REQUIRED"
+	GetEventRoundAttemptsErrorRoundNameTooShort     GetEventRoundAttemptsError = "ROUND_NAME_TOO_SHORT"
+	GetEventRoundAttemptsErrorRoundNameTooLong      GetEventRoundAttemptsError = "ROUND_NAME_TOO_LONG"
+	GetEventRoundAttemptsErrorNotFound              GetEventRoundAttemptsError = "NOT_FOUND"
+)
+
+var AllGetEventRoundAttemptsError = []GetEventRoundAttemptsError{
+	GetEventRoundAttemptsErrorNone,
+	GetEventRoundAttemptsErrorNameTooShort,
+	GetEventRoundAttemptsErrorNameTooLong,
+	GetEventRoundAttemptsErrorIDOrNameRequired,
+	GetEventRoundAttemptsErrorClientUserIDRequired,
+	GetEventRoundAttemptsErrorEventUserOrIDRequired,
+	GetEventRoundAttemptsErrorRoundNameTooShort,
+	GetEventRoundAttemptsErrorRoundNameTooLong,
+	GetEventRoundAttemptsErrorNotFound,
+}
+
+func (e GetEventRoundAttemptsError) IsValid() bool {
+	switch e {
+	case GetEventRoundAttemptsErrorNone, GetEventRoundAttemptsErrorNameTooShort, GetEventRoundAttemptsErrorNameTooLong, GetEventRoundAttemptsErrorIDOrNameRequired, GetEventRoundAttemptsErrorClientUserIDRequired, GetEventRoundAttemptsErrorEventUserOrIDRequired, GetEventRoundAttemptsErrorRoundNameTooShort, GetEventRoundAttemptsErrorRoundNameTooLong, GetEventRoundAttemptsErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetEventRoundAttemptsError) String() string {
+	return string(e)
+}
+
+func (e *GetEventRoundAttemptsError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetEventRoundAttemptsError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetEventRoundAttemptsError", str)
+	}
+	return nil
+}
+
+func (e GetEventRoundAttemptsError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetEventRoundAttemptsError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetEventRoundAttemptsError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting an event round.
+type GetEventRoundError string
+
+const (
+	GetEventRoundErrorNone                   GetEventRoundError = "NONE"
+	GetEventRoundErrorNameTooShort           GetEventRoundError = "NAME_TOO_SHORT"
+	GetEventRoundErrorNameTooLong            GetEventRoundError = "NAME_TOO_LONG"
+	GetEventRoundErrorIDOrNameRequired       GetEventRoundError = "ID_OR_NAME_REQUIRED"
+	GetEventRoundErrorEventRoundOrIDRequired GetEventRoundError = "EVENT_ROUND_OR_ID_REQUIRED"
+	GetEventRoundErrorNotFound               GetEventRoundError = "NOT_FOUND"
+)
+
+var AllGetEventRoundError = []GetEventRoundError{
+	GetEventRoundErrorNone,
+	GetEventRoundErrorNameTooShort,
+	GetEventRoundErrorNameTooLong,
+	GetEventRoundErrorIDOrNameRequired,
+	GetEventRoundErrorEventRoundOrIDRequired,
+	GetEventRoundErrorNotFound,
+}
+
+func (e GetEventRoundError) IsValid() bool {
+	switch e {
+	case GetEventRoundErrorNone, GetEventRoundErrorNameTooShort, GetEventRoundErrorNameTooLong, GetEventRoundErrorIDOrNameRequired, GetEventRoundErrorEventRoundOrIDRequired, GetEventRoundErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetEventRoundError) String() string {
+	return string(e)
+}
+
+func (e *GetEventRoundError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetEventRoundError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetEventRoundError", str)
+	}
+	return nil
+}
+
+func (e GetEventRoundError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetEventRoundError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetEventRoundError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting an event template.
+type GetEventTemplateError string
+
+const (
+	GetEventTemplateErrorNone             GetEventTemplateError = "NONE"
+	GetEventTemplateErrorNameTooShort     GetEventTemplateError = "NAME_TOO_SHORT"
+	GetEventTemplateErrorNameTooLong      GetEventTemplateError = "NAME_TOO_LONG"
+	GetEventTemplateErrorIDOrNameRequired GetEventTemplateError = "ID_OR_NAME_REQUIRED"
+	GetEventTemplateErrorNotFound         GetEventTemplateError = "NOT_FOUND"
+)
+
+var AllGetEventTemplateError = []GetEventTemplateError{
+	GetEventTemplateErrorNone,
+	GetEventTemplateErrorNameTooShort,
+	GetEventTemplateErrorNameTooLong,
+	GetEventTemplateErrorIDOrNameRequired,
+	GetEventTemplateErrorNotFound,
+}
+
+func (e GetEventTemplateError) IsValid() bool {
+	switch e {
+	case GetEventTemplateErrorNone, GetEventTemplateErrorNameTooShort, GetEventTemplateErrorNameTooLong, GetEventTemplateErrorIDOrNameRequired, GetEventTemplateErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetEventTemplateError) String() string {
+	return string(e)
+}
+
+func (e *GetEventTemplateError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetEventTemplateError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetEventTemplateError", str)
+	}
+	return nil
+}
+
+func (e GetEventTemplateError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetEventTemplateError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetEventTemplateError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting an event user.
+type GetEventUserError string
+
+const (
+	GetEventUserErrorNone                  GetEventUserError = "NONE"
+	GetEventUserErrorNameTooShort          GetEventUserError = "NAME_TOO_SHORT"
+	GetEventUserErrorNameTooLong           GetEventUserError = "NAME_TOO_LONG"
+	GetEventUserErrorIDOrNameRequired      GetEventUserError = "ID_OR_NAME_REQUIRED"
+	GetEventUserErrorClientUserIDRequired  GetEventUserError = "CLIENT_USER_ID_REQUIRED"
+	GetEventUserErrorEventUserOrIDRequired GetEventUserError = "EVENT_USER_OR_ID_REQUIRED"
+	GetEventUserErrorNotFound              GetEventUserError = "NOT_FOUND"
+)
+
+var AllGetEventUserError = []GetEventUserError{
+	GetEventUserErrorNone,
+	GetEventUserErrorNameTooShort,
+	GetEventUserErrorNameTooLong,
+	GetEventUserErrorIDOrNameRequired,
+	GetEventUserErrorClientUserIDRequired,
+	GetEventUserErrorEventUserOrIDRequired,
+	GetEventUserErrorNotFound,
+}
+
+func (e GetEventUserError) IsValid() bool {
+	switch e {
+	case GetEventUserErrorNone, GetEventUserErrorNameTooShort, GetEventUserErrorNameTooLong, GetEventUserErrorIDOrNameRequired, GetEventUserErrorClientUserIDRequired, GetEventUserErrorEventUserOrIDRequired, GetEventUserErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetEventUserError) String() string {
+	return string(e)
+}
+
+func (e *GetEventUserError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetEventUserError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetEventUserError", str)
+	}
+	return nil
+}
+
+func (e GetEventUserError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetEventUserError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetEventUserError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting an item.
+type GetItemError string
+
+const (
+	GetItemErrorNone         GetItemError = "NONE"
+	GetItemErrorIDRequired   GetItemError = "ID_REQUIRED"
+	GetItemErrorTypeRequired GetItemError = "TYPE_REQUIRED"
+	GetItemErrorNotFound     GetItemError = "NOT_FOUND"
+)
+
+var AllGetItemError = []GetItemError{
+	GetItemErrorNone,
+	GetItemErrorIDRequired,
+	GetItemErrorTypeRequired,
+	GetItemErrorNotFound,
+}
+
+func (e GetItemError) IsValid() bool {
+	switch e {
+	case GetItemErrorNone, GetItemErrorIDRequired, GetItemErrorTypeRequired, GetItemErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetItemError) String() string {
+	return string(e)
+}
+
+func (e *GetItemError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetItemError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetItemError", str)
+	}
+	return nil
+}
+
+func (e GetItemError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetItemError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetItemError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a match.
+type GetMatchError string
+
+const (
+	GetMatchErrorNone                                         GetMatchError = "NONE"
+	GetMatchErrorMatchIDOrMatchmakingTicketRequired           GetMatchError = "MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED"
+	GetMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired GetMatchError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
+	GetMatchErrorMatchmakingUserIDOrClientUserIDRequired      GetMatchError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
+	GetMatchErrorNotFound                                     GetMatchError = "NOT_FOUND"
+)
+
+var AllGetMatchError = []GetMatchError{
+	GetMatchErrorNone,
+	GetMatchErrorMatchIDOrMatchmakingTicketRequired,
+	GetMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired,
+	GetMatchErrorMatchmakingUserIDOrClientUserIDRequired,
+	GetMatchErrorNotFound,
+}
+
+func (e GetMatchError) IsValid() bool {
+	switch e {
+	case GetMatchErrorNone, GetMatchErrorMatchIDOrMatchmakingTicketRequired, GetMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired, GetMatchErrorMatchmakingUserIDOrClientUserIDRequired, GetMatchErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetMatchError) String() string {
+	return string(e)
+}
+
+func (e *GetMatchError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetMatchError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetMatchError", str)
+	}
+	return nil
+}
+
+func (e GetMatchError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetMatchError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetMatchError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a matchmaking ticket.
+type GetMatchmakingTicketError string
+
+const (
+	GetMatchmakingTicketErrorNone                                         GetMatchmakingTicketError = "NONE"
+	GetMatchmakingTicketErrorMatchmakingTicketIDOrMatchmakingUserRequired GetMatchmakingTicketError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
+	GetMatchmakingTicketErrorMatchmakingUserIDOrClientUserIDRequired      GetMatchmakingTicketError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
+	GetMatchmakingTicketErrorNotFound                                     GetMatchmakingTicketError = "NOT_FOUND"
+)
+
+var AllGetMatchmakingTicketError = []GetMatchmakingTicketError{
+	GetMatchmakingTicketErrorNone,
+	GetMatchmakingTicketErrorMatchmakingTicketIDOrMatchmakingUserRequired,
+	GetMatchmakingTicketErrorMatchmakingUserIDOrClientUserIDRequired,
+	GetMatchmakingTicketErrorNotFound,
+}
+
+func (e GetMatchmakingTicketError) IsValid() bool {
+	switch e {
+	case GetMatchmakingTicketErrorNone, GetMatchmakingTicketErrorMatchmakingTicketIDOrMatchmakingUserRequired, GetMatchmakingTicketErrorMatchmakingUserIDOrClientUserIDRequired, GetMatchmakingTicketErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetMatchmakingTicketError) String() string {
+	return string(e)
+}
+
+func (e *GetMatchmakingTicketError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetMatchmakingTicketError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetMatchmakingTicketError", str)
+	}
+	return nil
+}
+
+func (e GetMatchmakingTicketError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetMatchmakingTicketError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetMatchmakingTicketError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a list of matchmaking tickets.
+type GetMatchmakingTicketsError string
+
+const (
+	GetMatchmakingTicketsErrorNone          GetMatchmakingTicketsError = "NONE"
+	GetMatchmakingTicketsErrorMatchNotFound GetMatchmakingTicketsError = "MATCH_NOT_FOUND"
+	GetMatchmakingTicketsErrorUserNotFound  GetMatchmakingTicketsError = "USER_NOT_FOUND"
+)
+
+var AllGetMatchmakingTicketsError = []GetMatchmakingTicketsError{
+	GetMatchmakingTicketsErrorNone,
+	GetMatchmakingTicketsErrorMatchNotFound,
+	GetMatchmakingTicketsErrorUserNotFound,
+}
+
+func (e GetMatchmakingTicketsError) IsValid() bool {
+	switch e {
+	case GetMatchmakingTicketsErrorNone, GetMatchmakingTicketsErrorMatchNotFound, GetMatchmakingTicketsErrorUserNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetMatchmakingTicketsError) String() string {
+	return string(e)
+}
+
+func (e *GetMatchmakingTicketsError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetMatchmakingTicketsError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetMatchmakingTicketsError", str)
+	}
+	return nil
+}
+
+func (e GetMatchmakingTicketsError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetMatchmakingTicketsError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetMatchmakingTicketsError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a matchmaking user.
+type GetMatchmakingUserError string
+
+const (
+	GetMatchmakingUserErrorNone                                    GetMatchmakingUserError = "NONE"
+	GetMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired GetMatchmakingUserError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
+	GetMatchmakingUserErrorNotFound                                GetMatchmakingUserError = "NOT_FOUND"
+)
+
+var AllGetMatchmakingUserError = []GetMatchmakingUserError{
+	GetMatchmakingUserErrorNone,
+	GetMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired,
+	GetMatchmakingUserErrorNotFound,
+}
+
+func (e GetMatchmakingUserError) IsValid() bool {
+	switch e {
+	case GetMatchmakingUserErrorNone, GetMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired, GetMatchmakingUserErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetMatchmakingUserError) String() string {
+	return string(e)
+}
+
+func (e *GetMatchmakingUserError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetMatchmakingUserError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetMatchmakingUserError", str)
+	}
+	return nil
+}
+
+func (e GetMatchmakingUserError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetMatchmakingUserError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetMatchmakingUserError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a record.
+type GetRecordError string
+
+const (
+	GetRecordErrorNone                   GetRecordError = "NONE"
+	GetRecordErrorIDOrNameUserIDRequired GetRecordError = "ID_OR_NAME_USER_ID_REQUIRED"
+	GetRecordErrorNotFound               GetRecordError = "NOT_FOUND"
+	GetRecordErrorNameTooShort           GetRecordError = "NAME_TOO_SHORT"
+	GetRecordErrorNameTooLong            GetRecordError = "NAME_TOO_LONG"
+	GetRecordErrorUserIDRequired         GetRecordError = "USER_ID_REQUIRED"
+)
+
+var AllGetRecordError = []GetRecordError{
+	GetRecordErrorNone,
+	GetRecordErrorIDOrNameUserIDRequired,
+	GetRecordErrorNotFound,
+	GetRecordErrorNameTooShort,
+	GetRecordErrorNameTooLong,
+	GetRecordErrorUserIDRequired,
+}
+
+func (e GetRecordError) IsValid() bool {
+	switch e {
+	case GetRecordErrorNone, GetRecordErrorIDOrNameUserIDRequired, GetRecordErrorNotFound, GetRecordErrorNameTooShort, GetRecordErrorNameTooLong, GetRecordErrorUserIDRequired:
+		return true
+	}
+	return false
+}
+
+func (e GetRecordError) String() string {
+	return string(e)
+}
+
+func (e *GetRecordError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetRecordError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetRecordError", str)
+	}
+	return nil
+}
+
+func (e GetRecordError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetRecordError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetRecordError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a list of records.
+type GetRecordsError string
+
+const (
+	GetRecordsErrorNone          GetRecordsError = "NONE"
+	GetRecordsErrorNameTooShort  GetRecordsError = "NAME_TOO_SHORT"
+	GetRecordsErrorNameTooLong   GetRecordsError = "NAME_TOO_LONG"
+	GetRecordsErrorInvalidCursor GetRecordsError = "INVALID_CURSOR"
+)
+
+var AllGetRecordsError = []GetRecordsError{
+	GetRecordsErrorNone,
+	GetRecordsErrorNameTooShort,
+	GetRecordsErrorNameTooLong,
+	GetRecordsErrorInvalidCursor,
+}
+
+func (e GetRecordsError) IsValid() bool {
+	switch e {
+	case GetRecordsErrorNone, GetRecordsErrorNameTooShort, GetRecordsErrorNameTooLong, GetRecordsErrorInvalidCursor:
+		return true
+	}
+	return false
+}
+
+func (e GetRecordsError) String() string {
+	return string(e)
+}
+
+func (e *GetRecordsError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetRecordsError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetRecordsError", str)
+	}
+	return nil
+}
+
+func (e GetRecordsError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetRecordsError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetRecordsError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting an task.
+type GetTaskError string
+
+const (
+	GetTaskErrorNone         GetTaskError = "NONE"
+	GetTaskErrorIDRequired   GetTaskError = "ID_REQUIRED"
+	GetTaskErrorTypeRequired GetTaskError = "TYPE_REQUIRED"
+	GetTaskErrorNotFound     GetTaskError = "NOT_FOUND"
+)
+
+var AllGetTaskError = []GetTaskError{
+	GetTaskErrorNone,
+	GetTaskErrorIDRequired,
+	GetTaskErrorTypeRequired,
+	GetTaskErrorNotFound,
+}
+
+func (e GetTaskError) IsValid() bool {
+	switch e {
+	case GetTaskErrorNone, GetTaskErrorIDRequired, GetTaskErrorTypeRequired, GetTaskErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetTaskError) String() string {
+	return string(e)
+}
+
+func (e *GetTaskError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTaskError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTaskError", str)
+	}
+	return nil
+}
+
+func (e GetTaskError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTaskError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTaskError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a team.
+type GetTeamError string
+
+const (
+	GetTeamErrorNone             GetTeamError = "NONE"
+	GetTeamErrorNoFieldSpecified GetTeamError = "NO_FIELD_SPECIFIED"
+	GetTeamErrorNameTooShort     GetTeamError = "NAME_TOO_SHORT"
+	GetTeamErrorNameTooLong      GetTeamError = "NAME_TOO_LONG"
+	GetTeamErrorNotFound         GetTeamError = "NOT_FOUND"
+)
+
+var AllGetTeamError = []GetTeamError{
+	GetTeamErrorNone,
+	GetTeamErrorNoFieldSpecified,
+	GetTeamErrorNameTooShort,
+	GetTeamErrorNameTooLong,
+	GetTeamErrorNotFound,
+}
+
+func (e GetTeamError) IsValid() bool {
+	switch e {
+	case GetTeamErrorNone, GetTeamErrorNoFieldSpecified, GetTeamErrorNameTooShort, GetTeamErrorNameTooLong, GetTeamErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetTeamError) String() string {
+	return string(e)
+}
+
+func (e *GetTeamError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTeamError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTeamError", str)
+	}
+	return nil
+}
+
+func (e GetTeamError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTeamError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTeamError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a team member.
+type GetTeamMemberError string
+
+const (
+	GetTeamMemberErrorNone             GetTeamMemberError = "NONE"
+	GetTeamMemberErrorNoFieldSpecified GetTeamMemberError = "NO_FIELD_SPECIFIED"
+	GetTeamMemberErrorNotFound         GetTeamMemberError = "NOT_FOUND"
+)
+
+var AllGetTeamMemberError = []GetTeamMemberError{
+	GetTeamMemberErrorNone,
+	GetTeamMemberErrorNoFieldSpecified,
+	GetTeamMemberErrorNotFound,
+}
+
+func (e GetTeamMemberError) IsValid() bool {
+	switch e {
+	case GetTeamMemberErrorNone, GetTeamMemberErrorNoFieldSpecified, GetTeamMemberErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetTeamMemberError) String() string {
+	return string(e)
+}
+
+func (e *GetTeamMemberError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTeamMemberError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTeamMemberError", str)
+	}
+	return nil
+}
+
+func (e GetTeamMemberError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTeamMemberError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTeamMemberError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a team's messages.
+type GetTeamMessagesError string
+
+const (
+	GetTeamMessagesErrorNone             GetTeamMessagesError = "NONE"
+	GetTeamMessagesErrorNoFieldSpecified GetTeamMessagesError = "NO_FIELD_SPECIFIED"
+	GetTeamMessagesErrorNameTooShort     GetTeamMessagesError = "NAME_TOO_SHORT"
+	GetTeamMessagesErrorNameTooLong      GetTeamMessagesError = "NAME_TOO_LONG"
+)
+
+var AllGetTeamMessagesError = []GetTeamMessagesError{
+	GetTeamMessagesErrorNone,
+	GetTeamMessagesErrorNoFieldSpecified,
+	GetTeamMessagesErrorNameTooShort,
+	GetTeamMessagesErrorNameTooLong,
+}
+
+func (e GetTeamMessagesError) IsValid() bool {
+	switch e {
+	case GetTeamMessagesErrorNone, GetTeamMessagesErrorNoFieldSpecified, GetTeamMessagesErrorNameTooShort, GetTeamMessagesErrorNameTooLong:
+		return true
+	}
+	return false
+}
+
+func (e GetTeamMessagesError) String() string {
+	return string(e)
+}
+
+func (e *GetTeamMessagesError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTeamMessagesError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTeamMessagesError", str)
+	}
+	return nil
+}
+
+func (e GetTeamMessagesError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTeamMessagesError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTeamMessagesError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a team's season history.
+type GetTeamSeasonHistoryError string
+
+const (
+	GetTeamSeasonHistoryErrorNone             GetTeamSeasonHistoryError = "NONE"
+	GetTeamSeasonHistoryErrorNoFieldSpecified GetTeamSeasonHistoryError = "NO_FIELD_SPECIFIED"
+	GetTeamSeasonHistoryErrorNameTooShort     GetTeamSeasonHistoryError = "NAME_TOO_SHORT"
+	GetTeamSeasonHistoryErrorNameTooLong      GetTeamSeasonHistoryError = "NAME_TOO_LONG"
+)
+
+var AllGetTeamSeasonHistoryError = []GetTeamSeasonHistoryError{
+	GetTeamSeasonHistoryErrorNone,
+	GetTeamSeasonHistoryErrorNoFieldSpecified,
+	GetTeamSeasonHistoryErrorNameTooShort,
+	GetTeamSeasonHistoryErrorNameTooLong,
+}
+
+func (e GetTeamSeasonHistoryError) IsValid() bool {
+	switch e {
+	case GetTeamSeasonHistoryErrorNone, GetTeamSeasonHistoryErrorNoFieldSpecified, GetTeamSeasonHistoryErrorNameTooShort, GetTeamSeasonHistoryErrorNameTooLong:
+		return true
+	}
+	return false
+}
+
+func (e GetTeamSeasonHistoryError) String() string {
+	return string(e)
+}
+
+func (e *GetTeamSeasonHistoryError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTeamSeasonHistoryError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTeamSeasonHistoryError", str)
+	}
+	return nil
+}
+
+func (e GetTeamSeasonHistoryError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTeamSeasonHistoryError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTeamSeasonHistoryError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a list of teams.
+type GetTeamsError string
+
+const (
+	GetTeamsErrorNone          GetTeamsError = "NONE"
+	GetTeamsErrorInvalidCursor GetTeamsError = "INVALID_CURSOR"
+)
+
+var AllGetTeamsError = []GetTeamsError{
+	GetTeamsErrorNone,
+	GetTeamsErrorInvalidCursor,
+}
+
+func (e GetTeamsError) IsValid() bool {
+	switch e {
+	case GetTeamsErrorNone, GetTeamsErrorInvalidCursor:
+		return true
+	}
+	return false
+}
+
+func (e GetTeamsError) String() string {
+	return string(e)
+}
+
+func (e *GetTeamsError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTeamsError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTeamsError", str)
+	}
+	return nil
+}
+
+func (e GetTeamsError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTeamsError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTeamsError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a tournament bracket.
+type GetTournamentBracketError string
+
+const (
+	GetTournamentBracketErrorNone       GetTournamentBracketError = "NONE"
+	GetTournamentBracketErrorIDRequired GetTournamentBracketError = "ID_REQUIRED"
+	GetTournamentBracketErrorNotFound   GetTournamentBracketError = "NOT_FOUND"
+)
+
+var AllGetTournamentBracketError = []GetTournamentBracketError{
+	GetTournamentBracketErrorNone,
+	GetTournamentBracketErrorIDRequired,
+	GetTournamentBracketErrorNotFound,
+}
+
+func (e GetTournamentBracketError) IsValid() bool {
+	switch e {
+	case GetTournamentBracketErrorNone, GetTournamentBracketErrorIDRequired, GetTournamentBracketErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetTournamentBracketError) String() string {
+	return string(e)
+}
+
+func (e *GetTournamentBracketError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTournamentBracketError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTournamentBracketError", str)
+	}
+	return nil
+}
+
+func (e GetTournamentBracketError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTournamentBracketError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTournamentBracketError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a custom tournament definition.
+type GetTournamentDefinitionError string
+
+const (
+	GetTournamentDefinitionErrorNone                   GetTournamentDefinitionError = "NONE"
+	GetTournamentDefinitionErrorTournamentNameTooShort GetTournamentDefinitionError = "TOURNAMENT_NAME_TOO_SHORT"
+	GetTournamentDefinitionErrorTournamentNameTooLong  GetTournamentDefinitionError = "TOURNAMENT_NAME_TOO_LONG"
+	GetTournamentDefinitionErrorNotFound               GetTournamentDefinitionError = "NOT_FOUND"
+)
+
+var AllGetTournamentDefinitionError = []GetTournamentDefinitionError{
+	GetTournamentDefinitionErrorNone,
+	GetTournamentDefinitionErrorTournamentNameTooShort,
+	GetTournamentDefinitionErrorTournamentNameTooLong,
+	GetTournamentDefinitionErrorNotFound,
+}
+
+func (e GetTournamentDefinitionError) IsValid() bool {
+	switch e {
+	case GetTournamentDefinitionErrorNone, GetTournamentDefinitionErrorTournamentNameTooShort, GetTournamentDefinitionErrorTournamentNameTooLong, GetTournamentDefinitionErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetTournamentDefinitionError) String() string {
+	return string(e)
+}
+
+func (e *GetTournamentDefinitionError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTournamentDefinitionError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTournamentDefinitionError", str)
+	}
+	return nil
+}
+
+func (e GetTournamentDefinitionError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTournamentDefinitionError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTournamentDefinitionError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting the pairings of the current tournament.
+type GetTournamentPairingsError string
+
+const (
+	GetTournamentPairingsErrorNone                   GetTournamentPairingsError = "NONE"
+	GetTournamentPairingsErrorTournamentNameTooShort GetTournamentPairingsError = "TOURNAMENT_NAME_TOO_SHORT"
+	GetTournamentPairingsErrorTournamentNameTooLong  GetTournamentPairingsError = "TOURNAMENT_NAME_TOO_LONG"
+	GetTournamentPairingsErrorDefinitionNotFound     GetTournamentPairingsError = "DEFINITION_NOT_FOUND"
+	GetTournamentPairingsErrorTournamentNotActive    GetTournamentPairingsError = "TOURNAMENT_NOT_ACTIVE"
+)
+
+var AllGetTournamentPairingsError = []GetTournamentPairingsError{
+	GetTournamentPairingsErrorNone,
+	GetTournamentPairingsErrorTournamentNameTooShort,
+	GetTournamentPairingsErrorTournamentNameTooLong,
+	GetTournamentPairingsErrorDefinitionNotFound,
+	GetTournamentPairingsErrorTournamentNotActive,
+}
+
+func (e GetTournamentPairingsError) IsValid() bool {
+	switch e {
+	case GetTournamentPairingsErrorNone, GetTournamentPairingsErrorTournamentNameTooShort, GetTournamentPairingsErrorTournamentNameTooLong, GetTournamentPairingsErrorDefinitionNotFound, GetTournamentPairingsErrorTournamentNotActive:
+		return true
+	}
+	return false
+}
+
+func (e GetTournamentPairingsError) String() string {
+	return string(e)
+}
+
+func (e *GetTournamentPairingsError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTournamentPairingsError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTournamentPairingsError", str)
+	}
+	return nil
+}
+
+func (e GetTournamentPairingsError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTournamentPairingsError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTournamentPairingsError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting the reward tiers of a tournament.
+type GetTournamentRewardTiersError string
+
+const (
+	GetTournamentRewardTiersErrorNone                   GetTournamentRewardTiersError = "NONE"
+	GetTournamentRewardTiersErrorTournamentNameTooShort GetTournamentRewardTiersError = "TOURNAMENT_NAME_TOO_SHORT"
+	GetTournamentRewardTiersErrorTournamentNameTooLong  GetTournamentRewardTiersError = "TOURNAMENT_NAME_TOO_LONG"
+)
+
+var AllGetTournamentRewardTiersError = []GetTournamentRewardTiersError{
+	GetTournamentRewardTiersErrorNone,
+	GetTournamentRewardTiersErrorTournamentNameTooShort,
+	GetTournamentRewardTiersErrorTournamentNameTooLong,
+}
+
+func (e GetTournamentRewardTiersError) IsValid() bool {
+	switch e {
+	case GetTournamentRewardTiersErrorNone, GetTournamentRewardTiersErrorTournamentNameTooShort, GetTournamentRewardTiersErrorTournamentNameTooLong:
+		return true
+	}
+	return false
+}
+
+func (e GetTournamentRewardTiersError) String() string {
+	return string(e)
+}
+
+func (e *GetTournamentRewardTiersError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTournamentRewardTiersError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTournamentRewardTiersError", str)
+	}
+	return nil
+}
+
+func (e GetTournamentRewardTiersError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTournamentRewardTiersError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTournamentRewardTiersError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting the tournament rewards of a user.
+type GetTournamentRewardsError string
+
+const (
+	GetTournamentRewardsErrorNone           GetTournamentRewardsError = "NONE"
+	GetTournamentRewardsErrorUserIDRequired GetTournamentRewardsError = "USER_ID_REQUIRED"
+)
+
+var AllGetTournamentRewardsError = []GetTournamentRewardsError{
+	GetTournamentRewardsErrorNone,
+	GetTournamentRewardsErrorUserIDRequired,
+}
+
+func (e GetTournamentRewardsError) IsValid() bool {
+	switch e {
+	case GetTournamentRewardsErrorNone, GetTournamentRewardsErrorUserIDRequired:
+		return true
+	}
+	return false
+}
+
+func (e GetTournamentRewardsError) String() string {
+	return string(e)
+}
+
+func (e *GetTournamentRewardsError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTournamentRewardsError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTournamentRewardsError", str)
+	}
+	return nil
+}
+
+func (e GetTournamentRewardsError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTournamentRewardsError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTournamentRewardsError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting the standings of the current tournament.
+type GetTournamentStandingsError string
+
+const (
+	GetTournamentStandingsErrorNone                   GetTournamentStandingsError = "NONE"
+	GetTournamentStandingsErrorTournamentNameTooShort GetTournamentStandingsError = "TOURNAMENT_NAME_TOO_SHORT"
+	GetTournamentStandingsErrorTournamentNameTooLong  GetTournamentStandingsError = "TOURNAMENT_NAME_TOO_LONG"
+	GetTournamentStandingsErrorDefinitionNotFound     GetTournamentStandingsError = "DEFINITION_NOT_FOUND"
+	GetTournamentStandingsErrorTournamentNotActive    GetTournamentStandingsError = "TOURNAMENT_NOT_ACTIVE"
+)
+
+var AllGetTournamentStandingsError = []GetTournamentStandingsError{
+	GetTournamentStandingsErrorNone,
+	GetTournamentStandingsErrorTournamentNameTooShort,
+	GetTournamentStandingsErrorTournamentNameTooLong,
+	GetTournamentStandingsErrorDefinitionNotFound,
+	GetTournamentStandingsErrorTournamentNotActive,
+}
+
+func (e GetTournamentStandingsError) IsValid() bool {
+	switch e {
+	case GetTournamentStandingsErrorNone, GetTournamentStandingsErrorTournamentNameTooShort, GetTournamentStandingsErrorTournamentNameTooLong, GetTournamentStandingsErrorDefinitionNotFound, GetTournamentStandingsErrorTournamentNotActive:
+		return true
+	}
+	return false
+}
+
+func (e GetTournamentStandingsError) String() string {
+	return string(e)
+}
+
+func (e *GetTournamentStandingsError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTournamentStandingsError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTournamentStandingsError", str)
+	}
+	return nil
+}
+
+func (e GetTournamentStandingsError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTournamentStandingsError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTournamentStandingsError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a tournament team.
+type GetTournamentTeamError string
+
+const (
+	GetTournamentTeamErrorNone                                 GetTournamentTeamError = "NONE"
+	GetTournamentTeamErrorIDOrTournamentIntervalTeamIDRequired GetTournamentTeamError = "ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED"
+	GetTournamentTeamErrorTournamentNameTooShort               GetTournamentTeamError = "TOURNAMENT_NAME_TOO_SHORT"
+	GetTournamentTeamErrorTournamentNameTooLong                GetTournamentTeamError = "TOURNAMENT_NAME_TOO_LONG"
+	GetTournamentTeamErrorTeamIDRequired                       GetTournamentTeamError = "TEAM_ID_REQUIRED"
+	GetTournamentTeamErrorNotFound                             GetTournamentTeamError = "NOT_FOUND"
+)
+
+var AllGetTournamentTeamError = []GetTournamentTeamError{
+	GetTournamentTeamErrorNone,
+	GetTournamentTeamErrorIDOrTournamentIntervalTeamIDRequired,
+	GetTournamentTeamErrorTournamentNameTooShort,
+	GetTournamentTeamErrorTournamentNameTooLong,
+	GetTournamentTeamErrorTeamIDRequired,
+	GetTournamentTeamErrorNotFound,
+}
+
+func (e GetTournamentTeamError) IsValid() bool {
+	switch e {
+	case GetTournamentTeamErrorNone, GetTournamentTeamErrorIDOrTournamentIntervalTeamIDRequired, GetTournamentTeamErrorTournamentNameTooShort, GetTournamentTeamErrorTournamentNameTooLong, GetTournamentTeamErrorTeamIDRequired, GetTournamentTeamErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetTournamentTeamError) String() string {
+	return string(e)
+}
+
+func (e *GetTournamentTeamError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTournamentTeamError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTournamentTeamError", str)
+	}
+	return nil
+}
+
+func (e GetTournamentTeamError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTournamentTeamError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTournamentTeamError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a list of tournament teams.
+type GetTournamentTeamsError string
+
+const (
+	GetTournamentTeamsErrorNone                   GetTournamentTeamsError = "NONE"
+	GetTournamentTeamsErrorTournamentNameTooShort GetTournamentTeamsError = "TOURNAMENT_NAME_TOO_SHORT"
+	GetTournamentTeamsErrorTournamentNameTooLong  GetTournamentTeamsError = "TOURNAMENT_NAME_TOO_LONG"
+	GetTournamentTeamsErrorInvalidCursor          GetTournamentTeamsError = "INVALID_CURSOR"
+	GetTournamentTeamsErrorTournamentNameRequired GetTournamentTeamsError = "TOURNAMENT_NAME_REQUIRED"
+)
+
+var AllGetTournamentTeamsError = []GetTournamentTeamsError{
+	GetTournamentTeamsErrorNone,
+	GetTournamentTeamsErrorTournamentNameTooShort,
+	GetTournamentTeamsErrorTournamentNameTooLong,
+	GetTournamentTeamsErrorInvalidCursor,
+	GetTournamentTeamsErrorTournamentNameRequired,
+}
+
+func (e GetTournamentTeamsError) IsValid() bool {
+	switch e {
+	case GetTournamentTeamsErrorNone, GetTournamentTeamsErrorTournamentNameTooShort, GetTournamentTeamsErrorTournamentNameTooLong, GetTournamentTeamsErrorInvalidCursor, GetTournamentTeamsErrorTournamentNameRequired:
+		return true
+	}
+	return false
+}
+
+func (e GetTournamentTeamsError) String() string {
+	return string(e)
+}
+
+func (e *GetTournamentTeamsError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTournamentTeamsError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTournamentTeamsError", str)
+	}
+	return nil
+}
+
+func (e GetTournamentTeamsError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTournamentTeamsError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTournamentTeamsError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a tournament user.
+type GetTournamentUserError string
+
+const (
+	GetTournamentUserErrorNone                                 GetTournamentUserError = "NONE"
+	GetTournamentUserErrorIDOrTournamentIntervalUserIDRequired GetTournamentUserError = "ID_OR_TOURNAMENT_INTERVAL_USER_ID_REQUIRED"
+	GetTournamentUserErrorTournamentNameTooShort               GetTournamentUserError = "TOURNAMENT_NAME_TOO_SHORT"
+	GetTournamentUserErrorTournamentNameTooLong                GetTournamentUserError = "TOURNAMENT_NAME_TOO_LONG"
+	GetTournamentUserErrorUserIDRequired                       GetTournamentUserError = "USER_ID_REQUIRED"
+	GetTournamentUserErrorNotFound                             GetTournamentUserError = "NOT_FOUND"
+)
+
+var AllGetTournamentUserError = []GetTournamentUserError{
+	GetTournamentUserErrorNone,
+	GetTournamentUserErrorIDOrTournamentIntervalUserIDRequired,
+	GetTournamentUserErrorTournamentNameTooShort,
+	GetTournamentUserErrorTournamentNameTooLong,
+	GetTournamentUserErrorUserIDRequired,
+	GetTournamentUserErrorNotFound,
+}
+
+func (e GetTournamentUserError) IsValid() bool {
+	switch e {
+	case GetTournamentUserErrorNone, GetTournamentUserErrorIDOrTournamentIntervalUserIDRequired, GetTournamentUserErrorTournamentNameTooShort, GetTournamentUserErrorTournamentNameTooLong, GetTournamentUserErrorUserIDRequired, GetTournamentUserErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetTournamentUserError) String() string {
+	return string(e)
+}
+
+func (e *GetTournamentUserError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTournamentUserError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTournamentUserError", str)
+	}
+	return nil
+}
+
+func (e GetTournamentUserError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTournamentUserError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTournamentUserError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting the tournament history of a user.
+type GetTournamentUserHistoryError string
+
+const (
+	GetTournamentUserHistoryErrorNone                   GetTournamentUserHistoryError = "NONE"
+	GetTournamentUserHistoryErrorUserIDRequired         GetTournamentUserHistoryError = "USER_ID_REQUIRED"
+	GetTournamentUserHistoryErrorTournamentNameTooShort GetTournamentUserHistoryError = "TOURNAMENT_NAME_TOO_SHORT"
+	GetTournamentUserHistoryErrorTournamentNameTooLong  GetTournamentUserHistoryError = "TOURNAMENT_NAME_TOO_LONG"
+)
+
+var AllGetTournamentUserHistoryError = []GetTournamentUserHistoryError{
+	GetTournamentUserHistoryErrorNone,
+	GetTournamentUserHistoryErrorUserIDRequired,
+	GetTournamentUserHistoryErrorTournamentNameTooShort,
+	GetTournamentUserHistoryErrorTournamentNameTooLong,
+}
+
+func (e GetTournamentUserHistoryError) IsValid() bool {
+	switch e {
+	case GetTournamentUserHistoryErrorNone, GetTournamentUserHistoryErrorUserIDRequired, GetTournamentUserHistoryErrorTournamentNameTooShort, GetTournamentUserHistoryErrorTournamentNameTooLong:
+		return true
+	}
+	return false
+}
+
+func (e GetTournamentUserHistoryError) String() string {
+	return string(e)
+}
+
+func (e *GetTournamentUserHistoryError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTournamentUserHistoryError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTournamentUserHistoryError", str)
+	}
+	return nil
+}
+
+func (e GetTournamentUserHistoryError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTournamentUserHistoryError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTournamentUserHistoryError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting a list of tournament users.
+type GetTournamentUsersError string
+
+const (
+	GetTournamentUsersErrorNone                   GetTournamentUsersError = "NONE"
+	GetTournamentUsersErrorTournamentNameTooShort GetTournamentUsersError = "TOURNAMENT_NAME_TOO_SHORT"
+	GetTournamentUsersErrorTournamentNameTooLong  GetTournamentUsersError = "TOURNAMENT_NAME_TOO_LONG"
+	GetTournamentUsersErrorInvalidCursor          GetTournamentUsersError = "INVALID_CURSOR"
+	GetTournamentUsersErrorTournamentNameRequired GetTournamentUsersError = "TOURNAMENT_NAME_REQUIRED"
+)
+
+var AllGetTournamentUsersError = []GetTournamentUsersError{
+	GetTournamentUsersErrorNone,
+	GetTournamentUsersErrorTournamentNameTooShort,
+	GetTournamentUsersErrorTournamentNameTooLong,
+	GetTournamentUsersErrorInvalidCursor,
+	GetTournamentUsersErrorTournamentNameRequired,
+}
+
+func (e GetTournamentUsersError) IsValid() bool {
+	switch e {
+	case GetTournamentUsersErrorNone, GetTournamentUsersErrorTournamentNameTooShort, GetTournamentUsersErrorTournamentNameTooLong, GetTournamentUsersErrorInvalidCursor, GetTournamentUsersErrorTournamentNameRequired:
+		return true
+	}
+	return false
+}
+
+func (e GetTournamentUsersError) String() string {
+	return string(e)
+}
+
+func (e *GetTournamentUsersError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTournamentUsersError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTournamentUsersError", str)
+	}
+	return nil
+}
+
+func (e GetTournamentUsersError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTournamentUsersError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTournamentUsersError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when getting the wipe times of a tournament.
+type GetTournamentWipeTimeError string
+
+const (
+	GetTournamentWipeTimeErrorNone                   GetTournamentWipeTimeError = "NONE"
+	GetTournamentWipeTimeErrorTournamentNameTooShort GetTournamentWipeTimeError = "TOURNAMENT_NAME_TOO_SHORT"
+	GetTournamentWipeTimeErrorTournamentNameTooLong  GetTournamentWipeTimeError = "TOURNAMENT_NAME_TOO_LONG"
+	GetTournamentWipeTimeErrorNotFound               GetTournamentWipeTimeError = "NOT_FOUND"
+)
+
+var AllGetTournamentWipeTimeError = []GetTournamentWipeTimeError{
+	GetTournamentWipeTimeErrorNone,
+	GetTournamentWipeTimeErrorTournamentNameTooShort,
+	GetTournamentWipeTimeErrorTournamentNameTooLong,
+	GetTournamentWipeTimeErrorNotFound,
+}
+
+func (e GetTournamentWipeTimeError) IsValid() bool {
+	switch e {
+	case GetTournamentWipeTimeErrorNone, GetTournamentWipeTimeErrorTournamentNameTooShort, GetTournamentWipeTimeErrorTournamentNameTooLong, GetTournamentWipeTimeErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e GetTournamentWipeTimeError) String() string {
+	return string(e)
+}
+
+func (e *GetTournamentWipeTimeError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GetTournamentWipeTimeError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GetTournamentWipeTimeError", str)
+	}
+	return nil
+}
+
+func (e GetTournamentWipeTimeError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GetTournamentWipeTimeError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GetTournamentWipeTimeError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors related to items.
+type ItemError string
+
+const (
+	ItemErrorNone         ItemError = "NONE"
+	ItemErrorIDRequired   ItemError = "ID_REQUIRED"
+	ItemErrorTypeRequired ItemError = "TYPE_REQUIRED"
+	ItemErrorNotFound     ItemError = "NOT_FOUND"
+)
+
+var AllItemError = []ItemError{
+	ItemErrorNone,
+	ItemErrorIDRequired,
+	ItemErrorTypeRequired,
+	ItemErrorNotFound,
+}
+
+func (e ItemError) IsValid() bool {
 	switch e {
-	case GetEventErrorNone, GetEventErrorNameTooShort, GetEventErrorNameTooLong, GetEventErrorIDOrNameRequired, GetEventErrorNotFound:
+	case ItemErrorNone, ItemErrorIDRequired, ItemErrorTypeRequired, ItemErrorNotFound:
 		return true
 	}
 	return false
 }
 
-func (e GetEventError) String() string {
+func (e ItemError) String() string {
 	return string(e)
 }
 
-func (e *GetEventError) UnmarshalGQL(v any) error {
+func (e *ItemError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetEventError(str)
+	*e = ItemError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetEventError", str)
+		return fmt.Errorf("%s is not a valid ItemError", str)
 	}
 	return nil
 }
 
-func (e GetEventError) MarshalGQL(w io.Writer) {
+func (e ItemError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetEventError) UnmarshalJSON(b []byte) error {
+func (e *ItemError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1405,63 +4127,69 @@ func (e *GetEventError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetEventError) MarshalJSON() ([]byte, error) {
+func (e ItemError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting an event round.
-type GetEventRoundError string
+// Possible errors when joining a team.
+type JoinTeamError string
 
 const (
-	GetEventRoundErrorNone                   GetEventRoundError = "NONE"
-	GetEventRoundErrorNameTooShort           GetEventRoundError = "NAME_TOO_SHORT"
-	GetEventRoundErrorNameTooLong            GetEventRoundError = "NAME_TOO_LONG"
-	GetEventRoundErrorIDOrNameRequired       GetEventRoundError = "ID_OR_NAME_REQUIRED"
-	GetEventRoundErrorEventRoundOrIDRequired GetEventRoundError = "EVENT_ROUND_OR_ID_REQUIRED"
-	GetEventRoundErrorNotFound               GetEventRoundError = "NOT_FOUND"
+	JoinTeamErrorNone             JoinTeamError = "NONE"
+	JoinTeamErrorNoFieldSpecified JoinTeamError = "NO_FIELD_SPECIFIED"
+	JoinTeamErrorNameTooShort     JoinTeamError = "NAME_TOO_SHORT"
+	JoinTeamErrorNameTooLong      JoinTeamError = "NAME_TOO_LONG"
+	JoinTeamErrorUserIDRequired   JoinTeamError = "USER_ID_REQUIRED"
+	JoinTeamErrorDataRequired     JoinTeamError = "DATA_REQUIRED"
+	JoinTeamErrorNotFound         JoinTeamError = "NOT_FOUND"
+	JoinTeamErrorTeamFull         JoinTeamError = "TEAM_FULL"
+	JoinTeamErrorAlreadyInATeam   JoinTeamError = "ALREADY_IN_A_TEAM"
 )
 
-var AllGetEventRoundError = []GetEventRoundError{
-	GetEventRoundErrorNone,
-	GetEventRoundErrorNameTooShort,
-	GetEventRoundErrorNameTooLong,
-	GetEventRoundErrorIDOrNameRequired,
-	GetEventRoundErrorEventRoundOrIDRequired,
-	GetEventRoundErrorNotFound,
+var AllJoinTeamError = []JoinTeamError{
+	JoinTeamErrorNone,
+	JoinTeamErrorNoFieldSpecified,
+	JoinTeamErrorNameTooShort,
+	JoinTeamErrorNameTooLong,
+	JoinTeamErrorUserIDRequired,
+	JoinTeamErrorDataRequired,
+	JoinTeamErrorNotFound,
+	JoinTeamErrorTeamFull,
+	JoinTeamErrorAlreadyInATeam,
 }
 
-func (e GetEventRoundError) IsValid() bool {
+func (e JoinTeamError) IsValid() bool {
 	switch e {
-	case GetEventRoundErrorNone, GetEventRoundErrorNameTooShort, GetEventRoundErrorNameTooLong, GetEventRoundErrorIDOrNameRequired, GetEventRoundErrorEventRoundOrIDRequired, GetEventRoundErrorNotFound:
+	case JoinTeamErrorNone, JoinTeamErrorNoFieldSpecified, JoinTeamErrorNameTooShort, JoinTeamErrorNameTooLong, JoinTeamErrorUserIDRequired, JoinTeamErrorDataRequired, JoinTeamErrorNotFound, JoinTeamErrorTeamFull, JoinTeamErrorAlreadyInATeam:
 		return true
 	}
 	return false
 }
 
-func (e GetEventRoundError) String() string {
+func (e JoinTeamError) String() string {
 	return string(e)
 }
 
-func (e *GetEventRoundError) UnmarshalGQL(v any) error {
+func (e *JoinTeamError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetEventRoundError(str)
+	*e = JoinTeamError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetEventRoundError", str)
+		return fmt.Errorf("%s is not a valid JoinTeamError", str)
 	}
 	return nil
 }
 
-func (e GetEventRoundError) MarshalGQL(w io.Writer) {
+func (e JoinTeamError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetEventRoundError) UnmarshalJSON(b []byte) error {
+func (e *JoinTeamError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1469,65 +4197,241 @@ func (e *GetEventRoundError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetEventRoundError) MarshalJSON() ([]byte, error) {
+func (e JoinTeamError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting an event user.
-type GetEventUserError string
+// Possible errors when leaving a team.
+type LeaveTeamError string
 
 const (
-	GetEventUserErrorNone                  GetEventUserError = "NONE"
-	GetEventUserErrorNameTooShort          GetEventUserError = "NAME_TOO_SHORT"
-	GetEventUserErrorNameTooLong           GetEventUserError = "NAME_TOO_LONG"
-	GetEventUserErrorIDOrNameRequired      GetEventUserError = "ID_OR_NAME_REQUIRED"
-	GetEventUserErrorClientUserIDRequired  GetEventUserError = "CLIENT_USER_ID_REQUIRED"
-	GetEventUserErrorEventUserOrIDRequired GetEventUserError = "EVENT_USER_OR_ID_REQUIRED"
-	GetEventUserErrorNotFound              GetEventUserError = "NOT_FOUND"
+	LeaveTeamErrorNone             LeaveTeamError = "NONE"
+	LeaveTeamErrorNoFieldSpecified LeaveTeamError = "NO_FIELD_SPECIFIED"
+	LeaveTeamErrorNotFound         LeaveTeamError = "NOT_FOUND"
+)
+
+var AllLeaveTeamError = []LeaveTeamError{
+	LeaveTeamErrorNone,
+	LeaveTeamErrorNoFieldSpecified,
+	LeaveTeamErrorNotFound,
+}
+
+func (e LeaveTeamError) IsValid() bool {
+	switch e {
+	case LeaveTeamErrorNone, LeaveTeamErrorNoFieldSpecified, LeaveTeamErrorNotFound:
+		return true
+	}
+	return false
+}
+
+func (e LeaveTeamError) String() string {
+	return string(e)
+}
+
+func (e *LeaveTeamError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LeaveTeamError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LeaveTeamError", str)
+	}
+	return nil
+}
+
+func (e LeaveTeamError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LeaveTeamError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LeaveTeamError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible statuses for a match. Pending means it's waiting to be started. Started means it's currently being played. Ended means the match has ended.
+type MatchStatus string
+
+const (
+	MatchStatusPending MatchStatus = "PENDING"
+	MatchStatusStarted MatchStatus = "STARTED"
+	MatchStatusEnded   MatchStatus = "ENDED"
+)
+
+var AllMatchStatus = []MatchStatus{
+	MatchStatusPending,
+	MatchStatusStarted,
+	MatchStatusEnded,
+}
+
+func (e MatchStatus) IsValid() bool {
+	switch e {
+	case MatchStatusPending, MatchStatusStarted, MatchStatusEnded:
+		return true
+	}
+	return false
+}
+
+func (e MatchStatus) String() string {
+	return string(e)
+}
+
+func (e *MatchStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MatchStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MatchStatus", str)
+	}
+	return nil
+}
+
+func (e MatchStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MatchStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MatchStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible statuses for a matchmaking ticket. Pending means it's waiting to be matched. Matched means it's matched with other tickets, the match may have started or may not have. Ended means the match has ended.
+type MatchmakingTicketStatus string
+
+const (
+	MatchmakingTicketStatusPending MatchmakingTicketStatus = "PENDING"
+	MatchmakingTicketStatusMatched MatchmakingTicketStatus = "MATCHED"
+	MatchmakingTicketStatusEnded   MatchmakingTicketStatus = "ENDED"
+)
+
+var AllMatchmakingTicketStatus = []MatchmakingTicketStatus{
+	MatchmakingTicketStatusPending,
+	MatchmakingTicketStatusMatched,
+	MatchmakingTicketStatusEnded,
+}
+
+func (e MatchmakingTicketStatus) IsValid() bool {
+	switch e {
+	case MatchmakingTicketStatusPending, MatchmakingTicketStatusMatched, MatchmakingTicketStatusEnded:
+		return true
+	}
+	return false
+}
+
+func (e MatchmakingTicketStatus) String() string {
+	return string(e)
+}
+
+func (e *MatchmakingTicketStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MatchmakingTicketStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MatchmakingTicketStatus", str)
+	}
+	return nil
+}
+
+func (e MatchmakingTicketStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MatchmakingTicketStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MatchmakingTicketStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when pausing an event.
+type PauseEventError string
+
+const (
+	PauseEventErrorNone             PauseEventError = "NONE"
+	PauseEventErrorNameTooShort     PauseEventError = "NAME_TOO_SHORT"
+	PauseEventErrorNameTooLong      PauseEventError = "NAME_TOO_LONG"
+	PauseEventErrorIDOrNameRequired PauseEventError = "ID_OR_NAME_REQUIRED"
+	PauseEventErrorNotFound         PauseEventError = "NOT_FOUND"
+	PauseEventErrorAlreadyPaused    PauseEventError = "ALREADY_PAUSED"
+	PauseEventErrorEventEnded       PauseEventError = "EVENT_ENDED"
+	PauseEventErrorEventCancelled   PauseEventError = "EVENT_CANCELLED"
 )
 
-var AllGetEventUserError = []GetEventUserError{
-	GetEventUserErrorNone,
-	GetEventUserErrorNameTooShort,
-	GetEventUserErrorNameTooLong,
-	GetEventUserErrorIDOrNameRequired,
-	GetEventUserErrorClientUserIDRequired,
-	GetEventUserErrorEventUserOrIDRequired,
-	GetEventUserErrorNotFound,
+var AllPauseEventError = []PauseEventError{
+	PauseEventErrorNone,
+	PauseEventErrorNameTooShort,
+	PauseEventErrorNameTooLong,
+	PauseEventErrorIDOrNameRequired,
+	PauseEventErrorNotFound,
+	PauseEventErrorAlreadyPaused,
+	PauseEventErrorEventEnded,
+	PauseEventErrorEventCancelled,
 }
 
-func (e GetEventUserError) IsValid() bool {
+func (e PauseEventError) IsValid() bool {
 	switch e {
-	case GetEventUserErrorNone, GetEventUserErrorNameTooShort, GetEventUserErrorNameTooLong, GetEventUserErrorIDOrNameRequired, GetEventUserErrorClientUserIDRequired, GetEventUserErrorEventUserOrIDRequired, GetEventUserErrorNotFound:
+	case PauseEventErrorNone, PauseEventErrorNameTooShort, PauseEventErrorNameTooLong, PauseEventErrorIDOrNameRequired, PauseEventErrorNotFound, PauseEventErrorAlreadyPaused, PauseEventErrorEventEnded, PauseEventErrorEventCancelled:
 		return true
 	}
 	return false
 }
 
-func (e GetEventUserError) String() string {
+func (e PauseEventError) String() string {
 	return string(e)
 }
 
-func (e *GetEventUserError) UnmarshalGQL(v any) error {
+func (e *PauseEventError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetEventUserError(str)
+	*e = PauseEventError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetEventUserError", str)
+		return fmt.Errorf("%s is not a valid PauseEventError", str)
 	}
 	return nil
 }
 
-func (e GetEventUserError) MarshalGQL(w io.Writer) {
+func (e PauseEventError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetEventUserError) UnmarshalJSON(b []byte) error {
+func (e *PauseEventError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1535,59 +4439,65 @@ func (e *GetEventUserError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetEventUserError) MarshalJSON() ([]byte, error) {
+func (e PauseEventError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting an item.
-type GetItemError string
+// Possible errors when posting a team message.
+type PostTeamMessageError string
 
 const (
-	GetItemErrorNone         GetItemError = "NONE"
-	GetItemErrorIDRequired   GetItemError = "ID_REQUIRED"
-	GetItemErrorTypeRequired GetItemError = "TYPE_REQUIRED"
-	GetItemErrorNotFound     GetItemError = "NOT_FOUND"
+	PostTeamMessageErrorNone             PostTeamMessageError = "NONE"
+	PostTeamMessageErrorNoFieldSpecified PostTeamMessageError = "NO_FIELD_SPECIFIED"
+	PostTeamMessageErrorContentRequired  PostTeamMessageError = "CONTENT_REQUIRED"
+	PostTeamMessageErrorContentTooLong   PostTeamMessageError = "CONTENT_TOO_LONG"
+	PostTeamMessageErrorDataRequired     PostTeamMessageError = "DATA_REQUIRED"
+	PostTeamMessageErrorNotInATeam       PostTeamMessageError = "NOT_IN_A_TEAM"
+	PostTeamMessageErrorNotAllowed       PostTeamMessageError = "NOT_ALLOWED"
 )
 
-var AllGetItemError = []GetItemError{
-	GetItemErrorNone,
-	GetItemErrorIDRequired,
-	GetItemErrorTypeRequired,
-	GetItemErrorNotFound,
+var AllPostTeamMessageError = []PostTeamMessageError{
+	PostTeamMessageErrorNone,
+	PostTeamMessageErrorNoFieldSpecified,
+	PostTeamMessageErrorContentRequired,
+	PostTeamMessageErrorContentTooLong,
+	PostTeamMessageErrorDataRequired,
+	PostTeamMessageErrorNotInATeam,
+	PostTeamMessageErrorNotAllowed,
 }
 
-func (e GetItemError) IsValid() bool {
+func (e PostTeamMessageError) IsValid() bool {
 	switch e {
-	case GetItemErrorNone, GetItemErrorIDRequired, GetItemErrorTypeRequired, GetItemErrorNotFound:
+	case PostTeamMessageErrorNone, PostTeamMessageErrorNoFieldSpecified, PostTeamMessageErrorContentRequired, PostTeamMessageErrorContentTooLong, PostTeamMessageErrorDataRequired, PostTeamMessageErrorNotInATeam, PostTeamMessageErrorNotAllowed:
 		return true
 	}
 	return false
 }
 
-func (e GetItemError) String() string {
+func (e PostTeamMessageError) String() string {
 	return string(e)
 }
 
-func (e *GetItemError) UnmarshalGQL(v any) error {
+func (e *PostTeamMessageError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetItemError(str)
+	*e = PostTeamMessageError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetItemError", str)
+		return fmt.Errorf("%s is not a valid PostTeamMessageError", str)
 	}
 	return nil
 }
 
-func (e GetItemError) MarshalGQL(w io.Writer) {
+func (e PostTeamMessageError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetItemError) UnmarshalJSON(b []byte) error {
+func (e *PostTeamMessageError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1595,61 +4505,59 @@ func (e *GetItemError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetItemError) MarshalJSON() ([]byte, error) {
+func (e PostTeamMessageError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting a match.
-type GetMatchError string
+// Possible errors when recording the result of a tournament pairing. Byes are won when they are created, so they already have a result.
+type RecordTournamentPairingResultError string
 
 const (
-	GetMatchErrorNone                                         GetMatchError = "NONE"
-	GetMatchErrorMatchIDOrMatchmakingTicketRequired           GetMatchError = "MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED"
-	GetMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired GetMatchError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
-	GetMatchErrorMatchmakingUserIDOrClientUserIDRequired      GetMatchError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
-	GetMatchErrorNotFound                                     GetMatchError = "NOT_FOUND"
+	RecordTournamentPairingResultErrorNone            RecordTournamentPairingResultError = "NONE"
+	RecordTournamentPairingResultErrorIDRequired      RecordTournamentPairingResultError = "ID_REQUIRED"
+	RecordTournamentPairingResultErrorNotFound        RecordTournamentPairingResultError = "NOT_FOUND"
+	RecordTournamentPairingResultErrorAlreadyRecorded RecordTournamentPairingResultError = "ALREADY_RECORDED"
 )
 
-var AllGetMatchError = []GetMatchError{
-	GetMatchErrorNone,
-	GetMatchErrorMatchIDOrMatchmakingTicketRequired,
-	GetMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired,
-	GetMatchErrorMatchmakingUserIDOrClientUserIDRequired,
-	GetMatchErrorNotFound,
+var AllRecordTournamentPairingResultError = []RecordTournamentPairingResultError{
+	RecordTournamentPairingResultErrorNone,
+	RecordTournamentPairingResultErrorIDRequired,
+	RecordTournamentPairingResultErrorNotFound,
+	RecordTournamentPairingResultErrorAlreadyRecorded,
 }
 
-func (e GetMatchError) IsValid() bool {
+func (e RecordTournamentPairingResultError) IsValid() bool {
 	switch e {
-	case GetMatchErrorNone, GetMatchErrorMatchIDOrMatchmakingTicketRequired, GetMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired, GetMatchErrorMatchmakingUserIDOrClientUserIDRequired, GetMatchErrorNotFound:
+	case RecordTournamentPairingResultErrorNone, RecordTournamentPairingResultErrorIDRequired, RecordTournamentPairingResultErrorNotFound, RecordTournamentPairingResultErrorAlreadyRecorded:
 		return true
 	}
 	return false
 }
 
-func (e GetMatchError) String() string {
+func (e RecordTournamentPairingResultError) String() string {
 	return string(e)
 }
 
-func (e *GetMatchError) UnmarshalGQL(v any) error {
+func (e *RecordTournamentPairingResultError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetMatchError(str)
+	*e = RecordTournamentPairingResultError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetMatchError", str)
+		return fmt.Errorf("%s is not a valid RecordTournamentPairingResultError", str)
 	}
 	return nil
 }
 
-func (e GetMatchError) MarshalGQL(w io.Writer) {
+func (e RecordTournamentPairingResultError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetMatchError) UnmarshalJSON(b []byte) error {
+func (e *RecordTournamentPairingResultError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1657,59 +4565,77 @@ func (e *GetMatchError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetMatchError) MarshalJSON() ([]byte, error) {
+func (e RecordTournamentPairingResultError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting a matchmaking ticket.
-type GetMatchmakingTicketError string
+// Possible errors when registering for an event.
+type RegisterForEventError string
 
 const (
-	GetMatchmakingTicketErrorNone                                         GetMatchmakingTicketError = "NONE"
-	GetMatchmakingTicketErrorMatchmakingTicketIDOrMatchmakingUserRequired GetMatchmakingTicketError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
-	GetMatchmakingTicketErrorMatchmakingUserIDOrClientUserIDRequired      GetMatchmakingTicketError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
-	GetMatchmakingTicketErrorNotFound                                     GetMatchmakingTicketError = "NOT_FOUND"
+	RegisterForEventErrorNone                 RegisterForEventError = "NONE"
+	RegisterForEventErrorNameTooShort         RegisterForEventError = "NAME_TOO_SHORT"
+	RegisterForEventErrorNameTooLong          RegisterForEventError = "NAME_TOO_LONG"
+	RegisterForEventErrorIDOrNameRequired     RegisterForEventError = "ID_OR_NAME_REQUIRED"
+	RegisterForEventErrorClientUserIDRequired RegisterForEventError = "CLIENT_USER_ID_REQUIRED"
+	RegisterForEventErrorDataRequired         RegisterForEventError = "DATA_REQUIRED"
+	RegisterForEventErrorNotFound             RegisterForEventError = "NOT_FOUND"
+	RegisterForEventErrorAlreadyRegistered    RegisterForEventError = "ALREADY_REGISTERED"
+	RegisterForEventErrorRegistrationClosed   RegisterForEventError = "REGISTRATION_CLOSED"
+	RegisterForEventErrorEventFull            RegisterForEventError = "EVENT_FULL"
+	RegisterForEventErrorNotEligible          RegisterForEventError = "NOT_ELIGIBLE"
+	RegisterForEventErrorEventEnded           RegisterForEventError = "EVENT_ENDED"
+	RegisterForEventErrorEventCancelled       RegisterForEventError = "EVENT_CANCELLED"
 )
 
-var AllGetMatchmakingTicketError = []GetMatchmakingTicketError{
-	GetMatchmakingTicketErrorNone,
-	GetMatchmakingTicketErrorMatchmakingTicketIDOrMatchmakingUserRequired,
-	GetMatchmakingTicketErrorMatchmakingUserIDOrClientUserIDRequired,
-	GetMatchmakingTicketErrorNotFound,
-}
-
-func (e GetMatchmakingTicketError) IsValid() bool {
+var AllRegisterForEventError = []RegisterForEventError{
+	RegisterForEventErrorNone,
+	RegisterForEventErrorNameTooShort,
+	RegisterForEventErrorNameTooLong,
+	RegisterForEventErrorIDOrNameRequired,
+	RegisterForEventErrorClientUserIDRequired,
+	RegisterForEventErrorDataRequired,
+	RegisterForEventErrorNotFound,
+	RegisterForEventErrorAlreadyRegistered,
+	RegisterForEventErrorRegistrationClosed,
+	RegisterForEventErrorEventFull,
+	RegisterForEventErrorNotEligible,
+	RegisterForEventErrorEventEnded,
+	RegisterForEventErrorEventCancelled,
+}
+
+func (e RegisterForEventError) IsValid() bool {
 	switch e {
-	case GetMatchmakingTicketErrorNone, GetMatchmakingTicketErrorMatchmakingTicketIDOrMatchmakingUserRequired, GetMatchmakingTicketErrorMatchmakingUserIDOrClientUserIDRequired, GetMatchmakingTicketErrorNotFound:
+	case RegisterForEventErrorNone, RegisterForEventErrorNameTooShort, RegisterForEventErrorNameTooLong, RegisterForEventErrorIDOrNameRequired, RegisterForEventErrorClientUserIDRequired, RegisterForEventErrorDataRequired, RegisterForEventErrorNotFound, RegisterForEventErrorAlreadyRegistered, RegisterForEventErrorRegistrationClosed, RegisterForEventErrorEventFull, RegisterForEventErrorNotEligible, RegisterForEventErrorEventEnded, RegisterForEventErrorEventCancelled:
 		return true
 	}
 	return false
 }
 
-func (e GetMatchmakingTicketError) String() string {
+func (e RegisterForEventError) String() string {
 	return string(e)
 }
 
-func (e *GetMatchmakingTicketError) UnmarshalGQL(v any) error {
+func (e *RegisterForEventError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetMatchmakingTicketError(str)
+	*e = RegisterForEventError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetMatchmakingTicketError", str)
+		return fmt.Errorf("%s is not a valid RegisterForEventError", str)
 	}
 	return nil
 }
 
-func (e GetMatchmakingTicketError) MarshalGQL(w io.Writer) {
+func (e RegisterForEventError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetMatchmakingTicketError) UnmarshalJSON(b []byte) error {
+func (e *RegisterForEventError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1717,57 +4643,73 @@ func (e *GetMatchmakingTicketError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetMatchmakingTicketError) MarshalJSON() ([]byte, error) {
+func (e RegisterForEventError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting a list of matchmaking tickets.
-type GetMatchmakingTicketsError string
+// Possible errors when reinstating an event user.
+type ReinstateEventUserError string
 
 const (
-	GetMatchmakingTicketsErrorNone          GetMatchmakingTicketsError = "NONE"
-	GetMatchmakingTicketsErrorMatchNotFound GetMatchmakingTicketsError = "MATCH_NOT_FOUND"
-	GetMatchmakingTicketsErrorUserNotFound  GetMatchmakingTicketsError = "USER_NOT_FOUND"
+	ReinstateEventUserErrorNone                  ReinstateEventUserError = "NONE"
+	ReinstateEventUserErrorNameTooShort          ReinstateEventUserError = "NAME_TOO_SHORT"
+	ReinstateEventUserErrorNameTooLong           ReinstateEventUserError = "NAME_TOO_LONG"
+	ReinstateEventUserErrorIDOrNameRequired      ReinstateEventUserError = "ID_OR_NAME_REQUIRED"
+	ReinstateEventUserErrorClientUserIDRequired  ReinstateEventUserError = "CLIENT_USER_ID_REQUIRED"
+	ReinstateEventUserErrorEventUserOrIDRequired ReinstateEventUserError = "EVENT_USER_OR_ID_REQUIRED"
+	ReinstateEventUserErrorReasonRequired        ReinstateEventUserError = "REASON_REQUIRED"
+	ReinstateEventUserErrorModeratorRequired     ReinstateEventUserError = "MODERATOR_REQUIRED"
+	ReinstateEventUserErrorModeratorTooLong      ReinstateEventUserError = "MODERATOR_TOO_LONG"
+	ReinstateEventUserErrorNotFound              ReinstateEventUserError = "NOT_FOUND"
+	ReinstateEventUserErrorNotDisqualified       ReinstateEventUserError = "NOT_DISQUALIFIED"
 )
 
-var AllGetMatchmakingTicketsError = []GetMatchmakingTicketsError{
-	GetMatchmakingTicketsErrorNone,
-	GetMatchmakingTicketsErrorMatchNotFound,
-	GetMatchmakingTicketsErrorUserNotFound,
-}
-
-func (e GetMatchmakingTicketsError) IsValid() bool {
+var AllReinstateEventUserError = []ReinstateEventUserError{
+	ReinstateEventUserErrorNone,
+	ReinstateEventUserErrorNameTooShort,
+	ReinstateEventUserErrorNameTooLong,
+	ReinstateEventUserErrorIDOrNameRequired,
+	ReinstateEventUserErrorClientUserIDRequired,
+	ReinstateEventUserErrorEventUserOrIDRequired,
+	ReinstateEventUserErrorReasonRequired,
+	ReinstateEventUserErrorModeratorRequired,
+	ReinstateEventUserErrorModeratorTooLong,
+	ReinstateEventUserErrorNotFound,
+	ReinstateEventUserErrorNotDisqualified,
+}
+
+func (e ReinstateEventUserError) IsValid() bool {
 	switch e {
-	case GetMatchmakingTicketsErrorNone, GetMatchmakingTicketsErrorMatchNotFound, GetMatchmakingTicketsErrorUserNotFound:
+	case ReinstateEventUserErrorNone, ReinstateEventUserErrorNameTooShort, ReinstateEventUserErrorNameTooLong, ReinstateEventUserErrorIDOrNameRequired, ReinstateEventUserErrorClientUserIDRequired, ReinstateEventUserErrorEventUserOrIDRequired, ReinstateEventUserErrorReasonRequired, ReinstateEventUserErrorModeratorRequired, ReinstateEventUserErrorModeratorTooLong, ReinstateEventUserErrorNotFound, ReinstateEventUserErrorNotDisqualified:
 		return true
 	}
 	return false
 }
 
-func (e GetMatchmakingTicketsError) String() string {
+func (e ReinstateEventUserError) String() string {
 	return string(e)
 }
 
-func (e *GetMatchmakingTicketsError) UnmarshalGQL(v any) error {
+func (e *ReinstateEventUserError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetMatchmakingTicketsError(str)
+	*e = ReinstateEventUserError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetMatchmakingTicketsError", str)
+		return fmt.Errorf("%s is not a valid ReinstateEventUserError", str)
 	}
 	return nil
 }
 
-func (e GetMatchmakingTicketsError) MarshalGQL(w io.Writer) {
+func (e ReinstateEventUserError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetMatchmakingTicketsError) UnmarshalJSON(b []byte) error {
+func (e *ReinstateEventUserError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1775,57 +4717,59 @@ func (e *GetMatchmakingTicketsError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetMatchmakingTicketsError) MarshalJSON() ([]byte, error) {
+func (e ReinstateEventUserError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting a matchmaking user.
-type GetMatchmakingUserError string
+// Possible errors when removing an event result.
+type RemoveEventResultError string
 
 const (
-	GetMatchmakingUserErrorNone                                    GetMatchmakingUserError = "NONE"
-	GetMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired GetMatchmakingUserError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
-	GetMatchmakingUserErrorNotFound                                GetMatchmakingUserError = "NOT_FOUND"
+	RemoveEventResultErrorNone       RemoveEventResultError = "NONE"
+	RemoveEventResultErrorIDRequired RemoveEventResultError = "ID_REQUIRED"
+	RemoveEventResultErrorNotFound   RemoveEventResultError = "NOT_FOUND"
+	RemoveEventResultErrorEventEnded RemoveEventResultError = "EVENT_ENDED"
 )
 
-var AllGetMatchmakingUserError = []GetMatchmakingUserError{
-	GetMatchmakingUserErrorNone,
-	GetMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired,
-	GetMatchmakingUserErrorNotFound,
+var AllRemoveEventResultError = []RemoveEventResultError{
+	RemoveEventResultErrorNone,
+	RemoveEventResultErrorIDRequired,
+	RemoveEventResultErrorNotFound,
+	RemoveEventResultErrorEventEnded,
 }
 
-func (e GetMatchmakingUserError) IsValid() bool {
+func (e RemoveEventResultError) IsValid() bool {
 	switch e {
-	case GetMatchmakingUserErrorNone, GetMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired, GetMatchmakingUserErrorNotFound:
+	case RemoveEventResultErrorNone, RemoveEventResultErrorIDRequired, RemoveEventResultErrorNotFound, RemoveEventResultErrorEventEnded:
 		return true
 	}
 	return false
 }
 
-func (e GetMatchmakingUserError) String() string {
+func (e RemoveEventResultError) String() string {
 	return string(e)
 }
 
-func (e *GetMatchmakingUserError) UnmarshalGQL(v any) error {
+func (e *RemoveEventResultError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetMatchmakingUserError(str)
+	*e = RemoveEventResultError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetMatchmakingUserError", str)
+		return fmt.Errorf("%s is not a valid RemoveEventResultError", str)
 	}
 	return nil
 }
 
-func (e GetMatchmakingUserError) MarshalGQL(w io.Writer) {
+func (e RemoveEventResultError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetMatchmakingUserError) UnmarshalJSON(b []byte) error {
+func (e *RemoveEventResultError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1833,63 +4777,65 @@ func (e *GetMatchmakingUserError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetMatchmakingUserError) MarshalJSON() ([]byte, error) {
+func (e RemoveEventResultError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting a record.
-type GetRecordError string
+// Possible errors when reporting the winner of a tournament bracket match.
+type ReportTournamentBracketMatchResultError string
 
 const (
-	GetRecordErrorNone                   GetRecordError = "NONE"
-	GetRecordErrorIDOrNameUserIDRequired GetRecordError = "ID_OR_NAME_USER_ID_REQUIRED"
-	GetRecordErrorNotFound               GetRecordError = "NOT_FOUND"
-	GetRecordErrorNameTooShort           GetRecordError = "NAME_TOO_SHORT"
-	GetRecordErrorNameTooLong            GetRecordError = "NAME_TOO_LONG"
-	GetRecordErrorUserIDRequired         GetRecordError = "USER_ID_REQUIRED"
+	ReportTournamentBracketMatchResultErrorNone                 ReportTournamentBracketMatchResultError = "NONE"
+	ReportTournamentBracketMatchResultErrorMatchIDRequired      ReportTournamentBracketMatchResultError = "MATCH_ID_REQUIRED"
+	ReportTournamentBracketMatchResultErrorWinnerUserIDRequired ReportTournamentBracketMatchResultError = "WINNER_USER_ID_REQUIRED"
+	ReportTournamentBracketMatchResultErrorNotFound             ReportTournamentBracketMatchResultError = "NOT_FOUND"
+	ReportTournamentBracketMatchResultErrorMatchNotReady        ReportTournamentBracketMatchResultError = "MATCH_NOT_READY"
+	ReportTournamentBracketMatchResultErrorAlreadyReported      ReportTournamentBracketMatchResultError = "ALREADY_REPORTED"
+	ReportTournamentBracketMatchResultErrorWinnerNotInMatch     ReportTournamentBracketMatchResultError = "WINNER_NOT_IN_MATCH"
 )
 
-var AllGetRecordError = []GetRecordError{
-	GetRecordErrorNone,
-	GetRecordErrorIDOrNameUserIDRequired,
-	GetRecordErrorNotFound,
-	GetRecordErrorNameTooShort,
-	GetRecordErrorNameTooLong,
-	GetRecordErrorUserIDRequired,
+var AllReportTournamentBracketMatchResultError = []ReportTournamentBracketMatchResultError{
+	ReportTournamentBracketMatchResultErrorNone,
+	ReportTournamentBracketMatchResultErrorMatchIDRequired,
+	ReportTournamentBracketMatchResultErrorWinnerUserIDRequired,
+	ReportTournamentBracketMatchResultErrorNotFound,
+	ReportTournamentBracketMatchResultErrorMatchNotReady,
+	ReportTournamentBracketMatchResultErrorAlreadyReported,
+	ReportTournamentBracketMatchResultErrorWinnerNotInMatch,
 }
 
-func (e GetRecordError) IsValid() bool {
+func (e ReportTournamentBracketMatchResultError) IsValid() bool {
 	switch e {
-	case GetRecordErrorNone, GetRecordErrorIDOrNameUserIDRequired, GetRecordErrorNotFound, GetRecordErrorNameTooShort, GetRecordErrorNameTooLong, GetRecordErrorUserIDRequired:
+	case ReportTournamentBracketMatchResultErrorNone, ReportTournamentBracketMatchResultErrorMatchIDRequired, ReportTournamentBracketMatchResultErrorWinnerUserIDRequired, ReportTournamentBracketMatchResultErrorNotFound, ReportTournamentBracketMatchResultErrorMatchNotReady, ReportTournamentBracketMatchResultErrorAlreadyReported, ReportTournamentBracketMatchResultErrorWinnerNotInMatch:
 		return true
 	}
 	return false
 }
 
-func (e GetRecordError) String() string {
+func (e ReportTournamentBracketMatchResultError) String() string {
 	return string(e)
 }
 
-func (e *GetRecordError) UnmarshalGQL(v any) error {
+func (e *ReportTournamentBracketMatchResultError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetRecordError(str)
+	*e = ReportTournamentBracketMatchResultError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetRecordError", str)
+		return fmt.Errorf("%s is not a valid ReportTournamentBracketMatchResultError", str)
 	}
 	return nil
 }
 
-func (e GetRecordError) MarshalGQL(w io.Writer) {
+func (e ReportTournamentBracketMatchResultError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetRecordError) UnmarshalJSON(b []byte) error {
+func (e *ReportTournamentBracketMatchResultError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1897,57 +4843,59 @@ func (e *GetRecordError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetRecordError) MarshalJSON() ([]byte, error) {
+func (e ReportTournamentBracketMatchResultError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting a list of records.
-type GetRecordsError string
+// Possible errors when resetting team scores.
+type ResetTeamScoresError string
 
 const (
-	GetRecordsErrorNone         GetRecordsError = "NONE"
-	GetRecordsErrorNameTooShort GetRecordsError = "NAME_TOO_SHORT"
-	GetRecordsErrorNameTooLong  GetRecordsError = "NAME_TOO_LONG"
+	ResetTeamScoresErrorNone                ResetTeamScoresError = "NONE"
+	ResetTeamScoresErrorSeasonLabelRequired ResetTeamScoresError = "SEASON_LABEL_REQUIRED"
+	ResetTeamScoresErrorSeasonLabelTooLong  ResetTeamScoresError = "SEASON_LABEL_TOO_LONG"
+	ResetTeamScoresErrorSeasonLabelTaken    ResetTeamScoresError = "SEASON_LABEL_TAKEN"
 )
 
-var AllGetRecordsError = []GetRecordsError{
-	GetRecordsErrorNone,
-	GetRecordsErrorNameTooShort,
-	GetRecordsErrorNameTooLong,
+var AllResetTeamScoresError = []ResetTeamScoresError{
+	ResetTeamScoresErrorNone,
+	ResetTeamScoresErrorSeasonLabelRequired,
+	ResetTeamScoresErrorSeasonLabelTooLong,
+	ResetTeamScoresErrorSeasonLabelTaken,
 }
 
-func (e GetRecordsError) IsValid() bool {
+func (e ResetTeamScoresError) IsValid() bool {
 	switch e {
-	case GetRecordsErrorNone, GetRecordsErrorNameTooShort, GetRecordsErrorNameTooLong:
+	case ResetTeamScoresErrorNone, ResetTeamScoresErrorSeasonLabelRequired, ResetTeamScoresErrorSeasonLabelTooLong, ResetTeamScoresErrorSeasonLabelTaken:
 		return true
 	}
 	return false
 }
 
-func (e GetRecordsError) String() string {
+func (e ResetTeamScoresError) String() string {
 	return string(e)
 }
 
-func (e *GetRecordsError) UnmarshalGQL(v any) error {
+func (e *ResetTeamScoresError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetRecordsError(str)
+	*e = ResetTeamScoresError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetRecordsError", str)
+		return fmt.Errorf("%s is not a valid ResetTeamScoresError", str)
 	}
 	return nil
 }
 
-func (e GetRecordsError) MarshalGQL(w io.Writer) {
+func (e ResetTeamScoresError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetRecordsError) UnmarshalJSON(b []byte) error {
+func (e *ResetTeamScoresError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1955,59 +4903,63 @@ func (e *GetRecordsError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetRecordsError) MarshalJSON() ([]byte, error) {
+func (e ResetTeamScoresError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting an task.
-type GetTaskError string
+// Possible errors when resuming an event.
+type ResumeEventError string
 
 const (
-	GetTaskErrorNone         GetTaskError = "NONE"
-	GetTaskErrorIDRequired   GetTaskError = "ID_REQUIRED"
-	GetTaskErrorTypeRequired GetTaskError = "TYPE_REQUIRED"
-	GetTaskErrorNotFound     GetTaskError = "NOT_FOUND"
+	ResumeEventErrorNone             ResumeEventError = "NONE"
+	ResumeEventErrorNameTooShort     ResumeEventError = "NAME_TOO_SHORT"
+	ResumeEventErrorNameTooLong      ResumeEventError = "NAME_TOO_LONG"
+	ResumeEventErrorIDOrNameRequired ResumeEventError = "ID_OR_NAME_REQUIRED"
+	ResumeEventErrorNotFound         ResumeEventError = "NOT_FOUND"
+	ResumeEventErrorNotPaused        ResumeEventError = "NOT_PAUSED"
 )
 
-var AllGetTaskError = []GetTaskError{
-	GetTaskErrorNone,
-	GetTaskErrorIDRequired,
-	GetTaskErrorTypeRequired,
-	GetTaskErrorNotFound,
+var AllResumeEventError = []ResumeEventError{
+	ResumeEventErrorNone,
+	ResumeEventErrorNameTooShort,
+	ResumeEventErrorNameTooLong,
+	ResumeEventErrorIDOrNameRequired,
+	ResumeEventErrorNotFound,
+	ResumeEventErrorNotPaused,
 }
 
-func (e GetTaskError) IsValid() bool {
+func (e ResumeEventError) IsValid() bool {
 	switch e {
-	case GetTaskErrorNone, GetTaskErrorIDRequired, GetTaskErrorTypeRequired, GetTaskErrorNotFound:
+	case ResumeEventErrorNone, ResumeEventErrorNameTooShort, ResumeEventErrorNameTooLong, ResumeEventErrorIDOrNameRequired, ResumeEventErrorNotFound, ResumeEventErrorNotPaused:
 		return true
 	}
 	return false
 }
 
-func (e GetTaskError) String() string {
+func (e ResumeEventError) String() string {
 	return string(e)
 }
 
-func (e *GetTaskError) UnmarshalGQL(v any) error {
+func (e *ResumeEventError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetTaskError(str)
+	*e = ResumeEventError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetTaskError", str)
+		return fmt.Errorf("%s is not a valid ResumeEventError", str)
 	}
 	return nil
 }
 
-func (e GetTaskError) MarshalGQL(w io.Writer) {
+func (e ResumeEventError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetTaskError) UnmarshalJSON(b []byte) error {
+func (e *ResumeEventError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2015,61 +4967,59 @@ func (e *GetTaskError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetTaskError) MarshalJSON() ([]byte, error) {
+func (e ResumeEventError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting a team.
-type GetTeamError string
+// Possible errors when searching for teams.
+type SearchTeamsError string
 
 const (
-	GetTeamErrorNone             GetTeamError = "NONE"
-	GetTeamErrorNoFieldSpecified GetTeamError = "NO_FIELD_SPECIFIED"
-	GetTeamErrorNameTooShort     GetTeamError = "NAME_TOO_SHORT"
-	GetTeamErrorNameTooLong      GetTeamError = "NAME_TOO_LONG"
-	GetTeamErrorNotFound         GetTeamError = "NOT_FOUND"
+	SearchTeamsErrorNone          SearchTeamsError = "NONE"
+	SearchTeamsErrorQueryTooShort SearchTeamsError = "QUERY_TOO_SHORT"
+	SearchTeamsErrorQueryTooLong  SearchTeamsError = "QUERY_TOO_LONG"
+	SearchTeamsErrorInvalidCursor SearchTeamsError = "INVALID_CURSOR"
 )
 
-var AllGetTeamError = []GetTeamError{
-	GetTeamErrorNone,
-	GetTeamErrorNoFieldSpecified,
-	GetTeamErrorNameTooShort,
-	GetTeamErrorNameTooLong,
-	GetTeamErrorNotFound,
+var AllSearchTeamsError = []SearchTeamsError{
+	SearchTeamsErrorNone,
+	SearchTeamsErrorQueryTooShort,
+	SearchTeamsErrorQueryTooLong,
+	SearchTeamsErrorInvalidCursor,
 }
 
-func (e GetTeamError) IsValid() bool {
+func (e SearchTeamsError) IsValid() bool {
 	switch e {
-	case GetTeamErrorNone, GetTeamErrorNoFieldSpecified, GetTeamErrorNameTooShort, GetTeamErrorNameTooLong, GetTeamErrorNotFound:
+	case SearchTeamsErrorNone, SearchTeamsErrorQueryTooShort, SearchTeamsErrorQueryTooLong, SearchTeamsErrorInvalidCursor:
 		return true
 	}
 	return false
 }
 
-func (e GetTeamError) String() string {
+func (e SearchTeamsError) String() string {
 	return string(e)
 }
 
-func (e *GetTeamError) UnmarshalGQL(v any) error {
+func (e *SearchTeamsError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetTeamError(str)
+	*e = SearchTeamsError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetTeamError", str)
+		return fmt.Errorf("%s is not a valid SearchTeamsError", str)
 	}
 	return nil
 }
 
-func (e GetTeamError) MarshalGQL(w io.Writer) {
+func (e SearchTeamsError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetTeamError) UnmarshalJSON(b []byte) error {
+func (e *SearchTeamsError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2077,57 +5027,67 @@ func (e *GetTeamError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetTeamError) MarshalJSON() ([]byte, error) {
+func (e SearchTeamsError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting a team member.
-type GetTeamMemberError string
+// Possible errors when setting the third party delivery of an event.
+type SetEventThirdPartyDeliveryError string
 
 const (
-	GetTeamMemberErrorNone             GetTeamMemberError = "NONE"
-	GetTeamMemberErrorNoFieldSpecified GetTeamMemberError = "NO_FIELD_SPECIFIED"
-	GetTeamMemberErrorNotFound         GetTeamMemberError = "NOT_FOUND"
+	SetEventThirdPartyDeliveryErrorNone             SetEventThirdPartyDeliveryError = "NONE"
+	SetEventThirdPartyDeliveryErrorNameTooShort     SetEventThirdPartyDeliveryError = "NAME_TOO_SHORT"
+	SetEventThirdPartyDeliveryErrorNameTooLong      SetEventThirdPartyDeliveryError = "NAME_TOO_LONG"
+	SetEventThirdPartyDeliveryErrorIDOrNameRequired SetEventThirdPartyDeliveryError = "ID_OR_NAME_REQUIRED"
+	SetEventThirdPartyDeliveryErrorDeliveryRequired SetEventThirdPartyDeliveryError = "DELIVERY_REQUIRED"
+	SetEventThirdPartyDeliveryErrorTopLimitRequired SetEventThirdPartyDeliveryError = "TOP_LIMIT_REQUIRED"
+	SetEventThirdPartyDeliveryErrorURIInvalid       SetEventThirdPartyDeliveryError = "URI_INVALID"
+	SetEventThirdPartyDeliveryErrorNotFound         SetEventThirdPartyDeliveryError = "NOT_FOUND"
 )
 
-var AllGetTeamMemberError = []GetTeamMemberError{
-	GetTeamMemberErrorNone,
-	GetTeamMemberErrorNoFieldSpecified,
-	GetTeamMemberErrorNotFound,
+var AllSetEventThirdPartyDeliveryError = []SetEventThirdPartyDeliveryError{
+	SetEventThirdPartyDeliveryErrorNone,
+	SetEventThirdPartyDeliveryErrorNameTooShort,
+	SetEventThirdPartyDeliveryErrorNameTooLong,
+	SetEventThirdPartyDeliveryErrorIDOrNameRequired,
+	SetEventThirdPartyDeliveryErrorDeliveryRequired,
+	SetEventThirdPartyDeliveryErrorTopLimitRequired,
+	SetEventThirdPartyDeliveryErrorURIInvalid,
+	SetEventThirdPartyDeliveryErrorNotFound,
 }
 
-func (e GetTeamMemberError) IsValid() bool {
+func (e SetEventThirdPartyDeliveryError) IsValid() bool {
 	switch e {
-	case GetTeamMemberErrorNone, GetTeamMemberErrorNoFieldSpecified, GetTeamMemberErrorNotFound:
+	case SetEventThirdPartyDeliveryErrorNone, SetEventThirdPartyDeliveryErrorNameTooShort, SetEventThirdPartyDeliveryErrorNameTooLong, SetEventThirdPartyDeliveryErrorIDOrNameRequired, SetEventThirdPartyDeliveryErrorDeliveryRequired, SetEventThirdPartyDeliveryErrorTopLimitRequired, SetEventThirdPartyDeliveryErrorURIInvalid, SetEventThirdPartyDeliveryErrorNotFound:
 		return true
 	}
 	return false
 }
 
-func (e GetTeamMemberError) String() string {
+func (e SetEventThirdPartyDeliveryError) String() string {
 	return string(e)
 }
 
-func (e *GetTeamMemberError) UnmarshalGQL(v any) error {
+func (e *SetEventThirdPartyDeliveryError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetTeamMemberError(str)
+	*e = SetEventThirdPartyDeliveryError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetTeamMemberError", str)
+		return fmt.Errorf("%s is not a valid SetEventThirdPartyDeliveryError", str)
 	}
 	return nil
 }
 
-func (e GetTeamMemberError) MarshalGQL(w io.Writer) {
+func (e SetEventThirdPartyDeliveryError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetTeamMemberError) UnmarshalJSON(b []byte) error {
+func (e *SetEventThirdPartyDeliveryError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2135,63 +5095,65 @@ func (e *GetTeamMemberError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetTeamMemberError) MarshalJSON() ([]byte, error) {
+func (e SetEventThirdPartyDeliveryError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting a tournament user.
-type GetTournamentUserError string
+// Possible errors when setting the private server of the match.
+type SetMatchPrivateServerError string
 
 const (
-	GetTournamentUserErrorNone                                 GetTournamentUserError = "NONE"
-	GetTournamentUserErrorIDOrTournamentIntervalUserIDRequired GetTournamentUserError = "ID_OR_TOURNAMENT_INTERVAL_USER_ID_REQUIRED"
-	GetTournamentUserErrorTournamentNameTooShort               GetTournamentUserError = "TOURNAMENT_NAME_TOO_SHORT"
-	GetTournamentUserErrorTournamentNameTooLong                GetTournamentUserError = "TOURNAMENT_NAME_TOO_LONG"
-	GetTournamentUserErrorUserIDRequired                       GetTournamentUserError = "USER_ID_REQUIRED"
-	GetTournamentUserErrorNotFound                             GetTournamentUserError = "NOT_FOUND"
+	SetMatchPrivateServerErrorNone                                         SetMatchPrivateServerError = "NONE"
+	SetMatchPrivateServerErrorMatchIDOrMatchmakingTicketRequired           SetMatchPrivateServerError = "MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED"
+	SetMatchPrivateServerErrorMatchmakingTicketIDOrMatchmakingUserRequired SetMatchPrivateServerError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
+	SetMatchPrivateServerErrorMatchmakingUserIDOrClientUserIDRequired      SetMatchPrivateServerError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
+	SetMatchPrivateServerErrorPrivateServerIDRequired                      SetMatchPrivateServerError = "PRIVATE_SERVER_ID_REQUIRED"
+	SetMatchPrivateServerErrorPrivateServerAlreadySet                      SetMatchPrivateServerError = "PRIVATE_SERVER_ALREADY_SET"
+	SetMatchPrivateServerErrorNotFound                                     SetMatchPrivateServerError = "NOT_FOUND"
 )
 
-var AllGetTournamentUserError = []GetTournamentUserError{
-	GetTournamentUserErrorNone,
-	GetTournamentUserErrorIDOrTournamentIntervalUserIDRequired,
-	GetTournamentUserErrorTournamentNameTooShort,
-	GetTournamentUserErrorTournamentNameTooLong,
-	GetTournamentUserErrorUserIDRequired,
-	GetTournamentUserErrorNotFound,
+var AllSetMatchPrivateServerError = []SetMatchPrivateServerError{
+	SetMatchPrivateServerErrorNone,
+	SetMatchPrivateServerErrorMatchIDOrMatchmakingTicketRequired,
+	SetMatchPrivateServerErrorMatchmakingTicketIDOrMatchmakingUserRequired,
+	SetMatchPrivateServerErrorMatchmakingUserIDOrClientUserIDRequired,
+	SetMatchPrivateServerErrorPrivateServerIDRequired,
+	SetMatchPrivateServerErrorPrivateServerAlreadySet,
+	SetMatchPrivateServerErrorNotFound,
 }
 
-func (e GetTournamentUserError) IsValid() bool {
+func (e SetMatchPrivateServerError) IsValid() bool {
 	switch e {
-	case GetTournamentUserErrorNone, GetTournamentUserErrorIDOrTournamentIntervalUserIDRequired, GetTournamentUserErrorTournamentNameTooShort, GetTournamentUserErrorTournamentNameTooLong, GetTournamentUserErrorUserIDRequired, GetTournamentUserErrorNotFound:
+	case SetMatchPrivateServerErrorNone, SetMatchPrivateServerErrorMatchIDOrMatchmakingTicketRequired, SetMatchPrivateServerErrorMatchmakingTicketIDOrMatchmakingUserRequired, SetMatchPrivateServerErrorMatchmakingUserIDOrClientUserIDRequired, SetMatchPrivateServerErrorPrivateServerIDRequired, SetMatchPrivateServerErrorPrivateServerAlreadySet, SetMatchPrivateServerErrorNotFound:
 		return true
 	}
 	return false
 }
 
-func (e GetTournamentUserError) String() string {
+func (e SetMatchPrivateServerError) String() string {
 	return string(e)
 }
 
-func (e *GetTournamentUserError) UnmarshalGQL(v any) error {
+func (e *SetMatchPrivateServerError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetTournamentUserError(str)
+	*e = SetMatchPrivateServerError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetTournamentUserError", str)
+		return fmt.Errorf("%s is not a valid SetMatchPrivateServerError", str)
 	}
 	return nil
 }
 
-func (e GetTournamentUserError) MarshalGQL(w io.Writer) {
+func (e SetMatchPrivateServerError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetTournamentUserError) UnmarshalJSON(b []byte) error {
+func (e *SetMatchPrivateServerError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2199,57 +5161,65 @@ func (e *GetTournamentUserError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetTournamentUserError) MarshalJSON() ([]byte, error) {
+func (e SetMatchPrivateServerError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when getting a list of tournament users.
-type GetTournamentUsersError string
+// Possible errors when setting the wipe times of a tournament.
+type SetTournamentWipeTimeError string
 
 const (
-	GetTournamentUsersErrorNone                   GetTournamentUsersError = "NONE"
-	GetTournamentUsersErrorTournamentNameTooShort GetTournamentUsersError = "TOURNAMENT_NAME_TOO_SHORT"
-	GetTournamentUsersErrorTournamentNameTooLong  GetTournamentUsersError = "TOURNAMENT_NAME_TOO_LONG"
+	SetTournamentWipeTimeErrorNone                        SetTournamentWipeTimeError = "NONE"
+	SetTournamentWipeTimeErrorTournamentNameTooShort      SetTournamentWipeTimeError = "TOURNAMENT_NAME_TOO_SHORT"
+	SetTournamentWipeTimeErrorTournamentNameTooLong       SetTournamentWipeTimeError = "TOURNAMENT_NAME_TOO_LONG"
+	SetTournamentWipeTimeErrorInvalidMinute               SetTournamentWipeTimeError = "INVALID_MINUTE"
+	SetTournamentWipeTimeErrorInvalidWeeklyTournamentDay  SetTournamentWipeTimeError = "INVALID_WEEKLY_TOURNAMENT_DAY"
+	SetTournamentWipeTimeErrorInvalidMonthlyTournamentDay SetTournamentWipeTimeError = "INVALID_MONTHLY_TOURNAMENT_DAY"
+	SetTournamentWipeTimeErrorInvalidTimeZone             SetTournamentWipeTimeError = "INVALID_TIME_ZONE"
 )
 
-var AllGetTournamentUsersError = []GetTournamentUsersError{
-	GetTournamentUsersErrorNone,
-	GetTournamentUsersErrorTournamentNameTooShort,
-	GetTournamentUsersErrorTournamentNameTooLong,
+var AllSetTournamentWipeTimeError = []SetTournamentWipeTimeError{
+	SetTournamentWipeTimeErrorNone,
+	SetTournamentWipeTimeErrorTournamentNameTooShort,
+	SetTournamentWipeTimeErrorTournamentNameTooLong,
+	SetTournamentWipeTimeErrorInvalidMinute,
+	SetTournamentWipeTimeErrorInvalidWeeklyTournamentDay,
+	SetTournamentWipeTimeErrorInvalidMonthlyTournamentDay,
+	SetTournamentWipeTimeErrorInvalidTimeZone,
 }
 
-func (e GetTournamentUsersError) IsValid() bool {
+func (e SetTournamentWipeTimeError) IsValid() bool {
 	switch e {
-	case GetTournamentUsersErrorNone, GetTournamentUsersErrorTournamentNameTooShort, GetTournamentUsersErrorTournamentNameTooLong:
+	case SetTournamentWipeTimeErrorNone, SetTournamentWipeTimeErrorTournamentNameTooShort, SetTournamentWipeTimeErrorTournamentNameTooLong, SetTournamentWipeTimeErrorInvalidMinute, SetTournamentWipeTimeErrorInvalidWeeklyTournamentDay, SetTournamentWipeTimeErrorInvalidMonthlyTournamentDay, SetTournamentWipeTimeErrorInvalidTimeZone:
 		return true
 	}
 	return false
 }
 
-func (e GetTournamentUsersError) String() string {
+func (e SetTournamentWipeTimeError) String() string {
 	return string(e)
 }
 
-func (e *GetTournamentUsersError) UnmarshalGQL(v any) error {
+func (e *SetTournamentWipeTimeError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = GetTournamentUsersError(str)
+	*e = SetTournamentWipeTimeError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid GetTournamentUsersError", str)
+		return fmt.Errorf("%s is not a valid SetTournamentWipeTimeError", str)
 	}
 	return nil
 }
 
-func (e GetTournamentUsersError) MarshalGQL(w io.Writer) {
+func (e SetTournamentWipeTimeError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *GetTournamentUsersError) UnmarshalJSON(b []byte) error {
+func (e *SetTournamentWipeTimeError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2257,59 +5227,73 @@ func (e *GetTournamentUsersError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e GetTournamentUsersError) MarshalJSON() ([]byte, error) {
+func (e SetTournamentWipeTimeError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors related to items.
-type ItemError string
+// Possible errors when starting a match.
+type StartMatchError string
 
 const (
-	ItemErrorNone         ItemError = "NONE"
-	ItemErrorIDRequired   ItemError = "ID_REQUIRED"
-	ItemErrorTypeRequired ItemError = "TYPE_REQUIRED"
-	ItemErrorNotFound     ItemError = "NOT_FOUND"
+	StartMatchErrorNone                                         StartMatchError = "NONE"
+	StartMatchErrorMatchIDOrMatchmakingTicketRequired           StartMatchError = "MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED"
+	StartMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired StartMatchError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
+	StartMatchErrorMatchmakingUserIDOrClientUserIDRequired      StartMatchError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
+	StartMatchErrorStartTimeRequired                            StartMatchError = "START_TIME_REQUIRED"
+	StartMatchErrorInvalidStartTime                             StartMatchError = "INVALID_START_TIME"
+	StartMatchErrorStartTimeTooSoon                             StartMatchError = "START_TIME_TOO_SOON"
+	StartMatchErrorNotFound                                     StartMatchError = "NOT_FOUND"
+	StartMatchErrorNotEnoughPlayersToStart                      StartMatchError = "NOT_ENOUGH_PLAYERS_TO_START"
+	StartMatchErrorAlreadyHasStartTime                          StartMatchError = "ALREADY_HAS_START_TIME"
+	StartMatchErrorPrivateServerNotSet                          StartMatchError = "PRIVATE_SERVER_NOT_SET"
 )
 
-var AllItemError = []ItemError{
-	ItemErrorNone,
-	ItemErrorIDRequired,
-	ItemErrorTypeRequired,
-	ItemErrorNotFound,
+var AllStartMatchError = []StartMatchError{
+	StartMatchErrorNone,
+	StartMatchErrorMatchIDOrMatchmakingTicketRequired,
+	StartMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired,
+	StartMatchErrorMatchmakingUserIDOrClientUserIDRequired,
+	StartMatchErrorStartTimeRequired,
+	StartMatchErrorInvalidStartTime,
+	StartMatchErrorStartTimeTooSoon,
+	StartMatchErrorNotFound,
+	StartMatchErrorNotEnoughPlayersToStart,
+	StartMatchErrorAlreadyHasStartTime,
+	StartMatchErrorPrivateServerNotSet,
 }
 
-func (e ItemError) IsValid() bool {
+func (e StartMatchError) IsValid() bool {
 	switch e {
-	case ItemErrorNone, ItemErrorIDRequired, ItemErrorTypeRequired, ItemErrorNotFound:
+	case StartMatchErrorNone, StartMatchErrorMatchIDOrMatchmakingTicketRequired, StartMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired, StartMatchErrorMatchmakingUserIDOrClientUserIDRequired, StartMatchErrorStartTimeRequired, StartMatchErrorInvalidStartTime, StartMatchErrorStartTimeTooSoon, StartMatchErrorNotFound, StartMatchErrorNotEnoughPlayersToStart, StartMatchErrorAlreadyHasStartTime, StartMatchErrorPrivateServerNotSet:
 		return true
 	}
 	return false
 }
 
-func (e ItemError) String() string {
+func (e StartMatchError) String() string {
 	return string(e)
 }
 
-func (e *ItemError) UnmarshalGQL(v any) error {
+func (e *StartMatchError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ItemError(str)
+	*e = StartMatchError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ItemError", str)
+		return fmt.Errorf("%s is not a valid StartMatchError", str)
 	}
 	return nil
 }
 
-func (e ItemError) MarshalGQL(w io.Writer) {
+func (e StartMatchError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *ItemError) UnmarshalJSON(b []byte) error {
+func (e *StartMatchError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2317,69 +5301,67 @@ func (e *ItemError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e ItemError) MarshalJSON() ([]byte, error) {
+func (e StartMatchError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when joining a team.
-type JoinTeamError string
+// Possible errors when submitting a tournament score.
+type SubmitTournamentScoreError string
 
 const (
-	JoinTeamErrorNone             JoinTeamError = "NONE"
-	JoinTeamErrorNoFieldSpecified JoinTeamError = "NO_FIELD_SPECIFIED"
-	JoinTeamErrorNameTooShort     JoinTeamError = "NAME_TOO_SHORT"
-	JoinTeamErrorNameTooLong      JoinTeamError = "NAME_TOO_LONG"
-	JoinTeamErrorUserIDRequired   JoinTeamError = "USER_ID_REQUIRED"
-	JoinTeamErrorDataRequired     JoinTeamError = "DATA_REQUIRED"
-	JoinTeamErrorNotFound         JoinTeamError = "NOT_FOUND"
-	JoinTeamErrorTeamFull         JoinTeamError = "TEAM_FULL"
-	JoinTeamErrorAlreadyInATeam   JoinTeamError = "ALREADY_IN_A_TEAM"
+	SubmitTournamentScoreErrorNone                   SubmitTournamentScoreError = "NONE"
+	SubmitTournamentScoreErrorTournamentNameTooShort SubmitTournamentScoreError = "TOURNAMENT_NAME_TOO_SHORT"
+	SubmitTournamentScoreErrorTournamentNameTooLong  SubmitTournamentScoreError = "TOURNAMENT_NAME_TOO_LONG"
+	SubmitTournamentScoreErrorUserIDRequired         SubmitTournamentScoreError = "USER_ID_REQUIRED"
+	SubmitTournamentScoreErrorDefinitionNotFound     SubmitTournamentScoreError = "DEFINITION_NOT_FOUND"
+	SubmitTournamentScoreErrorTournamentNotActive    SubmitTournamentScoreError = "TOURNAMENT_NOT_ACTIVE"
+	SubmitTournamentScoreErrorNotATeamMember         SubmitTournamentScoreError = "NOT_A_TEAM_MEMBER"
+	SubmitTournamentScoreErrorScoreModeInvalid       SubmitTournamentScoreError = "SCORE_MODE_INVALID"
 )
 
-var AllJoinTeamError = []JoinTeamError{
-	JoinTeamErrorNone,
-	JoinTeamErrorNoFieldSpecified,
-	JoinTeamErrorNameTooShort,
-	JoinTeamErrorNameTooLong,
-	JoinTeamErrorUserIDRequired,
-	JoinTeamErrorDataRequired,
-	JoinTeamErrorNotFound,
-	JoinTeamErrorTeamFull,
-	JoinTeamErrorAlreadyInATeam,
+var AllSubmitTournamentScoreError = []SubmitTournamentScoreError{
+	SubmitTournamentScoreErrorNone,
+	SubmitTournamentScoreErrorTournamentNameTooShort,
+	SubmitTournamentScoreErrorTournamentNameTooLong,
+	SubmitTournamentScoreErrorUserIDRequired,
+	SubmitTournamentScoreErrorDefinitionNotFound,
+	SubmitTournamentScoreErrorTournamentNotActive,
+	SubmitTournamentScoreErrorNotATeamMember,
+	SubmitTournamentScoreErrorScoreModeInvalid,
 }
 
-func (e JoinTeamError) IsValid() bool {
+func (e SubmitTournamentScoreError) IsValid() bool {
 	switch e {
-	case JoinTeamErrorNone, JoinTeamErrorNoFieldSpecified, JoinTeamErrorNameTooShort, JoinTeamErrorNameTooLong, JoinTeamErrorUserIDRequired, JoinTeamErrorDataRequired, JoinTeamErrorNotFound, JoinTeamErrorTeamFull, JoinTeamErrorAlreadyInATeam:
+	case SubmitTournamentScoreErrorNone, SubmitTournamentScoreErrorTournamentNameTooShort, SubmitTournamentScoreErrorTournamentNameTooLong, SubmitTournamentScoreErrorUserIDRequired, SubmitTournamentScoreErrorDefinitionNotFound, SubmitTournamentScoreErrorTournamentNotActive, SubmitTournamentScoreErrorNotATeamMember, SubmitTournamentScoreErrorScoreModeInvalid:
 		return true
 	}
 	return false
 }
 
-func (e JoinTeamError) String() string {
+func (e SubmitTournamentScoreError) String() string {
 	return string(e)
 }
 
-func (e *JoinTeamError) UnmarshalGQL(v any) error {
+func (e *SubmitTournamentScoreError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = JoinTeamError(str)
+	*e = SubmitTournamentScoreError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid JoinTeamError", str)
+		return fmt.Errorf("%s is not a valid SubmitTournamentScoreError", str)
 	}
 	return nil
 }
 
-func (e JoinTeamError) MarshalGQL(w io.Writer) {
+func (e SubmitTournamentScoreError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *JoinTeamError) UnmarshalJSON(b []byte) error {
+func (e *SubmitTournamentScoreError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2387,57 +5369,59 @@ func (e *JoinTeamError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e JoinTeamError) MarshalJSON() ([]byte, error) {
+func (e SubmitTournamentScoreError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when leaving a team.
-type LeaveTeamError string
+// Possible errors related to tasks.
+type TaskError string
 
 const (
-	LeaveTeamErrorNone             LeaveTeamError = "NONE"
-	LeaveTeamErrorNoFieldSpecified LeaveTeamError = "NO_FIELD_SPECIFIED"
-	LeaveTeamErrorNotFound         LeaveTeamError = "NOT_FOUND"
+	TaskErrorNone         TaskError = "NONE"
+	TaskErrorIDRequired   TaskError = "ID_REQUIRED"
+	TaskErrorTypeRequired TaskError = "TYPE_REQUIRED"
+	TaskErrorNotFound     TaskError = "NOT_FOUND"
 )
 
-var AllLeaveTeamError = []LeaveTeamError{
-	LeaveTeamErrorNone,
-	LeaveTeamErrorNoFieldSpecified,
-	LeaveTeamErrorNotFound,
+var AllTaskError = []TaskError{
+	TaskErrorNone,
+	TaskErrorIDRequired,
+	TaskErrorTypeRequired,
+	TaskErrorNotFound,
 }
 
-func (e LeaveTeamError) IsValid() bool {
+func (e TaskError) IsValid() bool {
 	switch e {
-	case LeaveTeamErrorNone, LeaveTeamErrorNoFieldSpecified, LeaveTeamErrorNotFound:
+	case TaskErrorNone, TaskErrorIDRequired, TaskErrorTypeRequired, TaskErrorNotFound:
 		return true
 	}
 	return false
 }
 
-func (e LeaveTeamError) String() string {
+func (e TaskError) String() string {
 	return string(e)
 }
 
-func (e *LeaveTeamError) UnmarshalGQL(v any) error {
+func (e *TaskError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = LeaveTeamError(str)
+	*e = TaskError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid LeaveTeamError", str)
+		return fmt.Errorf("%s is not a valid TaskError", str)
 	}
 	return nil
 }
 
-func (e LeaveTeamError) MarshalGQL(w io.Writer) {
+func (e TaskError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *LeaveTeamError) UnmarshalJSON(b []byte) error {
+func (e *TaskError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2445,57 +5429,63 @@ func (e *LeaveTeamError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e LeaveTeamError) MarshalJSON() ([]byte, error) {
+func (e TaskError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible statuses for a match. Pending means it's waiting to be started. Started means it's currently being played. Ended means the match has ended.
-type MatchStatus string
+// Possible errors when deleting a team.
+type TeamError string
 
 const (
-	MatchStatusPending MatchStatus = "PENDING"
-	MatchStatusStarted MatchStatus = "STARTED"
-	MatchStatusEnded   MatchStatus = "ENDED"
+	TeamErrorNone             TeamError = "NONE"
+	TeamErrorNoFieldSpecified TeamError = "NO_FIELD_SPECIFIED"
+	TeamErrorNameTooShort     TeamError = "NAME_TOO_SHORT"
+	TeamErrorNameTooLong      TeamError = "NAME_TOO_LONG"
+	TeamErrorNotFound         TeamError = "NOT_FOUND"
+	TeamErrorNoMembers        TeamError = "NO_MEMBERS"
 )
 
-var AllMatchStatus = []MatchStatus{
-	MatchStatusPending,
-	MatchStatusStarted,
-	MatchStatusEnded,
+var AllTeamError = []TeamError{
+	TeamErrorNone,
+	TeamErrorNoFieldSpecified,
+	TeamErrorNameTooShort,
+	TeamErrorNameTooLong,
+	TeamErrorNotFound,
+	TeamErrorNoMembers,
 }
 
-func (e MatchStatus) IsValid() bool {
+func (e TeamError) IsValid() bool {
 	switch e {
-	case MatchStatusPending, MatchStatusStarted, MatchStatusEnded:
+	case TeamErrorNone, TeamErrorNoFieldSpecified, TeamErrorNameTooShort, TeamErrorNameTooLong, TeamErrorNotFound, TeamErrorNoMembers:
 		return true
 	}
 	return false
 }
 
-func (e MatchStatus) String() string {
+func (e TeamError) String() string {
 	return string(e)
 }
 
-func (e *MatchStatus) UnmarshalGQL(v any) error {
+func (e *TeamError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = MatchStatus(str)
+	*e = TeamError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid MatchStatus", str)
+		return fmt.Errorf("%s is not a valid TeamError", str)
 	}
 	return nil
 }
 
-func (e MatchStatus) MarshalGQL(w io.Writer) {
+func (e TeamError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *MatchStatus) UnmarshalJSON(b []byte) error {
+func (e *TeamError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2503,57 +5493,61 @@ func (e *MatchStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e MatchStatus) MarshalJSON() ([]byte, error) {
+func (e TeamError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible statuses for a matchmaking ticket. Pending means it's waiting to be matched. Matched means it's matched with other tickets, the match may have started or may not have. Ended means the match has ended.
-type MatchmakingTicketStatus string
+// Possible errors when deleting a team message.
+type TeamMessageError string
 
 const (
-	MatchmakingTicketStatusPending MatchmakingTicketStatus = "PENDING"
-	MatchmakingTicketStatusMatched MatchmakingTicketStatus = "MATCHED"
-	MatchmakingTicketStatusEnded   MatchmakingTicketStatus = "ENDED"
+	TeamMessageErrorNone           TeamMessageError = "NONE"
+	TeamMessageErrorIDRequired     TeamMessageError = "ID_REQUIRED"
+	TeamMessageErrorNotFound       TeamMessageError = "NOT_FOUND"
+	TeamMessageErrorUserIDRequired TeamMessageError = "USER_ID_REQUIRED"
+	TeamMessageErrorNotAllowed     TeamMessageError = "NOT_ALLOWED"
 )
 
-var AllMatchmakingTicketStatus = []MatchmakingTicketStatus{
-	MatchmakingTicketStatusPending,
-	MatchmakingTicketStatusMatched,
-	MatchmakingTicketStatusEnded,
+var AllTeamMessageError = []TeamMessageError{
+	TeamMessageErrorNone,
+	TeamMessageErrorIDRequired,
+	TeamMessageErrorNotFound,
+	TeamMessageErrorUserIDRequired,
+	TeamMessageErrorNotAllowed,
 }
 
-func (e MatchmakingTicketStatus) IsValid() bool {
+func (e TeamMessageError) IsValid() bool {
 	switch e {
-	case MatchmakingTicketStatusPending, MatchmakingTicketStatusMatched, MatchmakingTicketStatusEnded:
+	case TeamMessageErrorNone, TeamMessageErrorIDRequired, TeamMessageErrorNotFound, TeamMessageErrorUserIDRequired, TeamMessageErrorNotAllowed:
 		return true
 	}
 	return false
 }
 
-func (e MatchmakingTicketStatus) String() string {
+func (e TeamMessageError) String() string {
 	return string(e)
 }
 
-func (e *MatchmakingTicketStatus) UnmarshalGQL(v any) error {
+func (e *TeamMessageError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = MatchmakingTicketStatus(str)
+	*e = TeamMessageError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid MatchmakingTicketStatus", str)
+		return fmt.Errorf("%s is not a valid TeamMessageError", str)
 	}
 	return nil
 }
 
-func (e MatchmakingTicketStatus) MarshalGQL(w io.Writer) {
+func (e TeamMessageError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *MatchmakingTicketStatus) UnmarshalJSON(b []byte) error {
+func (e *TeamMessageError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2561,59 +5555,57 @@ func (e *MatchmakingTicketStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e MatchmakingTicketStatus) MarshalJSON() ([]byte, error) {
+func (e TeamMessageError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when removing an event result.
-type RemoveEventResultError string
+// Possible errors when deleting a tournament bracket.
+type TournamentBracketError string
 
 const (
-	RemoveEventResultErrorNone       RemoveEventResultError = "NONE"
-	RemoveEventResultErrorIDRequired RemoveEventResultError = "ID_REQUIRED"
-	RemoveEventResultErrorNotFound   RemoveEventResultError = "NOT_FOUND"
-	RemoveEventResultErrorEventEnded RemoveEventResultError = "EVENT_ENDED"
+	TournamentBracketErrorNone       TournamentBracketError = "NONE"
+	TournamentBracketErrorIDRequired TournamentBracketError = "ID_REQUIRED"
+	TournamentBracketErrorNotFound   TournamentBracketError = "NOT_FOUND"
 )
-
-var AllRemoveEventResultError = []RemoveEventResultError{
-	RemoveEventResultErrorNone,
-	RemoveEventResultErrorIDRequired,
-	RemoveEventResultErrorNotFound,
-	RemoveEventResultErrorEventEnded,
+
+var AllTournamentBracketError = []TournamentBracketError{
+	TournamentBracketErrorNone,
+	TournamentBracketErrorIDRequired,
+	TournamentBracketErrorNotFound,
 }
 
-func (e RemoveEventResultError) IsValid() bool {
+func (e TournamentBracketError) IsValid() bool {
 	switch e {
-	case RemoveEventResultErrorNone, RemoveEventResultErrorIDRequired, RemoveEventResultErrorNotFound, RemoveEventResultErrorEventEnded:
+	case TournamentBracketErrorNone, TournamentBracketErrorIDRequired, TournamentBracketErrorNotFound:
 		return true
 	}
 	return false
 }
 
-func (e RemoveEventResultError) String() string {
+func (e TournamentBracketError) String() string {
 	return string(e)
 }
 
-func (e *RemoveEventResultError) UnmarshalGQL(v any) error {
+func (e *TournamentBracketError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = RemoveEventResultError(str)
+	*e = TournamentBracketError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid RemoveEventResultError", str)
+		return fmt.Errorf("%s is not a valid TournamentBracketError", str)
 	}
 	return nil
 }
 
-func (e RemoveEventResultError) MarshalGQL(w io.Writer) {
+func (e TournamentBracketError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *RemoveEventResultError) UnmarshalJSON(b []byte) error {
+func (e *TournamentBracketError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2621,57 +5613,59 @@ func (e *RemoveEventResultError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e RemoveEventResultError) MarshalJSON() ([]byte, error) {
+func (e TournamentBracketError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when searching for teams.
-type SearchTeamsError string
+// Possible errors when deleting a custom tournament definition.
+type TournamentDefinitionError string
 
 const (
-	SearchTeamsErrorNone          SearchTeamsError = "NONE"
-	SearchTeamsErrorQueryTooShort SearchTeamsError = "QUERY_TOO_SHORT"
-	SearchTeamsErrorQueryTooLong  SearchTeamsError = "QUERY_TOO_LONG"
+	TournamentDefinitionErrorNone                   TournamentDefinitionError = "NONE"
+	TournamentDefinitionErrorTournamentNameTooShort TournamentDefinitionError = "TOURNAMENT_NAME_TOO_SHORT"
+	TournamentDefinitionErrorTournamentNameTooLong  TournamentDefinitionError = "TOURNAMENT_NAME_TOO_LONG"
+	TournamentDefinitionErrorNotFound               TournamentDefinitionError = "NOT_FOUND"
 )
 
-var AllSearchTeamsError = []SearchTeamsError{
-	SearchTeamsErrorNone,
-	SearchTeamsErrorQueryTooShort,
-	SearchTeamsErrorQueryTooLong,
+var AllTournamentDefinitionError = []TournamentDefinitionError{
+	TournamentDefinitionErrorNone,
+	TournamentDefinitionErrorTournamentNameTooShort,
+	TournamentDefinitionErrorTournamentNameTooLong,
+	TournamentDefinitionErrorNotFound,
 }
 
-func (e SearchTeamsError) IsValid() bool {
+func (e TournamentDefinitionError) IsValid() bool {
 	switch e {
-	case SearchTeamsErrorNone, SearchTeamsErrorQueryTooShort, SearchTeamsErrorQueryTooLong:
+	case TournamentDefinitionErrorNone, TournamentDefinitionErrorTournamentNameTooShort, TournamentDefinitionErrorTournamentNameTooLong, TournamentDefinitionErrorNotFound:
 		return true
 	}
 	return false
 }
 
-func (e SearchTeamsError) String() string {
+func (e TournamentDefinitionError) String() string {
 	return string(e)
 }
 
-func (e *SearchTeamsError) UnmarshalGQL(v any) error {
+func (e *TournamentDefinitionError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = SearchTeamsError(str)
+	*e = TournamentDefinitionError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid SearchTeamsError", str)
+		return fmt.Errorf("%s is not a valid TournamentDefinitionError", str)
 	}
 	return nil
 }
 
-func (e SearchTeamsError) MarshalGQL(w io.Writer) {
+func (e TournamentDefinitionError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *SearchTeamsError) UnmarshalJSON(b []byte) error {
+func (e *TournamentDefinitionError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2679,65 +5673,57 @@ func (e *SearchTeamsError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e SearchTeamsError) MarshalJSON() ([]byte, error) {
+func (e TournamentDefinitionError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when setting the private server of the match.
-type SetMatchPrivateServerError string
+// Possible errors when deleting a tournament reward tier.
+type TournamentRewardTierError string
 
 const (
-	SetMatchPrivateServerErrorNone                                         SetMatchPrivateServerError = "NONE"
-	SetMatchPrivateServerErrorMatchIDOrMatchmakingTicketRequired           SetMatchPrivateServerError = "MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED"
-	SetMatchPrivateServerErrorMatchmakingTicketIDOrMatchmakingUserRequired SetMatchPrivateServerError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
-	SetMatchPrivateServerErrorMatchmakingUserIDOrClientUserIDRequired      SetMatchPrivateServerError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
-	SetMatchPrivateServerErrorPrivateServerIDRequired                      SetMatchPrivateServerError = "PRIVATE_SERVER_ID_REQUIRED"
-	SetMatchPrivateServerErrorPrivateServerAlreadySet                      SetMatchPrivateServerError = "PRIVATE_SERVER_ALREADY_SET"
-	SetMatchPrivateServerErrorNotFound                                     SetMatchPrivateServerError = "NOT_FOUND"
+	TournamentRewardTierErrorNone       TournamentRewardTierError = "NONE"
+	TournamentRewardTierErrorIDRequired TournamentRewardTierError = "ID_REQUIRED"
+	TournamentRewardTierErrorNotFound   TournamentRewardTierError = "NOT_FOUND"
 )
 
-var AllSetMatchPrivateServerError = []SetMatchPrivateServerError{
-	SetMatchPrivateServerErrorNone,
-	SetMatchPrivateServerErrorMatchIDOrMatchmakingTicketRequired,
-	SetMatchPrivateServerErrorMatchmakingTicketIDOrMatchmakingUserRequired,
-	SetMatchPrivateServerErrorMatchmakingUserIDOrClientUserIDRequired,
-	SetMatchPrivateServerErrorPrivateServerIDRequired,
-	SetMatchPrivateServerErrorPrivateServerAlreadySet,
-	SetMatchPrivateServerErrorNotFound,
+var AllTournamentRewardTierError = []TournamentRewardTierError{
+	TournamentRewardTierErrorNone,
+	TournamentRewardTierErrorIDRequired,
+	TournamentRewardTierErrorNotFound,
 }
 
-func (e SetMatchPrivateServerError) IsValid() bool {
+func (e TournamentRewardTierError) IsValid() bool {
 	switch e {
-	case SetMatchPrivateServerErrorNone, SetMatchPrivateServerErrorMatchIDOrMatchmakingTicketRequired, SetMatchPrivateServerErrorMatchmakingTicketIDOrMatchmakingUserRequired, SetMatchPrivateServerErrorMatchmakingUserIDOrClientUserIDRequired, SetMatchPrivateServerErrorPrivateServerIDRequired, SetMatchPrivateServerErrorPrivateServerAlreadySet, SetMatchPrivateServerErrorNotFound:
+	case TournamentRewardTierErrorNone, TournamentRewardTierErrorIDRequired, TournamentRewardTierErrorNotFound:
 		return true
 	}
 	return false
 }
 
-func (e SetMatchPrivateServerError) String() string {
+func (e TournamentRewardTierError) String() string {
 	return string(e)
 }
 
-func (e *SetMatchPrivateServerError) UnmarshalGQL(v any) error {
+func (e *TournamentRewardTierError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = SetMatchPrivateServerError(str)
+	*e = TournamentRewardTierError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid SetMatchPrivateServerError", str)
+		return fmt.Errorf("%s is not a valid TournamentRewardTierError", str)
 	}
 	return nil
 }
 
-func (e SetMatchPrivateServerError) MarshalGQL(w io.Writer) {
+func (e TournamentRewardTierError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *SetMatchPrivateServerError) UnmarshalJSON(b []byte) error {
+func (e *TournamentRewardTierError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2745,73 +5731,63 @@ func (e *SetMatchPrivateServerError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e SetMatchPrivateServerError) MarshalJSON() ([]byte, error) {
+func (e TournamentRewardTierError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when starting a match.
-type StartMatchError string
+// Possible errors when requesting a tournament team without returning object.
+type TournamentTeamError string
 
 const (
-	StartMatchErrorNone                                         StartMatchError = "NONE"
-	StartMatchErrorMatchIDOrMatchmakingTicketRequired           StartMatchError = "MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED"
-	StartMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired StartMatchError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
-	StartMatchErrorMatchmakingUserIDOrClientUserIDRequired      StartMatchError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
-	StartMatchErrorStartTimeRequired                            StartMatchError = "START_TIME_REQUIRED"
-	StartMatchErrorInvalidStartTime                             StartMatchError = "INVALID_START_TIME"
-	StartMatchErrorStartTimeTooSoon                             StartMatchError = "START_TIME_TOO_SOON"
-	StartMatchErrorNotFound                                     StartMatchError = "NOT_FOUND"
-	StartMatchErrorNotEnoughPlayersToStart                      StartMatchError = "NOT_ENOUGH_PLAYERS_TO_START"
-	StartMatchErrorAlreadyHasStartTime                          StartMatchError = "ALREADY_HAS_START_TIME"
-	StartMatchErrorPrivateServerNotSet                          StartMatchError = "PRIVATE_SERVER_NOT_SET"
+	TournamentTeamErrorNone                                 TournamentTeamError = "NONE"
+	TournamentTeamErrorIDOrTournamentIntervalTeamIDRequired TournamentTeamError = "ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED"
+	TournamentTeamErrorTournamentNameTooShort               TournamentTeamError = "TOURNAMENT_NAME_TOO_SHORT"
+	TournamentTeamErrorTournamentNameTooLong                TournamentTeamError = "TOURNAMENT_NAME_TOO_LONG"
+	TournamentTeamErrorTeamIDRequired                       TournamentTeamError = "TEAM_ID_REQUIRED"
+	TournamentTeamErrorNotFound                             TournamentTeamError = "NOT_FOUND"
 )
 
-var AllStartMatchError = []StartMatchError{
-	StartMatchErrorNone,
-	StartMatchErrorMatchIDOrMatchmakingTicketRequired,
-	StartMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired,
-	StartMatchErrorMatchmakingUserIDOrClientUserIDRequired,
-	StartMatchErrorStartTimeRequired,
-	StartMatchErrorInvalidStartTime,
-	StartMatchErrorStartTimeTooSoon,
-	StartMatchErrorNotFound,
-	StartMatchErrorNotEnoughPlayersToStart,
-	StartMatchErrorAlreadyHasStartTime,
-	StartMatchErrorPrivateServerNotSet,
+var AllTournamentTeamError = []TournamentTeamError{
+	TournamentTeamErrorNone,
+	TournamentTeamErrorIDOrTournamentIntervalTeamIDRequired,
+	TournamentTeamErrorTournamentNameTooShort,
+	TournamentTeamErrorTournamentNameTooLong,
+	TournamentTeamErrorTeamIDRequired,
+	TournamentTeamErrorNotFound,
 }
 
-func (e StartMatchError) IsValid() bool {
+func (e TournamentTeamError) IsValid() bool {
 	switch e {
-	case StartMatchErrorNone, StartMatchErrorMatchIDOrMatchmakingTicketRequired, StartMatchErrorMatchmakingTicketIDOrMatchmakingUserRequired, StartMatchErrorMatchmakingUserIDOrClientUserIDRequired, StartMatchErrorStartTimeRequired, StartMatchErrorInvalidStartTime, StartMatchErrorStartTimeTooSoon, StartMatchErrorNotFound, StartMatchErrorNotEnoughPlayersToStart, StartMatchErrorAlreadyHasStartTime, StartMatchErrorPrivateServerNotSet:
+	case TournamentTeamErrorNone, TournamentTeamErrorIDOrTournamentIntervalTeamIDRequired, TournamentTeamErrorTournamentNameTooShort, TournamentTeamErrorTournamentNameTooLong, TournamentTeamErrorTeamIDRequired, TournamentTeamErrorNotFound:
 		return true
 	}
 	return false
 }
 
-func (e StartMatchError) String() string {
+func (e TournamentTeamError) String() string {
 	return string(e)
 }
 
-func (e *StartMatchError) UnmarshalGQL(v any) error {
+func (e *TournamentTeamError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = StartMatchError(str)
+	*e = TournamentTeamError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid StartMatchError", str)
+		return fmt.Errorf("%s is not a valid TournamentTeamError", str)
 	}
 	return nil
 }
 
-func (e StartMatchError) MarshalGQL(w io.Writer) {
+func (e TournamentTeamError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *StartMatchError) UnmarshalJSON(b []byte) error {
+func (e *TournamentTeamError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2819,59 +5795,63 @@ func (e *StartMatchError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e StartMatchError) MarshalJSON() ([]byte, error) {
+func (e TournamentTeamError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors related to tasks.
-type TaskError string
+// Possible errors when requesting a tournament user without returning object.
+type TournamentUserError string
 
 const (
-	TaskErrorNone         TaskError = "NONE"
-	TaskErrorIDRequired   TaskError = "ID_REQUIRED"
-	TaskErrorTypeRequired TaskError = "TYPE_REQUIRED"
-	TaskErrorNotFound     TaskError = "NOT_FOUND"
+	TournamentUserErrorNone                                 TournamentUserError = "NONE"
+	TournamentUserErrorIDOrTournamentIntervalUserIDRequired TournamentUserError = "ID_OR_TOURNAMENT_INTERVAL_USER_ID_REQUIRED"
+	TournamentUserErrorTournamentNameTooShort               TournamentUserError = "TOURNAMENT_NAME_TOO_SHORT"
+	TournamentUserErrorTournamentNameTooLong                TournamentUserError = "TOURNAMENT_NAME_TOO_LONG"
+	TournamentUserErrorUserIDRequired                       TournamentUserError = "USER_ID_REQUIRED"
+	TournamentUserErrorNotFound                             TournamentUserError = "NOT_FOUND"
 )
 
-var AllTaskError = []TaskError{
-	TaskErrorNone,
-	TaskErrorIDRequired,
-	TaskErrorTypeRequired,
-	TaskErrorNotFound,
+var AllTournamentUserError = []TournamentUserError{
+	TournamentUserErrorNone,
+	TournamentUserErrorIDOrTournamentIntervalUserIDRequired,
+	TournamentUserErrorTournamentNameTooShort,
+	TournamentUserErrorTournamentNameTooLong,
+	TournamentUserErrorUserIDRequired,
+	TournamentUserErrorNotFound,
 }
 
-func (e TaskError) IsValid() bool {
+func (e TournamentUserError) IsValid() bool {
 	switch e {
-	case TaskErrorNone, TaskErrorIDRequired, TaskErrorTypeRequired, TaskErrorNotFound:
+	case TournamentUserErrorNone, TournamentUserErrorIDOrTournamentIntervalUserIDRequired, TournamentUserErrorTournamentNameTooShort, TournamentUserErrorTournamentNameTooLong, TournamentUserErrorUserIDRequired, TournamentUserErrorNotFound:
 		return true
 	}
 	return false
 }
 
-func (e TaskError) String() string {
+func (e TournamentUserError) String() string {
 	return string(e)
 }
 
-func (e *TaskError) UnmarshalGQL(v any) error {
+func (e *TournamentUserError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = TaskError(str)
+	*e = TournamentUserError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid TaskError", str)
+		return fmt.Errorf("%s is not a valid TournamentUserError", str)
 	}
 	return nil
 }
 
-func (e TaskError) MarshalGQL(w io.Writer) {
+func (e TournamentUserError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *TaskError) UnmarshalJSON(b []byte) error {
+func (e *TournamentUserError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2879,61 +5859,59 @@ func (e *TaskError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e TaskError) MarshalJSON() ([]byte, error) {
+func (e TournamentUserError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when deleting a team.
-type TeamError string
+// Possible errors when deleting the wipe times of a tournament.
+type TournamentWipeTimeError string
 
 const (
-	TeamErrorNone             TeamError = "NONE"
-	TeamErrorNoFieldSpecified TeamError = "NO_FIELD_SPECIFIED"
-	TeamErrorNameTooShort     TeamError = "NAME_TOO_SHORT"
-	TeamErrorNameTooLong      TeamError = "NAME_TOO_LONG"
-	TeamErrorNotFound         TeamError = "NOT_FOUND"
+	TournamentWipeTimeErrorNone                   TournamentWipeTimeError = "NONE"
+	TournamentWipeTimeErrorTournamentNameTooShort TournamentWipeTimeError = "TOURNAMENT_NAME_TOO_SHORT"
+	TournamentWipeTimeErrorTournamentNameTooLong  TournamentWipeTimeError = "TOURNAMENT_NAME_TOO_LONG"
+	TournamentWipeTimeErrorNotFound               TournamentWipeTimeError = "NOT_FOUND"
 )
 
-var AllTeamError = []TeamError{
-	TeamErrorNone,
-	TeamErrorNoFieldSpecified,
-	TeamErrorNameTooShort,
-	TeamErrorNameTooLong,
-	TeamErrorNotFound,
+var AllTournamentWipeTimeError = []TournamentWipeTimeError{
+	TournamentWipeTimeErrorNone,
+	TournamentWipeTimeErrorTournamentNameTooShort,
+	TournamentWipeTimeErrorTournamentNameTooLong,
+	TournamentWipeTimeErrorNotFound,
 }
 
-func (e TeamError) IsValid() bool {
+func (e TournamentWipeTimeError) IsValid() bool {
 	switch e {
-	case TeamErrorNone, TeamErrorNoFieldSpecified, TeamErrorNameTooShort, TeamErrorNameTooLong, TeamErrorNotFound:
+	case TournamentWipeTimeErrorNone, TournamentWipeTimeErrorTournamentNameTooShort, TournamentWipeTimeErrorTournamentNameTooLong, TournamentWipeTimeErrorNotFound:
 		return true
 	}
 	return false
 }
 
-func (e TeamError) String() string {
+func (e TournamentWipeTimeError) String() string {
 	return string(e)
 }
 
-func (e *TeamError) UnmarshalGQL(v any) error {
+func (e *TournamentWipeTimeError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = TeamError(str)
+	*e = TournamentWipeTimeError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid TeamError", str)
+		return fmt.Errorf("%s is not a valid TournamentWipeTimeError", str)
 	}
 	return nil
 }
 
-func (e TeamError) MarshalGQL(w io.Writer) {
+func (e TournamentWipeTimeError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *TeamError) UnmarshalJSON(b []byte) error {
+func (e *TournamentWipeTimeError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -2941,63 +5919,71 @@ func (e *TeamError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e TeamError) MarshalJSON() ([]byte, error) {
+func (e TournamentWipeTimeError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible errors when requesting a tournament user without returning object.
-type TournamentUserError string
+// Possible errors when unregistering from an event.
+type UnregisterFromEventError string
 
 const (
-	TournamentUserErrorNone                                 TournamentUserError = "NONE"
-	TournamentUserErrorIDOrTournamentIntervalUserIDRequired TournamentUserError = "ID_OR_TOURNAMENT_INTERVAL_USER_ID_REQUIRED"
-	TournamentUserErrorTournamentNameTooShort               TournamentUserError = "TOURNAMENT_NAME_TOO_SHORT"
-	TournamentUserErrorTournamentNameTooLong                TournamentUserError = "TOURNAMENT_NAME_TOO_LONG"
-	TournamentUserErrorUserIDRequired                       TournamentUserError = "USER_ID_REQUIRED"
-	TournamentUserErrorNotFound                             TournamentUserError = "NOT_FOUND"
+	UnregisterFromEventErrorNone                  UnregisterFromEventError = "NONE"
+	UnregisterFromEventErrorNameTooShort          UnregisterFromEventError = "NAME_TOO_SHORT"
+	UnregisterFromEventErrorNameTooLong           UnregisterFromEventError = "NAME_TOO_LONG"
+	UnregisterFromEventErrorIDOrNameRequired      UnregisterFromEventError = "ID_OR_NAME_REQUIRED"
+	UnregisterFromEventErrorClientUserIDRequired  UnregisterFromEventError = "CLIENT_USER_ID_REQUIRED"
+	UnregisterFromEventErrorEventUserOrIDRequired UnregisterFromEventError = "EVENT_USER_OR_ID_REQUIRED"
+	UnregisterFromEventErrorNotFound              UnregisterFromEventError = "NOT_FOUND"
+	UnregisterFromEventErrorRegistrationClosed    UnregisterFromEventError = "REGISTRATION_CLOSED"
+	UnregisterFromEventErrorDisqualified          UnregisterFromEventError = "DISQUALIFIED"
+	UnregisterFromEventErrorHasResults            UnregisterFromEventError = "HAS_RESULTS"
 )
 
-var AllTournamentUserError = []TournamentUserError{
-	TournamentUserErrorNone,
-	TournamentUserErrorIDOrTournamentIntervalUserIDRequired,
-	TournamentUserErrorTournamentNameTooShort,
-	TournamentUserErrorTournamentNameTooLong,
-	TournamentUserErrorUserIDRequired,
-	TournamentUserErrorNotFound,
+var AllUnregisterFromEventError = []UnregisterFromEventError{
+	UnregisterFromEventErrorNone,
+	UnregisterFromEventErrorNameTooShort,
+	UnregisterFromEventErrorNameTooLong,
+	UnregisterFromEventErrorIDOrNameRequired,
+	UnregisterFromEventErrorClientUserIDRequired,
+	UnregisterFromEventErrorEventUserOrIDRequired,
+	UnregisterFromEventErrorNotFound,
+	UnregisterFromEventErrorRegistrationClosed,
+	UnregisterFromEventErrorDisqualified,
+	UnregisterFromEventErrorHasResults,
 }
 
-func (e TournamentUserError) IsValid() bool {
+func (e UnregisterFromEventError) IsValid() bool {
 	switch e {
-	case TournamentUserErrorNone, TournamentUserErrorIDOrTournamentIntervalUserIDRequired, TournamentUserErrorTournamentNameTooShort, TournamentUserErrorTournamentNameTooLong, TournamentUserErrorUserIDRequired, TournamentUserErrorNotFound:
+	case UnregisterFromEventErrorNone, UnregisterFromEventErrorNameTooShort, UnregisterFromEventErrorNameTooLong, UnregisterFromEventErrorIDOrNameRequired, UnregisterFromEventErrorClientUserIDRequired, UnregisterFromEventErrorEventUserOrIDRequired, UnregisterFromEventErrorNotFound, UnregisterFromEventErrorRegistrationClosed, UnregisterFromEventErrorDisqualified, UnregisterFromEventErrorHasResults:
 		return true
 	}
 	return false
 }
 
-func (e TournamentUserError) String() string {
+func (e UnregisterFromEventError) String() string {
 	return string(e)
 }
 
-func (e *TournamentUserError) UnmarshalGQL(v any) error {
+func (e *UnregisterFromEventError) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = TournamentUserError(str)
+	*e = UnregisterFromEventError(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid TournamentUserError", str)
+		return fmt.Errorf("%s is not a valid UnregisterFromEventError", str)
 	}
 	return nil
 }
 
-func (e TournamentUserError) MarshalGQL(w io.Writer) {
+func (e UnregisterFromEventError) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *TournamentUserError) UnmarshalJSON(b []byte) error {
+func (e *UnregisterFromEventError) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -3005,7 +5991,7 @@ func (e *TournamentUserError) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e TournamentUserError) MarshalJSON() ([]byte, error) {
+func (e UnregisterFromEventError) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
@@ -3025,6 +6011,15 @@ const (
 	UpdateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanMaxPlayers UpdateArenaError = "MAX_PLAYERS_PER_TICKET_CANNOT_BE_GREATER_THAN_MAX_PLAYERS"
 	UpdateArenaErrorArenaCurrentlyInUse                              UpdateArenaError = "ARENA_CURRENTLY_IN_USE"
 	UpdateArenaErrorNotFound                                         UpdateArenaError = "NOT_FOUND"
+	UpdateArenaErrorTeamCountRequired                                UpdateArenaError = "TEAM_COUNT_REQUIRED"
+	UpdateArenaErrorMaxPlayersMustBeDivisibleByTeamCount             UpdateArenaError = "MAX_PLAYERS_MUST_BE_DIVISIBLE_BY_TEAM_COUNT"
+	UpdateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanTeamSize   UpdateArenaError = "MAX_PLAYERS_PER_TICKET_CANNOT_BE_GREATER_THAN_TEAM_SIZE"
+	UpdateArenaErrorRoleDataKeyRequired                              UpdateArenaError = "ROLE_DATA_KEY_REQUIRED"
+	UpdateArenaErrorRolesRequired                                    UpdateArenaError = "ROLES_REQUIRED"
+	UpdateArenaErrorRoleNameRequired                                 UpdateArenaError = "ROLE_NAME_REQUIRED"
+	UpdateArenaErrorRoleCountRequired                                UpdateArenaError = "ROLE_COUNT_REQUIRED"
+	UpdateArenaErrorRoleNameDuplicate                                UpdateArenaError = "ROLE_NAME_DUPLICATE"
+	UpdateArenaErrorRoleCountsMustAddUpToTeamSize                    UpdateArenaError = "ROLE_COUNTS_MUST_ADD_UP_TO_TEAM_SIZE"
 )
 
 var AllUpdateArenaError = []UpdateArenaError{
@@ -3038,11 +6033,20 @@ var AllUpdateArenaError = []UpdateArenaError{
 	UpdateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanMaxPlayers,
 	UpdateArenaErrorArenaCurrentlyInUse,
 	UpdateArenaErrorNotFound,
+	UpdateArenaErrorTeamCountRequired,
+	UpdateArenaErrorMaxPlayersMustBeDivisibleByTeamCount,
+	UpdateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanTeamSize,
+	UpdateArenaErrorRoleDataKeyRequired,
+	UpdateArenaErrorRolesRequired,
+	UpdateArenaErrorRoleNameRequired,
+	UpdateArenaErrorRoleCountRequired,
+	UpdateArenaErrorRoleNameDuplicate,
+	UpdateArenaErrorRoleCountsMustAddUpToTeamSize,
 }
 
 func (e UpdateArenaError) IsValid() bool {
 	switch e {
-	case UpdateArenaErrorNone, UpdateArenaErrorNameTooShort, UpdateArenaErrorNameTooLong, UpdateArenaErrorArenaIDOrNameRequired, UpdateArenaErrorNoUpdateSpecified, UpdateArenaErrorIfCapacityChangedMustChangeAllPlayers, UpdateArenaErrorMinPlayersCannotBeGreaterThanMaxPlayers, UpdateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanMaxPlayers, UpdateArenaErrorArenaCurrentlyInUse, UpdateArenaErrorNotFound:
+	case UpdateArenaErrorNone, UpdateArenaErrorNameTooShort, UpdateArenaErrorNameTooLong, UpdateArenaErrorArenaIDOrNameRequired, UpdateArenaErrorNoUpdateSpecified, UpdateArenaErrorIfCapacityChangedMustChangeAllPlayers, UpdateArenaErrorMinPlayersCannotBeGreaterThanMaxPlayers, UpdateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanMaxPlayers, UpdateArenaErrorArenaCurrentlyInUse, UpdateArenaErrorNotFound, UpdateArenaErrorTeamCountRequired, UpdateArenaErrorMaxPlayersMustBeDivisibleByTeamCount, UpdateArenaErrorMaxPlayersPerTicketCannotBeGreaterThanTeamSize, UpdateArenaErrorRoleDataKeyRequired, UpdateArenaErrorRolesRequired, UpdateArenaErrorRoleNameRequired, UpdateArenaErrorRoleCountRequired, UpdateArenaErrorRoleNameDuplicate, UpdateArenaErrorRoleCountsMustAddUpToTeamSize:
 		return true
 	}
 	return false
@@ -3151,13 +6155,15 @@ func (e UpdateEventError) MarshalJSON() ([]byte, error) {
 type UpdateEventRoundError string
 
 const (
-	UpdateEventRoundErrorNone                   UpdateEventRoundError = "NONE"
-	UpdateEventRoundErrorNameTooShort           UpdateEventRoundError = "NAME_TOO_SHORT"
-	UpdateEventRoundErrorNameTooLong            UpdateEventRoundError = "NAME_TOO_LONG"
-	UpdateEventRoundErrorIDOrNameRequired       UpdateEventRoundError = "ID_OR_NAME_REQUIRED"
-	UpdateEventRoundErrorEventRoundOrIDRequired UpdateEventRoundError = "EVENT_ROUND_OR_ID_REQUIRED"
-	UpdateEventRoundErrorNoUpdateSpecified      UpdateEventRoundError = "NO_UPDATE_SPECIFIED"
-	UpdateEventRoundErrorNotFound               UpdateEventRoundError = "NOT_FOUND"
+	UpdateEventRoundErrorNone                         UpdateEventRoundError = "NONE"
+	UpdateEventRoundErrorNameTooShort                 UpdateEventRoundError = "NAME_TOO_SHORT"
+	UpdateEventRoundErrorNameTooLong                  UpdateEventRoundError = "NAME_TOO_LONG"
+	UpdateEventRoundErrorIDOrNameRequired             UpdateEventRoundError = "ID_OR_NAME_REQUIRED"
+	UpdateEventRoundErrorEventRoundOrIDRequired       UpdateEventRoundError = "EVENT_ROUND_OR_ID_REQUIRED"
+	UpdateEventRoundErrorNoUpdateSpecified            UpdateEventRoundError = "NO_UPDATE_SPECIFIED"
+	UpdateEventRoundErrorNotFound                     UpdateEventRoundError = "NOT_FOUND"
+	UpdateEventRoundErrorScoringInvalid               UpdateEventRoundError = "SCORING_INVALID"
+	UpdateEventRoundErrorResultAggregationTopRequired UpdateEventRoundError = "RESULT_AGGREGATION_TOP_REQUIRED"
 )
 
 var AllUpdateEventRoundError = []UpdateEventRoundError{
@@ -3168,11 +6174,13 @@ var AllUpdateEventRoundError = []UpdateEventRoundError{
 	UpdateEventRoundErrorEventRoundOrIDRequired,
 	UpdateEventRoundErrorNoUpdateSpecified,
 	UpdateEventRoundErrorNotFound,
+	UpdateEventRoundErrorScoringInvalid,
+	UpdateEventRoundErrorResultAggregationTopRequired,
 }
 
 func (e UpdateEventRoundError) IsValid() bool {
 	switch e {
-	case UpdateEventRoundErrorNone, UpdateEventRoundErrorNameTooShort, UpdateEventRoundErrorNameTooLong, UpdateEventRoundErrorIDOrNameRequired, UpdateEventRoundErrorEventRoundOrIDRequired, UpdateEventRoundErrorNoUpdateSpecified, UpdateEventRoundErrorNotFound:
+	case UpdateEventRoundErrorNone, UpdateEventRoundErrorNameTooShort, UpdateEventRoundErrorNameTooLong, UpdateEventRoundErrorIDOrNameRequired, UpdateEventRoundErrorEventRoundOrIDRequired, UpdateEventRoundErrorNoUpdateSpecified, UpdateEventRoundErrorNotFound, UpdateEventRoundErrorScoringInvalid, UpdateEventRoundErrorResultAggregationTopRequired:
 		return true
 	}
 	return false
@@ -3477,6 +6485,7 @@ const (
 	UpdateMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired UpdateMatchmakingUserError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
 	UpdateMatchmakingUserErrorDataRequired                            UpdateMatchmakingUserError = "DATA_REQUIRED"
 	UpdateMatchmakingUserErrorNotFound                                UpdateMatchmakingUserError = "NOT_FOUND"
+	UpdateMatchmakingUserErrorRolesCannotBeFilled                     UpdateMatchmakingUserError = "ROLES_CANNOT_BE_FILLED"
 )
 
 var AllUpdateMatchmakingUserError = []UpdateMatchmakingUserError{
@@ -3484,11 +6493,12 @@ var AllUpdateMatchmakingUserError = []UpdateMatchmakingUserError{
 	UpdateMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired,
 	UpdateMatchmakingUserErrorDataRequired,
 	UpdateMatchmakingUserErrorNotFound,
+	UpdateMatchmakingUserErrorRolesCannotBeFilled,
 }
 
 func (e UpdateMatchmakingUserError) IsValid() bool {
 	switch e {
-	case UpdateMatchmakingUserErrorNone, UpdateMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired, UpdateMatchmakingUserErrorDataRequired, UpdateMatchmakingUserErrorNotFound:
+	case UpdateMatchmakingUserErrorNone, UpdateMatchmakingUserErrorMatchmakingUserIDOrClientUserIDRequired, UpdateMatchmakingUserErrorDataRequired, UpdateMatchmakingUserErrorNotFound, UpdateMatchmakingUserErrorRolesCannotBeFilled:
 		return true
 	}
 	return false
@@ -3783,6 +6793,142 @@ func (e UpdateTeamMemberError) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Possible errors when updating a team message.
+type UpdateTeamMessageError string
+
+const (
+	UpdateTeamMessageErrorNone              UpdateTeamMessageError = "NONE"
+	UpdateTeamMessageErrorIDRequired        UpdateTeamMessageError = "ID_REQUIRED"
+	UpdateTeamMessageErrorNoUpdateSpecified UpdateTeamMessageError = "NO_UPDATE_SPECIFIED"
+	UpdateTeamMessageErrorContentRequired   UpdateTeamMessageError = "CONTENT_REQUIRED"
+	UpdateTeamMessageErrorContentTooLong    UpdateTeamMessageError = "CONTENT_TOO_LONG"
+	UpdateTeamMessageErrorNotFound          UpdateTeamMessageError = "NOT_FOUND"
+	UpdateTeamMessageErrorUserIDRequired    UpdateTeamMessageError = "USER_ID_REQUIRED"
+	UpdateTeamMessageErrorNotAllowed        UpdateTeamMessageError = "NOT_ALLOWED"
+)
+
+var AllUpdateTeamMessageError = []UpdateTeamMessageError{
+	UpdateTeamMessageErrorNone,
+	UpdateTeamMessageErrorIDRequired,
+	UpdateTeamMessageErrorNoUpdateSpecified,
+	UpdateTeamMessageErrorContentRequired,
+	UpdateTeamMessageErrorContentTooLong,
+	UpdateTeamMessageErrorNotFound,
+	UpdateTeamMessageErrorUserIDRequired,
+	UpdateTeamMessageErrorNotAllowed,
+}
+
+func (e UpdateTeamMessageError) IsValid() bool {
+	switch e {
+	case UpdateTeamMessageErrorNone, UpdateTeamMessageErrorIDRequired, UpdateTeamMessageErrorNoUpdateSpecified, UpdateTeamMessageErrorContentRequired, UpdateTeamMessageErrorContentTooLong, UpdateTeamMessageErrorNotFound, UpdateTeamMessageErrorUserIDRequired, UpdateTeamMessageErrorNotAllowed:
+		return true
+	}
+	return false
+}
+
+func (e UpdateTeamMessageError) String() string {
+	return string(e)
+}
+
+func (e *UpdateTeamMessageError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UpdateTeamMessageError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UpdateTeamMessageError", str)
+	}
+	return nil
+}
+
+func (e UpdateTeamMessageError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *UpdateTeamMessageError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e UpdateTeamMessageError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible errors when updating a tournament team.
+type UpdateTournamentTeamError string
+
+const (
+	UpdateTournamentTeamErrorNone                                 UpdateTournamentTeamError = "NONE"
+	UpdateTournamentTeamErrorIDOrTournamentIntervalTeamIDRequired UpdateTournamentTeamError = "ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED"
+	UpdateTournamentTeamErrorTournamentNameTooShort               UpdateTournamentTeamError = "TOURNAMENT_NAME_TOO_SHORT"
+	UpdateTournamentTeamErrorTournamentNameTooLong                UpdateTournamentTeamError = "TOURNAMENT_NAME_TOO_LONG"
+	UpdateTournamentTeamErrorTeamIDRequired                       UpdateTournamentTeamError = "TEAM_ID_REQUIRED"
+	UpdateTournamentTeamErrorNotFound                             UpdateTournamentTeamError = "NOT_FOUND"
+	UpdateTournamentTeamErrorNoUpdateSpecified                    UpdateTournamentTeamError = "NO_UPDATE_SPECIFIED"
+	UpdateTournamentTeamErrorIncrementScoreNotSpecified           UpdateTournamentTeamError = "INCREMENT_SCORE_NOT_SPECIFIED"
+)
+
+var AllUpdateTournamentTeamError = []UpdateTournamentTeamError{
+	UpdateTournamentTeamErrorNone,
+	UpdateTournamentTeamErrorIDOrTournamentIntervalTeamIDRequired,
+	UpdateTournamentTeamErrorTournamentNameTooShort,
+	UpdateTournamentTeamErrorTournamentNameTooLong,
+	UpdateTournamentTeamErrorTeamIDRequired,
+	UpdateTournamentTeamErrorNotFound,
+	UpdateTournamentTeamErrorNoUpdateSpecified,
+	UpdateTournamentTeamErrorIncrementScoreNotSpecified,
+}
+
+func (e UpdateTournamentTeamError) IsValid() bool {
+	switch e {
+	case UpdateTournamentTeamErrorNone, UpdateTournamentTeamErrorIDOrTournamentIntervalTeamIDRequired, UpdateTournamentTeamErrorTournamentNameTooShort, UpdateTournamentTeamErrorTournamentNameTooLong, UpdateTournamentTeamErrorTeamIDRequired, UpdateTournamentTeamErrorNotFound, UpdateTournamentTeamErrorNoUpdateSpecified, UpdateTournamentTeamErrorIncrementScoreNotSpecified:
+		return true
+	}
+	return false
+}
+
+func (e UpdateTournamentTeamError) String() string {
+	return string(e)
+}
+
+func (e *UpdateTournamentTeamError) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UpdateTournamentTeamError(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UpdateTournamentTeamError", str)
+	}
+	return nil
+}
+
+func (e UpdateTournamentTeamError) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *UpdateTournamentTeamError) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e UpdateTournamentTeamError) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Possible errors when updating a tournament user.
 type UpdateTournamentUserError string
 
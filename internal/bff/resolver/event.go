@@ -10,6 +10,7 @@ import (
 	"github.com/MorhafAlshibly/coanda/api"
 	"github.com/MorhafAlshibly/coanda/internal/bff"
 	"github.com/MorhafAlshibly/coanda/internal/bff/model"
+	"github.com/MorhafAlshibly/coanda/pkg/graphqlEnums"
 )
 
 // Error is the resolver for the error field.
@@ -17,6 +18,16 @@ func (r *addEventResultResponseResolver) Error(ctx context.Context, obj *api.Add
 	return model.AddEventResultError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *cancelEventResponseResolver) Error(ctx context.Context, obj *api.CancelEventResponse) (model.CancelEventError, error) {
+	return model.CancelEventError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *createEventFromTemplateResponseResolver) Error(ctx context.Context, obj *api.CreateEventFromTemplateResponse) (model.CreateEventFromTemplateError, error) {
+	return model.CreateEventFromTemplateError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *createEventResponseResolver) Error(ctx context.Context, obj *api.CreateEventResponse) (model.CreateEventError, error) {
 	return model.CreateEventError(obj.Error.String()), nil
@@ -27,11 +38,65 @@ func (r *createEventRoundResponseResolver) Error(ctx context.Context, obj *api.C
 	return model.CreateEventRoundError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *createEventTemplateResponseResolver) Error(ctx context.Context, obj *api.CreateEventTemplateResponse) (model.CreateEventTemplateError, error) {
+	return model.CreateEventTemplateError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *disqualifyEventUserResponseResolver) Error(ctx context.Context, obj *api.DisqualifyEventUserResponse) (model.DisqualifyEventUserError, error) {
+	return model.DisqualifyEventUserError(obj.Error.String()), nil
+}
+
+// Status is the resolver for the status field.
+func (r *eventResolver) Status(ctx context.Context, obj *api.Event) (graphqlEnums.EventStatus, error) {
+	return graphqlEnums.EventStatus(obj.Status.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *eventResponseResolver) Error(ctx context.Context, obj *api.EventResponse) (model.EventError, error) {
 	return model.EventError(obj.Error.String()), nil
 }
 
+// ResultOrder is the resolver for the resultOrder field.
+func (r *eventRoundResolver) ResultOrder(ctx context.Context, obj *api.EventRound) (graphqlEnums.EventRoundResultOrder, error) {
+	return graphqlEnums.EventRoundResultOrder(obj.ResultOrder.String()), nil
+}
+
+// ResultAggregation is the resolver for the resultAggregation field.
+func (r *eventRoundResolver) ResultAggregation(ctx context.Context, obj *api.EventRound) (graphqlEnums.EventRoundResultAggregation, error) {
+	return graphqlEnums.EventRoundResultAggregation(obj.ResultAggregation.String()), nil
+}
+
+// Type is the resolver for the type field.
+func (r *eventRoundScoringFormulaResolver) Type(ctx context.Context, obj *api.EventRoundScoringFormula) (graphqlEnums.EventRoundScoringFormulaType, error) {
+	return graphqlEnums.EventRoundScoringFormulaType(obj.Type.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *eventTemplateResponseResolver) Error(ctx context.Context, obj *api.EventTemplateResponse) (model.EventTemplateError, error) {
+	return model.EventTemplateError(obj.Error.String()), nil
+}
+
+// ResultOrder is the resolver for the resultOrder field.
+func (r *eventTemplateRoundResolver) ResultOrder(ctx context.Context, obj *api.EventTemplateRound) (graphqlEnums.EventRoundResultOrder, error) {
+	return graphqlEnums.EventRoundResultOrder(obj.ResultOrder.String()), nil
+}
+
+// ResultAggregation is the resolver for the resultAggregation field.
+func (r *eventTemplateRoundResolver) ResultAggregation(ctx context.Context, obj *api.EventTemplateRound) (graphqlEnums.EventRoundResultAggregation, error) {
+	return graphqlEnums.EventRoundResultAggregation(obj.ResultAggregation.String()), nil
+}
+
+// Fields is the resolver for the fields field.
+func (r *eventThirdPartyDeliveryResolver) Fields(ctx context.Context, obj *api.EventThirdPartyDelivery) ([]graphqlEnums.EventThirdPartyDeliveryField, error) {
+	fields := make([]graphqlEnums.EventThirdPartyDeliveryField, len(obj.Fields))
+	for i, field := range obj.Fields {
+		fields[i] = graphqlEnums.EventThirdPartyDeliveryField(field.String())
+	}
+	return fields, nil
+}
+
 // Error is the resolver for the error field.
 func (r *eventUserResponseResolver) Error(ctx context.Context, obj *api.EventUserResponse) (model.EventUserError, error) {
 	return model.EventUserError(obj.Error.String()), nil
@@ -42,11 +107,21 @@ func (r *getEventResponseResolver) Error(ctx context.Context, obj *api.GetEventR
 	return model.GetEventError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *getEventRoundAttemptsResponseResolver) Error(ctx context.Context, obj *api.GetEventRoundAttemptsResponse) (model.GetEventRoundAttemptsError, error) {
+	return model.GetEventRoundAttemptsError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *getEventRoundResponseResolver) Error(ctx context.Context, obj *api.GetEventRoundResponse) (model.GetEventRoundError, error) {
 	return model.GetEventRoundError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *getEventTemplateResponseResolver) Error(ctx context.Context, obj *api.GetEventTemplateResponse) (model.GetEventTemplateError, error) {
+	return model.GetEventTemplateError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *getEventUserResponseResolver) Error(ctx context.Context, obj *api.GetEventUserResponse) (model.GetEventUserError, error) {
 	return model.GetEventUserError(obj.Error.String()), nil
@@ -67,6 +142,31 @@ func (r *mutationResolver) DeleteEvent(ctx context.Context, input *api.EventRequ
 	return r.eventClient.DeleteEvent(ctx, input)
 }
 
+// PauseEvent is the resolver for the PauseEvent field.
+func (r *mutationResolver) PauseEvent(ctx context.Context, input *api.EventRequest) (*api.PauseEventResponse, error) {
+	return r.eventClient.PauseEvent(ctx, input)
+}
+
+// ResumeEvent is the resolver for the ResumeEvent field.
+func (r *mutationResolver) ResumeEvent(ctx context.Context, input *api.EventRequest) (*api.ResumeEventResponse, error) {
+	return r.eventClient.ResumeEvent(ctx, input)
+}
+
+// CancelEvent is the resolver for the CancelEvent field.
+func (r *mutationResolver) CancelEvent(ctx context.Context, input *api.EventRequest) (*api.CancelEventResponse, error) {
+	return r.eventClient.CancelEvent(ctx, input)
+}
+
+// RegisterForEvent is the resolver for the RegisterForEvent field.
+func (r *mutationResolver) RegisterForEvent(ctx context.Context, input *api.RegisterForEventRequest) (*api.RegisterForEventResponse, error) {
+	return r.eventClient.RegisterForEvent(ctx, input)
+}
+
+// UnregisterFromEvent is the resolver for the UnregisterFromEvent field.
+func (r *mutationResolver) UnregisterFromEvent(ctx context.Context, input *api.EventUserRequest) (*api.UnregisterFromEventResponse, error) {
+	return r.eventClient.UnregisterFromEvent(ctx, input)
+}
+
 // CreateEventRound is the resolver for the CreateEventRound field.
 func (r *mutationResolver) CreateEventRound(ctx context.Context, input *api.CreateEventRoundRequest) (*api.CreateEventRoundResponse, error) {
 	return r.eventClient.CreateEventRound(ctx, input)
@@ -97,11 +197,51 @@ func (r *mutationResolver) RemoveEventResult(ctx context.Context, input *api.Eve
 	return r.eventClient.RemoveEventResult(ctx, input)
 }
 
+// DisqualifyEventUser is the resolver for the DisqualifyEventUser field.
+func (r *mutationResolver) DisqualifyEventUser(ctx context.Context, input *api.DisqualifyEventUserRequest) (*api.DisqualifyEventUserResponse, error) {
+	return r.eventClient.DisqualifyEventUser(ctx, input)
+}
+
+// ReinstateEventUser is the resolver for the ReinstateEventUser field.
+func (r *mutationResolver) ReinstateEventUser(ctx context.Context, input *api.ReinstateEventUserRequest) (*api.ReinstateEventUserResponse, error) {
+	return r.eventClient.ReinstateEventUser(ctx, input)
+}
+
+// SetEventThirdPartyDelivery is the resolver for the SetEventThirdPartyDelivery field.
+func (r *mutationResolver) SetEventThirdPartyDelivery(ctx context.Context, input *api.SetEventThirdPartyDeliveryRequest) (*api.SetEventThirdPartyDeliveryResponse, error) {
+	return r.eventClient.SetEventThirdPartyDelivery(ctx, input)
+}
+
+// CreateEventTemplate is the resolver for the CreateEventTemplate field.
+func (r *mutationResolver) CreateEventTemplate(ctx context.Context, input *api.CreateEventTemplateRequest) (*api.CreateEventTemplateResponse, error) {
+	return r.eventClient.CreateEventTemplate(ctx, input)
+}
+
+// DeleteEventTemplate is the resolver for the DeleteEventTemplate field.
+func (r *mutationResolver) DeleteEventTemplate(ctx context.Context, input *api.EventTemplateRequest) (*api.EventTemplateResponse, error) {
+	return r.eventClient.DeleteEventTemplate(ctx, input)
+}
+
+// CreateEventFromTemplate is the resolver for the CreateEventFromTemplate field.
+func (r *mutationResolver) CreateEventFromTemplate(ctx context.Context, input *api.CreateEventFromTemplateRequest) (*api.CreateEventFromTemplateResponse, error) {
+	return r.eventClient.CreateEventFromTemplate(ctx, input)
+}
+
+// Error is the resolver for the error field.
+func (r *pauseEventResponseResolver) Error(ctx context.Context, obj *api.PauseEventResponse) (model.PauseEventError, error) {
+	return model.PauseEventError(obj.Error.String()), nil
+}
+
 // GetEvent is the resolver for the GetEvent field.
 func (r *queryResolver) GetEvent(ctx context.Context, input *api.GetEventRequest) (*api.GetEventResponse, error) {
 	return r.eventClient.GetEvent(ctx, input)
 }
 
+// GetEvents is the resolver for the GetEvents field.
+func (r *queryResolver) GetEvents(ctx context.Context, input *api.GetEventsRequest) (*api.GetEventsResponse, error) {
+	return r.eventClient.GetEvents(ctx, input)
+}
+
 // GetEventRound is the resolver for the GetEventRound field.
 func (r *queryResolver) GetEventRound(ctx context.Context, input *api.GetEventRoundRequest) (*api.GetEventRoundResponse, error) {
 	return r.eventClient.GetEventRound(ctx, input)
@@ -112,11 +252,46 @@ func (r *queryResolver) GetEventUser(ctx context.Context, input *api.GetEventUse
 	return r.eventClient.GetEventUser(ctx, input)
 }
 
+// GetEventRoundAttempts is the resolver for the GetEventRoundAttempts field.
+func (r *queryResolver) GetEventRoundAttempts(ctx context.Context, input *api.GetEventRoundAttemptsRequest) (*api.GetEventRoundAttemptsResponse, error) {
+	return r.eventClient.GetEventRoundAttempts(ctx, input)
+}
+
+// GetEventTemplate is the resolver for the GetEventTemplate field.
+func (r *queryResolver) GetEventTemplate(ctx context.Context, input *api.EventTemplateRequest) (*api.GetEventTemplateResponse, error) {
+	return r.eventClient.GetEventTemplate(ctx, input)
+}
+
+// Error is the resolver for the error field.
+func (r *registerForEventResponseResolver) Error(ctx context.Context, obj *api.RegisterForEventResponse) (model.RegisterForEventError, error) {
+	return model.RegisterForEventError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *reinstateEventUserResponseResolver) Error(ctx context.Context, obj *api.ReinstateEventUserResponse) (model.ReinstateEventUserError, error) {
+	return model.ReinstateEventUserError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *removeEventResultResponseResolver) Error(ctx context.Context, obj *api.RemoveEventResultResponse) (model.RemoveEventResultError, error) {
 	return model.RemoveEventResultError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *resumeEventResponseResolver) Error(ctx context.Context, obj *api.ResumeEventResponse) (model.ResumeEventError, error) {
+	return model.ResumeEventError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *setEventThirdPartyDeliveryResponseResolver) Error(ctx context.Context, obj *api.SetEventThirdPartyDeliveryResponse) (model.SetEventThirdPartyDeliveryError, error) {
+	return model.SetEventThirdPartyDeliveryError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *unregisterFromEventResponseResolver) Error(ctx context.Context, obj *api.UnregisterFromEventResponse) (model.UnregisterFromEventError, error) {
+	return model.UnregisterFromEventError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *updateEventResponseResolver) Error(ctx context.Context, obj *api.UpdateEventResponse) (model.UpdateEventError, error) {
 	return model.UpdateEventError(obj.Error.String()), nil
@@ -132,11 +307,101 @@ func (r *updateEventUserResponseResolver) Error(ctx context.Context, obj *api.Up
 	return model.UpdateEventUserError(obj.Error.String()), nil
 }
 
+// ResultOrder is the resolver for the resultOrder field.
+func (r *createEventRoundResolver) ResultOrder(ctx context.Context, obj *api.CreateEventRound, data *graphqlEnums.EventRoundResultOrder) error {
+	if data == nil {
+		return nil
+	}
+	obj.ResultOrder = api.EventRoundResultOrder(api.EventRoundResultOrder_value[data.String()])
+	return nil
+}
+
+// ResultAggregation is the resolver for the resultAggregation field.
+func (r *createEventRoundResolver) ResultAggregation(ctx context.Context, obj *api.CreateEventRound, data *graphqlEnums.EventRoundResultAggregation) error {
+	if data == nil {
+		return nil
+	}
+	obj.ResultAggregation = api.EventRoundResultAggregation(api.EventRoundResultAggregation_value[data.String()])
+	return nil
+}
+
+// Type is the resolver for the type field.
+func (r *eventRoundScoringFormulaInputResolver) Type(ctx context.Context, obj *api.EventRoundScoringFormula, data graphqlEnums.EventRoundScoringFormulaType) error {
+	obj.Type = api.EventRoundScoringFormulaType(api.EventRoundScoringFormulaType_value[data.String()])
+	return nil
+}
+
+// ResultOrder is the resolver for the resultOrder field.
+func (r *eventTemplateRoundInputResolver) ResultOrder(ctx context.Context, obj *api.EventTemplateRound, data *graphqlEnums.EventRoundResultOrder) error {
+	if data == nil {
+		return nil
+	}
+	obj.ResultOrder = api.EventRoundResultOrder(api.EventRoundResultOrder_value[data.String()])
+	return nil
+}
+
+// ResultAggregation is the resolver for the resultAggregation field.
+func (r *eventTemplateRoundInputResolver) ResultAggregation(ctx context.Context, obj *api.EventTemplateRound, data *graphqlEnums.EventRoundResultAggregation) error {
+	if data == nil {
+		return nil
+	}
+	obj.ResultAggregation = api.EventRoundResultAggregation(api.EventRoundResultAggregation_value[data.String()])
+	return nil
+}
+
+// Fields is the resolver for the fields field.
+func (r *eventThirdPartyDeliveryInputResolver) Fields(ctx context.Context, obj *api.EventThirdPartyDelivery, data []graphqlEnums.EventThirdPartyDeliveryField) error {
+	fields := make([]api.EventThirdPartyDeliveryField, len(data))
+	for i, field := range data {
+		fields[i] = api.EventThirdPartyDeliveryField(api.EventThirdPartyDeliveryField_value[field.String()])
+	}
+	obj.Fields = fields
+	return nil
+}
+
+// Statuses is the resolver for the statuses field.
+func (r *getEventsRequestResolver) Statuses(ctx context.Context, obj *api.GetEventsRequest, data []*graphqlEnums.EventStatus) error {
+	statuses := make([]api.EventStatus, len(data))
+	for i, status := range data {
+		statuses[i] = api.EventStatus(api.EventStatus_value[status.String()])
+	}
+	obj.Statuses = statuses
+	return nil
+}
+
+// ResultOrder is the resolver for the resultOrder field.
+func (r *updateEventRoundRequestResolver) ResultOrder(ctx context.Context, obj *api.UpdateEventRoundRequest, data *graphqlEnums.EventRoundResultOrder) error {
+	if data == nil {
+		return nil
+	}
+	obj.ResultOrder = api.EventRoundResultOrder(api.EventRoundResultOrder_value[data.String()]).Enum()
+	return nil
+}
+
+// ResultAggregation is the resolver for the resultAggregation field.
+func (r *updateEventRoundRequestResolver) ResultAggregation(ctx context.Context, obj *api.UpdateEventRoundRequest, data *graphqlEnums.EventRoundResultAggregation) error {
+	if data == nil {
+		return nil
+	}
+	obj.ResultAggregation = api.EventRoundResultAggregation(api.EventRoundResultAggregation_value[data.String()]).Enum()
+	return nil
+}
+
 // AddEventResultResponse returns bff.AddEventResultResponseResolver implementation.
 func (r *Resolver) AddEventResultResponse() bff.AddEventResultResponseResolver {
 	return &addEventResultResponseResolver{r}
 }
 
+// CancelEventResponse returns bff.CancelEventResponseResolver implementation.
+func (r *Resolver) CancelEventResponse() bff.CancelEventResponseResolver {
+	return &cancelEventResponseResolver{r}
+}
+
+// CreateEventFromTemplateResponse returns bff.CreateEventFromTemplateResponseResolver implementation.
+func (r *Resolver) CreateEventFromTemplateResponse() bff.CreateEventFromTemplateResponseResolver {
+	return &createEventFromTemplateResponseResolver{r}
+}
+
 // CreateEventResponse returns bff.CreateEventResponseResolver implementation.
 func (r *Resolver) CreateEventResponse() bff.CreateEventResponseResolver {
 	return &createEventResponseResolver{r}
@@ -147,9 +412,45 @@ func (r *Resolver) CreateEventRoundResponse() bff.CreateEventRoundResponseResolv
 	return &createEventRoundResponseResolver{r}
 }
 
+// CreateEventTemplateResponse returns bff.CreateEventTemplateResponseResolver implementation.
+func (r *Resolver) CreateEventTemplateResponse() bff.CreateEventTemplateResponseResolver {
+	return &createEventTemplateResponseResolver{r}
+}
+
+// DisqualifyEventUserResponse returns bff.DisqualifyEventUserResponseResolver implementation.
+func (r *Resolver) DisqualifyEventUserResponse() bff.DisqualifyEventUserResponseResolver {
+	return &disqualifyEventUserResponseResolver{r}
+}
+
+// Event returns bff.EventResolver implementation.
+func (r *Resolver) Event() bff.EventResolver { return &eventResolver{r} }
+
 // EventResponse returns bff.EventResponseResolver implementation.
 func (r *Resolver) EventResponse() bff.EventResponseResolver { return &eventResponseResolver{r} }
 
+// EventRound returns bff.EventRoundResolver implementation.
+func (r *Resolver) EventRound() bff.EventRoundResolver { return &eventRoundResolver{r} }
+
+// EventRoundScoringFormula returns bff.EventRoundScoringFormulaResolver implementation.
+func (r *Resolver) EventRoundScoringFormula() bff.EventRoundScoringFormulaResolver {
+	return &eventRoundScoringFormulaResolver{r}
+}
+
+// EventTemplateResponse returns bff.EventTemplateResponseResolver implementation.
+func (r *Resolver) EventTemplateResponse() bff.EventTemplateResponseResolver {
+	return &eventTemplateResponseResolver{r}
+}
+
+// EventTemplateRound returns bff.EventTemplateRoundResolver implementation.
+func (r *Resolver) EventTemplateRound() bff.EventTemplateRoundResolver {
+	return &eventTemplateRoundResolver{r}
+}
+
+// EventThirdPartyDelivery returns bff.EventThirdPartyDeliveryResolver implementation.
+func (r *Resolver) EventThirdPartyDelivery() bff.EventThirdPartyDeliveryResolver {
+	return &eventThirdPartyDeliveryResolver{r}
+}
+
 // EventUserResponse returns bff.EventUserResponseResolver implementation.
 func (r *Resolver) EventUserResponse() bff.EventUserResponseResolver {
 	return &eventUserResponseResolver{r}
@@ -160,21 +461,61 @@ func (r *Resolver) GetEventResponse() bff.GetEventResponseResolver {
 	return &getEventResponseResolver{r}
 }
 
+// GetEventRoundAttemptsResponse returns bff.GetEventRoundAttemptsResponseResolver implementation.
+func (r *Resolver) GetEventRoundAttemptsResponse() bff.GetEventRoundAttemptsResponseResolver {
+	return &getEventRoundAttemptsResponseResolver{r}
+}
+
 // GetEventRoundResponse returns bff.GetEventRoundResponseResolver implementation.
 func (r *Resolver) GetEventRoundResponse() bff.GetEventRoundResponseResolver {
 	return &getEventRoundResponseResolver{r}
 }
 
+// GetEventTemplateResponse returns bff.GetEventTemplateResponseResolver implementation.
+func (r *Resolver) GetEventTemplateResponse() bff.GetEventTemplateResponseResolver {
+	return &getEventTemplateResponseResolver{r}
+}
+
 // GetEventUserResponse returns bff.GetEventUserResponseResolver implementation.
 func (r *Resolver) GetEventUserResponse() bff.GetEventUserResponseResolver {
 	return &getEventUserResponseResolver{r}
 }
 
+// PauseEventResponse returns bff.PauseEventResponseResolver implementation.
+func (r *Resolver) PauseEventResponse() bff.PauseEventResponseResolver {
+	return &pauseEventResponseResolver{r}
+}
+
+// RegisterForEventResponse returns bff.RegisterForEventResponseResolver implementation.
+func (r *Resolver) RegisterForEventResponse() bff.RegisterForEventResponseResolver {
+	return &registerForEventResponseResolver{r}
+}
+
+// ReinstateEventUserResponse returns bff.ReinstateEventUserResponseResolver implementation.
+func (r *Resolver) ReinstateEventUserResponse() bff.ReinstateEventUserResponseResolver {
+	return &reinstateEventUserResponseResolver{r}
+}
+
 // RemoveEventResultResponse returns bff.RemoveEventResultResponseResolver implementation.
 func (r *Resolver) RemoveEventResultResponse() bff.RemoveEventResultResponseResolver {
 	return &removeEventResultResponseResolver{r}
 }
 
+// ResumeEventResponse returns bff.ResumeEventResponseResolver implementation.
+func (r *Resolver) ResumeEventResponse() bff.ResumeEventResponseResolver {
+	return &resumeEventResponseResolver{r}
+}
+
+// SetEventThirdPartyDeliveryResponse returns bff.SetEventThirdPartyDeliveryResponseResolver implementation.
+func (r *Resolver) SetEventThirdPartyDeliveryResponse() bff.SetEventThirdPartyDeliveryResponseResolver {
+	return &setEventThirdPartyDeliveryResponseResolver{r}
+}
+
+// UnregisterFromEventResponse returns bff.UnregisterFromEventResponseResolver implementation.
+func (r *Resolver) UnregisterFromEventResponse() bff.UnregisterFromEventResponseResolver {
+	return &unregisterFromEventResponseResolver{r}
+}
+
 // UpdateEventResponse returns bff.UpdateEventResponseResolver implementation.
 func (r *Resolver) UpdateEventResponse() bff.UpdateEventResponseResolver {
 	return &updateEventResponseResolver{r}
@@ -190,15 +531,69 @@ func (r *Resolver) UpdateEventUserResponse() bff.UpdateEventUserResponseResolver
 	return &updateEventUserResponseResolver{r}
 }
 
+// CreateEventRound returns bff.CreateEventRoundResolver implementation.
+func (r *Resolver) CreateEventRound() bff.CreateEventRoundResolver {
+	return &createEventRoundResolver{r}
+}
+
+// EventRoundScoringFormulaInput returns bff.EventRoundScoringFormulaInputResolver implementation.
+func (r *Resolver) EventRoundScoringFormulaInput() bff.EventRoundScoringFormulaInputResolver {
+	return &eventRoundScoringFormulaInputResolver{r}
+}
+
+// EventTemplateRoundInput returns bff.EventTemplateRoundInputResolver implementation.
+func (r *Resolver) EventTemplateRoundInput() bff.EventTemplateRoundInputResolver {
+	return &eventTemplateRoundInputResolver{r}
+}
+
+// EventThirdPartyDeliveryInput returns bff.EventThirdPartyDeliveryInputResolver implementation.
+func (r *Resolver) EventThirdPartyDeliveryInput() bff.EventThirdPartyDeliveryInputResolver {
+	return &eventThirdPartyDeliveryInputResolver{r}
+}
+
+// GetEventsRequest returns bff.GetEventsRequestResolver implementation.
+func (r *Resolver) GetEventsRequest() bff.GetEventsRequestResolver {
+	return &getEventsRequestResolver{r}
+}
+
+// UpdateEventRoundRequest returns bff.UpdateEventRoundRequestResolver implementation.
+func (r *Resolver) UpdateEventRoundRequest() bff.UpdateEventRoundRequestResolver {
+	return &updateEventRoundRequestResolver{r}
+}
+
 type addEventResultResponseResolver struct{ *Resolver }
+type cancelEventResponseResolver struct{ *Resolver }
+type createEventFromTemplateResponseResolver struct{ *Resolver }
 type createEventResponseResolver struct{ *Resolver }
 type createEventRoundResponseResolver struct{ *Resolver }
+type createEventTemplateResponseResolver struct{ *Resolver }
+type disqualifyEventUserResponseResolver struct{ *Resolver }
+type eventResolver struct{ *Resolver }
 type eventResponseResolver struct{ *Resolver }
+type eventRoundResolver struct{ *Resolver }
+type eventRoundScoringFormulaResolver struct{ *Resolver }
+type eventTemplateResponseResolver struct{ *Resolver }
+type eventTemplateRoundResolver struct{ *Resolver }
+type eventThirdPartyDeliveryResolver struct{ *Resolver }
 type eventUserResponseResolver struct{ *Resolver }
 type getEventResponseResolver struct{ *Resolver }
+type getEventRoundAttemptsResponseResolver struct{ *Resolver }
 type getEventRoundResponseResolver struct{ *Resolver }
+type getEventTemplateResponseResolver struct{ *Resolver }
 type getEventUserResponseResolver struct{ *Resolver }
+type pauseEventResponseResolver struct{ *Resolver }
+type registerForEventResponseResolver struct{ *Resolver }
+type reinstateEventUserResponseResolver struct{ *Resolver }
 type removeEventResultResponseResolver struct{ *Resolver }
+type resumeEventResponseResolver struct{ *Resolver }
+type setEventThirdPartyDeliveryResponseResolver struct{ *Resolver }
+type unregisterFromEventResponseResolver struct{ *Resolver }
 type updateEventResponseResolver struct{ *Resolver }
 type updateEventRoundResponseResolver struct{ *Resolver }
 type updateEventUserResponseResolver struct{ *Resolver }
+type createEventRoundResolver struct{ *Resolver }
+type eventRoundScoringFormulaInputResolver struct{ *Resolver }
+type eventTemplateRoundInputResolver struct{ *Resolver }
+type eventThirdPartyDeliveryInputResolver struct{ *Resolver }
+type getEventsRequestResolver struct{ *Resolver }
+type updateEventRoundRequestResolver struct{ *Resolver }
@@ -17,6 +17,11 @@ func (r *createArenaResponseResolver) Error(ctx context.Context, obj *api.Create
 	return model.CreateArenaError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *createMatchResponseResolver) Error(ctx context.Context, obj *api.CreateMatchResponse) (model.CreateMatchError, error) {
+	return model.CreateMatchError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *createMatchmakingTicketResponseResolver) Error(ctx context.Context, obj *api.CreateMatchmakingTicketResponse) (model.CreateMatchmakingTicketError, error) {
 	return model.CreateMatchmakingTicketError(obj.Error.String()), nil
@@ -122,6 +127,11 @@ func (r *mutationResolver) DeleteMatchmakingTicket(ctx context.Context, input *a
 	return r.matchmakingClient.DeleteMatchmakingTicket(ctx, input)
 }
 
+// CreateMatch is the resolver for the CreateMatch field.
+func (r *mutationResolver) CreateMatch(ctx context.Context, input *api.CreateMatchRequest) (*api.CreateMatchResponse, error) {
+	return r.matchmakingClient.CreateMatch(ctx, input)
+}
+
 // StartMatch is the resolver for the StartMatch field.
 func (r *mutationResolver) StartMatch(ctx context.Context, input *api.StartMatchRequest) (*api.StartMatchResponse, error) {
 	return r.matchmakingClient.StartMatch(ctx, input)
@@ -242,6 +252,11 @@ func (r *Resolver) CreateArenaResponse() bff.CreateArenaResponseResolver {
 	return &createArenaResponseResolver{r}
 }
 
+// CreateMatchResponse returns bff.CreateMatchResponseResolver implementation.
+func (r *Resolver) CreateMatchResponse() bff.CreateMatchResponseResolver {
+	return &createMatchResponseResolver{r}
+}
+
 // CreateMatchmakingTicketResponse returns bff.CreateMatchmakingTicketResponseResolver implementation.
 func (r *Resolver) CreateMatchmakingTicketResponse() bff.CreateMatchmakingTicketResponseResolver {
 	return &createMatchmakingTicketResponseResolver{r}
@@ -346,6 +361,7 @@ func (r *Resolver) GetMatchmakingTicketsRequest() bff.GetMatchmakingTicketsReque
 }
 
 type createArenaResponseResolver struct{ *Resolver }
+type createMatchResponseResolver struct{ *Resolver }
 type createMatchmakingTicketResponseResolver struct{ *Resolver }
 type createMatchmakingUserResponseResolver struct{ *Resolver }
 type deleteMatchResponseResolver struct{ *Resolver }
@@ -22,11 +22,26 @@ func (r *getTeamMemberResponseResolver) Error(ctx context.Context, obj *api.GetT
 	return model.GetTeamMemberError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *getTeamMessagesResponseResolver) Error(ctx context.Context, obj *api.GetTeamMessagesResponse) (model.GetTeamMessagesError, error) {
+	return model.GetTeamMessagesError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *getTeamResponseResolver) Error(ctx context.Context, obj *api.GetTeamResponse) (model.GetTeamError, error) {
 	return model.GetTeamError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *getTeamSeasonHistoryResponseResolver) Error(ctx context.Context, obj *api.GetTeamSeasonHistoryResponse) (model.GetTeamSeasonHistoryError, error) {
+	return model.GetTeamSeasonHistoryError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *getTeamsResponseResolver) Error(ctx context.Context, obj *api.GetTeamsResponse) (model.GetTeamsError, error) {
+	return model.GetTeamsError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *joinTeamResponseResolver) Error(ctx context.Context, obj *api.JoinTeamResponse) (model.JoinTeamError, error) {
 	return model.JoinTeamError(obj.Error.String()), nil
@@ -52,6 +67,11 @@ func (r *mutationResolver) DeleteTeam(ctx context.Context, input *api.TeamReques
 	return r.teamClient.DeleteTeam(ctx, input)
 }
 
+// RestoreTeam is the resolver for the RestoreTeam field.
+func (r *mutationResolver) RestoreTeam(ctx context.Context, input *api.TeamRequest) (*api.TeamResponse, error) {
+	return r.teamClient.RestoreTeam(ctx, input)
+}
+
 // JoinTeam is the resolver for the JoinTeam field.
 func (r *mutationResolver) JoinTeam(ctx context.Context, input *api.JoinTeamRequest) (*api.JoinTeamResponse, error) {
 	return r.teamClient.JoinTeam(ctx, input)
@@ -67,6 +87,31 @@ func (r *mutationResolver) UpdateTeamMember(ctx context.Context, input *api.Upda
 	return r.teamClient.UpdateTeamMember(ctx, input)
 }
 
+// PostTeamMessage is the resolver for the PostTeamMessage field.
+func (r *mutationResolver) PostTeamMessage(ctx context.Context, input *api.PostTeamMessageRequest) (*api.PostTeamMessageResponse, error) {
+	return r.teamClient.PostTeamMessage(ctx, input)
+}
+
+// UpdateTeamMessage is the resolver for the UpdateTeamMessage field.
+func (r *mutationResolver) UpdateTeamMessage(ctx context.Context, input *api.UpdateTeamMessageRequest) (*api.UpdateTeamMessageResponse, error) {
+	return r.teamClient.UpdateTeamMessage(ctx, input)
+}
+
+// DeleteTeamMessage is the resolver for the DeleteTeamMessage field.
+func (r *mutationResolver) DeleteTeamMessage(ctx context.Context, input *api.DeleteTeamMessageRequest) (*api.TeamMessageResponse, error) {
+	return r.teamClient.DeleteTeamMessage(ctx, input)
+}
+
+// ResetTeamScores is the resolver for the ResetTeamScores field.
+func (r *mutationResolver) ResetTeamScores(ctx context.Context, input *api.ResetTeamScoresRequest) (*api.ResetTeamScoresResponse, error) {
+	return r.teamClient.ResetTeamScores(ctx, input)
+}
+
+// Error is the resolver for the error field.
+func (r *postTeamMessageResponseResolver) Error(ctx context.Context, obj *api.PostTeamMessageResponse) (model.PostTeamMessageError, error) {
+	return model.PostTeamMessageError(obj.Error.String()), nil
+}
+
 // GetTeam is the resolver for the GetTeam field.
 func (r *queryResolver) GetTeam(ctx context.Context, input *api.GetTeamRequest) (*api.GetTeamResponse, error) {
 	return r.teamClient.GetTeam(ctx, input)
@@ -87,11 +132,31 @@ func (r *queryResolver) SearchTeams(ctx context.Context, input *api.SearchTeamsR
 	return r.teamClient.SearchTeams(ctx, input)
 }
 
+// GetTeamMessages is the resolver for the GetTeamMessages field.
+func (r *queryResolver) GetTeamMessages(ctx context.Context, input *api.GetTeamMessagesRequest) (*api.GetTeamMessagesResponse, error) {
+	return r.teamClient.GetTeamMessages(ctx, input)
+}
+
+// GetTeamSeasonHistory is the resolver for the GetTeamSeasonHistory field.
+func (r *queryResolver) GetTeamSeasonHistory(ctx context.Context, input *api.GetTeamSeasonHistoryRequest) (*api.GetTeamSeasonHistoryResponse, error) {
+	return r.teamClient.GetTeamSeasonHistory(ctx, input)
+}
+
+// Error is the resolver for the error field.
+func (r *resetTeamScoresResponseResolver) Error(ctx context.Context, obj *api.ResetTeamScoresResponse) (model.ResetTeamScoresError, error) {
+	return model.ResetTeamScoresError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *searchTeamsResponseResolver) Error(ctx context.Context, obj *api.SearchTeamsResponse) (model.SearchTeamsError, error) {
 	return model.SearchTeamsError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *teamMessageResponseResolver) Error(ctx context.Context, obj *api.TeamMessageResponse) (model.TeamMessageError, error) {
+	return model.TeamMessageError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *teamResponseResolver) Error(ctx context.Context, obj *api.TeamResponse) (model.TeamError, error) {
 	return model.TeamError(obj.Error.String()), nil
@@ -102,6 +167,11 @@ func (r *updateTeamMemberResponseResolver) Error(ctx context.Context, obj *api.U
 	return model.UpdateTeamMemberError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *updateTeamMessageResponseResolver) Error(ctx context.Context, obj *api.UpdateTeamMessageResponse) (model.UpdateTeamMessageError, error) {
+	return model.UpdateTeamMessageError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *updateTeamResponseResolver) Error(ctx context.Context, obj *api.UpdateTeamResponse) (model.UpdateTeamError, error) {
 	return model.UpdateTeamError(obj.Error.String()), nil
@@ -117,9 +187,24 @@ func (r *Resolver) GetTeamMemberResponse() bff.GetTeamMemberResponseResolver {
 	return &getTeamMemberResponseResolver{r}
 }
 
+// GetTeamMessagesResponse returns bff.GetTeamMessagesResponseResolver implementation.
+func (r *Resolver) GetTeamMessagesResponse() bff.GetTeamMessagesResponseResolver {
+	return &getTeamMessagesResponseResolver{r}
+}
+
 // GetTeamResponse returns bff.GetTeamResponseResolver implementation.
 func (r *Resolver) GetTeamResponse() bff.GetTeamResponseResolver { return &getTeamResponseResolver{r} }
 
+// GetTeamSeasonHistoryResponse returns bff.GetTeamSeasonHistoryResponseResolver implementation.
+func (r *Resolver) GetTeamSeasonHistoryResponse() bff.GetTeamSeasonHistoryResponseResolver {
+	return &getTeamSeasonHistoryResponseResolver{r}
+}
+
+// GetTeamsResponse returns bff.GetTeamsResponseResolver implementation.
+func (r *Resolver) GetTeamsResponse() bff.GetTeamsResponseResolver {
+	return &getTeamsResponseResolver{r}
+}
+
 // JoinTeamResponse returns bff.JoinTeamResponseResolver implementation.
 func (r *Resolver) JoinTeamResponse() bff.JoinTeamResponseResolver {
 	return &joinTeamResponseResolver{r}
@@ -130,11 +215,26 @@ func (r *Resolver) LeaveTeamResponse() bff.LeaveTeamResponseResolver {
 	return &leaveTeamResponseResolver{r}
 }
 
+// PostTeamMessageResponse returns bff.PostTeamMessageResponseResolver implementation.
+func (r *Resolver) PostTeamMessageResponse() bff.PostTeamMessageResponseResolver {
+	return &postTeamMessageResponseResolver{r}
+}
+
+// ResetTeamScoresResponse returns bff.ResetTeamScoresResponseResolver implementation.
+func (r *Resolver) ResetTeamScoresResponse() bff.ResetTeamScoresResponseResolver {
+	return &resetTeamScoresResponseResolver{r}
+}
+
 // SearchTeamsResponse returns bff.SearchTeamsResponseResolver implementation.
 func (r *Resolver) SearchTeamsResponse() bff.SearchTeamsResponseResolver {
 	return &searchTeamsResponseResolver{r}
 }
 
+// TeamMessageResponse returns bff.TeamMessageResponseResolver implementation.
+func (r *Resolver) TeamMessageResponse() bff.TeamMessageResponseResolver {
+	return &teamMessageResponseResolver{r}
+}
+
 // TeamResponse returns bff.TeamResponseResolver implementation.
 func (r *Resolver) TeamResponse() bff.TeamResponseResolver { return &teamResponseResolver{r} }
 
@@ -143,6 +243,11 @@ func (r *Resolver) UpdateTeamMemberResponse() bff.UpdateTeamMemberResponseResolv
 	return &updateTeamMemberResponseResolver{r}
 }
 
+// UpdateTeamMessageResponse returns bff.UpdateTeamMessageResponseResolver implementation.
+func (r *Resolver) UpdateTeamMessageResponse() bff.UpdateTeamMessageResponseResolver {
+	return &updateTeamMessageResponseResolver{r}
+}
+
 // UpdateTeamResponse returns bff.UpdateTeamResponseResolver implementation.
 func (r *Resolver) UpdateTeamResponse() bff.UpdateTeamResponseResolver {
 	return &updateTeamResponseResolver{r}
@@ -150,10 +255,17 @@ func (r *Resolver) UpdateTeamResponse() bff.UpdateTeamResponseResolver {
 
 type createTeamResponseResolver struct{ *Resolver }
 type getTeamMemberResponseResolver struct{ *Resolver }
+type getTeamMessagesResponseResolver struct{ *Resolver }
 type getTeamResponseResolver struct{ *Resolver }
+type getTeamSeasonHistoryResponseResolver struct{ *Resolver }
+type getTeamsResponseResolver struct{ *Resolver }
 type joinTeamResponseResolver struct{ *Resolver }
 type leaveTeamResponseResolver struct{ *Resolver }
+type postTeamMessageResponseResolver struct{ *Resolver }
+type resetTeamScoresResponseResolver struct{ *Resolver }
 type searchTeamsResponseResolver struct{ *Resolver }
+type teamMessageResponseResolver struct{ *Resolver }
 type teamResponseResolver struct{ *Resolver }
 type updateTeamMemberResponseResolver struct{ *Resolver }
+type updateTeamMessageResponseResolver struct{ *Resolver }
 type updateTeamResponseResolver struct{ *Resolver }
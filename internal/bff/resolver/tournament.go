@@ -13,11 +13,86 @@ import (
 	"github.com/MorhafAlshibly/coanda/pkg/graphqlEnums"
 )
 
+// Error is the resolver for the error field.
+func (r *claimTournamentRewardResponseResolver) Error(ctx context.Context, obj *api.ClaimTournamentRewardResponse) (model.ClaimTournamentRewardError, error) {
+	return model.ClaimTournamentRewardError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *createTournamentBracketResponseResolver) Error(ctx context.Context, obj *api.CreateTournamentBracketResponse) (model.CreateTournamentBracketError, error) {
+	return model.CreateTournamentBracketError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *createTournamentDefinitionResponseResolver) Error(ctx context.Context, obj *api.CreateTournamentDefinitionResponse) (model.CreateTournamentDefinitionError, error) {
+	return model.CreateTournamentDefinitionError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *createTournamentRewardTierResponseResolver) Error(ctx context.Context, obj *api.CreateTournamentRewardTierResponse) (model.CreateTournamentRewardTierError, error) {
+	return model.CreateTournamentRewardTierError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *createTournamentRoundResponseResolver) Error(ctx context.Context, obj *api.CreateTournamentRoundResponse) (model.CreateTournamentRoundError, error) {
+	return model.CreateTournamentRoundError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *createTournamentTeamResponseResolver) Error(ctx context.Context, obj *api.CreateTournamentTeamResponse) (model.CreateTournamentTeamError, error) {
+	return model.CreateTournamentTeamError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *createTournamentUserResponseResolver) Error(ctx context.Context, obj *api.CreateTournamentUserResponse) (model.CreateTournamentUserError, error) {
 	return model.CreateTournamentUserError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *getTournamentBracketResponseResolver) Error(ctx context.Context, obj *api.GetTournamentBracketResponse) (model.GetTournamentBracketError, error) {
+	return model.GetTournamentBracketError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *getTournamentDefinitionResponseResolver) Error(ctx context.Context, obj *api.GetTournamentDefinitionResponse) (model.GetTournamentDefinitionError, error) {
+	return model.GetTournamentDefinitionError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *getTournamentPairingsResponseResolver) Error(ctx context.Context, obj *api.GetTournamentPairingsResponse) (model.GetTournamentPairingsError, error) {
+	return model.GetTournamentPairingsError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *getTournamentRewardTiersResponseResolver) Error(ctx context.Context, obj *api.GetTournamentRewardTiersResponse) (model.GetTournamentRewardTiersError, error) {
+	return model.GetTournamentRewardTiersError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *getTournamentRewardsResponseResolver) Error(ctx context.Context, obj *api.GetTournamentRewardsResponse) (model.GetTournamentRewardsError, error) {
+	return model.GetTournamentRewardsError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *getTournamentStandingsResponseResolver) Error(ctx context.Context, obj *api.GetTournamentStandingsResponse) (model.GetTournamentStandingsError, error) {
+	return model.GetTournamentStandingsError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *getTournamentTeamResponseResolver) Error(ctx context.Context, obj *api.GetTournamentTeamResponse) (model.GetTournamentTeamError, error) {
+	return model.GetTournamentTeamError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *getTournamentTeamsResponseResolver) Error(ctx context.Context, obj *api.GetTournamentTeamsResponse) (model.GetTournamentTeamsError, error) {
+	return model.GetTournamentTeamsError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *getTournamentUserHistoryResponseResolver) Error(ctx context.Context, obj *api.GetTournamentUserHistoryResponse) (model.GetTournamentUserHistoryError, error) {
+	return model.GetTournamentUserHistoryError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *getTournamentUserResponseResolver) Error(ctx context.Context, obj *api.GetTournamentUserResponse) (model.GetTournamentUserError, error) {
 	return model.GetTournamentUserError(obj.Error.String()), nil
@@ -28,6 +103,11 @@ func (r *getTournamentUsersResponseResolver) Error(ctx context.Context, obj *api
 	return model.GetTournamentUsersError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *getTournamentWipeTimeResponseResolver) Error(ctx context.Context, obj *api.GetTournamentWipeTimeResponse) (model.GetTournamentWipeTimeError, error) {
+	return model.GetTournamentWipeTimeError(obj.Error.String()), nil
+}
+
 // CreateTournamentUser is the resolver for the CreateTournamentUser field.
 func (r *mutationResolver) CreateTournamentUser(ctx context.Context, input *api.CreateTournamentUserRequest) (*api.CreateTournamentUserResponse, error) {
 	return r.tournamentClient.CreateTournamentUser(ctx, input)
@@ -43,6 +123,86 @@ func (r *mutationResolver) DeleteTournamentUser(ctx context.Context, input *api.
 	return r.tournamentClient.DeleteTournamentUser(ctx, input)
 }
 
+// SubmitTournamentScore is the resolver for the SubmitTournamentScore field.
+func (r *mutationResolver) SubmitTournamentScore(ctx context.Context, input *api.SubmitTournamentScoreRequest) (*api.SubmitTournamentScoreResponse, error) {
+	return r.tournamentClient.SubmitTournamentScore(ctx, input)
+}
+
+// CreateTournamentTeam is the resolver for the CreateTournamentTeam field.
+func (r *mutationResolver) CreateTournamentTeam(ctx context.Context, input *api.CreateTournamentTeamRequest) (*api.CreateTournamentTeamResponse, error) {
+	return r.tournamentClient.CreateTournamentTeam(ctx, input)
+}
+
+// UpdateTournamentTeam is the resolver for the UpdateTournamentTeam field.
+func (r *mutationResolver) UpdateTournamentTeam(ctx context.Context, input *api.UpdateTournamentTeamRequest) (*api.UpdateTournamentTeamResponse, error) {
+	return r.tournamentClient.UpdateTournamentTeam(ctx, input)
+}
+
+// DeleteTournamentTeam is the resolver for the DeleteTournamentTeam field.
+func (r *mutationResolver) DeleteTournamentTeam(ctx context.Context, input *api.TournamentTeamRequest) (*api.TournamentTeamResponse, error) {
+	return r.tournamentClient.DeleteTournamentTeam(ctx, input)
+}
+
+// CreateTournamentDefinition is the resolver for the CreateTournamentDefinition field.
+func (r *mutationResolver) CreateTournamentDefinition(ctx context.Context, input *api.CreateTournamentDefinitionRequest) (*api.CreateTournamentDefinitionResponse, error) {
+	return r.tournamentClient.CreateTournamentDefinition(ctx, input)
+}
+
+// DeleteTournamentDefinition is the resolver for the DeleteTournamentDefinition field.
+func (r *mutationResolver) DeleteTournamentDefinition(ctx context.Context, input *api.TournamentDefinitionRequest) (*api.TournamentDefinitionResponse, error) {
+	return r.tournamentClient.DeleteTournamentDefinition(ctx, input)
+}
+
+// SetTournamentWipeTime is the resolver for the SetTournamentWipeTime field.
+func (r *mutationResolver) SetTournamentWipeTime(ctx context.Context, input *api.SetTournamentWipeTimeRequest) (*api.SetTournamentWipeTimeResponse, error) {
+	return r.tournamentClient.SetTournamentWipeTime(ctx, input)
+}
+
+// DeleteTournamentWipeTime is the resolver for the DeleteTournamentWipeTime field.
+func (r *mutationResolver) DeleteTournamentWipeTime(ctx context.Context, input *api.TournamentWipeTimeRequest) (*api.TournamentWipeTimeResponse, error) {
+	return r.tournamentClient.DeleteTournamentWipeTime(ctx, input)
+}
+
+// CreateTournamentRewardTier is the resolver for the CreateTournamentRewardTier field.
+func (r *mutationResolver) CreateTournamentRewardTier(ctx context.Context, input *api.CreateTournamentRewardTierRequest) (*api.CreateTournamentRewardTierResponse, error) {
+	return r.tournamentClient.CreateTournamentRewardTier(ctx, input)
+}
+
+// DeleteTournamentRewardTier is the resolver for the DeleteTournamentRewardTier field.
+func (r *mutationResolver) DeleteTournamentRewardTier(ctx context.Context, input *api.TournamentRewardTierRequest) (*api.TournamentRewardTierResponse, error) {
+	return r.tournamentClient.DeleteTournamentRewardTier(ctx, input)
+}
+
+// ClaimTournamentReward is the resolver for the ClaimTournamentReward field.
+func (r *mutationResolver) ClaimTournamentReward(ctx context.Context, input *api.ClaimTournamentRewardRequest) (*api.ClaimTournamentRewardResponse, error) {
+	return r.tournamentClient.ClaimTournamentReward(ctx, input)
+}
+
+// CreateTournamentBracket is the resolver for the CreateTournamentBracket field.
+func (r *mutationResolver) CreateTournamentBracket(ctx context.Context, input *api.CreateTournamentBracketRequest) (*api.CreateTournamentBracketResponse, error) {
+	return r.tournamentClient.CreateTournamentBracket(ctx, input)
+}
+
+// ReportTournamentBracketMatchResult is the resolver for the ReportTournamentBracketMatchResult field.
+func (r *mutationResolver) ReportTournamentBracketMatchResult(ctx context.Context, input *api.ReportTournamentBracketMatchResultRequest) (*api.ReportTournamentBracketMatchResultResponse, error) {
+	return r.tournamentClient.ReportTournamentBracketMatchResult(ctx, input)
+}
+
+// DeleteTournamentBracket is the resolver for the DeleteTournamentBracket field.
+func (r *mutationResolver) DeleteTournamentBracket(ctx context.Context, input *api.TournamentBracketRequest) (*api.TournamentBracketResponse, error) {
+	return r.tournamentClient.DeleteTournamentBracket(ctx, input)
+}
+
+// CreateTournamentRound is the resolver for the CreateTournamentRound field.
+func (r *mutationResolver) CreateTournamentRound(ctx context.Context, input *api.CreateTournamentRoundRequest) (*api.CreateTournamentRoundResponse, error) {
+	return r.tournamentClient.CreateTournamentRound(ctx, input)
+}
+
+// RecordTournamentPairingResult is the resolver for the RecordTournamentPairingResult field.
+func (r *mutationResolver) RecordTournamentPairingResult(ctx context.Context, input *api.RecordTournamentPairingResultRequest) (*api.RecordTournamentPairingResultResponse, error) {
+	return r.tournamentClient.RecordTournamentPairingResult(ctx, input)
+}
+
 // GetTournamentUser is the resolver for the GetTournamentUser field.
 func (r *queryResolver) GetTournamentUser(ctx context.Context, input *api.TournamentUserRequest) (*api.GetTournamentUserResponse, error) {
 	return r.tournamentClient.GetTournamentUser(ctx, input)
@@ -53,6 +213,140 @@ func (r *queryResolver) GetTournamentUsers(ctx context.Context, input *api.GetTo
 	return r.tournamentClient.GetTournamentUsers(ctx, input)
 }
 
+// GetTournamentUserHistory is the resolver for the GetTournamentUserHistory field.
+func (r *queryResolver) GetTournamentUserHistory(ctx context.Context, input *api.GetTournamentUserHistoryRequest) (*api.GetTournamentUserHistoryResponse, error) {
+	return r.tournamentClient.GetTournamentUserHistory(ctx, input)
+}
+
+// GetTournamentTeam is the resolver for the GetTournamentTeam field.
+func (r *queryResolver) GetTournamentTeam(ctx context.Context, input *api.TournamentTeamRequest) (*api.GetTournamentTeamResponse, error) {
+	return r.tournamentClient.GetTournamentTeam(ctx, input)
+}
+
+// GetTournamentTeams is the resolver for the GetTournamentTeams field.
+func (r *queryResolver) GetTournamentTeams(ctx context.Context, input *api.GetTournamentTeamsRequest) (*api.GetTournamentTeamsResponse, error) {
+	return r.tournamentClient.GetTournamentTeams(ctx, input)
+}
+
+// GetTournamentDefinition is the resolver for the GetTournamentDefinition field.
+func (r *queryResolver) GetTournamentDefinition(ctx context.Context, input *api.TournamentDefinitionRequest) (*api.GetTournamentDefinitionResponse, error) {
+	return r.tournamentClient.GetTournamentDefinition(ctx, input)
+}
+
+// GetTournamentWipeTime is the resolver for the GetTournamentWipeTime field.
+func (r *queryResolver) GetTournamentWipeTime(ctx context.Context, input *api.TournamentWipeTimeRequest) (*api.GetTournamentWipeTimeResponse, error) {
+	return r.tournamentClient.GetTournamentWipeTime(ctx, input)
+}
+
+// GetTournamentRewardTiers is the resolver for the GetTournamentRewardTiers field.
+func (r *queryResolver) GetTournamentRewardTiers(ctx context.Context, input *api.GetTournamentRewardTiersRequest) (*api.GetTournamentRewardTiersResponse, error) {
+	return r.tournamentClient.GetTournamentRewardTiers(ctx, input)
+}
+
+// GetTournamentRewards is the resolver for the GetTournamentRewards field.
+func (r *queryResolver) GetTournamentRewards(ctx context.Context, input *api.GetTournamentRewardsRequest) (*api.GetTournamentRewardsResponse, error) {
+	return r.tournamentClient.GetTournamentRewards(ctx, input)
+}
+
+// GetTournamentBracket is the resolver for the GetTournamentBracket field.
+func (r *queryResolver) GetTournamentBracket(ctx context.Context, input *api.TournamentBracketRequest) (*api.GetTournamentBracketResponse, error) {
+	return r.tournamentClient.GetTournamentBracket(ctx, input)
+}
+
+// GetTournamentPairings is the resolver for the GetTournamentPairings field.
+func (r *queryResolver) GetTournamentPairings(ctx context.Context, input *api.GetTournamentPairingsRequest) (*api.GetTournamentPairingsResponse, error) {
+	return r.tournamentClient.GetTournamentPairings(ctx, input)
+}
+
+// GetTournamentStandings is the resolver for the GetTournamentStandings field.
+func (r *queryResolver) GetTournamentStandings(ctx context.Context, input *api.GetTournamentStandingsRequest) (*api.GetTournamentStandingsResponse, error) {
+	return r.tournamentClient.GetTournamentStandings(ctx, input)
+}
+
+// Error is the resolver for the error field.
+func (r *recordTournamentPairingResultResponseResolver) Error(ctx context.Context, obj *api.RecordTournamentPairingResultResponse) (model.RecordTournamentPairingResultError, error) {
+	return model.RecordTournamentPairingResultError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *reportTournamentBracketMatchResultResponseResolver) Error(ctx context.Context, obj *api.ReportTournamentBracketMatchResultResponse) (model.ReportTournamentBracketMatchResultError, error) {
+	return model.ReportTournamentBracketMatchResultError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *setTournamentWipeTimeResponseResolver) Error(ctx context.Context, obj *api.SetTournamentWipeTimeResponse) (model.SetTournamentWipeTimeError, error) {
+	return model.SetTournamentWipeTimeError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *submitTournamentScoreResponseResolver) Error(ctx context.Context, obj *api.SubmitTournamentScoreResponse) (model.SubmitTournamentScoreError, error) {
+	return model.SubmitTournamentScoreError(obj.Error.String()), nil
+}
+
+// Format is the resolver for the format field.
+func (r *tournamentBracketResolver) Format(ctx context.Context, obj *api.TournamentBracket) (graphqlEnums.TournamentBracketFormat, error) {
+	return graphqlEnums.TournamentBracketFormat(obj.Format.String()), nil
+}
+
+// Side is the resolver for the side field.
+func (r *tournamentBracketMatchResolver) Side(ctx context.Context, obj *api.TournamentBracketMatch) (graphqlEnums.TournamentBracketSide, error) {
+	return graphqlEnums.TournamentBracketSide(obj.Side.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *tournamentBracketResponseResolver) Error(ctx context.Context, obj *api.TournamentBracketResponse) (model.TournamentBracketError, error) {
+	return model.TournamentBracketError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *tournamentDefinitionResponseResolver) Error(ctx context.Context, obj *api.TournamentDefinitionResponse) (model.TournamentDefinitionError, error) {
+	return model.TournamentDefinitionError(obj.Error.String()), nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *tournamentPairingResolver) Interval(ctx context.Context, obj *api.TournamentPairing) (graphqlEnums.TournamentInterval, error) {
+	return graphqlEnums.TournamentInterval(obj.Interval.String()), nil
+}
+
+// Format is the resolver for the format field.
+func (r *tournamentPairingResolver) Format(ctx context.Context, obj *api.TournamentPairing) (graphqlEnums.TournamentPairingFormat, error) {
+	return graphqlEnums.TournamentPairingFormat(obj.Format.String()), nil
+}
+
+// Result is the resolver for the result field.
+func (r *tournamentPairingResolver) Result(ctx context.Context, obj *api.TournamentPairing) (*graphqlEnums.TournamentPairingResult, error) {
+	if obj.Result == nil {
+		return nil, nil
+	}
+	result := graphqlEnums.TournamentPairingResult(obj.Result.String())
+	return &result, nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *tournamentRewardResolver) Interval(ctx context.Context, obj *api.TournamentReward) (graphqlEnums.TournamentInterval, error) {
+	return graphqlEnums.TournamentInterval(obj.Interval.String()), nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *tournamentRewardTierResolver) Interval(ctx context.Context, obj *api.TournamentRewardTier) (graphqlEnums.TournamentInterval, error) {
+	return graphqlEnums.TournamentInterval(obj.Interval.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *tournamentRewardTierResponseResolver) Error(ctx context.Context, obj *api.TournamentRewardTierResponse) (model.TournamentRewardTierError, error) {
+	return model.TournamentRewardTierError(obj.Error.String()), nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *tournamentTeamResolver) Interval(ctx context.Context, obj *api.TournamentTeam) (graphqlEnums.TournamentInterval, error) {
+	return graphqlEnums.TournamentInterval(obj.Interval.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *tournamentTeamResponseResolver) Error(ctx context.Context, obj *api.TournamentTeamResponse) (model.TournamentTeamError, error) {
+	return model.TournamentTeamError(obj.Error.String()), nil
+}
+
 // Interval is the resolver for the interval field.
 func (r *tournamentUserResolver) Interval(ctx context.Context, obj *api.TournamentUser) (graphqlEnums.TournamentInterval, error) {
 	return graphqlEnums.TournamentInterval(obj.Interval.String()), nil
@@ -63,34 +357,238 @@ func (r *tournamentUserResponseResolver) Error(ctx context.Context, obj *api.Tou
 	return model.TournamentUserError(obj.Error.String()), nil
 }
 
+// Error is the resolver for the error field.
+func (r *tournamentWipeTimeResponseResolver) Error(ctx context.Context, obj *api.TournamentWipeTimeResponse) (model.TournamentWipeTimeError, error) {
+	return model.TournamentWipeTimeError(obj.Error.String()), nil
+}
+
+// Error is the resolver for the error field.
+func (r *updateTournamentTeamResponseResolver) Error(ctx context.Context, obj *api.UpdateTournamentTeamResponse) (model.UpdateTournamentTeamError, error) {
+	return model.UpdateTournamentTeamError(obj.Error.String()), nil
+}
+
 // Error is the resolver for the error field.
 func (r *updateTournamentUserResponseResolver) Error(ctx context.Context, obj *api.UpdateTournamentUserResponse) (model.UpdateTournamentUserError, error) {
 	return model.UpdateTournamentUserError(obj.Error.String()), nil
 }
 
+// Format is the resolver for the format field.
+func (r *createTournamentBracketRequestResolver) Format(ctx context.Context, obj *api.CreateTournamentBracketRequest, data graphqlEnums.TournamentBracketFormat) error {
+	obj.Format = api.TournamentBracketFormat(api.TournamentBracketFormat_value[data.String()])
+	return nil
+}
+
+// Seeding is the resolver for the seeding field.
+func (r *createTournamentBracketRequestResolver) Seeding(ctx context.Context, obj *api.CreateTournamentBracketRequest, data graphqlEnums.TournamentBracketSeeding) error {
+	obj.Seeding = api.TournamentBracketSeeding(api.TournamentBracketSeeding_value[data.String()])
+	return nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *createTournamentBracketRequestResolver) Interval(ctx context.Context, obj *api.CreateTournamentBracketRequest, data graphqlEnums.TournamentInterval) error {
+	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
+	return nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *createTournamentRewardTierRequestResolver) Interval(ctx context.Context, obj *api.CreateTournamentRewardTierRequest, data graphqlEnums.TournamentInterval) error {
+	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
+	return nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *createTournamentRoundRequestResolver) Interval(ctx context.Context, obj *api.CreateTournamentRoundRequest, data graphqlEnums.TournamentInterval) error {
+	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
+	return nil
+}
+
+// Format is the resolver for the format field.
+func (r *createTournamentRoundRequestResolver) Format(ctx context.Context, obj *api.CreateTournamentRoundRequest, data graphqlEnums.TournamentPairingFormat) error {
+	obj.Format = api.TournamentPairingFormat(api.TournamentPairingFormat_value[data.String()])
+	return nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *createTournamentTeamRequestResolver) Interval(ctx context.Context, obj *api.CreateTournamentTeamRequest, data graphqlEnums.TournamentInterval) error {
+	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
+	return nil
+}
+
 // Interval is the resolver for the interval field.
 func (r *createTournamentUserRequestResolver) Interval(ctx context.Context, obj *api.CreateTournamentUserRequest, data graphqlEnums.TournamentInterval) error {
 	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
 	return nil
 }
 
+// Interval is the resolver for the interval field.
+func (r *getTournamentPairingsRequestResolver) Interval(ctx context.Context, obj *api.GetTournamentPairingsRequest, data graphqlEnums.TournamentInterval) error {
+	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
+	return nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *getTournamentRewardTiersRequestResolver) Interval(ctx context.Context, obj *api.GetTournamentRewardTiersRequest, data graphqlEnums.TournamentInterval) error {
+	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
+	return nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *getTournamentStandingsRequestResolver) Interval(ctx context.Context, obj *api.GetTournamentStandingsRequest, data graphqlEnums.TournamentInterval) error {
+	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
+	return nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *getTournamentTeamsRequestResolver) Interval(ctx context.Context, obj *api.GetTournamentTeamsRequest, data graphqlEnums.TournamentInterval) error {
+	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
+	return nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *getTournamentUserHistoryRequestResolver) Interval(ctx context.Context, obj *api.GetTournamentUserHistoryRequest, data *graphqlEnums.TournamentInterval) error {
+	if data == nil {
+		return nil
+	}
+	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()]).Enum()
+	return nil
+}
+
 // Interval is the resolver for the interval field.
 func (r *getTournamentUsersRequestResolver) Interval(ctx context.Context, obj *api.GetTournamentUsersRequest, data graphqlEnums.TournamentInterval) error {
 	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
 	return nil
 }
 
+// Result is the resolver for the result field.
+func (r *recordTournamentPairingResultRequestResolver) Result(ctx context.Context, obj *api.RecordTournamentPairingResultRequest, data graphqlEnums.TournamentPairingResult) error {
+	obj.Result = api.TournamentPairingResult(api.TournamentPairingResult_value[data.String()])
+	return nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *submitTournamentScoreRequestResolver) Interval(ctx context.Context, obj *api.SubmitTournamentScoreRequest, data graphqlEnums.TournamentInterval) error {
+	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
+	return nil
+}
+
+// ScoreMode is the resolver for the scoreMode field.
+func (r *submitTournamentScoreRequestResolver) ScoreMode(ctx context.Context, obj *api.SubmitTournamentScoreRequest, data graphqlEnums.TournamentScoreMode) error {
+	obj.ScoreMode = api.TournamentScoreMode(api.TournamentScoreMode_value[data.String()])
+	return nil
+}
+
+// Interval is the resolver for the interval field.
+func (r *tournamentIntervalTeamIdResolver) Interval(ctx context.Context, obj *api.TournamentIntervalTeamId, data graphqlEnums.TournamentInterval) error {
+	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
+	return nil
+}
+
 // Interval is the resolver for the interval field.
 func (r *tournamentIntervalUserIdResolver) Interval(ctx context.Context, obj *api.TournamentIntervalUserId, data graphqlEnums.TournamentInterval) error {
 	obj.Interval = api.TournamentInterval(api.TournamentInterval_value[data.String()])
 	return nil
 }
 
+// ScoreMode is the resolver for the scoreMode field.
+func (r *updateTournamentTeamRequestResolver) ScoreMode(ctx context.Context, obj *api.UpdateTournamentTeamRequest, data *graphqlEnums.TournamentScoreMode) error {
+	if data == nil {
+		obj.ScoreMode = nil
+		return nil
+	}
+	obj.ScoreMode = api.TournamentScoreMode(api.TournamentScoreMode_value[data.String()]).Enum()
+	return nil
+}
+
+// ScoreMode is the resolver for the scoreMode field.
+func (r *updateTournamentUserRequestResolver) ScoreMode(ctx context.Context, obj *api.UpdateTournamentUserRequest, data *graphqlEnums.TournamentScoreMode) error {
+	if data == nil {
+		obj.ScoreMode = nil
+		return nil
+	}
+	obj.ScoreMode = api.TournamentScoreMode(api.TournamentScoreMode_value[data.String()]).Enum()
+	return nil
+}
+
+// ClaimTournamentRewardResponse returns bff.ClaimTournamentRewardResponseResolver implementation.
+func (r *Resolver) ClaimTournamentRewardResponse() bff.ClaimTournamentRewardResponseResolver {
+	return &claimTournamentRewardResponseResolver{r}
+}
+
+// CreateTournamentBracketResponse returns bff.CreateTournamentBracketResponseResolver implementation.
+func (r *Resolver) CreateTournamentBracketResponse() bff.CreateTournamentBracketResponseResolver {
+	return &createTournamentBracketResponseResolver{r}
+}
+
+// CreateTournamentDefinitionResponse returns bff.CreateTournamentDefinitionResponseResolver implementation.
+func (r *Resolver) CreateTournamentDefinitionResponse() bff.CreateTournamentDefinitionResponseResolver {
+	return &createTournamentDefinitionResponseResolver{r}
+}
+
+// CreateTournamentRewardTierResponse returns bff.CreateTournamentRewardTierResponseResolver implementation.
+func (r *Resolver) CreateTournamentRewardTierResponse() bff.CreateTournamentRewardTierResponseResolver {
+	return &createTournamentRewardTierResponseResolver{r}
+}
+
+// CreateTournamentRoundResponse returns bff.CreateTournamentRoundResponseResolver implementation.
+func (r *Resolver) CreateTournamentRoundResponse() bff.CreateTournamentRoundResponseResolver {
+	return &createTournamentRoundResponseResolver{r}
+}
+
+// CreateTournamentTeamResponse returns bff.CreateTournamentTeamResponseResolver implementation.
+func (r *Resolver) CreateTournamentTeamResponse() bff.CreateTournamentTeamResponseResolver {
+	return &createTournamentTeamResponseResolver{r}
+}
+
 // CreateTournamentUserResponse returns bff.CreateTournamentUserResponseResolver implementation.
 func (r *Resolver) CreateTournamentUserResponse() bff.CreateTournamentUserResponseResolver {
 	return &createTournamentUserResponseResolver{r}
 }
 
+// GetTournamentBracketResponse returns bff.GetTournamentBracketResponseResolver implementation.
+func (r *Resolver) GetTournamentBracketResponse() bff.GetTournamentBracketResponseResolver {
+	return &getTournamentBracketResponseResolver{r}
+}
+
+// GetTournamentDefinitionResponse returns bff.GetTournamentDefinitionResponseResolver implementation.
+func (r *Resolver) GetTournamentDefinitionResponse() bff.GetTournamentDefinitionResponseResolver {
+	return &getTournamentDefinitionResponseResolver{r}
+}
+
+// GetTournamentPairingsResponse returns bff.GetTournamentPairingsResponseResolver implementation.
+func (r *Resolver) GetTournamentPairingsResponse() bff.GetTournamentPairingsResponseResolver {
+	return &getTournamentPairingsResponseResolver{r}
+}
+
+// GetTournamentRewardTiersResponse returns bff.GetTournamentRewardTiersResponseResolver implementation.
+func (r *Resolver) GetTournamentRewardTiersResponse() bff.GetTournamentRewardTiersResponseResolver {
+	return &getTournamentRewardTiersResponseResolver{r}
+}
+
+// GetTournamentRewardsResponse returns bff.GetTournamentRewardsResponseResolver implementation.
+func (r *Resolver) GetTournamentRewardsResponse() bff.GetTournamentRewardsResponseResolver {
+	return &getTournamentRewardsResponseResolver{r}
+}
+
+// GetTournamentStandingsResponse returns bff.GetTournamentStandingsResponseResolver implementation.
+func (r *Resolver) GetTournamentStandingsResponse() bff.GetTournamentStandingsResponseResolver {
+	return &getTournamentStandingsResponseResolver{r}
+}
+
+// GetTournamentTeamResponse returns bff.GetTournamentTeamResponseResolver implementation.
+func (r *Resolver) GetTournamentTeamResponse() bff.GetTournamentTeamResponseResolver {
+	return &getTournamentTeamResponseResolver{r}
+}
+
+// GetTournamentTeamsResponse returns bff.GetTournamentTeamsResponseResolver implementation.
+func (r *Resolver) GetTournamentTeamsResponse() bff.GetTournamentTeamsResponseResolver {
+	return &getTournamentTeamsResponseResolver{r}
+}
+
+// GetTournamentUserHistoryResponse returns bff.GetTournamentUserHistoryResponseResolver implementation.
+func (r *Resolver) GetTournamentUserHistoryResponse() bff.GetTournamentUserHistoryResponseResolver {
+	return &getTournamentUserHistoryResponseResolver{r}
+}
+
 // GetTournamentUserResponse returns bff.GetTournamentUserResponseResolver implementation.
 func (r *Resolver) GetTournamentUserResponse() bff.GetTournamentUserResponseResolver {
 	return &getTournamentUserResponseResolver{r}
@@ -101,6 +599,79 @@ func (r *Resolver) GetTournamentUsersResponse() bff.GetTournamentUsersResponseRe
 	return &getTournamentUsersResponseResolver{r}
 }
 
+// GetTournamentWipeTimeResponse returns bff.GetTournamentWipeTimeResponseResolver implementation.
+func (r *Resolver) GetTournamentWipeTimeResponse() bff.GetTournamentWipeTimeResponseResolver {
+	return &getTournamentWipeTimeResponseResolver{r}
+}
+
+// RecordTournamentPairingResultResponse returns bff.RecordTournamentPairingResultResponseResolver implementation.
+func (r *Resolver) RecordTournamentPairingResultResponse() bff.RecordTournamentPairingResultResponseResolver {
+	return &recordTournamentPairingResultResponseResolver{r}
+}
+
+// ReportTournamentBracketMatchResultResponse returns bff.ReportTournamentBracketMatchResultResponseResolver implementation.
+func (r *Resolver) ReportTournamentBracketMatchResultResponse() bff.ReportTournamentBracketMatchResultResponseResolver {
+	return &reportTournamentBracketMatchResultResponseResolver{r}
+}
+
+// SetTournamentWipeTimeResponse returns bff.SetTournamentWipeTimeResponseResolver implementation.
+func (r *Resolver) SetTournamentWipeTimeResponse() bff.SetTournamentWipeTimeResponseResolver {
+	return &setTournamentWipeTimeResponseResolver{r}
+}
+
+// SubmitTournamentScoreResponse returns bff.SubmitTournamentScoreResponseResolver implementation.
+func (r *Resolver) SubmitTournamentScoreResponse() bff.SubmitTournamentScoreResponseResolver {
+	return &submitTournamentScoreResponseResolver{r}
+}
+
+// TournamentBracket returns bff.TournamentBracketResolver implementation.
+func (r *Resolver) TournamentBracket() bff.TournamentBracketResolver {
+	return &tournamentBracketResolver{r}
+}
+
+// TournamentBracketMatch returns bff.TournamentBracketMatchResolver implementation.
+func (r *Resolver) TournamentBracketMatch() bff.TournamentBracketMatchResolver {
+	return &tournamentBracketMatchResolver{r}
+}
+
+// TournamentBracketResponse returns bff.TournamentBracketResponseResolver implementation.
+func (r *Resolver) TournamentBracketResponse() bff.TournamentBracketResponseResolver {
+	return &tournamentBracketResponseResolver{r}
+}
+
+// TournamentDefinitionResponse returns bff.TournamentDefinitionResponseResolver implementation.
+func (r *Resolver) TournamentDefinitionResponse() bff.TournamentDefinitionResponseResolver {
+	return &tournamentDefinitionResponseResolver{r}
+}
+
+// TournamentPairing returns bff.TournamentPairingResolver implementation.
+func (r *Resolver) TournamentPairing() bff.TournamentPairingResolver {
+	return &tournamentPairingResolver{r}
+}
+
+// TournamentReward returns bff.TournamentRewardResolver implementation.
+func (r *Resolver) TournamentReward() bff.TournamentRewardResolver {
+	return &tournamentRewardResolver{r}
+}
+
+// TournamentRewardTier returns bff.TournamentRewardTierResolver implementation.
+func (r *Resolver) TournamentRewardTier() bff.TournamentRewardTierResolver {
+	return &tournamentRewardTierResolver{r}
+}
+
+// TournamentRewardTierResponse returns bff.TournamentRewardTierResponseResolver implementation.
+func (r *Resolver) TournamentRewardTierResponse() bff.TournamentRewardTierResponseResolver {
+	return &tournamentRewardTierResponseResolver{r}
+}
+
+// TournamentTeam returns bff.TournamentTeamResolver implementation.
+func (r *Resolver) TournamentTeam() bff.TournamentTeamResolver { return &tournamentTeamResolver{r} }
+
+// TournamentTeamResponse returns bff.TournamentTeamResponseResolver implementation.
+func (r *Resolver) TournamentTeamResponse() bff.TournamentTeamResponseResolver {
+	return &tournamentTeamResponseResolver{r}
+}
+
 // TournamentUser returns bff.TournamentUserResolver implementation.
 func (r *Resolver) TournamentUser() bff.TournamentUserResolver { return &tournamentUserResolver{r} }
 
@@ -109,32 +680,158 @@ func (r *Resolver) TournamentUserResponse() bff.TournamentUserResponseResolver {
 	return &tournamentUserResponseResolver{r}
 }
 
+// TournamentWipeTimeResponse returns bff.TournamentWipeTimeResponseResolver implementation.
+func (r *Resolver) TournamentWipeTimeResponse() bff.TournamentWipeTimeResponseResolver {
+	return &tournamentWipeTimeResponseResolver{r}
+}
+
+// UpdateTournamentTeamResponse returns bff.UpdateTournamentTeamResponseResolver implementation.
+func (r *Resolver) UpdateTournamentTeamResponse() bff.UpdateTournamentTeamResponseResolver {
+	return &updateTournamentTeamResponseResolver{r}
+}
+
 // UpdateTournamentUserResponse returns bff.UpdateTournamentUserResponseResolver implementation.
 func (r *Resolver) UpdateTournamentUserResponse() bff.UpdateTournamentUserResponseResolver {
 	return &updateTournamentUserResponseResolver{r}
 }
 
+// CreateTournamentBracketRequest returns bff.CreateTournamentBracketRequestResolver implementation.
+func (r *Resolver) CreateTournamentBracketRequest() bff.CreateTournamentBracketRequestResolver {
+	return &createTournamentBracketRequestResolver{r}
+}
+
+// CreateTournamentRewardTierRequest returns bff.CreateTournamentRewardTierRequestResolver implementation.
+func (r *Resolver) CreateTournamentRewardTierRequest() bff.CreateTournamentRewardTierRequestResolver {
+	return &createTournamentRewardTierRequestResolver{r}
+}
+
+// CreateTournamentRoundRequest returns bff.CreateTournamentRoundRequestResolver implementation.
+func (r *Resolver) CreateTournamentRoundRequest() bff.CreateTournamentRoundRequestResolver {
+	return &createTournamentRoundRequestResolver{r}
+}
+
+// CreateTournamentTeamRequest returns bff.CreateTournamentTeamRequestResolver implementation.
+func (r *Resolver) CreateTournamentTeamRequest() bff.CreateTournamentTeamRequestResolver {
+	return &createTournamentTeamRequestResolver{r}
+}
+
 // CreateTournamentUserRequest returns bff.CreateTournamentUserRequestResolver implementation.
 func (r *Resolver) CreateTournamentUserRequest() bff.CreateTournamentUserRequestResolver {
 	return &createTournamentUserRequestResolver{r}
 }
 
+// GetTournamentPairingsRequest returns bff.GetTournamentPairingsRequestResolver implementation.
+func (r *Resolver) GetTournamentPairingsRequest() bff.GetTournamentPairingsRequestResolver {
+	return &getTournamentPairingsRequestResolver{r}
+}
+
+// GetTournamentRewardTiersRequest returns bff.GetTournamentRewardTiersRequestResolver implementation.
+func (r *Resolver) GetTournamentRewardTiersRequest() bff.GetTournamentRewardTiersRequestResolver {
+	return &getTournamentRewardTiersRequestResolver{r}
+}
+
+// GetTournamentStandingsRequest returns bff.GetTournamentStandingsRequestResolver implementation.
+func (r *Resolver) GetTournamentStandingsRequest() bff.GetTournamentStandingsRequestResolver {
+	return &getTournamentStandingsRequestResolver{r}
+}
+
+// GetTournamentTeamsRequest returns bff.GetTournamentTeamsRequestResolver implementation.
+func (r *Resolver) GetTournamentTeamsRequest() bff.GetTournamentTeamsRequestResolver {
+	return &getTournamentTeamsRequestResolver{r}
+}
+
+// GetTournamentUserHistoryRequest returns bff.GetTournamentUserHistoryRequestResolver implementation.
+func (r *Resolver) GetTournamentUserHistoryRequest() bff.GetTournamentUserHistoryRequestResolver {
+	return &getTournamentUserHistoryRequestResolver{r}
+}
+
 // GetTournamentUsersRequest returns bff.GetTournamentUsersRequestResolver implementation.
 func (r *Resolver) GetTournamentUsersRequest() bff.GetTournamentUsersRequestResolver {
 	return &getTournamentUsersRequestResolver{r}
 }
 
+// RecordTournamentPairingResultRequest returns bff.RecordTournamentPairingResultRequestResolver implementation.
+func (r *Resolver) RecordTournamentPairingResultRequest() bff.RecordTournamentPairingResultRequestResolver {
+	return &recordTournamentPairingResultRequestResolver{r}
+}
+
+// SubmitTournamentScoreRequest returns bff.SubmitTournamentScoreRequestResolver implementation.
+func (r *Resolver) SubmitTournamentScoreRequest() bff.SubmitTournamentScoreRequestResolver {
+	return &submitTournamentScoreRequestResolver{r}
+}
+
+// TournamentIntervalTeamId returns bff.TournamentIntervalTeamIdResolver implementation.
+func (r *Resolver) TournamentIntervalTeamId() bff.TournamentIntervalTeamIdResolver {
+	return &tournamentIntervalTeamIdResolver{r}
+}
+
 // TournamentIntervalUserId returns bff.TournamentIntervalUserIdResolver implementation.
 func (r *Resolver) TournamentIntervalUserId() bff.TournamentIntervalUserIdResolver {
 	return &tournamentIntervalUserIdResolver{r}
 }
 
+// UpdateTournamentTeamRequest returns bff.UpdateTournamentTeamRequestResolver implementation.
+func (r *Resolver) UpdateTournamentTeamRequest() bff.UpdateTournamentTeamRequestResolver {
+	return &updateTournamentTeamRequestResolver{r}
+}
+
+// UpdateTournamentUserRequest returns bff.UpdateTournamentUserRequestResolver implementation.
+func (r *Resolver) UpdateTournamentUserRequest() bff.UpdateTournamentUserRequestResolver {
+	return &updateTournamentUserRequestResolver{r}
+}
+
+type claimTournamentRewardResponseResolver struct{ *Resolver }
+type createTournamentBracketResponseResolver struct{ *Resolver }
+type createTournamentDefinitionResponseResolver struct{ *Resolver }
+type createTournamentRewardTierResponseResolver struct{ *Resolver }
+type createTournamentRoundResponseResolver struct{ *Resolver }
+type createTournamentTeamResponseResolver struct{ *Resolver }
 type createTournamentUserResponseResolver struct{ *Resolver }
+type getTournamentBracketResponseResolver struct{ *Resolver }
+type getTournamentDefinitionResponseResolver struct{ *Resolver }
+type getTournamentPairingsResponseResolver struct{ *Resolver }
+type getTournamentRewardTiersResponseResolver struct{ *Resolver }
+type getTournamentRewardsResponseResolver struct{ *Resolver }
+type getTournamentStandingsResponseResolver struct{ *Resolver }
+type getTournamentTeamResponseResolver struct{ *Resolver }
+type getTournamentTeamsResponseResolver struct{ *Resolver }
+type getTournamentUserHistoryResponseResolver struct{ *Resolver }
 type getTournamentUserResponseResolver struct{ *Resolver }
 type getTournamentUsersResponseResolver struct{ *Resolver }
+type getTournamentWipeTimeResponseResolver struct{ *Resolver }
+type recordTournamentPairingResultResponseResolver struct{ *Resolver }
+type reportTournamentBracketMatchResultResponseResolver struct{ *Resolver }
+type setTournamentWipeTimeResponseResolver struct{ *Resolver }
+type submitTournamentScoreResponseResolver struct{ *Resolver }
+type tournamentBracketResolver struct{ *Resolver }
+type tournamentBracketMatchResolver struct{ *Resolver }
+type tournamentBracketResponseResolver struct{ *Resolver }
+type tournamentDefinitionResponseResolver struct{ *Resolver }
+type tournamentPairingResolver struct{ *Resolver }
+type tournamentRewardResolver struct{ *Resolver }
+type tournamentRewardTierResolver struct{ *Resolver }
+type tournamentRewardTierResponseResolver struct{ *Resolver }
+type tournamentTeamResolver struct{ *Resolver }
+type tournamentTeamResponseResolver struct{ *Resolver }
 type tournamentUserResolver struct{ *Resolver }
 type tournamentUserResponseResolver struct{ *Resolver }
+type tournamentWipeTimeResponseResolver struct{ *Resolver }
+type updateTournamentTeamResponseResolver struct{ *Resolver }
 type updateTournamentUserResponseResolver struct{ *Resolver }
+type createTournamentBracketRequestResolver struct{ *Resolver }
+type createTournamentRewardTierRequestResolver struct{ *Resolver }
+type createTournamentRoundRequestResolver struct{ *Resolver }
+type createTournamentTeamRequestResolver struct{ *Resolver }
 type createTournamentUserRequestResolver struct{ *Resolver }
+type getTournamentPairingsRequestResolver struct{ *Resolver }
+type getTournamentRewardTiersRequestResolver struct{ *Resolver }
+type getTournamentStandingsRequestResolver struct{ *Resolver }
+type getTournamentTeamsRequestResolver struct{ *Resolver }
+type getTournamentUserHistoryRequestResolver struct{ *Resolver }
 type getTournamentUsersRequestResolver struct{ *Resolver }
+type recordTournamentPairingResultRequestResolver struct{ *Resolver }
+type submitTournamentScoreRequestResolver struct{ *Resolver }
+type tournamentIntervalTeamIdResolver struct{ *Resolver }
 type tournamentIntervalUserIdResolver struct{ *Resolver }
+type updateTournamentTeamRequestResolver struct{ *Resolver }
+type updateTournamentUserRequestResolver struct{ *Resolver }
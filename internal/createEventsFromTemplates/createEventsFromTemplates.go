@@ -0,0 +1,165 @@
+package createEventsFromTemplates
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MorhafAlshibly/coanda/internal/createEventsFromTemplates/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
+	"github.com/MorhafAlshibly/coanda/pkg/eventTemplate"
+	"github.com/go-sql-driver/mysql"
+)
+
+type App struct {
+	database *model.Queries
+	sql      *sql.DB
+	catchUp  time.Duration
+	limit    int32
+}
+
+func WithDatabase(database *model.Queries) func(*App) {
+	return func(input *App) {
+		input.database = database
+	}
+}
+
+func WithSql(sql *sql.DB) func(*App) {
+	return func(input *App) {
+		input.sql = sql
+	}
+}
+
+func WithCatchUp(catchUp time.Duration) func(*App) {
+	return func(input *App) {
+		input.catchUp = catchUp
+	}
+}
+
+func WithLimit(limit int32) func(*App) {
+	return func(input *App) {
+		input.limit = limit
+	}
+}
+
+func NewApp(opts ...func(*App)) *App {
+	app := App{
+		catchUp: time.Hour,
+		limit:   100,
+	}
+	for _, opt := range opts {
+		opt(&app)
+	}
+	return &app
+}
+
+func (a *App) Handler(ctx context.Context) error {
+	err := a.createEventsFromTemplates(ctx)
+	if err != nil {
+		fmt.Printf("failed to create events from templates: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (a *App) createEventsFromTemplates(ctx context.Context) error {
+	currentTime := time.Now().UTC()
+	limit := a.limit
+	offset := int32(0)
+	for {
+		templates, err := a.database.GetScheduledEventTemplates(ctx, model.GetScheduledEventTemplatesParams{
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			return err
+		}
+		if len(templates) == 0 {
+			break
+		}
+		for _, template := range templates {
+			var scheduledUntil *time.Time
+			if template.ScheduledUntil.Valid {
+				scheduledUntil = &template.ScheduledUntil.Time
+			}
+			startTimes, err := eventTemplate.GetScheduledStartTimes(currentTime, template.CronSchedule.String, time.Duration(template.LeadTimeSeconds)*time.Second, a.catchUp, scheduledUntil)
+			if err != nil {
+				// A template with an invalid schedule should not stop events being created from the others
+				fmt.Printf("failed to get start times of event template %d: %v", template.ID, err)
+				continue
+			}
+			for _, startTime := range startTimes {
+				err := a.createEventFromTemplate(ctx, template, startTime)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		offset += limit
+	}
+	return nil
+}
+
+func (a *App) createEventFromTemplate(ctx context.Context, template model.EventTemplate, startedAt time.Time) error {
+	var rounds []eventTemplate.Round
+	err := json.Unmarshal(template.Rounds, &rounds)
+	if err != nil {
+		return err
+	}
+	tx, err := a.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := a.database.WithTx(tx)
+	// The template is scheduled up to the event in the same transaction, so the event is not created again once it is deleted
+	_, err = qtx.UpdateEventTemplateScheduledUntil(ctx, model.UpdateEventTemplateScheduledUntilParams{
+		ScheduledUntil: sql.NullTime{Time: startedAt, Valid: true},
+		ID:             template.ID,
+	})
+	if err != nil {
+		return err
+	}
+	eventResult, err := qtx.CreateEvent(ctx, model.CreateEventParams{
+		Name:      eventTemplate.EventName(template.Name, startedAt),
+		Data:      template.Data,
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		// The event is named after its start time, so it has already been created by an earlier run
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && errorcode.IsDuplicateEntry(mysqlErr, "event", "name") {
+			return tx.Commit()
+		}
+		return err
+	}
+	eventID, err := eventResult.LastInsertId()
+	if err != nil {
+		return err
+	}
+	for _, round := range rounds {
+		_, err = qtx.CreateEventRound(ctx, model.CreateEventRoundParams{
+			EventID:              uint64(eventID),
+			Name:                 round.Name,
+			Data:                 round.Data,
+			Scoring:              round.Scoring,
+			ResultOrder:          model.EventRoundResultOrder(round.ResultOrder),
+			ResultAggregation:    model.EventRoundResultAggregation(round.ResultAggregation),
+			ResultAggregationTop: conversion.Uint32ToSqlNullInt32(round.ResultAggregationTop),
+			EndedAt:              round.EndedAt(startedAt),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	// Commit the transaction
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	return nil
+}
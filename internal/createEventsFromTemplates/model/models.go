@@ -0,0 +1,325 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+
+package model
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type EventRoundResultAggregation string
+
+const (
+	EventRoundResultAggregationLatest       EventRoundResultAggregation = "latest"
+	EventRoundResultAggregationBest         EventRoundResultAggregation = "best"
+	EventRoundResultAggregationSum          EventRoundResultAggregation = "sum"
+	EventRoundResultAggregationAverageOfTop EventRoundResultAggregation = "average_of_top"
+)
+
+func (e *EventRoundResultAggregation) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EventRoundResultAggregation(s)
+	case string:
+		*e = EventRoundResultAggregation(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EventRoundResultAggregation: %T", src)
+	}
+	return nil
+}
+
+type NullEventRoundResultAggregation struct {
+	EventRoundResultAggregation EventRoundResultAggregation
+	Valid                       bool // Valid is true if EventRoundResultAggregation is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEventRoundResultAggregation) Scan(value interface{}) error {
+	if value == nil {
+		ns.EventRoundResultAggregation, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EventRoundResultAggregation.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEventRoundResultAggregation) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EventRoundResultAggregation), nil
+}
+
+type EventRoundResultOrder string
+
+const (
+	EventRoundResultOrderAsc  EventRoundResultOrder = "asc"
+	EventRoundResultOrderDesc EventRoundResultOrder = "desc"
+)
+
+func (e *EventRoundResultOrder) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EventRoundResultOrder(s)
+	case string:
+		*e = EventRoundResultOrder(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EventRoundResultOrder: %T", src)
+	}
+	return nil
+}
+
+type NullEventRoundResultOrder struct {
+	EventRoundResultOrder EventRoundResultOrder
+	Valid                 bool // Valid is true if EventRoundResultOrder is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEventRoundResultOrder) Scan(value interface{}) error {
+	if value == nil {
+		ns.EventRoundResultOrder, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EventRoundResultOrder.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEventRoundResultOrder) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EventRoundResultOrder), nil
+}
+
+type EventUserDisqualificationAction string
+
+const (
+	EventUserDisqualificationActionDisqualified EventUserDisqualificationAction = "disqualified"
+	EventUserDisqualificationActionReinstated   EventUserDisqualificationAction = "reinstated"
+)
+
+func (e *EventUserDisqualificationAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EventUserDisqualificationAction(s)
+	case string:
+		*e = EventUserDisqualificationAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EventUserDisqualificationAction: %T", src)
+	}
+	return nil
+}
+
+type NullEventUserDisqualificationAction struct {
+	EventUserDisqualificationAction EventUserDisqualificationAction
+	Valid                           bool // Valid is true if EventUserDisqualificationAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEventUserDisqualificationAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.EventUserDisqualificationAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EventUserDisqualificationAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEventUserDisqualificationAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EventUserDisqualificationAction), nil
+}
+
+type Event struct {
+	ID                   uint64           `db:"id"`
+	Name                 string           `db:"name"`
+	Data                 json.RawMessage  `db:"data"`
+	StartedAt            time.Time        `db:"started_at"`
+	PausedAt             sql.NullTime     `db:"paused_at"`
+	CancelledAt          sql.NullTime     `db:"cancelled_at"`
+	RegistrationRequired bool             `db:"registration_required"`
+	RegistrationOpensAt  sql.NullTime     `db:"registration_opens_at"`
+	RegistrationClosesAt sql.NullTime     `db:"registration_closes_at"`
+	MaxParticipants      sql.NullInt32    `db:"max_participants"`
+	Eligibility          *json.RawMessage `db:"eligibility"`
+	SentToThirdPartyAt   sql.NullTime     `db:"sent_to_third_party_at"`
+	CreatedAt            time.Time        `db:"created_at"`
+	UpdatedAt            time.Time        `db:"updated_at"`
+}
+
+type EventLeaderboard struct {
+	ID                     uint64          `db:"id"`
+	EventID                uint64          `db:"event_id"`
+	ClientUserID           uint64          `db:"client_user_id"`
+	Score                  uint64          `db:"score"`
+	Ranking                uint64          `db:"ranking"`
+	Data                   json.RawMessage `db:"data"`
+	DisqualifiedAt         sql.NullTime    `db:"disqualified_at"`
+	DisqualificationReason sql.NullString  `db:"disqualification_reason"`
+	DisqualifiedBy         sql.NullString  `db:"disqualified_by"`
+	CreatedAt              time.Time       `db:"created_at"`
+	UpdatedAt              time.Time       `db:"updated_at"`
+}
+
+type EventRound struct {
+	ID                   uint64                      `db:"id"`
+	EventID              uint64                      `db:"event_id"`
+	Name                 string                      `db:"name"`
+	Scoring              json.RawMessage             `db:"scoring"`
+	ResultOrder          EventRoundResultOrder       `db:"result_order"`
+	ResultAggregation    EventRoundResultAggregation `db:"result_aggregation"`
+	ResultAggregationTop sql.NullInt32               `db:"result_aggregation_top"`
+	Data                 json.RawMessage             `db:"data"`
+	EndedAt              time.Time                   `db:"ended_at"`
+	SentToThirdPartyAt   sql.NullTime                `db:"sent_to_third_party_at"`
+	CreatedAt            time.Time                   `db:"created_at"`
+	UpdatedAt            time.Time                   `db:"updated_at"`
+}
+
+type EventRoundAttempt struct {
+	ID           uint64          `db:"id"`
+	EventUserID  uint64          `db:"event_user_id"`
+	EventRoundID uint64          `db:"event_round_id"`
+	Result       uint64          `db:"result"`
+	Data         json.RawMessage `db:"data"`
+	CreatedAt    time.Time       `db:"created_at"`
+}
+
+type EventRoundLeaderboard struct {
+	ID           uint64          `db:"id"`
+	EventID      uint64          `db:"event_id"`
+	RoundName    string          `db:"round_name"`
+	EventUserID  uint64          `db:"event_user_id"`
+	ClientUserID uint64          `db:"client_user_id"`
+	EventRoundID uint64          `db:"event_round_id"`
+	EventTeamID  sql.NullInt64   `db:"event_team_id"`
+	Result       uint64          `db:"result"`
+	Score        uint64          `db:"score"`
+	Ranking      uint64          `db:"ranking"`
+	Data         json.RawMessage `db:"data"`
+	CreatedAt    time.Time       `db:"created_at"`
+	UpdatedAt    time.Time       `db:"updated_at"`
+}
+
+type EventRoundUser struct {
+	ID           uint64          `db:"id"`
+	EventUserID  uint64          `db:"event_user_id"`
+	EventRoundID uint64          `db:"event_round_id"`
+	EventTeamID  sql.NullInt64   `db:"event_team_id"`
+	Result       uint64          `db:"result"`
+	Data         json.RawMessage `db:"data"`
+	CreatedAt    time.Time       `db:"created_at"`
+	UpdatedAt    time.Time       `db:"updated_at"`
+}
+
+type EventRoundUserRemoval struct {
+	ID               uint64          `db:"id"`
+	EventRoundUserID uint64          `db:"event_round_user_id"`
+	EventUserID      uint64          `db:"event_user_id"`
+	ClientUserID     uint64          `db:"client_user_id"`
+	EventRoundID     uint64          `db:"event_round_id"`
+	Result           uint64          `db:"result"`
+	Data             json.RawMessage `db:"data"`
+	CreatedAt        time.Time       `db:"created_at"`
+}
+
+type EventTeam struct {
+	ID        uint64    `db:"id"`
+	EventID   uint64    `db:"event_id"`
+	TeamID    uint64    `db:"team_id"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type EventTeamLeaderboard struct {
+	ID        uint64    `db:"id"`
+	EventID   uint64    `db:"event_id"`
+	TeamID    uint64    `db:"team_id"`
+	Score     uint64    `db:"score"`
+	Ranking   uint64    `db:"ranking"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type EventTemplate struct {
+	ID              uint64          `db:"id"`
+	Name            string          `db:"name"`
+	Data            json.RawMessage `db:"data"`
+	Rounds          json.RawMessage `db:"rounds"`
+	CronSchedule    sql.NullString  `db:"cron_schedule"`
+	LeadTimeSeconds uint32          `db:"lead_time_seconds"`
+	ScheduledUntil  sql.NullTime    `db:"scheduled_until"`
+	CreatedAt       time.Time       `db:"created_at"`
+	UpdatedAt       time.Time       `db:"updated_at"`
+}
+
+type EventThirdPartyDelivery struct {
+	EventID        uint64           `db:"event_id"`
+	ExcludedRounds *json.RawMessage `db:"excluded_rounds"`
+	TopLimit       sql.NullInt32    `db:"top_limit"`
+	Fields         *json.RawMessage `db:"fields"`
+	Uri            sql.NullString   `db:"uri"`
+	CreatedAt      time.Time        `db:"created_at"`
+	UpdatedAt      time.Time        `db:"updated_at"`
+}
+
+type EventUser struct {
+	ID                     uint64          `db:"id"`
+	EventID                uint64          `db:"event_id"`
+	ClientUserID           uint64          `db:"client_user_id"`
+	Data                   json.RawMessage `db:"data"`
+	DisqualifiedAt         sql.NullTime    `db:"disqualified_at"`
+	DisqualificationReason sql.NullString  `db:"disqualification_reason"`
+	DisqualifiedBy         sql.NullString  `db:"disqualified_by"`
+	CreatedAt              time.Time       `db:"created_at"`
+	UpdatedAt              time.Time       `db:"updated_at"`
+}
+
+type EventUserDisqualification struct {
+	ID          uint64                          `db:"id"`
+	EventUserID uint64                          `db:"event_user_id"`
+	Action      EventUserDisqualificationAction `db:"action"`
+	Reason      string                          `db:"reason"`
+	Moderator   string                          `db:"moderator"`
+	CreatedAt   time.Time                       `db:"created_at"`
+}
+
+type EventWithRound struct {
+	ID                        uint64                          `db:"id"`
+	Name                      string                          `db:"name"`
+	Status                    string                          `db:"status"`
+	CurrentRoundID            *uint64                         `db:"current_round_id"`
+	CurrentRoundName          *string                         `db:"current_round_name"`
+	Data                      json.RawMessage                 `db:"data"`
+	RoundID                   sql.NullInt64                   `db:"round_id"`
+	RoundName                 sql.NullString                  `db:"round_name"`
+	RoundScoring              json.RawMessage                 `db:"round_scoring"`
+	RoundResultOrder          NullEventRoundResultOrder       `db:"round_result_order"`
+	RoundResultAggregation    NullEventRoundResultAggregation `db:"round_result_aggregation"`
+	RoundResultAggregationTop sql.NullInt32                   `db:"round_result_aggregation_top"`
+	RoundData                 json.RawMessage                 `db:"round_data"`
+	RoundEndedAt              sql.NullTime                    `db:"round_ended_at"`
+	RoundCreatedAt            sql.NullTime                    `db:"round_created_at"`
+	RoundUpdatedAt            sql.NullTime                    `db:"round_updated_at"`
+	StartedAt                 time.Time                       `db:"started_at"`
+	EndedAt                   *time.Time                      `db:"ended_at"`
+	PausedAt                  sql.NullTime                    `db:"paused_at"`
+	CancelledAt               sql.NullTime                    `db:"cancelled_at"`
+	RegistrationRequired      bool                            `db:"registration_required"`
+	RegistrationOpensAt       sql.NullTime                    `db:"registration_opens_at"`
+	RegistrationClosesAt      sql.NullTime                    `db:"registration_closes_at"`
+	MaxParticipants           sql.NullInt32                   `db:"max_participants"`
+	Eligibility               *json.RawMessage                `db:"eligibility"`
+	CreatedAt                 time.Time                       `db:"created_at"`
+	UpdatedAt                 time.Time                       `db:"updated_at"`
+}
@@ -0,0 +1,30 @@
+-- name: GetScheduledEventTemplates :many
+SELECT *
+FROM event_template
+WHERE cron_schedule IS NOT NULL
+ORDER BY id ASC
+LIMIT ? OFFSET ?;
+-- name: UpdateEventTemplateScheduledUntil :execresult
+UPDATE event_template
+SET scheduled_until = sqlc.arg(scheduled_until)
+WHERE id = sqlc.arg(id)
+    AND (
+        scheduled_until IS NULL
+        OR scheduled_until < sqlc.arg(scheduled_until)
+    )
+LIMIT 1;
+-- name: CreateEvent :execresult
+INSERT INTO event (name, data, started_at)
+VALUES (?, ?, ?);
+-- name: CreateEventRound :execresult
+INSERT INTO event_round (
+        event_id,
+        name,
+        data,
+        scoring,
+        result_order,
+        result_aggregation,
+        result_aggregation_top,
+        ended_at
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
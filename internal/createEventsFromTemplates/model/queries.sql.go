@@ -0,0 +1,132 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: queries.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+const CreateEvent = `-- name: CreateEvent :execresult
+INSERT INTO event (name, data, started_at)
+VALUES (?, ?, ?)
+`
+
+type CreateEventParams struct {
+	Name      string          `db:"name"`
+	Data      json.RawMessage `db:"data"`
+	StartedAt time.Time       `db:"started_at"`
+}
+
+func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateEvent, arg.Name, arg.Data, arg.StartedAt)
+}
+
+const CreateEventRound = `-- name: CreateEventRound :execresult
+INSERT INTO event_round (
+        event_id,
+        name,
+        data,
+        scoring,
+        result_order,
+        result_aggregation,
+        result_aggregation_top,
+        ended_at
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateEventRoundParams struct {
+	EventID              uint64                      `db:"event_id"`
+	Name                 string                      `db:"name"`
+	Data                 json.RawMessage             `db:"data"`
+	Scoring              json.RawMessage             `db:"scoring"`
+	ResultOrder          EventRoundResultOrder       `db:"result_order"`
+	ResultAggregation    EventRoundResultAggregation `db:"result_aggregation"`
+	ResultAggregationTop sql.NullInt32               `db:"result_aggregation_top"`
+	EndedAt              time.Time                   `db:"ended_at"`
+}
+
+func (q *Queries) CreateEventRound(ctx context.Context, arg CreateEventRoundParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateEventRound,
+		arg.EventID,
+		arg.Name,
+		arg.Data,
+		arg.Scoring,
+		arg.ResultOrder,
+		arg.ResultAggregation,
+		arg.ResultAggregationTop,
+		arg.EndedAt,
+	)
+}
+
+const GetScheduledEventTemplates = `-- name: GetScheduledEventTemplates :many
+SELECT id, name, data, rounds, cron_schedule, lead_time_seconds, scheduled_until, created_at, updated_at
+FROM event_template
+WHERE cron_schedule IS NOT NULL
+ORDER BY id ASC
+LIMIT ? OFFSET ?
+`
+
+type GetScheduledEventTemplatesParams struct {
+	Limit  int32 `db:"limit"`
+	Offset int32 `db:"offset"`
+}
+
+func (q *Queries) GetScheduledEventTemplates(ctx context.Context, arg GetScheduledEventTemplatesParams) ([]EventTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, GetScheduledEventTemplates, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventTemplate
+	for rows.Next() {
+		var i EventTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Data,
+			&i.Rounds,
+			&i.CronSchedule,
+			&i.LeadTimeSeconds,
+			&i.ScheduledUntil,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const UpdateEventTemplateScheduledUntil = `-- name: UpdateEventTemplateScheduledUntil :execresult
+UPDATE event_template
+SET scheduled_until = ?
+WHERE id = ?
+    AND (
+        scheduled_until IS NULL
+        OR scheduled_until < ?
+    )
+LIMIT 1
+`
+
+type UpdateEventTemplateScheduledUntilParams struct {
+	ScheduledUntil sql.NullTime `db:"scheduled_until"`
+	ID             uint64       `db:"id"`
+}
+
+func (q *Queries) UpdateEventTemplateScheduledUntil(ctx context.Context, arg UpdateEventTemplateScheduledUntilParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, UpdateEventTemplateScheduledUntil, arg.ScheduledUntil, arg.ID, arg.ScheduledUntil)
+}
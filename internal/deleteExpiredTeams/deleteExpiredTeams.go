@@ -0,0 +1,69 @@
+package deleteExpiredTeams
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/MorhafAlshibly/coanda/internal/deleteExpiredTeams/model"
+)
+
+type App struct {
+	database    *model.Queries
+	gracePeriod time.Duration
+	limit       int32
+}
+
+func WithDatabase(database *model.Queries) func(*App) {
+	return func(input *App) {
+		input.database = database
+	}
+}
+
+func WithGracePeriod(gracePeriod time.Duration) func(*App) {
+	return func(input *App) {
+		input.gracePeriod = gracePeriod
+	}
+}
+
+func WithLimit(limit int32) func(*App) {
+	return func(input *App) {
+		input.limit = limit
+	}
+}
+
+func NewApp(options ...func(*App)) *App {
+	app := &App{
+		gracePeriod: 7 * 24 * time.Hour,
+		limit:       100,
+	}
+	for _, option := range options {
+		option(app)
+	}
+	return app
+}
+
+func (a *App) Handler(ctx context.Context) error {
+	// Teams soft deleted before this time have passed their grace period
+	deletedBefore := time.Now().UTC().Add(-a.gracePeriod)
+	// Delete in batches so a large backlog does not hold locks for too long
+	for {
+		result, err := a.database.DeleteExpiredTeams(ctx, model.DeleteExpiredTeamsParams{
+			DeletedAt: sql.NullTime{Time: deletedBefore, Valid: true},
+			Limit:     a.limit,
+		})
+		if err != nil {
+			fmt.Printf("failed to delete expired teams: %v", err)
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected < int64(a.limit) {
+			break
+		}
+	}
+	return nil
+}
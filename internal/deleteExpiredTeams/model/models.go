@@ -0,0 +1,88 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+
+package model
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+type RankedTeam struct {
+	ID          uint64          `db:"id"`
+	Name        string          `db:"name"`
+	Score       int64           `db:"score"`
+	Ranking     uint64          `db:"ranking"`
+	OwnerUserID sql.NullInt64   `db:"owner_user_id"`
+	Data        json.RawMessage `db:"data"`
+	CreatedAt   time.Time       `db:"created_at"`
+	UpdatedAt   time.Time       `db:"updated_at"`
+}
+
+type RankedTeamWithMember struct {
+	ID                      uint64          `db:"id"`
+	Name                    string          `db:"name"`
+	Score                   int64           `db:"score"`
+	Ranking                 uint64          `db:"ranking"`
+	OwnerUserID             sql.NullInt64   `db:"owner_user_id"`
+	Data                    json.RawMessage `db:"data"`
+	CreatedAt               time.Time       `db:"created_at"`
+	UpdatedAt               time.Time       `db:"updated_at"`
+	MemberID                sql.NullInt64   `db:"member_id"`
+	UserID                  sql.NullInt64   `db:"user_id"`
+	MemberNumber            sql.NullInt32   `db:"member_number"`
+	MemberData              json.RawMessage `db:"member_data"`
+	JoinedAt                sql.NullTime    `db:"joined_at"`
+	MemberUpdatedAt         sql.NullTime    `db:"member_updated_at"`
+	MemberNumberWithoutGaps interface{}     `db:"member_number_without_gaps"`
+}
+
+type Team struct {
+	ID          uint64          `db:"id"`
+	Name        string          `db:"name"`
+	Score       int64           `db:"score"`
+	OwnerUserID sql.NullInt64   `db:"owner_user_id"`
+	Data        json.RawMessage `db:"data"`
+	CreatedAt   time.Time       `db:"created_at"`
+	UpdatedAt   time.Time       `db:"updated_at"`
+	DeletedAt   sql.NullTime    `db:"deleted_at"`
+}
+
+type TeamMember struct {
+	ID           uint64          `db:"id"`
+	UserID       uint64          `db:"user_id"`
+	TeamID       uint64          `db:"team_id"`
+	MemberNumber uint32          `db:"member_number"`
+	Data         json.RawMessage `db:"data"`
+	JoinedAt     time.Time       `db:"joined_at"`
+	UpdatedAt    time.Time       `db:"updated_at"`
+}
+
+type TeamMessage struct {
+	ID        uint64          `db:"id"`
+	TeamID    uint64          `db:"team_id"`
+	UserID    uint64          `db:"user_id"`
+	Content   string          `db:"content"`
+	Pinned    bool            `db:"pinned"`
+	Data      json.RawMessage `db:"data"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
+}
+
+type TeamSeason struct {
+	ID          uint64          `db:"id"`
+	SeasonLabel string          `db:"season_label"`
+	TeamID      sql.NullInt64   `db:"team_id"`
+	Name        string          `db:"name"`
+	Score       int64           `db:"score"`
+	Ranking     uint64          `db:"ranking"`
+	Data        json.RawMessage `db:"data"`
+	ArchivedAt  time.Time       `db:"archived_at"`
+}
+
+type TeamWithFirstOpenMember struct {
+	ID              uint64 `db:"id"`
+	FirstOpenMember uint32 `db:"first_open_member"`
+}
@@ -0,0 +1,5 @@
+-- name: DeleteExpiredTeams :execresult
+DELETE FROM team
+WHERE deleted_at IS NOT NULL
+    AND deleted_at < ?
+LIMIT ?;
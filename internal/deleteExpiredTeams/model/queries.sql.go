@@ -0,0 +1,27 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: queries.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+)
+
+const DeleteExpiredTeams = `-- name: DeleteExpiredTeams :execresult
+DELETE FROM team
+WHERE deleted_at IS NOT NULL
+    AND deleted_at < ?
+LIMIT ?
+`
+
+type DeleteExpiredTeamsParams struct {
+	DeletedAt sql.NullTime `db:"deleted_at"`
+	Limit     int32        `db:"limit"`
+}
+
+func (q *Queries) DeleteExpiredTeams(ctx context.Context, arg DeleteExpiredTeamsParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, DeleteExpiredTeams, arg.DeletedAt, arg.Limit)
+}
@@ -91,6 +91,21 @@ func (c *AddEventResultCommand) Execute(ctx context.Context) error {
 		}
 		return err
 	}
+	// Results cannot be added to a cancelled or paused event
+	if event.CancelledAt.Valid {
+		c.Out = &api.AddEventResultResponse{
+			Success: false,
+			Error:   api.AddEventResultResponse_EVENT_CANCELLED,
+		}
+		return nil
+	}
+	if event.PausedAt.Valid {
+		c.Out = &api.AddEventResultResponse{
+			Success: false,
+			Error:   api.AddEventResultResponse_EVENT_PAUSED,
+		}
+		return nil
+	}
 	// Get current event round and lock it for update
 	eventRound, err := qtx.GetEventRound(ctx, model.GetEventRoundParams{
 		Event: model.GetEventParams{
@@ -122,6 +137,25 @@ func (c *AddEventResultCommand) Execute(ctx context.Context) error {
 		if err != sql.ErrNoRows {
 			return err
 		}
+		// Users of events that require registration must register before adding a result
+		if event.RegistrationRequired {
+			c.Out = &api.AddEventResultResponse{
+				Success: false,
+				Error:   api.AddEventResultResponse_NOT_REGISTERED,
+			}
+			return nil
+		}
+		rErr, err := checkForEventRegistrationError(ctx, qtx, event, c.In.UserData)
+		if err != nil {
+			return err
+		}
+		if rErr != nil {
+			c.Out = &api.AddEventResultResponse{
+				Success: false,
+				Error:   conversion.Enum(*rErr, api.AddEventResultResponse_Error_value, api.AddEventResultResponse_REGISTRATION_CLOSED),
+			}
+			return nil
+		}
 		eventUserResult, err := qtx.CreateEventUser(ctx, model.CreateEventUserParams{
 			EventID:      event.ID,
 			ClientUserID: c.In.ClientUserId,
@@ -136,6 +170,14 @@ func (c *AddEventResultCommand) Execute(ctx context.Context) error {
 			return err
 		}
 	} else {
+		// Disqualified users cannot add results until they are reinstated
+		if eventUser.DisqualifiedAt.Valid {
+			c.Out = &api.AddEventResultResponse{
+				Success: false,
+				Error:   api.AddEventResultResponse_DISQUALIFIED,
+			}
+			return nil
+		}
 		// If event user exists, we will use its ID
 		eventUserId = int64(eventUser.ID)
 		// Update the event user data
@@ -160,43 +202,87 @@ func (c *AddEventResultCommand) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	// Credit the result to the team of the user if they are in one, adding the team to the event if needed
+	eventTeamId := sql.NullInt64{}
+	teamId, err := qtx.GetTeamIdByUserId(ctx, c.In.ClientUserId)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if err == nil {
+		eventTeamResult, err := qtx.CreateEventTeam(ctx, model.CreateEventTeamParams{
+			EventID: event.ID,
+			TeamID:  teamId,
+		})
+		if err != nil {
+			return err
+		}
+		id, err := eventTeamResult.LastInsertId()
+		if err != nil {
+			return err
+		}
+		eventTeamId = sql.NullInt64{Int64: id, Valid: true}
+	}
 	// Get event round user and lock it for update, if it doesnt exist, it will be created in the next step
 	_, err = qtx.GetEventRoundUserByEventUserIdAndRoundId(ctx, model.GetEventRoundUserByEventUserIdAndRoundIdParams{
 		EventUserID:  uint64(eventUserId),
 		EventRoundID: eventRound.ID,
 	}, &goquOptions.SelectDataset{Locked: true})
-	if err != nil {
-		// Event round user does not exist
-		if err != sql.ErrNoRows {
-			return err
-		}
-		// Try to create event user round
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	eventRoundUserExists := err == nil
+	if !eventRoundUserExists {
+		// Try to create event user round, where the first attempt is the result for every aggregation
 		_, err = qtx.CreateEventRoundUser(ctx, model.CreateEventRoundUserParams{
 			EventUserID:  uint64(eventUserId),
 			EventRoundID: eventRound.ID,
+			EventTeamID:  eventTeamId,
 			Result:       c.In.Result,
 			Data:         roundUserData,
 		})
 		if err != nil {
 			return err
 		}
-	} else {
-		// Update the event round user
-		eventRoundUserResult, err := qtx.UpdateEventRoundUser(ctx, model.UpdateEventRoundUserParams{
+	}
+	if eventRoundUserExists {
+		// Results added before attempts were logged have none, so their result becomes the first attempt to not be lost in the aggregation
+		_, err = qtx.CreateEventRoundAttemptFromEventRoundUser(ctx, model.CreateEventRoundAttemptFromEventRoundUserParams{
 			EventUserID:  uint64(eventUserId),
 			EventRoundID: eventRound.ID,
-			Result:       c.In.Result,
-			Data:         roundUserData,
 		})
 		if err != nil {
 			return err
 		}
-		rowsAffected, err := eventRoundUserResult.RowsAffected()
+	}
+	// Log the attempt
+	_, err = qtx.CreateEventRoundAttempt(ctx, model.CreateEventRoundAttemptParams{
+		EventUserID:  uint64(eventUserId),
+		EventRoundID: eventRound.ID,
+		Result:       c.In.Result,
+		Data:         roundUserData,
+	})
+	if err != nil {
+		return err
+	}
+	if eventRoundUserExists {
+		// Aggregate every attempt of the user in the round into their result
+		results, err := qtx.GetEventRoundAttemptResults(ctx, model.GetEventRoundAttemptResultsParams{
+			EventUserID:  uint64(eventUserId),
+			EventRoundID: eventRound.ID,
+		})
 		if err != nil {
 			return err
 		}
-		if rowsAffected == 0 {
-			return errors.New("event round user was not updated")
+		// The event round user is locked, so no rows are affected only if the result and data are unchanged
+		_, err = qtx.UpdateEventRoundUser(ctx, model.UpdateEventRoundUserParams{
+			EventUserID:  uint64(eventUserId),
+			EventRoundID: eventRound.ID,
+			Result:       aggregateEventRoundResults(results, eventRound),
+			EventTeamID:  eventTeamId,
+			Data:         roundUserData,
+		})
+		if err != nil {
+			return err
 		}
 	}
 	// Commit the transaction
@@ -0,0 +1,91 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/goquOptions"
+)
+
+type CancelEventCommand struct {
+	service *Service
+	In      *api.EventRequest
+	Out     *api.CancelEventResponse
+}
+
+func NewCancelEventCommand(service *Service, in *api.EventRequest) *CancelEventCommand {
+	return &CancelEventCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *CancelEventCommand) Execute(ctx context.Context) error {
+	eErr := c.service.checkForEventRequestError(c.In)
+	// Check if error is found
+	if eErr != nil {
+		c.Out = &api.CancelEventResponse{
+			Success: false,
+			Error:   conversion.Enum(*eErr, api.CancelEventResponse_Error_value, api.CancelEventResponse_ID_OR_NAME_REQUIRED),
+		}
+		return nil
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	// Check if event exists and lock it for update
+	event, err := qtx.GetEvent(ctx, model.GetEventParams{
+		ID:   conversion.Uint64ToSqlNullInt64(c.In.Id),
+		Name: conversion.StringToSqlNullString(c.In.Name),
+	}, &goquOptions.SelectDataset{Locked: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.CancelEventResponse{
+				Success: false,
+				Error:   api.CancelEventResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	if event.CancelledAt.Valid {
+		c.Out = &api.CancelEventResponse{
+			Success: false,
+			Error:   api.CancelEventResponse_ALREADY_CANCELLED,
+		}
+		return nil
+	}
+	// An event that has ended cannot be cancelled, as it may already have been sent to the third party
+	eventWithRound, err := qtx.GetEventWithRound(ctx, model.GetEventParams{
+		ID: conversion.Uint64ToSqlNullInt64(&event.ID),
+	})
+	if err != nil {
+		return err
+	}
+	if len(eventWithRound) > 0 && eventWithRound[0].Status == "ended" {
+		c.Out = &api.CancelEventResponse{
+			Success: false,
+			Error:   api.CancelEventResponse_EVENT_ENDED,
+		}
+		return nil
+	}
+	_, err = qtx.CancelEvent(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.CancelEventResponse{
+		Success: true,
+		Error:   api.CancelEventResponse_NONE,
+	}
+	return nil
+}
@@ -66,8 +66,8 @@ func (c *CreateEventCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
-	// Check if we have any rounds
-	if len(c.In.Rounds) == 0 {
+	// Check if we have any rounds, unless the event is a draft
+	if len(c.In.Rounds) == 0 && !c.In.Draft {
 		c.Out = &api.CreateEventResponse{
 			Success: false,
 			Error:   api.CreateEventResponse_ROUNDS_REQUIRED,
@@ -82,6 +82,22 @@ func (c *CreateEventCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
+	// Check if registration closes after it opens
+	if c.In.RegistrationOpensAt != nil && c.In.RegistrationClosesAt != nil && c.In.RegistrationClosesAt.AsTime().Before(c.In.RegistrationOpensAt.AsTime()) {
+		c.Out = &api.CreateEventResponse{
+			Success: false,
+			Error:   api.CreateEventResponse_REGISTRATION_CLOSES_AT_BEFORE_OPENS_AT,
+		}
+		return nil
+	}
+	// Check if eligibility rules are valid
+	if !isEventEligibilityValid(c.In.Eligibility) {
+		c.Out = &api.CreateEventResponse{
+			Success: false,
+			Error:   api.CreateEventResponse_ELIGIBILITY_INVALID,
+		}
+		return nil
+	}
 	for _, round := range c.In.Rounds {
 		// Check if round name is large enough
 		if len(round.Name) < int(c.service.minRoundNameLength) {
@@ -124,18 +140,38 @@ func (c *CreateEventCommand) Execute(ctx context.Context) error {
 			return nil
 		}
 		// Check if round scoring is provided
-		if len(round.Scoring) == 0 {
+		if len(round.Scoring) == 0 && round.ScoringFormula == nil {
 			c.Out = &api.CreateEventResponse{
 				Success: false,
 				Error:   api.CreateEventResponse_ROUND_SCORING_REQUIRED,
 			}
 			return nil
 		}
+		// Check if round scoring is valid
+		if !isEventRoundScoringValid(round.Scoring, round.ScoringFormula) {
+			c.Out = &api.CreateEventResponse{
+				Success: false,
+				Error:   api.CreateEventResponse_ROUND_SCORING_INVALID,
+			}
+			return nil
+		}
+		// Check if the number of attempts to average is provided
+		if round.ResultAggregation == api.EventRoundResultAggregation_AVERAGE_OF_TOP && (round.ResultAggregationTop == nil || *round.ResultAggregationTop == 0) {
+			c.Out = &api.CreateEventResponse{
+				Success: false,
+				Error:   api.CreateEventResponse_ROUND_RESULT_AGGREGATION_TOP_REQUIRED,
+			}
+			return nil
+		}
 	}
 	data, err := conversion.ProtobufStructToRawJson(c.In.Data)
 	if err != nil {
 		return err
 	}
+	eligibility, err := marshalEventEligibility(c.In.Eligibility)
+	if err != nil {
+		return err
+	}
 	tx, err := c.service.sql.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -144,9 +180,14 @@ func (c *CreateEventCommand) Execute(ctx context.Context) error {
 	qtx := c.service.database.WithTx(tx)
 	// Create the event
 	eventResult, err := qtx.CreateEvent(ctx, model.CreateEventParams{
-		Name:      c.In.Name,
-		Data:      data,
-		StartedAt: c.In.StartedAt.AsTime(),
+		Name:                 c.In.Name,
+		Data:                 data,
+		StartedAt:            c.In.StartedAt.AsTime(),
+		RegistrationRequired: c.In.RegistrationRequired,
+		RegistrationOpensAt:  conversion.TimestampToSqlNullTime(c.In.RegistrationOpensAt),
+		RegistrationClosesAt: conversion.TimestampToSqlNullTime(c.In.RegistrationClosesAt),
+		MaxParticipants:      conversion.Uint32ToSqlNullInt32(c.In.MaxParticipants),
+		Eligibility:          eligibility,
 	})
 	// If the event already exists, return an error
 	if err != nil {
@@ -174,16 +215,19 @@ func (c *CreateEventCommand) Execute(ctx context.Context) error {
 			return err
 		}
 		// Convert scoring to json
-		roundScoring, err := conversion.MapToRawJson(map[string]interface{}{"scoring": round.Scoring})
+		roundScoring, err := marshalEventRoundScoring(round.Scoring, round.ScoringFormula)
 		if err != nil {
 			return err
 		}
 		_, err = qtx.CreateEventRound(ctx, model.CreateEventRoundParams{
-			EventID: uint64(eventID),
-			Name:    round.Name,
-			Data:    roundData,
-			Scoring: roundScoring,
-			EndedAt: round.EndedAt.AsTime(),
+			EventID:              uint64(eventID),
+			Name:                 round.Name,
+			Data:                 roundData,
+			Scoring:              roundScoring,
+			ResultOrder:          model.EventRoundResultOrder(round.ResultOrder.String()),
+			ResultAggregation:    model.EventRoundResultAggregation(round.ResultAggregation.String()),
+			ResultAggregationTop: conversion.Uint32ToSqlNullInt32(round.ResultAggregationTop),
+			EndedAt:              round.EndedAt.AsTime(),
 		})
 		// If the round already exists, return an error
 		if err != nil {
@@ -0,0 +1,136 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
+	"github.com/MorhafAlshibly/coanda/pkg/eventTemplate"
+	"github.com/go-sql-driver/mysql"
+)
+
+type CreateEventFromTemplateCommand struct {
+	service *Service
+	In      *api.CreateEventFromTemplateRequest
+	Out     *api.CreateEventFromTemplateResponse
+}
+
+func NewCreateEventFromTemplateCommand(service *Service, in *api.CreateEventFromTemplateRequest) *CreateEventFromTemplateCommand {
+	return &CreateEventFromTemplateCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *CreateEventFromTemplateCommand) Execute(ctx context.Context) error {
+	tErr := c.service.checkForEventTemplateRequestError(c.In.Template)
+	if tErr != nil {
+		c.Out = &api.CreateEventFromTemplateResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.CreateEventFromTemplateResponse_Error_value, api.CreateEventFromTemplateResponse_ID_OR_NAME_REQUIRED),
+		}
+		return nil
+	}
+	// Check if event started at is provided
+	if c.In.StartedAt == nil {
+		c.Out = &api.CreateEventFromTemplateResponse{
+			Success: false,
+			Error:   api.CreateEventFromTemplateResponse_STARTED_AT_REQUIRED,
+		}
+		return nil
+	}
+	// Check if event started at is in the future
+	startedAt := c.In.StartedAt.AsTime()
+	if startedAt.Before(time.Now()) {
+		c.Out = &api.CreateEventFromTemplateResponse{
+			Success: false,
+			Error:   api.CreateEventFromTemplateResponse_STARTED_AT_IN_THE_PAST,
+		}
+		return nil
+	}
+	template, err := c.service.database.GetEventTemplate(ctx, model.GetEventTemplateParams{
+		ID:   conversion.Uint64ToSqlNullInt64(c.In.Template.Id),
+		Name: conversion.StringToSqlNullString(c.In.Template.Name),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.CreateEventFromTemplateResponse{
+				Success: false,
+				Error:   api.CreateEventFromTemplateResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	var rounds []eventTemplate.Round
+	err = json.Unmarshal(template.Rounds, &rounds)
+	if err != nil {
+		return err
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	// The event is named after the template and its start time, so an event can only be created once for each start minute
+	name := eventTemplate.EventName(template.Name, startedAt)
+	eventResult, err := qtx.CreateEvent(ctx, model.CreateEventParams{
+		Name:      name,
+		Data:      template.Data,
+		StartedAt: startedAt,
+	})
+	// If the event already exists, return an error
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) {
+			if errorcode.IsDuplicateEntry(mysqlErr, "event", "name") {
+				c.Out = &api.CreateEventFromTemplateResponse{
+					Success: false,
+					Error:   api.CreateEventFromTemplateResponse_ALREADY_EXISTS,
+				}
+				return nil
+			}
+		}
+		return err
+	}
+	eventID, err := eventResult.LastInsertId()
+	if err != nil {
+		return err
+	}
+	// Create the rounds, which were checked for duplicates when the template was created
+	for _, round := range rounds {
+		_, err = qtx.CreateEventRound(ctx, model.CreateEventRoundParams{
+			EventID:              uint64(eventID),
+			Name:                 round.Name,
+			Data:                 round.Data,
+			Scoring:              round.Scoring,
+			ResultOrder:          model.EventRoundResultOrder(round.ResultOrder),
+			ResultAggregation:    model.EventRoundResultAggregation(round.ResultAggregation),
+			ResultAggregationTop: conversion.Uint32ToSqlNullInt32(round.ResultAggregationTop),
+			EndedAt:              round.EndedAt(startedAt),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	// Commit the transaction
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	uint64EventID := uint64(eventID)
+	c.Out = &api.CreateEventFromTemplateResponse{
+		Success: true,
+		Id:      &uint64EventID,
+		Name:    &name,
+		Error:   api.CreateEventFromTemplateResponse_NONE,
+	}
+	return nil
+}
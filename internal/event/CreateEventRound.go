@@ -115,28 +115,47 @@ func (c *CreateEventRoundCommand) Execute(ctx context.Context) error {
 		return nil
 	}
 	// Check if round scoring is specified
-	if len(c.In.Round.Scoring) == 0 {
+	if len(c.In.Round.Scoring) == 0 && c.In.Round.ScoringFormula == nil {
 		c.Out = &api.CreateEventRoundResponse{
 			Success: false,
 			Error:   api.CreateEventRoundResponse_ROUND_SCORING_REQUIRED,
 		}
 		return nil
 	}
+	// Check if round scoring is valid
+	if !isEventRoundScoringValid(c.In.Round.Scoring, c.In.Round.ScoringFormula) {
+		c.Out = &api.CreateEventRoundResponse{
+			Success: false,
+			Error:   api.CreateEventRoundResponse_ROUND_SCORING_INVALID,
+		}
+		return nil
+	}
+	// Check if the number of attempts to average is provided
+	if c.In.Round.ResultAggregation == api.EventRoundResultAggregation_AVERAGE_OF_TOP && (c.In.Round.ResultAggregationTop == nil || *c.In.Round.ResultAggregationTop == 0) {
+		c.Out = &api.CreateEventRoundResponse{
+			Success: false,
+			Error:   api.CreateEventRoundResponse_ROUND_RESULT_AGGREGATION_TOP_REQUIRED,
+		}
+		return nil
+	}
 	data, err := conversion.ProtobufStructToRawJson(c.In.Round.Data)
 	if err != nil {
 		return err
 	}
-	scoring, err := conversion.MapToRawJson(map[string]interface{}{"scoring": c.In.Round.Scoring})
+	scoring, err := marshalEventRoundScoring(c.In.Round.Scoring, c.In.Round.ScoringFormula)
 	if err != nil {
 		return err
 	}
 	// Create event round
 	result, err := qtx.CreateEventRound(ctx, model.CreateEventRoundParams{
-		EventID: event.ID,
-		Name:    c.In.Round.Name,
-		Data:    data,
-		Scoring: scoring,
-		EndedAt: c.In.Round.EndedAt.AsTime(),
+		EventID:              event.ID,
+		Name:                 c.In.Round.Name,
+		Data:                 data,
+		Scoring:              scoring,
+		ResultOrder:          model.EventRoundResultOrder(c.In.Round.ResultOrder.String()),
+		ResultAggregation:    model.EventRoundResultAggregation(c.In.Round.ResultAggregation.String()),
+		ResultAggregationTop: conversion.Uint32ToSqlNullInt32(c.In.Round.ResultAggregationTop),
+		EndedAt:              c.In.Round.EndedAt.AsTime(),
 	})
 	// If the round already exists, return an error
 	if err != nil {
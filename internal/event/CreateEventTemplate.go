@@ -0,0 +1,205 @@
+package event
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
+	"github.com/MorhafAlshibly/coanda/pkg/eventTemplate"
+	"github.com/go-sql-driver/mysql"
+)
+
+type CreateEventTemplateCommand struct {
+	service *Service
+	In      *api.CreateEventTemplateRequest
+	Out     *api.CreateEventTemplateResponse
+}
+
+func NewCreateEventTemplateCommand(service *Service, in *api.CreateEventTemplateRequest) *CreateEventTemplateCommand {
+	return &CreateEventTemplateCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *CreateEventTemplateCommand) Execute(ctx context.Context) error {
+	// Check if template name is large enough
+	if len(c.In.Name) < int(c.service.minEventNameLength) {
+		c.Out = &api.CreateEventTemplateResponse{
+			Success: false,
+			Error:   api.CreateEventTemplateResponse_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	// Check if template name leaves room for the start time in the names of its events
+	if len(c.In.Name) > eventTemplate.MaxNameLength(int(c.service.maxEventNameLength)) {
+		c.Out = &api.CreateEventTemplateResponse{
+			Success: false,
+			Error:   api.CreateEventTemplateResponse_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	// Check if data is provided
+	if c.In.Data == nil {
+		c.Out = &api.CreateEventTemplateResponse{
+			Success: false,
+			Error:   api.CreateEventTemplateResponse_DATA_REQUIRED,
+		}
+		return nil
+	}
+	// Check if we have any rounds
+	if len(c.In.Rounds) == 0 {
+		c.Out = &api.CreateEventTemplateResponse{
+			Success: false,
+			Error:   api.CreateEventTemplateResponse_ROUNDS_REQUIRED,
+		}
+		return nil
+	}
+	// Check if we have too many rounds
+	if len(c.In.Rounds) > int(c.service.maxNumberOfRounds) {
+		c.Out = &api.CreateEventTemplateResponse{
+			Success: false,
+			Error:   api.CreateEventTemplateResponse_TOO_MANY_ROUNDS,
+		}
+		return nil
+	}
+	// Check if the cron schedule is valid
+	if c.In.CronSchedule != nil {
+		_, err := eventTemplate.ParseCronSchedule(*c.In.CronSchedule)
+		if err != nil {
+			c.Out = &api.CreateEventTemplateResponse{
+				Success: false,
+				Error:   api.CreateEventTemplateResponse_CRON_SCHEDULE_INVALID,
+			}
+			return nil
+		}
+	}
+	// Check if the lead time is too long, as every event within the lead time is created at once
+	if c.In.LeadTimeSeconds > c.service.maxEventTemplateLeadTimeSeconds {
+		c.Out = &api.CreateEventTemplateResponse{
+			Success: false,
+			Error:   api.CreateEventTemplateResponse_LEAD_TIME_TOO_LONG,
+		}
+		return nil
+	}
+	// The rounds are stored together, so they are checked for duplicates here instead of by the database
+	roundNames := map[string]bool{}
+	roundEndedAfterSeconds := map[uint64]bool{}
+	for _, round := range c.In.Rounds {
+		// Check if round name is large enough
+		if len(round.Name) < int(c.service.minRoundNameLength) {
+			c.Out = &api.CreateEventTemplateResponse{
+				Success: false,
+				Error:   api.CreateEventTemplateResponse_ROUND_NAME_TOO_SHORT,
+			}
+			return nil
+		}
+		// Check if round name is small enough
+		if len(round.Name) > int(c.service.maxRoundNameLength) {
+			c.Out = &api.CreateEventTemplateResponse{
+				Success: false,
+				Error:   api.CreateEventTemplateResponse_ROUND_NAME_TOO_LONG,
+			}
+			return nil
+		}
+		// Check if round data is provided
+		if round.Data == nil {
+			c.Out = &api.CreateEventTemplateResponse{
+				Success: false,
+				Error:   api.CreateEventTemplateResponse_ROUND_DATA_REQUIRED,
+			}
+			return nil
+		}
+		// Check if the round ends after the event starts
+		if round.EndedAfterSeconds == 0 {
+			c.Out = &api.CreateEventTemplateResponse{
+				Success: false,
+				Error:   api.CreateEventTemplateResponse_ROUND_ENDED_AFTER_SECONDS_REQUIRED,
+			}
+			return nil
+		}
+		// Check if round scoring is provided
+		if len(round.Scoring) == 0 && round.ScoringFormula == nil {
+			c.Out = &api.CreateEventTemplateResponse{
+				Success: false,
+				Error:   api.CreateEventTemplateResponse_ROUND_SCORING_REQUIRED,
+			}
+			return nil
+		}
+		// Check if round scoring is valid
+		if !isEventRoundScoringValid(round.Scoring, round.ScoringFormula) {
+			c.Out = &api.CreateEventTemplateResponse{
+				Success: false,
+				Error:   api.CreateEventTemplateResponse_ROUND_SCORING_INVALID,
+			}
+			return nil
+		}
+		// Check if the number of attempts to average is provided
+		if round.ResultAggregation == api.EventRoundResultAggregation_AVERAGE_OF_TOP && (round.ResultAggregationTop == nil || *round.ResultAggregationTop == 0) {
+			c.Out = &api.CreateEventTemplateResponse{
+				Success: false,
+				Error:   api.CreateEventTemplateResponse_ROUND_RESULT_AGGREGATION_TOP_REQUIRED,
+			}
+			return nil
+		}
+		if roundNames[round.Name] {
+			c.Out = &api.CreateEventTemplateResponse{
+				Success: false,
+				Error:   api.CreateEventTemplateResponse_DUPLICATE_ROUND_NAME,
+			}
+			return nil
+		}
+		if roundEndedAfterSeconds[round.EndedAfterSeconds] {
+			c.Out = &api.CreateEventTemplateResponse{
+				Success: false,
+				Error:   api.CreateEventTemplateResponse_DUPLICATE_ROUND_ENDED_AFTER_SECONDS,
+			}
+			return nil
+		}
+		roundNames[round.Name] = true
+		roundEndedAfterSeconds[round.EndedAfterSeconds] = true
+	}
+	data, err := conversion.ProtobufStructToRawJson(c.In.Data)
+	if err != nil {
+		return err
+	}
+	rounds, err := marshalEventTemplateRounds(c.In.Rounds)
+	if err != nil {
+		return err
+	}
+	result, err := c.service.database.CreateEventTemplate(ctx, model.CreateEventTemplateParams{
+		Name:            c.In.Name,
+		Data:            data,
+		Rounds:          rounds,
+		CronSchedule:    conversion.StringToSqlNullString(c.In.CronSchedule),
+		LeadTimeSeconds: c.In.LeadTimeSeconds,
+	})
+	// If the template already exists, return an error
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) {
+			if errorcode.IsDuplicateEntry(mysqlErr, "event_template", "name") {
+				c.Out = &api.CreateEventTemplateResponse{
+					Success: false,
+					Error:   api.CreateEventTemplateResponse_ALREADY_EXISTS,
+				}
+				return nil
+			}
+		}
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	uint64Id := uint64(id)
+	c.Out = &api.CreateEventTemplateResponse{
+		Success: true,
+		Id:      &uint64Id,
+		Error:   api.CreateEventTemplateResponse_NONE,
+	}
+	return nil
+}
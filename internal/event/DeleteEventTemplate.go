@@ -0,0 +1,60 @@
+package event
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type DeleteEventTemplateCommand struct {
+	service *Service
+	In      *api.EventTemplateRequest
+	Out     *api.EventTemplateResponse
+}
+
+func NewDeleteEventTemplateCommand(service *Service, in *api.EventTemplateRequest) *DeleteEventTemplateCommand {
+	return &DeleteEventTemplateCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *DeleteEventTemplateCommand) Execute(ctx context.Context) error {
+	tErr := c.service.checkForEventTemplateRequestError(c.In)
+	// Check if error is found
+	if tErr != nil {
+		c.Out = &api.EventTemplateResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.EventTemplateResponse_Error_value, api.EventTemplateResponse_ID_OR_NAME_REQUIRED),
+		}
+		return nil
+	}
+	// Events already created from the template are kept
+	result, err := c.service.database.DeleteEventTemplate(ctx, model.GetEventTemplateParams{
+		ID:   conversion.Uint64ToSqlNullInt64(c.In.Id),
+		Name: conversion.StringToSqlNullString(c.In.Name),
+	})
+	// If an error occurs, it is an internal server error
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	// If no rows are affected, the template is not found
+	if rowsAffected == 0 {
+		c.Out = &api.EventTemplateResponse{
+			Success: false,
+			Error:   api.EventTemplateResponse_NOT_FOUND,
+		}
+		return nil
+	}
+	c.Out = &api.EventTemplateResponse{
+		Success: true,
+		Error:   api.EventTemplateResponse_NONE,
+	}
+	return nil
+}
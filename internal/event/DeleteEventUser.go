@@ -73,8 +73,32 @@ func (c *DeleteEventUserCommand) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	// If no rows are affected, the event user is not found
+	// If no rows are affected, the event user is either not found or disqualified
 	if rowsAffected == 0 {
+		eventUser, err := qtx.GetEventUser(ctx, model.GetEventUserParams{
+			Event: model.GetEventParams{
+				ID: conversion.Uint64ToSqlNullInt64(c.In.Event.Id),
+			},
+			ID:           conversion.Uint64ToSqlNullInt64(c.In.Id),
+			ClientUserID: conversion.Uint64ToSqlNullInt64(c.In.ClientUserId),
+		}, nil)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Out = &api.EventUserResponse{
+					Success: false,
+					Error:   api.EventUserResponse_NOT_FOUND,
+				}
+				return nil
+			}
+			return err
+		}
+		if eventUser.DisqualifiedAt.Valid {
+			c.Out = &api.EventUserResponse{
+				Success: false,
+				Error:   api.EventUserResponse_DISQUALIFIED,
+			}
+			return nil
+		}
 		c.Out = &api.EventUserResponse{
 			Success: false,
 			Error:   api.EventUserResponse_NOT_FOUND,
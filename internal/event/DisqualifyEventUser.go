@@ -0,0 +1,125 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type DisqualifyEventUserCommand struct {
+	service *Service
+	In      *api.DisqualifyEventUserRequest
+	Out     *api.DisqualifyEventUserResponse
+}
+
+func NewDisqualifyEventUserCommand(service *Service, in *api.DisqualifyEventUserRequest) *DisqualifyEventUserCommand {
+	return &DisqualifyEventUserCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *DisqualifyEventUserCommand) Execute(ctx context.Context) error {
+	euErr := c.service.checkForEventUserRequestError(c.In.User)
+	// Check if error is found
+	if euErr != nil {
+		c.Out = &api.DisqualifyEventUserResponse{
+			Success: false,
+			Error:   conversion.Enum(*euErr, api.DisqualifyEventUserResponse_Error_value, api.DisqualifyEventUserResponse_ID_OR_NAME_REQUIRED),
+		}
+		return nil
+	}
+	// Check if reason is provided
+	if c.In.Reason == "" {
+		c.Out = &api.DisqualifyEventUserResponse{
+			Success: false,
+			Error:   api.DisqualifyEventUserResponse_REASON_REQUIRED,
+		}
+		return nil
+	}
+	// Check if moderator is provided
+	if c.In.Moderator == "" {
+		c.Out = &api.DisqualifyEventUserResponse{
+			Success: false,
+			Error:   api.DisqualifyEventUserResponse_MODERATOR_REQUIRED,
+		}
+		return nil
+	}
+	// Check if moderator is small enough
+	if len(c.In.Moderator) > maxModeratorLength {
+		c.Out = &api.DisqualifyEventUserResponse{
+			Success: false,
+			Error:   api.DisqualifyEventUserResponse_MODERATOR_TOO_LONG,
+		}
+		return nil
+	}
+	// Create empty event request if not provided
+	if c.In.User.Event == nil {
+		c.In.User.Event = &api.EventRequest{}
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	eventUser, err := qtx.GetEventUser(ctx, model.GetEventUserParams{
+		Event: model.GetEventParams{
+			ID:   conversion.Uint64ToSqlNullInt64(c.In.User.Event.Id),
+			Name: conversion.StringToSqlNullString(c.In.User.Event.Name),
+		},
+		ID:           conversion.Uint64ToSqlNullInt64(c.In.User.Id),
+		ClientUserID: conversion.Uint64ToSqlNullInt64(c.In.User.ClientUserId),
+	}, nil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.DisqualifyEventUserResponse{
+				Success: false,
+				Error:   api.DisqualifyEventUserResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	// The results of the user are kept, and are left out of the leaderboards while they are disqualified
+	result, err := qtx.DisqualifyEventUser(ctx, model.DisqualifyEventUserParams{
+		DisqualificationReason: sql.NullString{String: c.In.Reason, Valid: true},
+		DisqualifiedBy:         sql.NullString{String: c.In.Moderator, Valid: true},
+		ID:                     eventUser.ID,
+	})
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		c.Out = &api.DisqualifyEventUserResponse{
+			Success: false,
+			Error:   api.DisqualifyEventUserResponse_ALREADY_DISQUALIFIED,
+		}
+		return nil
+	}
+	_, err = qtx.CreateEventUserDisqualification(ctx, model.CreateEventUserDisqualificationParams{
+		EventUserID: eventUser.ID,
+		Action:      model.EventUserDisqualificationActionDisqualified,
+		Reason:      c.In.Reason,
+		Moderator:   c.In.Moderator,
+	})
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.DisqualifyEventUserResponse{
+		Success: true,
+		Error:   api.DisqualifyEventUserResponse_NONE,
+	}
+	return nil
+}
@@ -56,6 +56,18 @@ func (c *GetEventCommand) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	// Events without a delivery are sent with the defaults of the sender
+	var apiThirdPartyDelivery *api.EventThirdPartyDelivery
+	thirdPartyDelivery, err := qtx.GetEventThirdPartyDelivery(ctx, apiEvent.Id)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if err == nil {
+		apiThirdPartyDelivery, err = unmarshalEventThirdPartyDelivery(thirdPartyDelivery)
+		if err != nil {
+			return err
+		}
+	}
 	leaderboard, err := qtx.GetEventLeaderboard(ctx, model.GetEventLeaderboardParams{
 		Event: model.GetEventParams{
 			ID:   conversion.Uint64ToSqlNullInt64(c.In.Event.Id),
@@ -75,14 +87,31 @@ func (c *GetEventCommand) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	var apiTeamLeaderboard []*api.EventTeam
+	if c.In.TeamLeaderboard {
+		teamLeaderboard, err := qtx.GetEventTeamLeaderboard(ctx, model.GetEventLeaderboardParams{
+			Event: model.GetEventParams{
+				ID:   conversion.Uint64ToSqlNullInt64(c.In.Event.Id),
+				Name: conversion.StringToSqlNullString(c.In.Event.Name),
+			},
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			return err
+		}
+		apiTeamLeaderboard = unmarshalEventTeamLeaderboard(teamLeaderboard)
+	}
 	err = tx.Commit()
 	if err != nil {
 		return err
 	}
 	c.Out = &api.GetEventResponse{
-		Success:     true,
-		Event:       apiEvent,
-		Leaderboard: apiLeaderboard,
+		Success:            true,
+		Event:              apiEvent,
+		Leaderboard:        apiLeaderboard,
+		TeamLeaderboard:    apiTeamLeaderboard,
+		ThirdPartyDelivery: apiThirdPartyDelivery,
 	}
 	return nil
 }
@@ -0,0 +1,95 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type GetEventRoundAttemptsCommand struct {
+	service *Service
+	In      *api.GetEventRoundAttemptsRequest
+	Out     *api.GetEventRoundAttemptsResponse
+}
+
+func NewGetEventRoundAttemptsCommand(service *Service, in *api.GetEventRoundAttemptsRequest) *GetEventRoundAttemptsCommand {
+	return &GetEventRoundAttemptsCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetEventRoundAttemptsCommand) Execute(ctx context.Context) error {
+	euErr := c.service.checkForEventUserRequestError(c.In.User)
+	// Check if error is found
+	if euErr != nil {
+		c.Out = &api.GetEventRoundAttemptsResponse{
+			Success: false,
+			Error:   conversion.Enum(*euErr, api.GetEventRoundAttemptsResponse_Error_value, api.GetEventRoundAttemptsResponse_ID_OR_NAME_REQUIRED),
+		}
+		return nil
+	}
+	// Check if round name is the right length if provided
+	if c.In.RoundName != nil {
+		if len(*c.In.RoundName) < int(c.service.minRoundNameLength) {
+			c.Out = &api.GetEventRoundAttemptsResponse{
+				Success: false,
+				Error:   api.GetEventRoundAttemptsResponse_ROUND_NAME_TOO_SHORT,
+			}
+			return nil
+		}
+		if len(*c.In.RoundName) > int(c.service.maxRoundNameLength) {
+			c.Out = &api.GetEventRoundAttemptsResponse{
+				Success: false,
+				Error:   api.GetEventRoundAttemptsResponse_ROUND_NAME_TOO_LONG,
+			}
+			return nil
+		}
+	}
+	// Create blank event request if not provided
+	if c.In.User.Event == nil {
+		c.In.User.Event = &api.EventRequest{}
+	}
+	limit, offset := conversion.PaginationToLimitOffset(c.In.Pagination, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
+	eventUser, err := c.service.database.GetEventUser(ctx, model.GetEventUserParams{
+		Event: model.GetEventParams{
+			ID:   conversion.Uint64ToSqlNullInt64(c.In.User.Event.Id),
+			Name: conversion.StringToSqlNullString(c.In.User.Event.Name),
+		},
+		ID:           conversion.Uint64ToSqlNullInt64(c.In.User.Id),
+		ClientUserID: conversion.Uint64ToSqlNullInt64(c.In.User.ClientUserId),
+	}, nil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.GetEventRoundAttemptsResponse{
+				Success: false,
+				Error:   api.GetEventRoundAttemptsResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	attempts, err := c.service.database.GetEventRoundAttempts(ctx, model.GetEventRoundAttemptsParams{
+		EventID:     eventUser.EventID,
+		EventUserID: eventUser.ID,
+		RoundName:   conversion.StringToSqlNullString(c.In.RoundName),
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		return err
+	}
+	apiAttempts, err := unmarshalEventRoundAttempts(attempts)
+	if err != nil {
+		return err
+	}
+	c.Out = &api.GetEventRoundAttemptsResponse{
+		Success:  true,
+		Attempts: apiAttempts,
+		Error:    api.GetEventRoundAttemptsResponse_NONE,
+	}
+	return nil
+}
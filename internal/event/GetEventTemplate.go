@@ -0,0 +1,58 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type GetEventTemplateCommand struct {
+	service *Service
+	In      *api.EventTemplateRequest
+	Out     *api.GetEventTemplateResponse
+}
+
+func NewGetEventTemplateCommand(service *Service, in *api.EventTemplateRequest) *GetEventTemplateCommand {
+	return &GetEventTemplateCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetEventTemplateCommand) Execute(ctx context.Context) error {
+	tErr := c.service.checkForEventTemplateRequestError(c.In)
+	if tErr != nil {
+		c.Out = &api.GetEventTemplateResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.GetEventTemplateResponse_Error_value, api.GetEventTemplateResponse_ID_OR_NAME_REQUIRED),
+		}
+		return nil
+	}
+	template, err := c.service.database.GetEventTemplate(ctx, model.GetEventTemplateParams{
+		ID:   conversion.Uint64ToSqlNullInt64(c.In.Id),
+		Name: conversion.StringToSqlNullString(c.In.Name),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.GetEventTemplateResponse{
+				Success: false,
+				Error:   api.GetEventTemplateResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	apiTemplate, err := unmarshalEventTemplate(template)
+	if err != nil {
+		return err
+	}
+	c.Out = &api.GetEventTemplateResponse{
+		Success:  true,
+		Template: apiTemplate,
+		Error:    api.GetEventTemplateResponse_NONE,
+	}
+	return nil
+}
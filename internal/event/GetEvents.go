@@ -0,0 +1,49 @@
+package event
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type GetEventsCommand struct {
+	service *Service
+	In      *api.GetEventsRequest
+	Out     *api.GetEventsResponse
+}
+
+func NewGetEventsCommand(service *Service, in *api.GetEventsRequest) *GetEventsCommand {
+	return &GetEventsCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetEventsCommand) Execute(ctx context.Context) error {
+	limit, offset := conversion.PaginationToLimitOffset(c.In.Pagination, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
+	statuses := []string{}
+	for _, status := range c.In.Statuses {
+		statuses = append(statuses, status.String())
+	}
+	rows, err := c.service.database.GetEvents(ctx, model.GetEventsParams{
+		Statuses:      statuses,
+		StartedAfter:  conversion.TimestampToSqlNullTime(c.In.StartedAfter),
+		StartedBefore: conversion.TimestampToSqlNullTime(c.In.StartedBefore),
+		Limit:         limit,
+		Offset:        offset,
+	})
+	if err != nil {
+		return err
+	}
+	events, err := unmarshalEventsWithRound(rows)
+	if err != nil {
+		return err
+	}
+	c.Out = &api.GetEventsResponse{
+		Success: true,
+		Events:  events,
+	}
+	return nil
+}
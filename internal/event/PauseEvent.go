@@ -0,0 +1,98 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/goquOptions"
+)
+
+type PauseEventCommand struct {
+	service *Service
+	In      *api.EventRequest
+	Out     *api.PauseEventResponse
+}
+
+func NewPauseEventCommand(service *Service, in *api.EventRequest) *PauseEventCommand {
+	return &PauseEventCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *PauseEventCommand) Execute(ctx context.Context) error {
+	eErr := c.service.checkForEventRequestError(c.In)
+	// Check if error is found
+	if eErr != nil {
+		c.Out = &api.PauseEventResponse{
+			Success: false,
+			Error:   conversion.Enum(*eErr, api.PauseEventResponse_Error_value, api.PauseEventResponse_ID_OR_NAME_REQUIRED),
+		}
+		return nil
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	// Check if event exists and lock it for update
+	event, err := qtx.GetEvent(ctx, model.GetEventParams{
+		ID:   conversion.Uint64ToSqlNullInt64(c.In.Id),
+		Name: conversion.StringToSqlNullString(c.In.Name),
+	}, &goquOptions.SelectDataset{Locked: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.PauseEventResponse{
+				Success: false,
+				Error:   api.PauseEventResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	if event.CancelledAt.Valid {
+		c.Out = &api.PauseEventResponse{
+			Success: false,
+			Error:   api.PauseEventResponse_EVENT_CANCELLED,
+		}
+		return nil
+	}
+	if event.PausedAt.Valid {
+		c.Out = &api.PauseEventResponse{
+			Success: false,
+			Error:   api.PauseEventResponse_ALREADY_PAUSED,
+		}
+		return nil
+	}
+	// Check if the last round of the event has ended
+	eventWithRound, err := qtx.GetEventWithRound(ctx, model.GetEventParams{
+		ID: conversion.Uint64ToSqlNullInt64(&event.ID),
+	})
+	if err != nil {
+		return err
+	}
+	if len(eventWithRound) > 0 && eventWithRound[0].Status == "ended" {
+		c.Out = &api.PauseEventResponse{
+			Success: false,
+			Error:   api.PauseEventResponse_EVENT_ENDED,
+		}
+		return nil
+	}
+	_, err = qtx.PauseEvent(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.PauseEventResponse{
+		Success: true,
+		Error:   api.PauseEventResponse_NONE,
+	}
+	return nil
+}
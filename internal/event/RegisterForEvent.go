@@ -0,0 +1,146 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/goquOptions"
+)
+
+type RegisterForEventCommand struct {
+	service *Service
+	In      *api.RegisterForEventRequest
+	Out     *api.RegisterForEventResponse
+}
+
+func NewRegisterForEventCommand(service *Service, in *api.RegisterForEventRequest) *RegisterForEventCommand {
+	return &RegisterForEventCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *RegisterForEventCommand) Execute(ctx context.Context) error {
+	eErr := c.service.checkForEventRequestError(c.In.Event)
+	if eErr != nil {
+		c.Out = &api.RegisterForEventResponse{
+			Success: false,
+			Error:   conversion.Enum(*eErr, api.RegisterForEventResponse_Error_value, api.RegisterForEventResponse_ID_OR_NAME_REQUIRED),
+		}
+		return nil
+	}
+	// Check if user is provided
+	if c.In.ClientUserId == 0 {
+		c.Out = &api.RegisterForEventResponse{
+			Success: false,
+			Error:   api.RegisterForEventResponse_CLIENT_USER_ID_REQUIRED,
+		}
+		return nil
+	}
+	// Check if data is provided
+	if c.In.Data == nil {
+		c.Out = &api.RegisterForEventResponse{
+			Success: false,
+			Error:   api.RegisterForEventResponse_DATA_REQUIRED,
+		}
+		return nil
+	}
+	data, err := conversion.ProtobufStructToRawJson(c.In.Data)
+	if err != nil {
+		return err
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	// Check if event exists and lock it for update, so the number of users does not change
+	event, err := qtx.GetEvent(ctx, model.GetEventParams{
+		ID:   conversion.Uint64ToSqlNullInt64(c.In.Event.Id),
+		Name: conversion.StringToSqlNullString(c.In.Event.Name),
+	}, &goquOptions.SelectDataset{Locked: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.RegisterForEventResponse{
+				Success: false,
+				Error:   api.RegisterForEventResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	if event.CancelledAt.Valid {
+		c.Out = &api.RegisterForEventResponse{
+			Success: false,
+			Error:   api.RegisterForEventResponse_EVENT_CANCELLED,
+		}
+		return nil
+	}
+	eventWithRound, err := qtx.GetEventWithRound(ctx, model.GetEventParams{
+		ID: conversion.Uint64ToSqlNullInt64(&event.ID),
+	})
+	if err != nil {
+		return err
+	}
+	if len(eventWithRound) > 0 && eventWithRound[0].Status == "ended" {
+		c.Out = &api.RegisterForEventResponse{
+			Success: false,
+			Error:   api.RegisterForEventResponse_EVENT_ENDED,
+		}
+		return nil
+	}
+	// Check if the user is already registered
+	_, err = qtx.GetEventUser(ctx, model.GetEventUserParams{
+		Event: model.GetEventParams{
+			ID: conversion.Uint64ToSqlNullInt64(&event.ID),
+		},
+		ClientUserID: conversion.Uint64ToSqlNullInt64(&c.In.ClientUserId),
+	}, nil)
+	if err == nil {
+		c.Out = &api.RegisterForEventResponse{
+			Success: false,
+			Error:   api.RegisterForEventResponse_ALREADY_REGISTERED,
+		}
+		return nil
+	}
+	if err != sql.ErrNoRows {
+		return err
+	}
+	rErr, err := checkForEventRegistrationError(ctx, qtx, event, c.In.Data)
+	if err != nil {
+		return err
+	}
+	if rErr != nil {
+		c.Out = &api.RegisterForEventResponse{
+			Success: false,
+			Error:   conversion.Enum(*rErr, api.RegisterForEventResponse_Error_value, api.RegisterForEventResponse_REGISTRATION_CLOSED),
+		}
+		return nil
+	}
+	result, err := qtx.CreateEventUser(ctx, model.CreateEventUserParams{
+		EventID:      event.ID,
+		ClientUserID: c.In.ClientUserId,
+		Data:         data,
+	})
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.RegisterForEventResponse{
+		Success: true,
+		Id:      conversion.ValueToPointer(uint64(id)),
+		Error:   api.RegisterForEventResponse_NONE,
+	}
+	return nil
+}
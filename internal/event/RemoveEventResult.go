@@ -34,8 +34,14 @@ func (c *RemoveEventResultCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
-	result, err := c.service.database.DeleteEventRoundUser(ctx, c.In.Id)
-	// If an error occurs, it is an internal server error
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	// The result is copied to the removals before it is deleted, so removed results can still be audited
+	result, err := qtx.CreateEventRoundUserRemoval(ctx, c.In.Id)
 	if err != nil {
 		return err
 	}
@@ -51,6 +57,27 @@ func (c *RemoveEventResultCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
+	result, err = qtx.DeleteEventRoundUser(ctx, c.In.Id)
+	// If an error occurs, it is an internal server error
+	if err != nil {
+		return err
+	}
+	rowsAffected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	// If no rows are affected, the result was removed in the meantime
+	if rowsAffected == 0 {
+		c.Out = &api.RemoveEventResultResponse{
+			Success: false,
+			Error:   api.RemoveEventResultResponse_NOT_FOUND,
+		}
+		return nil
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
 	c.Out = &api.RemoveEventResultResponse{
 		Success: true,
 		Error:   api.RemoveEventResultResponse_NONE,
@@ -0,0 +1,85 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/goquOptions"
+)
+
+type ResumeEventCommand struct {
+	service *Service
+	In      *api.EventRequest
+	Out     *api.ResumeEventResponse
+}
+
+func NewResumeEventCommand(service *Service, in *api.EventRequest) *ResumeEventCommand {
+	return &ResumeEventCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *ResumeEventCommand) Execute(ctx context.Context) error {
+	eErr := c.service.checkForEventRequestError(c.In)
+	// Check if error is found
+	if eErr != nil {
+		c.Out = &api.ResumeEventResponse{
+			Success: false,
+			Error:   conversion.Enum(*eErr, api.ResumeEventResponse_Error_value, api.ResumeEventResponse_ID_OR_NAME_REQUIRED),
+		}
+		return nil
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	// Check if event exists and lock it for update
+	event, err := qtx.GetEvent(ctx, model.GetEventParams{
+		ID:   conversion.Uint64ToSqlNullInt64(c.In.Id),
+		Name: conversion.StringToSqlNullString(c.In.Name),
+	}, &goquOptions.SelectDataset{Locked: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.ResumeEventResponse{
+				Success: false,
+				Error:   api.ResumeEventResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	if !event.PausedAt.Valid {
+		c.Out = &api.ResumeEventResponse{
+			Success: false,
+			Error:   api.ResumeEventResponse_NOT_PAUSED,
+		}
+		return nil
+	}
+	// Give the rounds that were running back the time they were paused for
+	_, err = qtx.ExtendEventRoundsPastPause(ctx, model.ExtendEventRoundsPastPauseParams{
+		PausedAt: event.PausedAt.Time,
+		EventID:  event.ID,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = qtx.ResumeEvent(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.ResumeEventResponse{
+		Success: true,
+		Error:   api.ResumeEventResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type SetEventThirdPartyDeliveryCommand struct {
+	service *Service
+	In      *api.SetEventThirdPartyDeliveryRequest
+	Out     *api.SetEventThirdPartyDeliveryResponse
+}
+
+func NewSetEventThirdPartyDeliveryCommand(service *Service, in *api.SetEventThirdPartyDeliveryRequest) *SetEventThirdPartyDeliveryCommand {
+	return &SetEventThirdPartyDeliveryCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *SetEventThirdPartyDeliveryCommand) Execute(ctx context.Context) error {
+	eErr := c.service.checkForEventRequestError(c.In.Event)
+	if eErr != nil {
+		c.Out = &api.SetEventThirdPartyDeliveryResponse{
+			Success: false,
+			Error:   conversion.Enum(*eErr, api.SetEventThirdPartyDeliveryResponse_Error_value, api.SetEventThirdPartyDeliveryResponse_ID_OR_NAME_REQUIRED),
+		}
+		return nil
+	}
+	// Check if delivery is provided
+	if c.In.Delivery == nil {
+		c.Out = &api.SetEventThirdPartyDeliveryResponse{
+			Success: false,
+			Error:   api.SetEventThirdPartyDeliveryResponse_DELIVERY_REQUIRED,
+		}
+		return nil
+	}
+	// Check if the top limit sends at least one rank
+	if c.In.Delivery.TopLimit != nil && *c.In.Delivery.TopLimit == 0 {
+		c.Out = &api.SetEventThirdPartyDeliveryResponse{
+			Success: false,
+			Error:   api.SetEventThirdPartyDeliveryResponse_TOP_LIMIT_REQUIRED,
+		}
+		return nil
+	}
+	// Check if the uri is an absolute http uri
+	if c.In.Delivery.Uri != nil {
+		uri, err := url.ParseRequestURI(*c.In.Delivery.Uri)
+		if err != nil || (uri.Scheme != "http" && uri.Scheme != "https") || uri.Host == "" {
+			c.Out = &api.SetEventThirdPartyDeliveryResponse{
+				Success: false,
+				Error:   api.SetEventThirdPartyDeliveryResponse_URI_INVALID,
+			}
+			return nil
+		}
+	}
+	excludedRounds, fields, err := marshalEventThirdPartyDelivery(c.In.Delivery)
+	if err != nil {
+		return err
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	event, err := qtx.GetEvent(ctx, model.GetEventParams{
+		ID:   conversion.Uint64ToSqlNullInt64(c.In.Event.Id),
+		Name: conversion.StringToSqlNullString(c.In.Event.Name),
+	}, nil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.SetEventThirdPartyDeliveryResponse{
+				Success: false,
+				Error:   api.SetEventThirdPartyDeliveryResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	// Replace the delivery of the event, so anything not given goes back to the defaults of the sender
+	_, err = qtx.SetEventThirdPartyDelivery(ctx, model.SetEventThirdPartyDeliveryParams{
+		EventID:        event.ID,
+		ExcludedRounds: excludedRounds,
+		TopLimit:       conversion.Uint32ToSqlNullInt32(c.In.Delivery.TopLimit),
+		Fields:         fields,
+		Uri:            conversion.StringToSqlNullString(c.In.Delivery.Uri),
+	})
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.SetEventThirdPartyDeliveryResponse{
+		Success: true,
+		Error:   api.SetEventThirdPartyDeliveryResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/event/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/goquOptions"
+)
+
+type UnregisterFromEventCommand struct {
+	service *Service
+	In      *api.EventUserRequest
+	Out     *api.UnregisterFromEventResponse
+}
+
+func NewUnregisterFromEventCommand(service *Service, in *api.EventUserRequest) *UnregisterFromEventCommand {
+	return &UnregisterFromEventCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *UnregisterFromEventCommand) Execute(ctx context.Context) error {
+	euErr := c.service.checkForEventUserRequestError(c.In)
+	// Check if error is found
+	if euErr != nil {
+		c.Out = &api.UnregisterFromEventResponse{
+			Success: false,
+			Error:   conversion.Enum(*euErr, api.UnregisterFromEventResponse_Error_value, api.UnregisterFromEventResponse_ID_OR_NAME_REQUIRED),
+		}
+		return nil
+	}
+	// Create empty event request if not provided
+	if c.In.Event == nil {
+		c.In.Event = &api.EventRequest{}
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	eventUser, err := qtx.GetEventUser(ctx, model.GetEventUserParams{
+		Event: model.GetEventParams{
+			ID:   conversion.Uint64ToSqlNullInt64(c.In.Event.Id),
+			Name: conversion.StringToSqlNullString(c.In.Event.Name),
+		},
+		ID:           conversion.Uint64ToSqlNullInt64(c.In.Id),
+		ClientUserID: conversion.Uint64ToSqlNullInt64(c.In.ClientUserId),
+	}, nil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.UnregisterFromEventResponse{
+				Success: false,
+				Error:   api.UnregisterFromEventResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	// Disqualified users cannot leave, as that would remove the record of their disqualification
+	if eventUser.DisqualifiedAt.Valid {
+		c.Out = &api.UnregisterFromEventResponse{
+			Success: false,
+			Error:   api.UnregisterFromEventResponse_DISQUALIFIED,
+		}
+		return nil
+	}
+	// Lock the event before the user, in the same order as adding a result
+	event, err := qtx.GetEvent(ctx, model.GetEventParams{
+		ID: conversion.Uint64ToSqlNullInt64(&eventUser.EventID),
+	}, &goquOptions.SelectDataset{Locked: true})
+	if err != nil {
+		return err
+	}
+	// Users cannot leave once registration has closed
+	if event.RegistrationClosesAt.Valid && time.Now().After(event.RegistrationClosesAt.Time) {
+		c.Out = &api.UnregisterFromEventResponse{
+			Success: false,
+			Error:   api.UnregisterFromEventResponse_REGISTRATION_CLOSED,
+		}
+		return nil
+	}
+	// Removing the user would remove their results with them, so only users without results can leave.
+	// Results are added with the event locked, so none can be added before the user is removed.
+	hasResults, err := qtx.EventUserHasResults(ctx, eventUser.ID)
+	if err != nil {
+		return err
+	}
+	if hasResults {
+		c.Out = &api.UnregisterFromEventResponse{
+			Success: false,
+			Error:   api.UnregisterFromEventResponse_HAS_RESULTS,
+		}
+		return nil
+	}
+	result, err := qtx.DeleteEventUser(ctx, model.GetEventUserWithoutWriteLockingParams{
+		ID: conversion.Uint64ToSqlNullInt64(&eventUser.ID),
+	})
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	// If no rows are affected, the event user was removed in the meantime
+	if rowsAffected == 0 {
+		c.Out = &api.UnregisterFromEventResponse{
+			Success: false,
+			Error:   api.UnregisterFromEventResponse_NOT_FOUND,
+		}
+		return nil
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.UnregisterFromEventResponse{
+		Success: true,
+		Error:   api.UnregisterFromEventResponse_NONE,
+	}
+	return nil
+}
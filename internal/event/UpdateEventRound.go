@@ -45,13 +45,36 @@ func (c *UpdateEventRoundCommand) Execute(ctx context.Context) error {
 			return err
 		}
 	}
-	if len(c.In.Scoring) > 0 {
-		scoring, err = conversion.MapToRawJson(map[string]interface{}{"scoring": c.In.Scoring})
+	if !isEventRoundScoringValid(c.In.Scoring, c.In.ScoringFormula) {
+		c.Out = &api.UpdateEventRoundResponse{
+			Success: false,
+			Error:   api.UpdateEventRoundResponse_SCORING_INVALID,
+		}
+		return nil
+	}
+	if len(c.In.Scoring) > 0 || c.In.ScoringFormula != nil {
+		scoring, err = marshalEventRoundScoring(c.In.Scoring, c.In.ScoringFormula)
 		if err != nil {
 			return err
 		}
 	}
-	if c.In.Data == nil && len(c.In.Scoring) == 0 {
+	resultOrder := model.NullEventRoundResultOrder{}
+	if c.In.ResultOrder != nil {
+		resultOrder = model.NullEventRoundResultOrder{EventRoundResultOrder: model.EventRoundResultOrder(c.In.ResultOrder.String()), Valid: true}
+	}
+	resultAggregation := model.NullEventRoundResultAggregation{}
+	if c.In.ResultAggregation != nil {
+		resultAggregation = model.NullEventRoundResultAggregation{EventRoundResultAggregation: model.EventRoundResultAggregation(c.In.ResultAggregation.String()), Valid: true}
+	}
+	// The number of attempts to average is required when switching to the average of top aggregation
+	if (c.In.ResultAggregation != nil && *c.In.ResultAggregation == api.EventRoundResultAggregation_AVERAGE_OF_TOP && c.In.ResultAggregationTop == nil) || (c.In.ResultAggregationTop != nil && *c.In.ResultAggregationTop == 0) {
+		c.Out = &api.UpdateEventRoundResponse{
+			Success: false,
+			Error:   api.UpdateEventRoundResponse_RESULT_AGGREGATION_TOP_REQUIRED,
+		}
+		return nil
+	}
+	if c.In.Data == nil && len(c.In.Scoring) == 0 && c.In.ScoringFormula == nil && c.In.ResultOrder == nil && c.In.ResultAggregation == nil && c.In.ResultAggregationTop == nil {
 		c.Out = &api.UpdateEventRoundResponse{
 			Success: false,
 			Error:   api.UpdateEventRoundResponse_NO_UPDATE_SPECIFIED,
@@ -73,8 +96,11 @@ func (c *UpdateEventRoundCommand) Execute(ctx context.Context) error {
 			ID:   conversion.Uint64ToSqlNullInt64(c.In.Round.Id),
 			Name: conversion.StringToSqlNullString(c.In.Round.RoundName),
 		},
-		Data:    data,
-		Scoring: scoring,
+		Data:                 data,
+		Scoring:              scoring,
+		ResultOrder:          resultOrder,
+		ResultAggregation:    resultAggregation,
+		ResultAggregationTop: conversion.Uint32ToSqlNullInt32(c.In.ResultAggregationTop),
 	})
 	if err != nil {
 		return err
@@ -3,14 +3,21 @@ package event
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/MorhafAlshibly/coanda/api"
 	"github.com/MorhafAlshibly/coanda/internal/event/model"
 	"github.com/MorhafAlshibly/coanda/pkg/cache"
 	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/eventTemplate"
 	"github.com/MorhafAlshibly/coanda/pkg/invoker"
 	"github.com/MorhafAlshibly/coanda/pkg/metric"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type Service struct {
@@ -26,6 +33,8 @@ type Service struct {
 	maxNumberOfRounds    uint8
 	defaultMaxPageLength uint8
 	maxMaxPageLength     uint8
+	// Events are created up to the lead time in advance, so a long lead time creates many events at once
+	maxEventTemplateLeadTimeSeconds uint32
 }
 
 func WithSql(sql *sql.DB) func(*Service) {
@@ -94,6 +103,12 @@ func WithMaxMaxPageLength(maxMaxPageLength uint8) func(*Service) {
 	}
 }
 
+func WithMaxEventTemplateLeadTimeSeconds(maxEventTemplateLeadTimeSeconds uint32) func(*Service) {
+	return func(input *Service) {
+		input.maxEventTemplateLeadTimeSeconds = maxEventTemplateLeadTimeSeconds
+	}
+}
+
 func NewService(opts ...func(*Service)) *Service {
 	service := &Service{
 		minEventNameLength:   3,
@@ -103,6 +118,8 @@ func NewService(opts ...func(*Service)) *Service {
 		maxNumberOfRounds:    10,
 		defaultMaxPageLength: 10,
 		maxMaxPageLength:     100,
+		// 30 days
+		maxEventTemplateLeadTimeSeconds: 2592000,
 	}
 	for _, opt := range opts {
 		opt(service)
@@ -130,6 +147,17 @@ func (s *Service) GetEvent(ctx context.Context, in *api.GetEventRequest) (*api.G
 	return command.Out, nil
 }
 
+// GetEvents is cached like the other reads, so statuses that change with time can be stale for up to the cache expiration, which is why it is kept to seconds
+func (s *Service) GetEvents(ctx context.Context, in *api.GetEventsRequest) (*api.GetEventsResponse, error) {
+	command := NewGetEventsCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric).SetInvoker(invoker.NewCacheInvoker(s.cache))))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
 func (s *Service) UpdateEvent(ctx context.Context, in *api.UpdateEventRequest) (*api.UpdateEventResponse, error) {
 	command := NewUpdateEventCommand(s, in)
 	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
@@ -220,6 +248,26 @@ func (s *Service) AddEventResult(ctx context.Context, in *api.AddEventResultRequ
 	return command.Out, nil
 }
 
+func (s *Service) RegisterForEvent(ctx context.Context, in *api.RegisterForEventRequest) (*api.RegisterForEventResponse, error) {
+	command := NewRegisterForEventCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) UnregisterFromEvent(ctx context.Context, in *api.EventUserRequest) (*api.UnregisterFromEventResponse, error) {
+	command := NewUnregisterFromEventCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
 func (s *Service) RemoveEventResult(ctx context.Context, in *api.EventRoundUserRequest) (*api.RemoveEventResultResponse, error) {
 	command := NewRemoveEventResultCommand(s, in)
 	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
@@ -230,8 +278,370 @@ func (s *Service) RemoveEventResult(ctx context.Context, in *api.EventRoundUserR
 	return command.Out, nil
 }
 
+func (s *Service) GetEventRoundAttempts(ctx context.Context, in *api.GetEventRoundAttemptsRequest) (*api.GetEventRoundAttemptsResponse, error) {
+	command := NewGetEventRoundAttemptsCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric).SetInvoker(invoker.NewCacheInvoker(s.cache))))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) PauseEvent(ctx context.Context, in *api.EventRequest) (*api.PauseEventResponse, error) {
+	command := NewPauseEventCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) ResumeEvent(ctx context.Context, in *api.EventRequest) (*api.ResumeEventResponse, error) {
+	command := NewResumeEventCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) CancelEvent(ctx context.Context, in *api.EventRequest) (*api.CancelEventResponse, error) {
+	command := NewCancelEventCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) DisqualifyEventUser(ctx context.Context, in *api.DisqualifyEventUserRequest) (*api.DisqualifyEventUserResponse, error) {
+	command := NewDisqualifyEventUserCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) ReinstateEventUser(ctx context.Context, in *api.ReinstateEventUserRequest) (*api.ReinstateEventUserResponse, error) {
+	command := NewReinstateEventUserCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) SetEventThirdPartyDelivery(ctx context.Context, in *api.SetEventThirdPartyDeliveryRequest) (*api.SetEventThirdPartyDeliveryResponse, error) {
+	command := NewSetEventThirdPartyDeliveryCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) CreateEventTemplate(ctx context.Context, in *api.CreateEventTemplateRequest) (*api.CreateEventTemplateResponse, error) {
+	command := NewCreateEventTemplateCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) GetEventTemplate(ctx context.Context, in *api.EventTemplateRequest) (*api.GetEventTemplateResponse, error) {
+	command := NewGetEventTemplateCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric).SetInvoker(invoker.NewCacheInvoker(s.cache))))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) DeleteEventTemplate(ctx context.Context, in *api.EventTemplateRequest) (*api.EventTemplateResponse, error) {
+	command := NewDeleteEventTemplateCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) CreateEventFromTemplate(ctx context.Context, in *api.CreateEventFromTemplateRequest) (*api.CreateEventFromTemplateResponse, error) {
+	command := NewCreateEventFromTemplateCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
 // Utility functions
 
+// eventRoundScoring is the scoring of a round as stored in the database, either a scoring array by rank or a scoring formula
+type eventRoundScoring struct {
+	Scoring         []uint64                   `json:"scoring,omitempty"`
+	Type            string                     `json:"type,omitempty"`
+	PercentileBands []eventRoundPercentileBand `json:"percentileBands,omitempty"`
+	// Percentiles holds the points of every percentile up to the last band, so the leaderboard views can look up the points of a rank
+	Percentiles []uint64 `json:"percentiles,omitempty"`
+	Points      uint64   `json:"points,omitempty"`
+	Decay       uint64   `json:"decay,omitempty"`
+	Minimum     uint64   `json:"minimum,omitempty"`
+	Factor      float64  `json:"factor,omitempty"`
+	Maximum     *uint64  `json:"maximum,omitempty"`
+}
+
+type eventRoundPercentileBand struct {
+	Percentile uint32 `json:"percentile"`
+	Points     uint64 `json:"points"`
+}
+
+// isEventRoundScoringValid checks that only one of a scoring array or a scoring formula is given, and that the formula makes sense
+func isEventRoundScoringValid(scoring []uint64, formula *api.EventRoundScoringFormula) bool {
+	if formula == nil {
+		return true
+	}
+	if len(scoring) > 0 {
+		return false
+	}
+	switch formula.Type {
+	case api.EventRoundScoringFormulaType_PERCENTILE_BANDS:
+		if len(formula.PercentileBands) == 0 {
+			return false
+		}
+		// Bands must be in order from the top percentile
+		previous := uint32(0)
+		for _, band := range formula.PercentileBands {
+			if band.Percentile <= previous || band.Percentile > 100 {
+				return false
+			}
+			previous = band.Percentile
+		}
+		return true
+	case api.EventRoundScoringFormulaType_LINEAR_DECAY:
+		return formula.Points > 0 && formula.Decay > 0 && formula.Minimum <= formula.Points
+	case api.EventRoundScoringFormulaType_PROPORTIONAL_TO_RESULT:
+		return formula.Factor > 0 && !math.IsInf(formula.Factor, 0)
+	}
+	return false
+}
+
+func marshalEventRoundScoring(scoring []uint64, formula *api.EventRoundScoringFormula) (json.RawMessage, error) {
+	if formula == nil {
+		return json.Marshal(eventRoundScoring{Scoring: scoring})
+	}
+	roundScoring := eventRoundScoring{
+		Type:    strings.ToLower(formula.Type.String()),
+		Points:  formula.Points,
+		Decay:   formula.Decay,
+		Minimum: formula.Minimum,
+		Factor:  formula.Factor,
+		Maximum: formula.Maximum,
+	}
+	if formula.Type == api.EventRoundScoringFormulaType_PERCENTILE_BANDS {
+		roundScoring.PercentileBands = make([]eventRoundPercentileBand, 0, len(formula.PercentileBands))
+		roundScoring.Percentiles = make([]uint64, 0, formula.PercentileBands[len(formula.PercentileBands)-1].Percentile)
+		for _, band := range formula.PercentileBands {
+			roundScoring.PercentileBands = append(roundScoring.PercentileBands, eventRoundPercentileBand{
+				Percentile: band.Percentile,
+				Points:     band.Points,
+			})
+			for uint32(len(roundScoring.Percentiles)) < band.Percentile {
+				roundScoring.Percentiles = append(roundScoring.Percentiles, band.Points)
+			}
+		}
+	}
+	return json.Marshal(roundScoring)
+}
+
+func unmarshalEventRoundScoring(scoring json.RawMessage) ([]uint64, *api.EventRoundScoringFormula, error) {
+	var roundScoring eventRoundScoring
+	err := json.Unmarshal(scoring, &roundScoring)
+	if err != nil {
+		return nil, nil, err
+	}
+	if roundScoring.Type == "" {
+		if len(roundScoring.Scoring) == 0 {
+			return nil, nil, errors.New("round scoring is empty")
+		}
+		return roundScoring.Scoring, nil, nil
+	}
+	formulaType, ok := api.EventRoundScoringFormulaType_value[strings.ToUpper(roundScoring.Type)]
+	if !ok {
+		return nil, nil, errors.New("round scoring type is unknown")
+	}
+	percentileBands := make([]*api.EventRoundPercentileBand, 0, len(roundScoring.PercentileBands))
+	for _, band := range roundScoring.PercentileBands {
+		percentileBands = append(percentileBands, &api.EventRoundPercentileBand{
+			Percentile: band.Percentile,
+			Points:     band.Points,
+		})
+	}
+	return nil, &api.EventRoundScoringFormula{
+		Type:            api.EventRoundScoringFormulaType(formulaType),
+		PercentileBands: percentileBands,
+		Points:          roundScoring.Points,
+		Decay:           roundScoring.Decay,
+		Minimum:         roundScoring.Minimum,
+		Factor:          roundScoring.Factor,
+		Maximum:         roundScoring.Maximum,
+	}, nil
+}
+
+// aggregateEventRoundResults aggregates the results of every attempt of a user in a round, in the order they were added, into their result
+func aggregateEventRoundResults(results []uint64, round model.EventRound) uint64 {
+	if len(results) == 0 {
+		return 0
+	}
+	// Sort a copy of the results from the best result, which depends on the order results are ranked in
+	best := append([]uint64(nil), results...)
+	sort.Slice(best, func(i, j int) bool {
+		if round.ResultOrder == model.EventRoundResultOrderDesc {
+			return best[i] > best[j]
+		}
+		return best[i] < best[j]
+	})
+	switch round.ResultAggregation {
+	case model.EventRoundResultAggregationBest:
+		return best[0]
+	case model.EventRoundResultAggregationSum:
+		sum := uint64(0)
+		for _, result := range results {
+			sum += result
+		}
+		return sum
+	case model.EventRoundResultAggregationAverageOfTop:
+		top := len(best)
+		if round.ResultAggregationTop.Valid && round.ResultAggregationTop.Int32 > 0 && int(round.ResultAggregationTop.Int32) < top {
+			top = int(round.ResultAggregationTop.Int32)
+		}
+		sum := uint64(0)
+		for _, result := range best[:top] {
+			sum += result
+		}
+		return sum / uint64(top)
+	}
+	return results[len(results)-1]
+}
+
+// eventEligibilityRule is a rule on a number in the data of a user as stored in the database
+type eventEligibilityRule struct {
+	Key     string   `json:"key"`
+	Minimum *float64 `json:"minimum,omitempty"`
+	Maximum *float64 `json:"maximum,omitempty"`
+}
+
+// isEventEligibilityValid checks that every rule has a key and a bound, and that the minimum is not above the maximum
+func isEventEligibilityValid(eligibility []*api.EventEligibilityRule) bool {
+	for _, rule := range eligibility {
+		if rule.Key == "" || (rule.Minimum == nil && rule.Maximum == nil) {
+			return false
+		}
+		if rule.Minimum != nil && rule.Maximum != nil && *rule.Minimum > *rule.Maximum {
+			return false
+		}
+	}
+	return true
+}
+
+func marshalEventEligibility(eligibility []*api.EventEligibilityRule) (*json.RawMessage, error) {
+	if len(eligibility) == 0 {
+		return nil, nil
+	}
+	rules := make([]eventEligibilityRule, 0, len(eligibility))
+	for _, rule := range eligibility {
+		rules = append(rules, eventEligibilityRule{
+			Key:     rule.Key,
+			Minimum: rule.Minimum,
+			Maximum: rule.Maximum,
+		})
+	}
+	rulesJson, err := json.Marshal(rules)
+	if err != nil {
+		return nil, err
+	}
+	return conversion.ValueToPointer(json.RawMessage(rulesJson)), nil
+}
+
+func unmarshalEventEligibility(eligibility *json.RawMessage) ([]*api.EventEligibilityRule, error) {
+	if eligibility == nil {
+		return nil, nil
+	}
+	var rules []eventEligibilityRule
+	err := json.Unmarshal(*eligibility, &rules)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*api.EventEligibilityRule, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, &api.EventEligibilityRule{
+			Key:     rule.Key,
+			Minimum: rule.Minimum,
+			Maximum: rule.Maximum,
+		})
+	}
+	return out, nil
+}
+
+// isEventUserEligible checks the data of a user against every eligibility rule of the event, where a missing or non number value is not eligible
+func isEventUserEligible(eligibility *json.RawMessage, data *structpb.Struct) (bool, error) {
+	rules, err := unmarshalEventEligibility(eligibility)
+	if err != nil {
+		return false, err
+	}
+	for _, rule := range rules {
+		value, ok := data.GetFields()[rule.Key].GetKind().(*structpb.Value_NumberValue)
+		if !ok {
+			return false, nil
+		}
+		if rule.Minimum != nil && value.NumberValue < *rule.Minimum {
+			return false, nil
+		}
+		if rule.Maximum != nil && value.NumberValue > *rule.Maximum {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// checkForEventRegistrationError checks if a user can join the event, which should be locked for update so the number of users does not change
+func checkForEventRegistrationError(ctx context.Context, qtx *model.Queries, event model.Event, data *structpb.Struct) (*EventRequestError, error) {
+	now := time.Now()
+	if (event.RegistrationOpensAt.Valid && now.Before(event.RegistrationOpensAt.Time)) || (event.RegistrationClosesAt.Valid && now.After(event.RegistrationClosesAt.Time)) {
+		return conversion.ValueToPointer(REGISTRATION_CLOSED), nil
+	}
+	if event.MaxParticipants.Valid {
+		users, err := qtx.CountEventUsers(ctx, event.ID)
+		if err != nil {
+			return nil, err
+		}
+		if users >= int64(event.MaxParticipants.Int32) {
+			return conversion.ValueToPointer(EVENT_FULL), nil
+		}
+	}
+	eligible, err := isEventUserEligible(event.Eligibility, data)
+	if err != nil {
+		return nil, err
+	}
+	if !eligible {
+		return conversion.ValueToPointer(NOT_ELIGIBLE), nil
+	}
+	return nil, nil
+}
+
 func unmarshalEventWithRound(event []model.EventWithRound) (*api.Event, error) {
 	if len(event) == 0 {
 		return nil, nil
@@ -240,18 +650,35 @@ func unmarshalEventWithRound(event []model.EventWithRound) (*api.Event, error) {
 	if err != nil {
 		return nil, err
 	}
+	eligibility, err := unmarshalEventEligibility(event[0].Eligibility)
+	if err != nil {
+		return nil, err
+	}
 	eventWithRound := api.Event{
-		Id:               event[0].ID,
-		Name:             event[0].Name,
-		CurrentRoundId:   event[0].CurrentRoundID,
-		CurrentRoundName: event[0].CurrentRoundName,
-		Data:             data,
-		StartedAt:        conversion.TimeToTimestamppb(&event[0].StartedAt),
-		CreatedAt:        conversion.TimeToTimestamppb(&event[0].CreatedAt),
-		UpdatedAt:        conversion.TimeToTimestamppb(&event[0].UpdatedAt),
+		Id:                   event[0].ID,
+		Name:                 event[0].Name,
+		CurrentRoundId:       event[0].CurrentRoundID,
+		CurrentRoundName:     event[0].CurrentRoundName,
+		Data:                 data,
+		StartedAt:            conversion.TimeToTimestamppb(&event[0].StartedAt),
+		CreatedAt:            conversion.TimeToTimestamppb(&event[0].CreatedAt),
+		UpdatedAt:            conversion.TimeToTimestamppb(&event[0].UpdatedAt),
+		Status:               api.EventStatus(api.EventStatus_value[strings.ToUpper(event[0].Status)]),
+		EndedAt:              conversion.TimeToTimestamppb(event[0].EndedAt),
+		PausedAt:             conversion.SqlNullTimeToTimestamp(event[0].PausedAt),
+		CancelledAt:          conversion.SqlNullTimeToTimestamp(event[0].CancelledAt),
+		RegistrationRequired: event[0].RegistrationRequired,
+		RegistrationOpensAt:  conversion.SqlNullTimeToTimestamp(event[0].RegistrationOpensAt),
+		RegistrationClosesAt: conversion.SqlNullTimeToTimestamp(event[0].RegistrationClosesAt),
+		MaxParticipants:      conversion.SqlNullInt32ToUint32(event[0].MaxParticipants),
+		Eligibility:          eligibility,
 	}
 	rounds := make([]*api.EventRound, 0, len(event))
 	for _, round := range event {
+		// Draft events have no rounds
+		if !round.RoundID.Valid {
+			continue
+		}
 		roundId := conversion.SqlNullInt64ToInt64(round.RoundID)
 		if roundId == nil {
 			return nil, errors.New("round id is null")
@@ -260,23 +687,10 @@ func unmarshalEventWithRound(event []model.EventWithRound) (*api.Event, error) {
 		if roundName == nil {
 			return nil, errors.New("round name is null")
 		}
-		roundScoring, err := conversion.RawJsonToMap(round.RoundScoring)
+		scoringArray, scoringFormula, err := unmarshalEventRoundScoring(round.RoundScoring)
 		if err != nil {
 			return nil, err
 		}
-		// Check if we have scoring
-		if _, ok := roundScoring["scoring"]; !ok {
-			return nil, errors.New("round scoring is missing")
-		}
-		// Convert round scoring to uint64 array
-		scoringField := roundScoring["scoring"].([]interface{})
-		scoringArray := make([]uint64, 0, len(scoringField))
-		for _, score := range scoringField {
-			scoringArray = append(scoringArray, uint64(score.(float64)))
-		}
-		if len(scoringArray) == 0 {
-			return nil, errors.New("round scoring is empty")
-		}
 		roundData, err := conversion.RawJsonToProtobufStruct(round.RoundData)
 		if err != nil {
 			return nil, err
@@ -294,19 +708,41 @@ func unmarshalEventWithRound(event []model.EventWithRound) (*api.Event, error) {
 			return nil, errors.New("round updated at is null")
 		}
 		rounds = append(rounds, &api.EventRound{
-			Id:        uint64(*roundId),
-			Name:      *roundName,
-			Scoring:   scoringArray,
-			Data:      roundData,
-			EndedAt:   endedAt,
-			CreatedAt: createdAt,
-			UpdatedAt: updatedAt,
+			Id:                   uint64(*roundId),
+			Name:                 *roundName,
+			Scoring:              scoringArray,
+			ScoringFormula:       scoringFormula,
+			ResultOrder:          api.EventRoundResultOrder(api.EventRoundResultOrder_value[strings.ToUpper(string(round.RoundResultOrder.EventRoundResultOrder))]),
+			ResultAggregation:    api.EventRoundResultAggregation(api.EventRoundResultAggregation_value[strings.ToUpper(string(round.RoundResultAggregation.EventRoundResultAggregation))]),
+			ResultAggregationTop: conversion.SqlNullInt32ToUint32(round.RoundResultAggregationTop),
+			Data:                 roundData,
+			EndedAt:              endedAt,
+			CreatedAt:            createdAt,
+			UpdatedAt:            updatedAt,
 		})
 	}
 	eventWithRound.Rounds = rounds
 	return &eventWithRound, nil
 }
 
+// unmarshalEventsWithRound unmarshals the rows of many events, where the rows of each event are next to each other
+func unmarshalEventsWithRound(rows []model.EventWithRound) ([]*api.Event, error) {
+	events := []*api.Event{}
+	start := 0
+	for i := range rows {
+		if i+1 < len(rows) && rows[i+1].ID == rows[start].ID {
+			continue
+		}
+		event, err := unmarshalEventWithRound(rows[start : i+1])
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+		start = i + 1
+	}
+	return events, nil
+}
+
 func unmarshalEventLeaderboard(leaderboard []model.EventLeaderboard) ([]*api.EventUser, error) {
 	eventUsers := make([]*api.EventUser, 0, len(leaderboard))
 	for _, eventUser := range leaderboard {
@@ -328,32 +764,47 @@ func unmarshalEventLeaderboard(leaderboard []model.EventLeaderboard) ([]*api.Eve
 	return eventUsers, nil
 }
 
+func unmarshalEventTeamLeaderboard(leaderboard []model.EventTeamLeaderboard) []*api.EventTeam {
+	eventTeams := make([]*api.EventTeam, 0, len(leaderboard))
+	for _, eventTeam := range leaderboard {
+		eventTeams = append(eventTeams, &api.EventTeam{
+			Id:        eventTeam.ID,
+			EventId:   eventTeam.EventID,
+			TeamId:    eventTeam.TeamID,
+			Score:     eventTeam.Score,
+			Ranking:   eventTeam.Ranking,
+			CreatedAt: conversion.TimeToTimestamppb(&eventTeam.CreatedAt),
+			UpdatedAt: conversion.TimeToTimestamppb(&eventTeam.UpdatedAt),
+		})
+	}
+	return eventTeams
+}
+
 func unmarshalEventRound(eventRound model.EventRound) (*api.EventRound, error) {
 	data, err := conversion.RawJsonToProtobufStruct(eventRound.Data)
 	if err != nil {
 		return nil, err
 	}
-	// Convert scoring to uint64 array
-	scoringField, err := conversion.RawJsonToMap(eventRound.Scoring)
+	scoringArray, scoringFormula, err := unmarshalEventRoundScoring(eventRound.Scoring)
 	if err != nil {
 		return nil, err
 	}
-	scoringArray := make([]uint64, 0, len(scoringField))
-	for _, score := range scoringField["scoring"].([]interface{}) {
-		scoringArray = append(scoringArray, uint64(score.(float64)))
-	}
 	endedAt := conversion.TimeToTimestamppb(&eventRound.EndedAt)
 	createdAt := conversion.TimeToTimestamppb(&eventRound.CreatedAt)
 	updatedAt := conversion.TimeToTimestamppb(&eventRound.UpdatedAt)
 	return &api.EventRound{
-		Id:        eventRound.ID,
-		EventId:   eventRound.EventID,
-		Name:      eventRound.Name,
-		Scoring:   scoringArray,
-		Data:      data,
-		EndedAt:   endedAt,
-		CreatedAt: createdAt,
-		UpdatedAt: updatedAt,
+		Id:                   eventRound.ID,
+		EventId:              eventRound.EventID,
+		Name:                 eventRound.Name,
+		Scoring:              scoringArray,
+		ScoringFormula:       scoringFormula,
+		ResultOrder:          api.EventRoundResultOrder(api.EventRoundResultOrder_value[strings.ToUpper(string(eventRound.ResultOrder))]),
+		ResultAggregation:    api.EventRoundResultAggregation(api.EventRoundResultAggregation_value[strings.ToUpper(string(eventRound.ResultAggregation))]),
+		ResultAggregationTop: conversion.SqlNullInt32ToUint32(eventRound.ResultAggregationTop),
+		Data:                 data,
+		EndedAt:              endedAt,
+		CreatedAt:            createdAt,
+		UpdatedAt:            updatedAt,
 	}, nil
 }
 
@@ -369,6 +820,7 @@ func unmarshalEventRoundLeaderboard(leaderboard []model.EventRoundLeaderboard) (
 			EventUserId:  eventUser.EventUserID,
 			ClientUserId: eventUser.ClientUserID,
 			EventRoundId: eventUser.EventRoundID,
+			EventTeamId:  conversion.SqlNullInt64ToUint64(eventUser.EventTeamID),
 			Result:       eventUser.Result,
 			Ranking:      eventUser.Ranking,
 			Data:         data,
@@ -379,6 +831,25 @@ func unmarshalEventRoundLeaderboard(leaderboard []model.EventRoundLeaderboard) (
 	return eventUsers, nil
 }
 
+func unmarshalEventRoundAttempts(attempts []model.EventRoundAttempt) ([]*api.EventRoundAttempt, error) {
+	eventRoundAttempts := make([]*api.EventRoundAttempt, 0, len(attempts))
+	for _, attempt := range attempts {
+		data, err := conversion.RawJsonToProtobufStruct(attempt.Data)
+		if err != nil {
+			return nil, err
+		}
+		eventRoundAttempts = append(eventRoundAttempts, &api.EventRoundAttempt{
+			Id:           attempt.ID,
+			EventUserId:  attempt.EventUserID,
+			EventRoundId: attempt.EventRoundID,
+			Result:       attempt.Result,
+			Data:         data,
+			CreatedAt:    conversion.TimeToTimestamppb(&attempt.CreatedAt),
+		})
+	}
+	return eventRoundAttempts, nil
+}
+
 func unmarshalEventUser(eventUser model.EventLeaderboard) (*api.EventUser, error) {
 	data, err := conversion.RawJsonToProtobufStruct(eventUser.Data)
 	if err != nil {
@@ -387,17 +858,143 @@ func unmarshalEventUser(eventUser model.EventLeaderboard) (*api.EventUser, error
 	createdAt := conversion.TimeToTimestamppb(&eventUser.CreatedAt)
 	updatedAt := conversion.TimeToTimestamppb(&eventUser.UpdatedAt)
 	return &api.EventUser{
-		Id:           eventUser.ID,
-		EventId:      eventUser.EventID,
-		ClientUserId: eventUser.ClientUserID,
-		Score:        eventUser.Score,
-		Ranking:      eventUser.Ranking,
-		Data:         data,
-		CreatedAt:    createdAt,
-		UpdatedAt:    updatedAt,
+		Id:                     eventUser.ID,
+		EventId:                eventUser.EventID,
+		ClientUserId:           eventUser.ClientUserID,
+		Score:                  eventUser.Score,
+		Ranking:                eventUser.Ranking,
+		Data:                   data,
+		DisqualifiedAt:         conversion.SqlNullTimeToTimestamp(eventUser.DisqualifiedAt),
+		DisqualificationReason: conversion.SqlNullStringToString(eventUser.DisqualificationReason),
+		DisqualifiedBy:         conversion.SqlNullStringToString(eventUser.DisqualifiedBy),
+		CreatedAt:              createdAt,
+		UpdatedAt:              updatedAt,
+	}, nil
+}
+
+// marshalEventThirdPartyDelivery returns the excluded rounds and fields of the delivery as stored, which are null if none are given
+func marshalEventThirdPartyDelivery(delivery *api.EventThirdPartyDelivery) (*json.RawMessage, *json.RawMessage, error) {
+	var excludedRounds *json.RawMessage
+	if len(delivery.ExcludedRounds) > 0 {
+		excludedRoundsJson, err := json.Marshal(delivery.ExcludedRounds)
+		if err != nil {
+			return nil, nil, err
+		}
+		excludedRounds = conversion.ValueToPointer(json.RawMessage(excludedRoundsJson))
+	}
+	var fields *json.RawMessage
+	if len(delivery.Fields) > 0 {
+		// Fields are stored as the keys of the users sent to the third party
+		deliveryFields := make([]string, 0, len(delivery.Fields))
+		for _, field := range delivery.Fields {
+			deliveryFields = append(deliveryFields, strings.ToLower(field.String()))
+		}
+		fieldsJson, err := json.Marshal(deliveryFields)
+		if err != nil {
+			return nil, nil, err
+		}
+		fields = conversion.ValueToPointer(json.RawMessage(fieldsJson))
+	}
+	return excludedRounds, fields, nil
+}
+
+func unmarshalEventThirdPartyDelivery(delivery model.EventThirdPartyDelivery) (*api.EventThirdPartyDelivery, error) {
+	excludedRounds := []string{}
+	if delivery.ExcludedRounds != nil {
+		err := json.Unmarshal(*delivery.ExcludedRounds, &excludedRounds)
+		if err != nil {
+			return nil, err
+		}
+	}
+	fields := []api.EventThirdPartyDeliveryField{}
+	if delivery.Fields != nil {
+		var deliveryFields []string
+		err := json.Unmarshal(*delivery.Fields, &deliveryFields)
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range deliveryFields {
+			fields = append(fields, api.EventThirdPartyDeliveryField(api.EventThirdPartyDeliveryField_value[strings.ToUpper(field)]))
+		}
+	}
+	return &api.EventThirdPartyDelivery{
+		ExcludedRounds: excludedRounds,
+		TopLimit:       conversion.SqlNullInt32ToUint32(delivery.TopLimit),
+		Fields:         fields,
+		Uri:            conversion.SqlNullStringToString(delivery.Uri),
+	}, nil
+}
+
+func marshalEventTemplateRounds(rounds []*api.EventTemplateRound) (json.RawMessage, error) {
+	templateRounds := make([]eventTemplate.Round, 0, len(rounds))
+	for _, round := range rounds {
+		data, err := conversion.ProtobufStructToRawJson(round.Data)
+		if err != nil {
+			return nil, err
+		}
+		scoring, err := marshalEventRoundScoring(round.Scoring, round.ScoringFormula)
+		if err != nil {
+			return nil, err
+		}
+		templateRounds = append(templateRounds, eventTemplate.Round{
+			Name:                 round.Name,
+			Data:                 data,
+			Scoring:              scoring,
+			ResultOrder:          strings.ToLower(round.ResultOrder.String()),
+			ResultAggregation:    strings.ToLower(round.ResultAggregation.String()),
+			ResultAggregationTop: round.ResultAggregationTop,
+			EndedAfterSeconds:    round.EndedAfterSeconds,
+		})
+	}
+	return json.Marshal(templateRounds)
+}
+
+func unmarshalEventTemplate(template model.EventTemplate) (*api.EventTemplate, error) {
+	data, err := conversion.RawJsonToProtobufStruct(template.Data)
+	if err != nil {
+		return nil, err
+	}
+	var templateRounds []eventTemplate.Round
+	err = json.Unmarshal(template.Rounds, &templateRounds)
+	if err != nil {
+		return nil, err
+	}
+	rounds := make([]*api.EventTemplateRound, 0, len(templateRounds))
+	for _, round := range templateRounds {
+		roundData, err := conversion.RawJsonToProtobufStruct(round.Data)
+		if err != nil {
+			return nil, err
+		}
+		scoringArray, scoringFormula, err := unmarshalEventRoundScoring(round.Scoring)
+		if err != nil {
+			return nil, err
+		}
+		rounds = append(rounds, &api.EventTemplateRound{
+			Name:                 round.Name,
+			Data:                 roundData,
+			EndedAfterSeconds:    round.EndedAfterSeconds,
+			Scoring:              scoringArray,
+			ScoringFormula:       scoringFormula,
+			ResultOrder:          api.EventRoundResultOrder(api.EventRoundResultOrder_value[strings.ToUpper(round.ResultOrder)]),
+			ResultAggregation:    api.EventRoundResultAggregation(api.EventRoundResultAggregation_value[strings.ToUpper(round.ResultAggregation)]),
+			ResultAggregationTop: round.ResultAggregationTop,
+		})
+	}
+	return &api.EventTemplate{
+		Id:              template.ID,
+		Name:            template.Name,
+		Data:            data,
+		Rounds:          rounds,
+		CronSchedule:    conversion.SqlNullStringToString(template.CronSchedule),
+		LeadTimeSeconds: template.LeadTimeSeconds,
+		CreatedAt:       conversion.TimeToTimestamppb(&template.CreatedAt),
+		UpdatedAt:       conversion.TimeToTimestamppb(&template.UpdatedAt),
 	}, nil
 }
 
+// The moderator of a disqualification is stored in a VARCHAR(255)
+const maxModeratorLength = 255
+
 // Enum for errors
 type EventRequestError string
 
@@ -412,6 +1009,10 @@ const (
 	EVENT_USER_OR_ID_REQUIRED EventRequestError = "EVENT_USER_OR_ID_REQUIRED"
 	// Event round user errors
 	EVENT_ROUND_USER_OR_ID_REQUIRED EventRequestError = "EVENT_ROUND_USER_OR_ID_REQUIRED"
+	// Event registration errors
+	REGISTRATION_CLOSED EventRequestError = "REGISTRATION_CLOSED"
+	EVENT_FULL          EventRequestError = "EVENT_FULL"
+	NOT_ELIGIBLE        EventRequestError = "NOT_ELIGIBLE"
 )
 
 func (s *Service) checkForEventRequestError(request *api.EventRequest) *EventRequestError {
@@ -463,3 +1064,23 @@ func (s *Service) checkForEventUserRequestError(request *api.EventUserRequest) *
 	}
 	return s.checkForEventRequestError(request.Event)
 }
+
+// The events of a template are named after it with their start time appended, so the name must leave room for it
+func (s *Service) checkForEventTemplateRequestError(request *api.EventTemplateRequest) *EventRequestError {
+	if request == nil {
+		return conversion.ValueToPointer(ID_OR_NAME_REQUIRED)
+	}
+	if request.Id != nil {
+		return nil
+	}
+	if request.Name == nil {
+		return conversion.ValueToPointer(ID_OR_NAME_REQUIRED)
+	}
+	if len(*request.Name) < int(s.minEventNameLength) {
+		return conversion.ValueToPointer(NAME_TOO_SHORT)
+	}
+	if len(*request.Name) > eventTemplate.MaxNameLength(int(s.maxEventNameLength)) {
+		return conversion.ValueToPointer(NAME_TOO_LONG)
+	}
+	return nil
+}
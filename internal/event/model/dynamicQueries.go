@@ -30,7 +30,8 @@ func filterGetEventParams(arg GetEventParams) goqu.Expression {
 }
 
 func (q *Queries) GetEvent(ctx context.Context, arg GetEventParams, opts *goquOptions.SelectDataset) (Event, error) {
-	event := gq.From("event").Prepared(true).Select("id", "name", "data", "started_at", "sent_to_third_party_at", "created_at", "updated_at")
+	event := gq.From("event").Prepared(true).Select("id", "name", "data", "started_at", "paused_at", "cancelled_at",
+		"registration_required", "registration_opens_at", "registration_closes_at", "max_participants", "eligibility", "sent_to_third_party_at", "created_at", "updated_at")
 	query, args, err := goquOptions.Apply(opts, event.Where(filterGetEventParams(arg)).Limit(1)).ToSQL()
 	if err != nil {
 		return Event{}, err
@@ -41,6 +42,13 @@ func (q *Queries) GetEvent(ctx context.Context, arg GetEventParams, opts *goquOp
 		&i.Name,
 		&i.Data,
 		&i.StartedAt,
+		&i.PausedAt,
+		&i.CancelledAt,
+		&i.RegistrationRequired,
+		&i.RegistrationOpensAt,
+		&i.RegistrationClosesAt,
+		&i.MaxParticipants,
+		&i.Eligibility,
 		&i.SentToThirdPartyAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -57,10 +65,59 @@ func (q *Queries) DeleteEvent(ctx context.Context, arg GetEventParams) (sql.Resu
 	return q.db.ExecContext(ctx, query, args...)
 }
 
+type GetEventTemplateParams struct {
+	ID   sql.NullInt64  `db:"id"`
+	Name sql.NullString `db:"name"`
+}
+
+// filterGetEventTemplateParams filters the GetEventTemplateParams to goqu.Expression
+func filterGetEventTemplateParams(arg GetEventTemplateParams) goqu.Expression {
+	expressions := []goqu.Expression{}
+	if arg.ID.Valid {
+		expressions = append(expressions, goqu.C("id").Eq(arg.ID))
+	}
+	if arg.Name.Valid {
+		expressions = append(expressions, goqu.C("name").Eq(arg.Name))
+	}
+	return goqu.And(expressions...)
+}
+
+func (q *Queries) GetEventTemplate(ctx context.Context, arg GetEventTemplateParams) (EventTemplate, error) {
+	template := gq.From("event_template").Prepared(true).Select("id", "name", "data", "rounds", "cron_schedule", "lead_time_seconds", "created_at", "updated_at")
+	query, args, err := template.Where(filterGetEventTemplateParams(arg)).Limit(1).ToSQL()
+	if err != nil {
+		return EventTemplate{}, err
+	}
+	var i EventTemplate
+	err = q.db.QueryRowContext(ctx, query, args...).Scan(
+		&i.ID,
+		&i.Name,
+		&i.Data,
+		&i.Rounds,
+		&i.CronSchedule,
+		&i.LeadTimeSeconds,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+func (q *Queries) DeleteEventTemplate(ctx context.Context, arg GetEventTemplateParams) (sql.Result, error) {
+	template := gq.Delete("event_template").Prepared(true)
+	query, args, err := template.Where(filterGetEventTemplateParams(arg)).Limit(1).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	return q.db.ExecContext(ctx, query, args...)
+}
+
+var eventWithRoundColumns = []interface{}{"id", "name", "status", "current_round_id", "current_round_name", "data",
+	"round_id", "round_name", "round_scoring", "round_result_order", "round_result_aggregation", "round_result_aggregation_top", "round_data", "round_ended_at", "round_created_at", "round_updated_at",
+	"started_at", "ended_at", "paused_at", "cancelled_at",
+	"registration_required", "registration_opens_at", "registration_closes_at", "max_participants", "eligibility", "created_at", "updated_at"}
+
 func (q *Queries) GetEventWithRound(ctx context.Context, arg GetEventParams) ([]EventWithRound, error) {
-	event := gq.From("event_with_round").Prepared(true).Select("id", "name", "current_round_id", "current_round_name", "data",
-		"round_id", "round_name", "round_scoring", "round_data", "round_ended_at", "round_created_at", "round_updated_at",
-		"started_at", "created_at", "updated_at")
+	event := gq.From("event_with_round").Prepared(true).Select(eventWithRoundColumns...)
 	// TODO: Fix a limit to the query
 	query, args, err := event.Where(filterGetEventParams(arg)).ToSQL()
 	if err != nil {
@@ -77,17 +134,103 @@ func (q *Queries) GetEventWithRound(ctx context.Context, arg GetEventParams) ([]
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
+			&i.Status,
+			&i.CurrentRoundID,
+			&i.CurrentRoundName,
+			&i.Data,
+			&i.RoundID,
+			&i.RoundName,
+			&i.RoundScoring,
+			&i.RoundResultOrder,
+			&i.RoundResultAggregation,
+			&i.RoundResultAggregationTop,
+			&i.RoundData,
+			&i.RoundEndedAt,
+			&i.RoundCreatedAt,
+			&i.RoundUpdatedAt,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.PausedAt,
+			&i.CancelledAt,
+			&i.RegistrationRequired,
+			&i.RegistrationOpensAt,
+			&i.RegistrationClosesAt,
+			&i.MaxParticipants,
+			&i.Eligibility,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	return items, nil
+}
+
+type GetEventsParams struct {
+	Statuses      []string
+	StartedAfter  sql.NullTime
+	StartedBefore sql.NullTime
+	Limit         uint64
+	Offset        uint64
+}
+
+// filterGetEventsParams filters the GetEventsParams to goqu.Expression
+func filterGetEventsParams(arg GetEventsParams) goqu.Expression {
+	expressions := []goqu.Expression{}
+	if len(arg.Statuses) > 0 {
+		expressions = append(expressions, goqu.C("status").In(arg.Statuses))
+	}
+	if arg.StartedAfter.Valid {
+		expressions = append(expressions, goqu.C("started_at").Gt(arg.StartedAfter.Time))
+	}
+	if arg.StartedBefore.Valid {
+		expressions = append(expressions, goqu.C("started_at").Lt(arg.StartedBefore.Time))
+	}
+	// The page is picked from the events first, so rounds do not count towards the limit
+	return goqu.C("id").In(gq.From(gq.From("event_with_round").Select("id").Where(goqu.And(expressions...)).GroupBy("id", "started_at").Order(goqu.C("started_at").Asc(), goqu.C("id").Asc()).Limit(uint(arg.Limit)).Offset(uint(arg.Offset))))
+}
+
+func (q *Queries) GetEvents(ctx context.Context, arg GetEventsParams) ([]EventWithRound, error) {
+	event := gq.From("event_with_round").Prepared(true).Select(eventWithRoundColumns...)
+	query, args, err := event.Where(filterGetEventsParams(arg)).Order(goqu.C("started_at").Asc(), goqu.C("id").Asc(), goqu.C("round_ended_at").Asc()).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventWithRound
+	for rows.Next() {
+		var i EventWithRound
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Status,
 			&i.CurrentRoundID,
 			&i.CurrentRoundName,
 			&i.Data,
 			&i.RoundID,
 			&i.RoundName,
 			&i.RoundScoring,
+			&i.RoundResultOrder,
+			&i.RoundResultAggregation,
+			&i.RoundResultAggregationTop,
 			&i.RoundData,
 			&i.RoundEndedAt,
 			&i.RoundCreatedAt,
 			&i.RoundUpdatedAt,
 			&i.StartedAt,
+			&i.EndedAt,
+			&i.PausedAt,
+			&i.CancelledAt,
+			&i.RegistrationRequired,
+			&i.RegistrationOpensAt,
+			&i.RegistrationClosesAt,
+			&i.MaxParticipants,
+			&i.Eligibility,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -118,7 +261,8 @@ func filterGetEventLeaderboardParams(arg GetEventLeaderboardParams) goqu.Express
 
 func (q *Queries) GetEventLeaderboard(ctx context.Context, arg GetEventLeaderboardParams) ([]EventLeaderboard, error) {
 	leaderboard := gq.From("event_leaderboard").Prepared(true).Select("id", "event_id", "client_user_id", "score", "ranking", "data", "created_at", "updated_at")
-	query, args, err := leaderboard.Where(filterGetEventLeaderboardParams(arg)).Limit(uint(arg.Limit)).Offset(uint(arg.Offset)).ToSQL()
+	// Disqualified users are hidden from the leaderboard
+	query, args, err := leaderboard.Where(filterGetEventLeaderboardParams(arg), goqu.C("disqualified_at").IsNull()).Limit(uint(arg.Limit)).Offset(uint(arg.Offset)).ToSQL()
 	if err != nil {
 		return nil, err
 	}
@@ -147,6 +291,36 @@ func (q *Queries) GetEventLeaderboard(ctx context.Context, arg GetEventLeaderboa
 	return items, nil
 }
 
+func (q *Queries) GetEventTeamLeaderboard(ctx context.Context, arg GetEventLeaderboardParams) ([]EventTeamLeaderboard, error) {
+	leaderboard := gq.From("event_team_leaderboard").Prepared(true).Select("id", "event_id", "team_id", "score", "ranking", "created_at", "updated_at")
+	query, args, err := leaderboard.Where(filterGetEventLeaderboardParams(arg)).Limit(uint(arg.Limit)).Offset(uint(arg.Offset)).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventTeamLeaderboard
+	for rows.Next() {
+		var i EventTeamLeaderboard
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.TeamID,
+			&i.Score,
+			&i.Ranking,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	return items, nil
+}
+
 type UpdateEventParams struct {
 	Event GetEventParams
 	Data  json.RawMessage `db:"data"`
@@ -196,6 +370,9 @@ func (q *Queries) GetEventRound(ctx context.Context, arg GetEventRoundParams, op
 		"event_id",
 		"name",
 		"scoring",
+		"result_order",
+		"result_aggregation",
+		"result_aggregation_top",
 		"data",
 		"ended_at",
 		"sent_to_third_party_at",
@@ -212,6 +389,9 @@ func (q *Queries) GetEventRound(ctx context.Context, arg GetEventRoundParams, op
 		&i.EventID,
 		&i.Name,
 		&i.Scoring,
+		&i.ResultOrder,
+		&i.ResultAggregation,
+		&i.ResultAggregationTop,
 		&i.Data,
 		&i.EndedAt,
 		&i.SentToThirdPartyAt,
@@ -255,7 +435,7 @@ func filterGetEventRoundLeaderboardParams(arg GetEventRoundLeaderboardParams) go
 }
 
 func (q *Queries) GetEventRoundLeaderboard(ctx context.Context, arg GetEventRoundLeaderboardParams) ([]EventRoundLeaderboard, error) {
-	leaderboard := gq.From("event_round_leaderboard").Prepared(true).Select("id", "event_id", "round_name", "event_user_id", "client_user_id", "event_round_id", "result", "score", "ranking", "data", "created_at", "updated_at")
+	leaderboard := gq.From("event_round_leaderboard").Prepared(true).Select("id", "event_id", "round_name", "event_user_id", "client_user_id", "event_round_id", "event_team_id", "result", "score", "ranking", "data", "created_at", "updated_at")
 	query, args, err := leaderboard.Where(filterGetEventRoundLeaderboardParams(arg)).Limit(uint(arg.Limit)).Offset(uint(arg.Offset)).ToSQL()
 	if err != nil {
 		return nil, err
@@ -275,6 +455,7 @@ func (q *Queries) GetEventRoundLeaderboard(ctx context.Context, arg GetEventRoun
 			&i.EventUserID,
 			&i.ClientUserID,
 			&i.EventRoundID,
+			&i.EventTeamID,
 			&i.Result,
 			&i.Score,
 			&i.Ranking,
@@ -290,9 +471,12 @@ func (q *Queries) GetEventRoundLeaderboard(ctx context.Context, arg GetEventRoun
 }
 
 type UpdateEventRoundParams struct {
-	EventRound GetEventRoundParams
-	Data       json.RawMessage `db:"data"`
-	Scoring    json.RawMessage `db:"scoring"`
+	EventRound           GetEventRoundParams
+	Data                 json.RawMessage                 `db:"data"`
+	Scoring              json.RawMessage                 `db:"scoring"`
+	ResultOrder          NullEventRoundResultOrder       `db:"result_order"`
+	ResultAggregation    NullEventRoundResultAggregation `db:"result_aggregation"`
+	ResultAggregationTop sql.NullInt32                   `db:"result_aggregation_top"`
 }
 
 func (q *Queries) UpdateEventRound(ctx context.Context, arg UpdateEventRoundParams) (sql.Result, error) {
@@ -303,6 +487,15 @@ func (q *Queries) UpdateEventRound(ctx context.Context, arg UpdateEventRoundPara
 	if arg.Scoring != nil {
 		updateRecord["scoring"] = arg.Scoring
 	}
+	if arg.ResultOrder.Valid {
+		updateRecord["result_order"] = arg.ResultOrder.EventRoundResultOrder
+	}
+	if arg.ResultAggregation.Valid {
+		updateRecord["result_aggregation"] = arg.ResultAggregation.EventRoundResultAggregation
+	}
+	if arg.ResultAggregationTop.Valid {
+		updateRecord["result_aggregation_top"] = arg.ResultAggregationTop
+	}
 	if arg.EventRound.Event.Name.Valid && !arg.EventRound.Event.ID.Valid {
 		event, err := q.GetEvent(ctx, arg.EventRound.Event, nil)
 		if err != nil {
@@ -351,6 +544,9 @@ func (q *Queries) GetEventUser(ctx context.Context, arg GetEventUserParams, opts
 		"score",
 		"ranking",
 		"data",
+		"disqualified_at",
+		"disqualification_reason",
+		"disqualified_by",
 		"created_at",
 		"updated_at",
 	)
@@ -366,6 +562,9 @@ func (q *Queries) GetEventUser(ctx context.Context, arg GetEventUserParams, opts
 		&i.Score,
 		&i.Ranking,
 		&i.Data,
+		&i.DisqualifiedAt,
+		&i.DisqualificationReason,
+		&i.DisqualifiedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -401,7 +600,7 @@ func filterGetEventRoundUsersParams(arg GetEventRoundUsersParams) goqu.Expressio
 }
 
 func (q *Queries) GetEventRoundUsers(ctx context.Context, arg GetEventRoundUsersParams) ([]EventRoundLeaderboard, error) {
-	eventRoundUsers := gq.From("event_round_leaderboard").Prepared(true).Select("id", "event_id", "round_name", "event_user_id", "client_user_id", "event_round_id", "result", "score", "ranking", "data", "created_at", "updated_at")
+	eventRoundUsers := gq.From("event_round_leaderboard").Prepared(true).Select("id", "event_id", "round_name", "event_user_id", "client_user_id", "event_round_id", "event_team_id", "result", "score", "ranking", "data", "created_at", "updated_at")
 	// Reorder by ranking because it ranks each round usually
 	query, args, err := eventRoundUsers.Where(filterGetEventRoundUsersParams(arg)).Order(goqu.C("ranking").Asc()).Limit(uint(arg.Limit)).Offset(uint(arg.Offset)).ToSQL()
 	if err != nil {
@@ -422,6 +621,7 @@ func (q *Queries) GetEventRoundUsers(ctx context.Context, arg GetEventRoundUsers
 			&i.EventUserID,
 			&i.ClientUserID,
 			&i.EventRoundID,
+			&i.EventTeamID,
 			&i.Result,
 			&i.Score,
 			&i.Ranking,
@@ -477,6 +677,65 @@ func (q *Queries) GetEventRoundUserByEventUserIdAndRoundId(ctx context.Context,
 	return i, err
 }
 
+type GetEventRoundAttemptsParams struct {
+	EventID     uint64         `db:"event_id"`
+	EventUserID uint64         `db:"event_user_id"`
+	RoundName   sql.NullString `db:"round_name"`
+	Limit       uint64
+	Offset      uint64
+}
+
+// filterGetEventRoundAttemptsParams filters the GetEventRoundAttemptsParams to goqu.Expression
+func filterGetEventRoundAttemptsParams(arg GetEventRoundAttemptsParams) goqu.Expression {
+	expressions := goqu.Ex{
+		"event_user_id": arg.EventUserID,
+	}
+	if arg.RoundName.Valid {
+		expressions["event_round_id"] = gq.From(gq.From("event_round").Select("id").Where(goqu.Ex{
+			"event_id": arg.EventID,
+			"name":     arg.RoundName,
+		}).Limit(1))
+	}
+	return expressions
+}
+
+func (q *Queries) GetEventRoundAttempts(ctx context.Context, arg GetEventRoundAttemptsParams) ([]EventRoundAttempt, error) {
+	eventRoundAttempt := gq.From("event_round_attempt").Prepared(true).Select(
+		"id",
+		"event_user_id",
+		"event_round_id",
+		"result",
+		"data",
+		"created_at",
+	)
+	// Latest attempts first
+	query, args, err := eventRoundAttempt.Where(filterGetEventRoundAttemptsParams(arg)).Order(goqu.C("id").Desc()).Limit(uint(arg.Limit)).Offset(uint(arg.Offset)).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventRoundAttempt
+	for rows.Next() {
+		var i EventRoundAttempt
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventUserID,
+			&i.EventRoundID,
+			&i.Result,
+			&i.Data,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	return items, nil
+}
+
 // GetEventUserWithoutWriteLockingParams is used to get an event user without the "Name" field
 // This is useful for updating the event user as to get the "Name" field we run a query
 // that locks the row for writing, which is not needed when updating the event user
@@ -520,8 +779,9 @@ func (q *Queries) UpdateEventUser(ctx context.Context, arg UpdateEventUserParams
 }
 
 func (q *Queries) DeleteEventUser(ctx context.Context, arg GetEventUserWithoutWriteLockingParams) (sql.Result, error) {
+	// Disqualified users are kept, as deleting them would remove the record of their disqualification
 	eventUser := gq.Delete("event_user").Prepared(true)
-	query, args, err := eventUser.Where(filterGetEventUserWithoutWriteLockingParams(arg)).ToSQL()
+	query, args, err := eventUser.Where(filterGetEventUserWithoutWriteLockingParams(arg), goqu.C("disqualified_at").IsNull()).ToSQL()
 	if err != nil {
 		return nil, err
 	}
@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"encoding/json"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
 	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
 	"github.com/MorhafAlshibly/coanda/pkg/mysqlTestServer"
 	"github.com/go-sql-driver/mysql"
@@ -17,7 +19,7 @@ import (
 var server *mysqlTestServer.Server
 
 func TestMain(m *testing.M) {
-	server = mysqlTestServer.NewServer("../../../migration/event.sql")
+	server = mysqlTestServer.NewServer("../../../migration/event.sql", "../../../migration/team.sql")
 	defer server.Close()
 	m.Run()
 }
@@ -86,11 +88,13 @@ func Test_CreateEventRound_EventRound_EventRoundCreated(t *testing.T) {
 		t.Fatalf("could not get last insert id: %v", err)
 	}
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(id),
-		Name:    "round",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{}`),
-		EndedAt: time.Now(),
+		EventID:           uint64(id),
+		Name:              "round",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           time.Now(),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -117,21 +121,25 @@ func Test_CreateEventRound_EventRoundExists_EventRoundNotCreated(t *testing.T) {
 	}
 	id, err := result.LastInsertId()
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(id),
-		Name:    "round1",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{}`),
-		EndedAt: time.Now(),
+		EventID:           uint64(id),
+		Name:              "round1",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           time.Now(),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(id),
-		Name:    "round1",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{}`),
-		EndedAt: time.Now(),
+		EventID:           uint64(id),
+		Name:              "round1",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           time.Now(),
 	})
 	if err == nil {
 		t.Fatalf("expected error, got nil")
@@ -159,21 +167,25 @@ func Test_CreateEventRound_EventRoundEndsAtSameTime_EventRoundNotCreated(t *test
 	id, err := result.LastInsertId()
 	endedAt := time.Now()
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(id),
-		Name:    "round2",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{}`),
-		EndedAt: endedAt,
+		EventID:           uint64(id),
+		Name:              "round2",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           endedAt,
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(id),
-		Name:    "round3",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{}`),
-		EndedAt: endedAt,
+		EventID:           uint64(id),
+		Name:              "round3",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           endedAt,
 	})
 	if err == nil {
 		t.Fatalf("expected error, got nil")
@@ -191,11 +203,13 @@ func Test_CreateEventRound_EventDoesNotExist_EventRoundNotCreated(t *testing.T)
 	tx := server.Connect(t)
 	q := New(tx)
 	_, err := q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: 999999,
-		Name:    "round4",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{}`),
-		EndedAt: time.Now(),
+		EventID:           999999,
+		Name:              "round4",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           time.Now(),
 	})
 	if err == nil {
 		t.Fatalf("expected error, got nil")
@@ -319,11 +333,13 @@ func Test_GetEventRoundUserByEventUserId_EventRoundUser_EventRoundUserReturned(t
 	}
 	id, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(id),
-		Name:    "round5",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{}`),
-		EndedAt: time.Now().Add(1 * time.Hour),
+		EventID:           uint64(id),
+		Name:              "round5",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           time.Now().Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -387,22 +403,26 @@ func Test_GetEventRoundUserByEventUserId_EventRoundUserInAnotherRound_EventRound
 	}
 	id, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(id),
-		Name:    "round6",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{}`),
-		EndedAt: time.Now().Add(1 * time.Hour),
+		EventID:           uint64(id),
+		Name:              "round6",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           time.Now().Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	eventRoundId1, err := result.LastInsertId()
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(id),
-		Name:    "round7",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{}`),
-		EndedAt: time.Now().Add(2 * time.Hour),
+		EventID:           uint64(id),
+		Name:              "round7",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           time.Now().Add(2 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -456,11 +476,13 @@ func Test_CreateEventRoundUser_EventRoundUser_EventRoundUserCreated(t *testing.T
 	}
 	eventUserId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(id),
-		Name:    "round8",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{}`),
-		EndedAt: time.Now().Add(1 * time.Hour),
+		EventID:           uint64(id),
+		Name:              "round8",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           time.Now().Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -506,11 +528,13 @@ func Test_CreateEventRoundUser_EventRoundUserExists_EventRoundUserNotCreated(t *
 	}
 	eventUserId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(id),
-		Name:    "round9",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{}`),
-		EndedAt: time.Now().Add(1 * time.Hour),
+		EventID:           uint64(id),
+		Name:              "round9",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           time.Now().Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -586,11 +610,13 @@ func Test_UpdateEventRoundUser_UpdateResultAndData_EventRoundUserUpdated(t *test
 	}
 	eventUserId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(id),
-		Name:    "round11",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{}`),
-		EndedAt: time.Now().Add(1 * time.Hour),
+		EventID:           uint64(id),
+		Name:              "round11",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           time.Now().Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -876,11 +902,13 @@ func Test_GetEventWithRound_ByID_EventWithRoundReturned(t *testing.T) {
 	}
 	eventId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round13",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round13",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -953,11 +981,13 @@ func Test_GetEventWithRound_ByName_EventWithRoundReturned(t *testing.T) {
 	}
 	eventId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round14",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round14",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -1028,22 +1058,26 @@ func Test_GetEventWithRound_ByIDMultipleEventRounds_EventReturned(t *testing.T)
 	}
 	eventId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round15",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round15",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	eventRoundId1, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round16",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(2 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round16",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(2 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -1082,11 +1116,13 @@ func Test_GetEventWithRound_ByIDEventEnded_EventReturned(t *testing.T) {
 	}
 	eventId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round17",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(-1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round17",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(-1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -1143,11 +1179,13 @@ func Test_GetEventLeaderboard_ByID_EventLeaderboardReturned(t *testing.T) {
 	}
 	eventUserId2, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round18",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [4,1]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round18",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -1236,11 +1274,13 @@ func Test_GetEventLeaderboard_ByName_EventLeaderboardReturned(t *testing.T) {
 	}
 	eventUserId2, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round19",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [4,1]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round19",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -1365,11 +1405,13 @@ func Test_GetEventLeaderboard_ByIDMultipleEventRounds_EventLeaderboardReturned(t
 	}
 	eventUserId2, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round20",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [4,1]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round20",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -1394,11 +1436,13 @@ func Test_GetEventLeaderboard_ByIDMultipleEventRounds_EventLeaderboardReturned(t
 		t.Fatalf("could not create event round user: %v", err)
 	}
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round21",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [10,9]}`),
-		EndedAt: startedAt.Add(2 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round21",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [10,9]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(2 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -1574,22 +1618,26 @@ func Test_GetEventRound_ByEventID_CurrentEventRoundReturned(t *testing.T) {
 	}
 	eventId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round22",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round22",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	eventRoundId1, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round23",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(2 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round23",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(2 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -1633,21 +1681,25 @@ func Test_GetEventRound_ByEventName_CurrentEventRoundReturned(t *testing.T) {
 	}
 	eventId, err := result.LastInsertId()
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round24",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round24",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round25",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(2 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round25",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(2 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -1720,11 +1772,13 @@ func Test_GetEventRound_ByRoundID_RoundReturned(t *testing.T) {
 	}
 	eventId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round26",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round26",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -1764,11 +1818,13 @@ func Test_GetEventRound_ByRoundName_RoundReturned(t *testing.T) {
 	}
 	eventId, err := result.LastInsertId()
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round27",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round27",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -1842,22 +1898,26 @@ func Test_GetEventRoundLeaderboard_ByEventID_RoundLeaderboardReturned(t *testing
 	}
 	eventUserId2, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round28",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [4,1]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round28",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	eventRoundId, err := result.LastInsertId()
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round29",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [4,1]}`),
-		EndedAt: startedAt.Add(2 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round29",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(2 * time.Hour),
 	})
 	result, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
 		EventRoundID: uint64(eventRoundId),
@@ -1919,6 +1979,110 @@ func Test_GetEventRoundLeaderboard_ByEventID_RoundLeaderboardReturned(t *testing
 	}
 }
 
+func Test_GetEventRoundLeaderboard_DescendingResultOrder_HighestResultRankedFirst(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event181",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+		EventID:      uint64(eventId),
+		ClientUserID: 42,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create or update event user: %v", err)
+	}
+	eventUserId1, err := result.LastInsertId()
+	result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+		EventID:      uint64(eventId),
+		ClientUserID: 43,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create or update event user: %v", err)
+	}
+	eventUserId2, err := result.LastInsertId()
+	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+		EventID:           uint64(eventId),
+		Name:              "round46",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderDesc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round: %v", err)
+	}
+	eventRoundId, err := result.LastInsertId()
+	_, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
+		EventRoundID: uint64(eventRoundId),
+		EventUserID:  uint64(eventUserId1),
+		Result:       2,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round user: %v", err)
+	}
+	_, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
+		EventRoundID: uint64(eventRoundId),
+		EventUserID:  uint64(eventUserId2),
+		Result:       1,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round user: %v", err)
+	}
+	roundLeaderboard, err := q.GetEventRoundLeaderboard(context.Background(), GetEventRoundLeaderboardParams{
+		EventRound: GetEventRoundParams{
+			Event: GetEventParams{
+				ID: sql.NullInt64{Int64: eventId, Valid: true},
+			},
+		},
+		Limit:  2,
+		Offset: 0,
+	})
+	if err != nil {
+		t.Fatalf("could not get event round leaderboard: %v", err)
+	}
+	if len(roundLeaderboard) != 2 {
+		t.Fatalf("expected 2 leaderboard entries, got %d", len(roundLeaderboard))
+	}
+	if roundLeaderboard[0].EventUserID != uint64(eventUserId1) {
+		t.Fatalf("expected event user id to be %d, got %d", eventUserId1, roundLeaderboard[0].EventUserID)
+	}
+	if roundLeaderboard[0].Score != 4 {
+		t.Fatalf("expected score to be 4, got %d", roundLeaderboard[0].Score)
+	}
+	leaderboard, err := q.GetEventLeaderboard(context.Background(), GetEventLeaderboardParams{
+		Event: GetEventParams{
+			ID: sql.NullInt64{Int64: eventId, Valid: true},
+		},
+		Limit:  2,
+		Offset: 0,
+	})
+	if err != nil {
+		t.Fatalf("could not get event leaderboard: %v", err)
+	}
+	if leaderboard[0].ID != uint64(eventUserId1) {
+		t.Fatalf("expected event user id to be %d, got %d", eventUserId1, leaderboard[0].ID)
+	}
+	if leaderboard[0].Score != 4 {
+		t.Fatalf("expected score to be 4, got %d", leaderboard[0].Score)
+	}
+	if leaderboard[1].Score != 1 {
+		t.Fatalf("expected score to be 1, got %d", leaderboard[1].Score)
+	}
+}
+
 func Test_GetEventRoundLeaderboard_ByEventName_RoundLeaderboardReturned(t *testing.T) {
 	tx := server.Connect(t)
 	q := New(tx)
@@ -1951,22 +2115,26 @@ func Test_GetEventRoundLeaderboard_ByEventName_RoundLeaderboardReturned(t *testi
 	}
 	eventUserId2, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round30",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [4,1]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round30",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	eventRoundId1, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round31",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [4,1]}`),
-		EndedAt: startedAt.Add(2 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round31",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(2 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -2078,22 +2246,26 @@ func Test_GetEventRoundLeaderboard_ByEventIDAllRoundsEnded_NoLeaderboardReturned
 	}
 	eventUserId2, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round33",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [4,1]}`),
-		EndedAt: startedAt.Add(-1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round33",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(-1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	eventRoundId1, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round34",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [4,1]}`),
-		EndedAt: startedAt.Add(-2 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round34",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(-2 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -2147,11 +2319,13 @@ func Test_UpdateEventRound_ByEventID_RoundUpdated(t *testing.T) {
 	}
 	eventId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round35",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round35",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -2193,21 +2367,25 @@ func Test_UpdateEventRound_ByEventName_RoundUpdated(t *testing.T) {
 	}
 	eventId, err := result.LastInsertId()
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round36",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round36",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round37",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(2 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round37",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(2 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -2263,11 +2441,13 @@ func Test_UpdateEventRound_ByRoundID_RoundUpdated(t *testing.T) {
 	}
 	eventId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round38",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round38",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -2307,21 +2487,25 @@ func Test_UpdateEventRound_ByEventNameRoundName_RoundUpdated(t *testing.T) {
 	}
 	eventId, err := result.LastInsertId()
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round39",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round39",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round40",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [1, 2, 4]}`),
-		EndedAt: startedAt.Add(2 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round40",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1, 2, 4]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(2 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -2581,22 +2765,26 @@ func Test_GetEventRoundUsers_ByEventUserID_EventRoundUsersReturned(t *testing.T)
 	}
 	eventUserId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round41",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [4,1]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round41",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	eventRoundId1, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round42",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [3,2]}`),
-		EndedAt: startedAt.Add(2 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round42",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [3,2]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(2 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -2700,22 +2888,26 @@ func Test_GetEventRoundUsers_ByEventIDAndUserID_EventRoundUsersReturned(t *testi
 	}
 	eventUserId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round43",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [4,1]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round43",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
 	}
 	eventRoundId1, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round44",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [2,3]}`),
-		EndedAt: startedAt.Add(2 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round44",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [2,3]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(2 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -3064,11 +3256,13 @@ func Test_DeleteEventRoundUser_ByEventRoundUserId_EventRoundUserDeleted(t *testi
 	}
 	eventUserId, err := result.LastInsertId()
 	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
-		EventID: uint64(eventId),
-		Name:    "round45",
-		Data:    json.RawMessage(`{}`),
-		Scoring: json.RawMessage(`{"scoring": [4,1]}`),
-		EndedAt: startedAt.Add(1 * time.Hour),
+		EventID:           uint64(eventId),
+		Name:              "round45",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("could not create event round: %v", err)
@@ -3119,3 +3313,1267 @@ func Test_DeleteEventRoundUser_ByEventRoundUserIdEventDoesNotExist_EventRoundUse
 		t.Fatalf("expected 0 rows affected, got %d", rowsAffected)
 	}
 }
+
+func Test_GetEventRoundLeaderboard_LinearDecayScoring_ScoreDecaysToMinimum(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event182",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+		EventID:           uint64(eventId),
+		Name:              "round47",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"type": "linear_decay", "points": 10, "decay": 4, "minimum": 3}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round: %v", err)
+	}
+	eventRoundId, err := result.LastInsertId()
+	for i := 0; i < 3; i++ {
+		result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+			EventID:      uint64(eventId),
+			ClientUserID: uint64(44 + i),
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create or update event user: %v", err)
+		}
+		eventUserId, err := result.LastInsertId()
+		_, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
+			EventRoundID: uint64(eventRoundId),
+			EventUserID:  uint64(eventUserId),
+			Result:       uint64(i + 1),
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create event round user: %v", err)
+		}
+	}
+	roundLeaderboard, err := q.GetEventRoundLeaderboard(context.Background(), GetEventRoundLeaderboardParams{
+		EventRound: GetEventRoundParams{
+			Event: GetEventParams{
+				ID: sql.NullInt64{Int64: eventId, Valid: true},
+			},
+		},
+		Limit:  3,
+		Offset: 0,
+	})
+	if err != nil {
+		t.Fatalf("could not get event round leaderboard: %v", err)
+	}
+	if len(roundLeaderboard) != 3 {
+		t.Fatalf("expected 3 leaderboard entries, got %d", len(roundLeaderboard))
+	}
+	for i, score := range []uint64{10, 6, 3} {
+		if roundLeaderboard[i].Score != score {
+			t.Fatalf("expected score of rank %d to be %d, got %d", i+1, score, roundLeaderboard[i].Score)
+		}
+	}
+	leaderboard, err := q.GetEventLeaderboard(context.Background(), GetEventLeaderboardParams{
+		Event: GetEventParams{
+			ID: sql.NullInt64{Int64: eventId, Valid: true},
+		},
+		Limit:  3,
+		Offset: 0,
+	})
+	if err != nil {
+		t.Fatalf("could not get event leaderboard: %v", err)
+	}
+	for i, score := range []uint64{10, 6, 3} {
+		if leaderboard[i].Score != score {
+			t.Fatalf("expected event score of rank %d to be %d, got %d", i+1, score, leaderboard[i].Score)
+		}
+	}
+}
+
+func Test_GetEventRoundLeaderboard_PercentileBandsScoring_ScoreByPercentile(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event183",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	// The top half scores 10 points and the rest 5 points
+	percentiles := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		if i < 50 {
+			percentiles = append(percentiles, "10")
+		} else {
+			percentiles = append(percentiles, "5")
+		}
+	}
+	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+		EventID:           uint64(eventId),
+		Name:              "round48",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"type": "percentile_bands", "percentiles": [` + strings.Join(percentiles, ",") + `]}`),
+		ResultOrder:       EventRoundResultOrderDesc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round: %v", err)
+	}
+	eventRoundId, err := result.LastInsertId()
+	for i := 0; i < 4; i++ {
+		result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+			EventID:      uint64(eventId),
+			ClientUserID: uint64(47 + i),
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create or update event user: %v", err)
+		}
+		eventUserId, err := result.LastInsertId()
+		_, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
+			EventRoundID: uint64(eventRoundId),
+			EventUserID:  uint64(eventUserId),
+			Result:       uint64(i + 1),
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create event round user: %v", err)
+		}
+	}
+	roundLeaderboard, err := q.GetEventRoundLeaderboard(context.Background(), GetEventRoundLeaderboardParams{
+		EventRound: GetEventRoundParams{
+			Event: GetEventParams{
+				ID: sql.NullInt64{Int64: eventId, Valid: true},
+			},
+		},
+		Limit:  4,
+		Offset: 0,
+	})
+	if err != nil {
+		t.Fatalf("could not get event round leaderboard: %v", err)
+	}
+	if len(roundLeaderboard) != 4 {
+		t.Fatalf("expected 4 leaderboard entries, got %d", len(roundLeaderboard))
+	}
+	for i, score := range []uint64{10, 10, 5, 5} {
+		if roundLeaderboard[i].Score != score {
+			t.Fatalf("expected score of rank %d to be %d, got %d", i+1, score, roundLeaderboard[i].Score)
+		}
+	}
+}
+
+func Test_GetEventRoundAttempts_AttemptsAdded_LatestAttemptFirst(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event184",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+		EventID:              uint64(eventId),
+		Name:                 "round49",
+		Data:                 json.RawMessage(`{}`),
+		Scoring:              json.RawMessage(`{"scoring": [1]}`),
+		ResultOrder:          EventRoundResultOrderAsc,
+		ResultAggregation:    EventRoundResultAggregationAverageOfTop,
+		ResultAggregationTop: sql.NullInt32{Int32: 2, Valid: true},
+		EndedAt:              startedAt.Add(1 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round: %v", err)
+	}
+	eventRoundId, err := result.LastInsertId()
+	result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+		EventID:      uint64(eventId),
+		ClientUserID: 51,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create or update event user: %v", err)
+	}
+	eventUserId, err := result.LastInsertId()
+	_, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
+		EventRoundID: uint64(eventRoundId),
+		EventUserID:  uint64(eventUserId),
+		Result:       5,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round user: %v", err)
+	}
+	for _, attemptResult := range []uint64{5, 3, 8} {
+		_, err = q.CreateEventRoundAttempt(context.Background(), CreateEventRoundAttemptParams{
+			EventUserID:  uint64(eventUserId),
+			EventRoundID: uint64(eventRoundId),
+			Result:       attemptResult,
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create event round attempt: %v", err)
+		}
+	}
+	results, err := q.GetEventRoundAttemptResults(context.Background(), GetEventRoundAttemptResultsParams{
+		EventUserID:  uint64(eventUserId),
+		EventRoundID: uint64(eventRoundId),
+	})
+	if err != nil {
+		t.Fatalf("could not get event round attempt results: %v", err)
+	}
+	if !reflect.DeepEqual(results, []uint64{5, 3, 8}) {
+		t.Fatalf("expected results to be %v, got %v", []uint64{5, 3, 8}, results)
+	}
+	attempts, err := q.GetEventRoundAttempts(context.Background(), GetEventRoundAttemptsParams{
+		EventID:     uint64(eventId),
+		EventUserID: uint64(eventUserId),
+		RoundName:   sql.NullString{String: "round49", Valid: true},
+		Limit:       2,
+		Offset:      0,
+	})
+	if err != nil {
+		t.Fatalf("could not get event round attempts: %v", err)
+	}
+	if len(attempts) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(attempts))
+	}
+	if attempts[0].Result != 8 {
+		t.Fatalf("expected latest attempt result to be 8, got %d", attempts[0].Result)
+	}
+	eventRound, err := q.GetEventRound(context.Background(), GetEventRoundParams{
+		ID: sql.NullInt64{Int64: eventRoundId, Valid: true},
+	}, nil)
+	if err != nil {
+		t.Fatalf("could not get event round: %v", err)
+	}
+	if eventRound.ResultAggregation != EventRoundResultAggregationAverageOfTop {
+		t.Fatalf("expected result aggregation to be %s, got %s", EventRoundResultAggregationAverageOfTop, eventRound.ResultAggregation)
+	}
+	if eventRound.ResultAggregationTop.Int32 != 2 {
+		t.Fatalf("expected result aggregation top to be 2, got %d", eventRound.ResultAggregationTop.Int32)
+	}
+}
+
+func Test_DeleteEventRoundUser_AttemptsAdded_AttemptsDeleted(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event185",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+		EventID:           uint64(eventId),
+		Name:              "round50",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationBest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round: %v", err)
+	}
+	eventRoundId, err := result.LastInsertId()
+	result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+		EventID:      uint64(eventId),
+		ClientUserID: 52,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create or update event user: %v", err)
+	}
+	eventUserId, err := result.LastInsertId()
+	result, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
+		EventRoundID: uint64(eventRoundId),
+		EventUserID:  uint64(eventUserId),
+		Result:       5,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round user: %v", err)
+	}
+	eventRoundUserId, err := result.LastInsertId()
+	_, err = q.CreateEventRoundAttempt(context.Background(), CreateEventRoundAttemptParams{
+		EventUserID:  uint64(eventUserId),
+		EventRoundID: uint64(eventRoundId),
+		Result:       5,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round attempt: %v", err)
+	}
+	_, err = q.DeleteEventRoundUser(context.Background(), uint64(eventRoundUserId))
+	if err != nil {
+		t.Fatalf("could not delete event round user: %v", err)
+	}
+	results, err := q.GetEventRoundAttemptResults(context.Background(), GetEventRoundAttemptResultsParams{
+		EventUserID:  uint64(eventUserId),
+		EventRoundID: uint64(eventRoundId),
+	})
+	if err != nil {
+		t.Fatalf("could not get event round attempt results: %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected attempts to be deleted, got %v", results)
+	}
+}
+
+func Test_CreateEventRoundAttemptFromEventRoundUser_NoAttempts_ResultLoggedOnce(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event200",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+		EventID:           uint64(eventId),
+		Name:              "round62",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationBest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round: %v", err)
+	}
+	eventRoundId, err := result.LastInsertId()
+	result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+		EventID:      uint64(eventId),
+		ClientUserID: 66,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create or update event user: %v", err)
+	}
+	eventUserId, err := result.LastInsertId()
+	// A result added before attempts were logged
+	_, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
+		EventRoundID: uint64(eventRoundId),
+		EventUserID:  uint64(eventUserId),
+		Result:       5,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round user: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		_, err = q.CreateEventRoundAttemptFromEventRoundUser(context.Background(), CreateEventRoundAttemptFromEventRoundUserParams{
+			EventUserID:  uint64(eventUserId),
+			EventRoundID: uint64(eventRoundId),
+		})
+		if err != nil {
+			t.Fatalf("could not create event round attempt from event round user: %v", err)
+		}
+	}
+	results, err := q.GetEventRoundAttemptResults(context.Background(), GetEventRoundAttemptResultsParams{
+		EventUserID:  uint64(eventUserId),
+		EventRoundID: uint64(eventRoundId),
+	})
+	if err != nil {
+		t.Fatalf("could not get event round attempt results: %v", err)
+	}
+	if len(results) != 1 || results[0] != 5 {
+		t.Fatalf("expected the result to be logged once, got %v", results)
+	}
+}
+
+func Test_CreateEventTeam_TeamAlreadyInEvent_SameId(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event186",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: time.Now().Round(time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	result, err = q.CreateEventTeam(context.Background(), CreateEventTeamParams{
+		EventID: uint64(eventId),
+		TeamID:  1,
+	})
+	if err != nil {
+		t.Fatalf("could not create event team: %v", err)
+	}
+	eventTeamId, err := result.LastInsertId()
+	result, err = q.CreateEventTeam(context.Background(), CreateEventTeamParams{
+		EventID: uint64(eventId),
+		TeamID:  1,
+	})
+	if err != nil {
+		t.Fatalf("could not create event team: %v", err)
+	}
+	sameEventTeamId, err := result.LastInsertId()
+	if sameEventTeamId != eventTeamId {
+		t.Fatalf("expected event team id to be %d, got %d", eventTeamId, sameEventTeamId)
+	}
+}
+
+func Test_GetEventTeamLeaderboard_MembersCreditTeam_TeamScoreIsSumOfMemberScores(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event187",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+		EventID:           uint64(eventId),
+		Name:              "round51",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [10, 5, 1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round: %v", err)
+	}
+	eventRoundId, err := result.LastInsertId()
+	eventTeamIds := make([]int64, 0, 2)
+	for _, teamId := range []uint64{1, 2} {
+		result, err = q.CreateEventTeam(context.Background(), CreateEventTeamParams{
+			EventID: uint64(eventId),
+			TeamID:  teamId,
+		})
+		if err != nil {
+			t.Fatalf("could not create event team: %v", err)
+		}
+		eventTeamId, err := result.LastInsertId()
+		if err != nil {
+			t.Fatalf("could not get event team id: %v", err)
+		}
+		eventTeamIds = append(eventTeamIds, eventTeamId)
+	}
+	// The first and third users are in the first team, and the second user is in the second team
+	for i, eventTeamId := range []int64{eventTeamIds[0], eventTeamIds[1], eventTeamIds[0]} {
+		result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+			EventID:      uint64(eventId),
+			ClientUserID: uint64(53 + i),
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create or update event user: %v", err)
+		}
+		eventUserId, err := result.LastInsertId()
+		_, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
+			EventRoundID: uint64(eventRoundId),
+			EventUserID:  uint64(eventUserId),
+			EventTeamID:  sql.NullInt64{Int64: eventTeamId, Valid: true},
+			Result:       uint64(i + 1),
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create event round user: %v", err)
+		}
+	}
+	leaderboard, err := q.GetEventTeamLeaderboard(context.Background(), GetEventLeaderboardParams{
+		Event: GetEventParams{
+			ID: sql.NullInt64{Int64: eventId, Valid: true},
+		},
+		Limit:  2,
+		Offset: 0,
+	})
+	if err != nil {
+		t.Fatalf("could not get event team leaderboard: %v", err)
+	}
+	if len(leaderboard) != 2 {
+		t.Fatalf("expected 2 leaderboard entries, got %d", len(leaderboard))
+	}
+	if leaderboard[0].TeamID != 1 || leaderboard[0].Score != 11 || leaderboard[0].Ranking != 1 {
+		t.Fatalf("expected first team to have a score of 11, got %+v", leaderboard[0])
+	}
+	if leaderboard[1].TeamID != 2 || leaderboard[1].Score != 5 || leaderboard[1].Ranking != 2 {
+		t.Fatalf("expected second team to have a score of 5, got %+v", leaderboard[1])
+	}
+}
+
+func Test_GetEventWithRound_NoRounds_StatusDraft(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event188",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: time.Now().Add(1 * time.Hour).Round(time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	event, err := q.GetEventWithRound(context.Background(), GetEventParams{
+		ID: sql.NullInt64{Int64: eventId, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not get event: %v", err)
+	}
+	if len(event) != 1 {
+		t.Fatalf("expected 1 event row, got %d", len(event))
+	}
+	if event[0].Status != "draft" {
+		t.Fatalf("expected event status to be draft, got %s", event[0].Status)
+	}
+	if event[0].EndedAt != nil {
+		t.Fatalf("expected event ended at to be nil, got %v", event[0].EndedAt)
+	}
+	if event[0].RoundID.Valid {
+		t.Fatalf("expected event to have no rounds, got %d", event[0].RoundID.Int64)
+	}
+}
+
+func Test_PauseEvent_EventLive_StatusPaused(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Add(-1 * time.Hour).Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event189",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	endedAt := startedAt.Add(3 * time.Hour)
+	_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+		EventID:           uint64(eventId),
+		Name:              "round52",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [10, 5, 1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           endedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event round: %v", err)
+	}
+	event, err := q.GetEventWithRound(context.Background(), GetEventParams{
+		ID: sql.NullInt64{Int64: eventId, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not get event: %v", err)
+	}
+	if event[0].Status != "live" {
+		t.Fatalf("expected event status to be live, got %s", event[0].Status)
+	}
+	if event[0].EndedAt == nil || !event[0].EndedAt.Equal(endedAt) {
+		t.Fatalf("expected event ended at to be %v, got %v", endedAt, event[0].EndedAt)
+	}
+	result, err = q.PauseEvent(context.Background(), uint64(eventId))
+	if err != nil {
+		t.Fatalf("could not pause event: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if rowsAffected != 1 {
+		t.Fatalf("expected 1 row to be affected, got %d", rowsAffected)
+	}
+	event, err = q.GetEventWithRound(context.Background(), GetEventParams{
+		ID: sql.NullInt64{Int64: eventId, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not get event: %v", err)
+	}
+	if event[0].Status != "paused" {
+		t.Fatalf("expected event status to be paused, got %s", event[0].Status)
+	}
+	if !event[0].PausedAt.Valid {
+		t.Fatalf("expected event paused at to be set")
+	}
+}
+
+func Test_ExtendEventRoundsPastPause_EventPaused_RunningRoundsExtended(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	now := time.Now().Truncate(time.Second)
+	startedAt := now.Add(-2 * time.Hour)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event190",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	// The first round ended before the pause, and the second round is extended to the end of the third round
+	endedAts := []time.Time{now.Add(-90 * time.Minute), now.Add(1 * time.Hour), now.Add(2 * time.Hour)}
+	names := []string{"round53", "round54", "round55"}
+	for i, endedAt := range endedAts {
+		_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+			EventID:           uint64(eventId),
+			Name:              names[i],
+			Data:              json.RawMessage(`{}`),
+			Scoring:           json.RawMessage(`{"scoring": [10, 5, 1]}`),
+			ResultOrder:       EventRoundResultOrderAsc,
+			ResultAggregation: EventRoundResultAggregationLatest,
+			EndedAt:           endedAt,
+		})
+		if err != nil {
+			t.Fatalf("could not create event round: %v", err)
+		}
+	}
+	_, err = q.ExtendEventRoundsPastPause(context.Background(), ExtendEventRoundsPastPauseParams{
+		PausedAt: now.Add(-1 * time.Hour),
+		EventID:  uint64(eventId),
+	})
+	if err != nil {
+		t.Fatalf("could not extend event rounds: %v", err)
+	}
+	event, err := q.GetEventWithRound(context.Background(), GetEventParams{
+		ID: sql.NullInt64{Int64: eventId, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not get event: %v", err)
+	}
+	if len(event) != 3 {
+		t.Fatalf("expected 3 rounds, got %d", len(event))
+	}
+	if !event[0].RoundEndedAt.Time.Equal(endedAts[0]) {
+		t.Fatalf("expected first round ended at to be %v, got %v", endedAts[0], event[0].RoundEndedAt.Time)
+	}
+	for i := 1; i < 3; i++ {
+		extension := event[i].RoundEndedAt.Time.Sub(endedAts[i])
+		if extension < 1*time.Hour || extension > 1*time.Hour+5*time.Second {
+			t.Fatalf("expected round %d to be extended by an hour, got %v", i, extension)
+		}
+	}
+}
+
+func Test_GetEvents_StatusScheduled_ScheduledEventWithAllRounds(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Add(1 * time.Hour).Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event191",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	for i, name := range []string{"round56", "round57"} {
+		_, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+			EventID:           uint64(eventId),
+			Name:              name,
+			Data:              json.RawMessage(`{}`),
+			Scoring:           json.RawMessage(`{"scoring": [10, 5, 1]}`),
+			ResultOrder:       EventRoundResultOrderAsc,
+			ResultAggregation: EventRoundResultAggregationLatest,
+			EndedAt:           startedAt.Add(time.Duration(i+1) * time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("could not create event round: %v", err)
+		}
+	}
+	// The draft event starts first, but is filtered out by its status
+	_, err = q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event192",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt.Add(-30 * time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	events, err := q.GetEvents(context.Background(), GetEventsParams{
+		Statuses:     []string{"SCHEDULED"},
+		StartedAfter: sql.NullTime{Time: time.Now(), Valid: true},
+		Limit:        1,
+		Offset:       0,
+	})
+	if err != nil {
+		t.Fatalf("could not get events: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 event rows, got %d", len(events))
+	}
+	for i, event := range events {
+		if event.ID != uint64(eventId) {
+			t.Fatalf("expected event id to be %d, got %d", eventId, event.ID)
+		}
+		if event.Status != "scheduled" {
+			t.Fatalf("expected event status to be scheduled, got %s", event.Status)
+		}
+		if event.RoundName.String != []string{"round56", "round57"}[i] {
+			t.Fatalf("expected round name to be %s, got %s", []string{"round56", "round57"}[i], event.RoundName.String)
+		}
+	}
+}
+
+func Test_CountEventUsers_RegistrationRequired_UsersCounted(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	registrationClosesAt := time.Now().Add(30 * time.Minute).Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:                 "event193",
+		Data:                 json.RawMessage(`{}`),
+		StartedAt:            time.Now().Add(1 * time.Hour).Round(time.Minute),
+		RegistrationRequired: true,
+		RegistrationClosesAt: sql.NullTime{Time: registrationClosesAt, Valid: true},
+		MaxParticipants:      sql.NullInt32{Int32: 2, Valid: true},
+		Eligibility:          conversion.ValueToPointer(json.RawMessage(`[{"key": "level", "minimum": 10}]`)),
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	event, err := q.GetEvent(context.Background(), GetEventParams{
+		ID: sql.NullInt64{Int64: eventId, Valid: true},
+	}, nil)
+	if err != nil {
+		t.Fatalf("could not get event: %v", err)
+	}
+	if !event.RegistrationRequired {
+		t.Fatalf("expected event to require registration")
+	}
+	if !event.RegistrationClosesAt.Valid || !event.RegistrationClosesAt.Time.Equal(registrationClosesAt) {
+		t.Fatalf("expected registration closes at to be %v, got %v", registrationClosesAt, event.RegistrationClosesAt)
+	}
+	if event.RegistrationOpensAt.Valid {
+		t.Fatalf("expected registration opens at to be null, got %v", event.RegistrationOpensAt.Time)
+	}
+	if !event.MaxParticipants.Valid || event.MaxParticipants.Int32 != 2 {
+		t.Fatalf("expected max participants to be 2, got %v", event.MaxParticipants)
+	}
+	var eligibility []map[string]interface{}
+	if event.Eligibility == nil {
+		t.Fatalf("expected eligibility to be set")
+	}
+	err = json.Unmarshal(*event.Eligibility, &eligibility)
+	if err != nil {
+		t.Fatalf("could not unmarshal eligibility: %v", err)
+	}
+	if len(eligibility) != 1 || eligibility[0]["key"] != "level" {
+		t.Fatalf("expected eligibility to have a level rule, got %s", *event.Eligibility)
+	}
+	for _, clientUserId := range []uint64{56, 57} {
+		_, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+			EventID:      uint64(eventId),
+			ClientUserID: clientUserId,
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create event user: %v", err)
+		}
+	}
+	users, err := q.CountEventUsers(context.Background(), uint64(eventId))
+	if err != nil {
+		t.Fatalf("could not count event users: %v", err)
+	}
+	if users != 2 {
+		t.Fatalf("expected 2 event users, got %d", users)
+	}
+}
+
+func Test_GetEventTemplate_ByName_TemplateReturned(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	rounds := json.RawMessage(`[{"name": "round1", "data": {}, "scoring": {"scoring": [100]}, "resultOrder": "asc", "resultAggregation": "latest", "endedAfterSeconds": 3600}]`)
+	_, err := q.CreateEventTemplate(context.Background(), CreateEventTemplateParams{
+		Name:            "template1",
+		Data:            json.RawMessage(`{}`),
+		Rounds:          rounds,
+		CronSchedule:    sql.NullString{String: "0 12 * * *", Valid: true},
+		LeadTimeSeconds: 3600,
+	})
+	if err != nil {
+		t.Fatalf("could not create event template: %v", err)
+	}
+	template, err := q.GetEventTemplate(context.Background(), GetEventTemplateParams{
+		Name: sql.NullString{String: "template1", Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not get event template: %v", err)
+	}
+	if template.Name != "template1" {
+		t.Fatalf("expected name template1, got %s", template.Name)
+	}
+	if template.CronSchedule.String != "0 12 * * *" {
+		t.Fatalf("expected cron schedule 0 12 * * *, got %s", template.CronSchedule.String)
+	}
+	if template.LeadTimeSeconds != 3600 {
+		t.Fatalf("expected lead time 3600, got %d", template.LeadTimeSeconds)
+	}
+	var templateRounds []map[string]interface{}
+	err = json.Unmarshal(template.Rounds, &templateRounds)
+	if err != nil {
+		t.Fatalf("could not unmarshal rounds: %v", err)
+	}
+	if len(templateRounds) != 1 || templateRounds[0]["name"] != "round1" {
+		t.Fatalf("expected round round1, got %s", string(template.Rounds))
+	}
+}
+
+func Test_DeleteEventTemplate_ById_TemplateDeleted(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateEventTemplate(context.Background(), CreateEventTemplateParams{
+		Name:   "template2",
+		Data:   json.RawMessage(`{}`),
+		Rounds: json.RawMessage(`[]`),
+	})
+	if err != nil {
+		t.Fatalf("could not create event template: %v", err)
+	}
+	templateId, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("could not get last insert id: %v", err)
+	}
+	result, err = q.DeleteEventTemplate(context.Background(), GetEventTemplateParams{
+		ID: sql.NullInt64{Int64: templateId, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not delete event template: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		t.Fatalf("could not get rows affected: %v", err)
+	}
+	if rowsAffected != 1 {
+		t.Fatalf("expected 1 row affected, got %d", rowsAffected)
+	}
+	_, err = q.GetEventTemplate(context.Background(), GetEventTemplateParams{
+		ID: sql.NullInt64{Int64: templateId, Valid: true},
+	})
+	if err != sql.ErrNoRows {
+		t.Fatalf("expected no rows error, got %v", err)
+	}
+}
+
+func Test_SetEventThirdPartyDelivery_DeliveryExists_DeliveryReplaced(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event194",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("could not get last insert id: %v", err)
+	}
+	_, err = q.SetEventThirdPartyDelivery(context.Background(), SetEventThirdPartyDeliveryParams{
+		EventID:        uint64(eventId),
+		ExcludedRounds: conversion.ValueToPointer(json.RawMessage(`["round1"]`)),
+		TopLimit:       sql.NullInt32{Int32: 10, Valid: true},
+		Uri:            sql.NullString{String: "https://example.com", Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not set event third party delivery: %v", err)
+	}
+	_, err = q.SetEventThirdPartyDelivery(context.Background(), SetEventThirdPartyDeliveryParams{
+		EventID: uint64(eventId),
+		Fields:  conversion.ValueToPointer(json.RawMessage(`["client_user_id", "score"]`)),
+	})
+	if err != nil {
+		t.Fatalf("could not set event third party delivery: %v", err)
+	}
+	delivery, err := q.GetEventThirdPartyDelivery(context.Background(), uint64(eventId))
+	if err != nil {
+		t.Fatalf("could not get event third party delivery: %v", err)
+	}
+	if delivery.ExcludedRounds != nil {
+		t.Fatalf("expected no excluded rounds, got %s", string(*delivery.ExcludedRounds))
+	}
+	if delivery.TopLimit.Valid {
+		t.Fatalf("expected no top limit, got %d", delivery.TopLimit.Int32)
+	}
+	if delivery.Uri.Valid {
+		t.Fatalf("expected no uri, got %s", delivery.Uri.String)
+	}
+	var fields []string
+	if delivery.Fields == nil {
+		t.Fatalf("expected fields to be set")
+	}
+	err = json.Unmarshal(*delivery.Fields, &fields)
+	if err != nil {
+		t.Fatalf("could not unmarshal fields: %v", err)
+	}
+	if len(fields) != 2 || fields[0] != "client_user_id" || fields[1] != "score" {
+		t.Fatalf("expected fields client_user_id and score, got %v", fields)
+	}
+}
+
+func Test_GetEventLeaderboard_UserDisqualified_UserHiddenAndOthersReranked(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event195",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+		EventID:           uint64(eventId),
+		Name:              "round58",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [4,1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round: %v", err)
+	}
+	eventRoundId, err := result.LastInsertId()
+	eventUserIds := []int64{}
+	for i, clientUserId := range []uint64{58, 59} {
+		result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+			EventID:      uint64(eventId),
+			ClientUserID: clientUserId,
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create event user: %v", err)
+		}
+		eventUserId, err := result.LastInsertId()
+		if err != nil {
+			t.Fatalf("could not get last insert id: %v", err)
+		}
+		eventUserIds = append(eventUserIds, eventUserId)
+		_, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
+			EventRoundID: uint64(eventRoundId),
+			EventUserID:  uint64(eventUserId),
+			Result:       uint64(i + 1),
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create event round user: %v", err)
+		}
+	}
+	result, err = q.DisqualifyEventUser(context.Background(), DisqualifyEventUserParams{
+		DisqualificationReason: sql.NullString{String: "cheating", Valid: true},
+		DisqualifiedBy:         sql.NullString{String: "moderator1", Valid: true},
+		ID:                     uint64(eventUserIds[0]),
+	})
+	if err != nil {
+		t.Fatalf("could not disqualify event user: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		t.Fatalf("could not get rows affected: %v", err)
+	}
+	if rowsAffected != 1 {
+		t.Fatalf("expected 1 row affected, got %d", rowsAffected)
+	}
+	leaderboard, err := q.GetEventLeaderboard(context.Background(), GetEventLeaderboardParams{
+		Event: GetEventParams{
+			ID: sql.NullInt64{Int64: eventId, Valid: true},
+		},
+		Limit:  2,
+		Offset: 0,
+	})
+	if err != nil {
+		t.Fatalf("could not get event leaderboard: %v", err)
+	}
+	if len(leaderboard) != 1 {
+		t.Fatalf("expected 1 leaderboard entry, got %d", len(leaderboard))
+	}
+	if leaderboard[0].ClientUserID != 59 {
+		t.Fatalf("expected user id to be 59, got %d", leaderboard[0].ClientUserID)
+	}
+	if leaderboard[0].Score != 4 {
+		t.Fatalf("expected score to be 4, got %d", leaderboard[0].Score)
+	}
+	if leaderboard[0].Ranking != 1 {
+		t.Fatalf("expected ranking to be 1, got %d", leaderboard[0].Ranking)
+	}
+	eventUser, err := q.GetEventUser(context.Background(), GetEventUserParams{
+		ID: sql.NullInt64{Int64: eventUserIds[0], Valid: true},
+	}, nil)
+	if err != nil {
+		t.Fatalf("could not get event user: %v", err)
+	}
+	if !eventUser.DisqualifiedAt.Valid {
+		t.Fatalf("expected event user to be disqualified")
+	}
+	if eventUser.DisqualificationReason.String != "cheating" {
+		t.Fatalf("expected reason to be cheating, got %s", eventUser.DisqualificationReason.String)
+	}
+	if eventUser.DisqualifiedBy.String != "moderator1" {
+		t.Fatalf("expected disqualified by to be moderator1, got %s", eventUser.DisqualifiedBy.String)
+	}
+}
+
+func Test_GetEventRoundLeaderboard_ProportionalToResultScoringAscending_LowestResultScoresMost(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event196",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+		EventID:           uint64(eventId),
+		Name:              "round59",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"type": "proportional_to_result", "factor": 2}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round: %v", err)
+	}
+	eventRoundId, err := result.LastInsertId()
+	for i, roundResult := range []uint64{10, 20, 40} {
+		result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+			EventID:      uint64(eventId),
+			ClientUserID: uint64(60 + i),
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create event user: %v", err)
+		}
+		eventUserId, err := result.LastInsertId()
+		_, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
+			EventRoundID: uint64(eventRoundId),
+			EventUserID:  uint64(eventUserId),
+			Result:       roundResult,
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create event round user: %v", err)
+		}
+	}
+	roundLeaderboard, err := q.GetEventRoundLeaderboard(context.Background(), GetEventRoundLeaderboardParams{
+		EventRound: GetEventRoundParams{
+			Event: GetEventParams{
+				ID: sql.NullInt64{Int64: eventId, Valid: true},
+			},
+		},
+		Limit:  3,
+		Offset: 0,
+	})
+	if err != nil {
+		t.Fatalf("could not get event round leaderboard: %v", err)
+	}
+	if len(roundLeaderboard) != 3 {
+		t.Fatalf("expected 3 leaderboard entries, got %d", len(roundLeaderboard))
+	}
+	// The results are mirrored between 10 and 40 before being multiplied by the factor
+	for i, score := range []uint64{80, 60, 20} {
+		if roundLeaderboard[i].Score != score {
+			t.Fatalf("expected score of rank %d to be %d, got %d", i+1, score, roundLeaderboard[i].Score)
+		}
+	}
+}
+
+func Test_EventUserHasResults_ResultAdded_HasResults(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event197",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+		EventID:           uint64(eventId),
+		Name:              "round60",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round: %v", err)
+	}
+	eventRoundId, err := result.LastInsertId()
+	result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+		EventID:      uint64(eventId),
+		ClientUserID: 63,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create event user: %v", err)
+	}
+	eventUserId, err := result.LastInsertId()
+	hasResults, err := q.EventUserHasResults(context.Background(), uint64(eventUserId))
+	if err != nil {
+		t.Fatalf("could not check event user results: %v", err)
+	}
+	if hasResults {
+		t.Fatal("expected event user to have no results")
+	}
+	_, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
+		EventRoundID: uint64(eventRoundId),
+		EventUserID:  uint64(eventUserId),
+		Result:       1,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round user: %v", err)
+	}
+	hasResults, err = q.EventUserHasResults(context.Background(), uint64(eventUserId))
+	if err != nil {
+		t.Fatalf("could not check event user results: %v", err)
+	}
+	if !hasResults {
+		t.Fatal("expected event user to have results")
+	}
+}
+
+func Test_DeleteEventUser_EventUserDisqualified_EventUserNotDeleted(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event198",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: time.Now().Add(1 * time.Hour).Round(time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+		EventID:      uint64(eventId),
+		ClientUserID: 64,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create event user: %v", err)
+	}
+	eventUserId, err := result.LastInsertId()
+	_, err = q.DisqualifyEventUser(context.Background(), DisqualifyEventUserParams{
+		DisqualificationReason: sql.NullString{String: "cheating", Valid: true},
+		DisqualifiedBy:         sql.NullString{String: "moderator1", Valid: true},
+		ID:                     uint64(eventUserId),
+	})
+	if err != nil {
+		t.Fatalf("could not disqualify event user: %v", err)
+	}
+	result, err = q.DeleteEventUser(context.Background(), GetEventUserWithoutWriteLockingParams{
+		ID: sql.NullInt64{Int64: eventUserId, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not delete event user: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		t.Fatalf("could not get rows affected: %v", err)
+	}
+	if rowsAffected != 0 {
+		t.Fatalf("expected 0 rows affected, got %d", rowsAffected)
+	}
+}
+
+func Test_CreateEventRoundUserRemoval_ResultAdded_RemovalCreated(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Round(time.Minute)
+	result, err := q.CreateEvent(context.Background(), CreateEventParams{
+		Name:      "event199",
+		Data:      json.RawMessage(`{}`),
+		StartedAt: startedAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create event: %v", err)
+	}
+	eventId, err := result.LastInsertId()
+	result, err = q.CreateEventRound(context.Background(), CreateEventRoundParams{
+		EventID:           uint64(eventId),
+		Name:              "round61",
+		Data:              json.RawMessage(`{}`),
+		Scoring:           json.RawMessage(`{"scoring": [1]}`),
+		ResultOrder:       EventRoundResultOrderAsc,
+		ResultAggregation: EventRoundResultAggregationLatest,
+		EndedAt:           startedAt.Add(1 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round: %v", err)
+	}
+	eventRoundId, err := result.LastInsertId()
+	result, err = q.CreateEventUser(context.Background(), CreateEventUserParams{
+		EventID:      uint64(eventId),
+		ClientUserID: 65,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create event user: %v", err)
+	}
+	eventUserId, err := result.LastInsertId()
+	result, err = q.CreateEventRoundUser(context.Background(), CreateEventRoundUserParams{
+		EventRoundID: uint64(eventRoundId),
+		EventUserID:  uint64(eventUserId),
+		Result:       7,
+		Data:         json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create event round user: %v", err)
+	}
+	eventRoundUserId, err := result.LastInsertId()
+	result, err = q.CreateEventRoundUserRemoval(context.Background(), uint64(eventRoundUserId))
+	if err != nil {
+		t.Fatalf("could not create event round user removal: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		t.Fatalf("could not get rows affected: %v", err)
+	}
+	if rowsAffected != 1 {
+		t.Fatalf("expected 1 row affected, got %d", rowsAffected)
+	}
+	var clientUserId, removedResult uint64
+	err = tx.QueryRowContext(context.Background(), "SELECT client_user_id, result FROM event_round_user_removal WHERE event_round_user_id = ?", eventRoundUserId).Scan(&clientUserId, &removedResult)
+	if err != nil {
+		t.Fatalf("could not get event round user removal: %v", err)
+	}
+	if clientUserId != 65 {
+		t.Fatalf("expected client user id 65, got %d", clientUserId)
+	}
+	if removedResult != 7 {
+		t.Fatalf("expected result 7, got %d", removedResult)
+	}
+}
@@ -1,18 +1,140 @@
 -- name: CreateEvent :execresult
-INSERT INTO event (name, data, started_at)
-VALUES (?, ?, ?);
+INSERT INTO event (
+        name,
+        data,
+        started_at,
+        registration_required,
+        registration_opens_at,
+        registration_closes_at,
+        max_participants,
+        eligibility
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
 -- name: CreateEventRound :execresult
-INSERT INTO event_round (event_id, name, data, scoring, ended_at)
+INSERT INTO event_round (
+        event_id,
+        name,
+        data,
+        scoring,
+        result_order,
+        result_aggregation,
+        result_aggregation_top,
+        ended_at
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+-- name: SetEventThirdPartyDelivery :execresult
+INSERT INTO event_third_party_delivery (
+        event_id,
+        excluded_rounds,
+        top_limit,
+        fields,
+        uri
+    )
+VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY
+UPDATE excluded_rounds = VALUES(excluded_rounds),
+    top_limit = VALUES(top_limit),
+    fields = VALUES(fields),
+    uri = VALUES(uri);
+-- name: GetEventThirdPartyDelivery :one
+SELECT *
+FROM event_third_party_delivery
+WHERE event_id = ?;
+-- name: CreateEventTemplate :execresult
+INSERT INTO event_template (
+        name,
+        data,
+        rounds,
+        cron_schedule,
+        lead_time_seconds
+    )
 VALUES (?, ?, ?, ?, ?);
 -- name: CreateEventUser :execresult
 INSERT INTO event_user (event_id, client_user_id, data)
 VALUES (?, ?, ?);
+-- name: CountEventUsers :one
+SELECT COUNT(*)
+FROM event_user
+WHERE event_id = ?;
+-- name: DisqualifyEventUser :execresult
+UPDATE event_user
+SET disqualified_at = NOW(),
+    disqualification_reason = ?,
+    disqualified_by = ?
+WHERE id = ?
+    AND disqualified_at IS NULL
+LIMIT 1;
+-- name: ReinstateEventUser :execresult
+UPDATE event_user
+SET disqualified_at = NULL,
+    disqualification_reason = NULL,
+    disqualified_by = NULL
+WHERE id = ?
+    AND disqualified_at IS NOT NULL
+LIMIT 1;
+-- name: CreateEventUserDisqualification :execresult
+INSERT INTO event_user_disqualification (event_user_id, action, reason, moderator)
+VALUES (?, ?, ?, ?);
 -- name: CreateEventRoundUser :execresult
-INSERT INTO event_round_user (event_user_id, event_round_id, result, data)
+INSERT INTO event_round_user (
+        event_user_id,
+        event_round_id,
+        event_team_id,
+        result,
+        data
+    )
+VALUES (?, ?, ?, ?, ?);
+-- name: CreateEventTeam :execresult
+INSERT INTO event_team (event_id, team_id)
+VALUES (?, ?) ON DUPLICATE KEY
+UPDATE id = LAST_INSERT_ID(id);
+-- name: GetTeamIdByUserId :one
+SELECT tm.team_id
+FROM team_member tm
+    JOIN team t ON tm.team_id = t.id
+WHERE tm.user_id = ?
+    AND t.deleted_at IS NULL
+LIMIT 1;
+-- name: EventUserHasResults :one
+SELECT EXISTS (
+        SELECT 1
+        FROM event_round_user eru
+        WHERE eru.event_user_id = ?
+    ) AS has_results;
+-- name: CreateEventRoundAttempt :execresult
+INSERT INTO event_round_attempt (event_user_id, event_round_id, result, data)
 VALUES (?, ?, ?, ?);
+-- name: CreateEventRoundAttemptFromEventRoundUser :execresult
+INSERT INTO event_round_attempt (
+        event_user_id,
+        event_round_id,
+        result,
+        data,
+        created_at
+    )
+SELECT eru.event_user_id,
+    eru.event_round_id,
+    eru.result,
+    eru.data,
+    eru.updated_at
+FROM event_round_user eru
+WHERE eru.event_user_id = ?
+    AND eru.event_round_id = ?
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event_round_attempt era
+        WHERE era.event_user_id = eru.event_user_id
+            AND era.event_round_id = eru.event_round_id
+    );
+-- name: GetEventRoundAttemptResults :many
+SELECT era.result
+FROM event_round_attempt era
+WHERE era.event_user_id = ?
+    AND era.event_round_id = ?
+ORDER BY era.id ASC;
 -- name: UpdateEventRoundUser :execresult
 UPDATE event_round_user eru
 SET eru.result = ?,
+    eru.event_team_id = ?,
     eru.data = ?
 WHERE eru.event_user_id = ?
     AND eru.event_round_id = ?
@@ -31,7 +153,59 @@ WHERE eru.event_user_id = ?
     AND er.ended_at > NOW()
 ORDER BY er.ended_at ASC
 LIMIT 1;
+-- name: CreateEventRoundUserRemoval :execresult
+INSERT INTO event_round_user_removal (
+        event_round_user_id,
+        event_user_id,
+        client_user_id,
+        event_round_id,
+        result,
+        data
+    )
+SELECT eru.id,
+    eru.event_user_id,
+    eu.client_user_id,
+    eru.event_round_id,
+    eru.result,
+    eru.data
+FROM event_round_user eru
+    JOIN event_user eu ON eru.event_user_id = eu.id
+WHERE eru.id = ?;
 -- name: DeleteEventRoundUser :execresult
 DELETE FROM event_round_user
 WHERE id = ?
-LIMIT 1;
\ No newline at end of file
+LIMIT 1;
+-- name: PauseEvent :execresult
+UPDATE event
+SET paused_at = NOW()
+WHERE id = ?
+LIMIT 1;
+-- The rounds still running when the event was paused are extended by the length of the pause, latest first so no two rounds share an end time
+-- name: ExtendEventRoundsPastPause :execresult
+UPDATE event_round er
+SET er.ended_at = DATE_ADD(
+        er.ended_at,
+        INTERVAL TIMESTAMPDIFF(SECOND, sqlc.arg(paused_at), NOW()) SECOND
+    )
+WHERE er.event_id = sqlc.arg(event_id)
+    AND er.ended_at > sqlc.arg(paused_at)
+ORDER BY er.ended_at DESC;
+-- name: ResumeEvent :execresult
+UPDATE event
+SET started_at = IF(
+        started_at > paused_at,
+        DATE_ADD(
+            started_at,
+            INTERVAL TIMESTAMPDIFF(SECOND, paused_at, NOW()) SECOND
+        ),
+        started_at
+    ),
+    paused_at = NULL
+WHERE id = ?
+LIMIT 1;
+-- name: CancelEvent :execresult
+UPDATE event
+SET cancelled_at = NOW(),
+    paused_at = NULL
+WHERE id = ?
+LIMIT 1;
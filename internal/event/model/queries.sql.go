@@ -12,32 +12,92 @@ import (
 	"time"
 )
 
+const CancelEvent = `-- name: CancelEvent :execresult
+UPDATE event
+SET cancelled_at = NOW(),
+    paused_at = NULL
+WHERE id = ?
+LIMIT 1
+`
+
+func (q *Queries) CancelEvent(ctx context.Context, id uint64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CancelEvent, id)
+}
+
+const CountEventUsers = `-- name: CountEventUsers :one
+SELECT COUNT(*)
+FROM event_user
+WHERE event_id = ?
+`
+
+func (q *Queries) CountEventUsers(ctx context.Context, eventID uint64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, CountEventUsers, eventID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const CreateEvent = `-- name: CreateEvent :execresult
-INSERT INTO event (name, data, started_at)
-VALUES (?, ?, ?)
+INSERT INTO event (
+        name,
+        data,
+        started_at,
+        registration_required,
+        registration_opens_at,
+        registration_closes_at,
+        max_participants,
+        eligibility
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateEventParams struct {
-	Name      string          `db:"name"`
-	Data      json.RawMessage `db:"data"`
-	StartedAt time.Time       `db:"started_at"`
+	Name                 string           `db:"name"`
+	Data                 json.RawMessage  `db:"data"`
+	StartedAt            time.Time        `db:"started_at"`
+	RegistrationRequired bool             `db:"registration_required"`
+	RegistrationOpensAt  sql.NullTime     `db:"registration_opens_at"`
+	RegistrationClosesAt sql.NullTime     `db:"registration_closes_at"`
+	MaxParticipants      sql.NullInt32    `db:"max_participants"`
+	Eligibility          *json.RawMessage `db:"eligibility"`
 }
 
 func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (sql.Result, error) {
-	return q.db.ExecContext(ctx, CreateEvent, arg.Name, arg.Data, arg.StartedAt)
+	return q.db.ExecContext(ctx, CreateEvent,
+		arg.Name,
+		arg.Data,
+		arg.StartedAt,
+		arg.RegistrationRequired,
+		arg.RegistrationOpensAt,
+		arg.RegistrationClosesAt,
+		arg.MaxParticipants,
+		arg.Eligibility,
+	)
 }
 
 const CreateEventRound = `-- name: CreateEventRound :execresult
-INSERT INTO event_round (event_id, name, data, scoring, ended_at)
-VALUES (?, ?, ?, ?, ?)
+INSERT INTO event_round (
+        event_id,
+        name,
+        data,
+        scoring,
+        result_order,
+        result_aggregation,
+        result_aggregation_top,
+        ended_at
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateEventRoundParams struct {
-	EventID uint64          `db:"event_id"`
-	Name    string          `db:"name"`
-	Data    json.RawMessage `db:"data"`
-	Scoring json.RawMessage `db:"scoring"`
-	EndedAt time.Time       `db:"ended_at"`
+	EventID              uint64                      `db:"event_id"`
+	Name                 string                      `db:"name"`
+	Data                 json.RawMessage             `db:"data"`
+	Scoring              json.RawMessage             `db:"scoring"`
+	ResultOrder          EventRoundResultOrder       `db:"result_order"`
+	ResultAggregation    EventRoundResultAggregation `db:"result_aggregation"`
+	ResultAggregationTop sql.NullInt32               `db:"result_aggregation_top"`
+	EndedAt              time.Time                   `db:"ended_at"`
 }
 
 func (q *Queries) CreateEventRound(ctx context.Context, arg CreateEventRoundParams) (sql.Result, error) {
@@ -46,18 +106,82 @@ func (q *Queries) CreateEventRound(ctx context.Context, arg CreateEventRoundPara
 		arg.Name,
 		arg.Data,
 		arg.Scoring,
+		arg.ResultOrder,
+		arg.ResultAggregation,
+		arg.ResultAggregationTop,
 		arg.EndedAt,
 	)
 }
 
-const CreateEventRoundUser = `-- name: CreateEventRoundUser :execresult
-INSERT INTO event_round_user (event_user_id, event_round_id, result, data)
+const CreateEventRoundAttempt = `-- name: CreateEventRoundAttempt :execresult
+INSERT INTO event_round_attempt (event_user_id, event_round_id, result, data)
 VALUES (?, ?, ?, ?)
 `
 
+type CreateEventRoundAttemptParams struct {
+	EventUserID  uint64          `db:"event_user_id"`
+	EventRoundID uint64          `db:"event_round_id"`
+	Result       uint64          `db:"result"`
+	Data         json.RawMessage `db:"data"`
+}
+
+func (q *Queries) CreateEventRoundAttempt(ctx context.Context, arg CreateEventRoundAttemptParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateEventRoundAttempt,
+		arg.EventUserID,
+		arg.EventRoundID,
+		arg.Result,
+		arg.Data,
+	)
+}
+
+const CreateEventRoundAttemptFromEventRoundUser = `-- name: CreateEventRoundAttemptFromEventRoundUser :execresult
+INSERT INTO event_round_attempt (
+        event_user_id,
+        event_round_id,
+        result,
+        data,
+        created_at
+    )
+SELECT eru.event_user_id,
+    eru.event_round_id,
+    eru.result,
+    eru.data,
+    eru.updated_at
+FROM event_round_user eru
+WHERE eru.event_user_id = ?
+    AND eru.event_round_id = ?
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event_round_attempt era
+        WHERE era.event_user_id = eru.event_user_id
+            AND era.event_round_id = eru.event_round_id
+    )
+`
+
+type CreateEventRoundAttemptFromEventRoundUserParams struct {
+	EventUserID  uint64 `db:"event_user_id"`
+	EventRoundID uint64 `db:"event_round_id"`
+}
+
+func (q *Queries) CreateEventRoundAttemptFromEventRoundUser(ctx context.Context, arg CreateEventRoundAttemptFromEventRoundUserParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateEventRoundAttemptFromEventRoundUser, arg.EventUserID, arg.EventRoundID)
+}
+
+const CreateEventRoundUser = `-- name: CreateEventRoundUser :execresult
+INSERT INTO event_round_user (
+        event_user_id,
+        event_round_id,
+        event_team_id,
+        result,
+        data
+    )
+VALUES (?, ?, ?, ?, ?)
+`
+
 type CreateEventRoundUserParams struct {
 	EventUserID  uint64          `db:"event_user_id"`
 	EventRoundID uint64          `db:"event_round_id"`
+	EventTeamID  sql.NullInt64   `db:"event_team_id"`
 	Result       uint64          `db:"result"`
 	Data         json.RawMessage `db:"data"`
 }
@@ -66,11 +190,80 @@ func (q *Queries) CreateEventRoundUser(ctx context.Context, arg CreateEventRound
 	return q.db.ExecContext(ctx, CreateEventRoundUser,
 		arg.EventUserID,
 		arg.EventRoundID,
+		arg.EventTeamID,
 		arg.Result,
 		arg.Data,
 	)
 }
 
+const CreateEventRoundUserRemoval = `-- name: CreateEventRoundUserRemoval :execresult
+INSERT INTO event_round_user_removal (
+        event_round_user_id,
+        event_user_id,
+        client_user_id,
+        event_round_id,
+        result,
+        data
+    )
+SELECT eru.id,
+    eru.event_user_id,
+    eu.client_user_id,
+    eru.event_round_id,
+    eru.result,
+    eru.data
+FROM event_round_user eru
+    JOIN event_user eu ON eru.event_user_id = eu.id
+WHERE eru.id = ?
+`
+
+func (q *Queries) CreateEventRoundUserRemoval(ctx context.Context, id uint64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateEventRoundUserRemoval, id)
+}
+
+const CreateEventTeam = `-- name: CreateEventTeam :execresult
+INSERT INTO event_team (event_id, team_id)
+VALUES (?, ?) ON DUPLICATE KEY
+UPDATE id = LAST_INSERT_ID(id)
+`
+
+type CreateEventTeamParams struct {
+	EventID uint64 `db:"event_id"`
+	TeamID  uint64 `db:"team_id"`
+}
+
+func (q *Queries) CreateEventTeam(ctx context.Context, arg CreateEventTeamParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateEventTeam, arg.EventID, arg.TeamID)
+}
+
+const CreateEventTemplate = `-- name: CreateEventTemplate :execresult
+INSERT INTO event_template (
+        name,
+        data,
+        rounds,
+        cron_schedule,
+        lead_time_seconds
+    )
+VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateEventTemplateParams struct {
+	Name            string          `db:"name"`
+	Data            json.RawMessage `db:"data"`
+	Rounds          json.RawMessage `db:"rounds"`
+	CronSchedule    sql.NullString  `db:"cron_schedule"`
+	LeadTimeSeconds uint32          `db:"lead_time_seconds"`
+}
+
+func (q *Queries) CreateEventTemplate(ctx context.Context, arg CreateEventTemplateParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateEventTemplate,
+		arg.Name,
+		arg.Data,
+		arg.Rounds,
+		arg.CronSchedule,
+		arg.LeadTimeSeconds,
+	)
+}
+
 const CreateEventUser = `-- name: CreateEventUser :execresult
 INSERT INTO event_user (event_id, client_user_id, data)
 VALUES (?, ?, ?)
@@ -86,6 +279,27 @@ func (q *Queries) CreateEventUser(ctx context.Context, arg CreateEventUserParams
 	return q.db.ExecContext(ctx, CreateEventUser, arg.EventID, arg.ClientUserID, arg.Data)
 }
 
+const CreateEventUserDisqualification = `-- name: CreateEventUserDisqualification :execresult
+INSERT INTO event_user_disqualification (event_user_id, action, reason, moderator)
+VALUES (?, ?, ?, ?)
+`
+
+type CreateEventUserDisqualificationParams struct {
+	EventUserID uint64                          `db:"event_user_id"`
+	Action      EventUserDisqualificationAction `db:"action"`
+	Reason      string                          `db:"reason"`
+	Moderator   string                          `db:"moderator"`
+}
+
+func (q *Queries) CreateEventUserDisqualification(ctx context.Context, arg CreateEventUserDisqualificationParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateEventUserDisqualification,
+		arg.EventUserID,
+		arg.Action,
+		arg.Reason,
+		arg.Moderator,
+	)
+}
+
 const DeleteEventRoundUser = `-- name: DeleteEventRoundUser :execresult
 DELETE FROM event_round_user
 WHERE id = ?
@@ -96,6 +310,98 @@ func (q *Queries) DeleteEventRoundUser(ctx context.Context, id uint64) (sql.Resu
 	return q.db.ExecContext(ctx, DeleteEventRoundUser, id)
 }
 
+const DisqualifyEventUser = `-- name: DisqualifyEventUser :execresult
+UPDATE event_user
+SET disqualified_at = NOW(),
+    disqualification_reason = ?,
+    disqualified_by = ?
+WHERE id = ?
+    AND disqualified_at IS NULL
+LIMIT 1
+`
+
+type DisqualifyEventUserParams struct {
+	DisqualificationReason sql.NullString `db:"disqualification_reason"`
+	DisqualifiedBy         sql.NullString `db:"disqualified_by"`
+	ID                     uint64         `db:"id"`
+}
+
+func (q *Queries) DisqualifyEventUser(ctx context.Context, arg DisqualifyEventUserParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, DisqualifyEventUser, arg.DisqualificationReason, arg.DisqualifiedBy, arg.ID)
+}
+
+const EventUserHasResults = `-- name: EventUserHasResults :one
+SELECT EXISTS (
+        SELECT 1
+        FROM event_round_user eru
+        WHERE eru.event_user_id = ?
+    ) AS has_results
+`
+
+func (q *Queries) EventUserHasResults(ctx context.Context, eventUserID uint64) (bool, error) {
+	row := q.db.QueryRowContext(ctx, EventUserHasResults, eventUserID)
+	var has_results bool
+	err := row.Scan(&has_results)
+	return has_results, err
+}
+
+const ExtendEventRoundsPastPause = `-- name: ExtendEventRoundsPastPause :execresult
+UPDATE event_round er
+SET er.ended_at = DATE_ADD(
+        er.ended_at,
+        INTERVAL TIMESTAMPDIFF(SECOND, ?, NOW()) SECOND
+    )
+WHERE er.event_id = ?
+    AND er.ended_at > ?
+ORDER BY er.ended_at DESC
+`
+
+type ExtendEventRoundsPastPauseParams struct {
+	PausedAt time.Time `db:"paused_at"`
+	EventID  uint64    `db:"event_id"`
+}
+
+// The rounds still running when the event was paused are extended by the length of the pause, latest first so no two rounds share an end time
+func (q *Queries) ExtendEventRoundsPastPause(ctx context.Context, arg ExtendEventRoundsPastPauseParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, ExtendEventRoundsPastPause, arg.PausedAt, arg.EventID, arg.PausedAt)
+}
+
+const GetEventRoundAttemptResults = `-- name: GetEventRoundAttemptResults :many
+SELECT era.result
+FROM event_round_attempt era
+WHERE era.event_user_id = ?
+    AND era.event_round_id = ?
+ORDER BY era.id ASC
+`
+
+type GetEventRoundAttemptResultsParams struct {
+	EventUserID  uint64 `db:"event_user_id"`
+	EventRoundID uint64 `db:"event_round_id"`
+}
+
+func (q *Queries) GetEventRoundAttemptResults(ctx context.Context, arg GetEventRoundAttemptResultsParams) ([]uint64, error) {
+	rows, err := q.db.QueryContext(ctx, GetEventRoundAttemptResults, arg.EventUserID, arg.EventRoundID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uint64
+	for rows.Next() {
+		var result uint64
+		if err := rows.Scan(&result); err != nil {
+			return nil, err
+		}
+		items = append(items, result)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const GetEventRoundUserByEventUserId = `-- name: GetEventRoundUserByEventUserId :one
 SELECT eru.event_user_id,
     eru.event_round_id,
@@ -135,9 +441,124 @@ func (q *Queries) GetEventRoundUserByEventUserId(ctx context.Context, eventUserI
 	return i, err
 }
 
+const GetEventThirdPartyDelivery = `-- name: GetEventThirdPartyDelivery :one
+SELECT event_id, excluded_rounds, top_limit, fields, uri, created_at, updated_at
+FROM event_third_party_delivery
+WHERE event_id = ?
+`
+
+func (q *Queries) GetEventThirdPartyDelivery(ctx context.Context, eventID uint64) (EventThirdPartyDelivery, error) {
+	row := q.db.QueryRowContext(ctx, GetEventThirdPartyDelivery, eventID)
+	var i EventThirdPartyDelivery
+	err := row.Scan(
+		&i.EventID,
+		&i.ExcludedRounds,
+		&i.TopLimit,
+		&i.Fields,
+		&i.Uri,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const GetTeamIdByUserId = `-- name: GetTeamIdByUserId :one
+SELECT tm.team_id
+FROM team_member tm
+    JOIN team t ON tm.team_id = t.id
+WHERE tm.user_id = ?
+    AND t.deleted_at IS NULL
+LIMIT 1
+`
+
+func (q *Queries) GetTeamIdByUserId(ctx context.Context, userID uint64) (uint64, error) {
+	row := q.db.QueryRowContext(ctx, GetTeamIdByUserId, userID)
+	var team_id uint64
+	err := row.Scan(&team_id)
+	return team_id, err
+}
+
+const PauseEvent = `-- name: PauseEvent :execresult
+UPDATE event
+SET paused_at = NOW()
+WHERE id = ?
+LIMIT 1
+`
+
+func (q *Queries) PauseEvent(ctx context.Context, id uint64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, PauseEvent, id)
+}
+
+const ReinstateEventUser = `-- name: ReinstateEventUser :execresult
+UPDATE event_user
+SET disqualified_at = NULL,
+    disqualification_reason = NULL,
+    disqualified_by = NULL
+WHERE id = ?
+    AND disqualified_at IS NOT NULL
+LIMIT 1
+`
+
+func (q *Queries) ReinstateEventUser(ctx context.Context, id uint64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, ReinstateEventUser, id)
+}
+
+const ResumeEvent = `-- name: ResumeEvent :execresult
+UPDATE event
+SET started_at = IF(
+        started_at > paused_at,
+        DATE_ADD(
+            started_at,
+            INTERVAL TIMESTAMPDIFF(SECOND, paused_at, NOW()) SECOND
+        ),
+        started_at
+    ),
+    paused_at = NULL
+WHERE id = ?
+LIMIT 1
+`
+
+func (q *Queries) ResumeEvent(ctx context.Context, id uint64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, ResumeEvent, id)
+}
+
+const SetEventThirdPartyDelivery = `-- name: SetEventThirdPartyDelivery :execresult
+INSERT INTO event_third_party_delivery (
+        event_id,
+        excluded_rounds,
+        top_limit,
+        fields,
+        uri
+    )
+VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY
+UPDATE excluded_rounds = VALUES(excluded_rounds),
+    top_limit = VALUES(top_limit),
+    fields = VALUES(fields),
+    uri = VALUES(uri)
+`
+
+type SetEventThirdPartyDeliveryParams struct {
+	EventID        uint64           `db:"event_id"`
+	ExcludedRounds *json.RawMessage `db:"excluded_rounds"`
+	TopLimit       sql.NullInt32    `db:"top_limit"`
+	Fields         *json.RawMessage `db:"fields"`
+	Uri            sql.NullString   `db:"uri"`
+}
+
+func (q *Queries) SetEventThirdPartyDelivery(ctx context.Context, arg SetEventThirdPartyDeliveryParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, SetEventThirdPartyDelivery,
+		arg.EventID,
+		arg.ExcludedRounds,
+		arg.TopLimit,
+		arg.Fields,
+		arg.Uri,
+	)
+}
+
 const UpdateEventRoundUser = `-- name: UpdateEventRoundUser :execresult
 UPDATE event_round_user eru
 SET eru.result = ?,
+    eru.event_team_id = ?,
     eru.data = ?
 WHERE eru.event_user_id = ?
     AND eru.event_round_id = ?
@@ -146,6 +567,7 @@ LIMIT 1
 
 type UpdateEventRoundUserParams struct {
 	Result       uint64          `db:"result"`
+	EventTeamID  sql.NullInt64   `db:"event_team_id"`
 	Data         json.RawMessage `db:"data"`
 	EventUserID  uint64          `db:"event_user_id"`
 	EventRoundID uint64          `db:"event_round_id"`
@@ -154,6 +576,7 @@ type UpdateEventRoundUserParams struct {
 func (q *Queries) UpdateEventRoundUser(ctx context.Context, arg UpdateEventRoundUserParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, UpdateEventRoundUser,
 		arg.Result,
+		arg.EventTeamID,
 		arg.Data,
 		arg.EventUserID,
 		arg.EventRoundID,
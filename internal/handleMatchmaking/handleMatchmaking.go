@@ -3,11 +3,13 @@ package handleMatchmaking
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/MorhafAlshibly/coanda/internal/handleMatchmaking/model"
 	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/matchmakingTeam"
 )
 
 type App struct {
@@ -16,6 +18,7 @@ type App struct {
 	eloWindowIncrementPerSecond uint16
 	eloWindowMax                uint16
 	limit                       int32
+	closestMatchLimit           int32
 }
 
 func WithSql(sql *sql.DB) func(*App) {
@@ -48,11 +51,18 @@ func WithLimit(limit int32) func(*App) {
 	}
 }
 
+func WithClosestMatchLimit(closestMatchLimit int32) func(*App) {
+	return func(input *App) {
+		input.closestMatchLimit = closestMatchLimit
+	}
+}
+
 func NewApp(options ...func(*App)) *App {
 	app := &App{
 		eloWindowIncrementPerSecond: 10,
 		eloWindowMax:                600,
 		limit:                       100,
+		closestMatchLimit:           10,
 	}
 	for _, option := range options {
 		option(app)
@@ -93,11 +103,30 @@ func (a *App) createNewMatch(ctx context.Context, ticketID uint64) error {
 		}
 		return err
 	}
-	// Get most popular arena on the ticket
-	arena, err := qtx.GetMostPopularArenaOnTicket(ctx, ticketID)
+	// Get the arenas on the ticket, from the most popular
+	arenas, err := qtx.GetArenasOnTicketByPopularity(ctx, ticketID)
 	if err != nil {
 		return err
 	}
+	// The ticket is on its own in the new match, so it only has to fit the team composition of the arena
+	var arena *model.GetArenasOnTicketByPopularityRow
+	team := sql.NullInt32{}
+	for i := range arenas {
+		var ok bool
+		team, ok, err = a.assignTeam(ctx, qtx, ticketID, nil, arenas[i].Teams, arenas[i].MaxPlayers)
+		if err != nil {
+			return err
+		}
+		if ok {
+			arena = &arenas[i]
+			break
+		}
+	}
+	if arena == nil {
+		// The ticket stays pending until its users are updated to fit an arena or it is deleted, which is not an error of the job
+		fmt.Println("No arena fits ticket ID:", ticketID)
+		return nil
+	}
 	// Create a new match
 	matchResult, err := qtx.CreateMatch(ctx, arena.ID)
 	if err != nil {
@@ -112,6 +141,7 @@ func (a *App) createNewMatch(ctx context.Context, ticketID uint64) error {
 	addMatchIDResult, err := qtx.AddMatchIDToTicket(ctx, model.AddMatchIDToTicketParams{
 		ID:                 ticketID,
 		MatchmakingMatchID: conversion.Int64ToSqlNullInt64(&matchID),
+		Team:               team,
 	})
 	if err != nil {
 		return err
@@ -181,34 +211,55 @@ func (a *App) matchmakeTicket(ctx context.Context, ticketID uint64) error {
 		}
 		return err
 	}
-	// Gets the closest match that has enough capacity and locks it for update
-	closestMatch, err := qtx.GetClosestMatch(ctx, model.GetClosestMatchParams{
+	// Gets the closest matches that have enough capacity and locks them for update
+	closestMatches, err := qtx.GetClosestMatches(ctx, model.GetClosestMatchesParams{
 		TicketID: conversion.Uint64ToSqlNullInt64(&ticketID),
+		Limit:    a.closestMatchLimit,
 	})
 	if err != nil {
-		if err == sql.ErrNoRows {
-			// If we didn't find a row, it means there are no matches available
-			return fmt.Errorf("No matches available for ticket ID %d", ticketID)
-		}
 		return err
 	}
-	// Check if the closest match's elo difference is within the acceptable range
+	if len(closestMatches) == 0 {
+		// The ticket waits for a match until it is aged, when a new match is created for it
+		fmt.Println("No match found for ticket ID:", ticketID)
+		return nil
+	}
 	// Elo window increment per second multiplied by time now minus the ticket's created at time
 	eloWindow := int64(a.eloWindowIncrementPerSecond) * int64(time.Since(ticket.CreatedAt).Seconds())
-	if closestMatch.EloDifference > eloWindow {
-		// If the elo difference is greater than the elo window, we cannot matchmake this ticket
-		return fmt.Errorf("Elo difference %d is greater than the elo window %d for ticket ID %d", closestMatch.EloDifference, eloWindow, ticketID)
-	}
-	if closestMatch.LockedAt.Valid {
-		if closestMatch.LockedAt.Time.Before(time.Now()) {
-			// Match is locked
-			return fmt.Errorf("Match with ID %d is locked and cannot be used for ticket ID %d", closestMatch.MatchID, ticketID)
+	var closestMatch *model.GetClosestMatchesRow
+	team := sql.NullInt32{}
+	// Matches are sorted by elo difference, so the first one the ticket fits the teams of is the closest
+	for i := range closestMatches {
+		// Check if the match's elo difference is within the acceptable range
+		if closestMatches[i].EloDifference > eloWindow {
+			// The matches after this one are further away, so none of them are within the elo window either
+			break
 		}
+		if closestMatches[i].LockedAt.Valid {
+			if closestMatches[i].LockedAt.Time.Before(time.Now()) {
+				// Match is locked
+				continue
+			}
+		}
+		var ok bool
+		team, ok, err = a.assignTeam(ctx, qtx, ticketID, &closestMatches[i].MatchID, closestMatches[i].Teams, closestMatches[i].MaxPlayers)
+		if err != nil {
+			return err
+		}
+		if ok {
+			closestMatch = &closestMatches[i]
+			break
+		}
+	}
+	if closestMatch == nil {
+		fmt.Println("No match found for ticket ID:", ticketID)
+		return nil
 	}
 	// Update the ticket with the match ID
 	_, err = qtx.AddMatchIDToTicket(ctx, model.AddMatchIDToTicketParams{
 		ID:                 ticketID,
 		MatchmakingMatchID: conversion.Uint64ToSqlNullInt64(&closestMatch.MatchID),
+		Team:               team,
 	})
 	if err != nil {
 		return err
@@ -222,6 +273,61 @@ func (a *App) matchmakeTicket(ctx context.Context, ticketID uint64) error {
 	return nil
 }
 
+// assignTeam returns the team of the match to put the ticket on, which is null if the arena has no teams.
+// The users of the ticket are kept together on one team, and they must be able to fill the role slots left on it.
+func (a *App) assignTeam(ctx context.Context, qtx *model.Queries, ticketID uint64, matchID *uint64, rawTeams json.RawMessage, maxPlayers uint32) (sql.NullInt32, bool, error) {
+	var teams matchmakingTeam.Teams
+	err := json.Unmarshal(rawTeams, &teams)
+	if err != nil {
+		return sql.NullInt32{}, false, err
+	}
+	// An arena without teams has an empty object
+	if teams.Count == 0 {
+		return sql.NullInt32{}, true, nil
+	}
+	users, err := qtx.GetTicketUsers(ctx, conversion.Uint64ToSqlNullInt64(&ticketID))
+	if err != nil {
+		return sql.NullInt32{}, false, err
+	}
+	party := [][]string{}
+	for _, user := range users {
+		roles, err := getRoles(teams, user.Data)
+		if err != nil {
+			return sql.NullInt32{}, false, err
+		}
+		party = append(party, roles)
+	}
+	players := []matchmakingTeam.Player{}
+	if matchID != nil {
+		matchPlayers, err := qtx.GetMatchPlayers(ctx, conversion.Uint64ToSqlNullInt64(matchID))
+		if err != nil {
+			return sql.NullInt32{}, false, err
+		}
+		for _, matchPlayer := range matchPlayers {
+			roles, err := getRoles(teams, matchPlayer.Data)
+			if err != nil {
+				return sql.NullInt32{}, false, err
+			}
+			players = append(players, matchmakingTeam.Player{
+				Team:  uint32(matchPlayer.Team.Int32),
+				Roles: roles,
+			})
+		}
+	}
+	team, ok := matchmakingTeam.AssignTeam(teams, maxPlayers, players, party)
+	if !ok {
+		return sql.NullInt32{}, false, nil
+	}
+	return sql.NullInt32{Int32: int32(team), Valid: true}, true, nil
+}
+
+func getRoles(teams matchmakingTeam.Teams, data json.RawMessage) ([]string, error) {
+	if len(teams.Roles) == 0 {
+		return []string{}, nil
+	}
+	return matchmakingTeam.GetRoles(data, teams.RoleDataKey)
+}
+
 func (a *App) matchmakeTickets(ctx context.Context) error {
 	limit := a.limit
 	offset := int32(0)
@@ -16,6 +16,7 @@ type MatchmakingArena struct {
 	MinPlayers          uint32          `db:"min_players"`
 	MaxPlayersPerTicket uint32          `db:"max_players_per_ticket"`
 	MaxPlayers          uint32          `db:"max_players"`
+	Teams               json.RawMessage `db:"teams"`
 	Data                json.RawMessage `db:"data"`
 	CreatedAt           time.Time       `db:"created_at"`
 	UpdatedAt           time.Time       `db:"updated_at"`
@@ -48,6 +49,7 @@ type MatchmakingMatchWithArena struct {
 	ArenaMinPlayers          uint32          `db:"arena_min_players"`
 	ArenaMaxPlayersPerTicket uint32          `db:"arena_max_players_per_ticket"`
 	ArenaMaxPlayers          uint32          `db:"arena_max_players"`
+	ArenaTeams               json.RawMessage `db:"arena_teams"`
 	ArenaData                json.RawMessage `db:"arena_data"`
 	ArenaCreatedAt           time.Time       `db:"arena_created_at"`
 	ArenaUpdatedAt           time.Time       `db:"arena_updated_at"`
@@ -70,6 +72,7 @@ type MatchmakingMatchWithArenaAndTicket struct {
 	ArenaMinPlayers                uint32          `db:"arena_min_players"`
 	ArenaMaxPlayersPerTicket       uint32          `db:"arena_max_players_per_ticket"`
 	ArenaMaxPlayers                uint32          `db:"arena_max_players"`
+	ArenaTeams                     json.RawMessage `db:"arena_teams"`
 	ArenaData                      json.RawMessage `db:"arena_data"`
 	ArenaCreatedAt                 time.Time       `db:"arena_created_at"`
 	ArenaUpdatedAt                 time.Time       `db:"arena_updated_at"`
@@ -77,6 +80,7 @@ type MatchmakingMatchWithArenaAndTicket struct {
 	MatchmakingUserID              sql.NullInt64   `db:"matchmaking_user_id"`
 	TicketStatus                   sql.NullString  `db:"ticket_status"`
 	TicketUserCount                interface{}     `db:"ticket_user_count"`
+	TicketTeam                     sql.NullInt32   `db:"ticket_team"`
 	TicketNumber                   interface{}     `db:"ticket_number"`
 	TicketData                     json.RawMessage `db:"ticket_data"`
 	TicketCreatedAt                sql.NullTime    `db:"ticket_created_at"`
@@ -92,6 +96,7 @@ type MatchmakingMatchWithArenaAndTicket struct {
 	TicketArenaMinPlayers          sql.NullInt32   `db:"ticket_arena_min_players"`
 	TicketArenaMaxPlayersPerTicket sql.NullInt32   `db:"ticket_arena_max_players_per_ticket"`
 	TicketArenaMaxPlayers          sql.NullInt32   `db:"ticket_arena_max_players"`
+	TicketArenaTeams               json.RawMessage `db:"ticket_arena_teams"`
 	ArenaNumber                    interface{}     `db:"arena_number"`
 	TicketArenaData                json.RawMessage `db:"ticket_arena_data"`
 	TicketArenaCreatedAt           sql.NullTime    `db:"ticket_arena_created_at"`
@@ -101,6 +106,7 @@ type MatchmakingMatchWithArenaAndTicket struct {
 type MatchmakingTicket struct {
 	ID                 uint64          `db:"id"`
 	MatchmakingMatchID sql.NullInt64   `db:"matchmaking_match_id"`
+	Team               sql.NullInt32   `db:"team"`
 	Data               json.RawMessage `db:"data"`
 	CreatedAt          time.Time       `db:"created_at"`
 	UpdatedAt          time.Time       `db:"updated_at"`
@@ -116,6 +122,7 @@ type MatchmakingTicketWithUser struct {
 	MatchmakingMatchID sql.NullInt64   `db:"matchmaking_match_id"`
 	Status             string          `db:"status"`
 	UserCount          interface{}     `db:"user_count"`
+	TicketTeam         sql.NullInt32   `db:"ticket_team"`
 	TicketData         json.RawMessage `db:"ticket_data"`
 	TicketCreatedAt    time.Time       `db:"ticket_created_at"`
 	TicketUpdatedAt    time.Time       `db:"ticket_updated_at"`
@@ -133,6 +140,7 @@ type MatchmakingTicketWithUserAndArena struct {
 	MatchmakingMatchID       sql.NullInt64   `db:"matchmaking_match_id"`
 	Status                   string          `db:"status"`
 	UserCount                interface{}     `db:"user_count"`
+	TicketTeam               sql.NullInt32   `db:"ticket_team"`
 	TicketData               json.RawMessage `db:"ticket_data"`
 	TicketCreatedAt          time.Time       `db:"ticket_created_at"`
 	TicketUpdatedAt          time.Time       `db:"ticket_updated_at"`
@@ -148,6 +156,7 @@ type MatchmakingTicketWithUserAndArena struct {
 	ArenaMinPlayers          uint32          `db:"arena_min_players"`
 	ArenaMaxPlayersPerTicket uint32          `db:"arena_max_players_per_ticket"`
 	ArenaMaxPlayers          uint32          `db:"arena_max_players"`
+	ArenaTeams               json.RawMessage `db:"arena_teams"`
 	ArenaNumber              interface{}     `db:"arena_number"`
 	ArenaData                json.RawMessage `db:"arena_data"`
 	ArenaCreatedAt           time.Time       `db:"arena_created_at"`
@@ -1,6 +1,7 @@
 -- name: GetAgedMatchmakingTickets :many
 SELECT id,
     matchmaking_match_id,
+    team,
     data,
     created_at,
     updated_at
@@ -15,12 +16,13 @@ LIMIT ? OFFSET ?;
 -- name: CreateMatch :execresult
 INSERT INTO matchmaking_match (matchmaking_arena_id, data)
 VALUES (?, "{}");
--- name: GetMostPopularArenaOnTicket :one
+-- name: GetArenasOnTicketByPopularity :many
 SELECT ma.id,
     ma.name,
     ma.min_players,
     ma.max_players_per_ticket,
     ma.max_players,
+    ma.teams,
     ma.data,
     ma.created_at,
     ma.updated_at,
@@ -34,15 +36,17 @@ WHERE ma.id IN (
         WHERE mta.matchmaking_ticket_id = ?
     )
 GROUP BY ma.id
-ORDER BY ticket_count DESC
-LIMIT 1;
+ORDER BY ticket_count DESC,
+    ma.id ASC;
 -- name: AddMatchIDToTicket :execresult
 UPDATE matchmaking_ticket
-SET matchmaking_match_id = ?
+SET matchmaking_match_id = ?,
+    team = ?
 WHERE id = ?;
 -- name: GetNonAgedMatchmakingTickets :many
 SELECT id,
     matchmaking_match_id,
+    team,
     data,
     created_at,
     updated_at
@@ -57,6 +61,7 @@ LIMIT ? OFFSET ?;
 -- name: LockTicketForUpdate :one
 SELECT id,
     matchmaking_match_id,
+    team,
     data,
     created_at,
     updated_at
@@ -65,7 +70,7 @@ WHERE id = ?
     AND matchmaking_match_id IS NULL
 LIMIT 1 FOR
 UPDATE;
--- name: GetClosestMatch :one
+-- name: GetClosestMatches :many
 WITH ticket_info AS (
     SELECT mu.matchmaking_ticket_id,
         COUNT(DISTINCT mu.id) AS user_count,
@@ -83,6 +88,7 @@ ticket_arenas AS (
 SELECT mm.id AS match_id,
     ma.name AS arena_name,
     ma.max_players,
+    ma.teams,
     current_players,
     (ma.max_players - current_players) AS remaining_capacity,
     ti.user_count AS ticket_user_count,
@@ -111,5 +117,23 @@ WHERE ti.user_count <= (ma.max_players - current_players)
         OR mm.locked_at > NOW()
     )
 ORDER BY elo_difference ASC
-LIMIT 1 FOR
-UPDATE;
\ No newline at end of file
+LIMIT ? FOR
+UPDATE;
+-- name: GetTicketUsers :many
+SELECT id,
+    matchmaking_ticket_id,
+    client_user_id,
+    elo,
+    data,
+    created_at,
+    updated_at
+FROM matchmaking_user
+WHERE matchmaking_ticket_id = ?
+ORDER BY id;
+-- name: GetMatchPlayers :many
+SELECT mt.team,
+    mu.data
+FROM matchmaking_ticket mt
+    JOIN matchmaking_user mu ON mu.matchmaking_ticket_id = mt.id
+WHERE mt.matchmaking_match_id = ?
+ORDER BY mu.id;
\ No newline at end of file
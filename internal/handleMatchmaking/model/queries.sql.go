@@ -14,17 +14,19 @@ import (
 
 const AddMatchIDToTicket = `-- name: AddMatchIDToTicket :execresult
 UPDATE matchmaking_ticket
-SET matchmaking_match_id = ?
+SET matchmaking_match_id = ?,
+    team = ?
 WHERE id = ?
 `
 
 type AddMatchIDToTicketParams struct {
 	MatchmakingMatchID sql.NullInt64 `db:"matchmaking_match_id"`
+	Team               sql.NullInt32 `db:"team"`
 	ID                 uint64        `db:"id"`
 }
 
 func (q *Queries) AddMatchIDToTicket(ctx context.Context, arg AddMatchIDToTicketParams) (sql.Result, error) {
-	return q.db.ExecContext(ctx, AddMatchIDToTicket, arg.MatchmakingMatchID, arg.ID)
+	return q.db.ExecContext(ctx, AddMatchIDToTicket, arg.MatchmakingMatchID, arg.Team, arg.ID)
 }
 
 const CreateMatch = `-- name: CreateMatch :execresult
@@ -39,6 +41,7 @@ func (q *Queries) CreateMatch(ctx context.Context, matchmakingArenaID uint64) (s
 const GetAgedMatchmakingTickets = `-- name: GetAgedMatchmakingTickets :many
 SELECT id,
     matchmaking_match_id,
+    team,
     data,
     created_at,
     updated_at
@@ -76,6 +79,7 @@ func (q *Queries) GetAgedMatchmakingTickets(ctx context.Context, arg GetAgedMatc
 		if err := rows.Scan(
 			&i.ID,
 			&i.MatchmakingMatchID,
+			&i.Team,
 			&i.Data,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -93,7 +97,78 @@ func (q *Queries) GetAgedMatchmakingTickets(ctx context.Context, arg GetAgedMatc
 	return items, nil
 }
 
-const GetClosestMatch = `-- name: GetClosestMatch :one
+const GetArenasOnTicketByPopularity = `-- name: GetArenasOnTicketByPopularity :many
+SELECT ma.id,
+    ma.name,
+    ma.min_players,
+    ma.max_players_per_ticket,
+    ma.max_players,
+    ma.teams,
+    ma.data,
+    ma.created_at,
+    ma.updated_at,
+    COUNT(mt.id) AS ticket_count
+FROM matchmaking_ticket mt
+    JOIN matchmaking_ticket_arena mta ON mt.id = mta.matchmaking_ticket_id
+    JOIN matchmaking_arena ma ON mta.matchmaking_arena_id = ma.id
+WHERE ma.id IN (
+        SELECT mta.matchmaking_arena_id
+        FROM matchmaking_ticket_arena mta
+        WHERE mta.matchmaking_ticket_id = ?
+    )
+GROUP BY ma.id
+ORDER BY ticket_count DESC,
+    ma.id ASC
+`
+
+type GetArenasOnTicketByPopularityRow struct {
+	ID                  uint64          `db:"id"`
+	Name                string          `db:"name"`
+	MinPlayers          uint32          `db:"min_players"`
+	MaxPlayersPerTicket uint32          `db:"max_players_per_ticket"`
+	MaxPlayers          uint32          `db:"max_players"`
+	Teams               json.RawMessage `db:"teams"`
+	Data                json.RawMessage `db:"data"`
+	CreatedAt           time.Time       `db:"created_at"`
+	UpdatedAt           time.Time       `db:"updated_at"`
+	TicketCount         int64           `db:"ticket_count"`
+}
+
+func (q *Queries) GetArenasOnTicketByPopularity(ctx context.Context, matchmakingTicketID uint64) ([]GetArenasOnTicketByPopularityRow, error) {
+	rows, err := q.db.QueryContext(ctx, GetArenasOnTicketByPopularity, matchmakingTicketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetArenasOnTicketByPopularityRow
+	for rows.Next() {
+		var i GetArenasOnTicketByPopularityRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.MinPlayers,
+			&i.MaxPlayersPerTicket,
+			&i.MaxPlayers,
+			&i.Teams,
+			&i.Data,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TicketCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetClosestMatches = `-- name: GetClosestMatches :many
 WITH ticket_info AS (
     SELECT mu.matchmaking_ticket_id,
         COUNT(DISTINCT mu.id) AS user_count,
@@ -111,6 +186,7 @@ ticket_arenas AS (
 SELECT mm.id AS match_id,
     ma.name AS arena_name,
     ma.max_players,
+    ma.teams,
     current_players,
     (ma.max_players - current_players) AS remaining_capacity,
     ti.user_count AS ticket_user_count,
@@ -139,100 +215,105 @@ WHERE ti.user_count <= (ma.max_players - current_players)
         OR mm.locked_at > NOW()
     )
 ORDER BY elo_difference ASC
-LIMIT 1 FOR
+LIMIT ? FOR
 UPDATE
 `
 
-type GetClosestMatchParams struct {
+type GetClosestMatchesParams struct {
 	TicketID sql.NullInt64 `db:"ticket_id"`
+	Limit    int32         `db:"limit"`
 }
 
-type GetClosestMatchRow struct {
-	MatchID           uint64       `db:"match_id"`
-	ArenaName         string       `db:"arena_name"`
-	MaxPlayers        uint32       `db:"max_players"`
-	CurrentPlayers    int64        `db:"current_players"`
-	RemainingCapacity int32        `db:"remaining_capacity"`
-	TicketUserCount   int64        `db:"ticket_user_count"`
-	MatchAvgElo       int64        `db:"match_avg_elo"`
-	TicketAvgElo      int64        `db:"ticket_avg_elo"`
-	EloDifference     int64        `db:"elo_difference"`
-	LockedAt          sql.NullTime `db:"locked_at"`
+type GetClosestMatchesRow struct {
+	MatchID           uint64          `db:"match_id"`
+	ArenaName         string          `db:"arena_name"`
+	MaxPlayers        uint32          `db:"max_players"`
+	Teams             json.RawMessage `db:"teams"`
+	CurrentPlayers    int64           `db:"current_players"`
+	RemainingCapacity int32           `db:"remaining_capacity"`
+	TicketUserCount   int64           `db:"ticket_user_count"`
+	MatchAvgElo       int64           `db:"match_avg_elo"`
+	TicketAvgElo      int64           `db:"ticket_avg_elo"`
+	EloDifference     int64           `db:"elo_difference"`
+	LockedAt          sql.NullTime    `db:"locked_at"`
 }
 
-func (q *Queries) GetClosestMatch(ctx context.Context, arg GetClosestMatchParams) (GetClosestMatchRow, error) {
-	row := q.db.QueryRowContext(ctx, GetClosestMatch, arg.TicketID, arg.TicketID)
-	var i GetClosestMatchRow
-	err := row.Scan(
-		&i.MatchID,
-		&i.ArenaName,
-		&i.MaxPlayers,
-		&i.CurrentPlayers,
-		&i.RemainingCapacity,
-		&i.TicketUserCount,
-		&i.MatchAvgElo,
-		&i.TicketAvgElo,
-		&i.EloDifference,
-		&i.LockedAt,
-	)
-	return i, err
+func (q *Queries) GetClosestMatches(ctx context.Context, arg GetClosestMatchesParams) ([]GetClosestMatchesRow, error) {
+	rows, err := q.db.QueryContext(ctx, GetClosestMatches, arg.TicketID, arg.TicketID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetClosestMatchesRow
+	for rows.Next() {
+		var i GetClosestMatchesRow
+		if err := rows.Scan(
+			&i.MatchID,
+			&i.ArenaName,
+			&i.MaxPlayers,
+			&i.Teams,
+			&i.CurrentPlayers,
+			&i.RemainingCapacity,
+			&i.TicketUserCount,
+			&i.MatchAvgElo,
+			&i.TicketAvgElo,
+			&i.EloDifference,
+			&i.LockedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const GetMostPopularArenaOnTicket = `-- name: GetMostPopularArenaOnTicket :one
-SELECT ma.id,
-    ma.name,
-    ma.min_players,
-    ma.max_players_per_ticket,
-    ma.max_players,
-    ma.data,
-    ma.created_at,
-    ma.updated_at,
-    COUNT(mt.id) AS ticket_count
+const GetMatchPlayers = `-- name: GetMatchPlayers :many
+SELECT mt.team,
+    mu.data
 FROM matchmaking_ticket mt
-    JOIN matchmaking_ticket_arena mta ON mt.id = mta.matchmaking_ticket_id
-    JOIN matchmaking_arena ma ON mta.matchmaking_arena_id = ma.id
-WHERE ma.id IN (
-        SELECT mta.matchmaking_arena_id
-        FROM matchmaking_ticket_arena mta
-        WHERE mta.matchmaking_ticket_id = ?
-    )
-GROUP BY ma.id
-ORDER BY ticket_count DESC
-LIMIT 1
+    JOIN matchmaking_user mu ON mu.matchmaking_ticket_id = mt.id
+WHERE mt.matchmaking_match_id = ?
+ORDER BY mu.id
 `
 
-type GetMostPopularArenaOnTicketRow struct {
-	ID                  uint64          `db:"id"`
-	Name                string          `db:"name"`
-	MinPlayers          uint32          `db:"min_players"`
-	MaxPlayersPerTicket uint32          `db:"max_players_per_ticket"`
-	MaxPlayers          uint32          `db:"max_players"`
-	Data                json.RawMessage `db:"data"`
-	CreatedAt           time.Time       `db:"created_at"`
-	UpdatedAt           time.Time       `db:"updated_at"`
-	TicketCount         int64           `db:"ticket_count"`
+type GetMatchPlayersRow struct {
+	Team sql.NullInt32   `db:"team"`
+	Data json.RawMessage `db:"data"`
 }
 
-func (q *Queries) GetMostPopularArenaOnTicket(ctx context.Context, matchmakingTicketID uint64) (GetMostPopularArenaOnTicketRow, error) {
-	row := q.db.QueryRowContext(ctx, GetMostPopularArenaOnTicket, matchmakingTicketID)
-	var i GetMostPopularArenaOnTicketRow
-	err := row.Scan(
-		&i.ID,
-		&i.Name,
-		&i.MinPlayers,
-		&i.MaxPlayersPerTicket,
-		&i.MaxPlayers,
-		&i.Data,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.TicketCount,
-	)
-	return i, err
+func (q *Queries) GetMatchPlayers(ctx context.Context, matchmakingMatchID sql.NullInt64) ([]GetMatchPlayersRow, error) {
+	rows, err := q.db.QueryContext(ctx, GetMatchPlayers, matchmakingMatchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMatchPlayersRow
+	for rows.Next() {
+		var i GetMatchPlayersRow
+		if err := rows.Scan(&i.Team, &i.Data); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const GetNonAgedMatchmakingTickets = `-- name: GetNonAgedMatchmakingTickets :many
 SELECT id,
     matchmaking_match_id,
+    team,
     data,
     created_at,
     updated_at
@@ -270,6 +351,51 @@ func (q *Queries) GetNonAgedMatchmakingTickets(ctx context.Context, arg GetNonAg
 		if err := rows.Scan(
 			&i.ID,
 			&i.MatchmakingMatchID,
+			&i.Team,
+			&i.Data,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetTicketUsers = `-- name: GetTicketUsers :many
+SELECT id,
+    matchmaking_ticket_id,
+    client_user_id,
+    elo,
+    data,
+    created_at,
+    updated_at
+FROM matchmaking_user
+WHERE matchmaking_ticket_id = ?
+ORDER BY id
+`
+
+func (q *Queries) GetTicketUsers(ctx context.Context, matchmakingTicketID sql.NullInt64) ([]MatchmakingUser, error) {
+	rows, err := q.db.QueryContext(ctx, GetTicketUsers, matchmakingTicketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MatchmakingUser
+	for rows.Next() {
+		var i MatchmakingUser
+		if err := rows.Scan(
+			&i.ID,
+			&i.MatchmakingTicketID,
+			&i.ClientUserID,
+			&i.Elo,
 			&i.Data,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -290,6 +416,7 @@ func (q *Queries) GetNonAgedMatchmakingTickets(ctx context.Context, arg GetNonAg
 const LockTicketForUpdate = `-- name: LockTicketForUpdate :one
 SELECT id,
     matchmaking_match_id,
+    team,
     data,
     created_at,
     updated_at
@@ -306,6 +433,7 @@ func (q *Queries) LockTicketForUpdate(ctx context.Context, id uint64) (Matchmaki
 	err := row.Scan(
 		&i.ID,
 		&i.MatchmakingMatchID,
+		&i.Team,
 		&i.Data,
 		&i.CreatedAt,
 		&i.UpdatedAt,
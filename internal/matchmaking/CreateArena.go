@@ -74,6 +74,14 @@ func (c *CreateArenaCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
+	tErr := c.service.checkForArenaTeamsError(c.In.Teams, c.In.MaxPlayersPerTicket, c.In.MaxPlayers)
+	if tErr != nil {
+		c.Out = &api.CreateArenaResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.CreateArenaResponse_Error_value, api.CreateArenaResponse_TEAM_COUNT_REQUIRED),
+		}
+		return nil
+	}
 	if c.In.Data == nil {
 		c.Out = &api.CreateArenaResponse{
 			Success: false,
@@ -85,11 +93,16 @@ func (c *CreateArenaCommand) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	teams, err := marshalArenaTeams(c.In.Teams)
+	if err != nil {
+		return err
+	}
 	result, err := c.service.database.CreateArena(ctx, model.CreateArenaParams{
 		Name:                c.In.Name,
 		MinPlayers:          uint32(c.In.MinPlayers),
 		MaxPlayersPerTicket: uint32(c.In.MaxPlayersPerTicket),
 		MaxPlayers:          uint32(c.In.MaxPlayers),
+		Teams:               teams,
 		Data:                data,
 	})
 	if err != nil {
@@ -219,6 +219,214 @@ func Test_CreateArena_NoData_DataRequiredError(t *testing.T) {
 	}
 }
 
+func Test_CreateArena_MaxPlayersNotDivisibleByTeamCount_MaxPlayersMustBeDivisibleByTeamCountError(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateArenaCommand(service, &api.CreateArenaRequest{
+		Name:                "test",
+		MinPlayers:          2,
+		MaxPlayersPerTicket: 2,
+		MaxPlayers:          5,
+		Teams: &api.ArenaTeams{
+			Count: 2,
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Success, false; got != want {
+		t.Fatalf("Expected success to be %v, got %v", want, got)
+	}
+	if got, want := c.Out.Error, api.CreateArenaResponse_MAX_PLAYERS_MUST_BE_DIVISIBLE_BY_TEAM_COUNT; got != want {
+		t.Fatalf("Expected error to be %v, got %v", want, got)
+	}
+}
+
+func Test_CreateArena_TicketBiggerThanTeam_MaxPlayersPerTicketCannotBeGreaterThanTeamSizeError(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateArenaCommand(service, &api.CreateArenaRequest{
+		Name:                "test",
+		MinPlayers:          2,
+		MaxPlayersPerTicket: 6,
+		MaxPlayers:          10,
+		Teams: &api.ArenaTeams{
+			Count: 2,
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Success, false; got != want {
+		t.Fatalf("Expected success to be %v, got %v", want, got)
+	}
+	if got, want := c.Out.Error, api.CreateArenaResponse_MAX_PLAYERS_PER_TICKET_CANNOT_BE_GREATER_THAN_TEAM_SIZE; got != want {
+		t.Fatalf("Expected error to be %v, got %v", want, got)
+	}
+}
+
+func Test_CreateArena_RolesWithoutRoleDataKey_RoleDataKeyRequiredError(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateArenaCommand(service, &api.CreateArenaRequest{
+		Name:                "test",
+		MinPlayers:          2,
+		MaxPlayersPerTicket: 5,
+		MaxPlayers:          10,
+		Teams: &api.ArenaTeams{
+			Count: 2,
+			Roles: []*api.ArenaRole{
+				{Name: "tank", Count: 1},
+				{Name: "dps", Count: 4},
+			},
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Success, false; got != want {
+		t.Fatalf("Expected success to be %v, got %v", want, got)
+	}
+	if got, want := c.Out.Error, api.CreateArenaResponse_ROLE_DATA_KEY_REQUIRED; got != want {
+		t.Fatalf("Expected error to be %v, got %v", want, got)
+	}
+}
+
+func Test_CreateArena_RoleCountsNotTeamSize_RoleCountsMustAddUpToTeamSizeError(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateArenaCommand(service, &api.CreateArenaRequest{
+		Name:                "test",
+		MinPlayers:          2,
+		MaxPlayersPerTicket: 5,
+		MaxPlayers:          10,
+		Teams: &api.ArenaTeams{
+			Count:       2,
+			RoleDataKey: conversion.ValueToPointer("role"),
+			Roles: []*api.ArenaRole{
+				{Name: "tank", Count: 1},
+				{Name: "dps", Count: 3},
+			},
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Success, false; got != want {
+		t.Fatalf("Expected success to be %v, got %v", want, got)
+	}
+	if got, want := c.Out.Error, api.CreateArenaResponse_ROLE_COUNTS_MUST_ADD_UP_TO_TEAM_SIZE; got != want {
+		t.Fatalf("Expected error to be %v, got %v", want, got)
+	}
+}
+
+func Test_CreateArena_DuplicateRoleName_RoleNameDuplicateError(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateArenaCommand(service, &api.CreateArenaRequest{
+		Name:                "test",
+		MinPlayers:          2,
+		MaxPlayersPerTicket: 5,
+		MaxPlayers:          10,
+		Teams: &api.ArenaTeams{
+			Count:       2,
+			RoleDataKey: conversion.ValueToPointer("role"),
+			Roles: []*api.ArenaRole{
+				{Name: "dps", Count: 2},
+				{Name: "dps", Count: 3},
+			},
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Success, false; got != want {
+		t.Fatalf("Expected success to be %v, got %v", want, got)
+	}
+	if got, want := c.Out.Error, api.CreateArenaResponse_ROLE_NAME_DUPLICATE; got != want {
+		t.Fatalf("Expected error to be %v, got %v", want, got)
+	}
+}
+
+func Test_CreateArena_ValidTeams_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	raw := json.RawMessage("{}")
+	data, err := conversion.RawJsonToProtobufStruct(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	teams := json.RawMessage(`{"count":2,"roleDataKey":"role","roles":[{"name":"tank","count":1},{"name":"healer","count":1},{"name":"dps","count":3}]}`)
+	mock.ExpectExec("INSERT INTO matchmaking_arena").WithArgs("test", 10, 5, 10, raw, teams).WillReturnResult(sqlmock.NewResult(1, 1))
+	c := NewCreateArenaCommand(service, &api.CreateArenaRequest{
+		Name:                "test",
+		MinPlayers:          10,
+		MaxPlayersPerTicket: 5,
+		MaxPlayers:          10,
+		Data:                data,
+		Teams: &api.ArenaTeams{
+			Count:       2,
+			RoleDataKey: conversion.ValueToPointer("role"),
+			Roles: []*api.ArenaRole{
+				{Name: "tank", Count: 1},
+				{Name: "healer", Count: 1},
+				{Name: "dps", Count: 3},
+			},
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Success, true; got != want {
+		t.Fatalf("Expected success to be %v, got %v", want, got)
+	}
+	if got, want := c.Out.Error, api.CreateArenaResponse_NONE; got != want {
+		t.Fatalf("Expected error to be %v, got %v", want, got)
+	}
+}
+
 func Test_CreateArena_ValidInput_Success(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -233,7 +441,7 @@ func Test_CreateArena_ValidInput_Success(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	mock.ExpectExec("INSERT INTO matchmaking_arena").WithArgs("test", 2, 3, 5, raw).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO matchmaking_arena").WithArgs("test", 2, 3, 5, raw, json.RawMessage("{}")).WillReturnResult(sqlmock.NewResult(1, 1))
 	c := NewCreateArenaCommand(service, &api.CreateArenaRequest{
 		Name:                "test",
 		MinPlayers:          2,
@@ -270,7 +478,7 @@ func Test_CreateArena_DuplicateName_AlreadyExistsError(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	mock.ExpectExec("INSERT INTO matchmaking_arena").WithArgs("test", 2, 3, 5, raw).WillReturnError(&mysql.MySQLError{
+	mock.ExpectExec("INSERT INTO matchmaking_arena").WithArgs("test", 2, 3, 5, raw, json.RawMessage("{}")).WillReturnError(&mysql.MySQLError{
 		Number:  uint16(errorcode.MySQLErrorCodeDuplicateEntry),
 		Message: "Duplicate entry 'test' for key 'matchmaking_arena.name'",
 	})
@@ -0,0 +1,73 @@
+package matchmaking
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/matchmaking/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type CreateMatchCommand struct {
+	service *Service
+	In      *api.CreateMatchRequest
+	Out     *api.CreateMatchResponse
+}
+
+func NewCreateMatchCommand(service *Service, in *api.CreateMatchRequest) *CreateMatchCommand {
+	return &CreateMatchCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *CreateMatchCommand) Execute(ctx context.Context) error {
+	aErr := c.service.checkForArenaRequestError(c.In.Arena)
+	if aErr != nil {
+		c.Out = &api.CreateMatchResponse{
+			Success: false,
+			Error:   conversion.Enum(*aErr, api.CreateMatchResponse_Error_value, api.CreateMatchResponse_ARENA_ID_OR_NAME_REQUIRED),
+		}
+		return nil
+	}
+	if c.In.Data == nil {
+		c.Out = &api.CreateMatchResponse{
+			Success: false,
+			Error:   api.CreateMatchResponse_DATA_REQUIRED,
+		}
+		return nil
+	}
+	data, err := conversion.ProtobufStructToRawJson(c.In.Data)
+	if err != nil {
+		return err
+	}
+	arena, err := c.service.database.GetArena(ctx, arenaRequestToArenaParams(c.In.Arena), nil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.CreateMatchResponse{
+				Success: false,
+				Error:   api.CreateMatchResponse_ARENA_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	result, err := c.service.database.CreateMatch(ctx, model.CreateMatchParams{
+		MatchmakingArenaID: arena.ID,
+		Data:               data,
+	})
+	if err != nil {
+		return err
+	}
+	matchId, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.CreateMatchResponse{
+		Success: true,
+		Id:      conversion.ValueToPointer(uint64(matchId)),
+		Error:   api.CreateMatchResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package matchmaking
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/matchmaking/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_CreateMatch_NoArena_ArenaIdOrNameRequired(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateMatchCommand(service, &api.CreateMatchRequest{
+		Data: &structpb.Struct{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Success, false; got != want {
+		t.Fatalf("Expected success to be %v, got %v", want, got)
+	}
+	if got, want := c.Out.Error, api.CreateMatchResponse_ARENA_ID_OR_NAME_REQUIRED; got != want {
+		t.Fatalf("Expected error to be %v, got %v", want, got)
+	}
+}
+
+func Test_CreateMatch_NoData_DataRequired(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateMatchCommand(service, &api.CreateMatchRequest{
+		Arena: &api.ArenaRequest{Id: conversion.ValueToPointer(uint64(6))},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Error, api.CreateMatchResponse_DATA_REQUIRED; got != want {
+		t.Fatalf("Expected error to be %v, got %v", want, got)
+	}
+}
+
+func Test_CreateMatch_ArenaDoesNotExist_ArenaNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").
+		WithArgs(uint64(6), 1).
+		WillReturnError(sql.ErrNoRows)
+	c := NewCreateMatchCommand(service, &api.CreateMatchRequest{
+		Arena: &api.ArenaRequest{Id: conversion.ValueToPointer(uint64(6))},
+		Data:  &structpb.Struct{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Error, api.CreateMatchResponse_ARENA_NOT_FOUND; got != want {
+		t.Fatalf("Expected error to be %v, got %v", want, got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_CreateMatch_ArenaByName_MatchCreated(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").
+		WithArgs("Test Arena", 1).
+		WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).
+			AddRow(uint64(6), "Test Arena", 2, 4, 8, json.RawMessage("{}"), time.Now(), time.Now(), json.RawMessage("{}")))
+	mock.ExpectExec("INSERT INTO matchmaking_match").
+		WithArgs(uint64(6), json.RawMessage("{}")).
+		WillReturnResult(sqlmock.NewResult(9, 1))
+	c := NewCreateMatchCommand(service, &api.CreateMatchRequest{
+		Arena: &api.ArenaRequest{Name: conversion.ValueToPointer("Test Arena")},
+		Data:  &structpb.Struct{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Success, true; got != want {
+		t.Fatalf("Expected success to be %v, got %v", want, got)
+	}
+	if got, want := *c.Out.Id, uint64(9); got != want {
+		t.Fatalf("Expected id to be %v, got %v", want, got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
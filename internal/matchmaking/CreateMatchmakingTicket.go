@@ -3,6 +3,7 @@ package matchmaking
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/MorhafAlshibly/coanda/api"
 	"github.com/MorhafAlshibly/coanda/internal/matchmaking/model"
@@ -57,6 +58,7 @@ func (c *CreateMatchmakingTicketCommand) Execute(ctx context.Context) error {
 	qtx := c.service.database.WithTx(tx)
 	// Get all user ids
 	userIds := make([]uint64, 0, len(c.In.MatchmakingUsers))
+	usersData := make([]json.RawMessage, 0, len(c.In.MatchmakingUsers))
 	userSet := make(map[uint64]bool)
 	for _, curr := range c.In.MatchmakingUsers {
 		user, err := qtx.GetMatchmakingUser(ctx, model.MatchmakingUserParams{
@@ -86,6 +88,7 @@ func (c *CreateMatchmakingTicketCommand) Execute(ctx context.Context) error {
 			continue
 		}
 		userIds = append(userIds, user.ID)
+		usersData = append(usersData, user.Data)
 		userSet[user.ID] = true
 	}
 	// Get all arena ids
@@ -111,6 +114,18 @@ func (c *CreateMatchmakingTicketCommand) Execute(ctx context.Context) error {
 			}
 			return nil
 		}
+		// Check if the users can fill the roles of the arena, as a ticket that can never fit would never be matched
+		canFill, err := canFillArenaRoles(arena.Teams, arena.MaxPlayers, usersData)
+		if err != nil {
+			return err
+		}
+		if !canFill {
+			c.Out = &api.CreateMatchmakingTicketResponse{
+				Success: false,
+				Error:   api.CreateMatchmakingTicketResponse_ROLES_CANNOT_BE_FILLED,
+			}
+			return nil
+		}
 		if _, ok := arenaSet[arena.ID]; ok {
 			continue
 		}
@@ -339,7 +339,7 @@ func Test_CreateMatchmakingTicket_MultipleArenasWithOneNotFound_ArenaNotFoundErr
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
 	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_user`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(matchmakingUserFields).AddRow(1, nil, 1, 1, raw, time.Now(), time.Now()))
-	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).AddRow(1, "test", 2, 3, 5, raw, time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).AddRow(1, "test", 2, 3, 5, raw, time.Now(), time.Now(), json.RawMessage("{}")))
 	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").WithArgs(2, 1).WillReturnError(sql.ErrNoRows)
 	mock.ExpectRollback()
 	c := NewCreateMatchmakingTicketCommand(service, &api.CreateMatchmakingTicketRequest{
@@ -387,7 +387,7 @@ func Test_CreateMatchmakingTicket_TooManyPlayersForArena_TooManyPlayersError(t *
 	mock.ExpectBegin()
 	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_user`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(matchmakingUserFields).AddRow(1, nil, 1, 1, raw, time.Now(), time.Now()))
 	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_user`").WithArgs(2, 1).WillReturnRows(sqlmock.NewRows(matchmakingUserFields).AddRow(2, nil, 2, 1, raw, time.Now(), time.Now()))
-	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).AddRow(1, "test", 1, 1, 5, raw, time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).AddRow(1, "test", 1, 1, 5, raw, time.Now(), time.Now(), json.RawMessage("{}")))
 	mock.ExpectRollback()
 	c := NewCreateMatchmakingTicketCommand(service, &api.CreateMatchmakingTicketRequest{
 		MatchmakingUsers: []*api.MatchmakingUserRequest{
@@ -417,6 +417,55 @@ func Test_CreateMatchmakingTicket_TooManyPlayersForArena_TooManyPlayersError(t *
 	}
 }
 
+func Test_CreateMatchmakingTicket_UsersCannotFillRoles_RolesCannotBeFilledError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	raw := json.RawMessage("{}")
+	data, err := conversion.RawJsonToProtobufStruct(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tank := json.RawMessage(`{"role": "tank"}`)
+	teams := json.RawMessage(`{"count": 2, "roleDataKey": "role", "roles": [{"name": "tank", "count": 1}, {"name": "dps", "count": 1}]}`)
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_user`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(matchmakingUserFields).AddRow(1, nil, 1, 1, tank, time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_user`").WithArgs(2, 1).WillReturnRows(sqlmock.NewRows(matchmakingUserFields).AddRow(2, nil, 2, 1, tank, time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).AddRow(1, "test", 2, 2, 4, raw, time.Now(), time.Now(), teams))
+	mock.ExpectRollback()
+	c := NewCreateMatchmakingTicketCommand(service, &api.CreateMatchmakingTicketRequest{
+		MatchmakingUsers: []*api.MatchmakingUserRequest{
+			{
+				Id: conversion.ValueToPointer(uint64(1)),
+			},
+			{
+				Id: conversion.ValueToPointer(uint64(2)),
+			},
+		},
+		Arenas: []*api.ArenaRequest{
+			{
+				Id: conversion.ValueToPointer(uint64(1)),
+			},
+		},
+		Data: data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Success, false; got != want {
+		t.Fatalf("Expected success to be %v, got %v", want, got)
+	}
+	if got, want := c.Out.Error, api.CreateMatchmakingTicketResponse_ROLES_CANNOT_BE_FILLED; got != want {
+		t.Fatalf("Expected error to be %v, got %v", want, got)
+	}
+}
+
 func Test_CreateMatchmakingTicket_ValidInput_Success(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -434,8 +483,8 @@ func Test_CreateMatchmakingTicket_ValidInput_Success(t *testing.T) {
 	mock.ExpectBegin()
 	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_user`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(matchmakingUserFields).AddRow(1, nil, 1, 1, raw, time.Now(), time.Now()))
 	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_user`").WithArgs(2, 1).WillReturnRows(sqlmock.NewRows(matchmakingUserFields).AddRow(2, nil, 2, 1, raw, time.Now(), time.Now()))
-	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).AddRow(1, "test", 2, 3, 5, raw, time.Now(), time.Now()))
-	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").WithArgs(2, 1).WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).AddRow(2, "test2", 2, 3, 5, raw, time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).AddRow(1, "test", 2, 3, 5, raw, time.Now(), time.Now(), json.RawMessage("{}")))
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").WithArgs(2, 1).WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).AddRow(2, "test2", 2, 3, 5, raw, time.Now(), time.Now(), json.RawMessage("{}")))
 	mock.ExpectExec("INSERT INTO matchmaking_ticket").WithArgs(raw).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec("UPDATE matchmaking_user").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec("UPDATE matchmaking_user").WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(2, 1))
@@ -16,7 +16,7 @@ import (
 
 var (
 	matchmakingUserFields  = []string{"id", "matchmaking_ticket_id", "client_user_id", "elo", "data", "created_at", "updated_at"}
-	matchmakingArenaFields = []string{"id", "name", "min_players", "max_players_per_ticket", "max_players", "data", "created_at", "updated_at"}
+	matchmakingArenaFields = []string{"id", "name", "min_players", "max_players_per_ticket", "max_players", "data", "created_at", "updated_at", "teams"}
 )
 
 func Test_CreateMatchmakingUser_NoClientUserId_ClientUserIdRequiredError(t *testing.T) {
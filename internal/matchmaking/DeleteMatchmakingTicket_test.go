@@ -204,6 +204,7 @@ func Test_DeleteMatchmakingTicket_MatchmakingTicketHasMatch_TicketCurrentlyInMat
 			uint64(6), uint64(3), "ENDED", 4, json.RawMessage("{}"), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour),
 			3, 3, 1600, 1, json.RawMessage("{}"), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour),
 			3, "Arena3", 4, 8, 8, 0, json.RawMessage("{}"), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour),
+			nil, json.RawMessage("{}"),
 		))
 	mock.ExpectRollback()
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
@@ -152,6 +152,7 @@ func Test_DeleteMatchmakingUser_MatchmakingUserHasTicket_UserCurrentlyInTicket(t
 			uint64(23), nil, "PENDING", 4, json.RawMessage("{}"), time.Now(), time.Now(),
 			3, 3, 1600, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			3, "Arena3", 4, 8, 8, 0, json.RawMessage("{}"), time.Now(), time.Now(),
+			nil, json.RawMessage("{}"),
 		))
 	mock.ExpectRollback()
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
@@ -193,6 +194,7 @@ func Test_DeleteMatchmakingUser_MatchmakingUserHasMatch_UserCurrentlyInMatch(t *
 			uint64(23), uint64(3), "ENDED", 4, json.RawMessage("{}"), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour),
 			3, 3, 1600, 1, json.RawMessage("{}"), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour),
 			3, "Arena3", 4, 8, 8, 0, json.RawMessage("{}"), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour),
+			nil, json.RawMessage("{}"),
 		))
 	mock.ExpectRollback()
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
@@ -25,6 +25,7 @@ var (
 		"ticket_arena_id", "ticket_arena_name", "ticket_arena_min_players", "ticket_arena_max_players_per_ticket",
 		"ticket_arena_max_players", "arena_number",
 		"ticket_arena_data", "ticket_arena_created_at", "ticket_arena_updated_at",
+		"arena_teams", "ticket_team", "ticket_arena_teams",
 	}
 )
 
@@ -251,6 +252,7 @@ func Test_EndMatch_StartTimeNotSet_StartTimeNotSetError(t *testing.T) {
 			uint64(1), uint64(4), "MATCHED", 1, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(4), 1200, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(1), "Arena1", 2, 4, 8, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+			json.RawMessage("{}"), nil, json.RawMessage("{}"),
 		))
 	mock.ExpectRollback()
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
@@ -293,6 +295,7 @@ func Test_EndMatch_MatchAlreadyEnded_AlreadyEndedError(t *testing.T) {
 			uint64(1), uint64(4), "MATCHED", 1, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(4), 1200, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(1), "Arena1", 2, 4, 8, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+			json.RawMessage("{}"), nil, json.RawMessage("{}"),
 		))
 	mock.ExpectRollback()
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
@@ -335,6 +338,7 @@ func Test_EndMatch_EndTimeBeforeStartTime_EndTimeBeforeStartTimeError(t *testing
 			uint64(1), uint64(4), "MATCHED", 1, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(4), 1200, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(1), "Arena1", 2, 4, 8, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+			json.RawMessage("{}"), nil, json.RawMessage("{}"),
 		))
 	mock.ExpectRollback()
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
@@ -99,7 +99,7 @@ func Test_GetArena_ById_Success(t *testing.T) {
 	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").
 		WithArgs(uint64(6), 1).
 		WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).
-			AddRow(uint64(6), "Test Arena", 2, 4, 8, json.RawMessage("{}"), time.Now(), time.Now()))
+			AddRow(uint64(6), "Test Arena", 2, 4, 8, json.RawMessage("{}"), time.Now(), time.Now(), json.RawMessage("{}")))
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
 	if err != nil {
 		t.Fatal(err)
@@ -133,7 +133,7 @@ func Test_GetArena_ByName_Success(t *testing.T) {
 	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").
 		WithArgs("Test Arena", 1).
 		WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).
-			AddRow(uint64(6), "Test Arena", 2, 4, 8, json.RawMessage("{}"), time.Now(), time.Now()))
+			AddRow(uint64(6), "Test Arena", 2, 4, 8, json.RawMessage("{}"), time.Now(), time.Now(), json.RawMessage("{}")))
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
 	if err != nil {
 		t.Fatal(err)
@@ -12,6 +12,11 @@ import (
 	"github.com/MorhafAlshibly/coanda/pkg/invoker"
 )
 
+var (
+	// Arenas are listed with their columns in the order of the table
+	matchmakingArenaTableFields = []string{"id", "name", "min_players", "max_players_per_ticket", "max_players", "teams", "data", "created_at", "updated_at"}
+)
+
 func Test_GetArenas_NoRows_NoRowsReturned(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -22,7 +27,7 @@ func Test_GetArenas_NoRows_NoRowsReturned(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	c := NewGetArenasCommand(service, &api.Pagination{})
-	mock.ExpectQuery("SELECT (.+) FROM matchmaking_arena").WillReturnRows(sqlmock.NewRows(matchmakingArenaFields))
+	mock.ExpectQuery("SELECT (.+) FROM matchmaking_arena").WillReturnRows(sqlmock.NewRows(matchmakingArenaTableFields))
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
 	if err != nil {
 		t.Fatal(err)
@@ -46,9 +51,9 @@ func Test_GetArenas_WithRows_Success(t *testing.T) {
 		WithSql(db), WithDatabase(queries))
 	c := NewGetArenasCommand(service, &api.Pagination{})
 	mock.ExpectQuery("SELECT (.+) FROM matchmaking_arena").
-		WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).
-			AddRow(uint64(1), "Arena 1", 2, 4, 8, json.RawMessage("{}"), time.Now(), time.Now()).
-			AddRow(uint64(2), "Arena 2", 3, 5, 10, json.RawMessage("{}"), time.Now(), time.Now()))
+		WillReturnRows(sqlmock.NewRows(matchmakingArenaTableFields).
+			AddRow(uint64(1), "Arena 1", 2, 4, 8, json.RawMessage("{}"), json.RawMessage("{}"), time.Now(), time.Now()).
+			AddRow(uint64(2), "Arena 2", 3, 5, 10, json.RawMessage("{}"), json.RawMessage("{}"), time.Now(), time.Now()))
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
 	if err != nil {
 		t.Fatal(err)
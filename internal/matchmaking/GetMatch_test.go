@@ -135,6 +135,7 @@ func Test_GetMatch_ByMatchId_Success(t *testing.T) {
 			uint64(1), uint64(4), "MATCHED", 1, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(4), 1200, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(1), "Arena1", 2, 4, 8, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+			json.RawMessage("{}"), nil, json.RawMessage("{}"),
 		))
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
 	if err != nil {
@@ -148,6 +149,49 @@ func Test_GetMatch_ByMatchId_Success(t *testing.T) {
 	}
 }
 
+func Test_GetMatch_ArenaWithTeams_TicketTeamReturned(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithDefaultMaxPageLength(1))
+	c := NewGetMatchCommand(service, &api.GetMatchRequest{
+		Match: &api.MatchRequest{
+			Id: conversion.ValueToPointer(uint64(9)),
+		},
+	})
+	teams := json.RawMessage(`{"count":2,"roleDataKey":"role","roles":[{"name":"tank","count":1},{"name":"dps","count":1}]}`)
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_match_with_arena_and_ticket`").
+		WithArgs(uint64(9), 0, 1, 0, 1, 0, 1).
+		WillReturnRows(sqlmock.NewRows(matchmakingMatchWithArenaAndTicketFields).AddRow(
+			uint64(9), nil, "PENDING", 1, 1, json.RawMessage("{}"), nil, nil, nil, time.Now(), time.Now(),
+			uint64(1), "Arena1", 2, 2, 4, json.RawMessage("{}"), time.Now(), time.Now(),
+			uint64(1), uint64(4), "MATCHED", 1, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+			uint64(4), 1200, 1, json.RawMessage(`{"role":"tank"}`), time.Now(), time.Now(),
+			uint64(1), "Arena1", 2, 2, 4, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+			teams, 2, teams,
+		))
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Success, true; got != want {
+		t.Fatalf("Expected success to be %v, got %v", want, got)
+	}
+	if got, want := c.Out.Match.Arena.Teams.Count, uint32(2); got != want {
+		t.Fatalf("Expected team count to be %d, got %d", want, got)
+	}
+	if got, want := len(c.Out.Match.Arena.Teams.Roles), 2; got != want {
+		t.Fatalf("Expected roles length to be %d, got %d", want, got)
+	}
+	if got, want := *c.Out.Match.Tickets[0].Team, uint32(2); got != want {
+		t.Fatalf("Expected ticket team to be %d, got %d", want, got)
+	}
+}
+
 func Test_GetMatch_MatchDoesntExist_NotFoundError(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
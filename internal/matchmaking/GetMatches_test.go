@@ -54,6 +54,7 @@ func Test_GetMatches_WithRows_Success(t *testing.T) {
 				uint64(1), uint64(4), "MATCHED", 1, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 				uint64(4), 1200, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 				uint64(1), "Arena1", 2, 4, 8, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+				json.RawMessage("{}"), nil, json.RawMessage("{}"),
 			).
 			AddRow(
 				uint64(10), nil, "PENDING", 2, 2, json.RawMessage("{}"), nil, nil, nil, time.Now(), time.Now(),
@@ -61,6 +62,7 @@ func Test_GetMatches_WithRows_Success(t *testing.T) {
 				uint64(2), uint64(5), "MATCHED", 2, 2, json.RawMessage("{}"), time.Now(), time.Now(),
 				uint64(5), 1500, 2, json.RawMessage("{}"), time.Now(), time.Now(),
 				uint64(2), "Arena2", 3, 5, 10, 2, json.RawMessage("{}"), time.Now(), time.Now(),
+				json.RawMessage("{}"), nil, json.RawMessage("{}"),
 			))
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
 	if err != nil {
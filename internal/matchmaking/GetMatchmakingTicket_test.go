@@ -20,6 +20,7 @@ var (
 		"user_created_at", "user_updated_at",
 		"arena_id", "arena_name", "arena_min_players", "arena_max_players_per_ticket",
 		"arena_max_players", "arena_number", "arena_data", "arena_created_at", "arena_updated_at",
+		"ticket_team", "arena_teams",
 	}
 )
 
@@ -116,6 +117,7 @@ func Test_GetMatchmakingTicket_ById_Success(t *testing.T) {
 				uint64(10), uint64(3), "ENDED", 4, json.RawMessage("{}"), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour),
 				3, 3, 1600, 1, json.RawMessage("{}"), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour),
 				3, "Arena3", 4, 8, 8, 0, json.RawMessage("{}"), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour),
+				nil, json.RawMessage("{}"),
 			))
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
 	if err != nil {
@@ -51,11 +51,13 @@ func Test_GetMatchmakingTickets_WithRows_Success(t *testing.T) {
 				uint64(10), uint64(3), "ENDED", 4, json.RawMessage("{}"), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour),
 				3, 3, 1600, 1, json.RawMessage("{}"), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour),
 				3, "Arena3", 4, 8, 8, 0, json.RawMessage("{}"), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour),
+				nil, json.RawMessage("{}"),
 			).
 			AddRow(
 				uint64(11), uint64(4), "MATCHED", 5, json.RawMessage("{}"), time.Now(), time.Now(),
 				4, 4, 1700, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 				4, "Arena4", 5, 10, 10, 0, json.RawMessage("{}"), time.Now(), time.Now(),
+				nil, json.RawMessage("{}"),
 			))
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
 	if err != nil {
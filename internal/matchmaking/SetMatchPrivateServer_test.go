@@ -230,6 +230,7 @@ func Test_SetMatchPrivateServer_PrivateServerAlreadySet_PrivateServerAlreadySetE
 			uint64(1), uint64(4), "MATCHED", 1, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(4), 1200, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(1), "Arena1", 2, 4, 8, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+			json.RawMessage("{}"), nil, json.RawMessage("{}"),
 		))
 	mock.ExpectRollback()
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
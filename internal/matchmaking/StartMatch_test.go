@@ -244,6 +244,7 @@ func Test_StartMatch_ByMatchId_Success(t *testing.T) {
 			uint64(1), uint64(4), "MATCHED", 1, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(4), 1200, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(1), "Arena1", 2, 4, 8, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+			json.RawMessage("{}"), nil, json.RawMessage("{}"),
 		))
 	mock.ExpectExec("UPDATE `matchmaking_match`").
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), uint64(8), 1).
@@ -317,6 +318,7 @@ func Test_StartMatch_NotEnoughPlayersToStart_NotEnoughPlayersToStartError(t *tes
 			uint64(1), uint64(4), "MATCHED", 1, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(4), 1200, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(1), "Arena1", 2, 4, 8, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+			json.RawMessage("{}"), nil, json.RawMessage("{}"),
 		))
 	mock.ExpectRollback()
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
@@ -355,6 +357,7 @@ func Test_StartMatch_StartedAtAlreadyHasAValue_AlreadyHasStartTimeError(t *testi
 			uint64(1), uint64(4), "MATCHED", 1, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(4), 1200, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(1), "Arena1", 2, 4, 8, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+			json.RawMessage("{}"), nil, json.RawMessage("{}"),
 		))
 	mock.ExpectRollback()
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
@@ -393,6 +396,7 @@ func Test_StartMatch_PrivateServerNotSet_PrivateServerNotSetError(t *testing.T)
 			uint64(1), uint64(4), "MATCHED", 1, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(4), 1200, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 			uint64(1), "Arena1", 2, 4, 8, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+			json.RawMessage("{}"), nil, json.RawMessage("{}"),
 		))
 	mock.ExpectRollback()
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
@@ -35,7 +35,7 @@ func (c *UpdateArenaCommand) Execute(ctx context.Context) error {
 		return nil
 	}
 	// Check if no update is specified
-	if c.In.MinPlayers == nil && c.In.MaxPlayers == nil && c.In.Data == nil {
+	if c.In.MinPlayers == nil && c.In.MaxPlayers == nil && c.In.Data == nil && c.In.Teams == nil {
 		c.Out = &api.UpdateArenaResponse{
 			Success: false,
 			Error:   api.UpdateArenaResponse_NO_UPDATE_SPECIFIED,
@@ -81,7 +81,7 @@ func (c *UpdateArenaCommand) Execute(ctx context.Context) error {
 	defer tx.Rollback()
 	qtx := c.service.database.WithTx(tx)
 	// Check if the arena exists and lock it for update
-	_, err = qtx.GetArena(ctx, arenaRequestToArenaParams(c.In.Arena), &goquOptions.SelectDataset{Locked: true})
+	arena, err := qtx.GetArena(ctx, arenaRequestToArenaParams(c.In.Arena), &goquOptions.SelectDataset{Locked: true})
 	if err != nil {
 		if err == sql.ErrNoRows {
 			// If we didn't find a row
@@ -112,12 +112,36 @@ func (c *UpdateArenaCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
+	// The teams must still split the players of the arena evenly after the update, whether the teams or the capacity are changed
+	teamsRequest := c.In.Teams
+	if teamsRequest == nil {
+		teamsRequest, err = unmarshalArenaTeams(arena.Teams)
+		if err != nil {
+			return err
+		}
+	}
+	tErr := c.service.checkForArenaTeamsError(teamsRequest, conversion.PointerToValue(c.In.MaxPlayersPerTicket, arena.MaxPlayersPerTicket), conversion.PointerToValue(c.In.MaxPlayers, arena.MaxPlayers))
+	if tErr != nil {
+		c.Out = &api.UpdateArenaResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.UpdateArenaResponse_Error_value, api.UpdateArenaResponse_TEAM_COUNT_REQUIRED),
+		}
+		return nil
+	}
+	teams := json.RawMessage(nil)
+	if c.In.Teams != nil {
+		teams, err = marshalArenaTeams(c.In.Teams)
+		if err != nil {
+			return err
+		}
+	}
 	result, err := qtx.UpdateArena(ctx, model.UpdateArenaParams{
 		Arena:               arenaRequestToArenaParams(c.In.Arena),
 		Data:                data,
 		MinPlayers:          conversion.Uint32ToSqlNullInt32(c.In.MinPlayers),
 		MaxPlayersPerTicket: conversion.Uint32ToSqlNullInt32(c.In.MaxPlayersPerTicket),
 		MaxPlayers:          conversion.Uint32ToSqlNullInt32(c.In.MaxPlayers),
+		Teams:               teams,
 	})
 	if err != nil {
 		return err
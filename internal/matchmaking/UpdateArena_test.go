@@ -246,6 +246,7 @@ func Test_UpdateArena_TicketsInUseWithThisArena_ArenaCurrentlyInUseError(t *test
 			AddRow(
 				uint64(8), "Arena4", 2, 4, 2,
 				json.RawMessage("{}"), time.Now(), time.Now(),
+				json.RawMessage("{}"),
 			))
 	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_ticket_with_user_and_arena`").
 		WillReturnRows(sqlmock.NewRows(matchmakingTicketFields).
@@ -253,6 +254,7 @@ func Test_UpdateArena_TicketsInUseWithThisArena_ArenaCurrentlyInUseError(t *test
 				uint64(11), uint64(4), "MATCHED", 5, json.RawMessage("{}"), time.Now(), time.Now(),
 				4, 4, 1700, 1, json.RawMessage("{}"), time.Now(), time.Now(),
 				4, "Arena4", 5, 10, 10, 0, json.RawMessage("{}"), time.Now(), time.Now(),
+				nil, json.RawMessage("{}"),
 			))
 	mock.ExpectRollback()
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
@@ -267,6 +269,47 @@ func Test_UpdateArena_TicketsInUseWithThisArena_ArenaCurrentlyInUseError(t *test
 	}
 }
 
+func Test_UpdateArena_CapacityChangedWithTeams_MaxPlayersMustBeDivisibleByTeamCountError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewUpdateArenaCommand(service, &api.UpdateArenaRequest{
+		Arena: &api.ArenaRequest{
+			Id: conversion.ValueToPointer(uint64(8)),
+		},
+		MinPlayers:          conversion.ValueToPointer(uint32(2)),
+		MaxPlayers:          conversion.ValueToPointer(uint32(5)),
+		MaxPlayersPerTicket: conversion.ValueToPointer(uint32(2)),
+	})
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_arena`").
+		WithArgs(uint64(8), 1).
+		WillReturnRows(sqlmock.NewRows(matchmakingArenaFields).
+			AddRow(
+				uint64(8), "Arena4", 2, 2, 4,
+				json.RawMessage("{}"), time.Now(), time.Now(),
+				json.RawMessage(`{"count":2}`),
+			))
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_ticket_with_user_and_arena`").
+		WillReturnRows(sqlmock.NewRows(matchmakingTicketFields))
+	mock.ExpectRollback()
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Success, false; got != want {
+		t.Fatalf("Expected success to be %v, got %v", want, got)
+	}
+	if got, want := c.Out.Error, api.UpdateArenaResponse_MAX_PLAYERS_MUST_BE_DIVISIBLE_BY_TEAM_COUNT; got != want {
+		t.Fatalf("Expected error to be %v, got %v", want, got)
+	}
+}
+
 func Test_UpdateArena_UpdateDataById_Success(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -293,6 +336,7 @@ func Test_UpdateArena_UpdateDataById_Success(t *testing.T) {
 			AddRow(
 				uint64(8), "Arena4", 2, 4, 2,
 				json.RawMessage("{}"), time.Now(), time.Now(),
+				json.RawMessage("{}"),
 			))
 	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_ticket_with_user_and_arena`").
 		WillReturnRows(sqlmock.NewRows(matchmakingTicketFields))
@@ -3,6 +3,8 @@ package matchmaking
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"math"
 
 	"github.com/MorhafAlshibly/coanda/api"
 	"github.com/MorhafAlshibly/coanda/internal/matchmaking/model"
@@ -77,6 +79,18 @@ func (c *UpdateMatchmakingUserCommand) Execute(ctx context.Context) error {
 			return err
 		}
 	}
+	// The users of a pending ticket must still fill the roles of its arenas, as a ticket that can never fit would never be matched
+	canFill, err := c.canFillTicketArenaRoles(ctx, qtx)
+	if err != nil {
+		return err
+	}
+	if !canFill {
+		c.Out = &api.UpdateMatchmakingUserResponse{
+			Success: false,
+			Error:   api.UpdateMatchmakingUserResponse_ROLES_CANNOT_BE_FILLED,
+		}
+		return nil
+	}
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -87,3 +101,46 @@ func (c *UpdateMatchmakingUserCommand) Execute(ctx context.Context) error {
 	}
 	return nil
 }
+
+// canFillTicketArenaRoles returns whether the users of the pending ticket of the user, with the updated data, can fill the roles of every arena on it.
+// A user without a pending ticket can always be updated.
+func (c *UpdateMatchmakingUserCommand) canFillTicketArenaRoles(ctx context.Context, qtx *model.Queries) (bool, error) {
+	ticket, err := qtx.GetMatchmakingTicket(ctx, model.GetMatchmakingTicketParams{
+		MatchmakingTicket: model.MatchmakingTicketParams{
+			MatchmakingUser: matchmakingUserRequestToMatchmakingUserParams(c.In.MatchmakingUser),
+		},
+		UserLimit:  math.MaxUint32,
+		ArenaLimit: math.MaxUint32,
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(ticket) == 0 || ticket[0].Status != "PENDING" {
+		return true, nil
+	}
+	// Each row is a user and arena pair of the ticket
+	usersData := []json.RawMessage{}
+	userSet := map[uint64]bool{}
+	arenas := []model.MatchmakingTicketWithUserAndArena{}
+	arenaSet := map[uint64]bool{}
+	for _, row := range ticket {
+		if !userSet[row.MatchmakingUserID] {
+			userSet[row.MatchmakingUserID] = true
+			usersData = append(usersData, row.UserData)
+		}
+		if !arenaSet[row.ArenaID] {
+			arenaSet[row.ArenaID] = true
+			arenas = append(arenas, row)
+		}
+	}
+	for _, arena := range arenas {
+		canFill, err := canFillArenaRoles(arena.ArenaTeams, arena.ArenaMaxPlayers, usersData)
+		if err != nil {
+			return false, err
+		}
+		if !canFill {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/MorhafAlshibly/coanda/api"
@@ -75,6 +76,8 @@ func Test_UpdateMatchmakingUser_ByMatchmakingUserId_Success(t *testing.T) {
 	mock.ExpectExec("UPDATE `matchmaking_user`").
 		WithArgs(json.RawMessage(`{"key":"value"}`), uint64(4), 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_ticket_with_user_and_arena`").
+		WillReturnRows(sqlmock.NewRows(matchmakingTicketFields))
 	mock.ExpectCommit()
 	c := NewUpdateMatchmakingUserCommand(service, &api.UpdateMatchmakingUserRequest{
 		MatchmakingUser: &api.MatchmakingUserRequest{
@@ -132,3 +135,59 @@ func Test_UpdateMatchmakingUser_UserDoesntExist_NotFoundError(t *testing.T) {
 		t.Fatalf("Expected error to be %v, got %v", want, got)
 	}
 }
+
+func Test_UpdateMatchmakingUser_PendingTicketRolesCannotBeFilled_RolesCannotBeFilledError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	// The user no longer has the role the arena needs
+	dps := json.RawMessage(`{"role":"dps"}`)
+	data, err := conversion.RawJsonToProtobufStruct(dps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	teams := json.RawMessage(`{"count": 2, "roleDataKey": "role", "roles": [{"name": "tank", "count": 1}, {"name": "dps", "count": 1}]}`)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `matchmaking_user`").
+		WithArgs(dps, uint64(4), 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM `matchmaking_ticket_with_user_and_arena`").
+		WillReturnRows(sqlmock.NewRows(matchmakingTicketFields).
+			AddRow(
+				uint64(23), nil, "PENDING", 2, json.RawMessage("{}"), time.Now(), time.Now(),
+				4, 4, 1600, 1, dps, time.Now(), time.Now(),
+				3, "Arena3", 4, 2, 4, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+				nil, teams,
+			).
+			AddRow(
+				uint64(23), nil, "PENDING", 2, json.RawMessage("{}"), time.Now(), time.Now(),
+				5, 5, 1600, 2, dps, time.Now(), time.Now(),
+				3, "Arena3", 4, 2, 4, 1, json.RawMessage("{}"), time.Now(), time.Now(),
+				nil, teams,
+			))
+	mock.ExpectRollback()
+	c := NewUpdateMatchmakingUserCommand(service, &api.UpdateMatchmakingUserRequest{
+		MatchmakingUser: &api.MatchmakingUserRequest{
+			Id: conversion.ValueToPointer(uint64(4)),
+		},
+		Data: data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Out.Success, false; got != want {
+		t.Fatalf("Expected success to be %v, got %v", want, got)
+	}
+	if got, want := c.Out.Error, api.UpdateMatchmakingUserResponse_ROLES_CANNOT_BE_FILLED; got != want {
+		t.Fatalf("Expected error to be %v, got %v", want, got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -3,6 +3,7 @@ package matchmaking
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/MorhafAlshibly/coanda/api"
@@ -10,6 +11,7 @@ import (
 	"github.com/MorhafAlshibly/coanda/pkg/cache"
 	"github.com/MorhafAlshibly/coanda/pkg/conversion"
 	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+	"github.com/MorhafAlshibly/coanda/pkg/matchmakingTeam"
 	"github.com/MorhafAlshibly/coanda/pkg/metric"
 )
 
@@ -232,6 +234,16 @@ func (s *Service) DeleteMatchmakingTicket(ctx context.Context, in *api.Matchmaki
 	return command.Out, nil
 }
 
+func (s *Service) CreateMatch(ctx context.Context, in *api.CreateMatchRequest) (*api.CreateMatchResponse, error) {
+	command := NewCreateMatchCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
 func (s *Service) StartMatch(ctx context.Context, in *api.StartMatchRequest) (*api.StartMatchResponse, error) {
 	command := NewStartMatchCommand(s, in)
 	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
@@ -307,6 +319,10 @@ func unmarshalArena(arena model.MatchmakingArena) (*api.Arena, error) {
 	if err != nil {
 		return nil, err
 	}
+	teams, err := unmarshalArenaTeams(arena.Teams)
+	if err != nil {
+		return nil, err
+	}
 	return &api.Arena{
 		Id:                  arena.ID,
 		Name:                arena.Name,
@@ -316,6 +332,7 @@ func unmarshalArena(arena model.MatchmakingArena) (*api.Arena, error) {
 		Data:                data,
 		CreatedAt:           conversion.TimeToTimestamppb(&arena.CreatedAt),
 		UpdatedAt:           conversion.TimeToTimestamppb(&arena.UpdatedAt),
+		Teams:               teams,
 	}, nil
 }
 
@@ -343,6 +360,7 @@ func unmarshalMatchmakingTicket(matchmakingTicket []model.MatchmakingTicketWithU
 		Id:        matchmakingTicket[0].TicketID,
 		MatchId:   conversion.SqlNullInt64ToUint64(matchmakingTicket[0].MatchmakingMatchID),
 		Status:    api.MatchmakingTicket_Status(api.MatchmakingTicket_Status_value[matchmakingTicket[0].Status]),
+		Team:      conversion.SqlNullInt32ToUint32(matchmakingTicket[0].TicketTeam),
 		Data:      data,
 		CreatedAt: conversion.TimeToTimestamppb(&matchmakingTicket[0].TicketCreatedAt),
 		UpdatedAt: conversion.TimeToTimestamppb(&matchmakingTicket[0].TicketUpdatedAt),
@@ -375,6 +393,7 @@ func unmarshalMatchmakingTicket(matchmakingTicket []model.MatchmakingTicketWithU
 				MinPlayers:          ticket.ArenaMinPlayers,
 				MaxPlayersPerTicket: ticket.ArenaMaxPlayersPerTicket,
 				MaxPlayers:          ticket.ArenaMaxPlayers,
+				Teams:               ticket.ArenaTeams,
 				Data:                ticket.ArenaData,
 				CreatedAt:           ticket.ArenaCreatedAt,
 				UpdatedAt:           ticket.ArenaUpdatedAt,
@@ -421,6 +440,7 @@ func unmarshalMatch(match []model.MatchmakingMatchWithArenaAndTicket) (*api.Matc
 		MinPlayers:          uint32(match[0].ArenaMinPlayers),
 		MaxPlayersPerTicket: uint32(match[0].ArenaMaxPlayersPerTicket),
 		MaxPlayers:          uint32(match[0].ArenaMaxPlayers),
+		Teams:               match[0].ArenaTeams,
 		Data:                match[0].ArenaData,
 		CreatedAt:           match[0].ArenaCreatedAt,
 		UpdatedAt:           match[0].ArenaUpdatedAt,
@@ -446,6 +466,7 @@ func unmarshalMatch(match []model.MatchmakingMatchWithArenaAndTicket) (*api.Matc
 			TicketID:                 uint64(ticket.TicketID.Int64),
 			MatchmakingMatchID:       conversion.Uint64ToSqlNullInt64(&ticket.MatchID),
 			Status:                   ticket.TicketStatus.String,
+			TicketTeam:               ticket.TicketTeam,
 			TicketData:               ticket.TicketData,
 			TicketCreatedAt:          ticket.TicketCreatedAt.Time,
 			TicketUpdatedAt:          ticket.TicketUpdatedAt.Time,
@@ -461,6 +482,7 @@ func unmarshalMatch(match []model.MatchmakingMatchWithArenaAndTicket) (*api.Matc
 			ArenaMinPlayers:          uint32(ticket.TicketArenaMinPlayers.Int32),
 			ArenaMaxPlayersPerTicket: uint32(ticket.TicketArenaMaxPlayersPerTicket.Int32),
 			ArenaMaxPlayers:          uint32(ticket.TicketArenaMaxPlayers.Int32),
+			ArenaTeams:               ticket.TicketArenaTeams,
 			ArenaNumber:              ticket.ArenaNumber,
 			ArenaData:                ticket.TicketArenaData,
 			ArenaCreatedAt:           ticket.TicketArenaCreatedAt.Time,
@@ -538,12 +560,21 @@ func matchRequestToMatchParams(request *api.MatchRequest) model.MatchParams {
 type MatchmakingRequestError string
 
 const (
-	NAME_TOO_SHORT                                     MatchmakingRequestError = "NAME_TOO_SHORT"
-	NAME_TOO_LONG                                      MatchmakingRequestError = "NAME_TOO_LONG"
-	ARENA_ID_OR_NAME_REQUIRED                          MatchmakingRequestError = "ARENA_ID_OR_NAME_REQUIRED"
-	MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED     MatchmakingRequestError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
-	MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED MatchmakingRequestError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
-	MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED            MatchmakingRequestError = "MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED"
+	NAME_TOO_SHORT                                          MatchmakingRequestError = "NAME_TOO_SHORT"
+	NAME_TOO_LONG                                           MatchmakingRequestError = "NAME_TOO_LONG"
+	ARENA_ID_OR_NAME_REQUIRED                               MatchmakingRequestError = "ARENA_ID_OR_NAME_REQUIRED"
+	MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED          MatchmakingRequestError = "MATCHMAKING_USER_ID_OR_CLIENT_USER_ID_REQUIRED"
+	MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED      MatchmakingRequestError = "MATCHMAKING_TICKET_ID_OR_MATCHMAKING_USER_REQUIRED"
+	MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED                 MatchmakingRequestError = "MATCH_ID_OR_MATCHMAKING_TICKET_REQUIRED"
+	TEAM_COUNT_REQUIRED                                     MatchmakingRequestError = "TEAM_COUNT_REQUIRED"
+	MAX_PLAYERS_MUST_BE_DIVISIBLE_BY_TEAM_COUNT             MatchmakingRequestError = "MAX_PLAYERS_MUST_BE_DIVISIBLE_BY_TEAM_COUNT"
+	MAX_PLAYERS_PER_TICKET_CANNOT_BE_GREATER_THAN_TEAM_SIZE MatchmakingRequestError = "MAX_PLAYERS_PER_TICKET_CANNOT_BE_GREATER_THAN_TEAM_SIZE"
+	ROLE_DATA_KEY_REQUIRED                                  MatchmakingRequestError = "ROLE_DATA_KEY_REQUIRED"
+	ROLES_REQUIRED                                          MatchmakingRequestError = "ROLES_REQUIRED"
+	ROLE_NAME_REQUIRED                                      MatchmakingRequestError = "ROLE_NAME_REQUIRED"
+	ROLE_COUNT_REQUIRED                                     MatchmakingRequestError = "ROLE_COUNT_REQUIRED"
+	ROLE_NAME_DUPLICATE                                     MatchmakingRequestError = "ROLE_NAME_DUPLICATE"
+	ROLE_COUNTS_MUST_ADD_UP_TO_TEAM_SIZE                    MatchmakingRequestError = "ROLE_COUNTS_MUST_ADD_UP_TO_TEAM_SIZE"
 )
 
 func (s *Service) checkForArenaRequestError(request *api.ArenaRequest) *MatchmakingRequestError {
@@ -603,3 +634,118 @@ func (s *Service) checkForMatchRequestError(request *api.MatchRequest) *Matchmak
 	}
 	return s.checkForMatchmakingTicketRequestError(request.MatchmakingTicket)
 }
+
+// checkForArenaTeamsError checks the teams of an arena split its players evenly, keep every ticket on one team and fill each team with role slots
+func (s *Service) checkForArenaTeamsError(teams *api.ArenaTeams, maxPlayersPerTicket uint32, maxPlayers uint32) *MatchmakingRequestError {
+	if teams == nil {
+		return nil
+	}
+	if teams.Count == 0 {
+		return conversion.ValueToPointer(TEAM_COUNT_REQUIRED)
+	}
+	if maxPlayers%teams.Count != 0 {
+		return conversion.ValueToPointer(MAX_PLAYERS_MUST_BE_DIVISIBLE_BY_TEAM_COUNT)
+	}
+	teamSize := maxPlayers / teams.Count
+	if maxPlayersPerTicket > teamSize {
+		return conversion.ValueToPointer(MAX_PLAYERS_PER_TICKET_CANNOT_BE_GREATER_THAN_TEAM_SIZE)
+	}
+	if len(teams.Roles) == 0 {
+		if teams.RoleDataKey != nil {
+			return conversion.ValueToPointer(ROLES_REQUIRED)
+		}
+		return nil
+	}
+	if teams.RoleDataKey == nil || *teams.RoleDataKey == "" {
+		return conversion.ValueToPointer(ROLE_DATA_KEY_REQUIRED)
+	}
+	seenRoleNames := map[string]bool{}
+	roleCount := uint32(0)
+	for _, role := range teams.Roles {
+		if role.Name == "" {
+			return conversion.ValueToPointer(ROLE_NAME_REQUIRED)
+		}
+		if role.Count == 0 {
+			return conversion.ValueToPointer(ROLE_COUNT_REQUIRED)
+		}
+		if seenRoleNames[role.Name] {
+			return conversion.ValueToPointer(ROLE_NAME_DUPLICATE)
+		}
+		seenRoleNames[role.Name] = true
+		roleCount += role.Count
+	}
+	if roleCount != teamSize {
+		return conversion.ValueToPointer(ROLE_COUNTS_MUST_ADD_UP_TO_TEAM_SIZE)
+	}
+	return nil
+}
+
+// canFillArenaRoles returns whether the users of a ticket can be put together on one team of the arena, each in a role slot they can fill
+func canFillArenaRoles(rawTeams json.RawMessage, maxPlayers uint32, usersData []json.RawMessage) (bool, error) {
+	var teams matchmakingTeam.Teams
+	err := json.Unmarshal(rawTeams, &teams)
+	if err != nil {
+		return false, err
+	}
+	// An arena without teams or roles takes any ticket with few enough players
+	if teams.Count == 0 || len(teams.Roles) == 0 {
+		return true, nil
+	}
+	party := [][]string{}
+	for _, userData := range usersData {
+		roles, err := matchmakingTeam.GetRoles(userData, teams.RoleDataKey)
+		if err != nil {
+			return false, err
+		}
+		party = append(party, roles)
+	}
+	_, ok := matchmakingTeam.AssignTeam(teams, maxPlayers, nil, party)
+	return ok, nil
+}
+
+// marshalArenaTeams returns the teams as stored, where an arena without teams has an empty object
+func marshalArenaTeams(teams *api.ArenaTeams) (json.RawMessage, error) {
+	if teams == nil {
+		return json.RawMessage("{}"), nil
+	}
+	storedTeams := matchmakingTeam.Teams{
+		Count:       teams.Count,
+		RoleDataKey: conversion.PointerToValue(teams.RoleDataKey, ""),
+		Roles:       []matchmakingTeam.Role{},
+	}
+	for _, role := range teams.Roles {
+		storedTeams.Roles = append(storedTeams.Roles, matchmakingTeam.Role{
+			Name:  role.Name,
+			Count: role.Count,
+		})
+	}
+	return json.Marshal(storedTeams)
+}
+
+func unmarshalArenaTeams(rawTeams json.RawMessage) (*api.ArenaTeams, error) {
+	if len(rawTeams) == 0 {
+		return nil, nil
+	}
+	var storedTeams matchmakingTeam.Teams
+	err := json.Unmarshal(rawTeams, &storedTeams)
+	if err != nil {
+		return nil, err
+	}
+	if storedTeams.Count == 0 {
+		return nil, nil
+	}
+	teams := &api.ArenaTeams{
+		Count: storedTeams.Count,
+		Roles: []*api.ArenaRole{},
+	}
+	if storedTeams.RoleDataKey != "" {
+		teams.RoleDataKey = &storedTeams.RoleDataKey
+	}
+	for _, role := range storedTeams.Roles {
+		teams.Roles = append(teams.Roles, &api.ArenaRole{
+			Name:  role.Name,
+			Count: role.Count,
+		})
+	}
+	return teams, nil
+}
@@ -40,6 +40,7 @@ func (q *Queries) GetArena(ctx context.Context, arg ArenaParams, opts *goquOptio
 		"data",
 		"created_at",
 		"updated_at",
+		"teams",
 	)
 	query, args, err := goquOptions.Apply(opts, arena.Where(filterGetArenaParams(arg)).Limit(1)).ToSQL()
 	if err != nil {
@@ -55,6 +56,7 @@ func (q *Queries) GetArena(ctx context.Context, arg ArenaParams, opts *goquOptio
 		&i.Data,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Teams,
 	)
 	return i, err
 }
@@ -65,6 +67,7 @@ type UpdateArenaParams struct {
 	MinPlayers          sql.NullInt32   `db:"min_players"`
 	MaxPlayersPerTicket sql.NullInt32   `db:"max_players_per_ticket"`
 	MaxPlayers          sql.NullInt32   `db:"max_players"`
+	Teams               json.RawMessage `db:"teams"`
 }
 
 func (q *Queries) UpdateArena(ctx context.Context, arg UpdateArenaParams) (sql.Result, error) {
@@ -82,6 +85,9 @@ func (q *Queries) UpdateArena(ctx context.Context, arg UpdateArenaParams) (sql.R
 	if arg.MaxPlayers.Valid {
 		updates["max_players"] = arg.MaxPlayers
 	}
+	if arg.Teams != nil {
+		updates["teams"] = []byte(arg.Teams)
+	}
 	arena = arena.Set(updates)
 	query, args, err := arena.Where(filterGetArenaParams(arg.Arena)).Limit(1).ToSQL()
 	if err != nil {
@@ -235,6 +241,8 @@ func (q *Queries) GetMatchmakingTicket(ctx context.Context, arg GetMatchmakingTi
 		"arena_data",
 		"arena_created_at",
 		"arena_updated_at",
+		"ticket_team",
+		"arena_teams",
 	)
 	query, args, err := matchmakingTicket.Where(filterGetMatchmakingTicketParams(arg)).ToSQL()
 	if err != nil {
@@ -272,6 +280,8 @@ func (q *Queries) GetMatchmakingTicket(ctx context.Context, arg GetMatchmakingTi
 			&i.ArenaData,
 			&i.ArenaCreatedAt,
 			&i.ArenaUpdatedAt,
+			&i.TicketTeam,
+			&i.ArenaTeams,
 		); err != nil {
 			return nil, err
 		}
@@ -354,6 +364,8 @@ func (q *Queries) GetMatchmakingTickets(ctx context.Context, arg GetMatchmakingT
 		"arena_data",
 		"arena_created_at",
 		"arena_updated_at",
+		"ticket_team",
+		"arena_teams",
 	)
 	query, args, err := matchmakingTicket.Where(filterGetMatchmakingTicketsParams(arg)).ToSQL()
 	if err != nil {
@@ -391,6 +403,8 @@ func (q *Queries) GetMatchmakingTickets(ctx context.Context, arg GetMatchmakingT
 			&i.ArenaData,
 			&i.ArenaCreatedAt,
 			&i.ArenaUpdatedAt,
+			&i.TicketTeam,
+			&i.ArenaTeams,
 		); err != nil {
 			return nil, err
 		}
@@ -529,6 +543,9 @@ func (q *Queries) GetMatch(ctx context.Context, arg GetMatchParams) ([]Matchmaki
 		"ticket_arena_data",
 		"ticket_arena_created_at",
 		"ticket_arena_updated_at",
+		"arena_teams",
+		"ticket_team",
+		"ticket_arena_teams",
 	)
 	query, args, err := matchmakingMatch.Where(filterGetMatchParams(arg)).ToSQL()
 	if err != nil {
@@ -585,6 +602,9 @@ func (q *Queries) GetMatch(ctx context.Context, arg GetMatchParams) ([]Matchmaki
 			&i.TicketArenaData,
 			&i.TicketArenaCreatedAt,
 			&i.TicketArenaUpdatedAt,
+			&i.ArenaTeams,
+			&i.TicketTeam,
+			&i.TicketArenaTeams,
 		); err != nil {
 			return nil, err
 		}
@@ -686,6 +706,9 @@ func (q *Queries) GetMatches(ctx context.Context, arg GetMatchesParams) ([]Match
 		"ticket_arena_data",
 		"ticket_arena_created_at",
 		"ticket_arena_updated_at",
+		"arena_teams",
+		"ticket_team",
+		"ticket_arena_teams",
 	)
 	query, args, err := matchmakingMatch.Where(filterGetMatchesParams(arg)).ToSQL()
 	if err != nil {
@@ -742,6 +765,9 @@ func (q *Queries) GetMatches(ctx context.Context, arg GetMatchesParams) ([]Match
 			&i.TicketArenaData,
 			&i.TicketArenaCreatedAt,
 			&i.TicketArenaUpdatedAt,
+			&i.ArenaTeams,
+			&i.TicketTeam,
+			&i.TicketArenaTeams,
 		); err != nil {
 			return nil, err
 		}
@@ -33,6 +33,7 @@ func Test_CreateArena_Arena_ArenaCreated(t *testing.T) {
 		MaxPlayersPerTicket: 7,
 		MaxPlayers:          20,
 		Data:                json.RawMessage(`{}`),
+		Teams:               json.RawMessage(`{}`),
 	})
 	if err != nil {
 		t.Fatalf("could not create arena: %v", err)
@@ -55,6 +56,7 @@ func Test_CreateArena_ArenaExists_ArenaNotCreated(t *testing.T) {
 		MaxPlayersPerTicket: 7,
 		MaxPlayers:          20,
 		Data:                json.RawMessage(`{}`),
+		Teams:               json.RawMessage(`{}`),
 	})
 	if err != nil {
 		t.Fatalf("could not create event: %v", err)
@@ -65,6 +67,7 @@ func Test_CreateArena_ArenaExists_ArenaNotCreated(t *testing.T) {
 		MaxPlayersPerTicket: 7,
 		MaxPlayers:          20,
 		Data:                json.RawMessage(`{}`),
+		Teams:               json.RawMessage(`{}`),
 	})
 	if err == nil {
 		t.Fatalf("expected error, got nil")
@@ -87,6 +90,7 @@ func Test_GetArenas_OneArena_Arenas(t *testing.T) {
 		MaxPlayersPerTicket: 7,
 		MaxPlayers:          20,
 		Data:                json.RawMessage(`{}`),
+		Teams:               json.RawMessage(`{}`),
 	})
 	if err != nil {
 		t.Fatalf("could not create arena: %v", err)
@@ -264,6 +268,7 @@ func Test_CreateMatchmakingTicketArena_MatchmakingTicketArena_MatchmakingTicketA
 		MaxPlayersPerTicket: 7,
 		MaxPlayers:          20,
 		Data:                json.RawMessage(`{}`),
+		Teams:               json.RawMessage(`{}`),
 	})
 	if err != nil {
 		t.Fatalf("could not create arena: %v", err)
@@ -305,6 +310,7 @@ func Test_GetArena_ByID_ArenaExists(t *testing.T) {
 		MaxPlayersPerTicket: 7,
 		MaxPlayers:          20,
 		Data:                json.RawMessage(`{}`),
+		Teams:               json.RawMessage(`{}`),
 	})
 	if err != nil {
 		t.Fatalf("could not create arena: %v", err)
@@ -368,6 +374,7 @@ func Test_GetArena_ByName_ArenaExists(t *testing.T) {
 		MaxPlayersPerTicket: 7,
 		MaxPlayers:          20,
 		Data:                json.RawMessage(`{}`),
+		Teams:               json.RawMessage(`{}`),
 	})
 	if err != nil {
 		t.Fatalf("could not create arena: %v", err)
@@ -411,6 +418,42 @@ func Test_GetArena_ByName_ArenaExists(t *testing.T) {
 	}
 }
 
+func Test_GetArena_ByIDWithTeams_TeamsReturned(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateArena(context.Background(), CreateArenaParams{
+		Name:                "arenaTeams1",
+		MinPlayers:          10,
+		MaxPlayersPerTicket: 5,
+		MaxPlayers:          10,
+		Data:                json.RawMessage(`{}`),
+		Teams:               json.RawMessage(`{"count": 2, "roleDataKey": "role", "roles": [{"name": "tank", "count": 1}, {"name": "dps", "count": 4}]}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create arena: %v", err)
+	}
+	arenaId, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("could not get last insert id: %v", err)
+	}
+	arena, err := q.GetArena(context.Background(), ArenaParams{
+		ID: conversion.Int64ToSqlNullInt64(&arenaId),
+	}, nil)
+	if err != nil {
+		t.Fatalf("could not get arena: %v", err)
+	}
+	var teams struct {
+		Count uint32 `json:"count"`
+	}
+	err = json.Unmarshal(arena.Teams, &teams)
+	if err != nil {
+		t.Fatalf("could not unmarshal teams: %v", err)
+	}
+	if teams.Count != 2 {
+		t.Fatalf("expected team count 2, got %d", teams.Count)
+	}
+}
+
 func Test_GetArena_ByName_ArenaDoesntExist(t *testing.T) {
 	tx := server.Connect(t)
 	q := New(tx)
@@ -450,6 +493,7 @@ func createTestTickets(q *Queries) ([]int64, []TestTicketData, error) {
 			MaxPlayersPerTicket: 7,
 			MaxPlayers:          20,
 			Data:                json.RawMessage(`{}`),
+			Teams:               json.RawMessage(`{}`),
 		})
 		if err != nil {
 			return nil, nil, err
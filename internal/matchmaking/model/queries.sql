@@ -4,15 +4,17 @@ INSERT INTO matchmaking_arena (
         min_players,
         max_players_per_ticket,
         max_players,
-        data
+        data,
+        teams
     )
-VALUES (?, ?, ?, ?, ?);
+VALUES (?, ?, ?, ?, ?, ?);
 -- name: GetArenas :many
 SELECT id,
     name,
     min_players,
     max_players_per_ticket,
     max_players,
+    teams,
     data,
     created_at,
     updated_at
@@ -43,4 +45,7 @@ WHERE id = ?
     AND matchmaking_ticket_id IS NULL;
 -- name: CreateMatchmakingTicketArena :execresult
 INSERT INTO matchmaking_ticket_arena (matchmaking_ticket_id, matchmaking_arena_id)
-VALUES (?, ?);
\ No newline at end of file
+VALUES (?, ?);
+-- name: CreateMatch :execresult
+INSERT INTO matchmaking_match (matchmaking_arena_id, data)
+VALUES (?, ?);
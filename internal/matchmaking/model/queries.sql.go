@@ -33,9 +33,10 @@ INSERT INTO matchmaking_arena (
         min_players,
         max_players_per_ticket,
         max_players,
-        data
+        data,
+        teams
     )
-VALUES (?, ?, ?, ?, ?)
+VALUES (?, ?, ?, ?, ?, ?)
 `
 
 type CreateArenaParams struct {
@@ -44,6 +45,7 @@ type CreateArenaParams struct {
 	MaxPlayersPerTicket uint32          `db:"max_players_per_ticket"`
 	MaxPlayers          uint32          `db:"max_players"`
 	Data                json.RawMessage `db:"data"`
+	Teams               json.RawMessage `db:"teams"`
 }
 
 func (q *Queries) CreateArena(ctx context.Context, arg CreateArenaParams) (sql.Result, error) {
@@ -53,9 +55,24 @@ func (q *Queries) CreateArena(ctx context.Context, arg CreateArenaParams) (sql.R
 		arg.MaxPlayersPerTicket,
 		arg.MaxPlayers,
 		arg.Data,
+		arg.Teams,
 	)
 }
 
+const CreateMatch = `-- name: CreateMatch :execresult
+INSERT INTO matchmaking_match (matchmaking_arena_id, data)
+VALUES (?, ?)
+`
+
+type CreateMatchParams struct {
+	MatchmakingArenaID uint64          `db:"matchmaking_arena_id"`
+	Data               json.RawMessage `db:"data"`
+}
+
+func (q *Queries) CreateMatch(ctx context.Context, arg CreateMatchParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateMatch, arg.MatchmakingArenaID, arg.Data)
+}
+
 const CreateMatchmakingTicket = `-- name: CreateMatchmakingTicket :execresult
 INSERT INTO matchmaking_ticket (data)
 VALUES (?)
@@ -100,6 +117,7 @@ SELECT id,
     min_players,
     max_players_per_ticket,
     max_players,
+    teams,
     data,
     created_at,
     updated_at
@@ -128,6 +146,7 @@ func (q *Queries) GetArenas(ctx context.Context, arg GetArenasParams) ([]Matchma
 			&i.MinPlayers,
 			&i.MaxPlayersPerTicket,
 			&i.MaxPlayers,
+			&i.Teams,
 			&i.Data,
 			&i.CreatedAt,
 			&i.UpdatedAt,
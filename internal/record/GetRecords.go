@@ -23,6 +23,19 @@ func NewGetRecordsCommand(service *Service, in *api.GetRecordsRequest) *GetRecor
 
 func (c *GetRecordsCommand) Execute(ctx context.Context) error {
 	limit, offset := conversion.PaginationToLimitOffset(c.In.Pagination, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
+	cursor, err := conversion.PaginationToCursor(c.In.Pagination)
+	if err != nil {
+		c.Out = &api.GetRecordsResponse{
+			Success: false,
+			Records: nil,
+			Error:   api.GetRecordsResponse_INVALID_CURSOR,
+		}
+		return nil
+	}
+	// Seeking from a cursor replaces the page offset
+	if cursor != nil {
+		offset = 0
+	}
 	if c.In.Name != nil {
 		if len(*c.In.Name) < int(c.service.minRecordNameLength) {
 			c.Out = &api.GetRecordsResponse{
@@ -44,12 +57,17 @@ func (c *GetRecordsCommand) Execute(ctx context.Context) error {
 	result, err := c.service.database.GetRecords(ctx, model.GetRecordsParams{
 		Name:   conversion.StringToSqlNullString(c.In.Name),
 		UserId: conversion.Uint64ToSqlNullInt64(c.In.UserId),
-		Limit:  limit,
+		// Fetch one extra row to know whether there is another page
+		Limit:  limit + 1,
 		Offset: offset,
+		Seek:   convertCursorToSeek(cursor),
 	})
 	if err != nil {
 		return err
 	}
+	result, nextCursor, previousCursor := conversion.CursorPage(result, limit, offset, cursor, func(record model.RankedRecord) conversion.Cursor {
+		return conversion.Cursor{Name: record.Name, Key: int64(record.Record), ID: record.ID}
+	})
 	records := make([]*api.Record, len(result))
 	for i, record := range result {
 		records[i], err = unmarshalRecord(&record)
@@ -58,9 +76,11 @@ func (c *GetRecordsCommand) Execute(ctx context.Context) error {
 		}
 	}
 	c.Out = &api.GetRecordsResponse{
-		Success: true,
-		Records: records,
-		Error:   api.GetRecordsResponse_NONE,
+		Success:        true,
+		Records:        records,
+		Error:          api.GetRecordsResponse_NONE,
+		NextCursor:     nextCursor,
+		PreviousCursor: previousCursor,
 	}
 	return nil
 }
@@ -2,6 +2,7 @@ package record
 
 import (
 	"context"
+	"encoding/json"
 	"reflect"
 	"testing"
 	"time"
@@ -78,7 +79,7 @@ func TestGetRecordsSuccess(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	mock.ExpectQuery("SELECT (.+) FROM `ranked_record`").WithArgs("test", 5, 5).WillReturnRows(sqlmock.NewRows(rankedRecord).AddRow(1, "test", 1, 1, 1, raw, time.Time{}, time.Time{}))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_record`").WithArgs("test", 6, 5).WillReturnRows(sqlmock.NewRows(rankedRecord).AddRow(1, "test", 1, 1, 1, raw, time.Time{}, time.Time{}))
 	c := NewGetRecordsCommand(service, &api.GetRecordsRequest{
 		Name: conversion.ValueToPointer("test"),
 		Pagination: &api.Pagination{
@@ -112,3 +113,72 @@ func TestGetRecordsSuccess(t *testing.T) {
 		t.Fatal("Expected data to be equal")
 	}
 }
+
+func TestGetRecordsInvalidCursor(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewGetRecordsCommand(service, &api.GetRecordsRequest{
+		Name: conversion.ValueToPointer("test"),
+		Pagination: &api.Pagination{
+			After:  conversion.ValueToPointer(conversion.EncodeCursor(conversion.Cursor{Name: "test", Key: 1, ID: 1})),
+			Before: conversion.ValueToPointer(conversion.EncodeCursor(conversion.Cursor{Name: "test", Key: 5, ID: 2})),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetRecordsResponse_INVALID_CURSOR {
+		t.Fatal("Expected error to be INVALID_CURSOR")
+	}
+}
+
+func TestGetRecordsBeforeCursor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	// Seeking backwards reverses the order, so the closest records to the cursor come first
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_record` WHERE \\(`id` IN \\(\\(SELECT \\* FROM \\(SELECT `id` FROM `record` (.+) ORDER BY `name` DESC, `record` DESC, `id` DESC LIMIT \\?\\) AS `t1`\\)\\)\\) ORDER BY `name` DESC, `record` DESC, `id` DESC").WithArgs("test", "test", "test", 30, "test", 30, 7, 3).WillReturnRows(sqlmock.NewRows(rankedRecord).
+		AddRow(6, "test", 3, 20, 2, json.RawMessage("{}"), time.Time{}, time.Time{}).
+		AddRow(5, "test", 2, 10, 1, json.RawMessage("{}"), time.Time{}, time.Time{}))
+	c := NewGetRecordsCommand(service, &api.GetRecordsRequest{
+		Name: conversion.ValueToPointer("test"),
+		Pagination: &api.Pagination{
+			Max:    conversion.ValueToPointer(uint32(2)),
+			Before: conversion.ValueToPointer(conversion.EncodeCursor(conversion.Cursor{Name: "test", Key: 30, ID: 7})),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.Records) != 2 {
+		t.Fatal("Expected records to have length 2")
+	}
+	if c.Out.Records[0].Id != 5 || c.Out.Records[1].Id != 6 {
+		t.Fatal("Expected records to be in ranking order")
+	}
+	if c.Out.PreviousCursor != nil {
+		t.Fatal("Expected previous cursor to be nil")
+	}
+	if c.Out.NextCursor == nil {
+		t.Fatal("Expected next cursor to be set")
+	}
+}
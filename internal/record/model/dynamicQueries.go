@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 
+	"github.com/MorhafAlshibly/coanda/pkg/goquOptions"
 	"github.com/doug-martin/goqu/v9"
 	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
 )
@@ -65,6 +66,14 @@ type GetRecordsParams struct {
 	UserId sql.NullInt64  `db:"user_id"`
 	Limit  uint64
 	Offset uint64
+	Seek   *goquOptions.Seek
+}
+
+// Record lists are ordered by ranking, which matches the name and record index
+var recordSeekColumns = []goquOptions.SeekColumn{
+	{Name: "name"},
+	{Name: "record"},
+	{Name: "id"},
 }
 
 func filterGetRecordsParams(arg GetRecordsParams) goqu.Ex {
@@ -89,7 +98,10 @@ func (q *Queries) GetRecords(ctx context.Context, arg GetRecordsParams) ([]Ranke
 		"created_at",
 		"updated_at",
 	)
-	query, args, err := records.Where(filterGetRecordsParams(arg)).Limit(uint(arg.Limit)).Offset(uint(arg.Offset)).ToSQL()
+	// The page is picked from the record table first, so the seek can use its index instead of the ranked view
+	page := gq.From("record").Select("id").Where(filterGetRecordsParams(arg))
+	page = goquOptions.ApplySeek(arg.Seek, page, recordSeekColumns...).Limit(uint(arg.Limit)).Offset(uint(arg.Offset))
+	query, args, err := records.Where(goqu.C("id").In(gq.From(page))).Order(goquOptions.SeekOrder(arg.Seek, recordSeekColumns...)...).ToSQL()
 	if err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/MorhafAlshibly/coanda/pkg/conversion"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/MorhafAlshibly/coanda/pkg/goquOptions"
 	"github.com/MorhafAlshibly/coanda/pkg/invoker"
 	"github.com/MorhafAlshibly/coanda/pkg/metric"
 )
@@ -154,6 +155,16 @@ func unmarshalRecord(record *model.RankedRecord) (*api.Record, error) {
 	}, nil
 }
 
+func convertCursorToSeek(cursor *conversion.Cursor) *goquOptions.Seek {
+	if cursor == nil {
+		return nil
+	}
+	return &goquOptions.Seek{
+		Values: []interface{}{cursor.Name, cursor.Key, cursor.ID},
+		Before: cursor.Before,
+	}
+}
+
 // Enum for errors
 type RecordRequestError string
 
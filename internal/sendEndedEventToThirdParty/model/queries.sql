@@ -1,19 +1,38 @@
 -- name: GetEndedEventRounds :many
-SELECt id,
-    event_id,
-    name,
-    scoring,
-    data,
-    ended_at,
-    created_at,
-    updated_at
-FROM event_round
-WHERE ended_at < NOW()
+SELECT er.id,
+    er.event_id,
+    er.name,
+    er.scoring,
+    er.data,
+    er.ended_at,
+    er.created_at,
+    er.updated_at,
+    d.top_limit,
+    d.fields,
+    d.uri
+FROM event_round er
+    LEFT JOIN event_third_party_delivery d ON d.event_id = er.event_id
+WHERE er.ended_at < NOW()
     AND (
-        sent_to_third_party_at IS NULL
-        OR sent_to_third_party_at > NOW()
+        er.sent_to_third_party_at IS NULL
+        OR er.sent_to_third_party_at > NOW()
     )
-ORDER BY id ASC
+    -- Rounds still running when the event was paused are extended once it resumes, and rounds still running when it was cancelled never finish, so only rounds that ended before either are sent
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event e
+        WHERE e.id = er.event_id
+            AND (
+                er.ended_at > e.paused_at
+                OR er.ended_at > e.cancelled_at
+            )
+    )
+    -- Rounds the delivery of the event excludes are not sent
+    AND (
+        d.excluded_rounds IS NULL
+        OR NOT JSON_CONTAINS(d.excluded_rounds, JSON_QUOTE(er.name))
+    )
+ORDER BY er.id ASC
 LIMIT ? OFFSET ?;
 -- name: GetEndedEventRoundLeaderboard :many
 SELECT erl.id,
@@ -37,15 +56,35 @@ WHERE erl.event_round_id = ?
         er.sent_to_third_party_at IS NULL
         OR er.sent_to_third_party_at > NOW()
     )
+    -- Rounds still running when the event was paused are extended once it resumes, and rounds still running when it was cancelled never finish, so only rounds that ended before either are sent
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event e
+        WHERE e.id = er.event_id
+            AND (
+                er.ended_at > e.paused_at
+                OR er.ended_at > e.cancelled_at
+            )
+    )
 ORDER BY erl.ranking ASC;
 -- name: UpdateEventRoundSentToThirdParty :execresult
-UPDATE event_round
-SET sent_to_third_party_at = NOW()
-WHERE id = ?
-    AND ended_at < NOW()
+UPDATE event_round er
+SET er.sent_to_third_party_at = NOW()
+WHERE er.id = ?
+    AND er.ended_at < NOW()
     AND (
-        sent_to_third_party_at IS NULL
-        OR sent_to_third_party_at > NOW()
+        er.sent_to_third_party_at IS NULL
+        OR er.sent_to_third_party_at > NOW()
+    )
+    -- Rounds still running when the event was paused are extended once it resumes, and rounds still running when it was cancelled never finish, so only rounds that ended before either are sent
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event e
+        WHERE e.id = er.event_id
+            AND (
+                er.ended_at > e.paused_at
+                OR er.ended_at > e.cancelled_at
+            )
     )
 LIMIT 1;
 -- name: GetEndedEvents :many
@@ -54,8 +93,12 @@ SELECT e.id,
     e.data,
     e.started_at,
     e.created_at,
-    e.updated_at
+    e.updated_at,
+    d.top_limit,
+    d.fields,
+    d.uri
 FROM event e
+    LEFT JOIN event_third_party_delivery d ON d.event_id = e.id
 WHERE (
         SELECT er.ended_at
         FROM event_round er
@@ -67,6 +110,14 @@ WHERE (
         e.sent_to_third_party_at IS NULL
         OR e.sent_to_third_party_at > NOW()
     )
+    -- Cancelled events are not sent, and neither are paused events with rounds that are extended once they resume
+    AND e.cancelled_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event_round per
+        WHERE per.event_id = e.id
+            AND per.ended_at > e.paused_at
+    )
 ORDER BY e.id ASC
 LIMIT ? OFFSET ?;
 -- name: GetEndedEventLeaderboard :many
@@ -81,6 +132,7 @@ SELECT el.id,
 FROM event_leaderboard el
     JOIN event e ON el.event_id = e.id
 WHERE e.id = ?
+    AND el.disqualified_at IS NULL
     AND el.ranking <= ?
     AND (
         SELECT er.ended_at
@@ -93,6 +145,14 @@ WHERE e.id = ?
         e.sent_to_third_party_at IS NULL
         OR e.sent_to_third_party_at > NOW()
     )
+    -- Cancelled events are not sent, and neither are paused events with rounds that are extended once they resume
+    AND e.cancelled_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event_round per
+        WHERE per.event_id = e.id
+            AND per.ended_at > e.paused_at
+    )
 ORDER BY el.ranking ASC;
 -- name: UpdateEventSentToThirdParty :execresult
 UPDATE event e
@@ -109,4 +169,12 @@ WHERE e.id = ?
         e.sent_to_third_party_at IS NULL
         OR e.sent_to_third_party_at > NOW()
     )
+    -- Cancelled events are not sent, and neither are paused events with rounds that are extended once they resume
+    AND e.cancelled_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event_round per
+        WHERE per.event_id = e.id
+            AND per.ended_at > e.paused_at
+    )
 LIMIT 1;
\ No newline at end of file
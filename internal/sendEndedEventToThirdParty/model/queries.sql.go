@@ -24,6 +24,7 @@ SELECT el.id,
 FROM event_leaderboard el
     JOIN event e ON el.event_id = e.id
 WHERE e.id = ?
+    AND el.disqualified_at IS NULL
     AND el.ranking <= ?
     AND (
         SELECT er.ended_at
@@ -36,6 +37,14 @@ WHERE e.id = ?
         e.sent_to_third_party_at IS NULL
         OR e.sent_to_third_party_at > NOW()
     )
+    -- Cancelled events are not sent, and neither are paused events with rounds that are extended once they resume
+    AND e.cancelled_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event_round per
+        WHERE per.event_id = e.id
+            AND per.ended_at > e.paused_at
+    )
 ORDER BY el.ranking ASC
 `
 
@@ -44,15 +53,26 @@ type GetEndedEventLeaderboardParams struct {
 	Ranking uint64 `db:"ranking"`
 }
 
-func (q *Queries) GetEndedEventLeaderboard(ctx context.Context, arg GetEndedEventLeaderboardParams) ([]EventLeaderboard, error) {
+type GetEndedEventLeaderboardRow struct {
+	ID           uint64          `db:"id"`
+	EventID      uint64          `db:"event_id"`
+	ClientUserID uint64          `db:"client_user_id"`
+	Score        uint64          `db:"score"`
+	Ranking      uint64          `db:"ranking"`
+	Data         json.RawMessage `db:"data"`
+	CreatedAt    time.Time       `db:"created_at"`
+	UpdatedAt    time.Time       `db:"updated_at"`
+}
+
+func (q *Queries) GetEndedEventLeaderboard(ctx context.Context, arg GetEndedEventLeaderboardParams) ([]GetEndedEventLeaderboardRow, error) {
 	rows, err := q.db.QueryContext(ctx, GetEndedEventLeaderboard, arg.ID, arg.Ranking)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []EventLeaderboard
+	var items []GetEndedEventLeaderboardRow
 	for rows.Next() {
-		var i EventLeaderboard
+		var i GetEndedEventLeaderboardRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.EventID,
@@ -98,6 +118,16 @@ WHERE erl.event_round_id = ?
         er.sent_to_third_party_at IS NULL
         OR er.sent_to_third_party_at > NOW()
     )
+    -- Rounds still running when the event was paused are extended once it resumes, and rounds still running when it was cancelled never finish, so only rounds that ended before either are sent
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event e
+        WHERE e.id = er.event_id
+            AND (
+                er.ended_at > e.paused_at
+                OR er.ended_at > e.cancelled_at
+            )
+    )
 ORDER BY erl.ranking ASC
 `
 
@@ -106,15 +136,30 @@ type GetEndedEventRoundLeaderboardParams struct {
 	Ranking      uint64 `db:"ranking"`
 }
 
-func (q *Queries) GetEndedEventRoundLeaderboard(ctx context.Context, arg GetEndedEventRoundLeaderboardParams) ([]EventRoundLeaderboard, error) {
+type GetEndedEventRoundLeaderboardRow struct {
+	ID           uint64          `db:"id"`
+	EventID      uint64          `db:"event_id"`
+	RoundName    string          `db:"round_name"`
+	EventUserID  uint64          `db:"event_user_id"`
+	ClientUserID uint64          `db:"client_user_id"`
+	EventRoundID uint64          `db:"event_round_id"`
+	Result       uint64          `db:"result"`
+	Score        uint64          `db:"score"`
+	Ranking      uint64          `db:"ranking"`
+	Data         json.RawMessage `db:"data"`
+	CreatedAt    time.Time       `db:"created_at"`
+	UpdatedAt    time.Time       `db:"updated_at"`
+}
+
+func (q *Queries) GetEndedEventRoundLeaderboard(ctx context.Context, arg GetEndedEventRoundLeaderboardParams) ([]GetEndedEventRoundLeaderboardRow, error) {
 	rows, err := q.db.QueryContext(ctx, GetEndedEventRoundLeaderboard, arg.EventRoundID, arg.Ranking)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []EventRoundLeaderboard
+	var items []GetEndedEventRoundLeaderboardRow
 	for rows.Next() {
-		var i EventRoundLeaderboard
+		var i GetEndedEventRoundLeaderboardRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.EventID,
@@ -143,21 +188,40 @@ func (q *Queries) GetEndedEventRoundLeaderboard(ctx context.Context, arg GetEnde
 }
 
 const GetEndedEventRounds = `-- name: GetEndedEventRounds :many
-SELECt id,
-    event_id,
-    name,
-    scoring,
-    data,
-    ended_at,
-    created_at,
-    updated_at
-FROM event_round
-WHERE ended_at < NOW()
+SELECT er.id,
+    er.event_id,
+    er.name,
+    er.scoring,
+    er.data,
+    er.ended_at,
+    er.created_at,
+    er.updated_at,
+    d.top_limit,
+    d.fields,
+    d.uri
+FROM event_round er
+    LEFT JOIN event_third_party_delivery d ON d.event_id = er.event_id
+WHERE er.ended_at < NOW()
     AND (
-        sent_to_third_party_at IS NULL
-        OR sent_to_third_party_at > NOW()
+        er.sent_to_third_party_at IS NULL
+        OR er.sent_to_third_party_at > NOW()
+    )
+    -- Rounds still running when the event was paused are extended once it resumes, and rounds still running when it was cancelled never finish, so only rounds that ended before either are sent
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event e
+        WHERE e.id = er.event_id
+            AND (
+                er.ended_at > e.paused_at
+                OR er.ended_at > e.cancelled_at
+            )
     )
-ORDER BY id ASC
+    -- Rounds the delivery of the event excludes are not sent
+    AND (
+        d.excluded_rounds IS NULL
+        OR NOT JSON_CONTAINS(d.excluded_rounds, JSON_QUOTE(er.name))
+    )
+ORDER BY er.id ASC
 LIMIT ? OFFSET ?
 `
 
@@ -167,14 +231,17 @@ type GetEndedEventRoundsParams struct {
 }
 
 type GetEndedEventRoundsRow struct {
-	ID        uint64          `db:"id"`
-	EventID   uint64          `db:"event_id"`
-	Name      string          `db:"name"`
-	Scoring   json.RawMessage `db:"scoring"`
-	Data      json.RawMessage `db:"data"`
-	EndedAt   time.Time       `db:"ended_at"`
-	CreatedAt time.Time       `db:"created_at"`
-	UpdatedAt time.Time       `db:"updated_at"`
+	ID        uint64           `db:"id"`
+	EventID   uint64           `db:"event_id"`
+	Name      string           `db:"name"`
+	Scoring   json.RawMessage  `db:"scoring"`
+	Data      json.RawMessage  `db:"data"`
+	EndedAt   time.Time        `db:"ended_at"`
+	CreatedAt time.Time        `db:"created_at"`
+	UpdatedAt time.Time        `db:"updated_at"`
+	TopLimit  sql.NullInt32    `db:"top_limit"`
+	Fields    *json.RawMessage `db:"fields"`
+	Uri       sql.NullString   `db:"uri"`
 }
 
 func (q *Queries) GetEndedEventRounds(ctx context.Context, arg GetEndedEventRoundsParams) ([]GetEndedEventRoundsRow, error) {
@@ -195,6 +262,9 @@ func (q *Queries) GetEndedEventRounds(ctx context.Context, arg GetEndedEventRoun
 			&i.EndedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TopLimit,
+			&i.Fields,
+			&i.Uri,
 		); err != nil {
 			return nil, err
 		}
@@ -215,8 +285,12 @@ SELECT e.id,
     e.data,
     e.started_at,
     e.created_at,
-    e.updated_at
+    e.updated_at,
+    d.top_limit,
+    d.fields,
+    d.uri
 FROM event e
+    LEFT JOIN event_third_party_delivery d ON d.event_id = e.id
 WHERE (
         SELECT er.ended_at
         FROM event_round er
@@ -228,6 +302,14 @@ WHERE (
         e.sent_to_third_party_at IS NULL
         OR e.sent_to_third_party_at > NOW()
     )
+    -- Cancelled events are not sent, and neither are paused events with rounds that are extended once they resume
+    AND e.cancelled_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event_round per
+        WHERE per.event_id = e.id
+            AND per.ended_at > e.paused_at
+    )
 ORDER BY e.id ASC
 LIMIT ? OFFSET ?
 `
@@ -238,12 +320,15 @@ type GetEndedEventsParams struct {
 }
 
 type GetEndedEventsRow struct {
-	ID        uint64          `db:"id"`
-	Name      string          `db:"name"`
-	Data      json.RawMessage `db:"data"`
-	StartedAt time.Time       `db:"started_at"`
-	CreatedAt time.Time       `db:"created_at"`
-	UpdatedAt time.Time       `db:"updated_at"`
+	ID        uint64           `db:"id"`
+	Name      string           `db:"name"`
+	Data      json.RawMessage  `db:"data"`
+	StartedAt time.Time        `db:"started_at"`
+	CreatedAt time.Time        `db:"created_at"`
+	UpdatedAt time.Time        `db:"updated_at"`
+	TopLimit  sql.NullInt32    `db:"top_limit"`
+	Fields    *json.RawMessage `db:"fields"`
+	Uri       sql.NullString   `db:"uri"`
 }
 
 func (q *Queries) GetEndedEvents(ctx context.Context, arg GetEndedEventsParams) ([]GetEndedEventsRow, error) {
@@ -262,6 +347,9 @@ func (q *Queries) GetEndedEvents(ctx context.Context, arg GetEndedEventsParams)
 			&i.StartedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TopLimit,
+			&i.Fields,
+			&i.Uri,
 		); err != nil {
 			return nil, err
 		}
@@ -277,13 +365,23 @@ func (q *Queries) GetEndedEvents(ctx context.Context, arg GetEndedEventsParams)
 }
 
 const UpdateEventRoundSentToThirdParty = `-- name: UpdateEventRoundSentToThirdParty :execresult
-UPDATE event_round
-SET sent_to_third_party_at = NOW()
-WHERE id = ?
-    AND ended_at < NOW()
+UPDATE event_round er
+SET er.sent_to_third_party_at = NOW()
+WHERE er.id = ?
+    AND er.ended_at < NOW()
     AND (
-        sent_to_third_party_at IS NULL
-        OR sent_to_third_party_at > NOW()
+        er.sent_to_third_party_at IS NULL
+        OR er.sent_to_third_party_at > NOW()
+    )
+    -- Rounds still running when the event was paused are extended once it resumes, and rounds still running when it was cancelled never finish, so only rounds that ended before either are sent
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event e
+        WHERE e.id = er.event_id
+            AND (
+                er.ended_at > e.paused_at
+                OR er.ended_at > e.cancelled_at
+            )
     )
 LIMIT 1
 `
@@ -307,6 +405,14 @@ WHERE e.id = ?
         e.sent_to_third_party_at IS NULL
         OR e.sent_to_third_party_at > NOW()
     )
+    -- Cancelled events are not sent, and neither are paused events with rounds that are extended once they resume
+    AND e.cancelled_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM event_round per
+        WHERE per.event_id = e.id
+            AND per.ended_at > e.paused_at
+    )
 LIMIT 1
 `
 
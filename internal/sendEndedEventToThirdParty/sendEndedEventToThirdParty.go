@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/MorhafAlshibly/coanda/internal/sendEndedEventToThirdParty/model"
@@ -122,9 +123,13 @@ func (a *App) sendEndedEventRoundToThirdParty(ctx context.Context, eventRound mo
 	}
 	defer tx.Rollback()
 	qtx := a.database.WithTx(tx)
+	fields, err := unmarshalDeliveryFields(eventRound.Fields)
+	if err != nil {
+		return err
+	}
 	leaderboard, err := qtx.GetEndedEventRoundLeaderboard(ctx, model.GetEndedEventRoundLeaderboardParams{
 		EventRoundID: eventRound.ID,
-		Ranking:      uint64(a.topLimit),
+		Ranking:      a.deliveryTopLimit(eventRound.TopLimit),
 	})
 	if err != nil {
 		return err
@@ -145,7 +150,7 @@ func (a *App) sendEndedEventRoundToThirdParty(ctx context.Context, eventRound mo
 	// Create json and send to third party
 	leaderboardData := []map[string]interface{}{}
 	for _, lb := range leaderboard {
-		leaderboardData = append(leaderboardData, map[string]interface{}{
+		leaderboardData = append(leaderboardData, filterDeliveryFields(map[string]interface{}{
 			"id":             lb.ID,
 			"event_user_id":  lb.EventUserID,
 			"client_user_id": lb.ClientUserID,
@@ -155,7 +160,7 @@ func (a *App) sendEndedEventRoundToThirdParty(ctx context.Context, eventRound mo
 			"data":           string(lb.Data),
 			"created_at":     lb.CreatedAt.Format(time.RFC3339),
 			"updated_at":     lb.UpdatedAt.Format(time.RFC3339),
-		})
+		}, fields))
 	}
 	roundData := map[string]interface{}{
 		"id":         eventRound.ID,
@@ -172,13 +177,10 @@ func (a *App) sendEndedEventRoundToThirdParty(ctx context.Context, eventRound mo
 		return err
 	}
 	// Send to third party with header
-	thirdPartyUriWithID := fmt.Sprintf("%s?id=event-%d-round-%d", a.thirdPartyUri, eventRound.EventID, eventRound.ID)
-	req, err := http.NewRequest("POST", thirdPartyUriWithID, bytes.NewBuffer([]byte(fmt.Sprintf("{value: \"%s\"}", string(marshalledRoundData)))))
+	req, err := a.newDeliveryRequest(eventRound.Uri, fmt.Sprintf("event-%d-round-%d", eventRound.EventID, eventRound.ID), marshalledRoundData)
 	if err != nil {
 		return err
 	}
-	req.Header.Set(a.apiKeyHeader, a.apiKey)
-	req.Header.Set("Content-Type", "application/json")
 	// Send request
 	client := &http.Client{}
 	resp, err := client.Do(req)
@@ -230,9 +232,13 @@ func (a *App) sendEndedEventToThirdParty(ctx context.Context, event model.GetEnd
 	}
 	defer tx.Rollback()
 	qtx := a.database.WithTx(tx)
+	fields, err := unmarshalDeliveryFields(event.Fields)
+	if err != nil {
+		return err
+	}
 	leaderboard, err := qtx.GetEndedEventLeaderboard(ctx, model.GetEndedEventLeaderboardParams{
 		ID:      event.ID,
-		Ranking: uint64(a.topLimit),
+		Ranking: a.deliveryTopLimit(event.TopLimit),
 	})
 	if err != nil {
 		return err
@@ -253,7 +259,7 @@ func (a *App) sendEndedEventToThirdParty(ctx context.Context, event model.GetEnd
 	// Create json and send to third party
 	leaderboardData := []map[string]interface{}{}
 	for _, lb := range leaderboard {
-		leaderboardData = append(leaderboardData, map[string]interface{}{
+		leaderboardData = append(leaderboardData, filterDeliveryFields(map[string]interface{}{
 			"id":             lb.ID,
 			"event_id":       lb.EventID,
 			"client_user_id": lb.ClientUserID,
@@ -262,7 +268,7 @@ func (a *App) sendEndedEventToThirdParty(ctx context.Context, event model.GetEnd
 			"data":           string(lb.Data),
 			"created_at":     lb.CreatedAt.Format(time.RFC3339),
 			"updated_at":     lb.UpdatedAt.Format(time.RFC3339),
-		})
+		}, fields))
 	}
 	eventData := map[string]interface{}{
 		"id":         event.ID,
@@ -278,13 +284,10 @@ func (a *App) sendEndedEventToThirdParty(ctx context.Context, event model.GetEnd
 		return err
 	}
 	// Send to third party with header
-	thirdPartyUriWithID := fmt.Sprintf("%s?id=event-%d", a.thirdPartyUri, event.ID)
-	req, err := http.NewRequest("POST", thirdPartyUriWithID, bytes.NewBuffer([]byte(fmt.Sprintf("{value: \"%s\"}", string(marshalledEventData)))))
+	req, err := a.newDeliveryRequest(event.Uri, fmt.Sprintf("event-%d", event.ID), marshalledEventData)
 	if err != nil {
 		return err
 	}
-	req.Header.Set(a.apiKeyHeader, a.apiKey)
-	req.Header.Set("Content-Type", "application/json")
 	// Send request
 	client := &http.Client{}
 	resp, err := client.Do(req)
@@ -303,3 +306,63 @@ func (a *App) sendEndedEventToThirdParty(ctx context.Context, event model.GetEnd
 	}
 	return nil
 }
+
+// deliveryTopLimit returns the number of ranks sent, which the delivery of an event can override
+func (a *App) deliveryTopLimit(topLimit sql.NullInt32) uint64 {
+	if topLimit.Valid {
+		return uint64(topLimit.Int32)
+	}
+	return uint64(a.topLimit)
+}
+
+// newDeliveryRequest returns the request sending the data with the given id to the third party, which the delivery of an event can override.
+// The api key is only for the third party of the sender, so it is never sent to the uri of a delivery.
+func (a *App) newDeliveryRequest(uri sql.NullString, id string, data []byte) (*http.Request, error) {
+	deliveryUri := a.thirdPartyUri
+	if uri.Valid {
+		deliveryUri = uri.String
+	}
+	thirdPartyUriWithID, err := url.Parse(deliveryUri)
+	if err != nil {
+		return nil, err
+	}
+	// Keep any query the uri already has
+	query := thirdPartyUriWithID.Query()
+	query.Set("id", id)
+	thirdPartyUriWithID.RawQuery = query.Encode()
+	req, err := http.NewRequest("POST", thirdPartyUriWithID.String(), bytes.NewBuffer([]byte(fmt.Sprintf("{value: \"%s\"}", string(data)))))
+	if err != nil {
+		return nil, err
+	}
+	if !uri.Valid {
+		req.Header.Set(a.apiKeyHeader, a.apiKey)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+func unmarshalDeliveryFields(fields *json.RawMessage) ([]string, error) {
+	if fields == nil {
+		return nil, nil
+	}
+	var deliveryFields []string
+	err := json.Unmarshal(*fields, &deliveryFields)
+	if err != nil {
+		return nil, err
+	}
+	return deliveryFields, nil
+}
+
+// filterDeliveryFields keeps only the fields of a user that the delivery of an event sends, or every field if it does not choose any
+func filterDeliveryFields(user map[string]interface{}, fields []string) map[string]interface{} {
+	if fields == nil {
+		return user
+	}
+	filteredUser := map[string]interface{}{}
+	for _, field := range fields {
+		if value, ok := user[field]; ok {
+			filteredUser[field] = value
+		}
+	}
+	return filteredUser
+}
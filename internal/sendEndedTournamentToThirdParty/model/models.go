@@ -12,6 +12,446 @@ import (
 	"time"
 )
 
+type ArchivedTournamentTeamTournamentInterval string
+
+const (
+	ArchivedTournamentTeamTournamentIntervalDaily     ArchivedTournamentTeamTournamentInterval = "daily"
+	ArchivedTournamentTeamTournamentIntervalWeekly    ArchivedTournamentTeamTournamentInterval = "weekly"
+	ArchivedTournamentTeamTournamentIntervalMonthly   ArchivedTournamentTeamTournamentInterval = "monthly"
+	ArchivedTournamentTeamTournamentIntervalUnlimited ArchivedTournamentTeamTournamentInterval = "unlimited"
+	ArchivedTournamentTeamTournamentIntervalCustom    ArchivedTournamentTeamTournamentInterval = "custom"
+)
+
+func (e *ArchivedTournamentTeamTournamentInterval) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ArchivedTournamentTeamTournamentInterval(s)
+	case string:
+		*e = ArchivedTournamentTeamTournamentInterval(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ArchivedTournamentTeamTournamentInterval: %T", src)
+	}
+	return nil
+}
+
+type NullArchivedTournamentTeamTournamentInterval struct {
+	ArchivedTournamentTeamTournamentInterval ArchivedTournamentTeamTournamentInterval
+	Valid                                    bool // Valid is true if ArchivedTournamentTeamTournamentInterval is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullArchivedTournamentTeamTournamentInterval) Scan(value interface{}) error {
+	if value == nil {
+		ns.ArchivedTournamentTeamTournamentInterval, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ArchivedTournamentTeamTournamentInterval.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullArchivedTournamentTeamTournamentInterval) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ArchivedTournamentTeamTournamentInterval), nil
+}
+
+type ArchivedTournamentTournamentInterval string
+
+const (
+	ArchivedTournamentTournamentIntervalDaily     ArchivedTournamentTournamentInterval = "daily"
+	ArchivedTournamentTournamentIntervalWeekly    ArchivedTournamentTournamentInterval = "weekly"
+	ArchivedTournamentTournamentIntervalMonthly   ArchivedTournamentTournamentInterval = "monthly"
+	ArchivedTournamentTournamentIntervalUnlimited ArchivedTournamentTournamentInterval = "unlimited"
+	ArchivedTournamentTournamentIntervalCustom    ArchivedTournamentTournamentInterval = "custom"
+)
+
+func (e *ArchivedTournamentTournamentInterval) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ArchivedTournamentTournamentInterval(s)
+	case string:
+		*e = ArchivedTournamentTournamentInterval(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ArchivedTournamentTournamentInterval: %T", src)
+	}
+	return nil
+}
+
+type NullArchivedTournamentTournamentInterval struct {
+	ArchivedTournamentTournamentInterval ArchivedTournamentTournamentInterval
+	Valid                                bool // Valid is true if ArchivedTournamentTournamentInterval is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullArchivedTournamentTournamentInterval) Scan(value interface{}) error {
+	if value == nil {
+		ns.ArchivedTournamentTournamentInterval, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ArchivedTournamentTournamentInterval.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullArchivedTournamentTournamentInterval) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ArchivedTournamentTournamentInterval), nil
+}
+
+type TournamentBracketFormat string
+
+const (
+	TournamentBracketFormatSingleElimination TournamentBracketFormat = "single_elimination"
+	TournamentBracketFormatDoubleElimination TournamentBracketFormat = "double_elimination"
+)
+
+func (e *TournamentBracketFormat) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TournamentBracketFormat(s)
+	case string:
+		*e = TournamentBracketFormat(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TournamentBracketFormat: %T", src)
+	}
+	return nil
+}
+
+type NullTournamentBracketFormat struct {
+	TournamentBracketFormat TournamentBracketFormat
+	Valid                   bool // Valid is true if TournamentBracketFormat is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTournamentBracketFormat) Scan(value interface{}) error {
+	if value == nil {
+		ns.TournamentBracketFormat, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TournamentBracketFormat.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTournamentBracketFormat) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TournamentBracketFormat), nil
+}
+
+type TournamentBracketMatchSide string
+
+const (
+	TournamentBracketMatchSideWinners    TournamentBracketMatchSide = "winners"
+	TournamentBracketMatchSideLosers     TournamentBracketMatchSide = "losers"
+	TournamentBracketMatchSideGrandFinal TournamentBracketMatchSide = "grand_final"
+)
+
+func (e *TournamentBracketMatchSide) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TournamentBracketMatchSide(s)
+	case string:
+		*e = TournamentBracketMatchSide(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TournamentBracketMatchSide: %T", src)
+	}
+	return nil
+}
+
+type NullTournamentBracketMatchSide struct {
+	TournamentBracketMatchSide TournamentBracketMatchSide
+	Valid                      bool // Valid is true if TournamentBracketMatchSide is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTournamentBracketMatchSide) Scan(value interface{}) error {
+	if value == nil {
+		ns.TournamentBracketMatchSide, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TournamentBracketMatchSide.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTournamentBracketMatchSide) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TournamentBracketMatchSide), nil
+}
+
+type TournamentPairingFormat string
+
+const (
+	TournamentPairingFormatSwiss      TournamentPairingFormat = "swiss"
+	TournamentPairingFormatRoundRobin TournamentPairingFormat = "round_robin"
+)
+
+func (e *TournamentPairingFormat) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TournamentPairingFormat(s)
+	case string:
+		*e = TournamentPairingFormat(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TournamentPairingFormat: %T", src)
+	}
+	return nil
+}
+
+type NullTournamentPairingFormat struct {
+	TournamentPairingFormat TournamentPairingFormat
+	Valid                   bool // Valid is true if TournamentPairingFormat is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTournamentPairingFormat) Scan(value interface{}) error {
+	if value == nil {
+		ns.TournamentPairingFormat, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TournamentPairingFormat.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTournamentPairingFormat) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TournamentPairingFormat), nil
+}
+
+type TournamentPairingResult string
+
+const (
+	TournamentPairingResultFirstWon  TournamentPairingResult = "first_won"
+	TournamentPairingResultSecondWon TournamentPairingResult = "second_won"
+	TournamentPairingResultDraw      TournamentPairingResult = "draw"
+)
+
+func (e *TournamentPairingResult) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TournamentPairingResult(s)
+	case string:
+		*e = TournamentPairingResult(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TournamentPairingResult: %T", src)
+	}
+	return nil
+}
+
+type NullTournamentPairingResult struct {
+	TournamentPairingResult TournamentPairingResult
+	Valid                   bool // Valid is true if TournamentPairingResult is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTournamentPairingResult) Scan(value interface{}) error {
+	if value == nil {
+		ns.TournamentPairingResult, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TournamentPairingResult.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTournamentPairingResult) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TournamentPairingResult), nil
+}
+
+type TournamentPairingTournamentInterval string
+
+const (
+	TournamentPairingTournamentIntervalDaily     TournamentPairingTournamentInterval = "daily"
+	TournamentPairingTournamentIntervalWeekly    TournamentPairingTournamentInterval = "weekly"
+	TournamentPairingTournamentIntervalMonthly   TournamentPairingTournamentInterval = "monthly"
+	TournamentPairingTournamentIntervalUnlimited TournamentPairingTournamentInterval = "unlimited"
+	TournamentPairingTournamentIntervalCustom    TournamentPairingTournamentInterval = "custom"
+)
+
+func (e *TournamentPairingTournamentInterval) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TournamentPairingTournamentInterval(s)
+	case string:
+		*e = TournamentPairingTournamentInterval(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TournamentPairingTournamentInterval: %T", src)
+	}
+	return nil
+}
+
+type NullTournamentPairingTournamentInterval struct {
+	TournamentPairingTournamentInterval TournamentPairingTournamentInterval
+	Valid                               bool // Valid is true if TournamentPairingTournamentInterval is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTournamentPairingTournamentInterval) Scan(value interface{}) error {
+	if value == nil {
+		ns.TournamentPairingTournamentInterval, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TournamentPairingTournamentInterval.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTournamentPairingTournamentInterval) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TournamentPairingTournamentInterval), nil
+}
+
+type TournamentRewardTierTournamentInterval string
+
+const (
+	TournamentRewardTierTournamentIntervalDaily     TournamentRewardTierTournamentInterval = "daily"
+	TournamentRewardTierTournamentIntervalWeekly    TournamentRewardTierTournamentInterval = "weekly"
+	TournamentRewardTierTournamentIntervalMonthly   TournamentRewardTierTournamentInterval = "monthly"
+	TournamentRewardTierTournamentIntervalUnlimited TournamentRewardTierTournamentInterval = "unlimited"
+	TournamentRewardTierTournamentIntervalCustom    TournamentRewardTierTournamentInterval = "custom"
+)
+
+func (e *TournamentRewardTierTournamentInterval) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TournamentRewardTierTournamentInterval(s)
+	case string:
+		*e = TournamentRewardTierTournamentInterval(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TournamentRewardTierTournamentInterval: %T", src)
+	}
+	return nil
+}
+
+type NullTournamentRewardTierTournamentInterval struct {
+	TournamentRewardTierTournamentInterval TournamentRewardTierTournamentInterval
+	Valid                                  bool // Valid is true if TournamentRewardTierTournamentInterval is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTournamentRewardTierTournamentInterval) Scan(value interface{}) error {
+	if value == nil {
+		ns.TournamentRewardTierTournamentInterval, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TournamentRewardTierTournamentInterval.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTournamentRewardTierTournamentInterval) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TournamentRewardTierTournamentInterval), nil
+}
+
+type TournamentRewardTournamentInterval string
+
+const (
+	TournamentRewardTournamentIntervalDaily     TournamentRewardTournamentInterval = "daily"
+	TournamentRewardTournamentIntervalWeekly    TournamentRewardTournamentInterval = "weekly"
+	TournamentRewardTournamentIntervalMonthly   TournamentRewardTournamentInterval = "monthly"
+	TournamentRewardTournamentIntervalUnlimited TournamentRewardTournamentInterval = "unlimited"
+	TournamentRewardTournamentIntervalCustom    TournamentRewardTournamentInterval = "custom"
+)
+
+func (e *TournamentRewardTournamentInterval) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TournamentRewardTournamentInterval(s)
+	case string:
+		*e = TournamentRewardTournamentInterval(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TournamentRewardTournamentInterval: %T", src)
+	}
+	return nil
+}
+
+type NullTournamentRewardTournamentInterval struct {
+	TournamentRewardTournamentInterval TournamentRewardTournamentInterval
+	Valid                              bool // Valid is true if TournamentRewardTournamentInterval is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTournamentRewardTournamentInterval) Scan(value interface{}) error {
+	if value == nil {
+		ns.TournamentRewardTournamentInterval, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TournamentRewardTournamentInterval.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTournamentRewardTournamentInterval) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TournamentRewardTournamentInterval), nil
+}
+
+type TournamentTeamTournamentInterval string
+
+const (
+	TournamentTeamTournamentIntervalDaily     TournamentTeamTournamentInterval = "daily"
+	TournamentTeamTournamentIntervalWeekly    TournamentTeamTournamentInterval = "weekly"
+	TournamentTeamTournamentIntervalMonthly   TournamentTeamTournamentInterval = "monthly"
+	TournamentTeamTournamentIntervalUnlimited TournamentTeamTournamentInterval = "unlimited"
+	TournamentTeamTournamentIntervalCustom    TournamentTeamTournamentInterval = "custom"
+)
+
+func (e *TournamentTeamTournamentInterval) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TournamentTeamTournamentInterval(s)
+	case string:
+		*e = TournamentTeamTournamentInterval(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TournamentTeamTournamentInterval: %T", src)
+	}
+	return nil
+}
+
+type NullTournamentTeamTournamentInterval struct {
+	TournamentTeamTournamentInterval TournamentTeamTournamentInterval
+	Valid                            bool // Valid is true if TournamentTeamTournamentInterval is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTournamentTeamTournamentInterval) Scan(value interface{}) error {
+	if value == nil {
+		ns.TournamentTeamTournamentInterval, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TournamentTeamTournamentInterval.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTournamentTeamTournamentInterval) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TournamentTeamTournamentInterval), nil
+}
+
 type TournamentTournamentInterval string
 
 const (
@@ -19,6 +459,7 @@ const (
 	TournamentTournamentIntervalWeekly    TournamentTournamentInterval = "weekly"
 	TournamentTournamentIntervalMonthly   TournamentTournamentInterval = "monthly"
 	TournamentTournamentIntervalUnlimited TournamentTournamentInterval = "unlimited"
+	TournamentTournamentIntervalCustom    TournamentTournamentInterval = "custom"
 )
 
 func (e *TournamentTournamentInterval) Scan(src interface{}) error {
@@ -56,11 +497,43 @@ func (ns NullTournamentTournamentInterval) Value() (driver.Value, error) {
 	return string(ns.TournamentTournamentInterval), nil
 }
 
+type ArchivedTournament struct {
+	ID                  uint64                               `db:"id"`
+	Name                string                               `db:"name"`
+	TournamentInterval  ArchivedTournamentTournamentInterval `db:"tournament_interval"`
+	UserID              uint64                               `db:"user_id"`
+	TeamID              sql.NullInt64                        `db:"team_id"`
+	Score               int64                                `db:"score"`
+	Ranking             uint64                               `db:"ranking"`
+	Data                json.RawMessage                      `db:"data"`
+	TournamentStartedAt time.Time                            `db:"tournament_started_at"`
+	SentToThirdPartyAt  sql.NullTime                         `db:"sent_to_third_party_at"`
+	CreatedAt           time.Time                            `db:"created_at"`
+	UpdatedAt           time.Time                            `db:"updated_at"`
+	ArchivedAt          time.Time                            `db:"archived_at"`
+}
+
+type ArchivedTournamentTeam struct {
+	ID                  uint64                                   `db:"id"`
+	Name                string                                   `db:"name"`
+	TournamentInterval  ArchivedTournamentTeamTournamentInterval `db:"tournament_interval"`
+	TeamID              uint64                                   `db:"team_id"`
+	Score               int64                                    `db:"score"`
+	Ranking             uint64                                   `db:"ranking"`
+	Data                json.RawMessage                          `db:"data"`
+	TournamentStartedAt time.Time                                `db:"tournament_started_at"`
+	SentToThirdPartyAt  sql.NullTime                             `db:"sent_to_third_party_at"`
+	CreatedAt           time.Time                                `db:"created_at"`
+	UpdatedAt           time.Time                                `db:"updated_at"`
+	ArchivedAt          time.Time                                `db:"archived_at"`
+}
+
 type RankedTournament struct {
 	ID                  uint64                       `db:"id"`
 	Name                string                       `db:"name"`
 	TournamentInterval  TournamentTournamentInterval `db:"tournament_interval"`
 	UserID              uint64                       `db:"user_id"`
+	TeamID              sql.NullInt64                `db:"team_id"`
 	Score               int64                        `db:"score"`
 	Ranking             uint64                       `db:"ranking"`
 	Data                json.RawMessage              `db:"data"`
@@ -70,11 +543,26 @@ type RankedTournament struct {
 	UpdatedAt           time.Time                    `db:"updated_at"`
 }
 
+type RankedTournamentTeam struct {
+	ID                  uint64                           `db:"id"`
+	Name                string                           `db:"name"`
+	TournamentInterval  TournamentTeamTournamentInterval `db:"tournament_interval"`
+	TeamID              uint64                           `db:"team_id"`
+	Score               int64                            `db:"score"`
+	Ranking             uint64                           `db:"ranking"`
+	Data                json.RawMessage                  `db:"data"`
+	TournamentStartedAt time.Time                        `db:"tournament_started_at"`
+	SentToThirdPartyAt  sql.NullTime                     `db:"sent_to_third_party_at"`
+	CreatedAt           time.Time                        `db:"created_at"`
+	UpdatedAt           time.Time                        `db:"updated_at"`
+}
+
 type Tournament struct {
 	ID                  uint64                       `db:"id"`
 	Name                string                       `db:"name"`
 	TournamentInterval  TournamentTournamentInterval `db:"tournament_interval"`
 	UserID              uint64                       `db:"user_id"`
+	TeamID              sql.NullInt64                `db:"team_id"`
 	Score               int64                        `db:"score"`
 	Data                json.RawMessage              `db:"data"`
 	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
@@ -82,3 +570,122 @@ type Tournament struct {
 	CreatedAt           time.Time                    `db:"created_at"`
 	UpdatedAt           time.Time                    `db:"updated_at"`
 }
+
+type TournamentBracket struct {
+	ID                 uint64                  `db:"id"`
+	Name               string                  `db:"name"`
+	Format             TournamentBracketFormat `db:"format"`
+	MatchmakingArenaID sql.NullInt64           `db:"matchmaking_arena_id"`
+	WinnerUserID       sql.NullInt64           `db:"winner_user_id"`
+	Data               json.RawMessage         `db:"data"`
+	EndedAt            sql.NullTime            `db:"ended_at"`
+	CreatedAt          time.Time               `db:"created_at"`
+	UpdatedAt          time.Time               `db:"updated_at"`
+}
+
+type TournamentBracketMatch struct {
+	ID                  uint64                     `db:"id"`
+	TournamentBracketID uint64                     `db:"tournament_bracket_id"`
+	Side                TournamentBracketMatchSide `db:"side"`
+	Round               uint32                     `db:"round"`
+	Position            uint32                     `db:"position"`
+	FirstUserID         sql.NullInt64              `db:"first_user_id"`
+	SecondUserID        sql.NullInt64              `db:"second_user_id"`
+	WinnerUserID        sql.NullInt64              `db:"winner_user_id"`
+	WinnerMatchID       sql.NullInt64              `db:"winner_match_id"`
+	WinnerMatchSlot     sql.NullInt32              `db:"winner_match_slot"`
+	LoserMatchID        sql.NullInt64              `db:"loser_match_id"`
+	LoserMatchSlot      sql.NullInt32              `db:"loser_match_slot"`
+	MatchmakingMatchID  sql.NullInt64              `db:"matchmaking_match_id"`
+	CompletedAt         sql.NullTime               `db:"completed_at"`
+	CreatedAt           time.Time                  `db:"created_at"`
+	UpdatedAt           time.Time                  `db:"updated_at"`
+}
+
+type TournamentBracketParticipant struct {
+	TournamentBracketID uint64        `db:"tournament_bracket_id"`
+	UserID              uint64        `db:"user_id"`
+	Seed                uint32        `db:"seed"`
+	SeedValue           sql.NullInt64 `db:"seed_value"`
+}
+
+type TournamentDefinition struct {
+	ID              uint64          `db:"id"`
+	Name            string          `db:"name"`
+	CronSchedule    sql.NullString  `db:"cron_schedule"`
+	DurationSeconds sql.NullInt64   `db:"duration_seconds"`
+	StartsAt        time.Time       `db:"starts_at"`
+	EndsAt          sql.NullTime    `db:"ends_at"`
+	TimeZone        string          `db:"time_zone"`
+	Data            json.RawMessage `db:"data"`
+	CreatedAt       time.Time       `db:"created_at"`
+	UpdatedAt       time.Time       `db:"updated_at"`
+}
+
+type TournamentPairing struct {
+	ID                  uint64                              `db:"id"`
+	Name                string                              `db:"name"`
+	TournamentInterval  TournamentPairingTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                           `db:"tournament_started_at"`
+	Format              TournamentPairingFormat             `db:"format"`
+	Round               uint32                              `db:"round"`
+	Position            uint32                              `db:"position"`
+	FirstUserID         uint64                              `db:"first_user_id"`
+	SecondUserID        sql.NullInt64                       `db:"second_user_id"`
+	Result              NullTournamentPairingResult         `db:"result"`
+	CompletedAt         sql.NullTime                        `db:"completed_at"`
+	CreatedAt           time.Time                           `db:"created_at"`
+	UpdatedAt           time.Time                           `db:"updated_at"`
+}
+
+type TournamentReward struct {
+	ID                  uint64                             `db:"id"`
+	TierID              uint64                             `db:"tier_id"`
+	Name                string                             `db:"name"`
+	TournamentInterval  TournamentRewardTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                          `db:"tournament_started_at"`
+	UserID              uint64                             `db:"user_id"`
+	Ranking             uint64                             `db:"ranking"`
+	Score               int64                              `db:"score"`
+	Data                json.RawMessage                    `db:"data"`
+	ClaimedAt           sql.NullTime                       `db:"claimed_at"`
+	CreatedAt           time.Time                          `db:"created_at"`
+}
+
+type TournamentRewardTier struct {
+	ID                 uint64                                 `db:"id"`
+	Name               string                                 `db:"name"`
+	TournamentInterval TournamentRewardTierTournamentInterval `db:"tournament_interval"`
+	MinRanking         sql.NullInt64                          `db:"min_ranking"`
+	MaxRanking         sql.NullInt64                          `db:"max_ranking"`
+	TopPercentage      sql.NullFloat64                        `db:"top_percentage"`
+	Data               json.RawMessage                        `db:"data"`
+	CreatedAt          time.Time                              `db:"created_at"`
+	UpdatedAt          time.Time                              `db:"updated_at"`
+}
+
+type TournamentTeam struct {
+	ID                  uint64                           `db:"id"`
+	Name                string                           `db:"name"`
+	TournamentInterval  TournamentTeamTournamentInterval `db:"tournament_interval"`
+	TeamID              uint64                           `db:"team_id"`
+	Score               int64                            `db:"score"`
+	Data                json.RawMessage                  `db:"data"`
+	TournamentStartedAt time.Time                        `db:"tournament_started_at"`
+	SentToThirdPartyAt  sql.NullTime                     `db:"sent_to_third_party_at"`
+	CreatedAt           time.Time                        `db:"created_at"`
+	UpdatedAt           time.Time                        `db:"updated_at"`
+}
+
+type TournamentWipeTime struct {
+	ID                      uint64    `db:"id"`
+	Name                    string    `db:"name"`
+	DailyTournamentMinute   uint32    `db:"daily_tournament_minute"`
+	WeeklyTournamentMinute  uint32    `db:"weekly_tournament_minute"`
+	WeeklyTournamentDay     uint32    `db:"weekly_tournament_day"`
+	MonthlyTournamentMinute uint32    `db:"monthly_tournament_minute"`
+	MonthlyTournamentDay    uint32    `db:"monthly_tournament_day"`
+	TimeZone                string    `db:"time_zone"`
+	CreatedAt               time.Time `db:"created_at"`
+	UpdatedAt               time.Time `db:"updated_at"`
+}
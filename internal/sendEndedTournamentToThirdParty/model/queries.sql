@@ -1,17 +1,34 @@
 -- name: GetEndedTournaments :many
+-- Windows are found through both user and team entries, as a window can have only teams
 SELECT name,
     tournament_interval,
     tournament_started_at
-FROM ranked_tournament
-WHERE tournament_started_at < ?
-    AND tournament_interval = ?
+FROM tournament
+WHERE tournament.tournament_started_at < ?
+    AND tournament.tournament_interval = ?
+    AND tournament.name NOT IN (
+        SELECT name
+        FROM tournament_wipe_time
+    )
     AND (
         sent_to_third_party_at IS NULL
-        OR sent_to_third_party_at > NOW()
+        OR tournament.sent_to_third_party_at > NOW()
     )
-GROUP BY name,
+UNION
+SELECT name,
     tournament_interval,
     tournament_started_at
+FROM tournament_team
+WHERE tournament_team.tournament_started_at < ?
+    AND tournament_team.tournament_interval = ?
+    AND tournament_team.name NOT IN (
+        SELECT name
+        FROM tournament_wipe_time
+    )
+    AND (
+        sent_to_third_party_at IS NULL
+        OR tournament_team.sent_to_third_party_at > NOW()
+    )
 ORDER BY name ASC,
     tournament_interval ASC,
     tournament_started_at DESC
@@ -45,4 +62,119 @@ WHERE name = ?
     AND (
         sent_to_third_party_at IS NULL
         OR sent_to_third_party_at > NOW()
-    );
\ No newline at end of file
+    );
+-- name: GetEndedTournamentTeams :many
+SELECT id,
+    name,
+    tournament_interval,
+    team_id,
+    score,
+    ranking,
+    data,
+    tournament_started_at,
+    created_at,
+    updated_at
+FROM ranked_tournament_team
+WHERE name = ?
+    AND tournament_started_at = ?
+    AND tournament_interval = ?
+    AND (
+        sent_to_third_party_at IS NULL
+        OR sent_to_third_party_at > NOW()
+    )
+    AND ranking <= ?;
+-- name: UpdateTournamentTeamSentToThirdParty :execresult
+UPDATE tournament_team
+SET sent_to_third_party_at = NOW()
+WHERE name = ?
+    AND tournament_started_at = ?
+    AND tournament_interval = ?
+    AND (
+        sent_to_third_party_at IS NULL
+        OR sent_to_third_party_at > NOW()
+    );
+-- name: GetTournamentDefinitions :many
+SELECT name,
+    cron_schedule,
+    duration_seconds,
+    starts_at,
+    ends_at,
+    time_zone
+FROM tournament_definition
+ORDER BY name ASC
+LIMIT ? OFFSET ?;
+-- name: GetEndedTournamentsByName :many
+SELECT name,
+    tournament_interval,
+    tournament_started_at
+FROM tournament
+WHERE tournament.name = ?
+    AND tournament.tournament_started_at < ?
+    AND tournament.tournament_interval = ?
+    AND (
+        sent_to_third_party_at IS NULL
+        OR tournament.sent_to_third_party_at > NOW()
+    )
+UNION
+SELECT name,
+    tournament_interval,
+    tournament_started_at
+FROM tournament_team
+WHERE tournament_team.name = ?
+    AND tournament_team.tournament_started_at < ?
+    AND tournament_team.tournament_interval = ?
+    AND (
+        sent_to_third_party_at IS NULL
+        OR tournament_team.sent_to_third_party_at > NOW()
+    )
+ORDER BY tournament_started_at DESC
+LIMIT ? OFFSET ?;
+-- name: GetTournamentWipeTimes :many
+SELECT name,
+    daily_tournament_minute,
+    weekly_tournament_minute,
+    weekly_tournament_day,
+    monthly_tournament_minute,
+    monthly_tournament_day,
+    time_zone
+FROM tournament_wipe_time
+ORDER BY name ASC
+LIMIT ? OFFSET ?;
+-- name: CreateTournamentRewards :execresult
+INSERT INTO tournament_reward (
+        tier_id,
+        name,
+        tournament_interval,
+        tournament_started_at,
+        user_id,
+        ranking,
+        score,
+        data
+    )
+SELECT trt.id,
+    rt.name,
+    rt.tournament_interval,
+    rt.tournament_started_at,
+    rt.user_id,
+    rt.ranking,
+    rt.score,
+    trt.data
+FROM ranked_tournament rt
+    JOIN tournament_reward_tier trt ON trt.name = rt.name
+    AND trt.tournament_interval = rt.tournament_interval
+WHERE rt.name = ?
+    AND rt.tournament_started_at = ?
+    AND rt.tournament_interval = ?
+    AND (
+        rt.ranking BETWEEN trt.min_ranking AND trt.max_ranking
+        OR rt.ranking <= CEIL(
+            trt.top_percentage / 100 * (
+                SELECT COUNT(*)
+                FROM tournament t
+                WHERE t.name = rt.name
+                    AND t.tournament_interval = rt.tournament_interval
+                    AND t.tournament_started_at = rt.tournament_started_at
+            )
+        )
+    ) ON DUPLICATE KEY
+UPDATE tier_id = tournament_reward.tier_id;
@@ -12,6 +12,137 @@ import (
 	"time"
 )
 
+const CreateTournamentRewards = `-- name: CreateTournamentRewards :execresult
+INSERT INTO tournament_reward (
+        tier_id,
+        name,
+        tournament_interval,
+        tournament_started_at,
+        user_id,
+        ranking,
+        score,
+        data
+    )
+SELECT trt.id,
+    rt.name,
+    rt.tournament_interval,
+    rt.tournament_started_at,
+    rt.user_id,
+    rt.ranking,
+    rt.score,
+    trt.data
+FROM ranked_tournament rt
+    JOIN tournament_reward_tier trt ON trt.name = rt.name
+    AND trt.tournament_interval = rt.tournament_interval
+WHERE rt.name = ?
+    AND rt.tournament_started_at = ?
+    AND rt.tournament_interval = ?
+    AND (
+        rt.ranking BETWEEN trt.min_ranking AND trt.max_ranking
+        OR rt.ranking <= CEIL(
+            trt.top_percentage / 100 * (
+                SELECT COUNT(*)
+                FROM tournament t
+                WHERE t.name = rt.name
+                    AND t.tournament_interval = rt.tournament_interval
+                    AND t.tournament_started_at = rt.tournament_started_at
+            )
+        )
+    ) ON DUPLICATE KEY
+UPDATE tier_id = tournament_reward.tier_id
+`
+
+type CreateTournamentRewardsParams struct {
+	Name                string                       `db:"name"`
+	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
+	TournamentInterval  TournamentTournamentInterval `db:"tournament_interval"`
+}
+
+func (q *Queries) CreateTournamentRewards(ctx context.Context, arg CreateTournamentRewardsParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateTournamentRewards, arg.Name, arg.TournamentStartedAt, arg.TournamentInterval)
+}
+
+const GetEndedTournamentTeams = `-- name: GetEndedTournamentTeams :many
+SELECT id,
+    name,
+    tournament_interval,
+    team_id,
+    score,
+    ranking,
+    data,
+    tournament_started_at,
+    created_at,
+    updated_at
+FROM ranked_tournament_team
+WHERE name = ?
+    AND tournament_started_at = ?
+    AND tournament_interval = ?
+    AND (
+        sent_to_third_party_at IS NULL
+        OR sent_to_third_party_at > NOW()
+    )
+    AND ranking <= ?
+`
+
+type GetEndedTournamentTeamsParams struct {
+	Name                string                           `db:"name"`
+	TournamentStartedAt time.Time                        `db:"tournament_started_at"`
+	TournamentInterval  TournamentTeamTournamentInterval `db:"tournament_interval"`
+	Ranking             uint64                           `db:"ranking"`
+}
+
+type GetEndedTournamentTeamsRow struct {
+	ID                  uint64                           `db:"id"`
+	Name                string                           `db:"name"`
+	TournamentInterval  TournamentTeamTournamentInterval `db:"tournament_interval"`
+	TeamID              uint64                           `db:"team_id"`
+	Score               int64                            `db:"score"`
+	Ranking             uint64                           `db:"ranking"`
+	Data                json.RawMessage                  `db:"data"`
+	TournamentStartedAt time.Time                        `db:"tournament_started_at"`
+	CreatedAt           time.Time                        `db:"created_at"`
+	UpdatedAt           time.Time                        `db:"updated_at"`
+}
+
+func (q *Queries) GetEndedTournamentTeams(ctx context.Context, arg GetEndedTournamentTeamsParams) ([]GetEndedTournamentTeamsRow, error) {
+	rows, err := q.db.QueryContext(ctx, GetEndedTournamentTeams,
+		arg.Name,
+		arg.TournamentStartedAt,
+		arg.TournamentInterval,
+		arg.Ranking,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEndedTournamentTeamsRow
+	for rows.Next() {
+		var i GetEndedTournamentTeamsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.TournamentInterval,
+			&i.TeamID,
+			&i.Score,
+			&i.Ranking,
+			&i.Data,
+			&i.TournamentStartedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const GetEndedTournamentUsers = `-- name: GetEndedTournamentUsers :many
 SELECT id,
     name,
@@ -97,16 +228,32 @@ const GetEndedTournaments = `-- name: GetEndedTournaments :many
 SELECT name,
     tournament_interval,
     tournament_started_at
-FROM ranked_tournament
-WHERE tournament_started_at < ?
-    AND tournament_interval = ?
+FROM tournament
+WHERE tournament.tournament_started_at < ?
+    AND tournament.tournament_interval = ?
+    AND tournament.name NOT IN (
+        SELECT name
+        FROM tournament_wipe_time
+    )
     AND (
         sent_to_third_party_at IS NULL
-        OR sent_to_third_party_at > NOW()
+        OR tournament.sent_to_third_party_at > NOW()
     )
-GROUP BY name,
+UNION
+SELECT name,
     tournament_interval,
     tournament_started_at
+FROM tournament_team
+WHERE tournament_team.tournament_started_at < ?
+    AND tournament_team.tournament_interval = ?
+    AND tournament_team.name NOT IN (
+        SELECT name
+        FROM tournament_wipe_time
+    )
+    AND (
+        sent_to_third_party_at IS NULL
+        OR tournament_team.sent_to_third_party_at > NOW()
+    )
 ORDER BY name ASC,
     tournament_interval ASC,
     tournament_started_at DESC
@@ -114,10 +261,12 @@ LIMIT ? OFFSET ?
 `
 
 type GetEndedTournamentsParams struct {
-	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
-	TournamentInterval  TournamentTournamentInterval `db:"tournament_interval"`
-	Limit               int32                        `db:"limit"`
-	Offset              int32                        `db:"offset"`
+	TournamentStartedAt   time.Time                        `db:"tournament_started_at"`
+	TournamentInterval    TournamentTournamentInterval     `db:"tournament_interval"`
+	TournamentStartedAt_2 time.Time                        `db:"tournament_started_at_2"`
+	TournamentInterval_2  TournamentTeamTournamentInterval `db:"tournament_interval_2"`
+	Limit                 int32                            `db:"limit"`
+	Offset                int32                            `db:"offset"`
 }
 
 type GetEndedTournamentsRow struct {
@@ -126,10 +275,13 @@ type GetEndedTournamentsRow struct {
 	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
 }
 
+// Windows are found through both user and team entries, as a window can have only teams
 func (q *Queries) GetEndedTournaments(ctx context.Context, arg GetEndedTournamentsParams) ([]GetEndedTournamentsRow, error) {
 	rows, err := q.db.QueryContext(ctx, GetEndedTournaments,
 		arg.TournamentStartedAt,
 		arg.TournamentInterval,
+		arg.TournamentStartedAt_2,
+		arg.TournamentInterval_2,
 		arg.Limit,
 		arg.Offset,
 	)
@@ -154,6 +306,198 @@ func (q *Queries) GetEndedTournaments(ctx context.Context, arg GetEndedTournamen
 	return items, nil
 }
 
+const GetEndedTournamentsByName = `-- name: GetEndedTournamentsByName :many
+SELECT name,
+    tournament_interval,
+    tournament_started_at
+FROM tournament
+WHERE tournament.name = ?
+    AND tournament.tournament_started_at < ?
+    AND tournament.tournament_interval = ?
+    AND (
+        sent_to_third_party_at IS NULL
+        OR tournament.sent_to_third_party_at > NOW()
+    )
+UNION
+SELECT name,
+    tournament_interval,
+    tournament_started_at
+FROM tournament_team
+WHERE tournament_team.name = ?
+    AND tournament_team.tournament_started_at < ?
+    AND tournament_team.tournament_interval = ?
+    AND (
+        sent_to_third_party_at IS NULL
+        OR tournament_team.sent_to_third_party_at > NOW()
+    )
+ORDER BY tournament_started_at DESC
+LIMIT ? OFFSET ?
+`
+
+type GetEndedTournamentsByNameParams struct {
+	Name                  string                           `db:"name"`
+	TournamentStartedAt   time.Time                        `db:"tournament_started_at"`
+	TournamentInterval    TournamentTournamentInterval     `db:"tournament_interval"`
+	Name_2                string                           `db:"name_2"`
+	TournamentStartedAt_2 time.Time                        `db:"tournament_started_at_2"`
+	TournamentInterval_2  TournamentTeamTournamentInterval `db:"tournament_interval_2"`
+	Limit                 int32                            `db:"limit"`
+	Offset                int32                            `db:"offset"`
+}
+
+type GetEndedTournamentsByNameRow struct {
+	Name                string                       `db:"name"`
+	TournamentInterval  TournamentTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
+}
+
+func (q *Queries) GetEndedTournamentsByName(ctx context.Context, arg GetEndedTournamentsByNameParams) ([]GetEndedTournamentsByNameRow, error) {
+	rows, err := q.db.QueryContext(ctx, GetEndedTournamentsByName,
+		arg.Name,
+		arg.TournamentStartedAt,
+		arg.TournamentInterval,
+		arg.Name_2,
+		arg.TournamentStartedAt_2,
+		arg.TournamentInterval_2,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEndedTournamentsByNameRow
+	for rows.Next() {
+		var i GetEndedTournamentsByNameRow
+		if err := rows.Scan(&i.Name, &i.TournamentInterval, &i.TournamentStartedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetTournamentDefinitions = `-- name: GetTournamentDefinitions :many
+SELECT name,
+    cron_schedule,
+    duration_seconds,
+    starts_at,
+    ends_at,
+    time_zone
+FROM tournament_definition
+ORDER BY name ASC
+LIMIT ? OFFSET ?
+`
+
+type GetTournamentDefinitionsParams struct {
+	Limit  int32 `db:"limit"`
+	Offset int32 `db:"offset"`
+}
+
+type GetTournamentDefinitionsRow struct {
+	Name            string         `db:"name"`
+	CronSchedule    sql.NullString `db:"cron_schedule"`
+	DurationSeconds sql.NullInt64  `db:"duration_seconds"`
+	StartsAt        time.Time      `db:"starts_at"`
+	EndsAt          sql.NullTime   `db:"ends_at"`
+	TimeZone        string         `db:"time_zone"`
+}
+
+func (q *Queries) GetTournamentDefinitions(ctx context.Context, arg GetTournamentDefinitionsParams) ([]GetTournamentDefinitionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, GetTournamentDefinitions, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTournamentDefinitionsRow
+	for rows.Next() {
+		var i GetTournamentDefinitionsRow
+		if err := rows.Scan(
+			&i.Name,
+			&i.CronSchedule,
+			&i.DurationSeconds,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetTournamentWipeTimes = `-- name: GetTournamentWipeTimes :many
+SELECT name,
+    daily_tournament_minute,
+    weekly_tournament_minute,
+    weekly_tournament_day,
+    monthly_tournament_minute,
+    monthly_tournament_day,
+    time_zone
+FROM tournament_wipe_time
+ORDER BY name ASC
+LIMIT ? OFFSET ?
+`
+
+type GetTournamentWipeTimesParams struct {
+	Limit  int32 `db:"limit"`
+	Offset int32 `db:"offset"`
+}
+
+type GetTournamentWipeTimesRow struct {
+	Name                    string `db:"name"`
+	DailyTournamentMinute   uint32 `db:"daily_tournament_minute"`
+	WeeklyTournamentMinute  uint32 `db:"weekly_tournament_minute"`
+	WeeklyTournamentDay     uint32 `db:"weekly_tournament_day"`
+	MonthlyTournamentMinute uint32 `db:"monthly_tournament_minute"`
+	MonthlyTournamentDay    uint32 `db:"monthly_tournament_day"`
+	TimeZone                string `db:"time_zone"`
+}
+
+func (q *Queries) GetTournamentWipeTimes(ctx context.Context, arg GetTournamentWipeTimesParams) ([]GetTournamentWipeTimesRow, error) {
+	rows, err := q.db.QueryContext(ctx, GetTournamentWipeTimes, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTournamentWipeTimesRow
+	for rows.Next() {
+		var i GetTournamentWipeTimesRow
+		if err := rows.Scan(
+			&i.Name,
+			&i.DailyTournamentMinute,
+			&i.WeeklyTournamentMinute,
+			&i.WeeklyTournamentDay,
+			&i.MonthlyTournamentMinute,
+			&i.MonthlyTournamentDay,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const UpdateTournamentSentToThirdParty = `-- name: UpdateTournamentSentToThirdParty :execresult
 UPDATE tournament
 SET sent_to_third_party_at = NOW()
@@ -175,3 +519,25 @@ type UpdateTournamentSentToThirdPartyParams struct {
 func (q *Queries) UpdateTournamentSentToThirdParty(ctx context.Context, arg UpdateTournamentSentToThirdPartyParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, UpdateTournamentSentToThirdParty, arg.Name, arg.TournamentStartedAt, arg.TournamentInterval)
 }
+
+const UpdateTournamentTeamSentToThirdParty = `-- name: UpdateTournamentTeamSentToThirdParty :execresult
+UPDATE tournament_team
+SET sent_to_third_party_at = NOW()
+WHERE name = ?
+    AND tournament_started_at = ?
+    AND tournament_interval = ?
+    AND (
+        sent_to_third_party_at IS NULL
+        OR sent_to_third_party_at > NOW()
+    )
+`
+
+type UpdateTournamentTeamSentToThirdPartyParams struct {
+	Name                string                           `db:"name"`
+	TournamentStartedAt time.Time                        `db:"tournament_started_at"`
+	TournamentInterval  TournamentTeamTournamentInterval `db:"tournament_interval"`
+}
+
+func (q *Queries) UpdateTournamentTeamSentToThirdParty(ctx context.Context, arg UpdateTournamentTeamSentToThirdPartyParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, UpdateTournamentTeamSentToThirdParty, arg.Name, arg.TournamentStartedAt, arg.TournamentInterval)
+}
@@ -118,6 +118,14 @@ func (a *App) Handler(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	err = a.sendEndedTournamentsWithWipeTimesToThirdParty(ctx)
+	if err != nil {
+		return err
+	}
+	err = a.sendEndedCustomTournamentsToThirdParty(ctx)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -133,28 +141,34 @@ func (a *App) sendEndedTournamentsToThirdParty(ctx context.Context) error {
 	}
 	for {
 		dailyTournaments, err := a.database.GetEndedTournaments(ctx, model.GetEndedTournamentsParams{
-			TournamentStartedAt: tournament.GetStartTime(time.Now().UTC(), api.TournamentInterval_DAILY, wipeTimes),
-			TournamentInterval:  model.TournamentTournamentIntervalDaily,
-			Limit:               limit,
-			Offset:              offset,
+			TournamentStartedAt:   tournament.GetStartTime(time.Now().UTC(), api.TournamentInterval_DAILY, wipeTimes),
+			TournamentInterval:    model.TournamentTournamentIntervalDaily,
+			TournamentStartedAt_2: tournament.GetStartTime(time.Now().UTC(), api.TournamentInterval_DAILY, wipeTimes),
+			TournamentInterval_2:  model.TournamentTeamTournamentIntervalDaily,
+			Limit:                 limit,
+			Offset:                offset,
 		})
 		if err != nil {
 			return err
 		}
 		weeklyTournaments, err := a.database.GetEndedTournaments(ctx, model.GetEndedTournamentsParams{
-			TournamentStartedAt: tournament.GetStartTime(time.Now().UTC(), api.TournamentInterval_WEEKLY, wipeTimes),
-			TournamentInterval:  model.TournamentTournamentIntervalWeekly,
-			Limit:               limit,
-			Offset:              offset,
+			TournamentStartedAt:   tournament.GetStartTime(time.Now().UTC(), api.TournamentInterval_WEEKLY, wipeTimes),
+			TournamentInterval:    model.TournamentTournamentIntervalWeekly,
+			TournamentStartedAt_2: tournament.GetStartTime(time.Now().UTC(), api.TournamentInterval_WEEKLY, wipeTimes),
+			TournamentInterval_2:  model.TournamentTeamTournamentIntervalWeekly,
+			Limit:                 limit,
+			Offset:                offset,
 		})
 		if err != nil {
 			return err
 		}
 		monthlyTournaments, err := a.database.GetEndedTournaments(ctx, model.GetEndedTournamentsParams{
-			TournamentStartedAt: tournament.GetStartTime(time.Now().UTC(), api.TournamentInterval_MONTHLY, wipeTimes),
-			TournamentInterval:  model.TournamentTournamentIntervalMonthly,
-			Limit:               limit,
-			Offset:              offset,
+			TournamentStartedAt:   tournament.GetStartTime(time.Now().UTC(), api.TournamentInterval_MONTHLY, wipeTimes),
+			TournamentInterval:    model.TournamentTournamentIntervalMonthly,
+			TournamentStartedAt_2: tournament.GetStartTime(time.Now().UTC(), api.TournamentInterval_MONTHLY, wipeTimes),
+			TournamentInterval_2:  model.TournamentTeamTournamentIntervalMonthly,
+			Limit:                 limit,
+			Offset:                offset,
 		})
 		if err != nil {
 			return err
@@ -164,7 +178,7 @@ func (a *App) sendEndedTournamentsToThirdParty(ctx context.Context) error {
 			break
 		}
 		for _, t := range tournaments {
-			err = a.sendEndedTournamentUsersToThirdParty(ctx, t.Name, t.TournamentStartedAt, t.TournamentInterval)
+			err = a.sendEndedTournamentToThirdParty(ctx, t.Name, t.TournamentStartedAt, t.TournamentInterval)
 			if err != nil {
 				return err
 			}
@@ -174,7 +188,122 @@ func (a *App) sendEndedTournamentsToThirdParty(ctx context.Context) error {
 	return nil
 }
 
-func (a *App) sendEndedTournamentUsersToThirdParty(ctx context.Context, name string, tournamentStartedAt time.Time, tournamentInterval model.TournamentTournamentInterval) error {
+// Tournaments with their own wipe times are left out of the other queries, and end at the wipe times in their time zone
+func (a *App) sendEndedTournamentsWithWipeTimesToThirdParty(ctx context.Context) error {
+	limit := a.limit
+	offset := int32(0)
+	intervals := map[api.TournamentInterval]model.TournamentTournamentInterval{
+		api.TournamentInterval_DAILY:   model.TournamentTournamentIntervalDaily,
+		api.TournamentInterval_WEEKLY:  model.TournamentTournamentIntervalWeekly,
+		api.TournamentInterval_MONTHLY: model.TournamentTournamentIntervalMonthly,
+	}
+	for {
+		wipeTimes, err := a.database.GetTournamentWipeTimes(ctx, model.GetTournamentWipeTimesParams{
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			return err
+		}
+		if len(wipeTimes) == 0 {
+			break
+		}
+		for _, w := range wipeTimes {
+			times, err := tournament.NewWipeTimes(w.DailyTournamentMinute, w.WeeklyTournamentMinute, w.WeeklyTournamentDay, w.MonthlyTournamentMinute, w.MonthlyTournamentDay, w.TimeZone)
+			if err != nil {
+				return err
+			}
+			for interval, tournamentInterval := range intervals {
+				err = a.sendEndedTournamentsByNameToThirdParty(ctx, w.Name, tournamentInterval, tournament.GetStartTime(time.Now().UTC(), interval, times))
+				if err != nil {
+					return err
+				}
+			}
+		}
+		offset += limit
+	}
+	return nil
+}
+
+// Custom tournaments end when their definition says so, so each definition is checked for windows that have ended
+func (a *App) sendEndedCustomTournamentsToThirdParty(ctx context.Context) error {
+	limit := a.limit
+	offset := int32(0)
+	for {
+		definitions, err := a.database.GetTournamentDefinitions(ctx, model.GetTournamentDefinitionsParams{
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			return err
+		}
+		if len(definitions) == 0 {
+			break
+		}
+		for _, d := range definitions {
+			definition, err := tournament.NewDefinition(d.CronSchedule, d.DurationSeconds, d.StartsAt, d.EndsAt, d.TimeZone)
+			if err != nil {
+				return err
+			}
+			startedAt, active, err := tournament.GetDefinitionStartTime(time.Now().UTC(), definition)
+			if err != nil {
+				return err
+			}
+			// The latest window has ended too if it is no longer running
+			if !active {
+				startedAt = startedAt.Add(time.Second)
+			}
+			err = a.sendEndedTournamentsByNameToThirdParty(ctx, d.Name, model.TournamentTournamentIntervalCustom, startedAt)
+			if err != nil {
+				return err
+			}
+		}
+		offset += limit
+	}
+	return nil
+}
+
+func (a *App) sendEndedTournamentsByNameToThirdParty(ctx context.Context, name string, tournamentInterval model.TournamentTournamentInterval, startedBefore time.Time) error {
+	limit := a.limit
+	offset := int32(0)
+	for {
+		tournaments, err := a.database.GetEndedTournamentsByName(ctx, model.GetEndedTournamentsByNameParams{
+			Name:                  name,
+			TournamentStartedAt:   startedBefore,
+			TournamentInterval:    tournamentInterval,
+			Name_2:                name,
+			TournamentStartedAt_2: startedBefore,
+			TournamentInterval_2:  model.TournamentTeamTournamentInterval(tournamentInterval),
+			Limit:                 limit,
+			Offset:                offset,
+		})
+		if err != nil {
+			return err
+		}
+		if len(tournaments) == 0 {
+			break
+		}
+		for _, t := range tournaments {
+			err = a.sendEndedTournamentToThirdParty(ctx, t.Name, t.TournamentStartedAt, t.TournamentInterval)
+			if err != nil {
+				return err
+			}
+		}
+		offset += limit
+	}
+	return nil
+}
+
+func (a *App) sendEndedTournamentToThirdParty(ctx context.Context, name string, tournamentStartedAt time.Time, tournamentInterval model.TournamentTournamentInterval) error {
+	// Rewards are granted at most once per tier and user, so they are granted again safely if the third party fails
+	_, err := a.database.CreateTournamentRewards(ctx, model.CreateTournamentRewardsParams{
+		Name:                name,
+		TournamentStartedAt: tournamentStartedAt,
+		TournamentInterval:  tournamentInterval,
+	})
+	if err != nil {
+		return err
+	}
 	tx, err := a.sql.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -190,6 +319,15 @@ func (a *App) sendEndedTournamentUsersToThirdParty(ctx context.Context, name str
 	if err != nil {
 		return err
 	}
+	tournamentTeams, err := qtx.GetEndedTournamentTeams(ctx, model.GetEndedTournamentTeamsParams{
+		Name:                name,
+		TournamentStartedAt: tournamentStartedAt,
+		TournamentInterval:  model.TournamentTeamTournamentInterval(tournamentInterval),
+		Ranking:             uint64(a.topLimit),
+	})
+	if err != nil {
+		return err
+	}
 	// Update tournament status to sent
 	result, err := qtx.UpdateTournamentSentToThirdParty(ctx, model.UpdateTournamentSentToThirdPartyParams{
 		Name:                name,
@@ -199,39 +337,88 @@ func (a *App) sendEndedTournamentUsersToThirdParty(ctx context.Context, name str
 	if err != nil {
 		return err
 	}
-	// Check if tournament was updated
-	rowsAffected, err := result.RowsAffected()
+	usersUpdated, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	result, err = qtx.UpdateTournamentTeamSentToThirdParty(ctx, model.UpdateTournamentTeamSentToThirdPartyParams{
+		Name:                name,
+		TournamentStartedAt: tournamentStartedAt,
+		TournamentInterval:  model.TournamentTeamTournamentInterval(tournamentInterval),
+	})
+	if err != nil {
+		return err
+	}
+	teamsUpdated, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
+	// Check if tournament was updated
+	if usersUpdated == 0 && teamsUpdated == 0 {
 		return nil
 	}
 	// Create json and send to third party
-	tournamentUsersData := []map[string]interface{}{}
-	for _, tu := range tournamentUsers {
-		tournamentUsersData = append(tournamentUsersData, map[string]interface{}{
-			"id":                    tu.ID,
-			"name":                  tu.Name,
-			"tournament_interval":   tu.TournamentInterval,
-			"user_id":               tu.UserID,
-			"score":                 tu.Score,
-			"ranking":               tu.Ranking,
-			"data":                  string(tu.Data),
-			"tournament_started_at": tu.TournamentStartedAt.Format(time.RFC3339),
-			"created_at":            tu.CreatedAt.Format(time.RFC3339),
-			"updated_at":            tu.UpdatedAt.Format(time.RFC3339),
-		})
+	if usersUpdated > 0 {
+		tournamentUsersData := []map[string]interface{}{}
+		for _, tu := range tournamentUsers {
+			tournamentUsersData = append(tournamentUsersData, map[string]interface{}{
+				"id":                    tu.ID,
+				"name":                  tu.Name,
+				"tournament_interval":   tu.TournamentInterval,
+				"user_id":               tu.UserID,
+				"score":                 tu.Score,
+				"ranking":               tu.Ranking,
+				"data":                  string(tu.Data),
+				"tournament_started_at": tu.TournamentStartedAt.Format(time.RFC3339),
+				"created_at":            tu.CreatedAt.Format(time.RFC3339),
+				"updated_at":            tu.UpdatedAt.Format(time.RFC3339),
+			})
+		}
+		sent, err := a.sendToThirdParty(fmt.Sprintf("tournament-%s-%s-%s", name, tournamentStartedAt.Format(time.RFC3339), tournamentInterval), tournamentUsersData)
+		if err != nil || !sent {
+			return err
+		}
 	}
-	marshalledTournamentData, err := json.Marshal(tournamentUsersData)
+	if teamsUpdated > 0 {
+		tournamentTeamsData := []map[string]interface{}{}
+		for _, tt := range tournamentTeams {
+			tournamentTeamsData = append(tournamentTeamsData, map[string]interface{}{
+				"id":                    tt.ID,
+				"name":                  tt.Name,
+				"tournament_interval":   tt.TournamentInterval,
+				"team_id":               tt.TeamID,
+				"score":                 tt.Score,
+				"ranking":               tt.Ranking,
+				"data":                  string(tt.Data),
+				"tournament_started_at": tt.TournamentStartedAt.Format(time.RFC3339),
+				"created_at":            tt.CreatedAt.Format(time.RFC3339),
+				"updated_at":            tt.UpdatedAt.Format(time.RFC3339),
+			})
+		}
+		sent, err := a.sendToThirdParty(fmt.Sprintf("tournament-team-%s-%s-%s", name, tournamentStartedAt.Format(time.RFC3339), tournamentInterval), tournamentTeamsData)
+		if err != nil || !sent {
+			return err
+		}
+	}
+	// Commit the transaction
+	err = tx.Commit()
 	if err != nil {
 		return err
 	}
+	return nil
+}
+
+// sendToThirdParty posts the data under the given id, and reports whether the third party accepted it
+func (a *App) sendToThirdParty(id string, data []map[string]interface{}) (bool, error) {
+	marshalledData, err := json.Marshal(data)
+	if err != nil {
+		return false, err
+	}
 	// Send to third party with header
-	thirdPartyUriWithID := fmt.Sprintf("%s?id=tournament-%s-%s-%s", a.thirdPartyUri, name, tournamentStartedAt.Format(time.RFC3339), tournamentInterval)
-	req, err := http.NewRequest("POST", thirdPartyUriWithID, bytes.NewBuffer([]byte(fmt.Sprintf("{value: \"%s\"}", string(marshalledTournamentData)))))
+	thirdPartyUriWithID := fmt.Sprintf("%s?id=%s", a.thirdPartyUri, id)
+	req, err := http.NewRequest("POST", thirdPartyUriWithID, bytes.NewBuffer([]byte(fmt.Sprintf("{value: \"%s\"}", string(marshalledData)))))
 	if err != nil {
-		return err
+		return false, err
 	}
 	req.Header.Set(a.apiKeyHeader, a.apiKey)
 	req.Header.Set("Content-Type", "application/json")
@@ -239,17 +426,9 @@ func (a *App) sendEndedTournamentUsersToThirdParty(ctx context.Context, name str
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		return err
+		return false, err
 	}
 	defer resp.Body.Close()
 	// Check response
-	if resp.StatusCode != http.StatusOK {
-		return nil
-	}
-	// Commit the transaction
-	err = tx.Commit()
-	if err != nil {
-		return err
-	}
-	return nil
+	return resp.StatusCode == http.StatusOK, nil
 }
@@ -84,9 +84,10 @@ func (c *CreateTeamCommand) Execute(ctx context.Context) error {
 	qtx := c.service.database.WithTx(tx)
 	// Create the team
 	result, err := qtx.CreateTeam(ctx, model.CreateTeamParams{
-		Name:  c.In.Name,
-		Score: *c.In.Score,
-		Data:  data,
+		Name:        c.In.Name,
+		Score:       *c.In.Score,
+		OwnerUserID: conversion.Uint64ToSqlNullInt64(&c.In.FirstMemberUserId),
+		Data:        data,
 	})
 	// If the team already exists, return appropriate error
 	if err != nil {
@@ -106,6 +107,11 @@ func (c *CreateTeamCommand) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	// The first member leaves a team pending deletion if they are still in one, so it is restored without them
+	_, err = qtx.DeleteTeamMemberOfDeletedTeam(ctx, c.In.FirstMemberUserId)
+	if err != nil {
+		return err
+	}
 	// Create the first member
 	_, err = qtx.CreateTeamMember(ctx, model.CreateTeamMemberParams{
 		UserID:       c.In.FirstMemberUserId,
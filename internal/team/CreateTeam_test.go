@@ -32,7 +32,8 @@ func TestCreateTeamNoScore(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO team").WithArgs("test", 0, raw).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO team").WithArgs("test", 0, 2, raw).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM team_member").WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectExec("INSERT INTO team_member").WithArgs(2, 1, 1, raw).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c := NewCreateTeamCommand(service, &api.CreateTeamRequest{
@@ -71,7 +72,8 @@ func TestCreateTeamFirstMemberExists(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO team").WithArgs("test", 0, raw).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO team").WithArgs("test", 0, 2, raw).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM team_member").WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectExec("INSERT INTO team_member").WithArgs(2, 1, 1, raw).WillReturnError(&mysql.MySQLError{Number: errorcode.MySQLErrorCodeDuplicateEntry, Message: "Duplicate entry '2-1' for key 'team_member.team_member_user_id_idx'"})
 	mock.ExpectRollback()
 	c := NewCreateTeamCommand(service, &api.CreateTeamRequest{
@@ -110,7 +112,7 @@ func TestCreateTeamNameTaken(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO team").WithArgs("test", 0, raw).WillReturnError(&mysql.MySQLError{Number: errorcode.MySQLErrorCodeDuplicateEntry, Message: "Duplicate entry 'test' for key 'team.name'"})
+	mock.ExpectExec("INSERT INTO team").WithArgs("test", 0, 1, raw).WillReturnError(&mysql.MySQLError{Number: errorcode.MySQLErrorCodeDuplicateEntry, Message: "Duplicate entry 'test' for key 'team.name'"})
 	mock.ExpectRollback()
 	c := NewCreateTeamCommand(service, &api.CreateTeamRequest{
 		Name:              "test",
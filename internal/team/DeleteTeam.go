@@ -35,15 +35,36 @@ func (c *DeleteTeamCommand) Execute(ctx context.Context) error {
 	if c.In.Member == nil {
 		c.In.Member = &api.TeamMemberRequest{}
 	}
-	result, err := c.service.database.DeleteTeam(ctx, model.TeamParams{
-		ID:   conversion.Uint64ToSqlNullInt64(c.In.Id),
-		Name: conversion.StringToSqlNullString(c.In.Name),
-		Member: model.GetTeamMemberParams{
-			ID:     conversion.Uint64ToSqlNullInt64(c.In.Member.Id),
-			UserID: conversion.Uint64ToSqlNullInt64(c.In.Member.UserId),
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	team, err := qtx.GetTeam(ctx, model.GetTeamParams{
+		Team: model.TeamParams{
+			ID:   conversion.Uint64ToSqlNullInt64(c.In.Id),
+			Name: conversion.StringToSqlNullString(c.In.Name),
+			Member: model.GetTeamMemberParams{
+				ID:     conversion.Uint64ToSqlNullInt64(c.In.Member.Id),
+				UserID: conversion.Uint64ToSqlNullInt64(c.In.Member.UserId),
+			},
 		},
+		Limit:  1,
+		Offset: 0,
 	})
-	// If an error occurs, it is an internal server error
+	if err != nil {
+		return err
+	}
+	if len(team) == 0 {
+		c.Out = &api.TeamResponse{
+			Success: false,
+			Error:   api.TeamResponse_NOT_FOUND,
+		}
+		return nil
+	}
+	// The team and its members are kept until the grace period ends so it can be restored, and its members are free to join another team
+	result, err := qtx.SoftDeleteTeam(ctx, team[0].ID)
 	if err != nil {
 		return err
 	}
@@ -51,7 +72,7 @@ func (c *DeleteTeamCommand) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	// If no rows are affected, the team is not found
+	// If no rows are affected, the team was deleted since it was found
 	if rowsAffected == 0 {
 		c.Out = &api.TeamResponse{
 			Success: false,
@@ -59,6 +80,10 @@ func (c *DeleteTeamCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
 	c.Out = &api.TeamResponse{
 		Success: true,
 		Error:   api.TeamResponse_NONE,
@@ -0,0 +1,83 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+)
+
+type DeleteTeamMessageCommand struct {
+	service *Service
+	In      *api.DeleteTeamMessageRequest
+	Out     *api.TeamMessageResponse
+}
+
+func NewDeleteTeamMessageCommand(service *Service, in *api.DeleteTeamMessageRequest) *DeleteTeamMessageCommand {
+	return &DeleteTeamMessageCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *DeleteTeamMessageCommand) Execute(ctx context.Context) error {
+	// Check if message id is provided
+	if c.In.Message == nil || c.In.Message.Id == 0 {
+		c.Out = &api.TeamMessageResponse{
+			Success: false,
+			Error:   api.TeamMessageResponse_ID_REQUIRED,
+		}
+		return nil
+	}
+	// Check if user id is provided, as only the author or the team owner can delete a message
+	if c.In.UserId == 0 {
+		c.Out = &api.TeamMessageResponse{
+			Success: false,
+			Error:   api.TeamMessageResponse_USER_ID_REQUIRED,
+		}
+		return nil
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	message, err := qtx.GetTeamMessage(ctx, c.In.Message.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.TeamMessageResponse{
+				Success: false,
+				Error:   api.TeamMessageResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	if message.UserID != c.In.UserId {
+		owner, err := isTeamOwner(ctx, qtx, message.TeamID, c.In.UserId)
+		if err != nil {
+			return err
+		}
+		if !owner {
+			c.Out = &api.TeamMessageResponse{
+				Success: false,
+				Error:   api.TeamMessageResponse_NOT_ALLOWED,
+			}
+			return nil
+		}
+	}
+	_, err = qtx.DeleteTeamMessage(ctx, message.ID)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.TeamMessageResponse{
+		Success: true,
+		Error:   api.TeamMessageResponse_NONE,
+	}
+	return nil
+}
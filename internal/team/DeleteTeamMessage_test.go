@@ -0,0 +1,176 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/team/model"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestDeleteTeamMessageNoId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewDeleteTeamMessageCommand(service, &api.DeleteTeamMessageRequest{})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TeamMessageResponse_ID_REQUIRED {
+		t.Fatal("Expected error to be ID_REQUIRED")
+	}
+}
+
+func TestDeleteTeamMessageNoUserId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewDeleteTeamMessageCommand(service, &api.DeleteTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TeamMessageResponse_USER_ID_REQUIRED {
+		t.Fatal("Expected error to be USER_ID_REQUIRED")
+	}
+}
+
+func TestDeleteTeamMessageByAuthor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM team_message").WithArgs(1).WillReturnRows(sqlmock.NewRows(teamMessage).AddRow(1, 3, 5, "Hello", false, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectExec("DELETE FROM team_message").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	c := NewDeleteTeamMessageCommand(service, &api.DeleteTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+		UserId:  5,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.Error != api.TeamMessageResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+}
+
+func TestDeleteTeamMessageByTeamOwner(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM team_message").WithArgs(1).WillReturnRows(sqlmock.NewRows(teamMessage).AddRow(1, 3, 5, "Hello", false, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT owner_user_id FROM team").WithArgs(3).WillReturnRows(sqlmock.NewRows([]string{"owner_user_id"}).AddRow(6))
+	mock.ExpectExec("DELETE FROM team_message").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	c := NewDeleteTeamMessageCommand(service, &api.DeleteTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+		UserId:  6,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.Error != api.TeamMessageResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+}
+
+func TestDeleteTeamMessageNotAllowed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM team_message").WithArgs(1).WillReturnRows(sqlmock.NewRows(teamMessage).AddRow(1, 3, 5, "Hello", false, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT owner_user_id FROM team").WithArgs(3).WillReturnRows(sqlmock.NewRows([]string{"owner_user_id"}).AddRow(6))
+	mock.ExpectRollback()
+	c := NewDeleteTeamMessageCommand(service, &api.DeleteTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+		UserId:  7,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TeamMessageResponse_NOT_ALLOWED {
+		t.Fatal("Expected error to be NOT_ALLOWED")
+	}
+}
+
+func TestDeleteTeamMessageNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM team_message").WithArgs(1).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+	c := NewDeleteTeamMessageCommand(service, &api.DeleteTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+		UserId:  5,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TeamMessageResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
@@ -2,7 +2,9 @@ package team
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/MorhafAlshibly/coanda/api"
@@ -91,7 +93,10 @@ func TestDeleteTeamById(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	mock.ExpectExec("DELETE FROM `team`").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, 1, json.RawMessage("{}"), time.Now(), time.Now(), 1, 1, 1, json.RawMessage("{}"), time.Now(), time.Now(), 1))
+	mock.ExpectExec("UPDATE team").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 	c := NewDeleteTeamCommand(service, &api.TeamRequest{
 		Id: conversion.ValueToPointer(uint64(1)),
 	})
@@ -116,7 +121,10 @@ func TestDeleteTeamByName(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	mock.ExpectExec("DELETE FROM `team`").WithArgs("test", 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs("test", 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, 1, json.RawMessage("{}"), time.Now(), time.Now(), 1, 1, 1, json.RawMessage("{}"), time.Now(), time.Now(), 1))
+	mock.ExpectExec("UPDATE team").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 	c := NewDeleteTeamCommand(service, &api.TeamRequest{
 		Name: conversion.ValueToPointer("test"),
 	})
@@ -141,7 +149,10 @@ func TestDeleteTeamByMemberId(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	mock.ExpectExec("DELETE FROM `team`").WithArgs(2, 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(2, 1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, 1, json.RawMessage("{}"), time.Now(), time.Now(), 1, 1, 1, json.RawMessage("{}"), time.Now(), time.Now(), 1))
+	mock.ExpectExec("UPDATE team").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 	c := NewDeleteTeamCommand(service, &api.TeamRequest{
 		Member: &api.TeamMemberRequest{
 			Id: conversion.ValueToPointer(uint64(2)),
@@ -168,7 +179,10 @@ func TestDeleteTeamByMemberUserId(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	mock.ExpectExec("DELETE FROM `team`").WithArgs(2, 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(2, 1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, 1, json.RawMessage("{}"), time.Now(), time.Now(), 1, 1, 1, json.RawMessage("{}"), time.Now(), time.Now(), 1))
+	mock.ExpectExec("UPDATE team").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 	c := NewDeleteTeamCommand(service, &api.TeamRequest{
 		Member: &api.TeamMemberRequest{
 			UserId: conversion.ValueToPointer(uint64(2)),
@@ -185,3 +199,30 @@ func TestDeleteTeamByMemberUserId(t *testing.T) {
 		t.Fatal("Expected error to be NONE")
 	}
 }
+
+func TestDeleteTeamNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember))
+	mock.ExpectRollback()
+	c := NewDeleteTeamCommand(service, &api.TeamRequest{
+		Id: conversion.ValueToPointer(uint64(1)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TeamResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
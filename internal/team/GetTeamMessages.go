@@ -0,0 +1,75 @@
+package team
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/team/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type GetTeamMessagesCommand struct {
+	service *Service
+	In      *api.GetTeamMessagesRequest
+	Out     *api.GetTeamMessagesResponse
+}
+
+func NewGetTeamMessagesCommand(service *Service, in *api.GetTeamMessagesRequest) *GetTeamMessagesCommand {
+	return &GetTeamMessagesCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetTeamMessagesCommand) Execute(ctx context.Context) error {
+	tErr := c.service.checkForTeamRequestError(c.In.Team)
+	// Check if error is found
+	if tErr != nil {
+		c.Out = &api.GetTeamMessagesResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.GetTeamMessagesResponse_Error_value, api.GetTeamMessagesResponse_NO_FIELD_SPECIFIED),
+		}
+		return nil
+	}
+	// Check if team member is initialised
+	if c.In.Team.Member == nil {
+		c.In.Team.Member = &api.TeamMemberRequest{}
+	}
+	limit, _ := conversion.PaginationToLimitOffset(&api.Pagination{Max: c.In.Max}, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
+	// Fetch one extra message to know whether there is another page
+	messages, err := c.service.database.GetTeamMessages(ctx, model.GetTeamMessagesParams{
+		Team: model.TeamParams{
+			ID:   conversion.Uint64ToSqlNullInt64(c.In.Team.Id),
+			Name: conversion.StringToSqlNullString(c.In.Team.Name),
+			Member: model.GetTeamMemberParams{
+				ID:     conversion.Uint64ToSqlNullInt64(c.In.Team.Member.Id),
+				UserID: conversion.Uint64ToSqlNullInt64(c.In.Team.Member.UserId),
+			},
+		},
+		Pinned: conversion.BoolToSqlNullBool(c.In.Pinned),
+		Before: conversion.Uint64ToSqlNullInt64(c.In.Before),
+		Limit:  limit + 1,
+	})
+	if err != nil {
+		return err
+	}
+	var nextBefore *uint64
+	if uint64(len(messages)) > limit {
+		messages = messages[:limit]
+		nextBefore = conversion.ValueToPointer(messages[len(messages)-1].ID)
+	}
+	outs := make([]*api.TeamMessage, len(messages))
+	for i, message := range messages {
+		outs[i], err = unmarshalTeamMessage(message)
+		if err != nil {
+			return err
+		}
+	}
+	c.Out = &api.GetTeamMessagesResponse{
+		Success:    true,
+		Messages:   outs,
+		NextBefore: nextBefore,
+		Error:      api.GetTeamMessagesResponse_NONE,
+	}
+	return nil
+}
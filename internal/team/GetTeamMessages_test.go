@@ -0,0 +1,114 @@
+package team
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/team/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+var (
+	teamMessage = []string{"id", "team_id", "user_id", "content", "pinned", "data", "created_at", "updated_at"}
+)
+
+func TestGetTeamMessagesNoFieldSpecified(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewGetTeamMessagesCommand(service, &api.GetTeamMessagesRequest{
+		Team: &api.TeamRequest{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTeamMessagesResponse_NO_FIELD_SPECIFIED {
+		t.Fatal("Expected error to be NO_FIELD_SPECIFIED")
+	}
+}
+
+func TestGetTeamMessagesById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM `team_message`").WithArgs(1, 11).WillReturnRows(sqlmock.NewRows(teamMessage).
+		AddRow(5, 1, 2, "Hello", true, json.RawMessage("{}"), time.Now(), time.Now()).
+		AddRow(4, 1, 3, "World", false, json.RawMessage("{}"), time.Now(), time.Now()))
+	c := NewGetTeamMessagesCommand(service, &api.GetTeamMessagesRequest{
+		Team: &api.TeamRequest{
+			Id: conversion.ValueToPointer(uint64(1)),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.Messages) != 2 {
+		t.Fatal("Expected 2 messages")
+	}
+	if c.Out.Messages[0].Content != "Hello" || c.Out.Messages[0].Pinned != true {
+		t.Fatal("Expected first message to be pinned and have content Hello")
+	}
+	if c.Out.NextBefore != nil {
+		t.Fatal("Expected next before to be nil")
+	}
+	if c.Out.Error != api.GetTeamMessagesResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+}
+
+func TestGetTeamMessagesNextPage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM `team_message`").WithArgs(10, 1, 2).WillReturnRows(sqlmock.NewRows(teamMessage).
+		AddRow(9, 1, 2, "Hello", false, json.RawMessage("{}"), time.Now(), time.Now()).
+		AddRow(8, 1, 3, "World", false, json.RawMessage("{}"), time.Now(), time.Now()))
+	c := NewGetTeamMessagesCommand(service, &api.GetTeamMessagesRequest{
+		Team: &api.TeamRequest{
+			Id: conversion.ValueToPointer(uint64(1)),
+		},
+		Before: conversion.ValueToPointer(uint64(10)),
+		Max:    conversion.ValueToPointer(uint32(1)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.Messages) != 1 {
+		t.Fatal("Expected 1 message")
+	}
+	if c.Out.NextBefore == nil || *c.Out.NextBefore != 9 {
+		t.Fatal("Expected next before to be 9")
+	}
+}
@@ -0,0 +1,67 @@
+package team
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/team/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type GetTeamSeasonHistoryCommand struct {
+	service *Service
+	In      *api.GetTeamSeasonHistoryRequest
+	Out     *api.GetTeamSeasonHistoryResponse
+}
+
+func NewGetTeamSeasonHistoryCommand(service *Service, in *api.GetTeamSeasonHistoryRequest) *GetTeamSeasonHistoryCommand {
+	return &GetTeamSeasonHistoryCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetTeamSeasonHistoryCommand) Execute(ctx context.Context) error {
+	tErr := c.service.checkForTeamRequestError(c.In.Team)
+	// Check if error is found
+	if tErr != nil {
+		c.Out = &api.GetTeamSeasonHistoryResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.GetTeamSeasonHistoryResponse_Error_value, api.GetTeamSeasonHistoryResponse_NO_FIELD_SPECIFIED),
+		}
+		return nil
+	}
+	// Check if team member is initialised
+	if c.In.Team.Member == nil {
+		c.In.Team.Member = &api.TeamMemberRequest{}
+	}
+	limit, offset := conversion.PaginationToLimitOffset(c.In.Pagination, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
+	seasons, err := c.service.database.GetTeamSeasons(ctx, model.GetTeamSeasonsParams{
+		Team: model.TeamParams{
+			ID:   conversion.Uint64ToSqlNullInt64(c.In.Team.Id),
+			Name: conversion.StringToSqlNullString(c.In.Team.Name),
+			Member: model.GetTeamMemberParams{
+				ID:     conversion.Uint64ToSqlNullInt64(c.In.Team.Member.Id),
+				UserID: conversion.Uint64ToSqlNullInt64(c.In.Team.Member.UserId),
+			},
+		},
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return err
+	}
+	outs := make([]*api.TeamSeason, len(seasons))
+	for i, season := range seasons {
+		outs[i], err = unmarshalTeamSeason(season)
+		if err != nil {
+			return err
+		}
+	}
+	c.Out = &api.GetTeamSeasonHistoryResponse{
+		Success: true,
+		Seasons: outs,
+		Error:   api.GetTeamSeasonHistoryResponse_NONE,
+	}
+	return nil
+}
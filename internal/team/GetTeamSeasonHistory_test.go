@@ -0,0 +1,132 @@
+package team
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/team/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+var (
+	teamSeason = []string{"id", "season_label", "team_id", "name", "score", "ranking", "data", "archived_at"}
+)
+
+func TestGetTeamSeasonHistoryNoFieldSpecified(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewGetTeamSeasonHistoryCommand(service, &api.GetTeamSeasonHistoryRequest{
+		Team: &api.TeamRequest{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTeamSeasonHistoryResponse_NO_FIELD_SPECIFIED {
+		t.Fatal("Expected error to be NO_FIELD_SPECIFIED")
+	}
+}
+
+func TestGetTeamSeasonHistoryNameTooShort(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTeamNameLength(3))
+	c := NewGetTeamSeasonHistoryCommand(service, &api.GetTeamSeasonHistoryRequest{
+		Team: &api.TeamRequest{
+			Name: conversion.ValueToPointer("a"),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTeamSeasonHistoryResponse_NAME_TOO_SHORT {
+		t.Fatal("Expected error to be NAME_TOO_SHORT")
+	}
+}
+
+func TestGetTeamSeasonHistoryById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM `team_season`").WithArgs(1, 10).WillReturnRows(sqlmock.NewRows(teamSeason).
+		AddRow(2, "season-2", 1, "test", 40, 1, json.RawMessage("{}"), time.Now()).
+		AddRow(1, "season-1", 1, "test", 25, 3, json.RawMessage("{}"), time.Now().Add(-time.Hour)))
+	c := NewGetTeamSeasonHistoryCommand(service, &api.GetTeamSeasonHistoryRequest{
+		Team: &api.TeamRequest{
+			Id: conversion.ValueToPointer(uint64(1)),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.Seasons) != 2 {
+		t.Fatal("Expected 2 seasons")
+	}
+	if c.Out.Seasons[0].SeasonLabel != "season-2" || c.Out.Seasons[0].Ranking != 1 {
+		t.Fatal("Expected most recent season to be first")
+	}
+	if c.Out.Error != api.GetTeamSeasonHistoryResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+}
+
+func TestGetTeamSeasonHistoryByMemberUserId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM `team_season`").WithArgs(2, 1, 10).WillReturnRows(sqlmock.NewRows(teamSeason))
+	c := NewGetTeamSeasonHistoryCommand(service, &api.GetTeamSeasonHistoryRequest{
+		Team: &api.TeamRequest{
+			Member: &api.TeamMemberRequest{
+				UserId: conversion.ValueToPointer(uint64(2)),
+			},
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.Seasons) != 0 {
+		t.Fatal("Expected no seasons")
+	}
+}
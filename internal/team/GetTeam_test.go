@@ -14,7 +14,7 @@ import (
 )
 
 var (
-	rankedTeamWithMember = []string{"id", "name", "score", "ranking", "data", "created_at", "updated_at", "member_id", "user_id", "member_number", "member_data", "joined_at", "member_updated_at", "member_number_without_gaps"}
+	rankedTeamWithMember = []string{"id", "name", "score", "ranking", "owner_user_id", "data", "created_at", "updated_at", "member_id", "user_id", "member_number", "member_data", "joined_at", "member_updated_at", "member_number_without_gaps"}
 )
 
 func TestGetTeamById(t *testing.T) {
@@ -34,7 +34,7 @@ func TestGetTeamById(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithDefaultMaxPageLength(1))
-	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	c := NewGetTeamCommand(service, &api.GetTeamRequest{
 		Team: &api.TeamRequest{
 			Id: conversion.ValueToPointer(uint64(1)),
@@ -78,7 +78,7 @@ func TestGetTeamByName(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithDefaultMaxPageLength(1))
-	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs("test", 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs("test", 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	c := NewGetTeamCommand(service, &api.GetTeamRequest{
 		Team: &api.TeamRequest{
 			Name: conversion.ValueToPointer("test"),
@@ -149,7 +149,7 @@ func TestGetTeamByMemberId(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithDefaultMaxPageLength(1))
-	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(2, 1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(2, 1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	c := NewGetTeamCommand(service, &api.GetTeamRequest{
 		Team: &api.TeamRequest{
 			Member: &api.TeamMemberRequest{
@@ -224,7 +224,7 @@ func TestGetTeamByMemberUserId(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithDefaultMaxPageLength(1))
-	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(2, 1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(2, 1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	c := NewGetTeamCommand(service, &api.GetTeamRequest{
 		Team: &api.TeamRequest{
 			Member: &api.TeamMemberRequest{
@@ -376,7 +376,7 @@ func Test_GetTeam_TeamHasMembers_ReturnTeamWithMembers(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithDefaultMaxPageLength(1))
-	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1).AddRow(1, "test", 0, 1, raw, time.Now(), time.Now(), 2, 2, 2, raw, time.Now(), time.Now(), 2).AddRow(1, "test", 0, 1, raw, time.Now(), time.Now(), 3, 3, 3, raw, time.Now(), time.Now(), 3))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1).AddRow(1, "test", 0, 1, 1, raw, time.Now(), time.Now(), 2, 2, 2, raw, time.Now(), time.Now(), 2).AddRow(1, "test", 0, 1, 1, raw, time.Now(), time.Now(), 3, 3, 3, raw, time.Now(), time.Now(), 3))
 	c := NewGetTeamCommand(service, &api.GetTeamRequest{
 		Team: &api.TeamRequest{
 			Id: conversion.ValueToPointer(uint64(1)),
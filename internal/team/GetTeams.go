@@ -6,6 +6,7 @@ import (
 	"github.com/MorhafAlshibly/coanda/api"
 	"github.com/MorhafAlshibly/coanda/internal/team/model"
 	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/goquOptions"
 )
 
 type GetTeamsCommand struct {
@@ -24,11 +25,30 @@ func NewGetTeamsCommand(service *Service, in *api.GetTeamsRequest) *GetTeamsComm
 func (c *GetTeamsCommand) Execute(ctx context.Context) error {
 	limit, offset := conversion.PaginationToLimitOffset(c.In.Pagination, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
 	memberLimit, memberOffset := conversion.PaginationToLimitOffset(c.In.MemberPagination, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
+	cursor, err := conversion.PaginationToCursor(c.In.Pagination)
+	if err != nil {
+		c.Out = &api.GetTeamsResponse{
+			Success: false,
+			Error:   api.GetTeamsResponse_INVALID_CURSOR,
+		}
+		return nil
+	}
+	// Seeking from a cursor replaces the page offset
+	var seek *goquOptions.Seek
+	if cursor != nil {
+		offset = 0
+		seek = &goquOptions.Seek{
+			Values: []interface{}{cursor.Key, cursor.ID},
+			Before: cursor.Before,
+		}
+	}
 	teams, err := c.service.database.GetTeams(ctx, model.GetTeamsParams{
-		MemberLimitPlusOffset: int64(memberLimit) + int64(memberOffset),
-		MemberOffset:          int64(memberOffset),
-		Limit:                 int32(limit),
-		Offset:                int32(offset),
+		MemberLimit:  memberLimit,
+		MemberOffset: memberOffset,
+		// Fetch one extra team to know whether there is another page
+		Limit:  limit + 1,
+		Offset: offset,
+		Seek:   seek,
 	})
 	if err != nil {
 		return err
@@ -37,9 +57,15 @@ func (c *GetTeamsCommand) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	outs, nextCursor, previousCursor := conversion.CursorPage(outs, limit, offset, cursor, func(team *api.Team) conversion.Cursor {
+		return conversion.Cursor{Key: team.Score, ID: team.Id}
+	})
 	c.Out = &api.GetTeamsResponse{
-		Success: true,
-		Teams:   outs,
+		Success:        true,
+		Teams:          outs,
+		Error:          api.GetTeamsResponse_NONE,
+		NextCursor:     nextCursor,
+		PreviousCursor: previousCursor,
 	}
 	return nil
 }
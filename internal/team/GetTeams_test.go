@@ -3,6 +3,7 @@ package team
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"reflect"
 	"testing"
 	"time"
@@ -31,7 +32,7 @@ func TestGetTeamsDefaultSettings(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithDefaultMaxPageLength(1))
-	mock.ExpectQuery("SELECT (.+) FROM ranked_team_with_member").WithArgs(1, 0, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 10, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 0, 2).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 10, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	c := NewGetTeamsCommand(service, &api.GetTeamsRequest{})
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
 	if err != nil {
@@ -77,7 +78,7 @@ func TestGetTeamsMultipleTeams(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithDefaultMaxPageLength(1))
-	mock.ExpectQuery("SELECT (.+) FROM ranked_team_with_member").WithArgs(1, 0, 2, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 10, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1).AddRow(2, "test2", 5, 2, raw, time.Now(), time.Now(), 2, 2, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 0, 3).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 10, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1).AddRow(2, "test2", 5, 2, 1, raw, time.Now(), time.Now(), 2, 2, 1, raw, time.Now(), time.Now(), 1))
 	c := NewGetTeamsCommand(service, &api.GetTeamsRequest{
 		Pagination: &api.Pagination{
 			Max: conversion.ValueToPointer(uint32(2)),
@@ -141,7 +142,7 @@ func TestGetTeamsMultipleTeamsWithPage(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithDefaultMaxPageLength(1))
-	mock.ExpectQuery("SELECT (.+) FROM ranked_team_with_member").WithArgs(1, 0, 2, 2).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 10, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1).AddRow(2, "test2", 5, 2, raw, time.Now(), time.Now(), 2, 2, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 0, 3, 2).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 10, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1).AddRow(2, "test2", 5, 2, 1, raw, time.Now(), time.Now(), 2, 2, 1, raw, time.Now(), time.Now(), 1))
 	c := NewGetTeamsCommand(service, &api.GetTeamsRequest{
 		Pagination: &api.Pagination{
 			Max:  conversion.ValueToPointer(uint32(2)),
@@ -206,7 +207,7 @@ func TestGetTeamsMultipleTeamsWithTooLargeMax(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithMaxMaxPageLength(1), WithDefaultMaxPageLength(1))
-	mock.ExpectQuery("SELECT (.+) FROM ranked_team_with_member").WithArgs(1, 0, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 10, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 0, 2).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 10, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	c := NewGetTeamsCommand(service, &api.GetTeamsRequest{
 		Pagination: &api.Pagination{
 			Max: conversion.ValueToPointer(uint32(2)),
@@ -247,7 +248,7 @@ func TestGetTeamsMultipleTeamsWithTooLargePage(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	mock.ExpectQuery("SELECT (.+) FROM ranked_team_with_member").WithArgs(service.defaultMaxPageLength, 0, service.defaultMaxPageLength, service.defaultMaxPageLength).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(service.defaultMaxPageLength, 0, service.defaultMaxPageLength+1, service.defaultMaxPageLength).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember))
 	c := NewGetTeamsCommand(service, &api.GetTeamsRequest{
 		Pagination: &api.Pagination{
 			Page: conversion.ValueToPointer(uint64(2)),
@@ -273,7 +274,7 @@ func TestGetTeamsNoTeams(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	mock.ExpectQuery("SELECT (.+) FROM ranked_team_with_member").WithArgs(service.defaultMaxPageLength, 0, service.defaultMaxPageLength, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(service.defaultMaxPageLength, 0, service.defaultMaxPageLength+1).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember))
 	c := NewGetTeamsCommand(service, &api.GetTeamsRequest{})
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
 	if err != nil {
@@ -304,26 +305,26 @@ func Test_GetTeams_MultipleTeamsWithMultipleMembers_TeamsWithMembersReturned(t *
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithDefaultMaxPageLength(5))
-	mock.ExpectQuery("SELECT (.+) FROM ranked_team_with_member").WithArgs(5, 0, 5, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRows(
-		[]driver.Value{1, "test", 10, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1},
-		[]driver.Value{1, "test", 10, 1, raw, time.Now(), time.Now(), 2, 2, 2, raw, time.Now(), time.Now(), 2},
-		[]driver.Value{1, "test", 10, 1, raw, time.Now(), time.Now(), 3, 3, 3, raw, time.Now(), time.Now(), 3},
-		[]driver.Value{1, "test", 10, 1, raw, time.Now(), time.Now(), 4, 4, 4, raw, time.Now(), time.Now(), 4},
-		[]driver.Value{2, "test2", 5, 2, raw, time.Now(), time.Now(), 5, 5, 1, raw, time.Now(), time.Now(), 1},
-		[]driver.Value{2, "test2", 5, 2, raw, time.Now(), time.Now(), 6, 6, 2, raw, time.Now(), time.Now(), 2},
-		[]driver.Value{2, "test2", 5, 2, raw, time.Now(), time.Now(), 7, 7, 3, raw, time.Now(), time.Now(), 3},
-		[]driver.Value{3, "test3", 2, 3, raw, time.Now(), time.Now(), 8, 8, 1, raw, time.Now(), time.Now(), 1},
-		[]driver.Value{3, "test3", 2, 3, raw, time.Now(), time.Now(), 9, 9, 2, raw, time.Now(), time.Now(), 2},
-		[]driver.Value{3, "test3", 2, 3, raw, time.Now(), time.Now(), 10, 10, 3, raw, time.Now(), time.Now(), 3},
-		[]driver.Value{3, "test3", 2, 3, raw, time.Now(), time.Now(), 11, 11, 4, raw, time.Now(), time.Now(), 4},
-		[]driver.Value{3, "test3", 2, 3, raw, time.Now(), time.Now(), 12, 12, 5, raw, time.Now(), time.Now(), 5},
-		[]driver.Value{4, "test4", 1, 4, raw, time.Now(), time.Now(), 13, 13, 1, raw, time.Now(), time.Now(), 1},
-		[]driver.Value{4, "test4", 1, 4, raw, time.Now(), time.Now(), 14, 14, 2, raw, time.Now(), time.Now(), 2},
-		[]driver.Value{5, "test5", 0, 5, raw, time.Now(), time.Now(), 15, 15, 1, raw, time.Now(), time.Now(), 1},
-		[]driver.Value{5, "test5", 0, 5, raw, time.Now(), time.Now(), 16, 16, 2, raw, time.Now(), time.Now(), 2},
-		[]driver.Value{5, "test5", 0, 5, raw, time.Now(), time.Now(), 17, 17, 3, raw, time.Now(), time.Now(), 3},
-		[]driver.Value{5, "test5", 0, 5, raw, time.Now(), time.Now(), 18, 18, 4, raw, time.Now(), time.Now(), 4},
-		[]driver.Value{5, "test5", 0, 5, raw, time.Now(), time.Now(), 19, 19, 5, raw, time.Now(), time.Now(), 5},
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(5, 0, 6).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRows(
+		[]driver.Value{1, "test", 10, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1},
+		[]driver.Value{1, "test", 10, 1, 1, raw, time.Now(), time.Now(), 2, 2, 2, raw, time.Now(), time.Now(), 2},
+		[]driver.Value{1, "test", 10, 1, 1, raw, time.Now(), time.Now(), 3, 3, 3, raw, time.Now(), time.Now(), 3},
+		[]driver.Value{1, "test", 10, 1, 1, raw, time.Now(), time.Now(), 4, 4, 4, raw, time.Now(), time.Now(), 4},
+		[]driver.Value{2, "test2", 5, 2, 1, raw, time.Now(), time.Now(), 5, 5, 1, raw, time.Now(), time.Now(), 1},
+		[]driver.Value{2, "test2", 5, 2, 1, raw, time.Now(), time.Now(), 6, 6, 2, raw, time.Now(), time.Now(), 2},
+		[]driver.Value{2, "test2", 5, 2, 1, raw, time.Now(), time.Now(), 7, 7, 3, raw, time.Now(), time.Now(), 3},
+		[]driver.Value{3, "test3", 2, 3, 1, raw, time.Now(), time.Now(), 8, 8, 1, raw, time.Now(), time.Now(), 1},
+		[]driver.Value{3, "test3", 2, 3, 1, raw, time.Now(), time.Now(), 9, 9, 2, raw, time.Now(), time.Now(), 2},
+		[]driver.Value{3, "test3", 2, 3, 1, raw, time.Now(), time.Now(), 10, 10, 3, raw, time.Now(), time.Now(), 3},
+		[]driver.Value{3, "test3", 2, 3, 1, raw, time.Now(), time.Now(), 11, 11, 4, raw, time.Now(), time.Now(), 4},
+		[]driver.Value{3, "test3", 2, 3, 1, raw, time.Now(), time.Now(), 12, 12, 5, raw, time.Now(), time.Now(), 5},
+		[]driver.Value{4, "test4", 1, 4, 1, raw, time.Now(), time.Now(), 13, 13, 1, raw, time.Now(), time.Now(), 1},
+		[]driver.Value{4, "test4", 1, 4, 1, raw, time.Now(), time.Now(), 14, 14, 2, raw, time.Now(), time.Now(), 2},
+		[]driver.Value{5, "test5", 0, 5, 1, raw, time.Now(), time.Now(), 15, 15, 1, raw, time.Now(), time.Now(), 1},
+		[]driver.Value{5, "test5", 0, 5, 1, raw, time.Now(), time.Now(), 16, 16, 2, raw, time.Now(), time.Now(), 2},
+		[]driver.Value{5, "test5", 0, 5, 1, raw, time.Now(), time.Now(), 17, 17, 3, raw, time.Now(), time.Now(), 3},
+		[]driver.Value{5, "test5", 0, 5, 1, raw, time.Now(), time.Now(), 18, 18, 4, raw, time.Now(), time.Now(), 4},
+		[]driver.Value{5, "test5", 0, 5, 1, raw, time.Now(), time.Now(), 19, 19, 5, raw, time.Now(), time.Now(), 5},
 	))
 	c := NewGetTeamsCommand(service, &api.GetTeamsRequest{})
 	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
@@ -541,3 +542,68 @@ func Test_GetTeams_MultipleTeamsWithMultipleMembers_TeamsWithMembersReturned(t *
 		t.Fatal("Expected member user id to be 19")
 	}
 }
+
+func TestGetTeamsAfterCursor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithDefaultMaxPageLength(1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member` (.+) WHERE \\(\\(`deleted_at` IS NULL\\) AND \\(\\(`score` < \\?\\) OR \\(\\(`score` = \\?\\) AND \\(`id` > \\?\\)\\)\\)\\)").WithArgs(1, 0, 10, 10, 1, 2).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).
+		AddRow(2, "test2", 5, 2, 1, json.RawMessage("{}"), time.Now(), time.Now(), 2, 2, 1, json.RawMessage("{}"), time.Now(), time.Now(), 1).
+		AddRow(3, "test3", 3, 3, 1, json.RawMessage("{}"), time.Now(), time.Now(), 3, 3, 1, json.RawMessage("{}"), time.Now(), time.Now(), 1))
+	c := NewGetTeamsCommand(service, &api.GetTeamsRequest{
+		Pagination: &api.Pagination{
+			After: conversion.ValueToPointer(conversion.EncodeCursor(conversion.Cursor{Key: 10, ID: 1})),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.Teams) != 1 || c.Out.Teams[0].Id != 2 {
+		t.Fatal("Expected teams to have the team after the cursor")
+	}
+	cursor, err := conversion.DecodeCursor(*c.Out.NextCursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor.Key != 5 || cursor.ID != 2 {
+		t.Fatal("Expected next cursor to point at the last team of the page")
+	}
+	if c.Out.PreviousCursor == nil {
+		t.Fatal("Expected previous cursor to be set")
+	}
+}
+
+func TestGetTeamsInvalidCursor(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewGetTeamsCommand(service, &api.GetTeamsRequest{
+		Pagination: &api.Pagination{
+			Before: conversion.ValueToPointer("%%%"),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTeamsResponse_INVALID_CURSOR {
+		t.Fatal("Expected error to be INVALID_CURSOR")
+	}
+}
@@ -99,6 +99,11 @@ func (c *JoinTeamCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
+	// The user leaves a team pending deletion if they are still in one, so it is restored without them
+	_, err = qtx.DeleteTeamMemberOfDeletedTeam(ctx, c.In.UserId)
+	if err != nil {
+		return err
+	}
 	// Add the member to the team
 	result, err := qtx.CreateTeamMember(ctx, model.CreateTeamMemberParams{
 		UserID:       c.In.UserId,
@@ -133,6 +138,18 @@ func (c *JoinTeamCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
+	// If the team has no owner, such as a team created before owners were kept, the longest tenured member takes ownership
+	owner, err := qtx.GetLongestTenuredTeamMember(ctx, team[0].ID)
+	if err != nil {
+		return err
+	}
+	_, err = qtx.ClaimTeamOwnership(ctx, model.ClaimTeamOwnershipParams{
+		ID:          team[0].ID,
+		OwnerUserID: conversion.Uint64ToSqlNullInt64(&owner.UserID),
+	})
+	if err != nil {
+		return err
+	}
 	err = tx.Commit()
 	if err != nil {
 		return err
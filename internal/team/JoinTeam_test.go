@@ -33,9 +33,12 @@ func TestJoinTeamByName(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs("test", 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(2, "test", 10, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs("test", 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(2, "test", 10, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	mock.ExpectQuery("SELECT (.+) FROM team_with_first_open_member").WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"first_open_member"}).AddRow(1))
+	mock.ExpectExec("DELETE FROM team_member").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectExec("INSERT INTO team_member").WithArgs(1, 2, 1, raw).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM team_member").WithArgs(2).WillReturnRows(sqlmock.NewRows(teamMember).AddRow(1, 1, 2, 1, raw, time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE team").WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectCommit()
 	c := NewJoinTeamCommand(service, &api.JoinTeamRequest{
 		Team: &api.TeamRequest{
@@ -74,9 +77,12 @@ func TestJoinTeamByMemberId(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(2, "test", 10, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(2, "test", 10, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	mock.ExpectQuery("SELECT (.+) FROM team_with_first_open_member").WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"first_open_member"}).AddRow(1))
+	mock.ExpectExec("DELETE FROM team_member").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectExec("INSERT INTO team_member").WithArgs(1, 2, 1, raw).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM team_member").WithArgs(2).WillReturnRows(sqlmock.NewRows(teamMember).AddRow(1, 1, 2, 1, raw, time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE team").WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectCommit()
 	c := NewJoinTeamCommand(service, &api.JoinTeamRequest{
 		Team: &api.TeamRequest{
@@ -154,8 +160,9 @@ func TestJoinTeamByMemberIdAlreadyExists(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(2, "test", 10, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(2, "test", 10, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	mock.ExpectQuery("SELECT (.+) FROM team_with_first_open_member").WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"first_open_member"}).AddRow(1))
+	mock.ExpectExec("DELETE FROM team_member").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectExec("INSERT INTO team_member").WithArgs(1, 2, 1, raw).WillReturnError(&mysql.MySQLError{Number: errorcode.MySQLErrorCodeDuplicateEntry, Message: "Duplicate entry '1' for key 'team_member.team_member_user_id_idx'"})
 	mock.ExpectRollback()
 	c := NewJoinTeamCommand(service, &api.JoinTeamRequest{
@@ -198,7 +205,7 @@ func TestJoinTeamByMemberTeamFull(t *testing.T) {
 		WithMaxMembers(3),
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(2, "test", 10, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(2, "test", 10, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	mock.ExpectQuery("SELECT (.+) FROM team_with_first_open_member").WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"first_open_member"}).AddRow(4))
 	mock.ExpectRollback()
 	c := NewJoinTeamCommand(service, &api.JoinTeamRequest{
@@ -2,6 +2,7 @@ package team
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/MorhafAlshibly/coanda/api"
 	"github.com/MorhafAlshibly/coanda/internal/team/model"
@@ -37,41 +38,44 @@ func (c *LeaveTeamCommand) Execute(ctx context.Context) error {
 	}
 	defer tx.Rollback()
 	qtx := c.service.database.WithTx(tx)
-	result, err := qtx.DeleteTeamMember(ctx, model.GetTeamMemberParams{
+	// Get the member first so we know which team they are leaving
+	member, err := qtx.GetTeamMember(ctx, model.GetTeamMemberParams{
 		ID:     conversion.Uint64ToSqlNullInt64(c.In.Id),
 		UserID: conversion.Uint64ToSqlNullInt64(c.In.UserId),
 	})
 	if err != nil {
-		return err
-	}
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return err
-	}
-	if rowsAffected == 0 {
-		c.Out = &api.LeaveTeamResponse{
-			Success: false,
-			Error:   api.LeaveTeamResponse_NOT_FOUND,
+		if err == sql.ErrNoRows {
+			c.Out = &api.LeaveTeamResponse{
+				Success: false,
+				Error:   api.LeaveTeamResponse_NOT_FOUND,
+			}
+			return nil
 		}
-		return nil
+		return err
 	}
-	// Check if that was the last member of the team
-	teamMembers, err := qtx.GetTeamMembers(ctx, model.GetTeamMembersParams{
-		Team: model.TeamParams{
-			Member: model.GetTeamMemberParams{
-				ID:     conversion.Uint64ToSqlNullInt64(c.In.Id),
-				UserID: conversion.Uint64ToSqlNullInt64(c.In.UserId),
-			},
-		},
-		Limit:  1,
-		Offset: 0,
+	_, err = qtx.DeleteTeamMember(ctx, model.GetTeamMemberParams{
+		ID: conversion.Uint64ToSqlNullInt64(&member.ID),
 	})
 	if err != nil {
 		return err
 	}
-	if len(teamMembers) == 0 {
-		_, err = qtx.DeleteTeam(ctx, model.TeamParams{
-			ID: conversion.Uint64ToSqlNullInt64(c.In.Id),
+	// Hand ownership over to the longest tenured member remaining
+	nextOwner, err := qtx.GetLongestTenuredTeamMember(ctx, member.TeamID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		// That was the last member of the team, so the team is soft deleted until the cleanup job removes it
+		_, err = qtx.SoftDeleteTeam(ctx, member.TeamID)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Only transfers ownership if the leaving member was the owner
+		_, err = qtx.TransferTeamOwnership(ctx, model.TransferTeamOwnershipParams{
+			ID:                  member.TeamID,
+			OwnerUserID:         conversion.Uint64ToSqlNullInt64(&nextOwner.UserID),
+			PreviousOwnerUserID: conversion.Uint64ToSqlNullInt64(&member.UserID),
 		})
 		if err != nil {
 			return err
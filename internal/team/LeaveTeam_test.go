@@ -23,8 +23,10 @@ func TestLeaveTeamById(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM `team_member`").WithArgs(3, 1).WillReturnRows(sqlmock.NewRows(teamMember).AddRow(3, 5, 2, 1, json.RawMessage("{}"), time.Now(), time.Now()))
 	mock.ExpectExec("DELETE FROM `team_member`").WithArgs(3, 1).WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectQuery("SELECT (.+) FROM `team_member`").WithArgs(3, 1, 1).WillReturnRows(sqlmock.NewRows(teamMember).AddRow(1, 2, 3, 1, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT (.+) FROM team_member").WithArgs(2).WillReturnRows(sqlmock.NewRows(teamMember).AddRow(4, 6, 2, 2, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE team").WithArgs(6, 2, 5).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c := NewLeaveTeamCommand(service, &api.TeamMemberRequest{
 		Id: conversion.ValueToPointer(uint64(3)),
@@ -51,9 +53,10 @@ func TestLeaveTeamByIdLastMember(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM `team_member`").WithArgs(3, 1).WillReturnRows(sqlmock.NewRows(teamMember).AddRow(3, 5, 2, 1, json.RawMessage("{}"), time.Now(), time.Now()))
 	mock.ExpectExec("DELETE FROM `team_member`").WithArgs(3, 1).WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectQuery("SELECT (.+) FROM `team_member`").WithArgs(3, 1, 1).WillReturnRows(sqlmock.NewRows(teamMember))
-	mock.ExpectExec("DELETE FROM `team`").WithArgs(3, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM team_member").WithArgs(2).WillReturnRows(sqlmock.NewRows(teamMember))
+	mock.ExpectExec("UPDATE team SET deleted_at").WithArgs(2).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c := NewLeaveTeamCommand(service, &api.TeamMemberRequest{
 		Id: conversion.ValueToPointer(uint64(3)),
@@ -80,8 +83,10 @@ func TestLeaveTeamByUserId(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectExec("DELETE FROM `team_member`").WithArgs(3, 1).WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectQuery("SELECT (.+) FROM `team_member`").WithArgs(3, 1, 1).WillReturnRows(sqlmock.NewRows(teamMember).AddRow(1, 2, 3, 1, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT (.+) FROM `team_member`").WithArgs(3, 1).WillReturnRows(sqlmock.NewRows(teamMember).AddRow(7, 3, 2, 2, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectExec("DELETE FROM `team_member`").WithArgs(7, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM team_member").WithArgs(2).WillReturnRows(sqlmock.NewRows(teamMember).AddRow(1, 2, 2, 1, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE team").WithArgs(2, 2, 3).WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectCommit()
 	c := NewLeaveTeamCommand(service, &api.TeamMemberRequest{
 		UserId: conversion.ValueToPointer(uint64(3)),
@@ -108,7 +113,7 @@ func TestLeaveTeamByIdNotFound(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectExec("DELETE FROM `team_member`").WithArgs(3, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT (.+) FROM `team_member`").WithArgs(3, 1).WillReturnRows(sqlmock.NewRows(teamMember))
 	mock.ExpectRollback()
 	c := NewLeaveTeamCommand(service, &api.TeamMemberRequest{
 		Id: conversion.ValueToPointer(uint64(3)),
@@ -135,7 +140,7 @@ func TestLeaveTeamByUserIdNotFound(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectExec("DELETE FROM `team_member`").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT (.+) FROM `team_member`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(teamMember))
 	mock.ExpectRollback()
 	c := NewLeaveTeamCommand(service, &api.TeamMemberRequest{
 		UserId: conversion.ValueToPointer(uint64(1)),
@@ -0,0 +1,122 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+	"unicode/utf8"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/team/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type PostTeamMessageCommand struct {
+	service *Service
+	In      *api.PostTeamMessageRequest
+	Out     *api.PostTeamMessageResponse
+}
+
+func NewPostTeamMessageCommand(service *Service, in *api.PostTeamMessageRequest) *PostTeamMessageCommand {
+	return &PostTeamMessageCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *PostTeamMessageCommand) Execute(ctx context.Context) error {
+	tmErr := c.service.checkForTeamMemberRequestError(c.In.Member)
+	// Check if error is found
+	if tmErr != nil {
+		c.Out = &api.PostTeamMessageResponse{
+			Success: false,
+			Error:   conversion.Enum(*tmErr, api.PostTeamMessageResponse_Error_value, api.PostTeamMessageResponse_NO_FIELD_SPECIFIED),
+		}
+		return nil
+	}
+	// Check if content is provided
+	if len(c.In.Content) == 0 {
+		c.Out = &api.PostTeamMessageResponse{
+			Success: false,
+			Error:   api.PostTeamMessageResponse_CONTENT_REQUIRED,
+		}
+		return nil
+	}
+	if utf8.RuneCountInString(c.In.Content) > int(c.service.maxMessageLength) {
+		c.Out = &api.PostTeamMessageResponse{
+			Success: false,
+			Error:   api.PostTeamMessageResponse_CONTENT_TOO_LONG,
+		}
+		return nil
+	}
+	// Check if data is provided
+	if c.In.Data == nil {
+		c.Out = &api.PostTeamMessageResponse{
+			Success: false,
+			Error:   api.PostTeamMessageResponse_DATA_REQUIRED,
+		}
+		return nil
+	}
+	data, err := conversion.ProtobufStructToRawJson(c.In.Data)
+	if err != nil {
+		return err
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	// Only current members of a team can post to it
+	member, err := qtx.GetTeamMember(ctx, model.GetTeamMemberParams{
+		ID:     conversion.Uint64ToSqlNullInt64(c.In.Member.Id),
+		UserID: conversion.Uint64ToSqlNullInt64(c.In.Member.UserId),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.PostTeamMessageResponse{
+				Success: false,
+				Error:   api.PostTeamMessageResponse_NOT_IN_A_TEAM,
+			}
+			return nil
+		}
+		return err
+	}
+	// Only the team owner can pin a message
+	if conversion.PointerBoolToValue(c.In.Pinned) {
+		owner, err := isTeamOwner(ctx, qtx, member.TeamID, member.UserID)
+		if err != nil {
+			return err
+		}
+		if !owner {
+			c.Out = &api.PostTeamMessageResponse{
+				Success: false,
+				Error:   api.PostTeamMessageResponse_NOT_ALLOWED,
+			}
+			return nil
+		}
+	}
+	result, err := qtx.CreateTeamMessage(ctx, model.CreateTeamMessageParams{
+		TeamID:  member.TeamID,
+		UserID:  member.UserID,
+		Content: c.In.Content,
+		Pinned:  conversion.PointerBoolToValue(c.In.Pinned),
+		Data:    data,
+	})
+	if err != nil {
+		return err
+	}
+	messageID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.PostTeamMessageResponse{
+		Success: true,
+		Id:      conversion.ValueToPointer(uint64(messageID)),
+		Error:   api.PostTeamMessageResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package team
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/team/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestPostTeamMessageNoFieldSpecified(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewPostTeamMessageCommand(service, &api.PostTeamMessageRequest{})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.PostTeamMessageResponse_NO_FIELD_SPECIFIED {
+		t.Fatal("Expected error to be NO_FIELD_SPECIFIED")
+	}
+}
+
+func TestPostTeamMessageNoContent(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewPostTeamMessageCommand(service, &api.PostTeamMessageRequest{
+		Member: &api.TeamMemberRequest{
+			UserId: conversion.ValueToPointer(uint64(1)),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.PostTeamMessageResponse_CONTENT_REQUIRED {
+		t.Fatal("Expected error to be CONTENT_REQUIRED")
+	}
+}
+
+func TestPostTeamMessageContentTooLong(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMaxMessageLength(5))
+	c := NewPostTeamMessageCommand(service, &api.PostTeamMessageRequest{
+		Member: &api.TeamMemberRequest{
+			UserId: conversion.ValueToPointer(uint64(1)),
+		},
+		Content: strings.Repeat("a", 6),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.PostTeamMessageResponse_CONTENT_TOO_LONG {
+		t.Fatal("Expected error to be CONTENT_TOO_LONG")
+	}
+}
+
+func TestPostTeamMessageNoData(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewPostTeamMessageCommand(service, &api.PostTeamMessageRequest{
+		Member: &api.TeamMemberRequest{
+			UserId: conversion.ValueToPointer(uint64(1)),
+		},
+		Content: "Hello",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.PostTeamMessageResponse_DATA_REQUIRED {
+		t.Fatal("Expected error to be DATA_REQUIRED")
+	}
+}
+
+func TestPostTeamMessageNotInATeam(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM `team_member`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(teamMember))
+	mock.ExpectRollback()
+	c := NewPostTeamMessageCommand(service, &api.PostTeamMessageRequest{
+		Member: &api.TeamMemberRequest{
+			UserId: conversion.ValueToPointer(uint64(1)),
+		},
+		Content: "Hello",
+		Data:    &structpb.Struct{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.PostTeamMessageResponse_NOT_IN_A_TEAM {
+		t.Fatal("Expected error to be NOT_IN_A_TEAM")
+	}
+}
+
+func TestPostTeamMessageSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM `team_member`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(teamMember).AddRow(2, 1, 3, 1, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectExec("INSERT INTO team_message").WithArgs(3, 1, "Hello", false, json.RawMessage("{}")).WillReturnResult(sqlmock.NewResult(4, 1))
+	mock.ExpectCommit()
+	c := NewPostTeamMessageCommand(service, &api.PostTeamMessageRequest{
+		Member: &api.TeamMemberRequest{
+			UserId: conversion.ValueToPointer(uint64(1)),
+		},
+		Content: "Hello",
+		Data:    &structpb.Struct{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if *c.Out.Id != 4 {
+		t.Fatal("Expected id to be 4")
+	}
+	if c.Out.Error != api.PostTeamMessageResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+}
+
+func TestPostTeamMessagePinnedNotTeamOwner(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM `team_member`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows(teamMember).AddRow(2, 1, 3, 1, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT owner_user_id FROM team").WithArgs(3).WillReturnRows(sqlmock.NewRows([]string{"owner_user_id"}).AddRow(6))
+	mock.ExpectRollback()
+	c := NewPostTeamMessageCommand(service, &api.PostTeamMessageRequest{
+		Member: &api.TeamMemberRequest{
+			UserId: conversion.ValueToPointer(uint64(1)),
+		},
+		Content: "Hello",
+		Data:    &structpb.Struct{},
+		Pinned:  conversion.ValueToPointer(true),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.PostTeamMessageResponse_NOT_ALLOWED {
+		t.Fatal("Expected error to be NOT_ALLOWED")
+	}
+}
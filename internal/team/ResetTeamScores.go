@@ -0,0 +1,79 @@
+package team
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
+	"github.com/go-sql-driver/mysql"
+)
+
+type ResetTeamScoresCommand struct {
+	service *Service
+	In      *api.ResetTeamScoresRequest
+	Out     *api.ResetTeamScoresResponse
+}
+
+func NewResetTeamScoresCommand(service *Service, in *api.ResetTeamScoresRequest) *ResetTeamScoresCommand {
+	return &ResetTeamScoresCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *ResetTeamScoresCommand) Execute(ctx context.Context) error {
+	// Check if season label is provided
+	if len(c.In.SeasonLabel) == 0 {
+		c.Out = &api.ResetTeamScoresResponse{
+			Success: false,
+			Error:   api.ResetTeamScoresResponse_SEASON_LABEL_REQUIRED,
+		}
+		return nil
+	}
+	if len(c.In.SeasonLabel) > int(c.service.maxSeasonLabelLength) {
+		c.Out = &api.ResetTeamScoresResponse{
+			Success: false,
+			Error:   api.ResetTeamScoresResponse_SEASON_LABEL_TOO_LONG,
+		}
+		return nil
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	// Snapshot the current standings before any score is reset
+	result, err := qtx.ArchiveTeamSeason(ctx, c.In.SeasonLabel)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == errorcode.MySQLErrorCodeDuplicateEntry {
+			c.Out = &api.ResetTeamScoresResponse{
+				Success: false,
+				Error:   api.ResetTeamScoresResponse_SEASON_LABEL_TAKEN,
+			}
+			return nil
+		}
+		return err
+	}
+	teamsArchived, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	_, err = qtx.ResetTeamScores(ctx)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.ResetTeamScoresResponse{
+		Success:       true,
+		TeamsArchived: conversion.ValueToPointer(uint64(teamsArchived)),
+		Error:         api.ResetTeamScoresResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package team
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/team/model"
+	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestResetTeamScoresNoSeasonLabel(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewResetTeamScoresCommand(service, &api.ResetTeamScoresRequest{})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.ResetTeamScoresResponse_SEASON_LABEL_REQUIRED {
+		t.Fatal("Expected error to be SEASON_LABEL_REQUIRED")
+	}
+}
+
+func TestResetTeamScoresSeasonLabelTooLong(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMaxSeasonLabelLength(5))
+	c := NewResetTeamScoresCommand(service, &api.ResetTeamScoresRequest{
+		SeasonLabel: strings.Repeat("a", 6),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.ResetTeamScoresResponse_SEASON_LABEL_TOO_LONG {
+		t.Fatal("Expected error to be SEASON_LABEL_TOO_LONG")
+	}
+}
+
+func TestResetTeamScoresSeasonLabelTaken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO team_season").WithArgs("season-1").WillReturnError(&mysql.MySQLError{Number: errorcode.MySQLErrorCodeDuplicateEntry, Message: "Duplicate entry 'season-1-1' for key 'team_season.team_season_season_label_team_id_idx'"})
+	mock.ExpectRollback()
+	c := NewResetTeamScoresCommand(service, &api.ResetTeamScoresRequest{
+		SeasonLabel: "season-1",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.ResetTeamScoresResponse_SEASON_LABEL_TAKEN {
+		t.Fatal("Expected error to be SEASON_LABEL_TAKEN")
+	}
+}
+
+func TestResetTeamScoresSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO team_season").WithArgs("season-1").WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("UPDATE team").WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	c := NewResetTeamScoresCommand(service, &api.ResetTeamScoresRequest{
+		SeasonLabel: "season-1",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if *c.Out.TeamsArchived != 3 {
+		t.Fatal("Expected teams archived to be 3")
+	}
+	if c.Out.Error != api.ResetTeamScoresResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+}
@@ -0,0 +1,113 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/team/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type RestoreTeamCommand struct {
+	service *Service
+	In      *api.TeamRequest
+	Out     *api.TeamResponse
+}
+
+func NewRestoreTeamCommand(service *Service, in *api.TeamRequest) *RestoreTeamCommand {
+	return &RestoreTeamCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *RestoreTeamCommand) Execute(ctx context.Context) error {
+	tErr := c.service.checkForTeamRequestError(c.In)
+	// Check if error is found
+	if tErr != nil {
+		c.Out = &api.TeamResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.TeamResponse_Error_value, api.TeamResponse_NO_FIELD_SPECIFIED),
+		}
+		return nil
+	}
+	// Check if team member is initialised
+	if c.In.Member == nil {
+		c.In.Member = &api.TeamMemberRequest{}
+	}
+	teamParams := model.TeamParams{
+		ID:   conversion.Uint64ToSqlNullInt64(c.In.Id),
+		Name: conversion.StringToSqlNullString(c.In.Name),
+		Member: model.GetTeamMemberParams{
+			ID:     conversion.Uint64ToSqlNullInt64(c.In.Member.Id),
+			UserID: conversion.Uint64ToSqlNullInt64(c.In.Member.UserId),
+		},
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	result, err := qtx.RestoreTeam(ctx, teamParams)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	// If no rows are affected, the team is not found or is not pending deletion
+	if rowsAffected == 0 {
+		c.Out = &api.TeamResponse{
+			Success: false,
+			Error:   api.TeamResponse_NOT_FOUND,
+		}
+		return nil
+	}
+	team, err := qtx.GetTeam(ctx, model.GetTeamParams{
+		Team:   teamParams,
+		Limit:  1,
+		Offset: 0,
+	})
+	if err != nil {
+		return err
+	}
+	if len(team) == 0 {
+		c.Out = &api.TeamResponse{
+			Success: false,
+			Error:   api.TeamResponse_NOT_FOUND,
+		}
+		return nil
+	}
+	// A team without members would stay restored with nobody to leave it, so it is left pending deletion
+	owner, err := qtx.GetLongestTenuredTeamMember(ctx, team[0].ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.TeamResponse{
+				Success: false,
+				Error:   api.TeamResponse_NO_MEMBERS,
+			}
+			return nil
+		}
+		return err
+	}
+	// If the owner has joined another team since, the longest tenured member takes ownership
+	_, err = qtx.ClaimTeamOwnership(ctx, model.ClaimTeamOwnershipParams{
+		ID:          team[0].ID,
+		OwnerUserID: conversion.Uint64ToSqlNullInt64(&owner.UserID),
+	})
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.TeamResponse{
+		Success: true,
+		Error:   api.TeamResponse_NONE,
+	}
+	return nil
+}
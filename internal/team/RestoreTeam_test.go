@@ -0,0 +1,124 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/team/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestRestoreTeamNoFieldSpecified(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewRestoreTeamCommand(service, &api.TeamRequest{})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TeamResponse_NO_FIELD_SPECIFIED {
+		t.Fatal("Expected error to be NO_FIELD_SPECIFIED")
+	}
+}
+
+func TestRestoreTeamById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team` SET `deleted_at`=? WHERE ((`id` = ?) AND (`deleted_at` IS NOT NULL)) LIMIT ?")).WithArgs(nil, 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, 2, json.RawMessage("{}"), time.Now(), time.Now(), 1, 2, 1, json.RawMessage("{}"), time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM team_member").WithArgs(1).WillReturnRows(sqlmock.NewRows(teamMember).AddRow(1, 2, 1, 1, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE team").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	c := NewRestoreTeamCommand(service, &api.TeamRequest{
+		Id: conversion.ValueToPointer(uint64(1)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.Error != api.TeamResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+}
+
+func TestRestoreTeamByNameNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `team`").WithArgs(nil, "test", 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	c := NewRestoreTeamCommand(service, &api.TeamRequest{
+		Name: conversion.ValueToPointer("test"),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TeamResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
+
+func TestRestoreTeamByIdNoMembers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `team`").WithArgs(nil, 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(1, 1, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "test", 0, 1, 2, json.RawMessage("{}"), time.Now(), time.Now(), nil, nil, nil, json.RawMessage("{}"), nil, nil, 1))
+	mock.ExpectQuery("SELECT (.+) FROM team_member").WithArgs(1).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+	c := NewRestoreTeamCommand(service, &api.TeamRequest{
+		Id: conversion.ValueToPointer(uint64(1)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TeamResponse_NO_MEMBERS {
+		t.Fatal("Expected error to be NO_MEMBERS")
+	}
+}
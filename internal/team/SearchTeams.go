@@ -6,6 +6,7 @@ import (
 	"github.com/MorhafAlshibly/coanda/api"
 	"github.com/MorhafAlshibly/coanda/internal/team/model"
 	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/goquOptions"
 )
 
 type SearchTeamsCommand struct {
@@ -38,12 +39,31 @@ func (c *SearchTeamsCommand) Execute(ctx context.Context) error {
 	}
 	limit, offset := conversion.PaginationToLimitOffset(c.In.Pagination, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
 	memberLimit, memberOffset := conversion.PaginationToLimitOffset(c.In.MemberPagination, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
+	cursor, err := conversion.PaginationToCursor(c.In.Pagination)
+	if err != nil {
+		c.Out = &api.SearchTeamsResponse{
+			Success: false,
+			Error:   api.SearchTeamsResponse_INVALID_CURSOR,
+		}
+		return nil
+	}
+	// Seeking from a cursor replaces the page offset
+	var seek *goquOptions.Seek
+	if cursor != nil {
+		offset = 0
+		seek = &goquOptions.Seek{
+			Values: []interface{}{cursor.Key, cursor.ID},
+			Before: cursor.Before,
+		}
+	}
 	teams, err := c.service.database.SearchTeams(ctx, model.SearchTeamsParams{
 		Query:                 c.In.Query,
 		MemberLimitPlusOffset: int64(memberLimit) + int64(memberOffset),
 		MemberOffset:          int64(memberOffset),
-		Limit:                 int32(limit),
-		Offset:                int32(offset),
+		// Fetch one extra team to know whether there is another page
+		Limit:  int32(limit + 1),
+		Offset: int32(offset),
+		Seek:   seek,
 	})
 	if err != nil {
 		return err
@@ -52,10 +72,15 @@ func (c *SearchTeamsCommand) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	outs, nextCursor, previousCursor := conversion.CursorPage(outs, limit, offset, cursor, func(team *api.Team) conversion.Cursor {
+		return conversion.Cursor{Key: team.Score, ID: team.Id}
+	})
 	c.Out = &api.SearchTeamsResponse{
-		Success: true,
-		Teams:   outs,
-		Error:   api.SearchTeamsResponse_NONE,
+		Success:        true,
+		Teams:          outs,
+		Error:          api.SearchTeamsResponse_NONE,
+		NextCursor:     nextCursor,
+		PreviousCursor: previousCursor,
 	}
 	return nil
 
@@ -78,7 +78,7 @@ func TestSearchTeamsNoMaxOrPage(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithMinTeamNameLength(2), WithMaxTeamNameLength(6))
-	mock.ExpectQuery("SELECT (.+) FROM ranked_team_with_member").WithArgs("aaaa", service.defaultMaxPageLength, 0, "aaaa", service.defaultMaxPageLength, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "aaaaaaaa", 10, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(service.defaultMaxPageLength, 0, "%aaaa%", service.defaultMaxPageLength+1).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "aaaaaaaa", 10, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	c := NewSearchTeamsCommand(service, &api.SearchTeamsRequest{
 		Query: "aaaa",
 	})
@@ -126,7 +126,7 @@ func TestSearchTeamsNoPage(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithMinTeamNameLength(2), WithMaxTeamNameLength(6))
-	mock.ExpectQuery("SELECT (.+) FROM ranked_team_with_member").WithArgs("aaaa", service.defaultMaxPageLength, 0, "aaaa", 2, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "aaaaaaaa", 10, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(service.defaultMaxPageLength, 0, "%aaaa%", 3).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "aaaaaaaa", 10, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	c := NewSearchTeamsCommand(service, &api.SearchTeamsRequest{
 		Query:      "aaaa",
 		Pagination: &api.Pagination{Max: conversion.ValueToPointer(uint32(2))},
@@ -175,7 +175,7 @@ func TestSearchTeamsNoMax(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithMinTeamNameLength(2), WithMaxTeamNameLength(6), WithDefaultMaxPageLength(4))
-	mock.ExpectQuery("SELECT (.+) FROM ranked_team_with_member").WithArgs("aaaa", 4, 0, "aaaa", 4, 4).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "aaaaaaaa", 10, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(4, 0, "%aaaa%", 5, 4).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).AddRow(1, "aaaaaaaa", 10, 1, 1, raw, time.Now(), time.Now(), 1, 1, 1, raw, time.Now(), time.Now(), 1))
 	c := NewSearchTeamsCommand(service, &api.SearchTeamsRequest{
 		Query:      "aaaa",
 		Pagination: &api.Pagination{Page: conversion.ValueToPointer(uint64(2))},
@@ -216,7 +216,7 @@ func TestSearchTeamsNoTeams(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries), WithMinTeamNameLength(2), WithMaxTeamNameLength(6))
-	mock.ExpectQuery("SELECT (.+) FROM ranked_team_with_member").WithArgs("aaaa", service.defaultMaxPageLength, 0, "aaaa", service.defaultMaxPageLength, 0).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member`").WithArgs(service.defaultMaxPageLength, 0, "%aaaa%", service.defaultMaxPageLength+1).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember))
 	c := NewSearchTeamsCommand(service, &api.SearchTeamsRequest{
 		Query: "aaaa",
 	})
@@ -234,3 +234,78 @@ func TestSearchTeamsNoTeams(t *testing.T) {
 		t.Fatal("Expected teams to be 0")
 	}
 }
+
+func TestSearchTeamsAfterCursor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTeamNameLength(2), WithMaxTeamNameLength(6), WithDefaultMaxPageLength(1))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_team_with_member` (.+) WHERE \\(\\(`name` LIKE \\?\\) AND \\(`deleted_at` IS NULL\\) AND \\(\\(`score` < \\?\\) OR \\(\\(`score` = \\?\\) AND \\(`id` > \\?\\)\\)\\)\\)").WithArgs(1, 0, "%aaaa%", 10, 10, 1, 2).WillReturnRows(sqlmock.NewRows(rankedTeamWithMember).
+		AddRow(2, "aaaa2", 5, 2, 1, raw, time.Now(), time.Now(), 2, 2, 1, raw, time.Now(), time.Now(), 1).
+		AddRow(3, "aaaa3", 3, 3, 1, raw, time.Now(), time.Now(), 3, 3, 1, raw, time.Now(), time.Now(), 1))
+	c := NewSearchTeamsCommand(service, &api.SearchTeamsRequest{
+		Query: "aaaa",
+		Pagination: &api.Pagination{
+			After: conversion.ValueToPointer(conversion.EncodeCursor(conversion.Cursor{Key: 10, ID: 1})),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.Teams) != 1 || c.Out.Teams[0].Id != 2 {
+		t.Fatal("Expected teams to have the team after the cursor")
+	}
+	cursor, err := conversion.DecodeCursor(*c.Out.NextCursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor.Key != 5 || cursor.ID != 2 {
+		t.Fatal("Expected next cursor to point at the last team of the page")
+	}
+	if c.Out.PreviousCursor == nil {
+		t.Fatal("Expected previous cursor to be set")
+	}
+}
+
+func TestSearchTeamsInvalidCursor(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTeamNameLength(2), WithMaxTeamNameLength(6))
+	c := NewSearchTeamsCommand(service, &api.SearchTeamsRequest{
+		Query: "aaaa",
+		Pagination: &api.Pagination{
+			Before: conversion.ValueToPointer("%%%"),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.SearchTeamsResponse_INVALID_CURSOR {
+		t.Fatal("Expected error to be INVALID_CURSOR")
+	}
+}
@@ -77,7 +77,7 @@ func TestUpdateTeamMemberNotFound(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team_member` SET `data`=? WHERE (`user_id` = ?) LIMIT ?")).WithArgs(raw, 18, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team_member` SET `data`=? WHERE ((`user_id` = ?) AND (`team_id` IN ((SELECT `id` FROM `team` WHERE (`deleted_at` IS NULL))))) LIMIT ?")).WithArgs(raw, 18, 1).WillReturnResult(sqlmock.NewResult(0, 0))
 	c := NewUpdateTeamMemberCommand(service, &api.UpdateTeamMemberRequest{
 		Member: &api.TeamMemberRequest{
 			UserId: conversion.ValueToPointer(uint64(18)),
@@ -113,7 +113,7 @@ func TestUpdateTeamMemberById(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team_member` SET `data`=? WHERE (`id` = ?) LIMIT ?")).WithArgs(raw, 7, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team_member` SET `data`=? WHERE ((`id` = ?) AND (`team_id` IN ((SELECT `id` FROM `team` WHERE (`deleted_at` IS NULL))))) LIMIT ?")).WithArgs(raw, 7, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	c := NewUpdateTeamMemberCommand(service, &api.UpdateTeamMemberRequest{
 		Member: &api.TeamMemberRequest{
 			Id: conversion.ValueToPointer(uint64(7)),
@@ -149,7 +149,7 @@ func TestUpdateTeamMemberByUserId(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team_member` SET `data`=? WHERE (`user_id` = ?) LIMIT ?")).WithArgs(raw, 21, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team_member` SET `data`=? WHERE ((`user_id` = ?) AND (`team_id` IN ((SELECT `id` FROM `team` WHERE (`deleted_at` IS NULL))))) LIMIT ?")).WithArgs(raw, 21, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	c := NewUpdateTeamMemberCommand(service, &api.UpdateTeamMemberRequest{
 		Member: &api.TeamMemberRequest{
 			UserId: conversion.ValueToPointer(uint64(21)),
@@ -0,0 +1,134 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"unicode/utf8"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/team/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type UpdateTeamMessageCommand struct {
+	service *Service
+	In      *api.UpdateTeamMessageRequest
+	Out     *api.UpdateTeamMessageResponse
+}
+
+func NewUpdateTeamMessageCommand(service *Service, in *api.UpdateTeamMessageRequest) *UpdateTeamMessageCommand {
+	return &UpdateTeamMessageCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *UpdateTeamMessageCommand) Execute(ctx context.Context) error {
+	// Check if message id is provided
+	if c.In.Message == nil || c.In.Message.Id == 0 {
+		c.Out = &api.UpdateTeamMessageResponse{
+			Success: false,
+			Error:   api.UpdateTeamMessageResponse_ID_REQUIRED,
+		}
+		return nil
+	}
+	// Check if user id is provided, as only the author can edit a message and only the team owner can pin it
+	if c.In.UserId == 0 {
+		c.Out = &api.UpdateTeamMessageResponse{
+			Success: false,
+			Error:   api.UpdateTeamMessageResponse_USER_ID_REQUIRED,
+		}
+		return nil
+	}
+	// Check if no update is specified
+	if c.In.Content == nil && c.In.Data == nil && c.In.Pinned == nil {
+		c.Out = &api.UpdateTeamMessageResponse{
+			Success: false,
+			Error:   api.UpdateTeamMessageResponse_NO_UPDATE_SPECIFIED,
+		}
+		return nil
+	}
+	// Check if content is valid if it is being updated
+	if c.In.Content != nil {
+		if len(*c.In.Content) == 0 {
+			c.Out = &api.UpdateTeamMessageResponse{
+				Success: false,
+				Error:   api.UpdateTeamMessageResponse_CONTENT_REQUIRED,
+			}
+			return nil
+		}
+		if utf8.RuneCountInString(*c.In.Content) > int(c.service.maxMessageLength) {
+			c.Out = &api.UpdateTeamMessageResponse{
+				Success: false,
+				Error:   api.UpdateTeamMessageResponse_CONTENT_TOO_LONG,
+			}
+			return nil
+		}
+	}
+	// Prepare data
+	data := json.RawMessage(nil)
+	if c.In.Data != nil {
+		var err error
+		data, err = conversion.ProtobufStructToRawJson(c.In.Data)
+		if err != nil {
+			return err
+		}
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	message, err := qtx.GetTeamMessage(ctx, c.In.Message.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.UpdateTeamMessageResponse{
+				Success: false,
+				Error:   api.UpdateTeamMessageResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	// Only the author can change what the message says
+	if (c.In.Content != nil || c.In.Data != nil) && message.UserID != c.In.UserId {
+		c.Out = &api.UpdateTeamMessageResponse{
+			Success: false,
+			Error:   api.UpdateTeamMessageResponse_NOT_ALLOWED,
+		}
+		return nil
+	}
+	if c.In.Pinned != nil {
+		owner, err := isTeamOwner(ctx, qtx, message.TeamID, c.In.UserId)
+		if err != nil {
+			return err
+		}
+		if !owner {
+			c.Out = &api.UpdateTeamMessageResponse{
+				Success: false,
+				Error:   api.UpdateTeamMessageResponse_NOT_ALLOWED,
+			}
+			return nil
+		}
+	}
+	_, err = qtx.UpdateTeamMessage(ctx, model.UpdateTeamMessageParams{
+		ID:      c.In.Message.Id,
+		Content: conversion.StringToSqlNullString(c.In.Content),
+		Pinned:  conversion.BoolToSqlNullBool(c.In.Pinned),
+		Data:    data,
+	})
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.UpdateTeamMessageResponse{
+		Success: true,
+		Error:   api.UpdateTeamMessageResponse_NONE,
+	}
+	return nil
+}
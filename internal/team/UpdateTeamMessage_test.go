@@ -0,0 +1,263 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/team/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestUpdateTeamMessageNoId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewUpdateTeamMessageCommand(service, &api.UpdateTeamMessageRequest{})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTeamMessageResponse_ID_REQUIRED {
+		t.Fatal("Expected error to be ID_REQUIRED")
+	}
+}
+
+func TestUpdateTeamMessageNoUserId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewUpdateTeamMessageCommand(service, &api.UpdateTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+		Content: conversion.ValueToPointer("Hello"),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTeamMessageResponse_USER_ID_REQUIRED {
+		t.Fatal("Expected error to be USER_ID_REQUIRED")
+	}
+}
+
+func TestUpdateTeamMessageNoUpdateSpecified(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewUpdateTeamMessageCommand(service, &api.UpdateTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+		UserId:  5,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTeamMessageResponse_NO_UPDATE_SPECIFIED {
+		t.Fatal("Expected error to be NO_UPDATE_SPECIFIED")
+	}
+}
+
+func TestUpdateTeamMessageEmptyContent(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewUpdateTeamMessageCommand(service, &api.UpdateTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+		Content: conversion.ValueToPointer(""),
+		UserId:  5,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTeamMessageResponse_CONTENT_REQUIRED {
+		t.Fatal("Expected error to be CONTENT_REQUIRED")
+	}
+}
+
+func TestUpdateTeamMessagePin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM team_message").WithArgs(1).WillReturnRows(sqlmock.NewRows(teamMessage).AddRow(1, 3, 5, "Hello", false, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT owner_user_id FROM team").WithArgs(3).WillReturnRows(sqlmock.NewRows([]string{"owner_user_id"}).AddRow(6))
+	mock.ExpectExec("UPDATE `team_message`").WithArgs(true, 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	c := NewUpdateTeamMessageCommand(service, &api.UpdateTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+		Pinned:  conversion.ValueToPointer(true),
+		UserId:  6,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.Error != api.UpdateTeamMessageResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+}
+
+func TestUpdateTeamMessageNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM team_message").WithArgs(1).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+	c := NewUpdateTeamMessageCommand(service, &api.UpdateTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+		Content: conversion.ValueToPointer("Hello"),
+		UserId:  5,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTeamMessageResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
+
+func TestUpdateTeamMessagePinNotTeamOwner(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM team_message").WithArgs(1).WillReturnRows(sqlmock.NewRows(teamMessage).AddRow(1, 3, 5, "Hello", false, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT owner_user_id FROM team").WithArgs(3).WillReturnRows(sqlmock.NewRows([]string{"owner_user_id"}).AddRow(6))
+	mock.ExpectRollback()
+	c := NewUpdateTeamMessageCommand(service, &api.UpdateTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+		Pinned:  conversion.ValueToPointer(true),
+		UserId:  5,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTeamMessageResponse_NOT_ALLOWED {
+		t.Fatal("Expected error to be NOT_ALLOWED")
+	}
+}
+
+func TestUpdateTeamMessageContentNotAuthor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM team_message").WithArgs(1).WillReturnRows(sqlmock.NewRows(teamMessage).AddRow(1, 3, 5, "Hello", false, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectRollback()
+	c := NewUpdateTeamMessageCommand(service, &api.UpdateTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+		Content: conversion.ValueToPointer("Goodbye"),
+		UserId:  6,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTeamMessageResponse_NOT_ALLOWED {
+		t.Fatal("Expected error to be NOT_ALLOWED")
+	}
+}
+
+func TestUpdateTeamMessageContentByAuthor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMaxMessageLength(5))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM team_message").WithArgs(1).WillReturnRows(sqlmock.NewRows(teamMessage).AddRow(1, 3, 5, "Hello", false, json.RawMessage("{}"), time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE `team_message`").WithArgs("héllo", 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	// The content is five characters but six bytes
+	c := NewUpdateTeamMessageCommand(service, &api.UpdateTeamMessageRequest{
+		Message: &api.TeamMessageRequest{Id: 1},
+		Content: conversion.ValueToPointer("héllo"),
+		UserId:  5,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.Error != api.UpdateTeamMessageResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+}
@@ -133,7 +133,7 @@ func TestUpdateTeamById(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team` SET `data`=?,`score`=score + ? WHERE (`id` = ?) LIMIT ?")).WithArgs(raw, 2, 5, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team` SET `data`=?,`score`=score + ? WHERE ((`id` = ?) AND (`deleted_at` IS NULL)) LIMIT ?")).WithArgs(raw, 2, 5, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c := NewUpdateTeamCommand(service, &api.UpdateTeamRequest{
 		Team:           &api.TeamRequest{Id: conversion.ValueToPointer(uint64(5))},
@@ -198,7 +198,7 @@ func TestUpdateTeamByIdIncrementScoreFalse(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team` SET `score`=? WHERE (`id` = ?) LIMIT ?")).WithArgs(2, 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team` SET `score`=? WHERE ((`id` = ?) AND (`deleted_at` IS NULL)) LIMIT ?")).WithArgs(2, 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c := NewUpdateTeamCommand(service, &api.UpdateTeamRequest{
 		Team:           &api.TeamRequest{Id: conversion.ValueToPointer(uint64(1))},
@@ -235,7 +235,7 @@ func TestUpdateTeamByIdNoScoreSpecified(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team` SET `data`=? WHERE (`id` = ?) LIMIT ?")).WithArgs(raw, 9, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team` SET `data`=? WHERE ((`id` = ?) AND (`deleted_at` IS NULL)) LIMIT ?")).WithArgs(raw, 9, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c := NewUpdateTeamCommand(service, &api.UpdateTeamRequest{
 		Team:           &api.TeamRequest{Id: conversion.ValueToPointer(uint64(9))},
@@ -264,7 +264,7 @@ func TestUpdateTeamByIdNoDataSpecified(t *testing.T) {
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team` SET `score`=score + ? WHERE (`id` = ?) LIMIT ?")).WithArgs(2, 9, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `team` SET `score`=score + ? WHERE ((`id` = ?) AND (`deleted_at` IS NULL)) LIMIT ?")).WithArgs(2, 9, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c := NewUpdateTeamCommand(service, &api.UpdateTeamRequest{
 		Team:           &api.TeamRequest{Id: conversion.ValueToPointer(uint64(9))},
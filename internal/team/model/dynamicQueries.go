@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 
+	"github.com/MorhafAlshibly/coanda/pkg/goquOptions"
 	"github.com/doug-martin/goqu/v9"
 	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
 	"github.com/doug-martin/goqu/v9/exp"
@@ -12,6 +13,9 @@ import (
 
 var gq = goqu.Dialect("mysql")
 
+// activeTeamIDs are the teams not pending deletion, which every query on members and messages is limited to
+var activeTeamIDs = gq.From("team").Select("id").Where(goqu.C("deleted_at").IsNull())
+
 type GetTeamMemberParams struct {
 	ID     sql.NullInt64 `db:"id"`
 	UserID sql.NullInt64 `db:"user_id"`
@@ -26,7 +30,7 @@ func filterGetTeamMemberParams(arg GetTeamMemberParams) exp.Expression {
 	if arg.UserID.Valid {
 		expressions["user_id"] = arg.UserID
 	}
-	return expressions
+	return goqu.And(expressions, goqu.C("team_id").In(activeTeamIDs))
 }
 
 func (q *Queries) GetTeamMember(ctx context.Context, arg GetTeamMemberParams) (TeamMember, error) {
@@ -108,7 +112,7 @@ func filterGetTeamParams(arg GetTeamParams) (exp.Expression, exp.LiteralExpressi
 }
 
 func (q *Queries) GetTeam(ctx context.Context, arg GetTeamParams) ([]RankedTeamWithMember, error) {
-	team := gq.From("ranked_team_with_member").Prepared(true).Select("id", "name", "score", "ranking", "data", "created_at", "updated_at", "member_id", "user_id", "member_number", "member_data", "joined_at", "member_updated_at", "member_number_without_gaps")
+	team := gq.From("ranked_team_with_member").Prepared(true).Select("id", "name", "score", "ranking", "owner_user_id", "data", "created_at", "updated_at", "member_id", "user_id", "member_number", "member_data", "joined_at", "member_updated_at", "member_number_without_gaps")
 	query, args, err := team.Where(filterGetTeamParams(arg)).ToSQL()
 	if err != nil {
 		return nil, err
@@ -126,6 +130,84 @@ func (q *Queries) GetTeam(ctx context.Context, arg GetTeamParams) ([]RankedTeamW
 			&i.Name,
 			&i.Score,
 			&i.Ranking,
+			&i.OwnerUserID,
+			&i.Data,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.MemberID,
+			&i.UserID,
+			&i.MemberNumber,
+			&i.MemberData,
+			&i.JoinedAt,
+			&i.MemberUpdatedAt,
+			&i.MemberNumberWithoutGaps,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	return items, nil
+}
+
+type GetTeamsParams struct {
+	MemberLimit  uint64
+	MemberOffset uint64
+	Limit        uint64
+	Offset       uint64
+	Seek         *goquOptions.Seek
+}
+
+// Team lists are ordered by ranking, which matches the score index
+var teamSeekColumns = []goquOptions.SeekColumn{
+	{Name: "score", Descending: true},
+	{Name: "id"},
+}
+
+func (q *Queries) GetTeams(ctx context.Context, arg GetTeamsParams) ([]RankedTeamWithMember, error) {
+	// The page is picked from the team table first, so members do not count towards the limit
+	page := gq.From("team").Select("id").Where(goqu.C("deleted_at").IsNull())
+	page = goquOptions.ApplySeek(arg.Seek, page, teamSeekColumns...).Limit(uint(arg.Limit)).Offset(uint(arg.Offset))
+	return q.getTeamsWithMembers(ctx, page, arg.MemberLimit, arg.MemberOffset, arg.Seek)
+}
+
+type SearchTeamsParams struct {
+	Query                 string `db:"query"`
+	MemberLimitPlusOffset int64  `db:"member_limit_plus_offset"`
+	MemberOffset          int64  `db:"member_offset"`
+	Limit                 int32  `db:"limit"`
+	Offset                int32  `db:"offset"`
+	Seek                  *goquOptions.Seek
+}
+
+func (q *Queries) SearchTeams(ctx context.Context, arg SearchTeamsParams) ([]RankedTeamWithMember, error) {
+	page := gq.From("team").Select("id").Where(goqu.C("name").ILike("%"+arg.Query+"%"), goqu.C("deleted_at").IsNull())
+	page = goquOptions.ApplySeek(arg.Seek, page, teamSeekColumns...).Limit(uint(arg.Limit)).Offset(uint(arg.Offset))
+	return q.getTeamsWithMembers(ctx, page, uint64(arg.MemberLimitPlusOffset-arg.MemberOffset), uint64(arg.MemberOffset), arg.Seek)
+}
+
+// getTeamsWithMembers returns the teams picked by the page with their members, in the order of the seek
+func (q *Queries) getTeamsWithMembers(ctx context.Context, page *goqu.SelectDataset, memberLimit uint64, memberOffset uint64, seek *goquOptions.Seek) ([]RankedTeamWithMember, error) {
+	team := gq.From("ranked_team_with_member").Prepared(true).Select("id", "name", "score", "ranking", "owner_user_id", "data", "created_at", "updated_at", "member_id", "user_id", "member_number", "member_data", "joined_at", "member_updated_at", "member_number_without_gaps")
+	limitMembers := goqu.L("member_number_without_gaps <= ? AND member_number_without_gaps > ?", memberLimit+memberOffset, memberOffset)
+	order := append(goquOptions.SeekOrder(seek, teamSeekColumns...), goqu.C("member_number").Asc())
+	query, args, err := team.Where(limitMembers, goqu.C("id").In(gq.From(page))).Order(order...).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RankedTeamWithMember
+	for rows.Next() {
+		var i RankedTeamWithMember
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Score,
+			&i.Ranking,
+			&i.OwnerUserID,
 			&i.Data,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -165,7 +247,7 @@ func filterGetTeamMembersParams(arg GetTeamMembersParams) exp.Expression {
 	if arg.Team.Member.UserID.Valid {
 		expressions["team_id"] = gq.From(gq.From("team_member").Select("team_id").Where(goqu.Ex{"user_id": arg.Team.Member.UserID}).Limit(1))
 	}
-	return expressions
+	return goqu.And(expressions, goqu.C("team_id").In(activeTeamIDs))
 }
 
 func (q *Queries) GetTeamMembers(ctx context.Context, arg GetTeamMembersParams) ([]TeamMember, error) {
@@ -224,6 +306,15 @@ func (q *Queries) DeleteTeam(ctx context.Context, arg TeamParams) (sql.Result, e
 	return q.db.ExecContext(ctx, query, args...)
 }
 
+func (q *Queries) RestoreTeam(ctx context.Context, arg TeamParams) (sql.Result, error) {
+	team := gq.Update("team").Prepared(true).Set(goqu.Record{"deleted_at": nil})
+	query, args, err := team.Where(filterTeamParams(arg), goqu.C("deleted_at").IsNotNull()).Limit(1).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	return q.db.ExecContext(ctx, query, args...)
+}
+
 type UpdateTeamParams struct {
 	Team           TeamParams
 	Data           json.RawMessage `db:"data"`
@@ -245,9 +336,155 @@ func (q *Queries) UpdateTeam(ctx context.Context, arg UpdateTeamParams) (sql.Res
 		}
 	}
 	team = team.Set(updates)
-	query, args, err := team.Where(filterTeamParams(arg.Team)).Limit(1).ToSQL()
+	// Teams that are pending deletion cannot be updated until they are restored
+	query, args, err := team.Where(filterTeamParams(arg.Team), goqu.C("deleted_at").IsNull()).Limit(1).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	return q.db.ExecContext(ctx, query, args...)
+}
+
+type GetTeamMessagesParams struct {
+	Team   TeamParams
+	Pinned sql.NullBool  `db:"pinned"`
+	Before sql.NullInt64 `db:"id"`
+	Limit  uint64
+}
+
+// filterGetTeamMessagesParams filters the GetTeamMessagesParams to exp.Expression
+func filterGetTeamMessagesParams(arg GetTeamMessagesParams) exp.Expression {
+	expressions := goqu.Ex{}
+	if arg.Team.ID.Valid {
+		expressions["team_id"] = arg.Team.ID
+	}
+	if arg.Team.Name.Valid {
+		expressions["team_id"] = gq.From(gq.From("team").Select("id").Where(goqu.Ex{"name": arg.Team.Name}).Limit(1))
+	}
+	if arg.Team.Member.ID.Valid {
+		expressions["team_id"] = gq.From(gq.From("team_member").Select("team_id").Where(goqu.Ex{"id": arg.Team.Member.ID}).Limit(1))
+	}
+	if arg.Team.Member.UserID.Valid {
+		expressions["team_id"] = gq.From(gq.From("team_member").Select("team_id").Where(goqu.Ex{"user_id": arg.Team.Member.UserID}).Limit(1))
+	}
+	if arg.Pinned.Valid {
+		expressions["pinned"] = arg.Pinned
+	}
+	// Messages are returned newest first, so the cursor is the id to start before
+	if arg.Before.Valid {
+		expressions["id"] = goqu.Op{"lt": arg.Before}
+	}
+	return goqu.And(expressions, goqu.C("team_id").In(activeTeamIDs))
+}
+
+func (q *Queries) GetTeamMessages(ctx context.Context, arg GetTeamMessagesParams) ([]TeamMessage, error) {
+	teamMessage := gq.From("team_message").Prepared(true).Select("id", "team_id", "user_id", "content", "pinned", "data", "created_at", "updated_at")
+	query, args, err := teamMessage.Where(filterGetTeamMessagesParams(arg)).Order(goqu.C("id").Desc()).Limit(uint(arg.Limit)).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamMessage
+	for rows.Next() {
+		var i TeamMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.UserID,
+			&i.Content,
+			&i.Pinned,
+			&i.Data,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	return items, nil
+}
+
+type UpdateTeamMessageParams struct {
+	ID      uint64          `db:"id"`
+	Content sql.NullString  `db:"content"`
+	Pinned  sql.NullBool    `db:"pinned"`
+	Data    json.RawMessage `db:"data"`
+}
+
+func (q *Queries) UpdateTeamMessage(ctx context.Context, arg UpdateTeamMessageParams) (sql.Result, error) {
+	teamMessage := gq.Update("team_message").Prepared(true)
+	updates := goqu.Record{}
+	if arg.Content.Valid {
+		updates["content"] = arg.Content
+	}
+	if arg.Pinned.Valid {
+		updates["pinned"] = arg.Pinned
+	}
+	if arg.Data != nil {
+		updates["data"] = []byte(arg.Data)
+	}
+	teamMessage = teamMessage.Set(updates)
+	query, args, err := teamMessage.Where(goqu.Ex{"id": arg.ID}).Limit(1).ToSQL()
 	if err != nil {
 		return nil, err
 	}
 	return q.db.ExecContext(ctx, query, args...)
 }
+
+type GetTeamSeasonsParams struct {
+	Team   TeamParams
+	Limit  uint64
+	Offset uint64
+}
+
+// filterGetTeamSeasonsParams filters the GetTeamSeasonsParams to exp.Expression
+func filterGetTeamSeasonsParams(arg GetTeamSeasonsParams) exp.Expression {
+	expressions := goqu.Ex{}
+	if arg.Team.ID.Valid {
+		expressions["team_id"] = arg.Team.ID
+	}
+	if arg.Team.Name.Valid {
+		expressions["team_id"] = gq.From(gq.From("team").Select("id").Where(goqu.Ex{"name": arg.Team.Name}).Limit(1))
+	}
+	if arg.Team.Member.ID.Valid {
+		expressions["team_id"] = gq.From(gq.From("team_member").Select("team_id").Where(goqu.Ex{"id": arg.Team.Member.ID}).Limit(1))
+	}
+	if arg.Team.Member.UserID.Valid {
+		expressions["team_id"] = gq.From(gq.From("team_member").Select("team_id").Where(goqu.Ex{"user_id": arg.Team.Member.UserID}).Limit(1))
+	}
+	return goqu.And(expressions, goqu.C("team_id").In(activeTeamIDs))
+}
+
+func (q *Queries) GetTeamSeasons(ctx context.Context, arg GetTeamSeasonsParams) ([]TeamSeason, error) {
+	teamSeason := gq.From("team_season").Prepared(true).Select("id", "season_label", "team_id", "name", "score", "ranking", "data", "archived_at")
+	query, args, err := teamSeason.Where(filterGetTeamSeasonsParams(arg)).Order(goqu.C("archived_at").Desc(), goqu.C("id").Desc()).Limit(uint(arg.Limit)).Offset(uint(arg.Offset)).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamSeason
+	for rows.Next() {
+		var i TeamSeason
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeasonLabel,
+			&i.TeamID,
+			&i.Name,
+			&i.Score,
+			&i.Ranking,
+			&i.Data,
+			&i.ArchivedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	return items, nil
+}
@@ -668,10 +668,10 @@ func Test_GetTeams_TwoTeams_ReturnTeams(t *testing.T) {
 		t.Fatalf("could not create team member: %v", err)
 	}
 	teams, err := q.GetTeams(context.Background(), GetTeamsParams{
-		MemberLimitPlusOffset: 2,
-		MemberOffset:          0,
-		Limit:                 2,
-		Offset:                0,
+		MemberLimit:  2,
+		MemberOffset: 0,
+		Limit:        2,
+		Offset:       0,
 	})
 	if err != nil {
 		t.Fatalf("could not get teams: %v", err)
@@ -2257,3 +2257,354 @@ func Test_UpdateTeam_TeamDoesNotExist_TeamNotUpdated(t *testing.T) {
 		t.Fatalf("expected 0 rows affected, got %d", rowsAffected)
 	}
 }
+
+func Test_GetTeamMessages_PinnedFilter_PinnedMessages(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateTeam(context.Background(), CreateTeamParams{
+		Name:  "teamMessages",
+		Score: 0,
+		Data:  json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create team: %v", err)
+	}
+	teamId, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("could not get last insert id: %v", err)
+	}
+	for i, pinned := range []bool{true, false, true} {
+		_, err = q.CreateTeamMessage(context.Background(), CreateTeamMessageParams{
+			TeamID:  uint64(teamId),
+			UserID:  uint64(i + 1),
+			Content: "message",
+			Pinned:  pinned,
+			Data:    json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create team message: %v", err)
+		}
+	}
+	messages, err := q.GetTeamMessages(context.Background(), GetTeamMessagesParams{
+		Team: TeamParams{
+			ID: sql.NullInt64{Int64: teamId, Valid: true},
+		},
+		Pinned: sql.NullBool{Bool: true, Valid: true},
+		Limit:  10,
+	})
+	if err != nil {
+		t.Fatalf("could not get team messages: %v", err)
+	}
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+	if messages[0].UserID != 3 || messages[1].UserID != 1 {
+		t.Fatalf("expected newest pinned message first, got %v", messages)
+	}
+}
+
+func Test_UpdateTeamMessage_Content_TeamMessageUpdated(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateTeam(context.Background(), CreateTeamParams{
+		Name:  "teamMessageUpdate",
+		Score: 0,
+		Data:  json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create team: %v", err)
+	}
+	teamId, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("could not get last insert id: %v", err)
+	}
+	result, err = q.CreateTeamMessage(context.Background(), CreateTeamMessageParams{
+		TeamID:  uint64(teamId),
+		UserID:  1,
+		Content: "before",
+		Data:    json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create team message: %v", err)
+	}
+	messageId, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("could not get last insert id: %v", err)
+	}
+	_, err = q.UpdateTeamMessage(context.Background(), UpdateTeamMessageParams{
+		ID:      uint64(messageId),
+		Content: sql.NullString{String: "after", Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not update team message: %v", err)
+	}
+	message, err := q.GetTeamMessage(context.Background(), uint64(messageId))
+	if err != nil {
+		t.Fatalf("could not get team message: %v", err)
+	}
+	if message.Content != "after" {
+		t.Fatalf("expected content to be after, got %s", message.Content)
+	}
+}
+
+func Test_SoftDeleteTeam_Team_TeamHiddenUntilRestored(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateTeam(context.Background(), CreateTeamParams{
+		Name:  "teamSoftDelete",
+		Score: 0,
+		Data:  json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create team: %v", err)
+	}
+	teamId, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("could not get last insert id: %v", err)
+	}
+	_, err = q.SoftDeleteTeam(context.Background(), uint64(teamId))
+	if err != nil {
+		t.Fatalf("could not soft delete team: %v", err)
+	}
+	team, err := q.GetTeam(context.Background(), GetTeamParams{
+		Team: TeamParams{
+			ID: sql.NullInt64{Int64: teamId, Valid: true},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		t.Fatalf("could not get team: %v", err)
+	}
+	if len(team) != 0 {
+		t.Fatalf("expected soft deleted team to be hidden, got %v", team)
+	}
+	result, err = q.RestoreTeam(context.Background(), TeamParams{
+		ID: sql.NullInt64{Int64: teamId, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not restore team: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		t.Fatalf("could not get rows affected: %v", err)
+	}
+	if rowsAffected != 1 {
+		t.Fatalf("expected 1 row affected, got %d", rowsAffected)
+	}
+	team, err = q.GetTeam(context.Background(), GetTeamParams{
+		Team: TeamParams{
+			ID: sql.NullInt64{Int64: teamId, Valid: true},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		t.Fatalf("could not get team: %v", err)
+	}
+	if len(team) != 1 {
+		t.Fatalf("expected restored team to be returned, got %d rows", len(team))
+	}
+}
+
+func Test_RestoreTeam_OwnerJoinedAnotherTeam_MembersRestoredAndOwnershipClaimed(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateTeam(context.Background(), CreateTeamParams{
+		Name:        "teamRestore",
+		Score:       0,
+		OwnerUserID: sql.NullInt64{Int64: 4810, Valid: true},
+		Data:        json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create team: %v", err)
+	}
+	teamId, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("could not get last insert id: %v", err)
+	}
+	for i, userId := range []uint64{4810, 4811, 4812} {
+		_, err = q.CreateTeamMember(context.Background(), CreateTeamMemberParams{
+			TeamID:       uint64(teamId),
+			UserID:       userId,
+			MemberNumber: uint32(i + 1),
+			Data:         json.RawMessage(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("could not create team member: %v", err)
+		}
+	}
+	_, err = q.SoftDeleteTeam(context.Background(), uint64(teamId))
+	if err != nil {
+		t.Fatalf("could not soft delete team: %v", err)
+	}
+	// The owner joins another team while the team is pending deletion
+	result, err = q.DeleteTeamMemberOfDeletedTeam(context.Background(), 4810)
+	if err != nil {
+		t.Fatalf("could not delete team member of deleted team: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		t.Fatalf("could not get rows affected: %v", err)
+	}
+	if rowsAffected != 1 {
+		t.Fatalf("expected 1 row affected, got %d", rowsAffected)
+	}
+	_, err = q.RestoreTeam(context.Background(), TeamParams{
+		ID: sql.NullInt64{Int64: teamId, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not restore team: %v", err)
+	}
+	owner, err := q.GetLongestTenuredTeamMember(context.Background(), uint64(teamId))
+	if err != nil {
+		t.Fatalf("could not get longest tenured team member: %v", err)
+	}
+	_, err = q.ClaimTeamOwnership(context.Background(), ClaimTeamOwnershipParams{
+		ID:          uint64(teamId),
+		OwnerUserID: sql.NullInt64{Int64: int64(owner.UserID), Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not claim team ownership: %v", err)
+	}
+	team, err := q.GetTeam(context.Background(), GetTeamParams{
+		Team: TeamParams{
+			ID: sql.NullInt64{Int64: teamId, Valid: true},
+		},
+		Limit: 10,
+	})
+	if err != nil {
+		t.Fatalf("could not get team: %v", err)
+	}
+	if len(team) != 2 {
+		t.Fatalf("expected 2 members, got %d", len(team))
+	}
+	if team[0].UserID.Int64 != 4811 || team[1].UserID.Int64 != 4812 {
+		t.Fatalf("expected members 4811 and 4812, got %d and %d", team[0].UserID.Int64, team[1].UserID.Int64)
+	}
+	if team[0].OwnerUserID.Int64 != 4811 {
+		t.Fatalf("expected owner 4811, got %d", team[0].OwnerUserID.Int64)
+	}
+}
+
+func Test_TransferTeamOwnership_NotOwner_OwnershipNotTransferred(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateTeam(context.Background(), CreateTeamParams{
+		Name:        "teamOwnership",
+		Score:       0,
+		OwnerUserID: sql.NullInt64{Int64: 1, Valid: true},
+		Data:        json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create team: %v", err)
+	}
+	teamId, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("could not get last insert id: %v", err)
+	}
+	result, err = q.TransferTeamOwnership(context.Background(), TransferTeamOwnershipParams{
+		ID:                  uint64(teamId),
+		OwnerUserID:         sql.NullInt64{Int64: 3, Valid: true},
+		PreviousOwnerUserID: sql.NullInt64{Int64: 2, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not transfer team ownership: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		t.Fatalf("could not get rows affected: %v", err)
+	}
+	if rowsAffected != 0 {
+		t.Fatalf("expected 0 rows affected, got %d", rowsAffected)
+	}
+}
+
+func Test_ResetTeamScores_Season_ScoresArchivedAndZeroed(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateTeam(context.Background(), CreateTeamParams{
+		Name:  "teamSeasonReset",
+		Score: 42,
+		Data:  json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create team: %v", err)
+	}
+	teamId, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("could not get last insert id: %v", err)
+	}
+	_, err = q.ArchiveTeamSeason(context.Background(), "seasonReset")
+	if err != nil {
+		t.Fatalf("could not archive team season: %v", err)
+	}
+	_, err = q.ResetTeamScores(context.Background())
+	if err != nil {
+		t.Fatalf("could not reset team scores: %v", err)
+	}
+	seasons, err := q.GetTeamSeasons(context.Background(), GetTeamSeasonsParams{
+		Team: TeamParams{
+			ID: sql.NullInt64{Int64: teamId, Valid: true},
+		},
+		Limit: 10,
+	})
+	if err != nil {
+		t.Fatalf("could not get team seasons: %v", err)
+	}
+	if len(seasons) != 1 {
+		t.Fatalf("expected 1 season, got %d", len(seasons))
+	}
+	if seasons[0].SeasonLabel != "seasonReset" || seasons[0].Score != 42 {
+		t.Fatalf("expected archived score of 42, got %v", seasons[0])
+	}
+	team, err := q.GetTeam(context.Background(), GetTeamParams{
+		Team: TeamParams{
+			ID: sql.NullInt64{Int64: teamId, Valid: true},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		t.Fatalf("could not get team: %v", err)
+	}
+	if len(team) == 0 || team[0].Score != 0 {
+		t.Fatalf("expected team score to be reset, got %v", team)
+	}
+}
+
+func Test_DeleteTeam_SeasonArchived_SeasonKept(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateTeam(context.Background(), CreateTeamParams{
+		Name:  "teamSeasonKept",
+		Score: 7,
+		Data:  json.RawMessage(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("could not create team: %v", err)
+	}
+	teamId, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("could not get last insert id: %v", err)
+	}
+	_, err = q.ArchiveTeamSeason(context.Background(), "seasonKept")
+	if err != nil {
+		t.Fatalf("could not archive team season: %v", err)
+	}
+	_, err = q.DeleteTeam(context.Background(), TeamParams{
+		ID: sql.NullInt64{Int64: teamId, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not delete team: %v", err)
+	}
+	var teamID sql.NullInt64
+	var score int64
+	err = tx.QueryRowContext(context.Background(), "SELECT team_id, score FROM team_season WHERE season_label = ? AND name = ?", "seasonKept", "teamSeasonKept").Scan(&teamID, &score)
+	if err != nil {
+		t.Fatalf("could not get team season: %v", err)
+	}
+	if teamID.Valid {
+		t.Fatalf("expected team id to be null, got %d", teamID.Int64)
+	}
+	if score != 7 {
+		t.Fatalf("expected score of 7, got %d", score)
+	}
+}
@@ -11,13 +11,14 @@ import (
 )
 
 type RankedTeam struct {
-	ID        uint64          `db:"id"`
-	Name      string          `db:"name"`
-	Score     int64           `db:"score"`
-	Ranking   uint64          `db:"ranking"`
-	Data      json.RawMessage `db:"data"`
-	CreatedAt time.Time       `db:"created_at"`
-	UpdatedAt time.Time       `db:"updated_at"`
+	ID          uint64          `db:"id"`
+	Name        string          `db:"name"`
+	Score       int64           `db:"score"`
+	Ranking     uint64          `db:"ranking"`
+	OwnerUserID sql.NullInt64   `db:"owner_user_id"`
+	Data        json.RawMessage `db:"data"`
+	CreatedAt   time.Time       `db:"created_at"`
+	UpdatedAt   time.Time       `db:"updated_at"`
 }
 
 type RankedTeamWithMember struct {
@@ -25,6 +26,7 @@ type RankedTeamWithMember struct {
 	Name                    string          `db:"name"`
 	Score                   int64           `db:"score"`
 	Ranking                 uint64          `db:"ranking"`
+	OwnerUserID             sql.NullInt64   `db:"owner_user_id"`
 	Data                    json.RawMessage `db:"data"`
 	CreatedAt               time.Time       `db:"created_at"`
 	UpdatedAt               time.Time       `db:"updated_at"`
@@ -38,12 +40,14 @@ type RankedTeamWithMember struct {
 }
 
 type Team struct {
-	ID        uint64          `db:"id"`
-	Name      string          `db:"name"`
-	Score     int64           `db:"score"`
-	Data      json.RawMessage `db:"data"`
-	CreatedAt time.Time       `db:"created_at"`
-	UpdatedAt time.Time       `db:"updated_at"`
+	ID          uint64          `db:"id"`
+	Name        string          `db:"name"`
+	Score       int64           `db:"score"`
+	OwnerUserID sql.NullInt64   `db:"owner_user_id"`
+	Data        json.RawMessage `db:"data"`
+	CreatedAt   time.Time       `db:"created_at"`
+	UpdatedAt   time.Time       `db:"updated_at"`
+	DeletedAt   sql.NullTime    `db:"deleted_at"`
 }
 
 type TeamMember struct {
@@ -56,6 +60,28 @@ type TeamMember struct {
 	UpdatedAt    time.Time       `db:"updated_at"`
 }
 
+type TeamMessage struct {
+	ID        uint64          `db:"id"`
+	TeamID    uint64          `db:"team_id"`
+	UserID    uint64          `db:"user_id"`
+	Content   string          `db:"content"`
+	Pinned    bool            `db:"pinned"`
+	Data      json.RawMessage `db:"data"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
+}
+
+type TeamSeason struct {
+	ID          uint64          `db:"id"`
+	SeasonLabel string          `db:"season_label"`
+	TeamID      sql.NullInt64   `db:"team_id"`
+	Name        string          `db:"name"`
+	Score       int64           `db:"score"`
+	Ranking     uint64          `db:"ranking"`
+	Data        json.RawMessage `db:"data"`
+	ArchivedAt  time.Time       `db:"archived_at"`
+}
+
 type TeamWithFirstOpenMember struct {
 	ID              uint64 `db:"id"`
 	FirstOpenMember uint32 `db:"first_open_member"`
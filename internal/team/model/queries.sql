@@ -1,75 +1,101 @@
--- name: GetTeams :many
+-- name: GetFirstOpenMemberNumber :one
+SELECT first_open_member
+FROM team_with_first_open_member
+WHERE id = sqlc.arg(team)
+LIMIT 1;
+-- name: CreateTeam :execresult
+INSERT INTO team (name, score, owner_user_id, data)
+VALUES (?, ?, ?, ?);
+-- name: CreateTeamMember :execresult
+INSERT INTO team_member (user_id, team_id, member_number, data)
+VALUES (?, ?, ?, ?);
+-- name: GetLongestTenuredTeamMember :one
 SELECT id,
-  name,
-  score,
-  ranking,
-  data,
-  created_at,
-  updated_at,
-  member_id,
   user_id,
+  team_id,
   member_number,
-  member_data,
+  data,
   joined_at,
-  member_updated_at,
-  member_number_without_gaps
-FROM ranked_team_with_member
-WHERE member_number_without_gaps <= CAST(sqlc.arg(member_limit_plus_offset) AS UNSIGNED)
-  AND member_number_without_gaps > CAST(sqlc.arg(member_offset) AS UNSIGNED)
-  AND id IN (
-    SELECT *
-    FROM (
-        SELECT id
-        FROM team
-        ORDER BY score DESC,
-          id
-        LIMIT ? OFFSET ?
-      ) temp_team
+  updated_at
+FROM team_member
+WHERE team_id = ?
+ORDER BY joined_at,
+  member_number
+LIMIT 1;
+-- name: TransferTeamOwnership :execresult
+UPDATE team
+SET owner_user_id = sqlc.arg(owner_user_id)
+WHERE id = sqlc.arg(id)
+  AND owner_user_id = sqlc.arg(previous_owner_user_id)
+  AND deleted_at IS NULL
+LIMIT 1;
+-- name: ClaimTeamOwnership :execresult
+UPDATE team
+SET owner_user_id = sqlc.arg(owner_user_id)
+WHERE team.id = sqlc.arg(id)
+  AND (
+    team.owner_user_id IS NULL
+    OR NOT EXISTS (
+      SELECT 1
+      FROM team_member
+      WHERE team_member.team_id = team.id
+        AND team_member.user_id = team.owner_user_id
+    )
   )
-ORDER BY score DESC,
+  AND team.deleted_at IS NULL
+LIMIT 1;
+-- name: SoftDeleteTeam :execresult
+UPDATE team
+SET deleted_at = NOW()
+WHERE id = ?
+  AND deleted_at IS NULL
+LIMIT 1;
+-- name: DeleteTeamMemberOfDeletedTeam :execresult
+DELETE FROM team_member
+WHERE user_id = ?
+  AND team_id IN (
+    SELECT id
+    FROM team
+    WHERE deleted_at IS NOT NULL
+  )
+LIMIT 1;
+-- name: CreateTeamMessage :execresult
+INSERT INTO team_message (team_id, user_id, content, pinned, data)
+VALUES (?, ?, ?, ?, ?);
+-- name: GetTeamMessage :one
+SELECT tm.id,
+  tm.team_id,
+  tm.user_id,
+  tm.content,
+  tm.pinned,
+  tm.data,
+  tm.created_at,
+  tm.updated_at
+FROM team_message tm
+  JOIN team t ON tm.team_id = t.id
+WHERE tm.id = ?
+  AND t.deleted_at IS NULL
+LIMIT 1;
+-- name: GetTeamOwner :one
+SELECT owner_user_id
+FROM team
+WHERE id = ?
+  AND deleted_at IS NULL
+LIMIT 1;
+-- name: DeleteTeamMessage :execresult
+DELETE FROM team_message
+WHERE id = ?
+LIMIT 1;
+-- name: ArchiveTeamSeason :execresult
+INSERT INTO team_season (season_label, team_id, name, score, ranking, data)
+SELECT sqlc.arg(season_label),
   id,
-  member_number;
--- name: SearchTeams :many
-SELECT id,
   name,
   score,
   ranking,
-  data,
-  created_at,
-  updated_at,
-  member_id,
-  user_id,
-  member_number,
-  member_data,
-  joined_at,
-  member_updated_at,
-  member_number_without_gaps
-FROM ranked_team_with_member
-WHERE name LIKE CONCAT('%', sqlc.arg(query), '%')
-  AND member_number_without_gaps <= CAST(sqlc.arg(member_limit_plus_offset) AS UNSIGNED)
-  AND member_number_without_gaps > CAST(sqlc.arg(member_offset) AS UNSIGNED)
-  AND id IN (
-    SELECT *
-    FROM (
-        SELECT id
-        FROM team
-        WHERE name LIKE CONCAT('%', sqlc.arg(query), '%')
-        ORDER BY score DESC,
-          id
-        LIMIT ? OFFSET ?
-      ) temp_team
-  )
-ORDER BY score DESC,
-  id,
-  member_number;
--- name: GetFirstOpenMemberNumber :one
-SELECT first_open_member
-FROM team_with_first_open_member
-WHERE id = sqlc.arg(team)
-LIMIT 1;
--- name: CreateTeam :execresult
-INSERT INTO team (name, score, data)
-VALUES (?, ?, ?);
--- name: CreateTeamMember :execresult
-INSERT INTO team_member (user_id, team_id, member_number, data)
-VALUES (?, ?, ?, ?);
\ No newline at end of file
+  data
+FROM ranked_team;
+-- name: ResetTeamScores :execresult
+UPDATE team
+SET score = 0
+WHERE score != 0;
@@ -11,19 +11,66 @@ import (
 	"encoding/json"
 )
 
+const ArchiveTeamSeason = `-- name: ArchiveTeamSeason :execresult
+INSERT INTO team_season (season_label, team_id, name, score, ranking, data)
+SELECT ?,
+  id,
+  name,
+  score,
+  ranking,
+  data
+FROM ranked_team
+`
+
+func (q *Queries) ArchiveTeamSeason(ctx context.Context, seasonLabel string) (sql.Result, error) {
+	return q.db.ExecContext(ctx, ArchiveTeamSeason, seasonLabel)
+}
+
+const ClaimTeamOwnership = `-- name: ClaimTeamOwnership :execresult
+UPDATE team
+SET owner_user_id = ?
+WHERE team.id = ?
+  AND (
+    team.owner_user_id IS NULL
+    OR NOT EXISTS (
+      SELECT 1
+      FROM team_member
+      WHERE team_member.team_id = team.id
+        AND team_member.user_id = team.owner_user_id
+    )
+  )
+  AND team.deleted_at IS NULL
+LIMIT 1
+`
+
+type ClaimTeamOwnershipParams struct {
+	OwnerUserID sql.NullInt64 `db:"owner_user_id"`
+	ID          uint64        `db:"id"`
+}
+
+func (q *Queries) ClaimTeamOwnership(ctx context.Context, arg ClaimTeamOwnershipParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, ClaimTeamOwnership, arg.OwnerUserID, arg.ID)
+}
+
 const CreateTeam = `-- name: CreateTeam :execresult
-INSERT INTO team (name, score, data)
-VALUES (?, ?, ?)
+INSERT INTO team (name, score, owner_user_id, data)
+VALUES (?, ?, ?, ?)
 `
 
 type CreateTeamParams struct {
-	Name  string          `db:"name"`
-	Score int64           `db:"score"`
-	Data  json.RawMessage `db:"data"`
+	Name        string          `db:"name"`
+	Score       int64           `db:"score"`
+	OwnerUserID sql.NullInt64   `db:"owner_user_id"`
+	Data        json.RawMessage `db:"data"`
 }
 
 func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (sql.Result, error) {
-	return q.db.ExecContext(ctx, CreateTeam, arg.Name, arg.Score, arg.Data)
+	return q.db.ExecContext(ctx, CreateTeam,
+		arg.Name,
+		arg.Score,
+		arg.OwnerUserID,
+		arg.Data,
+	)
 }
 
 const CreateTeamMember = `-- name: CreateTeamMember :execresult
@@ -47,6 +94,54 @@ func (q *Queries) CreateTeamMember(ctx context.Context, arg CreateTeamMemberPara
 	)
 }
 
+const CreateTeamMessage = `-- name: CreateTeamMessage :execresult
+INSERT INTO team_message (team_id, user_id, content, pinned, data)
+VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateTeamMessageParams struct {
+	TeamID  uint64          `db:"team_id"`
+	UserID  uint64          `db:"user_id"`
+	Content string          `db:"content"`
+	Pinned  bool            `db:"pinned"`
+	Data    json.RawMessage `db:"data"`
+}
+
+func (q *Queries) CreateTeamMessage(ctx context.Context, arg CreateTeamMessageParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateTeamMessage,
+		arg.TeamID,
+		arg.UserID,
+		arg.Content,
+		arg.Pinned,
+		arg.Data,
+	)
+}
+
+const DeleteTeamMemberOfDeletedTeam = `-- name: DeleteTeamMemberOfDeletedTeam :execresult
+DELETE FROM team_member
+WHERE user_id = ?
+  AND team_id IN (
+    SELECT id
+    FROM team
+    WHERE deleted_at IS NOT NULL
+  )
+LIMIT 1
+`
+
+func (q *Queries) DeleteTeamMemberOfDeletedTeam(ctx context.Context, userID uint64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, DeleteTeamMemberOfDeletedTeam, userID)
+}
+
+const DeleteTeamMessage = `-- name: DeleteTeamMessage :execresult
+DELETE FROM team_message
+WHERE id = ?
+LIMIT 1
+`
+
+func (q *Queries) DeleteTeamMessage(ctx context.Context, id uint64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, DeleteTeamMessage, id)
+}
+
 const GetFirstOpenMemberNumber = `-- name: GetFirstOpenMemberNumber :one
 SELECT first_open_member
 FROM team_with_first_open_member
@@ -61,173 +156,120 @@ func (q *Queries) GetFirstOpenMemberNumber(ctx context.Context, team uint64) (ui
 	return first_open_member, err
 }
 
-const GetTeams = `-- name: GetTeams :many
+const GetLongestTenuredTeamMember = `-- name: GetLongestTenuredTeamMember :one
 SELECT id,
-  name,
-  score,
-  ranking,
-  data,
-  created_at,
-  updated_at,
-  member_id,
   user_id,
+  team_id,
   member_number,
-  member_data,
+  data,
   joined_at,
-  member_updated_at,
-  member_number_without_gaps
-FROM ranked_team_with_member
-WHERE member_number_without_gaps <= CAST(? AS UNSIGNED)
-  AND member_number_without_gaps > CAST(? AS UNSIGNED)
-  AND id IN (
-    SELECT id
-    FROM (
-        SELECT id
-        FROM team
-        ORDER BY score DESC,
-          id
-        LIMIT ? OFFSET ?
-      ) temp_team
-  )
-ORDER BY score DESC,
-  id,
+  updated_at
+FROM team_member
+WHERE team_id = ?
+ORDER BY joined_at,
   member_number
+LIMIT 1
 `
 
-type GetTeamsParams struct {
-	MemberLimitPlusOffset int64 `db:"member_limit_plus_offset"`
-	MemberOffset          int64 `db:"member_offset"`
-	Limit                 int32 `db:"limit"`
-	Offset                int32 `db:"offset"`
+func (q *Queries) GetLongestTenuredTeamMember(ctx context.Context, teamID uint64) (TeamMember, error) {
+	row := q.db.QueryRowContext(ctx, GetLongestTenuredTeamMember, teamID)
+	var i TeamMember
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TeamID,
+		&i.MemberNumber,
+		&i.Data,
+		&i.JoinedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
-func (q *Queries) GetTeams(ctx context.Context, arg GetTeamsParams) ([]RankedTeamWithMember, error) {
-	rows, err := q.db.QueryContext(ctx, GetTeams,
-		arg.MemberLimitPlusOffset,
-		arg.MemberOffset,
-		arg.Limit,
-		arg.Offset,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []RankedTeamWithMember
-	for rows.Next() {
-		var i RankedTeamWithMember
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.Score,
-			&i.Ranking,
-			&i.Data,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.MemberID,
-			&i.UserID,
-			&i.MemberNumber,
-			&i.MemberData,
-			&i.JoinedAt,
-			&i.MemberUpdatedAt,
-			&i.MemberNumberWithoutGaps,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const SearchTeams = `-- name: SearchTeams :many
-SELECT id,
-  name,
-  score,
-  ranking,
-  data,
-  created_at,
-  updated_at,
-  member_id,
-  user_id,
-  member_number,
-  member_data,
-  joined_at,
-  member_updated_at,
-  member_number_without_gaps
-FROM ranked_team_with_member
-WHERE name LIKE CONCAT('%', ?, '%')
-  AND member_number_without_gaps <= CAST(? AS UNSIGNED)
-  AND member_number_without_gaps > CAST(? AS UNSIGNED)
-  AND id IN (
-    SELECT id
-    FROM (
-        SELECT id
-        FROM team
-        WHERE name LIKE CONCAT('%', ?, '%')
-        ORDER BY score DESC,
-          id
-        LIMIT ? OFFSET ?
-      ) temp_team
-  )
-ORDER BY score DESC,
-  id,
-  member_number
+const GetTeamMessage = `-- name: GetTeamMessage :one
+SELECT tm.id,
+  tm.team_id,
+  tm.user_id,
+  tm.content,
+  tm.pinned,
+  tm.data,
+  tm.created_at,
+  tm.updated_at
+FROM team_message tm
+  JOIN team t ON tm.team_id = t.id
+WHERE tm.id = ?
+  AND t.deleted_at IS NULL
+LIMIT 1
 `
 
-type SearchTeamsParams struct {
-	Query                 interface{} `db:"query"`
-	MemberLimitPlusOffset int64       `db:"member_limit_plus_offset"`
-	MemberOffset          int64       `db:"member_offset"`
-	Limit                 int32       `db:"limit"`
-	Offset                int32       `db:"offset"`
-}
-
-func (q *Queries) SearchTeams(ctx context.Context, arg SearchTeamsParams) ([]RankedTeamWithMember, error) {
-	rows, err := q.db.QueryContext(ctx, SearchTeams,
-		arg.Query,
-		arg.MemberLimitPlusOffset,
-		arg.MemberOffset,
-		arg.Query,
-		arg.Limit,
-		arg.Offset,
+func (q *Queries) GetTeamMessage(ctx context.Context, id uint64) (TeamMessage, error) {
+	row := q.db.QueryRowContext(ctx, GetTeamMessage, id)
+	var i TeamMessage
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.UserID,
+		&i.Content,
+		&i.Pinned,
+		&i.Data,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []RankedTeamWithMember
-	for rows.Next() {
-		var i RankedTeamWithMember
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.Score,
-			&i.Ranking,
-			&i.Data,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.MemberID,
-			&i.UserID,
-			&i.MemberNumber,
-			&i.MemberData,
-			&i.JoinedAt,
-			&i.MemberUpdatedAt,
-			&i.MemberNumberWithoutGaps,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+	return i, err
+}
+
+const GetTeamOwner = `-- name: GetTeamOwner :one
+SELECT owner_user_id
+FROM team
+WHERE id = ?
+  AND deleted_at IS NULL
+LIMIT 1
+`
+
+func (q *Queries) GetTeamOwner(ctx context.Context, id uint64) (sql.NullInt64, error) {
+	row := q.db.QueryRowContext(ctx, GetTeamOwner, id)
+	var owner_user_id sql.NullInt64
+	err := row.Scan(&owner_user_id)
+	return owner_user_id, err
+}
+
+const ResetTeamScores = `-- name: ResetTeamScores :execresult
+UPDATE team
+SET score = 0
+WHERE score != 0
+`
+
+func (q *Queries) ResetTeamScores(ctx context.Context) (sql.Result, error) {
+	return q.db.ExecContext(ctx, ResetTeamScores)
+}
+
+const SoftDeleteTeam = `-- name: SoftDeleteTeam :execresult
+UPDATE team
+SET deleted_at = NOW()
+WHERE id = ?
+  AND deleted_at IS NULL
+LIMIT 1
+`
+
+func (q *Queries) SoftDeleteTeam(ctx context.Context, id uint64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, SoftDeleteTeam, id)
+}
+
+const TransferTeamOwnership = `-- name: TransferTeamOwnership :execresult
+UPDATE team
+SET owner_user_id = ?
+WHERE id = ?
+  AND owner_user_id = ?
+  AND deleted_at IS NULL
+LIMIT 1
+`
+
+type TransferTeamOwnershipParams struct {
+	OwnerUserID         sql.NullInt64 `db:"owner_user_id"`
+	ID                  uint64        `db:"id"`
+	PreviousOwnerUserID sql.NullInt64 `db:"previous_owner_user_id"`
+}
+
+func (q *Queries) TransferTeamOwnership(ctx context.Context, arg TransferTeamOwnershipParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, TransferTeamOwnership, arg.OwnerUserID, arg.ID, arg.PreviousOwnerUserID)
 }
@@ -22,6 +22,8 @@ type Service struct {
 	maxMembers           uint8
 	minTeamNameLength    uint8
 	maxTeamNameLength    uint8
+	maxMessageLength     uint16
+	maxSeasonLabelLength uint8
 	defaultMaxPageLength uint8
 	maxMaxPageLength     uint8
 }
@@ -71,6 +73,18 @@ func WithMaxTeamNameLength(maxTeamNameLength uint8) func(*Service) {
 	}
 }
 
+func WithMaxMessageLength(maxMessageLength uint16) func(*Service) {
+	return func(input *Service) {
+		input.maxMessageLength = maxMessageLength
+	}
+}
+
+func WithMaxSeasonLabelLength(maxSeasonLabelLength uint8) func(*Service) {
+	return func(input *Service) {
+		input.maxSeasonLabelLength = maxSeasonLabelLength
+	}
+}
+
 func WithDefaultMaxPageLength(defaultMaxPageLength uint8) func(*Service) {
 	return func(input *Service) {
 		input.defaultMaxPageLength = defaultMaxPageLength
@@ -88,6 +102,8 @@ func NewService(opts ...func(*Service)) *Service {
 		maxMembers:           10,
 		minTeamNameLength:    3,
 		maxTeamNameLength:    20,
+		maxMessageLength:     500,
+		maxSeasonLabelLength: 64,
 		defaultMaxPageLength: 10,
 		maxMaxPageLength:     100,
 	}
@@ -177,6 +193,16 @@ func (s *Service) DeleteTeam(ctx context.Context, in *api.TeamRequest) (*api.Tea
 	return command.Out, nil
 }
 
+func (s *Service) RestoreTeam(ctx context.Context, in *api.TeamRequest) (*api.TeamResponse, error) {
+	command := NewRestoreTeamCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
 func (s *Service) JoinTeam(ctx context.Context, in *api.JoinTeamRequest) (*api.JoinTeamResponse, error) {
 	command := NewJoinTeamCommand(s, in)
 	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
@@ -197,6 +223,67 @@ func (s *Service) LeaveTeam(ctx context.Context, in *api.TeamMemberRequest) (*ap
 	return command.Out, nil
 }
 
+func (s *Service) PostTeamMessage(ctx context.Context, in *api.PostTeamMessageRequest) (*api.PostTeamMessageResponse, error) {
+	command := NewPostTeamMessageCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) GetTeamMessages(ctx context.Context, in *api.GetTeamMessagesRequest) (*api.GetTeamMessagesResponse, error) {
+	command := NewGetTeamMessagesCommand(s, in)
+	// Messages are not cached so a new message shows up straight away
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) UpdateTeamMessage(ctx context.Context, in *api.UpdateTeamMessageRequest) (*api.UpdateTeamMessageResponse, error) {
+	command := NewUpdateTeamMessageCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) DeleteTeamMessage(ctx context.Context, in *api.DeleteTeamMessageRequest) (*api.TeamMessageResponse, error) {
+	command := NewDeleteTeamMessageCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) ResetTeamScores(ctx context.Context, in *api.ResetTeamScoresRequest) (*api.ResetTeamScoresResponse, error) {
+	command := NewResetTeamScoresCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) GetTeamSeasonHistory(ctx context.Context, in *api.GetTeamSeasonHistoryRequest) (*api.GetTeamSeasonHistoryResponse, error) {
+	command := NewGetTeamSeasonHistoryCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric).SetInvoker(invoker.NewCacheInvoker(s.cache))))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
 // Utility functions
 
 func unmarshalTeamWithMembers(team []model.RankedTeamWithMember) (*api.Team, error) {
@@ -224,14 +311,15 @@ func unmarshalTeamWithMembers(team []model.RankedTeamWithMember) (*api.Team, err
 		}
 	}
 	return &api.Team{
-		Id:        team[0].ID,
-		Name:      team[0].Name,
-		Score:     team[0].Score,
-		Ranking:   team[0].Ranking,
-		Members:   members,
-		Data:      data,
-		CreatedAt: timestamppb.New(team[0].CreatedAt),
-		UpdatedAt: timestamppb.New(team[0].UpdatedAt),
+		Id:          team[0].ID,
+		Name:        team[0].Name,
+		Score:       team[0].Score,
+		Ranking:     team[0].Ranking,
+		Members:     members,
+		Data:        data,
+		CreatedAt:   timestamppb.New(team[0].CreatedAt),
+		UpdatedAt:   timestamppb.New(team[0].UpdatedAt),
+		OwnerUserId: conversion.SqlNullInt64ToUint64(team[0].OwnerUserID),
 	}, nil
 }
 
@@ -251,6 +339,43 @@ func unmarshalTeamMember(member model.TeamMember) (*api.TeamMember, error) {
 	}, nil
 }
 
+func unmarshalTeamMessage(message model.TeamMessage) (*api.TeamMessage, error) {
+	// Marshal data to protobuf struct
+	data, err := conversion.RawJsonToProtobufStruct(message.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &api.TeamMessage{
+		Id:        message.ID,
+		TeamId:    message.TeamID,
+		UserId:    message.UserID,
+		Content:   message.Content,
+		Pinned:    message.Pinned,
+		Data:      data,
+		CreatedAt: timestamppb.New(message.CreatedAt),
+		UpdatedAt: timestamppb.New(message.UpdatedAt),
+	}, nil
+}
+
+func unmarshalTeamSeason(season model.TeamSeason) (*api.TeamSeason, error) {
+	// Marshal data to protobuf struct
+	data, err := conversion.RawJsonToProtobufStruct(season.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &api.TeamSeason{
+		Id:          season.ID,
+		SeasonLabel: season.SeasonLabel,
+		// Seasons are only found through a team that still exists, so the team id is always set
+		TeamId:     uint64(season.TeamID.Int64),
+		Name:       season.Name,
+		Score:      season.Score,
+		Ranking:    season.Ranking,
+		Data:       data,
+		ArchivedAt: timestamppb.New(season.ArchivedAt),
+	}, nil
+}
+
 func unmarshalTeamsWithMembers(teams []model.RankedTeamWithMember) ([]*api.Team, error) {
 	result := make([]*api.Team, 0)
 	var currentTeam *api.Team
@@ -264,13 +389,14 @@ func unmarshalTeamsWithMembers(teams []model.RankedTeamWithMember) ([]*api.Team,
 				return nil, err
 			}
 			currentTeam = &api.Team{
-				Id:        team.ID,
-				Name:      team.Name,
-				Score:     team.Score,
-				Ranking:   team.Ranking,
-				Data:      data,
-				CreatedAt: timestamppb.New(team.CreatedAt),
-				UpdatedAt: timestamppb.New(team.UpdatedAt),
+				Id:          team.ID,
+				Name:        team.Name,
+				Score:       team.Score,
+				Ranking:     team.Ranking,
+				Data:        data,
+				CreatedAt:   timestamppb.New(team.CreatedAt),
+				UpdatedAt:   timestamppb.New(team.UpdatedAt),
+				OwnerUserId: conversion.SqlNullInt64ToUint64(team.OwnerUserID),
 			}
 		}
 		if !team.MemberID.Valid {
@@ -295,6 +421,18 @@ func unmarshalTeamsWithMembers(teams []model.RankedTeamWithMember) ([]*api.Team,
 	return result, nil
 }
 
+// isTeamOwner checks if the user owns the team, as only the owner can pin messages or delete the messages of other members
+func isTeamOwner(ctx context.Context, qtx *model.Queries, teamID uint64, userID uint64) (bool, error) {
+	owner, err := qtx.GetTeamOwner(ctx, teamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return owner.Valid && uint64(owner.Int64) == userID, nil
+}
+
 // Enum for errors
 type TeamRequestError string
 
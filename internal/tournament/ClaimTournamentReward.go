@@ -0,0 +1,77 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+)
+
+type ClaimTournamentRewardCommand struct {
+	service *Service
+	In      *api.ClaimTournamentRewardRequest
+	Out     *api.ClaimTournamentRewardResponse
+}
+
+func NewClaimTournamentRewardCommand(service *Service, in *api.ClaimTournamentRewardRequest) *ClaimTournamentRewardCommand {
+	return &ClaimTournamentRewardCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *ClaimTournamentRewardCommand) Execute(ctx context.Context) error {
+	if c.In.Id == 0 {
+		c.Out = &api.ClaimTournamentRewardResponse{
+			Success: false,
+			Error:   api.ClaimTournamentRewardResponse_ID_REQUIRED,
+		}
+		return nil
+	}
+	if c.In.UserId == 0 {
+		c.Out = &api.ClaimTournamentRewardResponse{
+			Success: false,
+			Error:   api.ClaimTournamentRewardResponse_USER_ID_REQUIRED,
+		}
+		return nil
+	}
+	result, err := c.service.database.ClaimTournamentReward(ctx, model.ClaimTournamentRewardParams{
+		ID:     c.In.Id,
+		UserID: c.In.UserId,
+	})
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		// Check if the reward does not exist or was already claimed
+		_, err = c.service.database.GetTournamentReward(ctx, model.GetTournamentRewardParams{
+			ID:     c.In.Id,
+			UserID: c.In.UserId,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Out = &api.ClaimTournamentRewardResponse{
+					Success: false,
+					Error:   api.ClaimTournamentRewardResponse_NOT_FOUND,
+				}
+				return nil
+			}
+			return err
+		}
+		c.Out = &api.ClaimTournamentRewardResponse{
+			Success: false,
+			Error:   api.ClaimTournamentRewardResponse_ALREADY_CLAIMED,
+		}
+		return nil
+	}
+	c.Out = &api.ClaimTournamentRewardResponse{
+		Success: true,
+		Error:   api.ClaimTournamentRewardResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestClaimTournamentRewardNoUserId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewClaimTournamentRewardCommand(service, &api.ClaimTournamentRewardRequest{
+		Id: 1,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.ClaimTournamentRewardResponse_USER_ID_REQUIRED {
+		t.Fatal("Expected error to be USER_ID_REQUIRED")
+	}
+}
+
+func TestClaimTournamentRewardSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectExec("UPDATE tournament_reward").WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	c := NewClaimTournamentRewardCommand(service, &api.ClaimTournamentRewardRequest{
+		Id:     1,
+		UserId: 2,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+}
+
+func TestClaimTournamentRewardNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectExec("UPDATE tournament_reward").WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_reward").WithArgs(1, 2).WillReturnError(sql.ErrNoRows)
+	c := NewClaimTournamentRewardCommand(service, &api.ClaimTournamentRewardRequest{
+		Id:     1,
+		UserId: 2,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.ClaimTournamentRewardResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
+
+func TestClaimTournamentRewardAlreadyClaimed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectExec("UPDATE tournament_reward").WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(0, 0))
+	rows := sqlmock.NewRows(tournamentReward).AddRow(1, 2, "test", "weekly", time.Now(), 2, 3, 100, []byte("{}"), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM tournament_reward").WithArgs(1, 2).WillReturnRows(rows)
+	c := NewClaimTournamentRewardCommand(service, &api.ClaimTournamentRewardRequest{
+		Id:     1,
+		UserId: 2,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.ClaimTournamentRewardResponse_ALREADY_CLAIMED {
+		t.Fatal("Expected error to be ALREADY_CLAIMED")
+	}
+}
@@ -0,0 +1,259 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
+	"github.com/MorhafAlshibly/coanda/pkg/tournament"
+	"github.com/go-sql-driver/mysql"
+)
+
+// maxTournamentBracketParticipants keeps the number of matches created in a single transaction reasonable
+const maxTournamentBracketParticipants = 1024
+
+type CreateTournamentBracketCommand struct {
+	service *Service
+	In      *api.CreateTournamentBracketRequest
+	Out     *api.CreateTournamentBracketResponse
+}
+
+func NewCreateTournamentBracketCommand(service *Service, in *api.CreateTournamentBracketRequest) *CreateTournamentBracketCommand {
+	return &CreateTournamentBracketCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *CreateTournamentBracketCommand) Execute(ctx context.Context) error {
+	if len(c.In.Name) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.CreateTournamentBracketResponse{
+			Success: false,
+			Error:   api.CreateTournamentBracketResponse_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	if len(c.In.Name) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.CreateTournamentBracketResponse{
+			Success: false,
+			Error:   api.CreateTournamentBracketResponse_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	if len(c.In.UserIds) < 2 {
+		c.Out = &api.CreateTournamentBracketResponse{
+			Success: false,
+			Error:   api.CreateTournamentBracketResponse_NOT_ENOUGH_PARTICIPANTS,
+		}
+		return nil
+	}
+	if len(c.In.UserIds) > maxTournamentBracketParticipants {
+		c.Out = &api.CreateTournamentBracketResponse{
+			Success: false,
+			Error:   api.CreateTournamentBracketResponse_TOO_MANY_PARTICIPANTS,
+		}
+		return nil
+	}
+	seen := make(map[uint64]bool, len(c.In.UserIds))
+	for _, userId := range c.In.UserIds {
+		if seen[userId] {
+			c.Out = &api.CreateTournamentBracketResponse{
+				Success: false,
+				Error:   api.CreateTournamentBracketResponse_DUPLICATE_PARTICIPANT,
+			}
+			return nil
+		}
+		seen[userId] = true
+	}
+	if c.In.Seeding == api.TournamentBracketSeeding_TOURNAMENT_SCORE && c.In.Tournament == nil {
+		c.Out = &api.CreateTournamentBracketResponse{
+			Success: false,
+			Error:   api.CreateTournamentBracketResponse_TOURNAMENT_REQUIRED,
+		}
+		return nil
+	}
+	if c.In.Data == nil {
+		c.Out = &api.CreateTournamentBracketResponse{
+			Success: false,
+			Error:   api.CreateTournamentBracketResponse_DATA_REQUIRED,
+		}
+		return nil
+	}
+	seedValues := map[uint64]int64{}
+	switch c.In.Seeding {
+	case api.TournamentBracketSeeding_TOURNAMENT_SCORE:
+		startedAt, tErr, err := c.service.getStartTime(ctx, *c.In.Tournament, c.In.Interval)
+		if err != nil {
+			return err
+		}
+		if tErr != nil {
+			c.Out = &api.CreateTournamentBracketResponse{
+				Success: false,
+				Error:   conversion.Enum(*tErr, api.CreateTournamentBracketResponse_Error_value, api.CreateTournamentBracketResponse_DEFINITION_NOT_FOUND),
+			}
+			return nil
+		}
+		scores, err := c.service.database.GetTournamentScores(ctx, model.GetTournamentScoresParams{
+			Name:                *c.In.Tournament,
+			TournamentInterval:  model.TournamentTournamentInterval(c.In.Interval.String()),
+			TournamentStartedAt: startedAt,
+			UserIds:             c.In.UserIds,
+		})
+		if err != nil {
+			return err
+		}
+		for _, score := range scores {
+			seedValues[score.UserID] = score.Score
+		}
+	case api.TournamentBracketSeeding_MATCHMAKING_ELO:
+		elos, err := c.service.database.GetMatchmakingUserElos(ctx, c.In.UserIds)
+		if err != nil {
+			return err
+		}
+		for _, elo := range elos {
+			seedValues[elo.ClientUserID] = elo.Elo
+		}
+	}
+	if c.In.MatchmakingArenaId != nil {
+		exists, err := c.service.matchmakingArenaExists(ctx, *c.In.MatchmakingArenaId)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			c.Out = &api.CreateTournamentBracketResponse{
+				Success: false,
+				Error:   api.CreateTournamentBracketResponse_ARENA_NOT_FOUND,
+			}
+			return nil
+		}
+	}
+	// Users are seeded from the highest score or elo, and users without one are seeded last in the order they were given
+	userIds := append([]uint64(nil), c.In.UserIds...)
+	sort.SliceStable(userIds, func(i, j int) bool {
+		first, firstOk := seedValues[userIds[i]]
+		second, secondOk := seedValues[userIds[j]]
+		if firstOk != secondOk {
+			return firstOk
+		}
+		return first > second
+	})
+	format := tournament.SingleElimination
+	if c.In.Format == api.TournamentBracketFormat_DOUBLE_ELIMINATION {
+		format = tournament.DoubleElimination
+	}
+	matches, err := tournament.NewBracket(format, len(userIds))
+	if err != nil {
+		return err
+	}
+	data, err := conversion.ProtobufStructToRawJson(c.In.Data)
+	if err != nil {
+		return err
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	result, err := qtx.CreateTournamentBracket(ctx, model.CreateTournamentBracketParams{
+		Name:               c.In.Name,
+		Format:             model.TournamentBracketFormat(c.In.Format.String()),
+		MatchmakingArenaID: conversion.Uint64ToSqlNullInt64(c.In.MatchmakingArenaId),
+		Data:               data,
+	})
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == errorcode.MySQLErrorCodeDuplicateEntry {
+			c.Out = &api.CreateTournamentBracketResponse{
+				Success: false,
+				Error:   api.CreateTournamentBracketResponse_ALREADY_EXISTS,
+			}
+			return nil
+		}
+		return err
+	}
+	bracketId, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	for i, userId := range userIds {
+		seedValue := sql.NullInt64{}
+		if value, ok := seedValues[userId]; ok {
+			seedValue = sql.NullInt64{Int64: value, Valid: true}
+		}
+		_, err = qtx.CreateTournamentBracketParticipant(ctx, model.CreateTournamentBracketParticipantParams{
+			TournamentBracketID: uint64(bracketId),
+			UserID:              userId,
+			Seed:                uint32(i + 1),
+			SeedValue:           seedValue,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	// Matches are created from the last, so the matches their winners and losers move to already exist
+	matchIds := make([]uint64, len(matches))
+	matchmakingMatches := []bracketMatchmakingMatch{}
+	for i := len(matches) - 1; i >= 0; i-- {
+		match := matches[i]
+		params := model.CreateTournamentBracketMatchParams{
+			TournamentBracketID: uint64(bracketId),
+			Side:                bracketSides[match.Side],
+			Round:               match.Round,
+			Position:            match.Position,
+		}
+		if match.Seeds[0] != 0 {
+			params.FirstUserID = sql.NullInt64{Int64: int64(userIds[match.Seeds[0]-1]), Valid: true}
+		}
+		if match.Seeds[1] != 0 {
+			params.SecondUserID = sql.NullInt64{Int64: int64(userIds[match.Seeds[1]-1]), Valid: true}
+		}
+		if match.WinnerTo != nil {
+			params.WinnerMatchID = sql.NullInt64{Int64: int64(matchIds[match.WinnerTo.Match]), Valid: true}
+			params.WinnerMatchSlot = sql.NullInt32{Int32: int32(match.WinnerTo.Slot), Valid: true}
+		}
+		if match.LoserTo != nil {
+			params.LoserMatchID = sql.NullInt64{Int64: int64(matchIds[match.LoserTo.Match]), Valid: true}
+			params.LoserMatchSlot = sql.NullInt32{Int32: int32(match.LoserTo.Slot), Valid: true}
+		}
+		result, err := qtx.CreateTournamentBracketMatch(ctx, params)
+		if err != nil {
+			return err
+		}
+		matchId, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		matchIds[i] = uint64(matchId)
+		if c.In.MatchmakingArenaId != nil && params.FirstUserID.Valid && params.SecondUserID.Valid {
+			matchmakingMatches = append(matchmakingMatches, bracketMatchmakingMatch{
+				bracketId:    uint64(bracketId),
+				matchId:      uint64(matchId),
+				firstUserId:  uint64(params.FirstUserID.Int64),
+				secondUserId: uint64(params.SecondUserID.Int64),
+			})
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	if c.In.MatchmakingArenaId != nil {
+		err = c.service.createBracketMatchmakingMatches(ctx, *c.In.MatchmakingArenaId, matchmakingMatches)
+		if err != nil {
+			return err
+		}
+	}
+	id := uint64(bracketId)
+	c.Out = &api.CreateTournamentBracketResponse{
+		Success: true,
+		Id:      &id,
+		Error:   api.CreateTournamentBracketResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// fakeMatchmakingClient answers the matchmaking calls made for brackets, and panics on any other call
+type fakeMatchmakingClient struct {
+	api.MatchmakingServiceClient
+	arenaIds       []uint64
+	matches        []*api.CreateMatchRequest
+	deletedMatches []uint64
+}
+
+func (f *fakeMatchmakingClient) GetArena(ctx context.Context, in *api.ArenaRequest, opts ...grpc.CallOption) (*api.GetArenaResponse, error) {
+	for _, id := range f.arenaIds {
+		if id == *in.Id {
+			return &api.GetArenaResponse{Success: true, Arena: &api.Arena{Id: id}}, nil
+		}
+	}
+	return &api.GetArenaResponse{Success: false, Error: api.GetArenaResponse_NOT_FOUND}, nil
+}
+
+func (f *fakeMatchmakingClient) CreateMatch(ctx context.Context, in *api.CreateMatchRequest, opts ...grpc.CallOption) (*api.CreateMatchResponse, error) {
+	f.matches = append(f.matches, in)
+	return &api.CreateMatchResponse{Success: true, Id: conversion.ValueToPointer(uint64(len(f.matches) + 8)), Error: api.CreateMatchResponse_NONE}, nil
+}
+
+func (f *fakeMatchmakingClient) DeleteMatch(ctx context.Context, in *api.MatchRequest, opts ...grpc.CallOption) (*api.DeleteMatchResponse, error) {
+	f.deletedMatches = append(f.deletedMatches, *in.Id)
+	return &api.DeleteMatchResponse{Success: true, Error: api.DeleteMatchResponse_NONE}, nil
+}
+
+func TestCreateTournamentBracketNotEnoughParticipants(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTournamentNameLength(3))
+	c := NewCreateTournamentBracketCommand(service, &api.CreateTournamentBracketRequest{
+		Name:    "test",
+		UserIds: []uint64{1},
+		Data:    &structpb.Struct{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentBracketResponse_NOT_ENOUGH_PARTICIPANTS {
+		t.Fatal("Expected error to be NOT_ENOUGH_PARTICIPANTS")
+	}
+}
+
+func TestCreateTournamentBracketDuplicateParticipant(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTournamentNameLength(3))
+	c := NewCreateTournamentBracketCommand(service, &api.CreateTournamentBracketRequest{
+		Name:    "test",
+		UserIds: []uint64{1, 2, 1},
+		Data:    &structpb.Struct{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentBracketResponse_DUPLICATE_PARTICIPANT {
+		t.Fatal("Expected error to be DUPLICATE_PARTICIPANT")
+	}
+}
+
+func TestCreateTournamentBracketTournamentScoreNoTournament(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTournamentNameLength(3))
+	c := NewCreateTournamentBracketCommand(service, &api.CreateTournamentBracketRequest{
+		Name:    "test",
+		UserIds: []uint64{1, 2},
+		Seeding: api.TournamentBracketSeeding_TOURNAMENT_SCORE,
+		Data:    &structpb.Struct{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentBracketResponse_TOURNAMENT_REQUIRED {
+		t.Fatal("Expected error to be TOURNAMENT_REQUIRED")
+	}
+}
+
+func TestCreateTournamentBracketArenaNotFound(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTournamentNameLength(3), WithMatchmakingClient(&fakeMatchmakingClient{}))
+	arenaId := uint64(1)
+	c := NewCreateTournamentBracketCommand(service, &api.CreateTournamentBracketRequest{
+		Name:               "test",
+		UserIds:            []uint64{1, 2},
+		MatchmakingArenaId: &arenaId,
+		Data:               &structpb.Struct{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentBracketResponse_ARENA_NOT_FOUND {
+		t.Fatal("Expected error to be ARENA_NOT_FOUND")
+	}
+}
+
+func TestCreateTournamentBracketSeededByElo(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTournamentNameLength(3))
+	// User 2 has the higher elo and user 3 has none, so they are seeded 2, 1, 3
+	mock.ExpectQuery("SELECT (.+) FROM matchmaking_user").WithArgs(1, 2, 3).WillReturnRows(sqlmock.NewRows([]string{"client_user_id", "elo"}).AddRow(1, 1000).AddRow(2, 1200))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO tournament_bracket").WithArgs("test", "SINGLE_ELIMINATION", nil, []byte("{}")).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO tournament_bracket_participant").WithArgs(1, 2, 1, 1200).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO tournament_bracket_participant").WithArgs(1, 1, 2, 1000).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO tournament_bracket_participant").WithArgs(1, 3, 3, nil).WillReturnResult(sqlmock.NewResult(0, 1))
+	// The final is created first, with seed 1 waiting for the winner of seeds 2 and 3
+	mock.ExpectExec("INSERT INTO tournament_bracket_match").WithArgs(1, "winners", 2, 0, 2, nil, nil, nil, nil, nil).WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectExec("INSERT INTO tournament_bracket_match").WithArgs(1, "winners", 1, 1, 1, 3, 2, 1, nil, nil).WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.ExpectCommit()
+	c := NewCreateTournamentBracketCommand(service, &api.CreateTournamentBracketRequest{
+		Name:    "test",
+		UserIds: []uint64{1, 2, 3},
+		Seeding: api.TournamentBracketSeeding_MATCHMAKING_ELO,
+		Data:    &structpb.Struct{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if *c.Out.Id != 1 {
+		t.Fatal("Expected id to be 1")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateTournamentBracketWithArenaCreatesMatchmakingMatches(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	matchmakingClient := &fakeMatchmakingClient{arenaIds: []uint64{4}}
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTournamentNameLength(3), WithMatchmakingClient(matchmakingClient))
+	arenaId := uint64(4)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO tournament_bracket").WithArgs("test", "SINGLE_ELIMINATION", 4, []byte("{}")).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO tournament_bracket_participant").WithArgs(1, 1, 1, nil).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO tournament_bracket_participant").WithArgs(1, 2, 2, nil).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO tournament_bracket_match").WithArgs(1, "winners", 1, 0, 1, 2, nil, nil, nil, nil).WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec("UPDATE tournament_bracket_match SET matchmaking_match_id").WithArgs(9, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	c := NewCreateTournamentBracketCommand(service, &api.CreateTournamentBracketRequest{
+		Name:               "test",
+		UserIds:            []uint64{1, 2},
+		MatchmakingArenaId: &arenaId,
+		Data:               &structpb.Struct{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(matchmakingClient.matches) != 1 || *matchmakingClient.matches[0].Arena.Id != 4 {
+		t.Fatal("Expected a matchmaking match to be created in the arena")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateTournamentBracketWithArenaMatchNotRecordedDeletesMatchmakingMatch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	matchmakingClient := &fakeMatchmakingClient{arenaIds: []uint64{4}}
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTournamentNameLength(3), WithMatchmakingClient(matchmakingClient))
+	arenaId := uint64(4)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO tournament_bracket").WithArgs("test", "SINGLE_ELIMINATION", 4, []byte("{}")).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO tournament_bracket_participant").WithArgs(1, 1, 1, nil).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO tournament_bracket_participant").WithArgs(1, 2, 2, nil).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO tournament_bracket_match").WithArgs(1, "winners", 1, 0, 1, 2, nil, nil, nil, nil).WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec("UPDATE tournament_bracket_match SET matchmaking_match_id").WithArgs(9, 2).WillReturnError(sql.ErrConnDone)
+	c := NewCreateTournamentBracketCommand(service, &api.CreateTournamentBracketRequest{
+		Name:               "test",
+		UserIds:            []uint64{1, 2},
+		MatchmakingArenaId: &arenaId,
+		Data:               &structpb.Struct{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if len(matchmakingClient.deletedMatches) != 1 || matchmakingClient.deletedMatches[0] != 9 {
+		t.Fatal("Expected the matchmaking match to be deleted")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
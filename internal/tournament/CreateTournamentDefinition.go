@@ -0,0 +1,129 @@
+package tournament
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
+	"github.com/MorhafAlshibly/coanda/pkg/tournament"
+	"github.com/go-sql-driver/mysql"
+)
+
+type CreateTournamentDefinitionCommand struct {
+	service *Service
+	In      *api.CreateTournamentDefinitionRequest
+	Out     *api.CreateTournamentDefinitionResponse
+}
+
+func NewCreateTournamentDefinitionCommand(service *Service, in *api.CreateTournamentDefinitionRequest) *CreateTournamentDefinitionCommand {
+	return &CreateTournamentDefinitionCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *CreateTournamentDefinitionCommand) Execute(ctx context.Context) error {
+	// Check if tournament name is large enough
+	if len(c.In.Name) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.CreateTournamentDefinitionResponse{
+			Success: false,
+			Error:   api.CreateTournamentDefinitionResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	// Check if tournament name is small enough
+	if len(c.In.Name) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.CreateTournamentDefinitionResponse{
+			Success: false,
+			Error:   api.CreateTournamentDefinitionResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	// A definition needs a cron schedule, a duration, or both
+	if c.In.CronSchedule == nil && (c.In.DurationSeconds == nil || *c.In.DurationSeconds == 0) {
+		c.Out = &api.CreateTournamentDefinitionResponse{
+			Success: false,
+			Error:   api.CreateTournamentDefinitionResponse_SCHEDULE_REQUIRED,
+		}
+		return nil
+	}
+	if c.In.CronSchedule != nil {
+		_, err := tournament.ParseCronSchedule(*c.In.CronSchedule)
+		if err != nil {
+			c.Out = &api.CreateTournamentDefinitionResponse{
+				Success: false,
+				Error:   api.CreateTournamentDefinitionResponse_INVALID_CRON_SCHEDULE,
+			}
+			return nil
+		}
+	}
+	timeZone := conversion.PointerToValue(c.In.TimeZone, "UTC")
+	_, err := time.LoadLocation(timeZone)
+	if err != nil || timeZone == "" || timeZone == "Local" {
+		c.Out = &api.CreateTournamentDefinitionResponse{
+			Success: false,
+			Error:   api.CreateTournamentDefinitionResponse_INVALID_TIME_ZONE,
+		}
+		return nil
+	}
+	if c.In.StartsAt == nil {
+		c.Out = &api.CreateTournamentDefinitionResponse{
+			Success: false,
+			Error:   api.CreateTournamentDefinitionResponse_STARTS_AT_REQUIRED,
+		}
+		return nil
+	}
+	if c.In.EndsAt != nil && !c.In.EndsAt.AsTime().After(c.In.StartsAt.AsTime()) {
+		c.Out = &api.CreateTournamentDefinitionResponse{
+			Success: false,
+			Error:   api.CreateTournamentDefinitionResponse_ENDS_AT_BEFORE_STARTS_AT,
+		}
+		return nil
+	}
+	// Check if data is provided
+	if c.In.Data == nil {
+		c.Out = &api.CreateTournamentDefinitionResponse{
+			Success: false,
+			Error:   api.CreateTournamentDefinitionResponse_DATA_REQUIRED,
+		}
+		return nil
+	}
+	raw, err := conversion.ProtobufStructToRawJson(c.In.Data)
+	if err != nil {
+		return err
+	}
+	result, err := c.service.database.CreateTournamentDefinition(ctx, model.CreateTournamentDefinitionParams{
+		Name:            c.In.Name,
+		CronSchedule:    conversion.StringToSqlNullString(c.In.CronSchedule),
+		DurationSeconds: conversion.Uint64ToSqlNullInt64(c.In.DurationSeconds),
+		StartsAt:        c.In.StartsAt.AsTime(),
+		EndsAt:          conversion.TimestampToSqlNullTime(c.In.EndsAt),
+		TimeZone:        timeZone,
+		Data:            raw,
+	})
+	if err != nil {
+		var mysqlError *mysql.MySQLError
+		if errors.As(err, &mysqlError) && mysqlError.Number == errorcode.MySQLErrorCodeDuplicateEntry {
+			c.Out = &api.CreateTournamentDefinitionResponse{
+				Success: false,
+				Error:   api.CreateTournamentDefinitionResponse_ALREADY_EXISTS,
+			}
+			return nil
+		}
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.CreateTournamentDefinitionResponse{
+		Success: true,
+		Id:      conversion.ValueToPointer(uint64(id)),
+		Error:   api.CreateTournamentDefinitionResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package tournament
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateTournamentDefinitionTournamentNameTooShort(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateTournamentDefinitionCommand(service, &api.CreateTournamentDefinitionRequest{
+		Name: "t",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentDefinitionResponse_TOURNAMENT_NAME_TOO_SHORT {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_SHORT")
+	}
+}
+
+func TestCreateTournamentDefinitionNoSchedule(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateTournamentDefinitionCommand(service, &api.CreateTournamentDefinitionRequest{
+		Name:            "test",
+		DurationSeconds: conversion.ValueToPointer(uint64(0)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentDefinitionResponse_SCHEDULE_REQUIRED {
+		t.Fatal("Expected error to be SCHEDULE_REQUIRED")
+	}
+}
+
+func TestCreateTournamentDefinitionInvalidCronSchedule(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateTournamentDefinitionCommand(service, &api.CreateTournamentDefinitionRequest{
+		Name:         "test",
+		CronSchedule: conversion.ValueToPointer("every friday"),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentDefinitionResponse_INVALID_CRON_SCHEDULE {
+		t.Fatal("Expected error to be INVALID_CRON_SCHEDULE")
+	}
+}
+
+func TestCreateTournamentDefinitionInvalidTimeZone(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateTournamentDefinitionCommand(service, &api.CreateTournamentDefinitionRequest{
+		Name:         "test",
+		CronSchedule: conversion.ValueToPointer("0 18 * * FRI"),
+		TimeZone:     conversion.ValueToPointer("Mars/Olympus_Mons"),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentDefinitionResponse_INVALID_TIME_ZONE {
+		t.Fatal("Expected error to be INVALID_TIME_ZONE")
+	}
+}
+
+func TestCreateTournamentDefinitionEndsAtBeforeStartsAt(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateTournamentDefinitionCommand(service, &api.CreateTournamentDefinitionRequest{
+		Name:            "test",
+		DurationSeconds: conversion.ValueToPointer(uint64(3600)),
+		StartsAt:        timestamppb.New(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
+		EndsAt:          timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentDefinitionResponse_ENDS_AT_BEFORE_STARTS_AT {
+		t.Fatal("Expected error to be ENDS_AT_BEFORE_STARTS_AT")
+	}
+}
+
+func TestCreateTournamentDefinitionSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	startsAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectExec("INSERT INTO tournament_definition").WithArgs("test", "0 18 * * FRI", 172800, startsAt, nil, "Europe/London", raw).WillReturnResult(sqlmock.NewResult(1, 1))
+	c := NewCreateTournamentDefinitionCommand(service, &api.CreateTournamentDefinitionRequest{
+		Name:            "test",
+		CronSchedule:    conversion.ValueToPointer("0 18 * * FRI"),
+		DurationSeconds: conversion.ValueToPointer(uint64(172800)),
+		StartsAt:        timestamppb.New(startsAt),
+		TimeZone:        conversion.ValueToPointer("Europe/London"),
+		Data:            data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if *c.Out.Id != 1 {
+		t.Fatal("Expected id to be 1")
+	}
+}
+
+func TestCreateTournamentDefinitionAlreadyExists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectExec("INSERT INTO tournament_definition").WillReturnError(&mysql.MySQLError{Number: errorcode.MySQLErrorCodeDuplicateEntry})
+	c := NewCreateTournamentDefinitionCommand(service, &api.CreateTournamentDefinitionRequest{
+		Name:            "test",
+		DurationSeconds: conversion.ValueToPointer(uint64(3600)),
+		StartsAt:        timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Data:            data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentDefinitionResponse_ALREADY_EXISTS {
+		t.Fatal("Expected error to be ALREADY_EXISTS")
+	}
+}
@@ -0,0 +1,97 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type CreateTournamentRewardTierCommand struct {
+	service *Service
+	In      *api.CreateTournamentRewardTierRequest
+	Out     *api.CreateTournamentRewardTierResponse
+}
+
+func NewCreateTournamentRewardTierCommand(service *Service, in *api.CreateTournamentRewardTierRequest) *CreateTournamentRewardTierCommand {
+	return &CreateTournamentRewardTierCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *CreateTournamentRewardTierCommand) Execute(ctx context.Context) error {
+	// Check if tournament name is large enough
+	if len(c.In.Tournament) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.CreateTournamentRewardTierResponse{
+			Success: false,
+			Error:   api.CreateTournamentRewardTierResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	// Check if tournament name is small enough
+	if len(c.In.Tournament) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.CreateTournamentRewardTierResponse{
+			Success: false,
+			Error:   api.CreateTournamentRewardTierResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	// A tier is either a band of rankings or a top percentage, but not both
+	hasRanking := c.In.MinRanking != nil || c.In.MaxRanking != nil
+	if hasRanking == (c.In.TopPercentage != nil) {
+		c.Out = &api.CreateTournamentRewardTierResponse{
+			Success: false,
+			Error:   api.CreateTournamentRewardTierResponse_RANKING_OR_PERCENTAGE_REQUIRED,
+		}
+		return nil
+	}
+	if hasRanking && (c.In.MinRanking == nil || c.In.MaxRanking == nil || *c.In.MinRanking == 0 || *c.In.MaxRanking < *c.In.MinRanking) {
+		c.Out = &api.CreateTournamentRewardTierResponse{
+			Success: false,
+			Error:   api.CreateTournamentRewardTierResponse_INVALID_RANKING,
+		}
+		return nil
+	}
+	if c.In.TopPercentage != nil && (*c.In.TopPercentage <= 0 || *c.In.TopPercentage > 100) {
+		c.Out = &api.CreateTournamentRewardTierResponse{
+			Success: false,
+			Error:   api.CreateTournamentRewardTierResponse_INVALID_PERCENTAGE,
+		}
+		return nil
+	}
+	// Check if data is provided
+	if c.In.Data == nil {
+		c.Out = &api.CreateTournamentRewardTierResponse{
+			Success: false,
+			Error:   api.CreateTournamentRewardTierResponse_DATA_REQUIRED,
+		}
+		return nil
+	}
+	raw, err := conversion.ProtobufStructToRawJson(c.In.Data)
+	if err != nil {
+		return err
+	}
+	result, err := c.service.database.CreateTournamentRewardTier(ctx, model.CreateTournamentRewardTierParams{
+		Name:               c.In.Tournament,
+		TournamentInterval: model.TournamentRewardTierTournamentInterval(c.In.Interval.String()),
+		MinRanking:         conversion.Uint64ToSqlNullInt64(c.In.MinRanking),
+		MaxRanking:         conversion.Uint64ToSqlNullInt64(c.In.MaxRanking),
+		TopPercentage:      conversion.Float64ToSqlNullFloat64(c.In.TopPercentage),
+		Data:               raw,
+	})
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.CreateTournamentRewardTierResponse{
+		Success: true,
+		Id:      conversion.ValueToPointer(uint64(id)),
+		Error:   api.CreateTournamentRewardTierResponse_NONE,
+	}
+	return nil
+}
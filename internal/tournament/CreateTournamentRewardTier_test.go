@@ -0,0 +1,126 @@
+package tournament
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestCreateTournamentRewardTierRankingAndPercentage(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateTournamentRewardTierCommand(service, &api.CreateTournamentRewardTierRequest{
+		Tournament:    "test",
+		MinRanking:    conversion.ValueToPointer(uint64(1)),
+		MaxRanking:    conversion.ValueToPointer(uint64(10)),
+		TopPercentage: conversion.ValueToPointer(float64(10)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentRewardTierResponse_RANKING_OR_PERCENTAGE_REQUIRED {
+		t.Fatal("Expected error to be RANKING_OR_PERCENTAGE_REQUIRED")
+	}
+}
+
+func TestCreateTournamentRewardTierMaxRankingBeforeMinRanking(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateTournamentRewardTierCommand(service, &api.CreateTournamentRewardTierRequest{
+		Tournament: "test",
+		MinRanking: conversion.ValueToPointer(uint64(10)),
+		MaxRanking: conversion.ValueToPointer(uint64(1)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentRewardTierResponse_INVALID_RANKING {
+		t.Fatal("Expected error to be INVALID_RANKING")
+	}
+}
+
+func TestCreateTournamentRewardTierInvalidPercentage(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateTournamentRewardTierCommand(service, &api.CreateTournamentRewardTierRequest{
+		Tournament:    "test",
+		TopPercentage: conversion.ValueToPointer(float64(150)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentRewardTierResponse_INVALID_PERCENTAGE {
+		t.Fatal("Expected error to be INVALID_PERCENTAGE")
+	}
+}
+
+func TestCreateTournamentRewardTierSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{"gold": 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectExec("INSERT INTO tournament_reward_tier").WithArgs("test", "WEEKLY", nil, nil, float64(10), raw).WillReturnResult(sqlmock.NewResult(2, 1))
+	c := NewCreateTournamentRewardTierCommand(service, &api.CreateTournamentRewardTierRequest{
+		Tournament:    "test",
+		Interval:      api.TournamentInterval_WEEKLY,
+		TopPercentage: conversion.ValueToPointer(float64(10)),
+		Data:          data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if *c.Out.Id != 2 {
+		t.Fatal("Expected id to be 2")
+	}
+}
@@ -0,0 +1,228 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
+	"github.com/MorhafAlshibly/coanda/pkg/tournament"
+	"github.com/go-sql-driver/mysql"
+)
+
+// maxTournamentRoundParticipants keeps the number of pairings created in a single transaction reasonable
+const maxTournamentRoundParticipants = 1024
+
+type CreateTournamentRoundCommand struct {
+	service *Service
+	In      *api.CreateTournamentRoundRequest
+	Out     *api.CreateTournamentRoundResponse
+}
+
+func NewCreateTournamentRoundCommand(service *Service, in *api.CreateTournamentRoundRequest) *CreateTournamentRoundCommand {
+	return &CreateTournamentRoundCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *CreateTournamentRoundCommand) Execute(ctx context.Context) error {
+	if len(c.In.Tournament) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.CreateTournamentRoundResponse{
+			Success: false,
+			Error:   api.CreateTournamentRoundResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	if len(c.In.Tournament) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.CreateTournamentRoundResponse{
+			Success: false,
+			Error:   api.CreateTournamentRoundResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	startedAt, tErr, err := c.service.getStartTime(ctx, c.In.Tournament, c.In.Interval)
+	if err != nil {
+		return err
+	}
+	if tErr != nil {
+		c.Out = &api.CreateTournamentRoundResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.CreateTournamentRoundResponse_Error_value, api.CreateTournamentRoundResponse_DEFINITION_NOT_FOUND),
+		}
+		return nil
+	}
+	format := model.TournamentPairingFormatSwiss
+	if c.In.Format == api.TournamentPairingFormat_ROUND_ROBIN {
+		format = model.TournamentPairingFormatRoundRobin
+	}
+	pairingsParams := model.GetTournamentPairingsParams{
+		Name:                c.In.Tournament,
+		TournamentInterval:  model.TournamentPairingTournamentInterval(c.In.Interval.String()),
+		TournamentStartedAt: startedAt,
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	previous, err := qtx.GetTournamentPairings(ctx, pairingsParams)
+	if err != nil {
+		return err
+	}
+	round := uint32(1)
+	if len(previous) > 0 {
+		if previous[0].Format != format {
+			c.Out = &api.CreateTournamentRoundResponse{
+				Success: false,
+				Error:   api.CreateTournamentRoundResponse_FORMAT_MISMATCH,
+			}
+			return nil
+		}
+		for _, pairing := range previous {
+			if !pairing.CompletedAt.Valid {
+				c.Out = &api.CreateTournamentRoundResponse{
+					Success: false,
+					Error:   api.CreateTournamentRoundResponse_ROUND_NOT_COMPLETE,
+				}
+				return nil
+			}
+		}
+		round = previous[len(previous)-1].Round + 1
+	}
+	var pairings []tournament.Pairing
+	if format == model.TournamentPairingFormatRoundRobin && round > 1 {
+		// The schedule is fixed by the users of the first round, so users that join later are not added
+		participants := []uint64{}
+		for _, pairing := range previous {
+			if pairing.Round != 1 {
+				break
+			}
+			participants = append(participants, pairing.FirstUserID)
+			if pairing.SecondUserID.Valid {
+				participants = append(participants, uint64(pairing.SecondUserID.Int64))
+			}
+		}
+		sort.Slice(participants, func(i, j int) bool { return participants[i] < participants[j] })
+		rounds, err := tournament.RoundRobinRounds(participants)
+		if err != nil {
+			return err
+		}
+		if int(round) > len(rounds) {
+			c.Out = &api.CreateTournamentRoundResponse{
+				Success: false,
+				Error:   api.CreateTournamentRoundResponse_ALL_ROUNDS_PLAYED,
+			}
+			return nil
+		}
+		pairings = rounds[round-1]
+	} else {
+		// Users are seeded by their score in the tournament
+		participants, err := qtx.GetTournamentUserIds(ctx, model.GetTournamentUserIdsParams{
+			Name:                c.In.Tournament,
+			TournamentInterval:  model.TournamentTournamentInterval(c.In.Interval.String()),
+			TournamentStartedAt: startedAt,
+		})
+		if err != nil {
+			return err
+		}
+		if len(participants) > maxTournamentRoundParticipants {
+			c.Out = &api.CreateTournamentRoundResponse{
+				Success: false,
+				Error:   api.CreateTournamentRoundResponse_TOO_MANY_PARTICIPANTS,
+			}
+			return nil
+		}
+		if format == model.TournamentPairingFormatRoundRobin {
+			sort.Slice(participants, func(i, j int) bool { return participants[i] < participants[j] })
+			var rounds [][]tournament.Pairing
+			rounds, err = tournament.RoundRobinRounds(participants)
+			if err == nil {
+				pairings = rounds[0]
+			}
+		} else {
+			pairings, err = tournament.SwissPairings(participants, convertTournamentPairingsToGames(previous))
+		}
+		if err == tournament.ErrNotEnoughParticipants {
+			c.Out = &api.CreateTournamentRoundResponse{
+				Success: false,
+				Error:   api.CreateTournamentRoundResponse_NOT_ENOUGH_PARTICIPANTS,
+			}
+			return nil
+		}
+		if err == tournament.ErrNoPairing {
+			c.Out = &api.CreateTournamentRoundResponse{
+				Success: false,
+				Error:   api.CreateTournamentRoundResponse_NO_PAIRING_POSSIBLE,
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for position, pairing := range pairings {
+		result, err := qtx.CreateTournamentPairing(ctx, model.CreateTournamentPairingParams{
+			Name:                c.In.Tournament,
+			TournamentInterval:  model.TournamentPairingTournamentInterval(c.In.Interval.String()),
+			TournamentStartedAt: startedAt,
+			Format:              format,
+			Round:               round,
+			Position:            uint32(position),
+			FirstUserID:         pairing.First,
+			SecondUserID:        sql.NullInt64{Int64: int64(pairing.Second), Valid: pairing.Second != 0},
+		})
+		if err != nil {
+			// Another round was created at the same time
+			var mysqlErr *mysql.MySQLError
+			if errors.As(err, &mysqlErr) && mysqlErr.Number == errorcode.MySQLErrorCodeDuplicateEntry {
+				c.Out = &api.CreateTournamentRoundResponse{
+					Success: false,
+					Error:   api.CreateTournamentRoundResponse_ROUND_NOT_COMPLETE,
+				}
+				return nil
+			}
+			return err
+		}
+		// A bye is won without playing
+		if pairing.Second == 0 {
+			id, err := result.LastInsertId()
+			if err != nil {
+				return err
+			}
+			_, err = qtx.RecordTournamentPairingResult(ctx, model.RecordTournamentPairingResultParams{
+				Result: model.NullTournamentPairingResult{TournamentPairingResult: model.TournamentPairingResultFirstWon, Valid: true},
+				ID:     uint64(id),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	created, err := qtx.GetTournamentPairings(ctx, pairingsParams)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	apiPairings := make([]*api.TournamentPairing, 0, len(pairings))
+	for _, pairing := range created {
+		if pairing.Round == round {
+			apiPairings = append(apiPairings, unmarshalTournamentPairing(&pairing))
+		}
+	}
+	c.Out = &api.CreateTournamentRoundResponse{
+		Success:  true,
+		Round:    &round,
+		Pairings: apiPairings,
+		Error:    api.CreateTournamentRoundResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+var tournamentPairing = []string{"id", "name", "tournament_interval", "tournament_started_at", "format", "round", "position", "first_user_id", "second_user_id", "result", "completed_at", "created_at", "updated_at"}
+
+func TestCreateTournamentRoundNameTooShort(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTournamentNameLength(5))
+	c := NewCreateTournamentRoundCommand(service, &api.CreateTournamentRoundRequest{
+		Tournament: "a",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentRoundResponse_TOURNAMENT_NAME_TOO_SHORT {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_SHORT")
+	}
+}
+
+func TestCreateTournamentRoundNotComplete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	startedAt := time.Now().Truncate(24 * time.Hour).UTC()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_pairing").WithArgs("test", "DAILY", startedAt).WillReturnRows(sqlmock.NewRows(tournamentPairing).
+		AddRow(1, "test", "daily", startedAt, "swiss", 1, 0, 1, 2, nil, nil, time.Now(), time.Now()))
+	mock.ExpectRollback()
+	c := NewCreateTournamentRoundCommand(service, &api.CreateTournamentRoundRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		Format:     api.TournamentPairingFormat_SWISS,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentRoundResponse_ROUND_NOT_COMPLETE {
+		t.Fatal("Expected error to be ROUND_NOT_COMPLETE")
+	}
+}
+
+func TestCreateTournamentRoundFormatMismatch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	startedAt := time.Now().Truncate(24 * time.Hour).UTC()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_pairing").WithArgs("test", "DAILY", startedAt).WillReturnRows(sqlmock.NewRows(tournamentPairing).
+		AddRow(1, "test", "daily", startedAt, "swiss", 1, 0, 1, 2, "draw", time.Now(), time.Now(), time.Now()))
+	mock.ExpectRollback()
+	c := NewCreateTournamentRoundCommand(service, &api.CreateTournamentRoundRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		Format:     api.TournamentPairingFormat_ROUND_ROBIN,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentRoundResponse_FORMAT_MISMATCH {
+		t.Fatal("Expected error to be FORMAT_MISMATCH")
+	}
+}
+
+func TestCreateTournamentRoundNotEnoughParticipants(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	startedAt := time.Now().Truncate(24 * time.Hour).UTC()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_pairing").WithArgs("test", "DAILY", startedAt).WillReturnRows(sqlmock.NewRows(tournamentPairing))
+	mock.ExpectQuery("SELECT user_id FROM tournament").WithArgs("test", "DAILY", startedAt).WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1))
+	mock.ExpectRollback()
+	c := NewCreateTournamentRoundCommand(service, &api.CreateTournamentRoundRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		Format:     api.TournamentPairingFormat_ROUND_ROBIN,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentRoundResponse_NOT_ENOUGH_PARTICIPANTS {
+		t.Fatal("Expected error to be NOT_ENOUGH_PARTICIPANTS")
+	}
+}
+
+func TestCreateTournamentRoundTooManyParticipants(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	startedAt := time.Now().Truncate(24 * time.Hour).UTC()
+	userIds := sqlmock.NewRows([]string{"user_id"})
+	for userId := 1; userId <= maxTournamentRoundParticipants+1; userId++ {
+		userIds.AddRow(userId)
+	}
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_pairing").WithArgs("test", "DAILY", startedAt).WillReturnRows(sqlmock.NewRows(tournamentPairing))
+	mock.ExpectQuery("SELECT user_id FROM tournament").WithArgs("test", "DAILY", startedAt).WillReturnRows(userIds)
+	mock.ExpectRollback()
+	c := NewCreateTournamentRoundCommand(service, &api.CreateTournamentRoundRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		Format:     api.TournamentPairingFormat_SWISS,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentRoundResponse_TOO_MANY_PARTICIPANTS {
+		t.Fatal("Expected error to be TOO_MANY_PARTICIPANTS")
+	}
+}
+
+func TestCreateTournamentRoundSwissFirstRoundWithBye(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	startedAt := time.Now().Truncate(24 * time.Hour).UTC()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_pairing").WithArgs("test", "DAILY", startedAt).WillReturnRows(sqlmock.NewRows(tournamentPairing))
+	mock.ExpectQuery("SELECT user_id FROM tournament").WithArgs("test", "DAILY", startedAt).WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(3).AddRow(1).AddRow(2))
+	// The user with the highest score plays the user halfway down, and the lowest user gets a bye
+	mock.ExpectExec("INSERT INTO tournament_pairing").WithArgs("test", "DAILY", startedAt, "swiss", 1, 0, 3, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO tournament_pairing").WithArgs("test", "DAILY", startedAt, "swiss", 1, 1, 2, nil).WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectExec("UPDATE tournament_pairing").WithArgs("first_won", 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_pairing").WithArgs("test", "DAILY", startedAt).WillReturnRows(sqlmock.NewRows(tournamentPairing).
+		AddRow(1, "test", "daily", startedAt, "swiss", 1, 0, 3, 1, nil, nil, time.Now(), time.Now()).
+		AddRow(2, "test", "daily", startedAt, "swiss", 1, 1, 2, nil, "first_won", time.Now(), time.Now(), time.Now()))
+	mock.ExpectCommit()
+	c := NewCreateTournamentRoundCommand(service, &api.CreateTournamentRoundRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		Format:     api.TournamentPairingFormat_SWISS,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if *c.Out.Round != 1 {
+		t.Fatal("Expected round to be 1")
+	}
+	if len(c.Out.Pairings) != 2 {
+		t.Fatal("Expected 2 pairings")
+	}
+	if c.Out.Pairings[1].SecondUserId != nil || *c.Out.Pairings[1].Result != api.TournamentPairingResult_FIRST_WON {
+		t.Fatal("Expected second pairing to be a bye won by the first user")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateTournamentRoundRoundRobinAllRoundsPlayed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	startedAt := time.Now().Truncate(24 * time.Hour).UTC()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_pairing").WithArgs("test", "DAILY", startedAt).WillReturnRows(sqlmock.NewRows(tournamentPairing).
+		AddRow(1, "test", "daily", startedAt, "round_robin", 1, 0, 1, 2, "first_won", time.Now(), time.Now(), time.Now()))
+	mock.ExpectRollback()
+	c := NewCreateTournamentRoundCommand(service, &api.CreateTournamentRoundRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		Format:     api.TournamentPairingFormat_ROUND_ROBIN,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentRoundResponse_ALL_ROUNDS_PLAYED {
+		t.Fatal("Expected error to be ALL_ROUNDS_PLAYED")
+	}
+}
@@ -0,0 +1,109 @@
+package tournament
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
+	"github.com/go-sql-driver/mysql"
+)
+
+type CreateTournamentTeamCommand struct {
+	service *Service
+	In      *api.CreateTournamentTeamRequest
+	Out     *api.CreateTournamentTeamResponse
+}
+
+func NewCreateTournamentTeamCommand(service *Service, in *api.CreateTournamentTeamRequest) *CreateTournamentTeamCommand {
+	return &CreateTournamentTeamCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *CreateTournamentTeamCommand) Execute(ctx context.Context) error {
+	// Check if tournament name is large enough
+	if len(c.In.Tournament) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.CreateTournamentTeamResponse{
+			Success: false,
+			Error:   api.CreateTournamentTeamResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	// Check if tournament name is small enough
+	if len(c.In.Tournament) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.CreateTournamentTeamResponse{
+			Success: false,
+			Error:   api.CreateTournamentTeamResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	// Check if team id is valid
+	if c.In.TeamId == 0 {
+		c.Out = &api.CreateTournamentTeamResponse{
+			Success: false,
+			Error:   api.CreateTournamentTeamResponse_TEAM_ID_REQUIRED,
+		}
+		return nil
+	}
+	// Check if data is provided
+	if c.In.Data == nil {
+		c.Out = &api.CreateTournamentTeamResponse{
+			Success: false,
+			Error:   api.CreateTournamentTeamResponse_DATA_REQUIRED,
+		}
+		return nil
+	}
+	// Custom tournaments can only be entered while one of their windows is running
+	startedAt, tErr, err := c.service.getStartTime(ctx, c.In.Tournament, c.In.Interval)
+	if err != nil {
+		return err
+	}
+	if tErr != nil {
+		c.Out = &api.CreateTournamentTeamResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.CreateTournamentTeamResponse_Error_value, api.CreateTournamentTeamResponse_DEFINITION_NOT_FOUND),
+		}
+		return nil
+	}
+	raw, err := conversion.ProtobufStructToRawJson(c.In.Data)
+	if err != nil {
+		return err
+	}
+	score := int64(0)
+	if c.In.Score != nil {
+		score = *c.In.Score
+	}
+	result, err := c.service.database.CreateTournamentTeam(ctx, model.CreateTournamentTeamParams{
+		Name:                c.In.Tournament,
+		TournamentInterval:  model.TournamentTeamTournamentInterval(c.In.Interval.String()),
+		TeamID:              c.In.TeamId,
+		Score:               score,
+		Data:                raw,
+		TournamentStartedAt: startedAt,
+	})
+	if err != nil {
+		var mysqlError *mysql.MySQLError
+		if errors.As(err, &mysqlError) && mysqlError.Number == errorcode.MySQLErrorCodeDuplicateEntry {
+			c.Out = &api.CreateTournamentTeamResponse{
+				Success: false,
+				Error:   api.CreateTournamentTeamResponse_ALREADY_EXISTS,
+			}
+			return nil
+		}
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.CreateTournamentTeamResponse{
+		Success: true,
+		Id:      conversion.ValueToPointer(uint64(id)),
+		Error:   api.CreateTournamentTeamResponse_NONE,
+	}
+	return nil
+}
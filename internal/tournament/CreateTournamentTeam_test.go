@@ -0,0 +1,205 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+	"github.com/MorhafAlshibly/coanda/pkg/tournament"
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestCreateTournamentTeamTournamentNameTooShort(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateTournamentTeamCommand(service, &api.CreateTournamentTeamRequest{
+		Tournament: "t",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentTeamResponse_TOURNAMENT_NAME_TOO_SHORT {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_SHORT")
+	}
+}
+
+func TestCreateTournamentTeamTournamentNameTooLong(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMaxTournamentNameLength(5))
+	c := NewCreateTournamentTeamCommand(service, &api.CreateTournamentTeamRequest{
+		Tournament: "aaaaaaa",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentTeamResponse_TOURNAMENT_NAME_TOO_LONG {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_LONG")
+	}
+}
+
+func TestCreateTournamentTeamNoTeamId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateTournamentTeamCommand(service, &api.CreateTournamentTeamRequest{
+		Tournament: "test",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentTeamResponse_TEAM_ID_REQUIRED {
+		t.Fatal("Expected error to be TEAM_ID_REQUIRED")
+	}
+}
+
+func TestCreateTournamentTeamDataRequired(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewCreateTournamentTeamCommand(service, &api.CreateTournamentTeamRequest{
+		Tournament: "test",
+		TeamId:     1,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentTeamResponse_DATA_REQUIRED {
+		t.Fatal("Expected error to be DATA_REQUIRED")
+	}
+}
+
+func TestCreateTournamentTeamSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec("INSERT INTO tournament_team").WithArgs("test", "DAILY", int64(1), int64(0), raw, tournament.GetStartTime(time.Now(), api.TournamentInterval_DAILY, tournament.WipeTimes{
+		DailyTournamentMinute:   0,
+		WeeklyTournamentMinute:  0,
+		WeeklyTournamentDay:     0,
+		MonthlyTournamentMinute: 0,
+		MonthlyTournamentDay:    1,
+	})).WillReturnResult(sqlmock.NewResult(4, 1))
+	c := NewCreateTournamentTeamCommand(service, &api.CreateTournamentTeamRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		TeamId:     1,
+		Data:       data,
+		Score:      conversion.ValueToPointer(int64(0)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if *c.Out.Id != 4 {
+		t.Fatal("Expected id to be 4")
+	}
+	if c.Out.Error != api.CreateTournamentTeamResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+}
+
+func TestCreateTournamentTeamAlreadyExists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec("INSERT INTO tournament_team").WithArgs("test", "DAILY", int64(1), int64(0), raw, tournament.GetStartTime(time.Now(), api.TournamentInterval_DAILY, tournament.WipeTimes{
+		DailyTournamentMinute:   0,
+		WeeklyTournamentMinute:  0,
+		WeeklyTournamentDay:     0,
+		MonthlyTournamentMinute: 0,
+		MonthlyTournamentDay:    1,
+	})).WillReturnError(&mysql.MySQLError{Number: errorcode.MySQLErrorCodeDuplicateEntry})
+	c := NewCreateTournamentTeamCommand(service, &api.CreateTournamentTeamRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		TeamId:     1,
+		Data:       data,
+		Score:      conversion.ValueToPointer(int64(0)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentTeamResponse_ALREADY_EXISTS {
+		t.Fatal("Expected error to be ALREADY_EXISTS")
+	}
+}
@@ -3,13 +3,11 @@ package tournament
 import (
 	"context"
 	"errors"
-	"time"
 
 	"github.com/MorhafAlshibly/coanda/api"
 	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
 	"github.com/MorhafAlshibly/coanda/pkg/conversion"
 	"github.com/MorhafAlshibly/coanda/pkg/errorcode"
-	"github.com/MorhafAlshibly/coanda/pkg/tournament"
 	"github.com/go-sql-driver/mysql"
 )
 
@@ -59,6 +57,30 @@ func (c *CreateTournamentUserCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
+	// Custom tournaments can only be entered while one of their windows is running
+	startedAt, tErr, err := c.service.getStartTime(ctx, c.In.Tournament, c.In.Interval)
+	if err != nil {
+		return err
+	}
+	if tErr != nil {
+		c.Out = &api.CreateTournamentUserResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.CreateTournamentUserResponse_Error_value, api.CreateTournamentUserResponse_DEFINITION_NOT_FOUND),
+		}
+		return nil
+	}
+	// A team can only be given by one of its members
+	isTeamMember, err := c.service.isTeamMember(ctx, c.In.UserId, c.In.TeamId)
+	if err != nil {
+		return err
+	}
+	if !isTeamMember {
+		c.Out = &api.CreateTournamentUserResponse{
+			Success: false,
+			Error:   api.CreateTournamentUserResponse_NOT_A_TEAM_MEMBER,
+		}
+		return nil
+	}
 	raw, err := conversion.ProtobufStructToRawJson(c.In.Data)
 	if err != nil {
 		return err
@@ -68,18 +90,13 @@ func (c *CreateTournamentUserCommand) Execute(ctx context.Context) error {
 		score = *c.In.Score
 	}
 	_, err = c.service.database.CreateTournament(ctx, model.CreateTournamentParams{
-		Name:               c.In.Tournament,
-		TournamentInterval: model.TournamentTournamentInterval(c.In.Interval.String()),
-		UserID:             c.In.UserId,
-		Score:              score,
-		Data:               raw,
-		TournamentStartedAt: tournament.GetStartTime(time.Now().UTC(), c.In.Interval, tournament.WipeTimes{
-			DailyTournamentMinute:   c.service.dailyTournamentMinute,
-			WeeklyTournamentMinute:  c.service.weeklyTournamentMinute,
-			WeeklyTournamentDay:     c.service.weeklyTournamentDay,
-			MonthlyTournamentMinute: c.service.monthlyTournamentMinute,
-			MonthlyTournamentDay:    c.service.monthlyTournamentDay,
-		}),
+		Name:                c.In.Tournament,
+		TournamentInterval:  model.TournamentTournamentInterval(c.In.Interval.String()),
+		UserID:              c.In.UserId,
+		TeamID:              conversion.Uint64ToSqlNullInt64(c.In.TeamId),
+		Score:               score,
+		Data:                raw,
+		TournamentStartedAt: startedAt,
 	})
 	if err != nil {
 		var mysqlError *mysql.MySQLError
@@ -2,6 +2,7 @@ package tournament
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -129,7 +130,8 @@ func TestCreateTournamentUserSuccess(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	mock.ExpectExec("INSERT INTO tournament").WithArgs("test", "DAILY", int64(1), int64(0), raw, tournament.GetStartTime(time.Now(), api.TournamentInterval_DAILY, tournament.WipeTimes{
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec("INSERT INTO tournament").WithArgs("test", "DAILY", int64(1), nil, int64(0), raw, tournament.GetStartTime(time.Now(), api.TournamentInterval_DAILY, tournament.WipeTimes{
 		DailyTournamentMinute:   0,
 		WeeklyTournamentMinute:  0,
 		WeeklyTournamentDay:     0,
@@ -172,7 +174,8 @@ func TestCreateTournamentUserAlreadyExists(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	mock.ExpectExec("INSERT INTO tournament").WithArgs("test", "DAILY", int64(1), int64(0), raw, tournament.GetStartTime(time.Now(), api.TournamentInterval_DAILY, tournament.WipeTimes{
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec("INSERT INTO tournament").WithArgs("test", "DAILY", int64(1), nil, int64(0), raw, tournament.GetStartTime(time.Now(), api.TournamentInterval_DAILY, tournament.WipeTimes{
 		DailyTournamentMinute:   0,
 		WeeklyTournamentMinute:  0,
 		WeeklyTournamentDay:     0,
@@ -197,3 +200,259 @@ func TestCreateTournamentUserAlreadyExists(t *testing.T) {
 		t.Fatal("Expected error to be ALREADY_EXISTS")
 	}
 }
+
+func TestCreateTournamentUserCustomDefinitionNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_definition").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	c := NewCreateTournamentUserCommand(service, &api.CreateTournamentUserRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_CUSTOM,
+		UserId:     1,
+		Data:       data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentUserResponse_DEFINITION_NOT_FOUND {
+		t.Fatal("Expected error to be DEFINITION_NOT_FOUND")
+	}
+}
+
+func TestCreateTournamentUserCustomEnded(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	rows := sqlmock.NewRows(tournamentDefinition).
+		AddRow(1, "test", nil, 3600, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), "UTC", []byte("{}"), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM tournament_definition").WithArgs("test").WillReturnRows(rows)
+	c := NewCreateTournamentUserCommand(service, &api.CreateTournamentUserRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_CUSTOM,
+		UserId:     1,
+		Data:       data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentUserResponse_TOURNAMENT_NOT_ACTIVE {
+		t.Fatal("Expected error to be TOURNAMENT_NOT_ACTIVE")
+	}
+}
+
+func TestCreateTournamentUserCustomSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	// One day windows starting at midnight, so the current window started at midnight today
+	rows := sqlmock.NewRows(tournamentDefinition).
+		AddRow(1, "test", nil, 86400, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), nil, "UTC", []byte("{}"), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM tournament_definition").WithArgs("test").WillReturnRows(rows)
+	mock.ExpectExec("INSERT INTO tournament").WithArgs("test", "CUSTOM", int64(1), nil, int64(0), raw, time.Now().UTC().Truncate(24*time.Hour)).WillReturnResult(sqlmock.NewResult(1, 1))
+	c := NewCreateTournamentUserCommand(service, &api.CreateTournamentUserRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_CUSTOM,
+		UserId:     1,
+		Data:       data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+}
+
+func TestCreateTournamentUserWipeTimeInTimeZone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	location, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := sqlmock.NewRows(tournamentWipeTime).AddRow(1, "test", 0, 0, 1, 0, 1, "Asia/Tokyo", time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnRows(rows)
+	mock.ExpectExec("INSERT INTO tournament").WithArgs("test", "DAILY", int64(1), nil, int64(0), raw, tournament.GetStartTime(time.Now(), api.TournamentInterval_DAILY, tournament.WipeTimes{
+		WeeklyTournamentDay:  time.Monday,
+		MonthlyTournamentDay: 1,
+		TimeZone:             location,
+	})).WillReturnResult(sqlmock.NewResult(1, 1))
+	c := NewCreateTournamentUserCommand(service, &api.CreateTournamentUserRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		UserId:     1,
+		Data:       data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+}
+
+func TestCreateTournamentUserNotATeamMember(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM team_member").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"team_id"}).AddRow(3))
+	c := NewCreateTournamentUserCommand(service, &api.CreateTournamentUserRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		UserId:     1,
+		TeamId:     conversion.ValueToPointer(uint64(2)),
+		Data:       data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.CreateTournamentUserResponse_NOT_A_TEAM_MEMBER {
+		t.Fatal("Expected error to be NOT_A_TEAM_MEMBER")
+	}
+}
+
+func TestCreateTournamentUserNotInAnyTeam(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM team_member").WithArgs(1).WillReturnError(sql.ErrNoRows)
+	c := NewCreateTournamentUserCommand(service, &api.CreateTournamentUserRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		UserId:     1,
+		TeamId:     conversion.ValueToPointer(uint64(2)),
+		Data:       data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Error != api.CreateTournamentUserResponse_NOT_A_TEAM_MEMBER {
+		t.Fatal("Expected error to be NOT_A_TEAM_MEMBER")
+	}
+}
+
+func TestCreateTournamentUserTeamMemberSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM team_member").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"team_id"}).AddRow(2))
+	mock.ExpectExec("INSERT INTO tournament").WithArgs("test", "DAILY", int64(1), int64(2), int64(0), raw, tournament.GetStartTime(time.Now(), api.TournamentInterval_DAILY, tournament.WipeTimes{
+		DailyTournamentMinute:   0,
+		WeeklyTournamentMinute:  0,
+		WeeklyTournamentDay:     0,
+		MonthlyTournamentMinute: 0,
+		MonthlyTournamentDay:    1,
+	})).WillReturnResult(sqlmock.NewResult(1, 1))
+	c := NewCreateTournamentUserCommand(service, &api.CreateTournamentUserRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		UserId:     1,
+		TeamId:     conversion.ValueToPointer(uint64(2)),
+		Data:       data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,51 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+)
+
+type DeleteTournamentBracketCommand struct {
+	service *Service
+	In      *api.TournamentBracketRequest
+	Out     *api.TournamentBracketResponse
+}
+
+func NewDeleteTournamentBracketCommand(service *Service, in *api.TournamentBracketRequest) *DeleteTournamentBracketCommand {
+	return &DeleteTournamentBracketCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *DeleteTournamentBracketCommand) Execute(ctx context.Context) error {
+	if c.In.Id == 0 {
+		c.Out = &api.TournamentBracketResponse{
+			Success: false,
+			Error:   api.TournamentBracketResponse_ID_REQUIRED,
+		}
+		return nil
+	}
+	// Participants and matches are deleted with the bracket, matchmaking matches created for it are kept
+	result, err := c.service.database.DeleteTournamentBracket(ctx, c.In.Id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		c.Out = &api.TournamentBracketResponse{
+			Success: false,
+			Error:   api.TournamentBracketResponse_NOT_FOUND,
+		}
+		return nil
+	}
+	c.Out = &api.TournamentBracketResponse{
+		Success: true,
+		Error:   api.TournamentBracketResponse_NONE,
+	}
+	return nil
+}
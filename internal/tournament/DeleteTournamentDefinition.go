@@ -0,0 +1,59 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+)
+
+type DeleteTournamentDefinitionCommand struct {
+	service *Service
+	In      *api.TournamentDefinitionRequest
+	Out     *api.TournamentDefinitionResponse
+}
+
+func NewDeleteTournamentDefinitionCommand(service *Service, in *api.TournamentDefinitionRequest) *DeleteTournamentDefinitionCommand {
+	return &DeleteTournamentDefinitionCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *DeleteTournamentDefinitionCommand) Execute(ctx context.Context) error {
+	// Check if tournament name is valid
+	if len(c.In.Name) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.TournamentDefinitionResponse{
+			Success: false,
+			Error:   api.TournamentDefinitionResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	if len(c.In.Name) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.TournamentDefinitionResponse{
+			Success: false,
+			Error:   api.TournamentDefinitionResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	// Entries already in the custom tournament are kept, but no new windows can start
+	result, err := c.service.database.DeleteTournamentDefinition(ctx, c.In.Name)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		c.Out = &api.TournamentDefinitionResponse{
+			Success: false,
+			Error:   api.TournamentDefinitionResponse_NOT_FOUND,
+		}
+		return nil
+	}
+	c.Out = &api.TournamentDefinitionResponse{
+		Success: true,
+		Error:   api.TournamentDefinitionResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package tournament
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestDeleteTournamentDefinitionSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectExec("DELETE FROM tournament_definition").WithArgs("test").WillReturnResult(sqlmock.NewResult(0, 1))
+	c := NewDeleteTournamentDefinitionCommand(service, &api.TournamentDefinitionRequest{
+		Name: "test",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+}
+
+func TestDeleteTournamentDefinitionNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectExec("DELETE FROM tournament_definition").WithArgs("test").WillReturnResult(sqlmock.NewResult(0, 0))
+	c := NewDeleteTournamentDefinitionCommand(service, &api.TournamentDefinitionRequest{
+		Name: "test",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TournamentDefinitionResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
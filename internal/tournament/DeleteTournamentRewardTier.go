@@ -0,0 +1,51 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+)
+
+type DeleteTournamentRewardTierCommand struct {
+	service *Service
+	In      *api.TournamentRewardTierRequest
+	Out     *api.TournamentRewardTierResponse
+}
+
+func NewDeleteTournamentRewardTierCommand(service *Service, in *api.TournamentRewardTierRequest) *DeleteTournamentRewardTierCommand {
+	return &DeleteTournamentRewardTierCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *DeleteTournamentRewardTierCommand) Execute(ctx context.Context) error {
+	if c.In.Id == 0 {
+		c.Out = &api.TournamentRewardTierResponse{
+			Success: false,
+			Error:   api.TournamentRewardTierResponse_ID_REQUIRED,
+		}
+		return nil
+	}
+	// Rewards already granted from the tier are kept
+	result, err := c.service.database.DeleteTournamentRewardTier(ctx, c.In.Id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		c.Out = &api.TournamentRewardTierResponse{
+			Success: false,
+			Error:   api.TournamentRewardTierResponse_NOT_FOUND,
+		}
+		return nil
+	}
+	c.Out = &api.TournamentRewardTierResponse{
+		Success: true,
+		Error:   api.TournamentRewardTierResponse_NONE,
+	}
+	return nil
+}
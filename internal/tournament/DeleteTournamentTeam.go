@@ -0,0 +1,63 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type DeleteTournamentTeamCommand struct {
+	service *Service
+	In      *api.TournamentTeamRequest
+	Out     *api.TournamentTeamResponse
+}
+
+func NewDeleteTournamentTeamCommand(service *Service, in *api.TournamentTeamRequest) *DeleteTournamentTeamCommand {
+	return &DeleteTournamentTeamCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *DeleteTournamentTeamCommand) Execute(ctx context.Context) error {
+	// Check for errors
+	tErr := c.service.checkForTournamentTeamRequestError(c.In)
+	if tErr != nil {
+		c.Out = &api.TournamentTeamResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.TournamentTeamResponse_Error_value, api.TournamentTeamResponse_ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED),
+		}
+		return nil
+	}
+	nameIntervalTeamIDStartedAt, err := c.service.convertTournamentIntervalTeamIdToNullNameIntervalTeamIDStartedAt(ctx, c.In.TournamentIntervalTeamId)
+	if err != nil {
+		return err
+	}
+	// Delete the tournament team
+	result, err := c.service.database.DeleteTournamentTeam(ctx, model.GetTournamentTeamParams{
+		ID:                          conversion.Uint64ToSqlNullInt64(c.In.Id),
+		NameIntervalTeamIDStartedAt: nameIntervalTeamIDStartedAt,
+	})
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	// Check if the tournament team was deleted
+	if rowsAffected == 0 {
+		c.Out = &api.TournamentTeamResponse{
+			Success: false,
+			Error:   api.TournamentTeamResponse_NOT_FOUND,
+		}
+		return nil
+	}
+	c.Out = &api.TournamentTeamResponse{
+		Success: true,
+		Error:   api.TournamentTeamResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestDeleteTournamentTeamNoIdOrTournamentIntervalTeamId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewDeleteTournamentTeamCommand(service, &api.TournamentTeamRequest{})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TournamentTeamResponse_ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED {
+		t.Fatal("Expected error to be ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED")
+	}
+}
+
+func TestDeleteTournamentTeamTournamentNameTooShort(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewDeleteTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+			Tournament: "a",
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TournamentTeamResponse_TOURNAMENT_NAME_TOO_SHORT {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_SHORT")
+	}
+}
+
+func TestDeleteTournamentTeamTournamentNameTooLong(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMaxTournamentNameLength(5))
+	c := NewDeleteTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+			Tournament: "123456908097",
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TournamentTeamResponse_TOURNAMENT_NAME_TOO_LONG {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_LONG")
+	}
+}
+
+func TestDeleteTournamentTeamNoTeamId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewDeleteTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+			Tournament: "test",
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TournamentTeamResponse_TEAM_ID_REQUIRED {
+		t.Fatal("Expected error to be TEAM_ID_REQUIRED")
+	}
+}
+
+func TestDeleteTournamentTeamByTournamentIntervalTeamId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec("DELETE FROM `tournament_team`").WithArgs("test", 1, "DAILY", time.Now().Truncate(time.Hour*24).UTC(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	c := NewDeleteTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+			Tournament: "test",
+			Interval:   api.TournamentInterval_DAILY,
+			TeamId:     1,
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+}
+
+func TestDeleteTournamentTeamById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectExec("DELETE FROM `tournament_team`").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	c := NewDeleteTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		Id: conversion.ValueToPointer(uint64(1)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+}
+
+func TestDeleteTournamentTeamNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec("DELETE FROM `tournament_team`").WithArgs("test", 1, "DAILY", time.Now().Truncate(time.Hour*24).UTC(), 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	c := NewDeleteTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+			Tournament: "test",
+			Interval:   api.TournamentInterval_DAILY,
+			TeamId:     1,
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.TournamentTeamResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
@@ -31,10 +31,14 @@ func (c *DeleteTournamentUserCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
+	nameIntervalUserIDStartedAt, err := c.service.convertTournamentIntervalUserIdToNullNameIntervalUserIDStartedAt(ctx, c.In.TournamentIntervalUserId)
+	if err != nil {
+		return err
+	}
 	// Delete the tournament user
 	result, err := c.service.database.DeleteTournament(ctx, model.GetTournamentParams{
 		ID:                          conversion.Uint64ToSqlNullInt64(c.In.Id),
-		NameIntervalUserIDStartedAt: c.service.convertTournamentIntervalUserIdToNullNameIntervalUserIDStartedAt(c.In.TournamentIntervalUserId),
+		NameIntervalUserIDStartedAt: nameIntervalUserIDStartedAt,
 	})
 	if err != nil {
 		return err
@@ -2,6 +2,7 @@ package tournament
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -121,6 +122,7 @@ func TestDeleteTeamByTournamentIntervalUserId(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
 	mock.ExpectExec("DELETE FROM `tournament`").WithArgs("test", "DAILY", time.Now().Truncate(time.Hour*24).UTC(), 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	c := NewDeleteTournamentUserCommand(service, &api.TournamentUserRequest{
 		TournamentIntervalUserId: &api.TournamentIntervalUserId{
@@ -169,6 +171,7 @@ func TestDeleteTeamNotFound(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
 	mock.ExpectExec("DELETE FROM `tournament`").WithArgs("test", "DAILY", time.Now().Truncate(time.Hour*24).UTC(), 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
 	c := NewDeleteTournamentUserCommand(service, &api.TournamentUserRequest{
 		TournamentIntervalUserId: &api.TournamentIntervalUserId{
@@ -0,0 +1,59 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+)
+
+type DeleteTournamentWipeTimeCommand struct {
+	service *Service
+	In      *api.TournamentWipeTimeRequest
+	Out     *api.TournamentWipeTimeResponse
+}
+
+func NewDeleteTournamentWipeTimeCommand(service *Service, in *api.TournamentWipeTimeRequest) *DeleteTournamentWipeTimeCommand {
+	return &DeleteTournamentWipeTimeCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *DeleteTournamentWipeTimeCommand) Execute(ctx context.Context) error {
+	// Check if tournament name is valid
+	if len(c.In.Tournament) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.TournamentWipeTimeResponse{
+			Success: false,
+			Error:   api.TournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	if len(c.In.Tournament) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.TournamentWipeTimeResponse{
+			Success: false,
+			Error:   api.TournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	// The tournament goes back to the wipe times of the service
+	result, err := c.service.database.DeleteTournamentWipeTime(ctx, c.In.Tournament)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		c.Out = &api.TournamentWipeTimeResponse{
+			Success: false,
+			Error:   api.TournamentWipeTimeResponse_NOT_FOUND,
+		}
+		return nil
+	}
+	c.Out = &api.TournamentWipeTimeResponse{
+		Success: true,
+		Error:   api.TournamentWipeTimeResponse_NONE,
+	}
+	return nil
+}
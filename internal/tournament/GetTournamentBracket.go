@@ -0,0 +1,60 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+)
+
+type GetTournamentBracketCommand struct {
+	service *Service
+	In      *api.TournamentBracketRequest
+	Out     *api.GetTournamentBracketResponse
+}
+
+func NewGetTournamentBracketCommand(service *Service, in *api.TournamentBracketRequest) *GetTournamentBracketCommand {
+	return &GetTournamentBracketCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetTournamentBracketCommand) Execute(ctx context.Context) error {
+	if c.In.Id == 0 {
+		c.Out = &api.GetTournamentBracketResponse{
+			Success: false,
+			Error:   api.GetTournamentBracketResponse_ID_REQUIRED,
+		}
+		return nil
+	}
+	bracket, err := c.service.database.GetTournamentBracket(ctx, c.In.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.GetTournamentBracketResponse{
+				Success: false,
+				Error:   api.GetTournamentBracketResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	participants, err := c.service.database.GetTournamentBracketParticipants(ctx, bracket.ID)
+	if err != nil {
+		return err
+	}
+	matches, err := c.service.database.GetTournamentBracketMatches(ctx, bracket.ID)
+	if err != nil {
+		return err
+	}
+	tournamentBracket, err := unmarshalTournamentBracket(&bracket, participants, matches)
+	if err != nil {
+		return err
+	}
+	c.Out = &api.GetTournamentBracketResponse{
+		Success:           true,
+		TournamentBracket: tournamentBracket,
+		Error:             api.GetTournamentBracketResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestGetTournamentBracketNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket").WithArgs(1).WillReturnError(sql.ErrNoRows)
+	c := NewGetTournamentBracketCommand(service, &api.TournamentBracketRequest{
+		Id: 1,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentBracketResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
+
+func TestGetTournamentBracketSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket").WithArgs(1).WillReturnRows(sqlmock.NewRows(tournamentBracket).
+		AddRow(1, "test", "double_elimination", nil, nil, []byte("{}"), nil, time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket_participant").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"tournament_bracket_id", "user_id", "seed", "seed_value"}).
+		AddRow(1, 5, 1, 1200).AddRow(1, 6, 2, nil))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket_match").WithArgs(1).WillReturnRows(sqlmock.NewRows(tournamentBracketMatch).
+		AddRow(2, 1, "grand_final", 1, 0, nil, nil, nil, nil, nil, nil, nil, nil, nil, time.Now(), time.Now()))
+	c := NewGetTournamentBracketCommand(service, &api.TournamentBracketRequest{
+		Id: 1,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.TournamentBracket.Format != api.TournamentBracketFormat_DOUBLE_ELIMINATION {
+		t.Fatal("Expected format to be DOUBLE_ELIMINATION")
+	}
+	if len(c.Out.TournamentBracket.Participants) != 2 {
+		t.Fatal("Expected 2 participants")
+	}
+	if c.Out.TournamentBracket.Participants[1].SeedValue != nil {
+		t.Fatal("Expected second participant to have no seed value")
+	}
+	if c.Out.TournamentBracket.Matches[0].Side != api.TournamentBracketSide_GRAND_FINAL {
+		t.Fatal("Expected match side to be GRAND_FINAL")
+	}
+}
@@ -0,0 +1,60 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+)
+
+type GetTournamentDefinitionCommand struct {
+	service *Service
+	In      *api.TournamentDefinitionRequest
+	Out     *api.GetTournamentDefinitionResponse
+}
+
+func NewGetTournamentDefinitionCommand(service *Service, in *api.TournamentDefinitionRequest) *GetTournamentDefinitionCommand {
+	return &GetTournamentDefinitionCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetTournamentDefinitionCommand) Execute(ctx context.Context) error {
+	// Check if tournament name is valid
+	if len(c.In.Name) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.GetTournamentDefinitionResponse{
+			Success: false,
+			Error:   api.GetTournamentDefinitionResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	if len(c.In.Name) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.GetTournamentDefinitionResponse{
+			Success: false,
+			Error:   api.GetTournamentDefinitionResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	result, err := c.service.database.GetTournamentDefinition(ctx, c.In.Name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.GetTournamentDefinitionResponse{
+				Success: false,
+				Error:   api.GetTournamentDefinitionResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	definition, err := unmarshalTournamentDefinition(&result)
+	if err != nil {
+		return err
+	}
+	c.Out = &api.GetTournamentDefinitionResponse{
+		Success:              true,
+		TournamentDefinition: definition,
+		Error:                api.GetTournamentDefinitionResponse_NONE,
+	}
+	return nil
+}
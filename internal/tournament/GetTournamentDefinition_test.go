@@ -0,0 +1,99 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+var (
+	tournamentDefinition = []string{"id", "name", "cron_schedule", "duration_seconds", "starts_at", "ends_at", "time_zone", "data", "created_at", "updated_at"}
+)
+
+func TestGetTournamentDefinitionTournamentNameTooLong(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMaxTournamentNameLength(5))
+	c := NewGetTournamentDefinitionCommand(service, &api.TournamentDefinitionRequest{
+		Name: "aaaaaaa",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentDefinitionResponse_TOURNAMENT_NAME_TOO_LONG {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_LONG")
+	}
+}
+
+func TestGetTournamentDefinitionSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	startsAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows(tournamentDefinition).AddRow(1, "test", "0 18 * * FRI", nil, startsAt, nil, "UTC", []byte("{}"), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM tournament_definition").WithArgs("test").WillReturnRows(rows)
+	c := NewGetTournamentDefinitionCommand(service, &api.TournamentDefinitionRequest{
+		Name: "test",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if *c.Out.TournamentDefinition.CronSchedule != "0 18 * * FRI" {
+		t.Fatal("Expected cron schedule to be 0 18 * * FRI")
+	}
+	if c.Out.TournamentDefinition.DurationSeconds != nil {
+		t.Fatal("Expected duration seconds to be nil")
+	}
+	if !c.Out.TournamentDefinition.StartsAt.AsTime().Equal(startsAt) {
+		t.Fatal("Expected starts at to be the start of 2020")
+	}
+}
+
+func TestGetTournamentDefinitionNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_definition").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	c := NewGetTournamentDefinitionCommand(service, &api.TournamentDefinitionRequest{
+		Name: "test",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentDefinitionResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
@@ -0,0 +1,71 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type GetTournamentPairingsCommand struct {
+	service *Service
+	In      *api.GetTournamentPairingsRequest
+	Out     *api.GetTournamentPairingsResponse
+}
+
+func NewGetTournamentPairingsCommand(service *Service, in *api.GetTournamentPairingsRequest) *GetTournamentPairingsCommand {
+	return &GetTournamentPairingsCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetTournamentPairingsCommand) Execute(ctx context.Context) error {
+	if len(c.In.Tournament) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.GetTournamentPairingsResponse{
+			Success: false,
+			Error:   api.GetTournamentPairingsResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	if len(c.In.Tournament) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.GetTournamentPairingsResponse{
+			Success: false,
+			Error:   api.GetTournamentPairingsResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	startedAt, tErr, err := c.service.getStartTime(ctx, c.In.Tournament, c.In.Interval)
+	if err != nil {
+		return err
+	}
+	if tErr != nil {
+		c.Out = &api.GetTournamentPairingsResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.GetTournamentPairingsResponse_Error_value, api.GetTournamentPairingsResponse_DEFINITION_NOT_FOUND),
+		}
+		return nil
+	}
+	result, err := c.service.database.GetTournamentPairings(ctx, model.GetTournamentPairingsParams{
+		Name:                c.In.Tournament,
+		TournamentInterval:  model.TournamentPairingTournamentInterval(c.In.Interval.String()),
+		TournamentStartedAt: startedAt,
+	})
+	if err != nil {
+		return err
+	}
+	pairings := make([]*api.TournamentPairing, 0, len(result))
+	for _, pairing := range result {
+		if c.In.Round != nil && pairing.Round != *c.In.Round {
+			continue
+		}
+		pairings = append(pairings, unmarshalTournamentPairing(&pairing))
+	}
+	c.Out = &api.GetTournamentPairingsResponse{
+		Success:  true,
+		Pairings: pairings,
+		Error:    api.GetTournamentPairingsResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+)
+
+type GetTournamentRewardTiersCommand struct {
+	service *Service
+	In      *api.GetTournamentRewardTiersRequest
+	Out     *api.GetTournamentRewardTiersResponse
+}
+
+func NewGetTournamentRewardTiersCommand(service *Service, in *api.GetTournamentRewardTiersRequest) *GetTournamentRewardTiersCommand {
+	return &GetTournamentRewardTiersCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetTournamentRewardTiersCommand) Execute(ctx context.Context) error {
+	// Check if tournament name is valid
+	if len(c.In.Tournament) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.GetTournamentRewardTiersResponse{
+			Success: false,
+			Error:   api.GetTournamentRewardTiersResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	if len(c.In.Tournament) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.GetTournamentRewardTiersResponse{
+			Success: false,
+			Error:   api.GetTournamentRewardTiersResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	result, err := c.service.database.GetTournamentRewardTiers(ctx, model.GetTournamentRewardTiersParams{
+		Name:               c.In.Tournament,
+		TournamentInterval: model.TournamentRewardTierTournamentInterval(c.In.Interval.String()),
+	})
+	if err != nil {
+		return err
+	}
+	tiers := make([]*api.TournamentRewardTier, len(result))
+	for i, tier := range result {
+		tiers[i], err = unmarshalTournamentRewardTier(&tier)
+		if err != nil {
+			return err
+		}
+	}
+	c.Out = &api.GetTournamentRewardTiersResponse{
+		Success:               true,
+		TournamentRewardTiers: tiers,
+		Error:                 api.GetTournamentRewardTiersResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package tournament
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestGetTournamentRewardTiersTwoTiers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	rows := sqlmock.NewRows([]string{"id", "name", "tournament_interval", "min_ranking", "max_ranking", "top_percentage", "data", "created_at", "updated_at"}).
+		AddRow(1, "test", "daily", 1, 10, nil, []byte("{}"), time.Now(), time.Now()).
+		AddRow(2, "test", "daily", nil, nil, 10.5, []byte("{}"), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM tournament_reward_tier").WithArgs("test", "DAILY").WillReturnRows(rows)
+	c := NewGetTournamentRewardTiersCommand(service, &api.GetTournamentRewardTiersRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.TournamentRewardTiers) != 2 {
+		t.Fatal("Expected 2 tiers")
+	}
+	if *c.Out.TournamentRewardTiers[0].MaxRanking != 10 {
+		t.Fatal("Expected max ranking of the first tier to be 10")
+	}
+	if *c.Out.TournamentRewardTiers[1].TopPercentage != 10.5 {
+		t.Fatal("Expected top percentage of the second tier to be 10.5")
+	}
+	if c.Out.TournamentRewardTiers[1].Interval != api.TournamentInterval_DAILY {
+		t.Fatal("Expected interval to be DAILY")
+	}
+}
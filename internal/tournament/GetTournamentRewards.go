@@ -0,0 +1,55 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type GetTournamentRewardsCommand struct {
+	service *Service
+	In      *api.GetTournamentRewardsRequest
+	Out     *api.GetTournamentRewardsResponse
+}
+
+func NewGetTournamentRewardsCommand(service *Service, in *api.GetTournamentRewardsRequest) *GetTournamentRewardsCommand {
+	return &GetTournamentRewardsCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetTournamentRewardsCommand) Execute(ctx context.Context) error {
+	if c.In.UserId == 0 {
+		c.Out = &api.GetTournamentRewardsResponse{
+			Success: false,
+			Error:   api.GetTournamentRewardsResponse_USER_ID_REQUIRED,
+		}
+		return nil
+	}
+	limit, offset := conversion.PaginationToLimitOffset(c.In.Pagination, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
+	result, err := c.service.database.GetTournamentRewards(ctx, model.GetTournamentRewardsParams{
+		UserID:  c.In.UserId,
+		Claimed: conversion.BoolToSqlNullBool(c.In.Claimed),
+		Limit:   limit,
+		Offset:  offset,
+	})
+	if err != nil {
+		return err
+	}
+	rewards := make([]*api.TournamentReward, len(result))
+	for i, reward := range result {
+		rewards[i], err = unmarshalTournamentReward(&reward)
+		if err != nil {
+			return err
+		}
+	}
+	c.Out = &api.GetTournamentRewardsResponse{
+		Success:           true,
+		TournamentRewards: rewards,
+		Error:             api.GetTournamentRewardsResponse_NONE,
+	}
+	return nil
+}
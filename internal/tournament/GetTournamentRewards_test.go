@@ -0,0 +1,72 @@
+package tournament
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+var (
+	tournamentReward = []string{"id", "tier_id", "name", "tournament_interval", "tournament_started_at", "user_id", "ranking", "score", "data", "claimed_at", "created_at"}
+)
+
+func TestGetTournamentRewardsNoUserId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewGetTournamentRewardsCommand(service, &api.GetTournamentRewardsRequest{})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentRewardsResponse_USER_ID_REQUIRED {
+		t.Fatal("Expected error to be USER_ID_REQUIRED")
+	}
+}
+
+func TestGetTournamentRewardsUnclaimed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	rows := sqlmock.NewRows(tournamentReward).AddRow(1, 2, "test", "weekly", time.Now(), 1, 3, 100, []byte(`{"gold":100}`), nil, time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM `tournament_reward` WHERE \\(\\(`user_id` = \\?\\) AND \\(`claimed_at` IS NULL\\)\\)").WithArgs(1, 10).WillReturnRows(rows)
+	c := NewGetTournamentRewardsCommand(service, &api.GetTournamentRewardsRequest{
+		UserId:  1,
+		Claimed: conversion.ValueToPointer(false),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.TournamentRewards) != 1 {
+		t.Fatal("Expected 1 reward")
+	}
+	if c.Out.TournamentRewards[0].Ranking != 3 {
+		t.Fatal("Expected ranking to be 3")
+	}
+	if c.Out.TournamentRewards[0].ClaimedAt != nil {
+		t.Fatal("Expected claimed at to be nil")
+	}
+}
@@ -0,0 +1,95 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/tournament"
+)
+
+type GetTournamentStandingsCommand struct {
+	service *Service
+	In      *api.GetTournamentStandingsRequest
+	Out     *api.GetTournamentStandingsResponse
+}
+
+func NewGetTournamentStandingsCommand(service *Service, in *api.GetTournamentStandingsRequest) *GetTournamentStandingsCommand {
+	return &GetTournamentStandingsCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetTournamentStandingsCommand) Execute(ctx context.Context) error {
+	if len(c.In.Tournament) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.GetTournamentStandingsResponse{
+			Success: false,
+			Error:   api.GetTournamentStandingsResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	if len(c.In.Tournament) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.GetTournamentStandingsResponse{
+			Success: false,
+			Error:   api.GetTournamentStandingsResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	startedAt, tErr, err := c.service.getStartTime(ctx, c.In.Tournament, c.In.Interval)
+	if err != nil {
+		return err
+	}
+	if tErr != nil {
+		c.Out = &api.GetTournamentStandingsResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.GetTournamentStandingsResponse_Error_value, api.GetTournamentStandingsResponse_DEFINITION_NOT_FOUND),
+		}
+		return nil
+	}
+	pairings, err := c.service.database.GetTournamentPairings(ctx, model.GetTournamentPairingsParams{
+		Name:                c.In.Tournament,
+		TournamentInterval:  model.TournamentPairingTournamentInterval(c.In.Interval.String()),
+		TournamentStartedAt: startedAt,
+	})
+	if err != nil {
+		return err
+	}
+	// Users are in the order they were first paired, which is their seed order
+	participants := []uint64{}
+	seen := map[uint64]bool{}
+	for _, pairing := range pairings {
+		for _, userId := range []uint64{pairing.FirstUserID, uint64(pairing.SecondUserID.Int64)} {
+			if userId != 0 && !seen[userId] {
+				seen[userId] = true
+				participants = append(participants, userId)
+			}
+		}
+	}
+	// Everyone gets one bye in an odd round robin, so it is not worth anything there
+	byePoints := float64(tournament.SwissByePoints)
+	if len(pairings) > 0 && pairings[0].Format == model.TournamentPairingFormatRoundRobin {
+		byePoints = 0
+	}
+	result := tournament.Standings(participants, convertTournamentPairingsToGames(pairings), byePoints)
+	standings := make([]*api.TournamentStanding, len(result))
+	for i, standing := range result {
+		standings[i] = &api.TournamentStanding{
+			UserId:          standing.UserID,
+			Points:          standing.Points,
+			Buchholz:        standing.Buchholz,
+			SonnebornBerger: standing.SonnebornBerger,
+			Wins:            standing.Wins,
+			Draws:           standing.Draws,
+			Losses:          standing.Losses,
+			Ranking:         standing.Ranking,
+		}
+	}
+	c.Out = &api.GetTournamentStandingsResponse{
+		Success:   true,
+		Standings: standings,
+		Error:     api.GetTournamentStandingsResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestGetTournamentStandingsNameTooLong(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMaxTournamentNameLength(5))
+	c := NewGetTournamentStandingsCommand(service, &api.GetTournamentStandingsRequest{
+		Tournament: "aaaaaa",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentStandingsResponse_TOURNAMENT_NAME_TOO_LONG {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_LONG")
+	}
+}
+
+func TestGetTournamentStandingsSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	startedAt := time.Now().Truncate(24 * time.Hour).UTC()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM tournament_pairing").WithArgs("test", "DAILY", startedAt).WillReturnRows(sqlmock.NewRows(tournamentPairing).
+		AddRow(1, "test", "daily", startedAt, "swiss", 1, 0, 1, 2, "second_won", time.Now(), time.Now(), time.Now()).
+		AddRow(2, "test", "daily", startedAt, "swiss", 1, 1, 3, nil, "first_won", time.Now(), time.Now(), time.Now()).
+		AddRow(3, "test", "daily", startedAt, "swiss", 2, 0, 2, 3, nil, nil, time.Now(), time.Now()))
+	c := NewGetTournamentStandingsCommand(service, &api.GetTournamentStandingsRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.Standings) != 3 {
+		t.Fatal("Expected 3 standings")
+	}
+	// 2 beat 1 and 3 had a bye, so they share the lead in seed order as their opponents have no points
+	if c.Out.Standings[0].UserId != 2 || c.Out.Standings[0].Ranking != 1 {
+		t.Fatal("Expected user 2 to be ranked first")
+	}
+	if c.Out.Standings[1].UserId != 3 || c.Out.Standings[1].Points != 1 || c.Out.Standings[1].Ranking != 1 {
+		t.Fatal("Expected user 3 to be ranked first with 1 point")
+	}
+	if c.Out.Standings[2].UserId != 1 || c.Out.Standings[2].Losses != 1 {
+		t.Fatal("Expected user 1 to be ranked last with 1 loss")
+	}
+}
@@ -0,0 +1,71 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type GetTournamentTeamCommand struct {
+	service *Service
+	In      *api.TournamentTeamRequest
+	Out     *api.GetTournamentTeamResponse
+}
+
+func NewGetTournamentTeamCommand(service *Service, in *api.TournamentTeamRequest) *GetTournamentTeamCommand {
+	return &GetTournamentTeamCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetTournamentTeamCommand) Execute(ctx context.Context) error {
+	// Check for errors
+	tErr := c.service.checkForTournamentTeamRequestError(c.In)
+	if tErr != nil {
+		c.Out = &api.GetTournamentTeamResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.GetTournamentTeamResponse_Error_value, api.GetTournamentTeamResponse_ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED),
+		}
+		return nil
+	}
+	nameIntervalTeamIDStartedAt, err := c.service.convertTournamentIntervalTeamIdToNullNameIntervalTeamIDStartedAt(ctx, c.In.TournamentIntervalTeamId)
+	if err != nil {
+		return err
+	}
+	// Get the tournament team
+	params := model.GetTournamentTeamParams{
+		ID:                          conversion.Uint64ToSqlNullInt64(c.In.Id),
+		NameIntervalTeamIDStartedAt: nameIntervalTeamIDStartedAt,
+	}
+	result, err := c.service.database.GetTournamentTeam(ctx, params)
+	// Past windows, and the latest window of a custom tournament that has ended, may have been moved to the archive
+	if err == sql.ErrNoRows {
+		params.Archived = true
+		result, err = c.service.database.GetTournamentTeam(ctx, params)
+	}
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.GetTournamentTeamResponse{
+				Success: false,
+				Error:   api.GetTournamentTeamResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	// Unmarshal the tournament team
+	tournamentUser, err := unmarshalTournamentTeam(&result)
+	if err != nil {
+		return err
+	}
+	c.Out = &api.GetTournamentTeamResponse{
+		Success:        true,
+		TournamentTeam: tournamentUser,
+		Error:          api.GetTournamentTeamResponse_NONE,
+	}
+	return nil
+}
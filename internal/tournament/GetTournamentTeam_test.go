@@ -0,0 +1,292 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+var (
+	rankedTournamentTeam = []string{"id", "name", "tournament_interval",
+		"team_id", "score", "ranking", "data", "tournament_started_at", "sent_to_third_party_at", "created_at", "updated_at"}
+)
+
+func TestGetTournamentTeamNoTournamentIntervalTeamId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewGetTournamentTeamCommand(service, &api.TournamentTeamRequest{})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentTeamResponse_ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED {
+		t.Fatal("Expected error to be ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED")
+	}
+}
+
+func TestGetTournamentTeamTournamentNameTooShort(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewGetTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+			Tournament: "a",
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentTeamResponse_TOURNAMENT_NAME_TOO_SHORT {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_SHORT")
+	}
+}
+
+func TestGetTournamentTeamTournamentNameTooLong(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMaxTournamentNameLength(5))
+	c := NewGetTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+			Tournament: "123456",
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentTeamResponse_TOURNAMENT_NAME_TOO_LONG {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_LONG")
+	}
+}
+
+func TestGetTournamentTeamNoTeamId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewGetTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+			Tournament: "test",
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentTeamResponse_TEAM_ID_REQUIRED {
+		t.Fatal("Expected error to be TEAM_ID_REQUIRED")
+	}
+}
+
+func TestGetTournamentTeamById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	rows := sqlmock.NewRows(rankedTournamentTeam).AddRow(1, "test", "DAILY", 1, 1, 1, raw, time.Now(), time.Now(), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament_team`").WithArgs(1, 1).WillReturnRows(rows)
+	c := NewGetTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		Id: conversion.ValueToPointer(uint64(1)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.Error != api.GetTournamentTeamResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+	if c.Out.TournamentTeam.Tournament != "test" {
+		t.Fatal("Expected tournament to be test")
+	}
+	if c.Out.TournamentTeam.Interval != api.TournamentInterval_DAILY {
+		t.Fatal("Expected interval to be DAILY")
+	}
+	if c.Out.TournamentTeam.TeamId != 1 {
+		t.Fatal("Expected user id to be 1")
+	}
+	if c.Out.TournamentTeam.Score != 1 {
+		t.Fatal("Expected score to be 1")
+	}
+	if c.Out.TournamentTeam.Ranking != 1 {
+		t.Fatal("Expected ranking to be 1")
+	}
+	if !reflect.DeepEqual(c.Out.TournamentTeam.Data, data) {
+		t.Fatal("Expected data to be data")
+	}
+}
+
+func TestGetTournamentTeamByTournamentIntervalTeamId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	rows := sqlmock.NewRows(rankedTournamentTeam).AddRow(1, "test", "DAILY", 1, 1, 1, raw, time.Now(), time.Now(), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament_team`").WithArgs("test", 1, "DAILY", time.Now().Truncate(24*time.Hour).UTC(), 1).WillReturnRows(rows)
+	c := NewGetTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+			Tournament: "test",
+			Interval:   api.TournamentInterval_DAILY,
+			TeamId:     1,
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.Error != api.GetTournamentTeamResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+	if c.Out.TournamentTeam.Tournament != "test" {
+		t.Fatal("Expected tournament to be test")
+	}
+	if c.Out.TournamentTeam.Interval != api.TournamentInterval_DAILY {
+		t.Fatal("Expected interval to be DAILY")
+	}
+	if c.Out.TournamentTeam.TeamId != 1 {
+		t.Fatal("Expected user id to be 1")
+	}
+	if c.Out.TournamentTeam.Score != 1 {
+		t.Fatal("Expected score to be 1")
+	}
+	if c.Out.TournamentTeam.Ranking != 1 {
+		t.Fatal("Expected ranking to be 1")
+	}
+	if !reflect.DeepEqual(c.Out.TournamentTeam.Data, data) {
+		t.Fatal("Expected data to be data")
+	}
+}
+
+func TestGetTournamentTeamNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament_team`").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM `archived_tournament_team`").WillReturnError(sql.ErrNoRows)
+	c := NewGetTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+			Tournament: "test",
+			TeamId:     1,
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentTeamResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
+
+func TestGetTournamentTeamByIdArchived(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament_team`").WithArgs(1, 1).WillReturnError(sql.ErrNoRows)
+	rows := sqlmock.NewRows(rankedTournamentTeam).AddRow(1, "test", "monthly", 2, 300, 2, []byte("{}"), time.Now(), time.Now(), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM `archived_tournament_team`").WithArgs(1, 1).WillReturnRows(rows)
+	c := NewGetTournamentTeamCommand(service, &api.TournamentTeamRequest{
+		Id: conversion.ValueToPointer(uint64(1)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.TournamentTeam.Interval != api.TournamentInterval_MONTHLY {
+		t.Fatal("Expected interval to be MONTHLY")
+	}
+	if c.Out.TournamentTeam.Score != 300 {
+		t.Fatal("Expected score to be 300")
+	}
+	if c.Out.TournamentTeam.Ranking != 2 {
+		t.Fatal("Expected ranking to be 2")
+	}
+}
@@ -0,0 +1,107 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type GetTournamentTeamsCommand struct {
+	service *Service
+	In      *api.GetTournamentTeamsRequest
+	Out     *api.GetTournamentTeamsResponse
+}
+
+func NewGetTournamentTeamsCommand(service *Service, in *api.GetTournamentTeamsRequest) *GetTournamentTeamsCommand {
+	return &GetTournamentTeamsCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetTournamentTeamsCommand) Execute(ctx context.Context) error {
+	// Check if tournament name is valid
+	if c.In.Tournament != nil {
+		if len(*c.In.Tournament) < int(c.service.minTournamentNameLength) {
+			c.Out = &api.GetTournamentTeamsResponse{
+				Success: false,
+				Error:   api.GetTournamentTeamsResponse_TOURNAMENT_NAME_TOO_SHORT,
+			}
+			return nil
+		}
+		if len(*c.In.Tournament) > int(c.service.maxTournamentNameLength) {
+			c.Out = &api.GetTournamentTeamsResponse{
+				Success: false,
+				Error:   api.GetTournamentTeamsResponse_TOURNAMENT_NAME_TOO_LONG,
+			}
+			return nil
+		}
+	}
+	// Every custom tournament has its own windows, so one must be chosen
+	if c.In.Interval == api.TournamentInterval_CUSTOM && c.In.Tournament == nil {
+		c.Out = &api.GetTournamentTeamsResponse{
+			Success: false,
+			Error:   api.GetTournamentTeamsResponse_TOURNAMENT_NAME_REQUIRED,
+		}
+		return nil
+	}
+	limit, offset := conversion.PaginationToLimitOffset(c.In.Pagination, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
+	cursor, err := conversion.PaginationToCursor(c.In.Pagination)
+	if err != nil {
+		c.Out = &api.GetTournamentTeamsResponse{
+			Success: false,
+			Error:   api.GetTournamentTeamsResponse_INVALID_CURSOR,
+		}
+		return nil
+	}
+	// Seeking from a cursor replaces the page offset
+	if cursor != nil {
+		offset = 0
+	}
+	startedAt, tErr, err := c.service.getStartTime(ctx, conversion.PointerToValue(c.In.Tournament, ""), c.In.Interval)
+	if err != nil {
+		return err
+	}
+	params := model.GetTournamentTeamsParams{
+		Name:                conversion.StringToSqlNullString(c.In.Tournament),
+		TournamentInterval:  model.TournamentTeamTournamentInterval(c.In.Interval.String()),
+		TeamID:              conversion.Uint64ToSqlNullInt64(c.In.TeamId),
+		TournamentStartedAt: startedAt,
+		// Fetch one extra row to know whether there is another page
+		Limit:  limit + 1,
+		Offset: offset,
+		Seek:   convertCursorToSeek(cursor),
+	}
+	result, err := c.service.database.GetTournamentTeams(ctx, params)
+	if err != nil {
+		return err
+	}
+	// The latest window of a custom tournament that has ended may have been moved to the archive
+	if len(result) == 0 && tErr != nil && *tErr == TOURNAMENT_NOT_ACTIVE {
+		params.Archived = true
+		result, err = c.service.database.GetTournamentTeams(ctx, params)
+		if err != nil {
+			return err
+		}
+	}
+	result, nextCursor, previousCursor := conversion.CursorPage(result, limit, offset, cursor, func(tournament model.RankedTournamentTeam) conversion.Cursor {
+		return conversion.Cursor{Name: tournament.Name, Key: tournament.Score, ID: tournament.ID}
+	})
+	tournaments := make([]*api.TournamentTeam, len(result))
+	for i, tournament := range result {
+		tournaments[i], err = unmarshalTournamentTeam(&tournament)
+		if err != nil {
+			return err
+		}
+	}
+	c.Out = &api.GetTournamentTeamsResponse{
+		Success:         true,
+		TournamentTeams: tournaments,
+		Error:           api.GetTournamentTeamsResponse_NONE,
+		NextCursor:      nextCursor,
+		PreviousCursor:  previousCursor,
+	}
+	return nil
+}
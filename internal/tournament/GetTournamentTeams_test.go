@@ -0,0 +1,134 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestGetTournamentTeamsNameTooShort(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewGetTournamentTeamsCommand(service, &api.GetTournamentTeamsRequest{
+		Tournament: conversion.ValueToPointer("t"),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentTeamsResponse_TOURNAMENT_NAME_TOO_SHORT {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_SHORT")
+	}
+}
+
+func TestGetTournamentTeamsNameTooLong(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMaxTournamentNameLength(5))
+	c := NewGetTournamentTeamsCommand(service, &api.GetTournamentTeamsRequest{
+		Tournament: conversion.ValueToPointer("aaaaaaa"),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentTeamsResponse_TOURNAMENT_NAME_TOO_LONG {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_LONG")
+	}
+}
+
+func TestGetTournamentTeamsNoneFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament_team`").WillReturnRows(sqlmock.NewRows(rankedTournamentTeam))
+	c := NewGetTournamentTeamsCommand(service, &api.GetTournamentTeamsRequest{
+		Tournament: conversion.ValueToPointer("test"),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.Error != api.GetTournamentTeamsResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+	if len(c.Out.TournamentTeams) != 0 {
+		t.Fatal("Expected users to be empty")
+	}
+}
+
+func TestGetTournamentTeamsTwoUsers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament_team`").WillReturnRows(sqlmock.NewRows(rankedTournamentTeam).AddRow(1, "test", "DAILY", 1, 1, 1, raw, time.Now(), time.Now(), time.Now(), time.Now()).AddRow(1, "test", "DAILY", 2, 1, 1, raw, time.Now(), time.Now(), time.Now(), time.Now()))
+	c := NewGetTournamentTeamsCommand(service, &api.GetTournamentTeamsRequest{
+		Tournament: conversion.ValueToPointer("test"),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.Error != api.GetTournamentTeamsResponse_NONE {
+		t.Fatal("Expected error to be NONE")
+	}
+	if len(c.Out.TournamentTeams) != 2 {
+		t.Fatal("Expected users to have 2 users")
+	}
+	if c.Out.TournamentTeams[0].TeamId != 1 {
+		t.Fatal("Expected first user to have id 1")
+	}
+	if c.Out.TournamentTeams[1].TeamId != 2 {
+		t.Fatal("Expected second user to have id 2")
+	}
+}
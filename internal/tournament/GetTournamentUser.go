@@ -32,11 +32,21 @@ func (c *GetTournamentUserCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
+	nameIntervalUserIDStartedAt, err := c.service.convertTournamentIntervalUserIdToNullNameIntervalUserIDStartedAt(ctx, c.In.TournamentIntervalUserId)
+	if err != nil {
+		return err
+	}
 	// Get the tournament user
-	result, err := c.service.database.GetTournament(ctx, model.GetTournamentParams{
+	params := model.GetTournamentParams{
 		ID:                          conversion.Uint64ToSqlNullInt64(c.In.Id),
-		NameIntervalUserIDStartedAt: c.service.convertTournamentIntervalUserIdToNullNameIntervalUserIDStartedAt(c.In.TournamentIntervalUserId),
-	})
+		NameIntervalUserIDStartedAt: nameIntervalUserIDStartedAt,
+	}
+	result, err := c.service.database.GetTournament(ctx, params)
+	// Past windows, and the latest window of a custom tournament that has ended, may have been moved to the archive
+	if err == sql.ErrNoRows {
+		params.Archived = true
+		result, err = c.service.database.GetTournament(ctx, params)
+	}
 	if err != nil {
 		if err == sql.ErrNoRows {
 			c.Out = &api.GetTournamentUserResponse{
@@ -0,0 +1,150 @@
+package tournament
+
+import (
+	"context"
+	"time"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/tournament"
+)
+
+type GetTournamentUserHistoryCommand struct {
+	service *Service
+	In      *api.GetTournamentUserHistoryRequest
+	Out     *api.GetTournamentUserHistoryResponse
+}
+
+func NewGetTournamentUserHistoryCommand(service *Service, in *api.GetTournamentUserHistoryRequest) *GetTournamentUserHistoryCommand {
+	return &GetTournamentUserHistoryCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetTournamentUserHistoryCommand) Execute(ctx context.Context) error {
+	if c.In.UserId == 0 {
+		c.Out = &api.GetTournamentUserHistoryResponse{
+			Success: false,
+			Error:   api.GetTournamentUserHistoryResponse_USER_ID_REQUIRED,
+		}
+		return nil
+	}
+	if c.In.Tournament != nil {
+		if len(*c.In.Tournament) < int(c.service.minTournamentNameLength) {
+			c.Out = &api.GetTournamentUserHistoryResponse{
+				Success: false,
+				Error:   api.GetTournamentUserHistoryResponse_TOURNAMENT_NAME_TOO_SHORT,
+			}
+			return nil
+		}
+		if len(*c.In.Tournament) > int(c.service.maxTournamentNameLength) {
+			c.Out = &api.GetTournamentUserHistoryResponse{
+				Success: false,
+				Error:   api.GetTournamentUserHistoryResponse_TOURNAMENT_NAME_TOO_LONG,
+			}
+			return nil
+		}
+	}
+	interval := model.NullTournamentTournamentInterval{}
+	if c.In.Interval != nil {
+		interval = model.NullTournamentTournamentInterval{TournamentTournamentInterval: model.TournamentTournamentInterval(c.In.Interval.String()), Valid: true}
+	}
+	currentStartedAt, err := c.currentStartedAt(ctx)
+	if err != nil {
+		return err
+	}
+	limit, offset := conversion.PaginationToLimitOffset(c.In.Pagination, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
+	result, err := c.service.database.GetTournamentUserHistory(ctx, model.GetTournamentUserHistoryParams{
+		UserID:             c.In.UserId,
+		Name:               conversion.StringToSqlNullString(c.In.Tournament),
+		TournamentInterval: interval,
+		CurrentStartedAt:   currentStartedAt,
+		Limit:              limit,
+		Offset:             offset,
+	})
+	if err != nil {
+		return err
+	}
+	tournaments := make([]*api.TournamentUser, len(result))
+	for i, tournament := range result {
+		tournaments[i], err = unmarshalTournamentUser(&tournament)
+		if err != nil {
+			return err
+		}
+	}
+	c.Out = &api.GetTournamentUserHistoryResponse{
+		Success:         true,
+		TournamentUsers: tournaments,
+		Error:           api.GetTournamentUserHistoryResponse_NONE,
+	}
+	return nil
+}
+
+// currentStartedAt returns the start of the current window of every interval in the history, so the history only has windows that have ended and can be cached.
+// Unlimited tournaments never end, and custom windows that are still in the tournament table are only included for a given tournament, as each has its own definition.
+// Without a given tournament, tournaments with their own wipe time get their own starts, as their windows can start before the default ones.
+func (c *GetTournamentUserHistoryCommand) currentStartedAt(ctx context.Context) ([]model.TournamentIntervalStartedAt, error) {
+	name := ""
+	if c.In.Tournament != nil {
+		name = *c.In.Tournament
+	}
+	intervals := []api.TournamentInterval{api.TournamentInterval_DAILY, api.TournamentInterval_WEEKLY, api.TournamentInterval_MONTHLY, api.TournamentInterval_CUSTOM}
+	if c.In.Interval != nil {
+		intervals = []api.TournamentInterval{*c.In.Interval}
+	}
+	wipeTimes, err := c.service.getWipeTimes(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	currentStartedAt := []model.TournamentIntervalStartedAt{}
+	for _, interval := range intervals {
+		if interval == api.TournamentInterval_UNLIMITED || (interval == api.TournamentInterval_CUSTOM && name == "") {
+			continue
+		}
+		startedAt := tournament.GetStartTime(time.Now().UTC(), interval, wipeTimes)
+		if interval == api.TournamentInterval_CUSTOM {
+			var tErr *tournamentRequestError
+			startedAt, tErr, err = c.service.getStartTime(ctx, name, interval)
+			if err != nil {
+				return nil, err
+			}
+			if tErr != nil {
+				if *tErr == DEFINITION_NOT_FOUND {
+					continue
+				}
+				// No window is running, so every window has ended
+				startedAt = time.Now().UTC()
+			}
+		}
+		currentStartedAt = append(currentStartedAt, model.TournamentIntervalStartedAt{
+			TournamentInterval:  model.TournamentTournamentInterval(interval.String()),
+			TournamentStartedAt: startedAt,
+		})
+	}
+	if name != "" {
+		return currentStartedAt, nil
+	}
+	tournamentWipeTimes, err := c.service.database.GetTournamentWipeTimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, wipeTime := range tournamentWipeTimes {
+		wipeTimes, err := tournament.NewWipeTimes(wipeTime.DailyTournamentMinute, wipeTime.WeeklyTournamentMinute, wipeTime.WeeklyTournamentDay, wipeTime.MonthlyTournamentMinute, wipeTime.MonthlyTournamentDay, wipeTime.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		for _, interval := range intervals {
+			if interval == api.TournamentInterval_UNLIMITED || interval == api.TournamentInterval_CUSTOM {
+				continue
+			}
+			currentStartedAt = append(currentStartedAt, model.TournamentIntervalStartedAt{
+				Name:                wipeTime.Name,
+				TournamentInterval:  model.TournamentTournamentInterval(interval.String()),
+				TournamentStartedAt: tournament.GetStartTime(time.Now().UTC(), interval, wipeTimes),
+			})
+		}
+	}
+	return currentStartedAt, nil
+}
@@ -0,0 +1,136 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestGetTournamentUserHistoryNoUserId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewGetTournamentUserHistoryCommand(service, &api.GetTournamentUserHistoryRequest{})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentUserHistoryResponse_USER_ID_REQUIRED {
+		t.Fatal("Expected error to be USER_ID_REQUIRED")
+	}
+}
+
+func TestGetTournamentUserHistoryNameTooShort(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTournamentNameLength(5))
+	c := NewGetTournamentUserHistoryCommand(service, &api.GetTournamentUserHistoryRequest{
+		UserId:     1,
+		Tournament: conversion.ValueToPointer("test"),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentUserHistoryResponse_TOURNAMENT_NAME_TOO_SHORT {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_SHORT")
+	}
+}
+
+func TestGetTournamentUserHistoryWeekly(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	thisWeek := time.Now().Truncate(7 * 24 * time.Hour).UTC()
+	lastWeek := thisWeek.Add(-7 * 24 * time.Hour)
+	rows := sqlmock.NewRows(rankedTournament).
+		AddRow(2, "test", "weekly", 1, nil, 50, 4, []byte("{}"), lastWeek, nil, time.Now(), time.Now()).
+		AddRow(1, "test", "weekly", 1, nil, 120, 1, []byte("{}"), lastWeek.Add(-7*24*time.Hour), lastWeek, time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	// The current week is left out, so the history can be cached
+	mock.ExpectQuery("SELECT (.+) FROM `tournament` AS `t` (.+)`t`.`tournament_started_at` < (.+) UNION ALL (.+) FROM `archived_tournament` AS `a` (.+) ORDER BY `tournament_started_at` DESC").WithArgs(1, "test", "WEEKLY", "WEEKLY", sqlmock.AnyArg(), 1, "test", "WEEKLY", 10).WillReturnRows(rows)
+	c := NewGetTournamentUserHistoryCommand(service, &api.GetTournamentUserHistoryRequest{
+		UserId:     1,
+		Tournament: conversion.ValueToPointer("test"),
+		Interval:   conversion.ValueToPointer(api.TournamentInterval_WEEKLY),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.TournamentUsers) != 2 {
+		t.Fatal("Expected 2 tournament users")
+	}
+	if c.Out.TournamentUsers[0].SentToThirdPartyAt != nil {
+		t.Fatal("Expected last week to not be sent to a third party yet")
+	}
+	if c.Out.TournamentUsers[1].Ranking != 1 {
+		t.Fatal("Expected the week before ranking to be 1")
+	}
+	if c.Out.TournamentUsers[1].SentToThirdPartyAt == nil {
+		t.Fatal("Expected the week before to be sent to a third party")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetTournamentUserHistoryNoNameTournamentWipeTime(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	wipeTimes := sqlmock.NewRows(tournamentWipeTime).AddRow(1, "tokyo", 0, 0, 1, 0, 1, "Asia/Tokyo", time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time ORDER BY name").WillReturnRows(wipeTimes)
+	// The tournament with its own wipe time is compared against its own current week, and every other tournament against the default one
+	mock.ExpectQuery("SELECT (.+) FROM `tournament` AS `t` (.+)`t`.`name` NOT IN (.+)`t`.`name` = (.+) UNION ALL (.+) FROM `archived_tournament` AS `a` (.+) ORDER BY `tournament_started_at` DESC").WithArgs(1, "WEEKLY", "WEEKLY", sqlmock.AnyArg(), "tokyo", "WEEKLY", sqlmock.AnyArg(), "tokyo", 1, "WEEKLY", 10).WillReturnRows(sqlmock.NewRows(rankedTournament))
+	c := NewGetTournamentUserHistoryCommand(service, &api.GetTournamentUserHistoryRequest{
+		UserId:   1,
+		Interval: conversion.ValueToPointer(api.TournamentInterval_WEEKLY),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -16,7 +16,7 @@ import (
 
 var (
 	rankedTournament = []string{"id", "name", "tournament_interval",
-		"user_id", "score", "ranking", "data", "tournament_started_at", "sent_to_third_party_at", "created_at", "updated_at"}
+		"user_id", "team_id", "score", "ranking", "data", "tournament_started_at", "sent_to_third_party_at", "created_at", "updated_at"}
 )
 
 func TestGetTournamentUserNoTournamentIntervalUserId(t *testing.T) {
@@ -136,7 +136,7 @@ func TestGetTournamentUserById(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	rows := sqlmock.NewRows(rankedTournament).AddRow(1, "test", "DAILY", 1, 1, 1, raw, time.Now(), time.Now(), time.Now(), time.Now())
+	rows := sqlmock.NewRows(rankedTournament).AddRow(1, "test", "DAILY", 1, nil, 1, 1, raw, time.Now(), time.Now(), time.Now(), time.Now())
 	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament`").WithArgs(1, 1).WillReturnRows(rows)
 	c := NewGetTournamentUserCommand(service, &api.TournamentUserRequest{
 		Id: conversion.ValueToPointer(uint64(1)),
@@ -188,7 +188,8 @@ func TestGetTournamentUserByTournamentIntervalUserId(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	rows := sqlmock.NewRows(rankedTournament).AddRow(1, "test", "DAILY", 1, 1, 1, raw, time.Now(), time.Now(), time.Now(), time.Now())
+	rows := sqlmock.NewRows(rankedTournament).AddRow(1, "test", "DAILY", 1, nil, 1, 1, raw, time.Now(), time.Now(), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
 	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament`").WithArgs("test", "DAILY", time.Now().Truncate(24*time.Hour).UTC(), 1, 1).WillReturnRows(rows)
 	c := NewGetTournamentUserCommand(service, &api.TournamentUserRequest{
 		TournamentIntervalUserId: &api.TournamentIntervalUserId{
@@ -236,7 +237,9 @@ func TestGetTournamentUserNotFound(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
 	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament`").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM `archived_tournament`").WillReturnError(sql.ErrNoRows)
 	c := NewGetTournamentUserCommand(service, &api.TournamentUserRequest{
 		TournamentIntervalUserId: &api.TournamentIntervalUserId{
 			Tournament: "test",
@@ -254,3 +257,97 @@ func TestGetTournamentUserNotFound(t *testing.T) {
 		t.Fatal("Expected error to be NOT_FOUND")
 	}
 }
+
+func TestGetTournamentUserByIdArchived(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament`").WithArgs(1, 1).WillReturnError(sql.ErrNoRows)
+	rows := sqlmock.NewRows(rankedTournament).AddRow(1, "test", "weekly", 1, nil, 50, 3, []byte("{}"), time.Now(), time.Now(), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM `archived_tournament`").WithArgs(1, 1).WillReturnRows(rows)
+	c := NewGetTournamentUserCommand(service, &api.TournamentUserRequest{
+		Id: conversion.ValueToPointer(uint64(1)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.TournamentUser.Interval != api.TournamentInterval_WEEKLY {
+		t.Fatal("Expected interval to be WEEKLY")
+	}
+	if c.Out.TournamentUser.Ranking != 3 {
+		t.Fatal("Expected ranking to be 3")
+	}
+	if c.Out.TournamentUser.SentToThirdPartyAt == nil {
+		t.Fatal("Expected sent to third party at to be set")
+	}
+}
+
+func TestGetTournamentUserByIdNotFoundInArchive(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament`").WithArgs(1, 1).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM `archived_tournament`").WithArgs(1, 1).WillReturnError(sql.ErrNoRows)
+	c := NewGetTournamentUserCommand(service, &api.TournamentUserRequest{
+		Id: conversion.ValueToPointer(uint64(1)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentUserResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
+
+func TestGetTournamentUserByTournamentIntervalUserIdArchived(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	startedAt := time.Now().Truncate(24 * time.Hour).UTC()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament`").WithArgs("test", "DAILY", startedAt, 1, 1).WillReturnError(sql.ErrNoRows)
+	rows := sqlmock.NewRows(rankedTournament).AddRow(1, "test", "daily", 1, nil, 50, 2, []byte("{}"), startedAt, time.Now(), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM `archived_tournament`").WithArgs("test", "DAILY", startedAt, 1, 1).WillReturnRows(rows)
+	c := NewGetTournamentUserCommand(service, &api.TournamentUserRequest{
+		TournamentIntervalUserId: &api.TournamentIntervalUserId{
+			Tournament: "test",
+			UserId:     1,
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.TournamentUser.Ranking != 2 {
+		t.Fatal("Expected ranking to be 2")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -2,12 +2,10 @@ package tournament
 
 import (
 	"context"
-	"time"
 
 	"github.com/MorhafAlshibly/coanda/api"
 	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
 	"github.com/MorhafAlshibly/coanda/pkg/conversion"
-	"github.com/MorhafAlshibly/coanda/pkg/tournament"
 )
 
 type GetTournamentUsersCommand struct {
@@ -41,24 +39,56 @@ func (c *GetTournamentUsersCommand) Execute(ctx context.Context) error {
 			return nil
 		}
 	}
+	// Every custom tournament has its own windows, so one must be chosen
+	if c.In.Interval == api.TournamentInterval_CUSTOM && c.In.Tournament == nil {
+		c.Out = &api.GetTournamentUsersResponse{
+			Success: false,
+			Error:   api.GetTournamentUsersResponse_TOURNAMENT_NAME_REQUIRED,
+		}
+		return nil
+	}
 	limit, offset := conversion.PaginationToLimitOffset(c.In.Pagination, c.service.defaultMaxPageLength, c.service.maxMaxPageLength)
-	result, err := c.service.database.GetTournaments(ctx, model.GetTournamentsParams{
-		Name:               conversion.StringToSqlNullString(c.In.Tournament),
-		TournamentInterval: model.TournamentTournamentInterval(c.In.Interval.String()),
-		UserID:             conversion.Uint64ToSqlNullInt64(c.In.UserId),
-		TournamentStartedAt: tournament.GetStartTime(time.Now(), c.In.Interval, tournament.WipeTimes{
-			DailyTournamentMinute:   c.service.dailyTournamentMinute,
-			WeeklyTournamentMinute:  c.service.weeklyTournamentMinute,
-			WeeklyTournamentDay:     c.service.weeklyTournamentDay,
-			MonthlyTournamentMinute: c.service.monthlyTournamentMinute,
-			MonthlyTournamentDay:    c.service.monthlyTournamentDay,
-		}),
-		Limit:  limit,
+	cursor, err := conversion.PaginationToCursor(c.In.Pagination)
+	if err != nil {
+		c.Out = &api.GetTournamentUsersResponse{
+			Success: false,
+			Error:   api.GetTournamentUsersResponse_INVALID_CURSOR,
+		}
+		return nil
+	}
+	// Seeking from a cursor replaces the page offset
+	if cursor != nil {
+		offset = 0
+	}
+	startedAt, tErr, err := c.service.getStartTime(ctx, conversion.PointerToValue(c.In.Tournament, ""), c.In.Interval)
+	if err != nil {
+		return err
+	}
+	params := model.GetTournamentsParams{
+		Name:                conversion.StringToSqlNullString(c.In.Tournament),
+		TournamentInterval:  model.TournamentTournamentInterval(c.In.Interval.String()),
+		UserID:              conversion.Uint64ToSqlNullInt64(c.In.UserId),
+		TournamentStartedAt: startedAt,
+		// Fetch one extra row to know whether there is another page
+		Limit:  limit + 1,
 		Offset: offset,
-	})
+		Seek:   convertCursorToSeek(cursor),
+	}
+	result, err := c.service.database.GetTournaments(ctx, params)
 	if err != nil {
 		return err
 	}
+	// The latest window of a custom tournament that has ended may have been moved to the archive
+	if len(result) == 0 && tErr != nil && *tErr == TOURNAMENT_NOT_ACTIVE {
+		params.Archived = true
+		result, err = c.service.database.GetTournaments(ctx, params)
+		if err != nil {
+			return err
+		}
+	}
+	result, nextCursor, previousCursor := conversion.CursorPage(result, limit, offset, cursor, func(tournament model.RankedTournament) conversion.Cursor {
+		return conversion.Cursor{Name: tournament.Name, Key: tournament.Score, ID: tournament.ID}
+	})
 	tournaments := make([]*api.TournamentUser, len(result))
 	for i, tournament := range result {
 		tournaments[i], err = unmarshalTournamentUser(&tournament)
@@ -70,6 +100,8 @@ func (c *GetTournamentUsersCommand) Execute(ctx context.Context) error {
 		Success:         true,
 		TournamentUsers: tournaments,
 		Error:           api.GetTournamentUsersResponse_NONE,
+		NextCursor:      nextCursor,
+		PreviousCursor:  previousCursor,
 	}
 	return nil
 }
@@ -2,6 +2,8 @@ package tournament
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -69,6 +71,7 @@ func TestGetTournamentUsersNoneFound(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
 	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament`").WillReturnRows(sqlmock.NewRows(rankedTournament))
 	c := NewGetTournamentUsersCommand(service, &api.GetTournamentUsersRequest{
 		Tournament: conversion.ValueToPointer("test"),
@@ -105,7 +108,8 @@ func TestGetTournamentUsersTwoUsers(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
-	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament`").WillReturnRows(sqlmock.NewRows(rankedTournament).AddRow(1, "test", "DAILY", 1, 1, 1, raw, time.Now(), time.Now(), time.Now(), time.Now()).AddRow(1, "test", "DAILY", 2, 1, 1, raw, time.Now(), time.Now(), time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament`").WillReturnRows(sqlmock.NewRows(rankedTournament).AddRow(1, "test", "DAILY", 1, nil, 1, 1, raw, time.Now(), time.Now(), time.Now(), time.Now()).AddRow(1, "test", "DAILY", 2, nil, 1, 1, raw, time.Now(), time.Now(), time.Now(), time.Now()))
 	c := NewGetTournamentUsersCommand(service, &api.GetTournamentUsersRequest{
 		Tournament: conversion.ValueToPointer("test"),
 	})
@@ -129,3 +133,140 @@ func TestGetTournamentUsersTwoUsers(t *testing.T) {
 		t.Fatal("Expected second user to have id 2")
 	}
 }
+
+func TestGetTournamentUsersInvalidCursor(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewGetTournamentUsersCommand(service, &api.GetTournamentUsersRequest{
+		Tournament: conversion.ValueToPointer("test"),
+		Pagination: &api.Pagination{
+			After: conversion.ValueToPointer("not a cursor"),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentUsersResponse_INVALID_CURSOR {
+		t.Fatal("Expected error to be INVALID_CURSOR")
+	}
+}
+
+func TestGetTournamentUsersNextCursor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament` (.+) ORDER BY `name` ASC, `score` DESC, `id` ASC LIMIT ?").WithArgs("test", "DAILY", sqlmock.AnyArg(), 2).WillReturnRows(sqlmock.NewRows(rankedTournament).AddRow(3, "test", "DAILY", 1, nil, 20, 1, json.RawMessage("{}"), time.Now(), time.Now(), time.Now(), time.Now()).AddRow(4, "test", "DAILY", 2, nil, 10, 2, json.RawMessage("{}"), time.Now(), time.Now(), time.Now(), time.Now()))
+	c := NewGetTournamentUsersCommand(service, &api.GetTournamentUsersRequest{
+		Tournament: conversion.ValueToPointer("test"),
+		Pagination: &api.Pagination{
+			Max: conversion.ValueToPointer(uint32(1)),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.TournamentUsers) != 1 {
+		t.Fatal("Expected users to have 1 user")
+	}
+	if c.Out.PreviousCursor != nil {
+		t.Fatal("Expected previous cursor to be nil")
+	}
+	cursor, err := conversion.DecodeCursor(*c.Out.NextCursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor.Name != "test" || cursor.Key != 20 || cursor.ID != 3 {
+		t.Fatal("Expected next cursor to point at the last user of the page")
+	}
+}
+
+func TestGetTournamentUsersAfterCursor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament` WHERE \\(`id` IN \\(\\(SELECT \\* FROM \\(SELECT `id` FROM `tournament` WHERE (.+) AND \\(\\(`name` > \\?\\) OR \\(\\(`name` = \\?\\) AND \\(`score` < \\?\\)\\) OR \\(\\(`name` = \\?\\) AND \\(`score` = \\?\\) AND \\(`id` > \\?\\)\\)\\)\\) ORDER BY").WithArgs("test", "DAILY", sqlmock.AnyArg(), "test", "test", 20, "test", 20, 3, 2).WillReturnRows(sqlmock.NewRows(rankedTournament).AddRow(4, "test", "DAILY", 2, nil, 10, 2, json.RawMessage("{}"), time.Now(), time.Now(), time.Now(), time.Now()))
+	c := NewGetTournamentUsersCommand(service, &api.GetTournamentUsersRequest{
+		Tournament: conversion.ValueToPointer("test"),
+		Pagination: &api.Pagination{
+			Max:   conversion.ValueToPointer(uint32(1)),
+			After: conversion.ValueToPointer(conversion.EncodeCursor(conversion.Cursor{Name: "test", Key: 20, ID: 3})),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.TournamentUsers) != 1 || c.Out.TournamentUsers[0].Id != 4 {
+		t.Fatal("Expected users to have the user after the cursor")
+	}
+	if c.Out.NextCursor != nil {
+		t.Fatal("Expected next cursor to be nil")
+	}
+	if c.Out.PreviousCursor == nil {
+		t.Fatal("Expected previous cursor to be set")
+	}
+}
+
+func TestGetTournamentUsersEndedCustomWindowArchived(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	// Hour long windows that stopped at the start of 2020, so the latest window has ended
+	definition := sqlmock.NewRows(tournamentDefinition).
+		AddRow(1, "test", nil, 3600, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), "UTC", []byte("{}"), time.Now(), time.Now())
+	startedAt := time.Date(2020, 1, 1, 23, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("SELECT (.+) FROM tournament_definition").WithArgs("test").WillReturnRows(definition)
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament` (.+) FROM `tournament`").WithArgs("test", "CUSTOM", startedAt, 11).WillReturnRows(sqlmock.NewRows(rankedTournament))
+	mock.ExpectQuery("SELECT (.+) FROM `archived_tournament` (.+) FROM `archived_tournament`").WithArgs("test", "CUSTOM", startedAt, 11).WillReturnRows(sqlmock.NewRows(rankedTournament).AddRow(1, "test", "custom", 1, nil, 10, 1, []byte("{}"), startedAt, time.Now(), time.Now(), time.Now()))
+	c := NewGetTournamentUsersCommand(service, &api.GetTournamentUsersRequest{
+		Tournament: conversion.ValueToPointer("test"),
+		Interval:   api.TournamentInterval_CUSTOM,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if len(c.Out.TournamentUsers) != 1 || c.Out.TournamentUsers[0].Ranking != 1 {
+		t.Fatal("Expected the archived user to be returned")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,56 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+)
+
+type GetTournamentWipeTimeCommand struct {
+	service *Service
+	In      *api.TournamentWipeTimeRequest
+	Out     *api.GetTournamentWipeTimeResponse
+}
+
+func NewGetTournamentWipeTimeCommand(service *Service, in *api.TournamentWipeTimeRequest) *GetTournamentWipeTimeCommand {
+	return &GetTournamentWipeTimeCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *GetTournamentWipeTimeCommand) Execute(ctx context.Context) error {
+	// Check if tournament name is valid
+	if len(c.In.Tournament) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.GetTournamentWipeTimeResponse{
+			Success: false,
+			Error:   api.GetTournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	if len(c.In.Tournament) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.GetTournamentWipeTimeResponse{
+			Success: false,
+			Error:   api.GetTournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	result, err := c.service.database.GetTournamentWipeTime(ctx, c.In.Tournament)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.GetTournamentWipeTimeResponse{
+				Success: false,
+				Error:   api.GetTournamentWipeTimeResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	c.Out = &api.GetTournamentWipeTimeResponse{
+		Success:            true,
+		TournamentWipeTime: unmarshalTournamentWipeTime(&result),
+		Error:              api.GetTournamentWipeTimeResponse_NONE,
+	}
+	return nil
+}
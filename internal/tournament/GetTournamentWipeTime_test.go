@@ -0,0 +1,71 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+var (
+	tournamentWipeTime = []string{"id", "name", "daily_tournament_minute", "weekly_tournament_minute", "weekly_tournament_day", "monthly_tournament_minute", "monthly_tournament_day", "time_zone", "created_at", "updated_at"}
+)
+
+func TestGetTournamentWipeTimeSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	rows := sqlmock.NewRows(tournamentWipeTime).AddRow(1, "test", 60, 0, 1, 0, 1, "Asia/Tokyo", time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnRows(rows)
+	c := NewGetTournamentWipeTimeCommand(service, &api.TournamentWipeTimeRequest{
+		Tournament: "test",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.TournamentWipeTime.DailyTournamentMinute != 60 {
+		t.Fatal("Expected daily tournament minute to be 60")
+	}
+	if c.Out.TournamentWipeTime.TimeZone != "Asia/Tokyo" {
+		t.Fatal("Expected time zone to be Asia/Tokyo")
+	}
+}
+
+func TestGetTournamentWipeTimeNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	c := NewGetTournamentWipeTimeCommand(service, &api.TournamentWipeTimeRequest{
+		Tournament: "test",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.GetTournamentWipeTimeResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
@@ -0,0 +1,67 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+)
+
+type RecordTournamentPairingResultCommand struct {
+	service *Service
+	In      *api.RecordTournamentPairingResultRequest
+	Out     *api.RecordTournamentPairingResultResponse
+}
+
+func NewRecordTournamentPairingResultCommand(service *Service, in *api.RecordTournamentPairingResultRequest) *RecordTournamentPairingResultCommand {
+	return &RecordTournamentPairingResultCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *RecordTournamentPairingResultCommand) Execute(ctx context.Context) error {
+	if c.In.Id == 0 {
+		c.Out = &api.RecordTournamentPairingResultResponse{
+			Success: false,
+			Error:   api.RecordTournamentPairingResultResponse_ID_REQUIRED,
+		}
+		return nil
+	}
+	result, err := c.service.database.RecordTournamentPairingResult(ctx, model.RecordTournamentPairingResultParams{
+		Result: model.NullTournamentPairingResult{TournamentPairingResult: model.TournamentPairingResult(c.In.Result.String()), Valid: true},
+		ID:     c.In.Id,
+	})
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		// Check if the pairing does not exist or already has a result, which byes have from when they are created
+		_, err = c.service.database.GetTournamentPairing(ctx, c.In.Id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Out = &api.RecordTournamentPairingResultResponse{
+					Success: false,
+					Error:   api.RecordTournamentPairingResultResponse_NOT_FOUND,
+				}
+				return nil
+			}
+			return err
+		}
+		c.Out = &api.RecordTournamentPairingResultResponse{
+			Success: false,
+			Error:   api.RecordTournamentPairingResultResponse_ALREADY_RECORDED,
+		}
+		return nil
+	}
+	c.Out = &api.RecordTournamentPairingResultResponse{
+		Success: true,
+		Error:   api.RecordTournamentPairingResultResponse_NONE,
+	}
+	return nil
+}
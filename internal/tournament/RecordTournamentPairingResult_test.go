@@ -0,0 +1,113 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestRecordTournamentPairingResultNoId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewRecordTournamentPairingResultCommand(service, &api.RecordTournamentPairingResultRequest{})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.RecordTournamentPairingResultResponse_ID_REQUIRED {
+		t.Fatal("Expected error to be ID_REQUIRED")
+	}
+}
+
+func TestRecordTournamentPairingResultSuccess(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectExec("UPDATE tournament_pairing").WithArgs("DRAW", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	c := NewRecordTournamentPairingResultCommand(service, &api.RecordTournamentPairingResultRequest{
+		Id:     1,
+		Result: api.TournamentPairingResult_DRAW,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+}
+
+func TestRecordTournamentPairingResultNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectExec("UPDATE tournament_pairing").WithArgs("FIRST_WON", 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_pairing").WithArgs(1).WillReturnError(sql.ErrNoRows)
+	c := NewRecordTournamentPairingResultCommand(service, &api.RecordTournamentPairingResultRequest{
+		Id:     1,
+		Result: api.TournamentPairingResult_FIRST_WON,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.RecordTournamentPairingResultResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
+
+func TestRecordTournamentPairingResultAlreadyRecorded(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectExec("UPDATE tournament_pairing").WithArgs("SECOND_WON", 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_pairing").WithArgs(1).WillReturnRows(sqlmock.NewRows(tournamentPairing).
+		AddRow(1, "test", "daily", time.Now(), "swiss", 1, 0, 1, 2, "first_won", time.Now(), time.Now(), time.Now()))
+	c := NewRecordTournamentPairingResultCommand(service, &api.RecordTournamentPairingResultRequest{
+		Id:     1,
+		Result: api.TournamentPairingResult_SECOND_WON,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.RecordTournamentPairingResultResponse_ALREADY_RECORDED {
+		t.Fatal("Expected error to be ALREADY_RECORDED")
+	}
+}
@@ -0,0 +1,168 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+)
+
+type ReportTournamentBracketMatchResultCommand struct {
+	service *Service
+	In      *api.ReportTournamentBracketMatchResultRequest
+	Out     *api.ReportTournamentBracketMatchResultResponse
+}
+
+func NewReportTournamentBracketMatchResultCommand(service *Service, in *api.ReportTournamentBracketMatchResultRequest) *ReportTournamentBracketMatchResultCommand {
+	return &ReportTournamentBracketMatchResultCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *ReportTournamentBracketMatchResultCommand) Execute(ctx context.Context) error {
+	if c.In.MatchId == 0 {
+		c.Out = &api.ReportTournamentBracketMatchResultResponse{
+			Success: false,
+			Error:   api.ReportTournamentBracketMatchResultResponse_MATCH_ID_REQUIRED,
+		}
+		return nil
+	}
+	if c.In.WinnerUserId == 0 {
+		c.Out = &api.ReportTournamentBracketMatchResultResponse{
+			Success: false,
+			Error:   api.ReportTournamentBracketMatchResultResponse_WINNER_USER_ID_REQUIRED,
+		}
+		return nil
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	match, err := qtx.GetTournamentBracketMatch(ctx, c.In.MatchId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.Out = &api.ReportTournamentBracketMatchResultResponse{
+				Success: false,
+				Error:   api.ReportTournamentBracketMatchResultResponse_NOT_FOUND,
+			}
+			return nil
+		}
+		return err
+	}
+	if match.CompletedAt.Valid {
+		c.Out = &api.ReportTournamentBracketMatchResultResponse{
+			Success: false,
+			Error:   api.ReportTournamentBracketMatchResultResponse_ALREADY_REPORTED,
+		}
+		return nil
+	}
+	if !match.FirstUserID.Valid || !match.SecondUserID.Valid {
+		c.Out = &api.ReportTournamentBracketMatchResultResponse{
+			Success: false,
+			Error:   api.ReportTournamentBracketMatchResultResponse_MATCH_NOT_READY,
+		}
+		return nil
+	}
+	var loserUserId uint64
+	switch c.In.WinnerUserId {
+	case uint64(match.FirstUserID.Int64):
+		loserUserId = uint64(match.SecondUserID.Int64)
+	case uint64(match.SecondUserID.Int64):
+		loserUserId = uint64(match.FirstUserID.Int64)
+	default:
+		c.Out = &api.ReportTournamentBracketMatchResultResponse{
+			Success: false,
+			Error:   api.ReportTournamentBracketMatchResultResponse_WINNER_NOT_IN_MATCH,
+		}
+		return nil
+	}
+	_, err = qtx.CompleteTournamentBracketMatch(ctx, model.CompleteTournamentBracketMatchParams{
+		WinnerUserID: sql.NullInt64{Int64: int64(c.In.WinnerUserId), Valid: true},
+		ID:           match.ID,
+	})
+	if err != nil {
+		return err
+	}
+	bracket, err := qtx.GetTournamentBracket(ctx, match.TournamentBracketID)
+	if err != nil {
+		return err
+	}
+	// The matchmaking matches are created after the result is committed, so the client is checked before
+	if bracket.MatchmakingArenaID.Valid && c.service.matchmakingClient == nil {
+		return errMatchmakingClientRequired
+	}
+	// The participant from the winners bracket ends a double elimination bracket by winning the grand final, so the reset match is left unplayed
+	endedWithoutReset := match.Side == model.TournamentBracketMatchSideGrandFinal && match.WinnerMatchID.Valid && c.In.WinnerUserId == uint64(match.FirstUserID.Int64)
+	// Move the winner and the loser to their next matches, and create matchmaking matches for the ones that now have both users
+	moves := []struct {
+		matchId sql.NullInt64
+		slot    sql.NullInt32
+		userId  uint64
+	}{
+		{match.WinnerMatchID, match.WinnerMatchSlot, c.In.WinnerUserId},
+		{match.LoserMatchID, match.LoserMatchSlot, loserUserId},
+	}
+	if endedWithoutReset {
+		moves = nil
+	}
+	matchmakingMatches := []bracketMatchmakingMatch{}
+	for _, move := range moves {
+		if !move.matchId.Valid {
+			continue
+		}
+		_, err = qtx.SetTournamentBracketMatchUser(ctx, model.SetTournamentBracketMatchUserParams{
+			ID:     uint64(move.matchId.Int64),
+			Slot:   uint8(move.slot.Int32),
+			UserID: move.userId,
+		})
+		if err != nil {
+			return err
+		}
+		if !bracket.MatchmakingArenaID.Valid {
+			continue
+		}
+		next, err := qtx.GetTournamentBracketMatch(ctx, uint64(move.matchId.Int64))
+		if err != nil {
+			return err
+		}
+		if next.FirstUserID.Valid && next.SecondUserID.Valid {
+			matchmakingMatches = append(matchmakingMatches, bracketMatchmakingMatch{
+				bracketId:    bracket.ID,
+				matchId:      next.ID,
+				firstUserId:  uint64(next.FirstUserID.Int64),
+				secondUserId: uint64(next.SecondUserID.Int64),
+			})
+		}
+	}
+	// The winner of the final match is the winner of the bracket
+	bracketEnded := !match.WinnerMatchID.Valid || endedWithoutReset
+	if bracketEnded {
+		_, err = qtx.EndTournamentBracket(ctx, model.EndTournamentBracketParams{
+			WinnerUserID: sql.NullInt64{Int64: int64(c.In.WinnerUserId), Valid: true},
+			ID:           bracket.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	if bracket.MatchmakingArenaID.Valid {
+		err = c.service.createBracketMatchmakingMatches(ctx, uint64(bracket.MatchmakingArenaID.Int64), matchmakingMatches)
+		if err != nil {
+			return err
+		}
+	}
+	c.Out = &api.ReportTournamentBracketMatchResultResponse{
+		Success:      true,
+		BracketEnded: bracketEnded,
+		Error:        api.ReportTournamentBracketMatchResultResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package tournament
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+var (
+	tournamentBracket      = []string{"id", "name", "format", "matchmaking_arena_id", "winner_user_id", "data", "ended_at", "created_at", "updated_at"}
+	tournamentBracketMatch = []string{"id", "tournament_bracket_id", "side", "round", "position", "first_user_id", "second_user_id", "winner_user_id", "winner_match_id", "winner_match_slot", "loser_match_id", "loser_match_slot", "matchmaking_match_id", "completed_at", "created_at", "updated_at"}
+)
+
+func TestReportTournamentBracketMatchResultNoMatchId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewReportTournamentBracketMatchResultCommand(service, &api.ReportTournamentBracketMatchResultRequest{
+		WinnerUserId: 1,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.ReportTournamentBracketMatchResultResponse_MATCH_ID_REQUIRED {
+		t.Fatal("Expected error to be MATCH_ID_REQUIRED")
+	}
+}
+
+func TestReportTournamentBracketMatchResultNotReady(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket_match").WithArgs(1).WillReturnRows(sqlmock.NewRows(tournamentBracketMatch).
+		AddRow(1, 1, "winners", 2, 0, 1, nil, nil, nil, nil, nil, nil, nil, nil, time.Now(), time.Now()))
+	mock.ExpectRollback()
+	c := NewReportTournamentBracketMatchResultCommand(service, &api.ReportTournamentBracketMatchResultRequest{
+		MatchId:      1,
+		WinnerUserId: 1,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.ReportTournamentBracketMatchResultResponse_MATCH_NOT_READY {
+		t.Fatal("Expected error to be MATCH_NOT_READY")
+	}
+}
+
+func TestReportTournamentBracketMatchResultWinnerNotInMatch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket_match").WithArgs(1).WillReturnRows(sqlmock.NewRows(tournamentBracketMatch).
+		AddRow(1, 1, "winners", 1, 0, 1, 2, nil, nil, nil, nil, nil, nil, nil, time.Now(), time.Now()))
+	mock.ExpectRollback()
+	c := NewReportTournamentBracketMatchResultCommand(service, &api.ReportTournamentBracketMatchResultRequest{
+		MatchId:      1,
+		WinnerUserId: 3,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.ReportTournamentBracketMatchResultResponse_WINNER_NOT_IN_MATCH {
+		t.Fatal("Expected error to be WINNER_NOT_IN_MATCH")
+	}
+}
+
+func TestReportTournamentBracketMatchResultAlreadyReported(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket_match").WithArgs(1).WillReturnRows(sqlmock.NewRows(tournamentBracketMatch).
+		AddRow(1, 1, "winners", 1, 0, 1, 2, 1, nil, nil, nil, nil, nil, time.Now(), time.Now(), time.Now()))
+	mock.ExpectRollback()
+	c := NewReportTournamentBracketMatchResultCommand(service, &api.ReportTournamentBracketMatchResultRequest{
+		MatchId:      1,
+		WinnerUserId: 2,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.ReportTournamentBracketMatchResultResponse_ALREADY_REPORTED {
+		t.Fatal("Expected error to be ALREADY_REPORTED")
+	}
+}
+
+func TestReportTournamentBracketMatchResultWinnerMovesOn(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket_match").WithArgs(1).WillReturnRows(sqlmock.NewRows(tournamentBracketMatch).
+		AddRow(1, 1, "winners", 1, 0, 1, 2, nil, 3, 1, nil, nil, nil, nil, time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE tournament_bracket_match").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket").WithArgs(1).WillReturnRows(sqlmock.NewRows(tournamentBracket).
+		AddRow(1, "test", "single_elimination", nil, nil, []byte("{}"), nil, time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE `tournament_bracket_match`").WithArgs(2, 3, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c := NewReportTournamentBracketMatchResultCommand(service, &api.ReportTournamentBracketMatchResultRequest{
+		MatchId:      1,
+		WinnerUserId: 2,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.BracketEnded != false {
+		t.Fatal("Expected bracket ended to be false")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReportTournamentBracketMatchResultFinalEndsBracket(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket_match").WithArgs(3).WillReturnRows(sqlmock.NewRows(tournamentBracketMatch).
+		AddRow(3, 1, "winners", 2, 0, 1, 2, nil, nil, nil, nil, nil, nil, nil, time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE tournament_bracket_match").WithArgs(1, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket").WithArgs(1).WillReturnRows(sqlmock.NewRows(tournamentBracket).
+		AddRow(1, "test", "single_elimination", nil, nil, []byte("{}"), nil, time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE tournament_bracket").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c := NewReportTournamentBracketMatchResultCommand(service, &api.ReportTournamentBracketMatchResultRequest{
+		MatchId:      3,
+		WinnerUserId: 1,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.BracketEnded != true {
+		t.Fatal("Expected bracket ended to be true")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReportTournamentBracketMatchResultGrandFinalWonByWinnersBracketEndsBracket(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	// The grand final sends both users to the reset match, which is not played as user 1 has not lost yet
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket_match").WithArgs(5).WillReturnRows(sqlmock.NewRows(tournamentBracketMatch).
+		AddRow(5, 1, "grand_final", 1, 0, 1, 2, nil, 6, 0, 6, 1, nil, nil, time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE tournament_bracket_match").WithArgs(1, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket").WithArgs(1).WillReturnRows(sqlmock.NewRows(tournamentBracket).
+		AddRow(1, "test", "double_elimination", nil, nil, []byte("{}"), nil, time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE tournament_bracket").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c := NewReportTournamentBracketMatchResultCommand(service, &api.ReportTournamentBracketMatchResultRequest{
+		MatchId:      5,
+		WinnerUserId: 1,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.BracketEnded != true {
+		t.Fatal("Expected bracket ended to be true")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReportTournamentBracketMatchResultGrandFinalWonByLosersBracketResetsBracket(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket_match").WithArgs(5).WillReturnRows(sqlmock.NewRows(tournamentBracketMatch).
+		AddRow(5, 1, "grand_final", 1, 0, 1, 2, nil, 6, 0, 6, 1, nil, nil, time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE tournament_bracket_match").WithArgs(2, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_bracket").WithArgs(1).WillReturnRows(sqlmock.NewRows(tournamentBracket).
+		AddRow(1, "test", "double_elimination", nil, nil, []byte("{}"), nil, time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE `tournament_bracket_match`").WithArgs(2, 6, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `tournament_bracket_match`").WithArgs(1, 6, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c := NewReportTournamentBracketMatchResultCommand(service, &api.ReportTournamentBracketMatchResultRequest{
+		MatchId:      5,
+		WinnerUserId: 2,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.BracketEnded != false {
+		t.Fatal("Expected bracket ended to be false")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,101 @@
+package tournament
+
+import (
+	"context"
+	"time"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+const (
+	minutesInDay = 24 * 60
+	// Later days do not exist in every month
+	maxMonthlyTournamentDay = 28
+)
+
+type SetTournamentWipeTimeCommand struct {
+	service *Service
+	In      *api.SetTournamentWipeTimeRequest
+	Out     *api.SetTournamentWipeTimeResponse
+}
+
+func NewSetTournamentWipeTimeCommand(service *Service, in *api.SetTournamentWipeTimeRequest) *SetTournamentWipeTimeCommand {
+	return &SetTournamentWipeTimeCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *SetTournamentWipeTimeCommand) Execute(ctx context.Context) error {
+	// Check if tournament name is large enough
+	if len(c.In.Tournament) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.SetTournamentWipeTimeResponse{
+			Success: false,
+			Error:   api.SetTournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	// Check if tournament name is small enough
+	if len(c.In.Tournament) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.SetTournamentWipeTimeResponse{
+			Success: false,
+			Error:   api.SetTournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	// Wipe times that are not given default to the wipe times of the service
+	dailyTournamentMinute := conversion.PointerToValue(c.In.DailyTournamentMinute, uint32(c.service.dailyTournamentMinute))
+	weeklyTournamentMinute := conversion.PointerToValue(c.In.WeeklyTournamentMinute, uint32(c.service.weeklyTournamentMinute))
+	weeklyTournamentDay := conversion.PointerToValue(c.In.WeeklyTournamentDay, uint32(c.service.weeklyTournamentDay))
+	monthlyTournamentMinute := conversion.PointerToValue(c.In.MonthlyTournamentMinute, uint32(c.service.monthlyTournamentMinute))
+	monthlyTournamentDay := conversion.PointerToValue(c.In.MonthlyTournamentDay, uint32(c.service.monthlyTournamentDay))
+	timeZone := conversion.PointerToValue(c.In.TimeZone, "UTC")
+	if dailyTournamentMinute >= minutesInDay || weeklyTournamentMinute >= minutesInDay || monthlyTournamentMinute >= minutesInDay {
+		c.Out = &api.SetTournamentWipeTimeResponse{
+			Success: false,
+			Error:   api.SetTournamentWipeTimeResponse_INVALID_MINUTE,
+		}
+		return nil
+	}
+	if weeklyTournamentDay > uint32(time.Saturday) {
+		c.Out = &api.SetTournamentWipeTimeResponse{
+			Success: false,
+			Error:   api.SetTournamentWipeTimeResponse_INVALID_WEEKLY_TOURNAMENT_DAY,
+		}
+		return nil
+	}
+	if monthlyTournamentDay == 0 || monthlyTournamentDay > maxMonthlyTournamentDay {
+		c.Out = &api.SetTournamentWipeTimeResponse{
+			Success: false,
+			Error:   api.SetTournamentWipeTimeResponse_INVALID_MONTHLY_TOURNAMENT_DAY,
+		}
+		return nil
+	}
+	_, err := time.LoadLocation(timeZone)
+	if err != nil || timeZone == "" || timeZone == "Local" {
+		c.Out = &api.SetTournamentWipeTimeResponse{
+			Success: false,
+			Error:   api.SetTournamentWipeTimeResponse_INVALID_TIME_ZONE,
+		}
+		return nil
+	}
+	_, err = c.service.database.SetTournamentWipeTime(ctx, model.SetTournamentWipeTimeParams{
+		Name:                    c.In.Tournament,
+		DailyTournamentMinute:   dailyTournamentMinute,
+		WeeklyTournamentMinute:  weeklyTournamentMinute,
+		WeeklyTournamentDay:     weeklyTournamentDay,
+		MonthlyTournamentMinute: monthlyTournamentMinute,
+		MonthlyTournamentDay:    monthlyTournamentDay,
+		TimeZone:                timeZone,
+	})
+	if err != nil {
+		return err
+	}
+	c.Out = &api.SetTournamentWipeTimeResponse{
+		Success: true,
+		Error:   api.SetTournamentWipeTimeResponse_NONE,
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package tournament
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestSetTournamentWipeTimeTournamentNameTooShort(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewSetTournamentWipeTimeCommand(service, &api.SetTournamentWipeTimeRequest{
+		Tournament: "t",
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.SetTournamentWipeTimeResponse_TOURNAMENT_NAME_TOO_SHORT {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_SHORT")
+	}
+}
+
+func TestSetTournamentWipeTimeInvalidMinute(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewSetTournamentWipeTimeCommand(service, &api.SetTournamentWipeTimeRequest{
+		Tournament:            "test",
+		DailyTournamentMinute: conversion.ValueToPointer(uint32(1440)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.SetTournamentWipeTimeResponse_INVALID_MINUTE {
+		t.Fatal("Expected error to be INVALID_MINUTE")
+	}
+}
+
+func TestSetTournamentWipeTimeInvalidWeeklyTournamentDay(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewSetTournamentWipeTimeCommand(service, &api.SetTournamentWipeTimeRequest{
+		Tournament:          "test",
+		WeeklyTournamentDay: conversion.ValueToPointer(uint32(7)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.SetTournamentWipeTimeResponse_INVALID_WEEKLY_TOURNAMENT_DAY {
+		t.Fatal("Expected error to be INVALID_WEEKLY_TOURNAMENT_DAY")
+	}
+}
+
+func TestSetTournamentWipeTimeInvalidMonthlyTournamentDay(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewSetTournamentWipeTimeCommand(service, &api.SetTournamentWipeTimeRequest{
+		Tournament:           "test",
+		MonthlyTournamentDay: conversion.ValueToPointer(uint32(31)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.SetTournamentWipeTimeResponse_INVALID_MONTHLY_TOURNAMENT_DAY {
+		t.Fatal("Expected error to be INVALID_MONTHLY_TOURNAMENT_DAY")
+	}
+}
+
+func TestSetTournamentWipeTimeInvalidTimeZone(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewSetTournamentWipeTimeCommand(service, &api.SetTournamentWipeTimeRequest{
+		Tournament: "test",
+		TimeZone:   conversion.ValueToPointer("Asia/Atlantis"),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.SetTournamentWipeTimeResponse_INVALID_TIME_ZONE {
+		t.Fatal("Expected error to be INVALID_TIME_ZONE")
+	}
+}
+
+func TestSetTournamentWipeTimeDefaultsFromService(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithDailyTournamentMinute(60))
+	mock.ExpectExec("INSERT INTO tournament_wipe_time").WithArgs("test", 60, 540, 1, 0, 1, "Asia/Tokyo").WillReturnResult(sqlmock.NewResult(1, 1))
+	c := NewSetTournamentWipeTimeCommand(service, &api.SetTournamentWipeTimeRequest{
+		Tournament:             "test",
+		WeeklyTournamentMinute: conversion.ValueToPointer(uint32(540)),
+		TimeZone:               conversion.ValueToPointer("Asia/Tokyo"),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+}
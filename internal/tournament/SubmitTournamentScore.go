@@ -0,0 +1,137 @@
+package tournament
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type SubmitTournamentScoreCommand struct {
+	service *Service
+	In      *api.SubmitTournamentScoreRequest
+	Out     *api.SubmitTournamentScoreResponse
+}
+
+func NewSubmitTournamentScoreCommand(service *Service, in *api.SubmitTournamentScoreRequest) *SubmitTournamentScoreCommand {
+	return &SubmitTournamentScoreCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *SubmitTournamentScoreCommand) Execute(ctx context.Context) error {
+	// Check if tournament name is large enough
+	if len(c.In.Tournament) < int(c.service.minTournamentNameLength) {
+		c.Out = &api.SubmitTournamentScoreResponse{
+			Success: false,
+			Error:   api.SubmitTournamentScoreResponse_TOURNAMENT_NAME_TOO_SHORT,
+		}
+		return nil
+	}
+	// Check if tournament name is small enough
+	if len(c.In.Tournament) > int(c.service.maxTournamentNameLength) {
+		c.Out = &api.SubmitTournamentScoreResponse{
+			Success: false,
+			Error:   api.SubmitTournamentScoreResponse_TOURNAMENT_NAME_TOO_LONG,
+		}
+		return nil
+	}
+	// Check if user id is valid
+	if c.In.UserId == 0 {
+		c.Out = &api.SubmitTournamentScoreResponse{
+			Success: false,
+			Error:   api.SubmitTournamentScoreResponse_USER_ID_REQUIRED,
+		}
+		return nil
+	}
+	// Check if score mode is valid, so an unknown score mode does not overwrite the score
+	scoreMode, ok := convertScoreMode(&c.In.ScoreMode, nil)
+	if !ok {
+		c.Out = &api.SubmitTournamentScoreResponse{
+			Success: false,
+			Error:   api.SubmitTournamentScoreResponse_SCORE_MODE_INVALID,
+		}
+		return nil
+	}
+	startedAt, tErr, err := c.service.getStartTime(ctx, c.In.Tournament, c.In.Interval)
+	if err != nil {
+		return err
+	}
+	if tErr != nil {
+		c.Out = &api.SubmitTournamentScoreResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.SubmitTournamentScoreResponse_Error_value, api.SubmitTournamentScoreResponse_DEFINITION_NOT_FOUND),
+		}
+		return nil
+	}
+	// A team can only be given by one of its members
+	isTeamMember, err := c.service.isTeamMember(ctx, c.In.UserId, c.In.TeamId)
+	if err != nil {
+		return err
+	}
+	if !isTeamMember {
+		c.Out = &api.SubmitTournamentScoreResponse{
+			Success: false,
+			Error:   api.SubmitTournamentScoreResponse_NOT_A_TEAM_MEMBER,
+		}
+		return nil
+	}
+	// A new tournament user is created with empty data if no data is given
+	data := json.RawMessage("{}")
+	if c.In.Data != nil {
+		data, err = conversion.ProtobufStructToRawJson(c.In.Data)
+		if err != nil {
+			return err
+		}
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	// Insert the tournament user, or apply the score to the tournament user if it already exists in the current tournament
+	_, err = qtx.SubmitTournamentScore(ctx, model.SubmitTournamentScoreParams{
+		Name:                c.In.Tournament,
+		TournamentInterval:  model.TournamentTournamentInterval(c.In.Interval.String()),
+		UserID:              c.In.UserId,
+		TeamID:              conversion.Uint64ToSqlNullInt64(c.In.TeamId),
+		Score:               c.In.Score,
+		Data:                data,
+		TournamentStartedAt: startedAt,
+		ScoreMode:           scoreMode.String(),
+		UpdateData:          c.In.Data != nil,
+	})
+	if err != nil {
+		return err
+	}
+	tournamentUser, err := qtx.GetTournament(ctx, model.GetTournamentParams{
+		NameIntervalUserIDStartedAt: model.NullNameIntervalUserIDStartedAt{
+			Name:                c.In.Tournament,
+			TournamentInterval:  model.TournamentTournamentInterval(c.In.Interval.String()),
+			UserID:              c.In.UserId,
+			TournamentStartedAt: startedAt,
+			Valid:               true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	out, err := unmarshalTournamentUser(&tournamentUser)
+	if err != nil {
+		return err
+	}
+	c.Out = &api.SubmitTournamentScoreResponse{
+		Success:        true,
+		TournamentUser: out,
+		Error:          api.SubmitTournamentScoreResponse_NONE,
+	}
+	return nil
+}
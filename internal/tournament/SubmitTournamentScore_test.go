@@ -0,0 +1,195 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestSubmitTournamentScoreNameTooShort(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMinTournamentNameLength(5))
+	c := NewSubmitTournamentScoreCommand(service, &api.SubmitTournamentScoreRequest{
+		Tournament: "a",
+		UserId:     1,
+		Score:      10,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.SubmitTournamentScoreResponse_TOURNAMENT_NAME_TOO_SHORT {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_SHORT")
+	}
+}
+
+func TestSubmitTournamentScoreNoUserId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewSubmitTournamentScoreCommand(service, &api.SubmitTournamentScoreRequest{
+		Tournament: "test",
+		Score:      10,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.SubmitTournamentScoreResponse_USER_ID_REQUIRED {
+		t.Fatal("Expected error to be USER_ID_REQUIRED")
+	}
+}
+
+func TestSubmitTournamentScoreInvalidScoreMode(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewSubmitTournamentScoreCommand(service, &api.SubmitTournamentScoreRequest{
+		Tournament: "test",
+		UserId:     1,
+		Score:      10,
+		ScoreMode:  api.TournamentScoreMode(100),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.SubmitTournamentScoreResponse_SCORE_MODE_INVALID {
+		t.Fatal("Expected error to be SCORE_MODE_INVALID")
+	}
+}
+
+func TestSubmitTournamentScoreDefinitionNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_definition").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	c := NewSubmitTournamentScoreCommand(service, &api.SubmitTournamentScoreRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_CUSTOM,
+		UserId:     1,
+		Score:      10,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.SubmitTournamentScoreResponse_DEFINITION_NOT_FOUND {
+		t.Fatal("Expected error to be DEFINITION_NOT_FOUND")
+	}
+}
+
+func TestSubmitTournamentScoreMax(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	raw, err := conversion.MapToRawJson(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	startedAt := time.Now().Truncate(24 * time.Hour).UTC()
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO tournament (.+) ON DUPLICATE KEY UPDATE").WithArgs("test", "DAILY", 1, nil, 10, raw, startedAt, "max", "max", "max", false).WillReturnResult(sqlmock.NewResult(1, 2))
+	rows := sqlmock.NewRows(rankedTournament).AddRow(1, "test", "DAILY", 1, nil, 12, 3, raw, startedAt, time.Now(), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament`").WithArgs("test", "DAILY", startedAt, 1, 1).WillReturnRows(rows)
+	mock.ExpectCommit()
+	c := NewSubmitTournamentScoreCommand(service, &api.SubmitTournamentScoreRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		UserId:     1,
+		Score:      10,
+		ScoreMode:  api.TournamentScoreMode_MAX,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.TournamentUser.Score != 12 {
+		t.Fatal("Expected score to be 12")
+	}
+	if c.Out.TournamentUser.Ranking != 3 {
+		t.Fatal("Expected ranking to be 3")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSubmitTournamentScoreNotATeamMember(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+) FROM team_member").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"team_id"}).AddRow(3))
+	c := NewSubmitTournamentScoreCommand(service, &api.SubmitTournamentScoreRequest{
+		Tournament: "test",
+		Interval:   api.TournamentInterval_DAILY,
+		UserId:     1,
+		TeamId:     conversion.ValueToPointer(uint64(2)),
+		Score:      10,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.SubmitTournamentScoreResponse_NOT_A_TEAM_MEMBER {
+		t.Fatal("Expected error to be NOT_A_TEAM_MEMBER")
+	}
+}
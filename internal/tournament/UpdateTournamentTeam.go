@@ -0,0 +1,129 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+)
+
+type UpdateTournamentTeamCommand struct {
+	service *Service
+	In      *api.UpdateTournamentTeamRequest
+	Out     *api.UpdateTournamentTeamResponse
+}
+
+func NewUpdateTournamentTeamCommand(service *Service, in *api.UpdateTournamentTeamRequest) *UpdateTournamentTeamCommand {
+	return &UpdateTournamentTeamCommand{
+		service: service,
+		In:      in,
+	}
+}
+
+func (c *UpdateTournamentTeamCommand) Execute(ctx context.Context) error {
+	tErr := c.service.checkForTournamentTeamRequestError(c.In.Tournament)
+	if tErr != nil {
+		c.Out = &api.UpdateTournamentTeamResponse{
+			Success: false,
+			Error:   conversion.Enum(*tErr, api.UpdateTournamentTeamResponse_Error_value, api.UpdateTournamentTeamResponse_ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED),
+		}
+		return nil
+	}
+	if c.In.Score == nil && c.In.Data == nil {
+		c.Out = &api.UpdateTournamentTeamResponse{
+			Success: false,
+			Error:   api.UpdateTournamentTeamResponse_NO_UPDATE_SPECIFIED,
+		}
+		return nil
+	}
+	scoreMode, ok := convertScoreMode(c.In.ScoreMode, c.In.IncrementScore)
+	if c.In.Score != nil && !ok {
+		c.Out = &api.UpdateTournamentTeamResponse{
+			Success: false,
+			Error:   api.UpdateTournamentTeamResponse_INCREMENT_SCORE_NOT_SPECIFIED,
+		}
+		return nil
+	}
+	data := json.RawMessage(nil)
+	if c.In.Data != nil {
+		var err error
+		data, err = conversion.ProtobufStructToRawJson(c.In.Data)
+		if err != nil {
+			return err
+		}
+	}
+	nameIntervalTeamIDStartedAt, err := c.service.convertTournamentIntervalTeamIdToNullNameIntervalTeamIDStartedAt(ctx, c.In.Tournament.TournamentIntervalTeamId)
+	if err != nil {
+		return err
+	}
+	tournamentTeam := model.GetTournamentTeamParams{
+		ID:                          conversion.Uint64ToSqlNullInt64(c.In.Tournament.Id),
+		NameIntervalTeamIDStartedAt: nameIntervalTeamIDStartedAt,
+	}
+	tx, err := c.service.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := c.service.database.WithTx(tx)
+	scoreChanged := false
+	if c.In.Score != nil {
+		// The score is updated on its own so the affected rows show whether the stored score changed
+		result, err := qtx.UpdateTournamentTeam(ctx, model.UpdateTournamentTeamParams{
+			TournamentTeam: tournamentTeam,
+			Score:          conversion.Int64ToSqlNullInt64(c.In.Score),
+			ScoreMode:      scoreMode,
+		})
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		scoreChanged = rowsAffected != 0
+	}
+	dataChanged := false
+	if c.In.Data != nil {
+		result, err := qtx.UpdateTournamentTeam(ctx, model.UpdateTournamentTeamParams{
+			TournamentTeam: tournamentTeam,
+			Data:           data,
+		})
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		dataChanged = rowsAffected != 0
+	}
+	if !scoreChanged && !dataChanged {
+		// Check if we didn't find a row
+		_, err = qtx.GetTournamentTeam(ctx, tournamentTeam)
+		// Check if tournament team is found, if not return not found
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Out = &api.UpdateTournamentTeamResponse{
+					Success: false,
+					Error:   api.UpdateTournamentTeamResponse_NOT_FOUND,
+				}
+				return nil
+			}
+			return err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	c.Out = &api.UpdateTournamentTeamResponse{
+		Success:      true,
+		Error:        api.UpdateTournamentTeamResponse_NONE,
+		ScoreChanged: scoreChanged,
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+package tournament
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
+	"github.com/MorhafAlshibly/coanda/pkg/conversion"
+	"github.com/MorhafAlshibly/coanda/pkg/invoker"
+)
+
+func TestUpdateTournamentTeamNoTournamentRequest(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewUpdateTournamentTeamCommand(service, &api.UpdateTournamentTeamRequest{})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTournamentTeamResponse_ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED {
+		t.Fatal("Expected error to be ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED")
+	}
+}
+
+func TestUpdateTournamentTeamNoTournamentIntervalTeamId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewUpdateTournamentTeamCommand(service, &api.UpdateTournamentTeamRequest{
+		Tournament: &api.TournamentTeamRequest{},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTournamentTeamResponse_ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED {
+		t.Fatal("Expected error to be ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED")
+	}
+}
+
+func TestUpdateTournamentTeamNameTooShort(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewUpdateTournamentTeamCommand(service, &api.UpdateTournamentTeamRequest{
+		Tournament: &api.TournamentTeamRequest{
+			TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+				Tournament: "a",
+			},
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTournamentTeamResponse_TOURNAMENT_NAME_TOO_SHORT {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_SHORT")
+	}
+}
+
+func TestUpdateTournamentTeamNameTooLong(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries), WithMaxTournamentNameLength(5))
+	c := NewUpdateTournamentTeamCommand(service, &api.UpdateTournamentTeamRequest{
+		Tournament: &api.TournamentTeamRequest{
+			TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+				Tournament: "123456",
+			},
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTournamentTeamResponse_TOURNAMENT_NAME_TOO_LONG {
+		t.Fatal("Expected error to be TOURNAMENT_NAME_TOO_LONG")
+	}
+}
+
+func TestUpdateTournamentTeamNoTeamId(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewUpdateTournamentTeamCommand(service, &api.UpdateTournamentTeamRequest{
+		Tournament: &api.TournamentTeamRequest{
+			TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+				Tournament: "test",
+			},
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTournamentTeamResponse_TEAM_ID_REQUIRED {
+		t.Fatal("Expected error to be TEAM_ID_REQUIRED")
+	}
+}
+
+func TestUpdateTournamentTeamNoUpdateSpecified(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewUpdateTournamentTeamCommand(service, &api.UpdateTournamentTeamRequest{
+		Tournament: &api.TournamentTeamRequest{
+			Id: conversion.ValueToPointer(uint64(1)),
+		},
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTournamentTeamResponse_NO_UPDATE_SPECIFIED {
+		t.Fatal("Expected error to be NO_UPDATE_SPECIFIED")
+	}
+}
+
+func TestUpdateTournamentTeamScoreWithoutIncrement(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	c := NewUpdateTournamentTeamCommand(service, &api.UpdateTournamentTeamRequest{
+		Tournament: &api.TournamentTeamRequest{
+			Id: conversion.ValueToPointer(uint64(1)),
+		},
+		Score:          conversion.ValueToPointer(int64(1)),
+		IncrementScore: nil,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTournamentTeamResponse_INCREMENT_SCORE_NOT_SPECIFIED {
+		t.Fatal("Expected error to be INCREMENT_SCORE_NOT_SPECIFIED")
+	}
+}
+
+func TestUpdateTournamentTeamData(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `tournament_team`").WithArgs(raw, uint64(1), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	c := NewUpdateTournamentTeamCommand(service, &api.UpdateTournamentTeamRequest{
+		Tournament: &api.TournamentTeamRequest{
+			Id: conversion.ValueToPointer(uint64(1)),
+		},
+		Data: data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+}
+
+func TestUpdateTournamentTeamScore(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `tournament_team`").WithArgs(10, 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	c := NewUpdateTournamentTeamCommand(service, &api.UpdateTournamentTeamRequest{
+		Tournament: &api.TournamentTeamRequest{
+			Id: conversion.ValueToPointer(uint64(1)),
+		},
+		IncrementScore: conversion.ValueToPointer(false),
+		Score:          conversion.ValueToPointer(int64(10)),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+}
+
+func TestUpdateTournamentTeamNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `tournament_team`").WithArgs(10, 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament_team`").WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{}))
+	mock.ExpectRollback()
+	c := NewUpdateTournamentTeamCommand(service, &api.UpdateTournamentTeamRequest{
+		Tournament: &api.TournamentTeamRequest{
+			Id: conversion.ValueToPointer(uint64(1)),
+		},
+		Score:          conversion.ValueToPointer(int64(10)),
+		IncrementScore: conversion.ValueToPointer(true),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != false {
+		t.Fatal("Expected success to be false")
+	}
+	if c.Out.Error != api.UpdateTournamentTeamResponse_NOT_FOUND {
+		t.Fatal("Expected error to be NOT_FOUND")
+	}
+}
+
+func TestUpdateTournamentTeamByTournamentIntervalTeamId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `tournament_team`").WithArgs(raw, "test", 1, "DAILY", time.Now().Truncate(24*time.Hour).UTC(), 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	c := NewUpdateTournamentTeamCommand(service, &api.UpdateTournamentTeamRequest{
+		Tournament: &api.TournamentTeamRequest{
+			TournamentIntervalTeamId: &api.TournamentIntervalTeamId{
+				Tournament: "test",
+				Interval:   api.TournamentInterval_DAILY,
+				TeamId:     1,
+			},
+		},
+		Data: data,
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+}
+
+func TestUpdateTournamentTeamMaxScoreAndData(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	data, err := conversion.MapToProtobufStruct(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := conversion.ProtobufStructToRawJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `tournament_team` SET `score`=GREATEST\\(score, \\?\\)").WithArgs(10, 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE `tournament_team` SET `data`=\\?").WithArgs(raw, 1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c := NewUpdateTournamentTeamCommand(service, &api.UpdateTournamentTeamRequest{
+		Tournament: &api.TournamentTeamRequest{
+			Id: conversion.ValueToPointer(uint64(1)),
+		},
+		Data:      data,
+		Score:     conversion.ValueToPointer(int64(10)),
+		ScoreMode: conversion.ValueToPointer(api.TournamentScoreMode_MAX),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.ScoreChanged != false {
+		t.Fatal("Expected score changed to be false")
+	}
+}
@@ -39,7 +39,8 @@ func (c *UpdateTournamentUserCommand) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
-	if c.In.Score != nil && c.In.IncrementScore == nil {
+	scoreMode, ok := convertScoreMode(c.In.ScoreMode, c.In.IncrementScore)
+	if c.In.Score != nil && !ok {
 		c.Out = &api.UpdateTournamentUserResponse{
 			Success: false,
 			Error:   api.UpdateTournamentUserResponse_INCREMENT_SCORE_NOT_SPECIFIED,
@@ -54,35 +55,55 @@ func (c *UpdateTournamentUserCommand) Execute(ctx context.Context) error {
 			return err
 		}
 	}
+	nameIntervalUserIDStartedAt, err := c.service.convertTournamentIntervalUserIdToNullNameIntervalUserIDStartedAt(ctx, c.In.Tournament.TournamentIntervalUserId)
+	if err != nil {
+		return err
+	}
+	tournamentUser := model.GetTournamentParams{
+		ID:                          conversion.Uint64ToSqlNullInt64(c.In.Tournament.Id),
+		NameIntervalUserIDStartedAt: nameIntervalUserIDStartedAt,
+	}
 	tx, err := c.service.sql.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 	qtx := c.service.database.WithTx(tx)
-	// Update the tournament user in the store
-	result, err := qtx.UpdateTournament(ctx, model.UpdateTournamentParams{
-		Tournament: model.GetTournamentParams{
-			ID:                          conversion.Uint64ToSqlNullInt64(c.In.Tournament.Id),
-			NameIntervalUserIDStartedAt: c.service.convertTournamentIntervalUserIdToNullNameIntervalUserIDStartedAt(c.In.Tournament.TournamentIntervalUserId),
-		},
-		Score:          conversion.Int64ToSqlNullInt64(c.In.Score),
-		IncrementScore: conversion.PointerBoolToValue(c.In.IncrementScore),
-		Data:           data,
-	})
-	if err != nil {
-		return err
+	scoreChanged := false
+	if c.In.Score != nil {
+		// The score is updated on its own so the affected rows show whether the stored score changed
+		result, err := qtx.UpdateTournament(ctx, model.UpdateTournamentParams{
+			Tournament: tournamentUser,
+			Score:      conversion.Int64ToSqlNullInt64(c.In.Score),
+			ScoreMode:  scoreMode,
+		})
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		scoreChanged = rowsAffected != 0
 	}
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return err
+	dataChanged := false
+	if c.In.Data != nil {
+		result, err := qtx.UpdateTournament(ctx, model.UpdateTournamentParams{
+			Tournament: tournamentUser,
+			Data:       data,
+		})
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		dataChanged = rowsAffected != 0
 	}
-	if rowsAffected == 0 {
+	if !scoreChanged && !dataChanged {
 		// Check if we didn't find a row
-		_, err = qtx.GetTournament(ctx, model.GetTournamentParams{
-			ID:                          conversion.Uint64ToSqlNullInt64(c.In.Tournament.Id),
-			NameIntervalUserIDStartedAt: c.service.convertTournamentIntervalUserIdToNullNameIntervalUserIDStartedAt(c.In.Tournament.TournamentIntervalUserId),
-		})
+		_, err = qtx.GetTournament(ctx, tournamentUser)
 		// Check if tournament user is found, if not return not found
 		if err != nil {
 			if err == sql.ErrNoRows {
@@ -100,8 +121,9 @@ func (c *UpdateTournamentUserCommand) Execute(ctx context.Context) error {
 		return err
 	}
 	c.Out = &api.UpdateTournamentUserResponse{
-		Success: true,
-		Error:   api.UpdateTournamentUserResponse_NONE,
+		Success:      true,
+		Error:        api.UpdateTournamentUserResponse_NONE,
+		ScoreChanged: scoreChanged,
 	}
 	return nil
 }
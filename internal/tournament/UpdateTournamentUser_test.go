@@ -2,6 +2,7 @@ package tournament
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -308,6 +309,7 @@ func TestUpdateTournamentUserByTournamentIntervalUserId(t *testing.T) {
 	queries := model.New(db)
 	service := NewService(
 		WithSql(db), WithDatabase(queries))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
 	mock.ExpectBegin()
 	mock.ExpectExec("UPDATE `tournament`").WithArgs(raw, "test", "DAILY", time.Now().Truncate(24*time.Hour).UTC(), 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
@@ -329,3 +331,71 @@ func TestUpdateTournamentUserByTournamentIntervalUserId(t *testing.T) {
 		t.Fatal("Expected success to be true")
 	}
 }
+
+func TestUpdateTournamentUserMaxScoreChanged(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `tournament` SET `score`=GREATEST\\(score, \\?\\)").WithArgs(10, 1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c := NewUpdateTournamentUserCommand(service, &api.UpdateTournamentUserRequest{
+		Tournament: &api.TournamentUserRequest{
+			Id: conversion.ValueToPointer(uint64(1)),
+		},
+		Score:     conversion.ValueToPointer(int64(10)),
+		ScoreMode: conversion.ValueToPointer(api.TournamentScoreMode_MAX),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.ScoreChanged != true {
+		t.Fatal("Expected score changed to be true")
+	}
+}
+
+func TestUpdateTournamentUserMinScoreUnchanged(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	raw, err := conversion.MapToRawJson(map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	queries := model.New(db)
+	service := NewService(
+		WithSql(db), WithDatabase(queries))
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `tournament` SET `score`=LEAST\\(score, \\?\\)").WithArgs(10, 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	rows := sqlmock.NewRows(rankedTournament).AddRow(1, "test", "DAILY", 1, nil, 5, 1, raw, time.Now(), time.Now(), time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM `ranked_tournament`").WithArgs(1, 1).WillReturnRows(rows)
+	mock.ExpectCommit()
+	c := NewUpdateTournamentUserCommand(service, &api.UpdateTournamentUserRequest{
+		Tournament: &api.TournamentUserRequest{
+			Id: conversion.ValueToPointer(uint64(1)),
+		},
+		Score:     conversion.ValueToPointer(int64(10)),
+		ScoreMode: conversion.ValueToPointer(api.TournamentScoreMode_MIN),
+	})
+	err = invoker.NewBasicInvoker().Invoke(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Out.Success != true {
+		t.Fatal("Expected success to be true")
+	}
+	if c.Out.ScoreChanged != false {
+		t.Fatal("Expected score changed to be false")
+	}
+}
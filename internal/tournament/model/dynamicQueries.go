@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/MorhafAlshibly/coanda/pkg/goquOptions"
 	"github.com/doug-martin/goqu/v9"
 	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
 	"github.com/doug-martin/goqu/v9/exp"
@@ -13,9 +14,55 @@ import (
 
 var gq = goqu.Dialect("mysql")
 
+// ScoreMode determines how a new score is combined with the stored score
+type ScoreMode int
+
+const (
+	ScoreModeSet ScoreMode = iota
+	ScoreModeIncrement
+	ScoreModeMax
+	ScoreModeMin
+)
+
+// String returns the name the score mode is matched by in queries
+func (m ScoreMode) String() string {
+	switch m {
+	case ScoreModeIncrement:
+		return "increment"
+	case ScoreModeMax:
+		return "max"
+	case ScoreModeMin:
+		return "min"
+	}
+	return "set"
+}
+
+// scoreUpdate returns the value the score is set to, so the score is combined in the same statement it is stored in
+func scoreUpdate(mode ScoreMode, score sql.NullInt64) interface{} {
+	switch mode {
+	case ScoreModeIncrement:
+		return goqu.L("score + ?", score)
+	case ScoreModeMax:
+		return goqu.L("GREATEST(score, ?)", score)
+	case ScoreModeMin:
+		return goqu.L("LEAST(score, ?)", score)
+	}
+	return score
+}
+
 type GetTournamentParams struct {
 	ID                          sql.NullInt64 `db:"id"`
 	NameIntervalUserIDStartedAt NullNameIntervalUserIDStartedAt
+	// Archived reads from the archive, where windows keep the ranking they ended with
+	Archived bool
+}
+
+// tournamentTables returns the table rows are read from with their ranking, and the table pages are picked from
+func tournamentTables(archived bool) (string, string) {
+	if archived {
+		return "archived_tournament", "archived_tournament"
+	}
+	return "ranked_tournament", "tournament"
 }
 
 type NullNameIntervalUserIDStartedAt struct {
@@ -42,7 +89,8 @@ func filterGetTournamentParams(arg GetTournamentParams) exp.Expression {
 }
 
 func (q *Queries) GetTournament(ctx context.Context, arg GetTournamentParams) (RankedTournament, error) {
-	tournament := gq.From("ranked_tournament").Prepared(true).Select("id", "name", "tournament_interval", "user_id", "score", "ranking", "data", "tournament_started_at", "sent_to_third_party_at", "created_at", "updated_at")
+	ranked, _ := tournamentTables(arg.Archived)
+	tournament := gq.From(ranked).Prepared(true).Select("id", "name", "tournament_interval", "user_id", "team_id", "score", "ranking", "data", "tournament_started_at", "sent_to_third_party_at", "created_at", "updated_at")
 	query, args, err := tournament.Where(filterGetTournamentParams(arg)).Limit(1).ToSQL()
 	if err != nil {
 		return RankedTournament{}, err
@@ -53,6 +101,7 @@ func (q *Queries) GetTournament(ctx context.Context, arg GetTournamentParams) (R
 		&i.Name,
 		&i.TournamentInterval,
 		&i.UserID,
+		&i.TeamID,
 		&i.Score,
 		&i.Ranking,
 		&i.Data,
@@ -64,6 +113,13 @@ func (q *Queries) GetTournament(ctx context.Context, arg GetTournamentParams) (R
 	return i, err
 }
 
+// Tournament lists are ordered by ranking, which matches the name, interval, and score index
+var tournamentSeekColumns = []goquOptions.SeekColumn{
+	{Name: "name"},
+	{Name: "score", Descending: true},
+	{Name: "id"},
+}
+
 type GetTournamentsParams struct {
 	Name                sql.NullString               `db:"name"`
 	UserID              sql.NullInt64                `db:"user_id"`
@@ -71,6 +127,8 @@ type GetTournamentsParams struct {
 	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
 	Limit               uint64                       `db:"limit"`
 	Offset              uint64                       `db:"offset"`
+	Seek                *goquOptions.Seek
+	Archived            bool
 }
 
 // filterGetTournamentsParams filters the GetTournamentsParams to exp.Expression
@@ -88,8 +146,12 @@ func filterGetTournamentsParams(arg GetTournamentsParams) exp.Expression {
 }
 
 func (q *Queries) GetTournaments(ctx context.Context, arg GetTournamentsParams) ([]RankedTournament, error) {
-	tournament := gq.From("ranked_tournament").Prepared(true).Select("id", "name", "tournament_interval", "user_id", "score", "ranking", "data", "tournament_started_at", "sent_to_third_party_at", "created_at", "updated_at")
-	query, args, err := tournament.Where(filterGetTournamentsParams(arg)).Limit(uint(arg.Limit)).Offset(uint(arg.Offset)).ToSQL()
+	ranked, base := tournamentTables(arg.Archived)
+	tournament := gq.From(ranked).Prepared(true).Select("id", "name", "tournament_interval", "user_id", "team_id", "score", "ranking", "data", "tournament_started_at", "sent_to_third_party_at", "created_at", "updated_at")
+	// The page is picked from the tournament table first, so the seek can use its index instead of the ranked view
+	page := gq.From(base).Select("id").Where(filterGetTournamentsParams(arg))
+	page = goquOptions.ApplySeek(arg.Seek, page, tournamentSeekColumns...).Limit(uint(arg.Limit)).Offset(uint(arg.Offset))
+	query, args, err := tournament.Where(goqu.C("id").In(gq.From(page))).Order(goquOptions.SeekOrder(arg.Seek, tournamentSeekColumns...)...).ToSQL()
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +168,7 @@ func (q *Queries) GetTournaments(ctx context.Context, arg GetTournamentsParams)
 			&i.Name,
 			&i.TournamentInterval,
 			&i.UserID,
+			&i.TeamID,
 			&i.Score,
 			&i.Ranking,
 			&i.Data,
@@ -131,10 +194,10 @@ func (q *Queries) DeleteTournament(ctx context.Context, arg GetTournamentParams)
 }
 
 type UpdateTournamentParams struct {
-	Tournament     GetTournamentParams
-	Data           json.RawMessage `db:"data"`
-	Score          sql.NullInt64   `db:"score"`
-	IncrementScore bool
+	Tournament GetTournamentParams
+	Data       json.RawMessage `db:"data"`
+	Score      sql.NullInt64   `db:"score"`
+	ScoreMode  ScoreMode
 }
 
 func (q *Queries) UpdateTournament(ctx context.Context, arg UpdateTournamentParams) (sql.Result, error) {
@@ -144,13 +207,370 @@ func (q *Queries) UpdateTournament(ctx context.Context, arg UpdateTournamentPara
 		updates["data"] = []byte(arg.Data)
 	}
 	if arg.Score.Valid {
-		if arg.IncrementScore {
-			updates["score"] = goqu.L("score + ?", arg.Score)
+		updates["score"] = scoreUpdate(arg.ScoreMode, arg.Score)
+	}
+	query, args, err := tournament.Where(filterGetTournamentParams(arg.Tournament)).Set(updates).Limit(1).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	return q.db.ExecContext(ctx, query, args...)
+}
+
+type GetTournamentTeamParams struct {
+	ID                          sql.NullInt64 `db:"id"`
+	NameIntervalTeamIDStartedAt NullNameIntervalTeamIDStartedAt
+	// Archived reads from the archive, where windows keep the score and ranking they ended with
+	Archived bool
+}
+
+// tournamentTeamTable returns the table team rows are read from with their ranking
+func tournamentTeamTable(archived bool) string {
+	if archived {
+		return "archived_tournament_team"
+	}
+	return "ranked_tournament_team"
+}
+
+type NullNameIntervalTeamIDStartedAt struct {
+	Name                string                           `db:"name"`
+	TournamentInterval  TournamentTeamTournamentInterval `db:"tournament_interval"`
+	TeamID              uint64                           `db:"team_id"`
+	TournamentStartedAt time.Time                        `db:"tournament_started_at"`
+	Valid               bool
+}
+
+// filterGetTournamentTeamParams filters the GetTournamentTeamParams to exp.Expression
+func filterGetTournamentTeamParams(arg GetTournamentTeamParams) exp.Expression {
+	expressions := goqu.Ex{}
+	if arg.ID.Valid {
+		expressions["id"] = arg.ID
+	}
+	if arg.NameIntervalTeamIDStartedAt.Valid {
+		expressions["name"] = arg.NameIntervalTeamIDStartedAt.Name
+		expressions["tournament_interval"] = arg.NameIntervalTeamIDStartedAt.TournamentInterval
+		expressions["team_id"] = arg.NameIntervalTeamIDStartedAt.TeamID
+		expressions["tournament_started_at"] = arg.NameIntervalTeamIDStartedAt.TournamentStartedAt
+	}
+	return expressions
+}
+
+func (q *Queries) GetTournamentTeam(ctx context.Context, arg GetTournamentTeamParams) (RankedTournamentTeam, error) {
+	tournamentTeam := gq.From(tournamentTeamTable(arg.Archived)).Prepared(true).Select("id", "name", "tournament_interval", "team_id", "score", "ranking", "data", "tournament_started_at", "sent_to_third_party_at", "created_at", "updated_at")
+	query, args, err := tournamentTeam.Where(filterGetTournamentTeamParams(arg)).Limit(1).ToSQL()
+	if err != nil {
+		return RankedTournamentTeam{}, err
+	}
+	var i RankedTournamentTeam
+	err = q.db.QueryRowContext(ctx, query, args...).Scan(
+		&i.ID,
+		&i.Name,
+		&i.TournamentInterval,
+		&i.TeamID,
+		&i.Score,
+		&i.Ranking,
+		&i.Data,
+		&i.TournamentStartedAt,
+		&i.SentToThirdPartyAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+type GetTournamentTeamsParams struct {
+	Name                sql.NullString                   `db:"name"`
+	TeamID              sql.NullInt64                    `db:"team_id"`
+	TournamentInterval  TournamentTeamTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                        `db:"tournament_started_at"`
+	Limit               uint64                           `db:"limit"`
+	Offset              uint64                           `db:"offset"`
+	Seek                *goquOptions.Seek
+	Archived            bool
+}
+
+// filterGetTournamentTeamsParams filters the GetTournamentTeamsParams to exp.Expression
+func filterGetTournamentTeamsParams(arg GetTournamentTeamsParams) exp.Expression {
+	expressions := goqu.Ex{}
+	if arg.Name.Valid {
+		expressions["name"] = arg.Name
+	}
+	if arg.TeamID.Valid {
+		expressions["team_id"] = arg.TeamID
+	}
+	expressions["tournament_interval"] = arg.TournamentInterval
+	expressions["tournament_started_at"] = arg.TournamentStartedAt
+	return expressions
+}
+
+func (q *Queries) GetTournamentTeams(ctx context.Context, arg GetTournamentTeamsParams) ([]RankedTournamentTeam, error) {
+	tournamentTeam := gq.From(tournamentTeamTable(arg.Archived)).Prepared(true).Select("id", "name", "tournament_interval", "team_id", "score", "ranking", "data", "tournament_started_at", "sent_to_third_party_at", "created_at", "updated_at")
+	tournamentTeam = goquOptions.ApplySeek(arg.Seek, tournamentTeam.Where(filterGetTournamentTeamsParams(arg)), tournamentSeekColumns...)
+	query, args, err := tournamentTeam.Limit(uint(arg.Limit)).Offset(uint(arg.Offset)).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RankedTournamentTeam
+	for rows.Next() {
+		var i RankedTournamentTeam
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.TournamentInterval,
+			&i.TeamID,
+			&i.Score,
+			&i.Ranking,
+			&i.Data,
+			&i.TournamentStartedAt,
+			&i.SentToThirdPartyAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	return items, nil
+}
+
+func (q *Queries) DeleteTournamentTeam(ctx context.Context, arg GetTournamentTeamParams) (sql.Result, error) {
+	tournamentTeam := gq.Delete("tournament_team").Prepared(true)
+	query, args, err := tournamentTeam.Where(filterGetTournamentTeamParams(arg)).Limit(1).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	return q.db.ExecContext(ctx, query, args...)
+}
+
+type UpdateTournamentTeamParams struct {
+	TournamentTeam GetTournamentTeamParams
+	Data           json.RawMessage `db:"data"`
+	Score          sql.NullInt64   `db:"score"`
+	ScoreMode      ScoreMode
+}
+
+func (q *Queries) UpdateTournamentTeam(ctx context.Context, arg UpdateTournamentTeamParams) (sql.Result, error) {
+	tournamentTeam := gq.Update("tournament_team").Prepared(true)
+	updates := goqu.Record{}
+	if arg.Data != nil {
+		updates["data"] = []byte(arg.Data)
+	}
+	if arg.Score.Valid {
+		updates["score"] = scoreUpdate(arg.ScoreMode, arg.Score)
+	}
+	query, args, err := tournamentTeam.Where(filterGetTournamentTeamParams(arg.TournamentTeam)).Set(updates).Limit(1).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	return q.db.ExecContext(ctx, query, args...)
+}
+
+type GetTournamentRewardsParams struct {
+	UserID  uint64       `db:"user_id"`
+	Claimed sql.NullBool `db:"claimed"`
+	Limit   uint64       `db:"limit"`
+	Offset  uint64       `db:"offset"`
+}
+
+// filterGetTournamentRewardsParams filters the GetTournamentRewardsParams to exp.Expression
+func filterGetTournamentRewardsParams(arg GetTournamentRewardsParams) exp.Expression {
+	expressions := []goqu.Expression{goqu.C("user_id").Eq(arg.UserID)}
+	if arg.Claimed.Valid {
+		if arg.Claimed.Bool {
+			expressions = append(expressions, goqu.C("claimed_at").IsNotNull())
 		} else {
-			updates["score"] = arg.Score
+			expressions = append(expressions, goqu.C("claimed_at").IsNull())
 		}
 	}
-	query, args, err := tournament.Where(filterGetTournamentParams(arg.Tournament)).Set(updates).Limit(1).ToSQL()
+	return goqu.And(expressions...)
+}
+
+func (q *Queries) GetTournamentRewards(ctx context.Context, arg GetTournamentRewardsParams) ([]TournamentReward, error) {
+	reward := gq.From("tournament_reward").Prepared(true).Select("id", "tier_id", "name", "tournament_interval", "tournament_started_at", "user_id", "ranking", "score", "data", "claimed_at", "created_at")
+	query, args, err := reward.Where(filterGetTournamentRewardsParams(arg)).Order(goqu.C("created_at").Desc(), goqu.C("id").Desc()).Limit(uint(arg.Limit)).Offset(uint(arg.Offset)).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TournamentReward
+	for rows.Next() {
+		var i TournamentReward
+		if err := rows.Scan(
+			&i.ID,
+			&i.TierID,
+			&i.Name,
+			&i.TournamentInterval,
+			&i.TournamentStartedAt,
+			&i.UserID,
+			&i.Ranking,
+			&i.Score,
+			&i.Data,
+			&i.ClaimedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	return items, nil
+}
+
+// TournamentIntervalStartedAt is the start of the current window of an interval.
+// A name limits the start to that tournament, otherwise it applies to every tournament that has no start of its own.
+type TournamentIntervalStartedAt struct {
+	Name                string
+	TournamentInterval  TournamentTournamentInterval
+	TournamentStartedAt time.Time
+}
+
+type GetTournamentUserHistoryParams struct {
+	UserID             uint64                           `db:"user_id"`
+	Name               sql.NullString                   `db:"name"`
+	TournamentInterval NullTournamentTournamentInterval `db:"tournament_interval"`
+	// CurrentStartedAt keeps the current windows out of the history, rows of an interval that is not listed are never returned unless archived
+	CurrentStartedAt []TournamentIntervalStartedAt
+	Limit            uint64 `db:"limit"`
+	Offset           uint64 `db:"offset"`
+}
+
+// filterGetTournamentUserHistoryParams filters the GetTournamentUserHistoryParams of the given table to exp.Expression
+func filterGetTournamentUserHistoryParams(table exp.IdentifierExpression, arg GetTournamentUserHistoryParams) exp.Expression {
+	expressions := []goqu.Expression{table.Col("user_id").Eq(arg.UserID)}
+	if arg.Name.Valid {
+		expressions = append(expressions, table.Col("name").Eq(arg.Name.String))
+	}
+	if arg.TournamentInterval.Valid {
+		expressions = append(expressions, table.Col("tournament_interval").Eq(arg.TournamentInterval.TournamentTournamentInterval))
+	}
+	return goqu.And(expressions...)
+}
+
+// filterEndedTournamentWindows filters the rows of the given table to windows that started before the current window of their tournament and interval
+func filterEndedTournamentWindows(table exp.IdentifierExpression, currentStartedAt []TournamentIntervalStartedAt) exp.Expression {
+	if len(currentStartedAt) == 0 {
+		return goqu.L("FALSE")
+	}
+	names := []string{}
+	seen := map[string]bool{}
+	for _, current := range currentStartedAt {
+		if current.Name != "" && !seen[current.Name] {
+			seen[current.Name] = true
+			names = append(names, current.Name)
+		}
+	}
+	expressions := make([]goqu.Expression, len(currentStartedAt))
+	for i, current := range currentStartedAt {
+		window := []goqu.Expression{
+			table.Col("tournament_interval").Eq(current.TournamentInterval),
+			table.Col("tournament_started_at").Lt(current.TournamentStartedAt),
+		}
+		if current.Name != "" {
+			window = append(window, table.Col("name").Eq(current.Name))
+		} else if len(names) > 0 {
+			// Tournaments with their own start are compared against it instead
+			window = append(window, table.Col("name").NotIn(names))
+		}
+		expressions[i] = goqu.And(window...)
+	}
+	return goqu.Or(expressions...)
+}
+
+// GetTournamentUserHistory returns every ended window the user has taken part in, from the most recent, including windows that have been archived.
+// The rows are found by user rather than through the ranked_tournament view, as the view ranks every window before it can be filtered by user.
+func (q *Queries) GetTournamentUserHistory(ctx context.Context, arg GetTournamentUserHistoryParams) ([]RankedTournament, error) {
+	t, o, a := goqu.T("t"), goqu.T("o"), goqu.T("a")
+	// Counting the distinct higher scores in the window matches the dense ranking of the view
+	ranking := gq.From(goqu.T("tournament").As("o")).Select(goqu.L("COUNT(DISTINCT ?)", o.Col("score"))).Where(
+		o.Col("name").Eq(t.Col("name")),
+		o.Col("tournament_interval").Eq(t.Col("tournament_interval")),
+		o.Col("tournament_started_at").Eq(t.Col("tournament_started_at")),
+		o.Col("score").Gt(t.Col("score")),
+	)
+	tournament := gq.From(goqu.T("tournament").As("t")).Select(
+		t.Col("id"),
+		t.Col("name"),
+		t.Col("tournament_interval"),
+		t.Col("user_id"),
+		t.Col("team_id"),
+		t.Col("score"),
+		goqu.L("(?) + 1", ranking).As("ranking"),
+		t.Col("data"),
+		t.Col("tournament_started_at"),
+		t.Col("sent_to_third_party_at"),
+		t.Col("created_at"),
+		t.Col("updated_at"),
+	).Where(filterGetTournamentUserHistoryParams(t, arg), filterEndedTournamentWindows(t, arg.CurrentStartedAt))
+	// Archived windows keep the ranking they ended with, and have always ended
+	archived := gq.From(goqu.T("archived_tournament").As("a")).Select(
+		a.Col("id"),
+		a.Col("name"),
+		a.Col("tournament_interval"),
+		a.Col("user_id"),
+		a.Col("team_id"),
+		a.Col("score"),
+		a.Col("ranking"),
+		a.Col("data"),
+		a.Col("tournament_started_at"),
+		a.Col("sent_to_third_party_at"),
+		a.Col("created_at"),
+		a.Col("updated_at"),
+	).Where(filterGetTournamentUserHistoryParams(a, arg))
+	history := gq.From(tournament.UnionAll(archived).As("h")).Prepared(true).Select("id", "name", "tournament_interval", "user_id", "team_id", "score", "ranking", "data", "tournament_started_at", "sent_to_third_party_at", "created_at", "updated_at")
+	query, args, err := history.Order(goqu.C("tournament_started_at").Desc(), goqu.C("id").Desc()).Limit(uint(arg.Limit)).Offset(uint(arg.Offset)).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RankedTournament
+	for rows.Next() {
+		var i RankedTournament
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.TournamentInterval,
+			&i.UserID,
+			&i.TeamID,
+			&i.Score,
+			&i.Ranking,
+			&i.Data,
+			&i.TournamentStartedAt,
+			&i.SentToThirdPartyAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	return items, nil
+}
+
+type SetTournamentBracketMatchUserParams struct {
+	ID     uint64 `db:"id"`
+	Slot   uint8
+	UserID uint64 `db:"user_id"`
+}
+
+// SetTournamentBracketMatchUser places the user in the first or second slot of the match
+func (q *Queries) SetTournamentBracketMatchUser(ctx context.Context, arg SetTournamentBracketMatchUserParams) (sql.Result, error) {
+	column := "first_user_id"
+	if arg.Slot == 1 {
+		column = "second_user_id"
+	}
+	match := gq.Update("tournament_bracket_match").Prepared(true)
+	query, args, err := match.Where(goqu.Ex{"id": arg.ID}).Set(goqu.Record{column: arg.UserID}).Limit(1).ToSQL()
 	if err != nil {
 		return nil, err
 	}
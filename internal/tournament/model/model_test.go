@@ -15,7 +15,7 @@ import (
 var server *mysqlTestServer.Server
 
 func TestMain(m *testing.M) {
-	server = mysqlTestServer.NewServer("../../../migration/tournament.sql")
+	server = mysqlTestServer.NewServer("../../../migration/tournament.sql", "../../../migration/matchmaking.sql")
 	defer server.Close()
 	m.Run()
 }
@@ -630,8 +630,8 @@ func Test_UpdateTournament_UpdateScoreById_TournamentUpdated(t *testing.T) {
 		Tournament: GetTournamentParams{
 			ID: sql.NullInt64{Int64: id, Valid: true},
 		},
-		Score:          sql.NullInt64{Int64: 2, Valid: true},
-		IncrementScore: false,
+		Score:     sql.NullInt64{Int64: 2, Valid: true},
+		ScoreMode: ScoreModeSet,
 	})
 	if err != nil {
 		t.Fatalf("could not update tournament: %v", err)
@@ -676,8 +676,8 @@ func Test_UpdateTournament_IncrementScoreById_TournamentUpdated(t *testing.T) {
 		Tournament: GetTournamentParams{
 			ID: sql.NullInt64{Int64: id, Valid: true},
 		},
-		Score:          sql.NullInt64{Int64: 2, Valid: true},
-		IncrementScore: true,
+		Score:     sql.NullInt64{Int64: 2, Valid: true},
+		ScoreMode: ScoreModeIncrement,
 	})
 	if err != nil {
 		t.Fatalf("could not update tournament: %v", err)
@@ -700,6 +700,88 @@ func Test_UpdateTournament_IncrementScoreById_TournamentUpdated(t *testing.T) {
 	}
 }
 
+func Test_UpdateTournament_MaxScoreById_ScoreNotLowered(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	result, err := q.CreateTournament(context.Background(), CreateTournamentParams{
+		Name:                "test18",
+		TournamentInterval:  TournamentTournamentIntervalWeekly,
+		UserID:              18,
+		Score:               5,
+		Data:                json.RawMessage(`{"key": "value"}`),
+		TournamentStartedAt: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("could not create tournament: %v", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("could not get last insert id: %v", err)
+	}
+	result, err = q.UpdateTournament(context.Background(), UpdateTournamentParams{
+		Tournament: GetTournamentParams{
+			ID: sql.NullInt64{Int64: id, Valid: true},
+		},
+		Score:     sql.NullInt64{Int64: 2, Valid: true},
+		ScoreMode: ScoreModeMax,
+	})
+	if err != nil {
+		t.Fatalf("could not update tournament: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		t.Fatalf("could not get rows affected: %v", err)
+	}
+	if rowsAffected != 0 {
+		t.Fatalf("expected 0 rows affected, got %d", rowsAffected)
+	}
+	tournament, err := q.GetTournament(context.Background(), GetTournamentParams{
+		ID: sql.NullInt64{Int64: id, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("could not get tournament: %v", err)
+	}
+	if tournament.Score != 5 {
+		t.Fatalf("expected tournament score 5, got %d", tournament.Score)
+	}
+}
+
+func Test_SubmitTournamentScore_TwiceWithIncrement_ScoreAdded(t *testing.T) {
+	tx := server.Connect(t)
+	q := New(tx)
+	startedAt := time.Now().Truncate(time.Second)
+	for i := 0; i < 2; i++ {
+		_, err := q.SubmitTournamentScore(context.Background(), SubmitTournamentScoreParams{
+			Name:                "test21",
+			TournamentInterval:  TournamentTournamentIntervalDaily,
+			UserID:              21,
+			Score:               4,
+			Data:                json.RawMessage(`{"key": "value"}`),
+			TournamentStartedAt: startedAt,
+			ScoreMode:           ScoreModeIncrement.String(),
+			UpdateData:          false,
+		})
+		if err != nil {
+			t.Fatalf("could not submit tournament score: %v", err)
+		}
+	}
+	tournament, err := q.GetTournament(context.Background(), GetTournamentParams{
+		NameIntervalUserIDStartedAt: NullNameIntervalUserIDStartedAt{
+			Name:                "test21",
+			TournamentInterval:  TournamentTournamentIntervalDaily,
+			UserID:              21,
+			TournamentStartedAt: startedAt,
+			Valid:               true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("could not get tournament: %v", err)
+	}
+	if tournament.Score != 8 {
+		t.Fatalf("expected tournament score 8, got %d", tournament.Score)
+	}
+}
+
 func Test_UpdateTournament_ById_TournamentDoesNotExist_Error(t *testing.T) {
 	tx := server.Connect(t)
 	q := New(tx)
@@ -3,8 +3,369 @@ INSERT INTO tournament (
         name,
         tournament_interval,
         user_id,
+        team_id,
         score,
         data,
         tournament_started_at
     )
-VALUES (?, ?, ?, ?, ?, ?);
\ No newline at end of file
+VALUES (?, ?, ?, ?, ?, ?, ?);
+-- name: SubmitTournamentScore :execresult
+INSERT INTO tournament (
+        name,
+        tournament_interval,
+        user_id,
+        team_id,
+        score,
+        data,
+        tournament_started_at
+    )
+VALUES (
+        sqlc.arg(name),
+        sqlc.arg(tournament_interval),
+        sqlc.arg(user_id),
+        sqlc.narg(team_id),
+        sqlc.arg(score),
+        sqlc.arg(data),
+        sqlc.arg(tournament_started_at)
+    ) ON DUPLICATE KEY
+UPDATE team_id = COALESCE(VALUES(team_id), team_id),
+    score = CASE
+        WHEN sqlc.arg(score_mode) = 'increment' THEN score + VALUES(score)
+        WHEN sqlc.arg(score_mode) = 'max' THEN GREATEST(score, VALUES(score))
+        WHEN sqlc.arg(score_mode) = 'min' THEN LEAST(score, VALUES(score))
+        ELSE VALUES(score)
+    END,
+    data = CASE
+        WHEN sqlc.arg(update_data) = TRUE THEN VALUES(data)
+        ELSE data
+    END;
+-- name: CreateTournamentTeam :execresult
+INSERT INTO tournament_team (
+        name,
+        tournament_interval,
+        team_id,
+        score,
+        data,
+        tournament_started_at
+    )
+VALUES (?, ?, ?, ?, ?, ?);
+-- name: CreateTournamentDefinition :execresult
+INSERT INTO tournament_definition (
+        name,
+        cron_schedule,
+        duration_seconds,
+        starts_at,
+        ends_at,
+        time_zone,
+        data
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?);
+-- name: GetTournamentDefinition :one
+SELECT id,
+    name,
+    cron_schedule,
+    duration_seconds,
+    starts_at,
+    ends_at,
+    time_zone,
+    data,
+    created_at,
+    updated_at
+FROM tournament_definition
+WHERE name = ?
+LIMIT 1;
+-- name: DeleteTournamentDefinition :execresult
+DELETE FROM tournament_definition
+WHERE name = ?
+LIMIT 1;
+-- name: SetTournamentWipeTime :execresult
+INSERT INTO tournament_wipe_time (
+        name,
+        daily_tournament_minute,
+        weekly_tournament_minute,
+        weekly_tournament_day,
+        monthly_tournament_minute,
+        monthly_tournament_day,
+        time_zone
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY
+UPDATE daily_tournament_minute = VALUES(daily_tournament_minute),
+    weekly_tournament_minute = VALUES(weekly_tournament_minute),
+    weekly_tournament_day = VALUES(weekly_tournament_day),
+    monthly_tournament_minute = VALUES(monthly_tournament_minute),
+    monthly_tournament_day = VALUES(monthly_tournament_day),
+    time_zone = VALUES(time_zone);
+-- name: GetTournamentWipeTime :one
+SELECT id,
+    name,
+    daily_tournament_minute,
+    weekly_tournament_minute,
+    weekly_tournament_day,
+    monthly_tournament_minute,
+    monthly_tournament_day,
+    time_zone,
+    created_at,
+    updated_at
+FROM tournament_wipe_time
+WHERE name = ?
+LIMIT 1;
+-- name: GetTournamentWipeTimes :many
+SELECT id,
+    name,
+    daily_tournament_minute,
+    weekly_tournament_minute,
+    weekly_tournament_day,
+    monthly_tournament_minute,
+    monthly_tournament_day,
+    time_zone,
+    created_at,
+    updated_at
+FROM tournament_wipe_time
+ORDER BY name ASC;
+-- name: DeleteTournamentWipeTime :execresult
+DELETE FROM tournament_wipe_time
+WHERE name = ?
+LIMIT 1;
+-- name: CreateTournamentRewardTier :execresult
+INSERT INTO tournament_reward_tier (
+        name,
+        tournament_interval,
+        min_ranking,
+        max_ranking,
+        top_percentage,
+        data
+    )
+VALUES (?, ?, ?, ?, ?, ?);
+-- name: GetTournamentRewardTiers :many
+SELECT id,
+    name,
+    tournament_interval,
+    min_ranking,
+    max_ranking,
+    top_percentage,
+    data,
+    created_at,
+    updated_at
+FROM tournament_reward_tier
+WHERE name = ?
+    AND tournament_interval = ?
+ORDER BY id ASC;
+-- name: DeleteTournamentRewardTier :execresult
+DELETE FROM tournament_reward_tier
+WHERE id = ?
+LIMIT 1;
+-- name: GetTournamentReward :one
+SELECT id,
+    tier_id,
+    name,
+    tournament_interval,
+    tournament_started_at,
+    user_id,
+    ranking,
+    score,
+    data,
+    claimed_at,
+    created_at
+FROM tournament_reward
+WHERE id = ?
+    AND user_id = ?
+LIMIT 1;
+-- name: ClaimTournamentReward :execresult
+UPDATE tournament_reward
+SET claimed_at = NOW()
+WHERE id = ?
+    AND user_id = ?
+    AND claimed_at IS NULL
+LIMIT 1;
+-- name: CreateTournamentBracket :execresult
+INSERT INTO tournament_bracket (name, format, matchmaking_arena_id, data)
+VALUES (?, ?, ?, ?);
+-- name: GetTournamentBracket :one
+SELECT id,
+    name,
+    format,
+    matchmaking_arena_id,
+    winner_user_id,
+    data,
+    ended_at,
+    created_at,
+    updated_at
+FROM tournament_bracket
+WHERE id = ?
+LIMIT 1;
+-- name: EndTournamentBracket :execresult
+UPDATE tournament_bracket
+SET winner_user_id = ?,
+    ended_at = NOW()
+WHERE id = ?
+LIMIT 1;
+-- name: DeleteTournamentBracket :execresult
+DELETE FROM tournament_bracket
+WHERE id = ?
+LIMIT 1;
+-- name: CreateTournamentBracketParticipant :execresult
+INSERT INTO tournament_bracket_participant (tournament_bracket_id, user_id, seed, seed_value)
+VALUES (?, ?, ?, ?);
+-- name: GetTournamentBracketParticipants :many
+SELECT tournament_bracket_id,
+    user_id,
+    seed,
+    seed_value
+FROM tournament_bracket_participant
+WHERE tournament_bracket_id = ?
+ORDER BY seed ASC;
+-- name: CreateTournamentBracketMatch :execresult
+INSERT INTO tournament_bracket_match (
+        tournament_bracket_id,
+        side,
+        round,
+        position,
+        first_user_id,
+        second_user_id,
+        winner_match_id,
+        winner_match_slot,
+        loser_match_id,
+        loser_match_slot
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+-- name: GetTournamentBracketMatch :one
+SELECT id,
+    tournament_bracket_id,
+    side,
+    round,
+    position,
+    first_user_id,
+    second_user_id,
+    winner_user_id,
+    winner_match_id,
+    winner_match_slot,
+    loser_match_id,
+    loser_match_slot,
+    matchmaking_match_id,
+    completed_at,
+    created_at,
+    updated_at
+FROM tournament_bracket_match
+WHERE id = ?
+LIMIT 1 FOR
+UPDATE;
+-- name: GetTournamentBracketMatches :many
+SELECT id,
+    tournament_bracket_id,
+    side,
+    round,
+    position,
+    first_user_id,
+    second_user_id,
+    winner_user_id,
+    winner_match_id,
+    winner_match_slot,
+    loser_match_id,
+    loser_match_slot,
+    matchmaking_match_id,
+    completed_at,
+    created_at,
+    updated_at
+FROM tournament_bracket_match
+WHERE tournament_bracket_id = ?
+ORDER BY side ASC,
+    round ASC,
+    position ASC;
+-- name: CompleteTournamentBracketMatch :execresult
+UPDATE tournament_bracket_match
+SET winner_user_id = ?,
+    completed_at = NOW()
+WHERE id = ?
+    AND completed_at IS NULL
+LIMIT 1;
+-- name: SetTournamentBracketMatchMatchmakingMatch :execresult
+UPDATE tournament_bracket_match
+SET matchmaking_match_id = ?
+WHERE id = ?
+    AND matchmaking_match_id IS NULL
+LIMIT 1;
+-- name: GetTournamentScores :many
+SELECT user_id,
+    score
+FROM tournament
+WHERE name = sqlc.arg(name)
+    AND tournament_interval = sqlc.arg(tournament_interval)
+    AND tournament_started_at = sqlc.arg(tournament_started_at)
+    AND user_id IN (sqlc.slice(user_ids));
+-- name: GetMatchmakingUserElos :many
+-- Elos are only read from the matchmaking tables, matches are created through the matchmaking service
+SELECT client_user_id,
+    elo
+FROM matchmaking_user
+WHERE client_user_id IN (sqlc.slice(client_user_ids));
+-- name: GetTournamentUserIds :many
+SELECT user_id
+FROM tournament
+WHERE name = ?
+    AND tournament_interval = ?
+    AND tournament_started_at = ?
+ORDER BY score DESC,
+    id ASC;
+-- name: CreateTournamentPairing :execresult
+INSERT INTO tournament_pairing (
+        name,
+        tournament_interval,
+        tournament_started_at,
+        format,
+        round,
+        position,
+        first_user_id,
+        second_user_id
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+-- name: GetTournamentPairings :many
+SELECT id,
+    name,
+    tournament_interval,
+    tournament_started_at,
+    format,
+    round,
+    position,
+    first_user_id,
+    second_user_id,
+    result,
+    completed_at,
+    created_at,
+    updated_at
+FROM tournament_pairing
+WHERE name = ?
+    AND tournament_interval = ?
+    AND tournament_started_at = ?
+ORDER BY round ASC,
+    position ASC;
+-- name: GetTournamentPairing :one
+SELECT id,
+    name,
+    tournament_interval,
+    tournament_started_at,
+    format,
+    round,
+    position,
+    first_user_id,
+    second_user_id,
+    result,
+    completed_at,
+    created_at,
+    updated_at
+FROM tournament_pairing
+WHERE id = ?
+LIMIT 1;
+-- name: RecordTournamentPairingResult :execresult
+UPDATE tournament_pairing
+SET result = ?,
+    completed_at = NOW()
+WHERE id = ?
+    AND completed_at IS NULL
+LIMIT 1;
+-- name: GetTeamIdByUserId :one
+SELECT tm.team_id
+FROM team_member tm
+    JOIN team t ON tm.team_id = t.id
+WHERE tm.user_id = ?
+    AND t.deleted_at IS NULL
+LIMIT 1;
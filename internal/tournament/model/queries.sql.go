@@ -9,25 +9,64 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"strings"
 	"time"
 )
 
+const ClaimTournamentReward = `-- name: ClaimTournamentReward :execresult
+UPDATE tournament_reward
+SET claimed_at = NOW()
+WHERE id = ?
+    AND user_id = ?
+    AND claimed_at IS NULL
+LIMIT 1
+`
+
+type ClaimTournamentRewardParams struct {
+	ID     uint64 `db:"id"`
+	UserID uint64 `db:"user_id"`
+}
+
+func (q *Queries) ClaimTournamentReward(ctx context.Context, arg ClaimTournamentRewardParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, ClaimTournamentReward, arg.ID, arg.UserID)
+}
+
+const CompleteTournamentBracketMatch = `-- name: CompleteTournamentBracketMatch :execresult
+UPDATE tournament_bracket_match
+SET winner_user_id = ?,
+    completed_at = NOW()
+WHERE id = ?
+    AND completed_at IS NULL
+LIMIT 1
+`
+
+type CompleteTournamentBracketMatchParams struct {
+	WinnerUserID sql.NullInt64 `db:"winner_user_id"`
+	ID           uint64        `db:"id"`
+}
+
+func (q *Queries) CompleteTournamentBracketMatch(ctx context.Context, arg CompleteTournamentBracketMatchParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CompleteTournamentBracketMatch, arg.WinnerUserID, arg.ID)
+}
+
 const CreateTournament = `-- name: CreateTournament :execresult
 INSERT INTO tournament (
         name,
         tournament_interval,
         user_id,
+        team_id,
         score,
         data,
         tournament_started_at
     )
-VALUES (?, ?, ?, ?, ?, ?)
+VALUES (?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateTournamentParams struct {
 	Name                string                       `db:"name"`
 	TournamentInterval  TournamentTournamentInterval `db:"tournament_interval"`
 	UserID              uint64                       `db:"user_id"`
+	TeamID              sql.NullInt64                `db:"team_id"`
 	Score               int64                        `db:"score"`
 	Data                json.RawMessage              `db:"data"`
 	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
@@ -38,8 +77,1083 @@ func (q *Queries) CreateTournament(ctx context.Context, arg CreateTournamentPara
 		arg.Name,
 		arg.TournamentInterval,
 		arg.UserID,
+		arg.TeamID,
+		arg.Score,
+		arg.Data,
+		arg.TournamentStartedAt,
+	)
+}
+
+const CreateTournamentBracket = `-- name: CreateTournamentBracket :execresult
+INSERT INTO tournament_bracket (name, format, matchmaking_arena_id, data)
+VALUES (?, ?, ?, ?)
+`
+
+type CreateTournamentBracketParams struct {
+	Name               string                  `db:"name"`
+	Format             TournamentBracketFormat `db:"format"`
+	MatchmakingArenaID sql.NullInt64           `db:"matchmaking_arena_id"`
+	Data               json.RawMessage         `db:"data"`
+}
+
+func (q *Queries) CreateTournamentBracket(ctx context.Context, arg CreateTournamentBracketParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateTournamentBracket,
+		arg.Name,
+		arg.Format,
+		arg.MatchmakingArenaID,
+		arg.Data,
+	)
+}
+
+const CreateTournamentBracketMatch = `-- name: CreateTournamentBracketMatch :execresult
+INSERT INTO tournament_bracket_match (
+        tournament_bracket_id,
+        side,
+        round,
+        position,
+        first_user_id,
+        second_user_id,
+        winner_match_id,
+        winner_match_slot,
+        loser_match_id,
+        loser_match_slot
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateTournamentBracketMatchParams struct {
+	TournamentBracketID uint64                     `db:"tournament_bracket_id"`
+	Side                TournamentBracketMatchSide `db:"side"`
+	Round               uint32                     `db:"round"`
+	Position            uint32                     `db:"position"`
+	FirstUserID         sql.NullInt64              `db:"first_user_id"`
+	SecondUserID        sql.NullInt64              `db:"second_user_id"`
+	WinnerMatchID       sql.NullInt64              `db:"winner_match_id"`
+	WinnerMatchSlot     sql.NullInt32              `db:"winner_match_slot"`
+	LoserMatchID        sql.NullInt64              `db:"loser_match_id"`
+	LoserMatchSlot      sql.NullInt32              `db:"loser_match_slot"`
+}
+
+func (q *Queries) CreateTournamentBracketMatch(ctx context.Context, arg CreateTournamentBracketMatchParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateTournamentBracketMatch,
+		arg.TournamentBracketID,
+		arg.Side,
+		arg.Round,
+		arg.Position,
+		arg.FirstUserID,
+		arg.SecondUserID,
+		arg.WinnerMatchID,
+		arg.WinnerMatchSlot,
+		arg.LoserMatchID,
+		arg.LoserMatchSlot,
+	)
+}
+
+const CreateTournamentBracketParticipant = `-- name: CreateTournamentBracketParticipant :execresult
+INSERT INTO tournament_bracket_participant (tournament_bracket_id, user_id, seed, seed_value)
+VALUES (?, ?, ?, ?)
+`
+
+type CreateTournamentBracketParticipantParams struct {
+	TournamentBracketID uint64        `db:"tournament_bracket_id"`
+	UserID              uint64        `db:"user_id"`
+	Seed                uint32        `db:"seed"`
+	SeedValue           sql.NullInt64 `db:"seed_value"`
+}
+
+func (q *Queries) CreateTournamentBracketParticipant(ctx context.Context, arg CreateTournamentBracketParticipantParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateTournamentBracketParticipant,
+		arg.TournamentBracketID,
+		arg.UserID,
+		arg.Seed,
+		arg.SeedValue,
+	)
+}
+
+const CreateTournamentDefinition = `-- name: CreateTournamentDefinition :execresult
+INSERT INTO tournament_definition (
+        name,
+        cron_schedule,
+        duration_seconds,
+        starts_at,
+        ends_at,
+        time_zone,
+        data
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateTournamentDefinitionParams struct {
+	Name            string          `db:"name"`
+	CronSchedule    sql.NullString  `db:"cron_schedule"`
+	DurationSeconds sql.NullInt64   `db:"duration_seconds"`
+	StartsAt        time.Time       `db:"starts_at"`
+	EndsAt          sql.NullTime    `db:"ends_at"`
+	TimeZone        string          `db:"time_zone"`
+	Data            json.RawMessage `db:"data"`
+}
+
+func (q *Queries) CreateTournamentDefinition(ctx context.Context, arg CreateTournamentDefinitionParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateTournamentDefinition,
+		arg.Name,
+		arg.CronSchedule,
+		arg.DurationSeconds,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.TimeZone,
+		arg.Data,
+	)
+}
+
+const CreateTournamentPairing = `-- name: CreateTournamentPairing :execresult
+INSERT INTO tournament_pairing (
+        name,
+        tournament_interval,
+        tournament_started_at,
+        format,
+        round,
+        position,
+        first_user_id,
+        second_user_id
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateTournamentPairingParams struct {
+	Name                string                              `db:"name"`
+	TournamentInterval  TournamentPairingTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                           `db:"tournament_started_at"`
+	Format              TournamentPairingFormat             `db:"format"`
+	Round               uint32                              `db:"round"`
+	Position            uint32                              `db:"position"`
+	FirstUserID         uint64                              `db:"first_user_id"`
+	SecondUserID        sql.NullInt64                       `db:"second_user_id"`
+}
+
+func (q *Queries) CreateTournamentPairing(ctx context.Context, arg CreateTournamentPairingParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateTournamentPairing,
+		arg.Name,
+		arg.TournamentInterval,
+		arg.TournamentStartedAt,
+		arg.Format,
+		arg.Round,
+		arg.Position,
+		arg.FirstUserID,
+		arg.SecondUserID,
+	)
+}
+
+const CreateTournamentRewardTier = `-- name: CreateTournamentRewardTier :execresult
+INSERT INTO tournament_reward_tier (
+        name,
+        tournament_interval,
+        min_ranking,
+        max_ranking,
+        top_percentage,
+        data
+    )
+VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type CreateTournamentRewardTierParams struct {
+	Name               string                                 `db:"name"`
+	TournamentInterval TournamentRewardTierTournamentInterval `db:"tournament_interval"`
+	MinRanking         sql.NullInt64                          `db:"min_ranking"`
+	MaxRanking         sql.NullInt64                          `db:"max_ranking"`
+	TopPercentage      sql.NullFloat64                        `db:"top_percentage"`
+	Data               json.RawMessage                        `db:"data"`
+}
+
+func (q *Queries) CreateTournamentRewardTier(ctx context.Context, arg CreateTournamentRewardTierParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateTournamentRewardTier,
+		arg.Name,
+		arg.TournamentInterval,
+		arg.MinRanking,
+		arg.MaxRanking,
+		arg.TopPercentage,
+		arg.Data,
+	)
+}
+
+const CreateTournamentTeam = `-- name: CreateTournamentTeam :execresult
+INSERT INTO tournament_team (
+        name,
+        tournament_interval,
+        team_id,
+        score,
+        data,
+        tournament_started_at
+    )
+VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type CreateTournamentTeamParams struct {
+	Name                string                           `db:"name"`
+	TournamentInterval  TournamentTeamTournamentInterval `db:"tournament_interval"`
+	TeamID              uint64                           `db:"team_id"`
+	Score               int64                            `db:"score"`
+	Data                json.RawMessage                  `db:"data"`
+	TournamentStartedAt time.Time                        `db:"tournament_started_at"`
+}
+
+func (q *Queries) CreateTournamentTeam(ctx context.Context, arg CreateTournamentTeamParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, CreateTournamentTeam,
+		arg.Name,
+		arg.TournamentInterval,
+		arg.TeamID,
+		arg.Score,
+		arg.Data,
+		arg.TournamentStartedAt,
+	)
+}
+
+const DeleteTournamentBracket = `-- name: DeleteTournamentBracket :execresult
+DELETE FROM tournament_bracket
+WHERE id = ?
+LIMIT 1
+`
+
+func (q *Queries) DeleteTournamentBracket(ctx context.Context, id uint64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, DeleteTournamentBracket, id)
+}
+
+const DeleteTournamentDefinition = `-- name: DeleteTournamentDefinition :execresult
+DELETE FROM tournament_definition
+WHERE name = ?
+LIMIT 1
+`
+
+func (q *Queries) DeleteTournamentDefinition(ctx context.Context, name string) (sql.Result, error) {
+	return q.db.ExecContext(ctx, DeleteTournamentDefinition, name)
+}
+
+const DeleteTournamentRewardTier = `-- name: DeleteTournamentRewardTier :execresult
+DELETE FROM tournament_reward_tier
+WHERE id = ?
+LIMIT 1
+`
+
+func (q *Queries) DeleteTournamentRewardTier(ctx context.Context, id uint64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, DeleteTournamentRewardTier, id)
+}
+
+const DeleteTournamentWipeTime = `-- name: DeleteTournamentWipeTime :execresult
+DELETE FROM tournament_wipe_time
+WHERE name = ?
+LIMIT 1
+`
+
+func (q *Queries) DeleteTournamentWipeTime(ctx context.Context, name string) (sql.Result, error) {
+	return q.db.ExecContext(ctx, DeleteTournamentWipeTime, name)
+}
+
+const EndTournamentBracket = `-- name: EndTournamentBracket :execresult
+UPDATE tournament_bracket
+SET winner_user_id = ?,
+    ended_at = NOW()
+WHERE id = ?
+LIMIT 1
+`
+
+type EndTournamentBracketParams struct {
+	WinnerUserID sql.NullInt64 `db:"winner_user_id"`
+	ID           uint64        `db:"id"`
+}
+
+func (q *Queries) EndTournamentBracket(ctx context.Context, arg EndTournamentBracketParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, EndTournamentBracket, arg.WinnerUserID, arg.ID)
+}
+
+const GetMatchmakingUserElos = `-- name: GetMatchmakingUserElos :many
+SELECT client_user_id,
+    elo
+FROM matchmaking_user
+WHERE client_user_id IN (/*SLICE:client_user_ids*/?)
+`
+
+type GetMatchmakingUserElosRow struct {
+	ClientUserID uint64 `db:"client_user_id"`
+	Elo          int64  `db:"elo"`
+}
+
+// Elos are only read from the matchmaking tables, matches are created through the matchmaking service
+func (q *Queries) GetMatchmakingUserElos(ctx context.Context, clientUserIds []uint64) ([]GetMatchmakingUserElosRow, error) {
+	query := GetMatchmakingUserElos
+	var queryParams []interface{}
+	if len(clientUserIds) > 0 {
+		for _, v := range clientUserIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:client_user_ids*/?", strings.Repeat(",?", len(clientUserIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:client_user_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMatchmakingUserElosRow
+	for rows.Next() {
+		var i GetMatchmakingUserElosRow
+		if err := rows.Scan(&i.ClientUserID, &i.Elo); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetTeamIdByUserId = `-- name: GetTeamIdByUserId :one
+SELECT tm.team_id
+FROM team_member tm
+    JOIN team t ON tm.team_id = t.id
+WHERE tm.user_id = ?
+    AND t.deleted_at IS NULL
+LIMIT 1
+`
+
+func (q *Queries) GetTeamIdByUserId(ctx context.Context, userID uint64) (uint64, error) {
+	row := q.db.QueryRowContext(ctx, GetTeamIdByUserId, userID)
+	var team_id uint64
+	err := row.Scan(&team_id)
+	return team_id, err
+}
+
+const GetTournamentBracket = `-- name: GetTournamentBracket :one
+SELECT id,
+    name,
+    format,
+    matchmaking_arena_id,
+    winner_user_id,
+    data,
+    ended_at,
+    created_at,
+    updated_at
+FROM tournament_bracket
+WHERE id = ?
+LIMIT 1
+`
+
+func (q *Queries) GetTournamentBracket(ctx context.Context, id uint64) (TournamentBracket, error) {
+	row := q.db.QueryRowContext(ctx, GetTournamentBracket, id)
+	var i TournamentBracket
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Format,
+		&i.MatchmakingArenaID,
+		&i.WinnerUserID,
+		&i.Data,
+		&i.EndedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const GetTournamentBracketMatch = `-- name: GetTournamentBracketMatch :one
+SELECT id,
+    tournament_bracket_id,
+    side,
+    round,
+    position,
+    first_user_id,
+    second_user_id,
+    winner_user_id,
+    winner_match_id,
+    winner_match_slot,
+    loser_match_id,
+    loser_match_slot,
+    matchmaking_match_id,
+    completed_at,
+    created_at,
+    updated_at
+FROM tournament_bracket_match
+WHERE id = ?
+LIMIT 1 FOR
+UPDATE
+`
+
+func (q *Queries) GetTournamentBracketMatch(ctx context.Context, id uint64) (TournamentBracketMatch, error) {
+	row := q.db.QueryRowContext(ctx, GetTournamentBracketMatch, id)
+	var i TournamentBracketMatch
+	err := row.Scan(
+		&i.ID,
+		&i.TournamentBracketID,
+		&i.Side,
+		&i.Round,
+		&i.Position,
+		&i.FirstUserID,
+		&i.SecondUserID,
+		&i.WinnerUserID,
+		&i.WinnerMatchID,
+		&i.WinnerMatchSlot,
+		&i.LoserMatchID,
+		&i.LoserMatchSlot,
+		&i.MatchmakingMatchID,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const GetTournamentBracketMatches = `-- name: GetTournamentBracketMatches :many
+SELECT id,
+    tournament_bracket_id,
+    side,
+    round,
+    position,
+    first_user_id,
+    second_user_id,
+    winner_user_id,
+    winner_match_id,
+    winner_match_slot,
+    loser_match_id,
+    loser_match_slot,
+    matchmaking_match_id,
+    completed_at,
+    created_at,
+    updated_at
+FROM tournament_bracket_match
+WHERE tournament_bracket_id = ?
+ORDER BY side ASC,
+    round ASC,
+    position ASC
+`
+
+func (q *Queries) GetTournamentBracketMatches(ctx context.Context, tournamentBracketID uint64) ([]TournamentBracketMatch, error) {
+	rows, err := q.db.QueryContext(ctx, GetTournamentBracketMatches, tournamentBracketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TournamentBracketMatch
+	for rows.Next() {
+		var i TournamentBracketMatch
+		if err := rows.Scan(
+			&i.ID,
+			&i.TournamentBracketID,
+			&i.Side,
+			&i.Round,
+			&i.Position,
+			&i.FirstUserID,
+			&i.SecondUserID,
+			&i.WinnerUserID,
+			&i.WinnerMatchID,
+			&i.WinnerMatchSlot,
+			&i.LoserMatchID,
+			&i.LoserMatchSlot,
+			&i.MatchmakingMatchID,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetTournamentBracketParticipants = `-- name: GetTournamentBracketParticipants :many
+SELECT tournament_bracket_id,
+    user_id,
+    seed,
+    seed_value
+FROM tournament_bracket_participant
+WHERE tournament_bracket_id = ?
+ORDER BY seed ASC
+`
+
+func (q *Queries) GetTournamentBracketParticipants(ctx context.Context, tournamentBracketID uint64) ([]TournamentBracketParticipant, error) {
+	rows, err := q.db.QueryContext(ctx, GetTournamentBracketParticipants, tournamentBracketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TournamentBracketParticipant
+	for rows.Next() {
+		var i TournamentBracketParticipant
+		if err := rows.Scan(
+			&i.TournamentBracketID,
+			&i.UserID,
+			&i.Seed,
+			&i.SeedValue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetTournamentDefinition = `-- name: GetTournamentDefinition :one
+SELECT id,
+    name,
+    cron_schedule,
+    duration_seconds,
+    starts_at,
+    ends_at,
+    time_zone,
+    data,
+    created_at,
+    updated_at
+FROM tournament_definition
+WHERE name = ?
+LIMIT 1
+`
+
+func (q *Queries) GetTournamentDefinition(ctx context.Context, name string) (TournamentDefinition, error) {
+	row := q.db.QueryRowContext(ctx, GetTournamentDefinition, name)
+	var i TournamentDefinition
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CronSchedule,
+		&i.DurationSeconds,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.TimeZone,
+		&i.Data,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const GetTournamentPairing = `-- name: GetTournamentPairing :one
+SELECT id,
+    name,
+    tournament_interval,
+    tournament_started_at,
+    format,
+    round,
+    position,
+    first_user_id,
+    second_user_id,
+    result,
+    completed_at,
+    created_at,
+    updated_at
+FROM tournament_pairing
+WHERE id = ?
+LIMIT 1
+`
+
+func (q *Queries) GetTournamentPairing(ctx context.Context, id uint64) (TournamentPairing, error) {
+	row := q.db.QueryRowContext(ctx, GetTournamentPairing, id)
+	var i TournamentPairing
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.TournamentInterval,
+		&i.TournamentStartedAt,
+		&i.Format,
+		&i.Round,
+		&i.Position,
+		&i.FirstUserID,
+		&i.SecondUserID,
+		&i.Result,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const GetTournamentPairings = `-- name: GetTournamentPairings :many
+SELECT id,
+    name,
+    tournament_interval,
+    tournament_started_at,
+    format,
+    round,
+    position,
+    first_user_id,
+    second_user_id,
+    result,
+    completed_at,
+    created_at,
+    updated_at
+FROM tournament_pairing
+WHERE name = ?
+    AND tournament_interval = ?
+    AND tournament_started_at = ?
+ORDER BY round ASC,
+    position ASC
+`
+
+type GetTournamentPairingsParams struct {
+	Name                string                              `db:"name"`
+	TournamentInterval  TournamentPairingTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                           `db:"tournament_started_at"`
+}
+
+func (q *Queries) GetTournamentPairings(ctx context.Context, arg GetTournamentPairingsParams) ([]TournamentPairing, error) {
+	rows, err := q.db.QueryContext(ctx, GetTournamentPairings, arg.Name, arg.TournamentInterval, arg.TournamentStartedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TournamentPairing
+	for rows.Next() {
+		var i TournamentPairing
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.TournamentInterval,
+			&i.TournamentStartedAt,
+			&i.Format,
+			&i.Round,
+			&i.Position,
+			&i.FirstUserID,
+			&i.SecondUserID,
+			&i.Result,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetTournamentReward = `-- name: GetTournamentReward :one
+SELECT id,
+    tier_id,
+    name,
+    tournament_interval,
+    tournament_started_at,
+    user_id,
+    ranking,
+    score,
+    data,
+    claimed_at,
+    created_at
+FROM tournament_reward
+WHERE id = ?
+    AND user_id = ?
+LIMIT 1
+`
+
+type GetTournamentRewardParams struct {
+	ID     uint64 `db:"id"`
+	UserID uint64 `db:"user_id"`
+}
+
+func (q *Queries) GetTournamentReward(ctx context.Context, arg GetTournamentRewardParams) (TournamentReward, error) {
+	row := q.db.QueryRowContext(ctx, GetTournamentReward, arg.ID, arg.UserID)
+	var i TournamentReward
+	err := row.Scan(
+		&i.ID,
+		&i.TierID,
+		&i.Name,
+		&i.TournamentInterval,
+		&i.TournamentStartedAt,
+		&i.UserID,
+		&i.Ranking,
+		&i.Score,
+		&i.Data,
+		&i.ClaimedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const GetTournamentRewardTiers = `-- name: GetTournamentRewardTiers :many
+SELECT id,
+    name,
+    tournament_interval,
+    min_ranking,
+    max_ranking,
+    top_percentage,
+    data,
+    created_at,
+    updated_at
+FROM tournament_reward_tier
+WHERE name = ?
+    AND tournament_interval = ?
+ORDER BY id ASC
+`
+
+type GetTournamentRewardTiersParams struct {
+	Name               string                                 `db:"name"`
+	TournamentInterval TournamentRewardTierTournamentInterval `db:"tournament_interval"`
+}
+
+func (q *Queries) GetTournamentRewardTiers(ctx context.Context, arg GetTournamentRewardTiersParams) ([]TournamentRewardTier, error) {
+	rows, err := q.db.QueryContext(ctx, GetTournamentRewardTiers, arg.Name, arg.TournamentInterval)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TournamentRewardTier
+	for rows.Next() {
+		var i TournamentRewardTier
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.TournamentInterval,
+			&i.MinRanking,
+			&i.MaxRanking,
+			&i.TopPercentage,
+			&i.Data,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetTournamentScores = `-- name: GetTournamentScores :many
+SELECT user_id,
+    score
+FROM tournament
+WHERE name = ?
+    AND tournament_interval = ?
+    AND tournament_started_at = ?
+    AND user_id IN (/*SLICE:user_ids*/?)
+`
+
+type GetTournamentScoresParams struct {
+	Name                string                       `db:"name"`
+	TournamentInterval  TournamentTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
+	UserIds             []uint64                     `db:"user_ids"`
+}
+
+type GetTournamentScoresRow struct {
+	UserID uint64 `db:"user_id"`
+	Score  int64  `db:"score"`
+}
+
+func (q *Queries) GetTournamentScores(ctx context.Context, arg GetTournamentScoresParams) ([]GetTournamentScoresRow, error) {
+	query := GetTournamentScores
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Name)
+	queryParams = append(queryParams, arg.TournamentInterval)
+	queryParams = append(queryParams, arg.TournamentStartedAt)
+	if len(arg.UserIds) > 0 {
+		for _, v := range arg.UserIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:user_ids*/?", strings.Repeat(",?", len(arg.UserIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:user_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTournamentScoresRow
+	for rows.Next() {
+		var i GetTournamentScoresRow
+		if err := rows.Scan(&i.UserID, &i.Score); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetTournamentUserIds = `-- name: GetTournamentUserIds :many
+SELECT user_id
+FROM tournament
+WHERE name = ?
+    AND tournament_interval = ?
+    AND tournament_started_at = ?
+ORDER BY score DESC,
+    id ASC
+`
+
+type GetTournamentUserIdsParams struct {
+	Name                string                       `db:"name"`
+	TournamentInterval  TournamentTournamentInterval `db:"tournament_interval"`
+	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
+}
+
+func (q *Queries) GetTournamentUserIds(ctx context.Context, arg GetTournamentUserIdsParams) ([]uint64, error) {
+	rows, err := q.db.QueryContext(ctx, GetTournamentUserIds, arg.Name, arg.TournamentInterval, arg.TournamentStartedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uint64
+	for rows.Next() {
+		var user_id uint64
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetTournamentWipeTime = `-- name: GetTournamentWipeTime :one
+SELECT id,
+    name,
+    daily_tournament_minute,
+    weekly_tournament_minute,
+    weekly_tournament_day,
+    monthly_tournament_minute,
+    monthly_tournament_day,
+    time_zone,
+    created_at,
+    updated_at
+FROM tournament_wipe_time
+WHERE name = ?
+LIMIT 1
+`
+
+func (q *Queries) GetTournamentWipeTime(ctx context.Context, name string) (TournamentWipeTime, error) {
+	row := q.db.QueryRowContext(ctx, GetTournamentWipeTime, name)
+	var i TournamentWipeTime
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.DailyTournamentMinute,
+		&i.WeeklyTournamentMinute,
+		&i.WeeklyTournamentDay,
+		&i.MonthlyTournamentMinute,
+		&i.MonthlyTournamentDay,
+		&i.TimeZone,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const GetTournamentWipeTimes = `-- name: GetTournamentWipeTimes :many
+SELECT id,
+    name,
+    daily_tournament_minute,
+    weekly_tournament_minute,
+    weekly_tournament_day,
+    monthly_tournament_minute,
+    monthly_tournament_day,
+    time_zone,
+    created_at,
+    updated_at
+FROM tournament_wipe_time
+ORDER BY name ASC
+`
+
+func (q *Queries) GetTournamentWipeTimes(ctx context.Context) ([]TournamentWipeTime, error) {
+	rows, err := q.db.QueryContext(ctx, GetTournamentWipeTimes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TournamentWipeTime
+	for rows.Next() {
+		var i TournamentWipeTime
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.DailyTournamentMinute,
+			&i.WeeklyTournamentMinute,
+			&i.WeeklyTournamentDay,
+			&i.MonthlyTournamentMinute,
+			&i.MonthlyTournamentDay,
+			&i.TimeZone,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const RecordTournamentPairingResult = `-- name: RecordTournamentPairingResult :execresult
+UPDATE tournament_pairing
+SET result = ?,
+    completed_at = NOW()
+WHERE id = ?
+    AND completed_at IS NULL
+LIMIT 1
+`
+
+type RecordTournamentPairingResultParams struct {
+	Result NullTournamentPairingResult `db:"result"`
+	ID     uint64                      `db:"id"`
+}
+
+func (q *Queries) RecordTournamentPairingResult(ctx context.Context, arg RecordTournamentPairingResultParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, RecordTournamentPairingResult, arg.Result, arg.ID)
+}
+
+const SetTournamentBracketMatchMatchmakingMatch = `-- name: SetTournamentBracketMatchMatchmakingMatch :execresult
+UPDATE tournament_bracket_match
+SET matchmaking_match_id = ?
+WHERE id = ?
+    AND matchmaking_match_id IS NULL
+LIMIT 1
+`
+
+type SetTournamentBracketMatchMatchmakingMatchParams struct {
+	MatchmakingMatchID sql.NullInt64 `db:"matchmaking_match_id"`
+	ID                 uint64        `db:"id"`
+}
+
+func (q *Queries) SetTournamentBracketMatchMatchmakingMatch(ctx context.Context, arg SetTournamentBracketMatchMatchmakingMatchParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, SetTournamentBracketMatchMatchmakingMatch, arg.MatchmakingMatchID, arg.ID)
+}
+
+const SetTournamentWipeTime = `-- name: SetTournamentWipeTime :execresult
+INSERT INTO tournament_wipe_time (
+        name,
+        daily_tournament_minute,
+        weekly_tournament_minute,
+        weekly_tournament_day,
+        monthly_tournament_minute,
+        monthly_tournament_day,
+        time_zone
+    )
+VALUES (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY
+UPDATE daily_tournament_minute = VALUES(daily_tournament_minute),
+    weekly_tournament_minute = VALUES(weekly_tournament_minute),
+    weekly_tournament_day = VALUES(weekly_tournament_day),
+    monthly_tournament_minute = VALUES(monthly_tournament_minute),
+    monthly_tournament_day = VALUES(monthly_tournament_day),
+    time_zone = VALUES(time_zone)
+`
+
+type SetTournamentWipeTimeParams struct {
+	Name                    string `db:"name"`
+	DailyTournamentMinute   uint32 `db:"daily_tournament_minute"`
+	WeeklyTournamentMinute  uint32 `db:"weekly_tournament_minute"`
+	WeeklyTournamentDay     uint32 `db:"weekly_tournament_day"`
+	MonthlyTournamentMinute uint32 `db:"monthly_tournament_minute"`
+	MonthlyTournamentDay    uint32 `db:"monthly_tournament_day"`
+	TimeZone                string `db:"time_zone"`
+}
+
+func (q *Queries) SetTournamentWipeTime(ctx context.Context, arg SetTournamentWipeTimeParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, SetTournamentWipeTime,
+		arg.Name,
+		arg.DailyTournamentMinute,
+		arg.WeeklyTournamentMinute,
+		arg.WeeklyTournamentDay,
+		arg.MonthlyTournamentMinute,
+		arg.MonthlyTournamentDay,
+		arg.TimeZone,
+	)
+}
+
+const SubmitTournamentScore = `-- name: SubmitTournamentScore :execresult
+INSERT INTO tournament (
+        name,
+        tournament_interval,
+        user_id,
+        team_id,
+        score,
+        data,
+        tournament_started_at
+    )
+VALUES (
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?
+    ) ON DUPLICATE KEY
+UPDATE team_id = COALESCE(VALUES(team_id), team_id),
+    score = CASE
+        WHEN ? = 'increment' THEN score + VALUES(score)
+        WHEN ? = 'max' THEN GREATEST(score, VALUES(score))
+        WHEN ? = 'min' THEN LEAST(score, VALUES(score))
+        ELSE VALUES(score)
+    END,
+    data = CASE
+        WHEN ? = TRUE THEN VALUES(data)
+        ELSE data
+    END
+`
+
+type SubmitTournamentScoreParams struct {
+	Name                string                       `db:"name"`
+	TournamentInterval  TournamentTournamentInterval `db:"tournament_interval"`
+	UserID              uint64                       `db:"user_id"`
+	TeamID              sql.NullInt64                `db:"team_id"`
+	Score               int64                        `db:"score"`
+	Data                json.RawMessage              `db:"data"`
+	TournamentStartedAt time.Time                    `db:"tournament_started_at"`
+	ScoreMode           interface{}                  `db:"score_mode"`
+	UpdateData          interface{}                  `db:"update_data"`
+}
+
+func (q *Queries) SubmitTournamentScore(ctx context.Context, arg SubmitTournamentScoreParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, SubmitTournamentScore,
+		arg.Name,
+		arg.TournamentInterval,
+		arg.UserID,
+		arg.TeamID,
 		arg.Score,
 		arg.Data,
 		arg.TournamentStartedAt,
+		arg.ScoreMode,
+		arg.ScoreMode,
+		arg.ScoreMode,
+		arg.UpdateData,
 	)
 }
@@ -3,6 +3,8 @@ package tournament
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	// "github.com/MorhafAlshibly/coanda/pkg/database"
+	"github.com/MorhafAlshibly/coanda/pkg/goquOptions"
 	"github.com/MorhafAlshibly/coanda/pkg/invoker"
 	"github.com/MorhafAlshibly/coanda/pkg/metric"
 )
@@ -33,6 +36,7 @@ type Service struct {
 	monthlyTournamentDay    uint8
 	defaultMaxPageLength    uint8
 	maxMaxPageLength        uint8
+	matchmakingClient       api.MatchmakingServiceClient
 }
 
 func WithSql(sql *sql.DB) func(*Service) {
@@ -113,6 +117,12 @@ func WithMaxMaxPageLength(maxMaxPageLength uint8) func(*Service) {
 	}
 }
 
+func WithMatchmakingClient(matchmakingClient api.MatchmakingServiceClient) func(*Service) {
+	return func(input *Service) {
+		input.matchmakingClient = matchmakingClient
+	}
+}
+
 func NewService(opts ...func(*Service)) *Service {
 	service := Service{
 		minTournamentNameLength: 3,
@@ -161,6 +171,16 @@ func (s *Service) GetTournamentUsers(ctx context.Context, in *api.GetTournamentU
 	return command.Out, nil
 }
 
+func (s *Service) GetTournamentUserHistory(ctx context.Context, in *api.GetTournamentUserHistoryRequest) (*api.GetTournamentUserHistoryResponse, error) {
+	command := NewGetTournamentUserHistoryCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric).SetInvoker(invoker.NewCacheInvoker(s.cache))))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
 func (s *Service) UpdateTournamentUser(ctx context.Context, in *api.UpdateTournamentUserRequest) (*api.UpdateTournamentUserResponse, error) {
 	command := NewUpdateTournamentUserCommand(s, in)
 	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
@@ -181,6 +201,256 @@ func (s *Service) DeleteTournamentUser(ctx context.Context, in *api.TournamentUs
 	return command.Out, nil
 }
 
+func (s *Service) CreateTournamentTeam(ctx context.Context, in *api.CreateTournamentTeamRequest) (*api.CreateTournamentTeamResponse, error) {
+	command := NewCreateTournamentTeamCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) GetTournamentTeam(ctx context.Context, in *api.TournamentTeamRequest) (*api.GetTournamentTeamResponse, error) {
+	command := NewGetTournamentTeamCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric).SetInvoker(invoker.NewCacheInvoker(s.cache))))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) GetTournamentTeams(ctx context.Context, in *api.GetTournamentTeamsRequest) (*api.GetTournamentTeamsResponse, error) {
+	command := NewGetTournamentTeamsCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric).SetInvoker(invoker.NewCacheInvoker(s.cache))))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) UpdateTournamentTeam(ctx context.Context, in *api.UpdateTournamentTeamRequest) (*api.UpdateTournamentTeamResponse, error) {
+	command := NewUpdateTournamentTeamCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) DeleteTournamentTeam(ctx context.Context, in *api.TournamentTeamRequest) (*api.TournamentTeamResponse, error) {
+	command := NewDeleteTournamentTeamCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) CreateTournamentDefinition(ctx context.Context, in *api.CreateTournamentDefinitionRequest) (*api.CreateTournamentDefinitionResponse, error) {
+	command := NewCreateTournamentDefinitionCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) GetTournamentDefinition(ctx context.Context, in *api.TournamentDefinitionRequest) (*api.GetTournamentDefinitionResponse, error) {
+	command := NewGetTournamentDefinitionCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric).SetInvoker(invoker.NewCacheInvoker(s.cache))))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) DeleteTournamentDefinition(ctx context.Context, in *api.TournamentDefinitionRequest) (*api.TournamentDefinitionResponse, error) {
+	command := NewDeleteTournamentDefinitionCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) SetTournamentWipeTime(ctx context.Context, in *api.SetTournamentWipeTimeRequest) (*api.SetTournamentWipeTimeResponse, error) {
+	command := NewSetTournamentWipeTimeCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) GetTournamentWipeTime(ctx context.Context, in *api.TournamentWipeTimeRequest) (*api.GetTournamentWipeTimeResponse, error) {
+	command := NewGetTournamentWipeTimeCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric).SetInvoker(invoker.NewCacheInvoker(s.cache))))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) DeleteTournamentWipeTime(ctx context.Context, in *api.TournamentWipeTimeRequest) (*api.TournamentWipeTimeResponse, error) {
+	command := NewDeleteTournamentWipeTimeCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) CreateTournamentRewardTier(ctx context.Context, in *api.CreateTournamentRewardTierRequest) (*api.CreateTournamentRewardTierResponse, error) {
+	command := NewCreateTournamentRewardTierCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) GetTournamentRewardTiers(ctx context.Context, in *api.GetTournamentRewardTiersRequest) (*api.GetTournamentRewardTiersResponse, error) {
+	command := NewGetTournamentRewardTiersCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric).SetInvoker(invoker.NewCacheInvoker(s.cache))))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) DeleteTournamentRewardTier(ctx context.Context, in *api.TournamentRewardTierRequest) (*api.TournamentRewardTierResponse, error) {
+	command := NewDeleteTournamentRewardTierCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) GetTournamentRewards(ctx context.Context, in *api.GetTournamentRewardsRequest) (*api.GetTournamentRewardsResponse, error) {
+	command := NewGetTournamentRewardsCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric).SetInvoker(invoker.NewCacheInvoker(s.cache))))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) ClaimTournamentReward(ctx context.Context, in *api.ClaimTournamentRewardRequest) (*api.ClaimTournamentRewardResponse, error) {
+	command := NewClaimTournamentRewardCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) SubmitTournamentScore(ctx context.Context, in *api.SubmitTournamentScoreRequest) (*api.SubmitTournamentScoreResponse, error) {
+	command := NewSubmitTournamentScoreCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) CreateTournamentBracket(ctx context.Context, in *api.CreateTournamentBracketRequest) (*api.CreateTournamentBracketResponse, error) {
+	command := NewCreateTournamentBracketCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) GetTournamentBracket(ctx context.Context, in *api.TournamentBracketRequest) (*api.GetTournamentBracketResponse, error) {
+	command := NewGetTournamentBracketCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) ReportTournamentBracketMatchResult(ctx context.Context, in *api.ReportTournamentBracketMatchResultRequest) (*api.ReportTournamentBracketMatchResultResponse, error) {
+	command := NewReportTournamentBracketMatchResultCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) DeleteTournamentBracket(ctx context.Context, in *api.TournamentBracketRequest) (*api.TournamentBracketResponse, error) {
+	command := NewDeleteTournamentBracketCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) CreateTournamentRound(ctx context.Context, in *api.CreateTournamentRoundRequest) (*api.CreateTournamentRoundResponse, error) {
+	command := NewCreateTournamentRoundCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) GetTournamentPairings(ctx context.Context, in *api.GetTournamentPairingsRequest) (*api.GetTournamentPairingsResponse, error) {
+	command := NewGetTournamentPairingsCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) RecordTournamentPairingResult(ctx context.Context, in *api.RecordTournamentPairingResultRequest) (*api.RecordTournamentPairingResultResponse, error) {
+	command := NewRecordTournamentPairingResultCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
+func (s *Service) GetTournamentStandings(ctx context.Context, in *api.GetTournamentStandingsRequest) (*api.GetTournamentStandingsResponse, error) {
+	command := NewGetTournamentStandingsCommand(s, in)
+	invoker := invoker.NewLogInvoker().SetInvoker(invoker.NewTransportInvoker().SetInvoker(invoker.NewMetricInvoker(s.metric)))
+	err := invoker.Invoke(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+	return command.Out, nil
+}
+
 func unmarshalTournamentUser(tournamentUser *model.RankedTournament) (*api.TournamentUser, error) {
 	data, err := conversion.RawJsonToProtobufStruct(tournamentUser.Data)
 	if err != nil {
@@ -191,6 +461,7 @@ func unmarshalTournamentUser(tournamentUser *model.RankedTournament) (*api.Tourn
 		Id:                  tournamentUser.ID,
 		Tournament:          tournamentUser.Name,
 		UserId:              tournamentUser.UserID,
+		TeamId:              conversion.SqlNullInt64ToUint64(tournamentUser.TeamID),
 		Interval:            interval,
 		Score:               tournamentUser.Score,
 		Ranking:             tournamentUser.Ranking,
@@ -198,21 +469,390 @@ func unmarshalTournamentUser(tournamentUser *model.RankedTournament) (*api.Tourn
 		TournamentStartedAt: timestamppb.New(tournamentUser.TournamentStartedAt),
 		CreatedAt:           timestamppb.New(tournamentUser.CreatedAt),
 		UpdatedAt:           timestamppb.New(tournamentUser.UpdatedAt),
+		SentToThirdPartyAt:  conversion.SqlNullTimeToTimestamp(tournamentUser.SentToThirdPartyAt),
+	}, nil
+}
+
+func unmarshalTournamentTeam(tournamentTeam *model.RankedTournamentTeam) (*api.TournamentTeam, error) {
+	data, err := conversion.RawJsonToProtobufStruct(tournamentTeam.Data)
+	if err != nil {
+		return nil, err
+	}
+	interval := api.TournamentInterval(api.TournamentInterval_value[strings.ToUpper(string(tournamentTeam.TournamentInterval))])
+	return &api.TournamentTeam{
+		Id:                  tournamentTeam.ID,
+		Tournament:          tournamentTeam.Name,
+		TeamId:              tournamentTeam.TeamID,
+		Interval:            interval,
+		Score:               tournamentTeam.Score,
+		Ranking:             tournamentTeam.Ranking,
+		Data:                data,
+		TournamentStartedAt: timestamppb.New(tournamentTeam.TournamentStartedAt),
+		CreatedAt:           timestamppb.New(tournamentTeam.CreatedAt),
+		UpdatedAt:           timestamppb.New(tournamentTeam.UpdatedAt),
+	}, nil
+}
+
+func unmarshalTournamentDefinition(definition *model.TournamentDefinition) (*api.TournamentDefinition, error) {
+	data, err := conversion.RawJsonToProtobufStruct(definition.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &api.TournamentDefinition{
+		Id:              definition.ID,
+		Name:            definition.Name,
+		CronSchedule:    conversion.SqlNullStringToString(definition.CronSchedule),
+		DurationSeconds: conversion.SqlNullInt64ToUint64(definition.DurationSeconds),
+		StartsAt:        timestamppb.New(definition.StartsAt),
+		EndsAt:          conversion.SqlNullTimeToTimestamp(definition.EndsAt),
+		TimeZone:        definition.TimeZone,
+		Data:            data,
+		CreatedAt:       timestamppb.New(definition.CreatedAt),
+		UpdatedAt:       timestamppb.New(definition.UpdatedAt),
+	}, nil
+}
+
+func unmarshalTournamentWipeTime(wipeTime *model.TournamentWipeTime) *api.TournamentWipeTime {
+	return &api.TournamentWipeTime{
+		Id:                      wipeTime.ID,
+		Tournament:              wipeTime.Name,
+		DailyTournamentMinute:   wipeTime.DailyTournamentMinute,
+		WeeklyTournamentMinute:  wipeTime.WeeklyTournamentMinute,
+		WeeklyTournamentDay:     wipeTime.WeeklyTournamentDay,
+		MonthlyTournamentMinute: wipeTime.MonthlyTournamentMinute,
+		MonthlyTournamentDay:    wipeTime.MonthlyTournamentDay,
+		TimeZone:                wipeTime.TimeZone,
+		CreatedAt:               timestamppb.New(wipeTime.CreatedAt),
+		UpdatedAt:               timestamppb.New(wipeTime.UpdatedAt),
+	}
+}
+
+func unmarshalTournamentRewardTier(tier *model.TournamentRewardTier) (*api.TournamentRewardTier, error) {
+	data, err := conversion.RawJsonToProtobufStruct(tier.Data)
+	if err != nil {
+		return nil, err
+	}
+	var topPercentage *float64
+	if tier.TopPercentage.Valid {
+		topPercentage = &tier.TopPercentage.Float64
+	}
+	return &api.TournamentRewardTier{
+		Id:            tier.ID,
+		Tournament:    tier.Name,
+		Interval:      api.TournamentInterval(api.TournamentInterval_value[strings.ToUpper(string(tier.TournamentInterval))]),
+		MinRanking:    conversion.SqlNullInt64ToUint64(tier.MinRanking),
+		MaxRanking:    conversion.SqlNullInt64ToUint64(tier.MaxRanking),
+		TopPercentage: topPercentage,
+		Data:          data,
+		CreatedAt:     timestamppb.New(tier.CreatedAt),
+		UpdatedAt:     timestamppb.New(tier.UpdatedAt),
 	}, nil
 }
 
+func unmarshalTournamentReward(reward *model.TournamentReward) (*api.TournamentReward, error) {
+	data, err := conversion.RawJsonToProtobufStruct(reward.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &api.TournamentReward{
+		Id:                  reward.ID,
+		TierId:              reward.TierID,
+		Tournament:          reward.Name,
+		Interval:            api.TournamentInterval(api.TournamentInterval_value[strings.ToUpper(string(reward.TournamentInterval))]),
+		TournamentStartedAt: timestamppb.New(reward.TournamentStartedAt),
+		UserId:              reward.UserID,
+		Ranking:             reward.Ranking,
+		Score:               reward.Score,
+		Data:                data,
+		ClaimedAt:           conversion.SqlNullTimeToTimestamp(reward.ClaimedAt),
+		CreatedAt:           timestamppb.New(reward.CreatedAt),
+	}, nil
+}
+
+func unmarshalTournamentBracket(bracket *model.TournamentBracket, participants []model.TournamentBracketParticipant, matches []model.TournamentBracketMatch) (*api.TournamentBracket, error) {
+	data, err := conversion.RawJsonToProtobufStruct(bracket.Data)
+	if err != nil {
+		return nil, err
+	}
+	apiParticipants := make([]*api.TournamentBracketParticipant, len(participants))
+	for i, participant := range participants {
+		apiParticipants[i] = &api.TournamentBracketParticipant{
+			UserId:    participant.UserID,
+			Seed:      participant.Seed,
+			SeedValue: conversion.SqlNullInt64ToInt64(participant.SeedValue),
+		}
+	}
+	apiMatches := make([]*api.TournamentBracketMatch, len(matches))
+	for i, match := range matches {
+		apiMatches[i] = &api.TournamentBracketMatch{
+			Id:                 match.ID,
+			Side:               api.TournamentBracketSide(api.TournamentBracketSide_value[strings.ToUpper(string(match.Side))]),
+			Round:              match.Round,
+			Position:           match.Position,
+			FirstUserId:        conversion.SqlNullInt64ToUint64(match.FirstUserID),
+			SecondUserId:       conversion.SqlNullInt64ToUint64(match.SecondUserID),
+			WinnerUserId:       conversion.SqlNullInt64ToUint64(match.WinnerUserID),
+			WinnerMatchId:      conversion.SqlNullInt64ToUint64(match.WinnerMatchID),
+			LoserMatchId:       conversion.SqlNullInt64ToUint64(match.LoserMatchID),
+			MatchmakingMatchId: conversion.SqlNullInt64ToUint64(match.MatchmakingMatchID),
+			CompletedAt:        conversion.SqlNullTimeToTimestamp(match.CompletedAt),
+		}
+	}
+	return &api.TournamentBracket{
+		Id:                 bracket.ID,
+		Name:               bracket.Name,
+		Format:             api.TournamentBracketFormat(api.TournamentBracketFormat_value[strings.ToUpper(string(bracket.Format))]),
+		MatchmakingArenaId: conversion.SqlNullInt64ToUint64(bracket.MatchmakingArenaID),
+		WinnerUserId:       conversion.SqlNullInt64ToUint64(bracket.WinnerUserID),
+		Participants:       apiParticipants,
+		Matches:            apiMatches,
+		Data:               data,
+		EndedAt:            conversion.SqlNullTimeToTimestamp(bracket.EndedAt),
+		CreatedAt:          timestamppb.New(bracket.CreatedAt),
+		UpdatedAt:          timestamppb.New(bracket.UpdatedAt),
+	}, nil
+}
+
+func unmarshalTournamentPairing(pairing *model.TournamentPairing) *api.TournamentPairing {
+	var result *api.TournamentPairingResult
+	if pairing.Result.Valid {
+		result = api.TournamentPairingResult(api.TournamentPairingResult_value[strings.ToUpper(string(pairing.Result.TournamentPairingResult))]).Enum()
+	}
+	return &api.TournamentPairing{
+		Id:                  pairing.ID,
+		Tournament:          pairing.Name,
+		Interval:            api.TournamentInterval(api.TournamentInterval_value[strings.ToUpper(string(pairing.TournamentInterval))]),
+		TournamentStartedAt: timestamppb.New(pairing.TournamentStartedAt),
+		Format:              api.TournamentPairingFormat(api.TournamentPairingFormat_value[strings.ToUpper(string(pairing.Format))]),
+		Round:               pairing.Round,
+		Position:            pairing.Position,
+		FirstUserId:         pairing.FirstUserID,
+		SecondUserId:        conversion.SqlNullInt64ToUint64(pairing.SecondUserID),
+		Result:              result,
+		CompletedAt:         conversion.SqlNullTimeToTimestamp(pairing.CompletedAt),
+		CreatedAt:           timestamppb.New(pairing.CreatedAt),
+		UpdatedAt:           timestamppb.New(pairing.UpdatedAt),
+	}
+}
+
+func convertCursorToSeek(cursor *conversion.Cursor) *goquOptions.Seek {
+	if cursor == nil {
+		return nil
+	}
+	return &goquOptions.Seek{
+		Values: []interface{}{cursor.Name, cursor.Key, cursor.ID},
+		Before: cursor.Before,
+	}
+}
+
+// convertScoreMode returns how a score update is applied, where the score mode takes precedence over the increment score flag.
+// False is returned if neither is specified.
+func convertScoreMode(scoreMode *api.TournamentScoreMode, incrementScore *bool) (model.ScoreMode, bool) {
+	if scoreMode != nil {
+		switch *scoreMode {
+		case api.TournamentScoreMode_SET:
+			return model.ScoreModeSet, true
+		case api.TournamentScoreMode_INCREMENT:
+			return model.ScoreModeIncrement, true
+		case api.TournamentScoreMode_MAX:
+			return model.ScoreModeMax, true
+		case api.TournamentScoreMode_MIN:
+			return model.ScoreModeMin, true
+		}
+		return model.ScoreModeSet, false
+	}
+	if incrementScore == nil {
+		return model.ScoreModeSet, false
+	}
+	if *incrementScore {
+		return model.ScoreModeIncrement, true
+	}
+	return model.ScoreModeSet, true
+}
+
+var bracketSides = map[tournament.BracketSide]model.TournamentBracketMatchSide{
+	tournament.WinnersSide:    model.TournamentBracketMatchSideWinners,
+	tournament.LosersSide:     model.TournamentBracketMatchSideLosers,
+	tournament.GrandFinalSide: model.TournamentBracketMatchSideGrandFinal,
+}
+
+var errMatchmakingClientRequired = errors.New("a matchmaking client is required for brackets with a matchmaking arena")
+
+// matchmakingArenaExists checks the arena of a bracket through the matchmaking service
+func (s *Service) matchmakingArenaExists(ctx context.Context, arenaId uint64) (bool, error) {
+	if s.matchmakingClient == nil {
+		return false, errMatchmakingClientRequired
+	}
+	arena, err := s.matchmakingClient.GetArena(ctx, &api.ArenaRequest{Id: &arenaId})
+	if err != nil {
+		return false, err
+	}
+	return arena.Success, nil
+}
+
+// bracketMatchmakingMatch is a bracket match that has both of its users, and needs a match in the arena of the bracket
+type bracketMatchmakingMatch struct {
+	bracketId    uint64
+	matchId      uint64
+	firstUserId  uint64
+	secondUserId uint64
+}
+
+// createBracketMatchmakingMatches creates a match in the arena of the bracket for the two users of each bracket match through the matchmaking service, so they can be matched against each other.
+// It is called after the bracket is committed, so no locks are held across the calls and a rolled back bracket leaves no matches behind.
+// A match that cannot be recorded on its bracket match is deleted again.
+func (s *Service) createBracketMatchmakingMatches(ctx context.Context, arenaId uint64, matches []bracketMatchmakingMatch) error {
+	for _, bracketMatch := range matches {
+		data, err := conversion.MapToProtobufStruct(map[string]interface{}{
+			"tournamentBracketId":      bracketMatch.bracketId,
+			"tournamentBracketMatchId": bracketMatch.matchId,
+			"userIds":                  []interface{}{bracketMatch.firstUserId, bracketMatch.secondUserId},
+		})
+		if err != nil {
+			return err
+		}
+		match, err := s.matchmakingClient.CreateMatch(ctx, &api.CreateMatchRequest{
+			Arena: &api.ArenaRequest{Id: &arenaId},
+			Data:  data,
+		})
+		if err != nil {
+			return err
+		}
+		if !match.Success {
+			return fmt.Errorf("could not create matchmaking match: %s", match.Error)
+		}
+		result, err := s.database.SetTournamentBracketMatchMatchmakingMatch(ctx, model.SetTournamentBracketMatchMatchmakingMatchParams{
+			MatchmakingMatchID: sql.NullInt64{Int64: int64(*match.Id), Valid: true},
+			ID:                 bracketMatch.matchId,
+		})
+		if err == nil {
+			var rowsAffected int64
+			rowsAffected, err = result.RowsAffected()
+			// The bracket match already has a match, so this one is not needed
+			if err == nil && rowsAffected == 0 {
+				err = fmt.Errorf("tournament bracket match %d already has a matchmaking match", bracketMatch.matchId)
+			}
+		}
+		if err != nil {
+			_, deleteErr := s.matchmakingClient.DeleteMatch(ctx, &api.MatchRequest{Id: match.Id})
+			return errors.Join(err, deleteErr)
+		}
+	}
+	return nil
+}
+
+// convertTournamentPairingsToGames returns the pairings as games for the swiss pairing and standings of the tournament package
+func convertTournamentPairingsToGames(pairings []model.TournamentPairing) []tournament.PairingGame {
+	games := make([]tournament.PairingGame, len(pairings))
+	for i, pairing := range pairings {
+		games[i] = tournament.PairingGame{
+			Pairing: tournament.Pairing{
+				First:  pairing.FirstUserID,
+				Second: uint64(pairing.SecondUserID.Int64),
+			},
+			Completed: pairing.CompletedAt.Valid,
+		}
+		switch pairing.Result.TournamentPairingResult {
+		case model.TournamentPairingResultFirstWon:
+			games[i].Outcome = tournament.FirstWon
+		case model.TournamentPairingResultSecondWon:
+			games[i].Outcome = tournament.SecondWon
+		case model.TournamentPairingResultDraw:
+			games[i].Outcome = tournament.Draw
+		}
+	}
+	return games
+}
+
+// getWipeTimes returns the wipe times of the tournament, or the wipe times of the service if the tournament has none
+func (s *Service) getWipeTimes(ctx context.Context, name string) (tournament.WipeTimes, error) {
+	wipeTimes := tournament.WipeTimes{
+		DailyTournamentMinute:   s.dailyTournamentMinute,
+		WeeklyTournamentMinute:  s.weeklyTournamentMinute,
+		WeeklyTournamentDay:     s.weeklyTournamentDay,
+		MonthlyTournamentMinute: s.monthlyTournamentMinute,
+		MonthlyTournamentDay:    s.monthlyTournamentDay,
+	}
+	// Listing every tournament has no single name to look up
+	if name == "" {
+		return wipeTimes, nil
+	}
+	wipeTime, err := s.database.GetTournamentWipeTime(ctx, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return wipeTimes, nil
+		}
+		return tournament.WipeTimes{}, err
+	}
+	return tournament.NewWipeTimes(wipeTime.DailyTournamentMinute, wipeTime.WeeklyTournamentMinute, wipeTime.WeeklyTournamentDay, wipeTime.MonthlyTournamentMinute, wipeTime.MonthlyTournamentDay, wipeTime.TimeZone)
+}
+
+// getStartTime returns the start of the current tournament with the given name and interval.
+// Custom tournaments are resolved from their definition, and an error is returned if there is no definition or no window is running.
+func (s *Service) getStartTime(ctx context.Context, name string, interval api.TournamentInterval) (time.Time, *tournamentRequestError, error) {
+	if interval == api.TournamentInterval_UNLIMITED {
+		return tournament.GetStartTime(time.Now().UTC(), interval, tournament.WipeTimes{}), nil, nil
+	}
+	if interval != api.TournamentInterval_CUSTOM {
+		wipeTimes, err := s.getWipeTimes(ctx, name)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		return tournament.GetStartTime(time.Now().UTC(), interval, wipeTimes), nil, nil
+	}
+	definition, err := s.database.GetTournamentDefinition(ctx, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Unix(0, 0).UTC(), conversion.ValueToPointer(DEFINITION_NOT_FOUND), nil
+		}
+		return time.Time{}, nil, err
+	}
+	schedule, err := tournament.NewDefinition(definition.CronSchedule, definition.DurationSeconds, definition.StartsAt, definition.EndsAt, definition.TimeZone)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	startedAt, active, err := tournament.GetDefinitionStartTime(time.Now().UTC(), schedule)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if !active {
+		return startedAt, conversion.ValueToPointer(TOURNAMENT_NOT_ACTIVE), nil
+	}
+	return startedAt, nil, nil
+}
+
+// isTeamMember checks that the user is a member of the given team, so users can only add score to their own team.
+// No team is always allowed.
+func (s *Service) isTeamMember(ctx context.Context, userID uint64, teamID *uint64) (bool, error) {
+	if teamID == nil {
+		return true, nil
+	}
+	memberTeamID, err := s.database.GetTeamIdByUserId(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return memberTeamID == *teamID, nil
+}
+
 // Enum for errors
-type tournamentUserRequestError string
+type tournamentRequestError string
 
 const (
-	NOT_FOUND                                  tournamentUserRequestError = "NOT_FOUND"
-	ID_OR_TOURNAMENT_INTERVAL_USER_ID_REQUIRED tournamentUserRequestError = "ID_OR_TOURNAMENT_INTERVAL_USER_ID_REQUIRED"
-	TOURNAMENT_NAME_TOO_SHORT                  tournamentUserRequestError = "TOURNAMENT_NAME_TOO_SHORT"
-	TOURNAMENT_NAME_TOO_LONG                   tournamentUserRequestError = "TOURNAMENT_NAME_TOO_LONG"
-	USER_ID_REQUIRED                           tournamentUserRequestError = "USER_ID_REQUIRED"
+	NOT_FOUND                                  tournamentRequestError = "NOT_FOUND"
+	ID_OR_TOURNAMENT_INTERVAL_USER_ID_REQUIRED tournamentRequestError = "ID_OR_TOURNAMENT_INTERVAL_USER_ID_REQUIRED"
+	TOURNAMENT_NAME_TOO_SHORT                  tournamentRequestError = "TOURNAMENT_NAME_TOO_SHORT"
+	TOURNAMENT_NAME_TOO_LONG                   tournamentRequestError = "TOURNAMENT_NAME_TOO_LONG"
+	USER_ID_REQUIRED                           tournamentRequestError = "USER_ID_REQUIRED"
+	ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED tournamentRequestError = "ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED"
+	TEAM_ID_REQUIRED                           tournamentRequestError = "TEAM_ID_REQUIRED"
+	DEFINITION_NOT_FOUND                       tournamentRequestError = "DEFINITION_NOT_FOUND"
+	TOURNAMENT_NOT_ACTIVE                      tournamentRequestError = "TOURNAMENT_NOT_ACTIVE"
 )
 
-func (s *Service) checkForTournamentUserRequestError(request *api.TournamentUserRequest) *tournamentUserRequestError {
+func (s *Service) checkForTournamentUserRequestError(request *api.TournamentUserRequest) *tournamentRequestError {
 	if request == nil {
 		return conversion.ValueToPointer(ID_OR_TOURNAMENT_INTERVAL_USER_ID_REQUIRED)
 	}
@@ -234,23 +874,64 @@ func (s *Service) checkForTournamentUserRequestError(request *api.TournamentUser
 	return nil
 }
 
-func (s *Service) convertTournamentIntervalUserIdToNullNameIntervalUserIDStartedAt(nameIntervalUserID *api.TournamentIntervalUserId) model.NullNameIntervalUserIDStartedAt {
+func (s *Service) convertTournamentIntervalUserIdToNullNameIntervalUserIDStartedAt(ctx context.Context, nameIntervalUserID *api.TournamentIntervalUserId) (model.NullNameIntervalUserIDStartedAt, error) {
 	if nameIntervalUserID == nil {
 		return model.NullNameIntervalUserIDStartedAt{
 			Valid: false,
-		}
+		}, nil
+	}
+	// Reads and updates use the latest window of a custom tournament, even if it has ended
+	startedAt, _, err := s.getStartTime(ctx, nameIntervalUserID.Tournament, nameIntervalUserID.Interval)
+	if err != nil {
+		return model.NullNameIntervalUserIDStartedAt{}, err
 	}
 	return model.NullNameIntervalUserIDStartedAt{
-		Name:               nameIntervalUserID.Tournament,
-		TournamentInterval: model.TournamentTournamentInterval(nameIntervalUserID.Interval.String()),
-		UserID:             nameIntervalUserID.UserId,
-		TournamentStartedAt: tournament.GetStartTime(time.Now().UTC(), nameIntervalUserID.Interval, tournament.WipeTimes{
-			DailyTournamentMinute:   s.dailyTournamentMinute,
-			WeeklyTournamentMinute:  s.weeklyTournamentMinute,
-			WeeklyTournamentDay:     s.weeklyTournamentDay,
-			MonthlyTournamentMinute: s.monthlyTournamentMinute,
-			MonthlyTournamentDay:    s.monthlyTournamentDay,
-		}),
-		Valid: true,
+		Name:                nameIntervalUserID.Tournament,
+		TournamentInterval:  model.TournamentTournamentInterval(nameIntervalUserID.Interval.String()),
+		UserID:              nameIntervalUserID.UserId,
+		TournamentStartedAt: startedAt,
+		Valid:               true,
+	}, nil
+}
+
+func (s *Service) checkForTournamentTeamRequestError(request *api.TournamentTeamRequest) *tournamentRequestError {
+	if request == nil {
+		return conversion.ValueToPointer(ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED)
 	}
+	if request.Id == nil {
+		if request.TournamentIntervalTeamId == nil {
+			return conversion.ValueToPointer(ID_OR_TOURNAMENT_INTERVAL_TEAM_ID_REQUIRED)
+		}
+		if len(request.TournamentIntervalTeamId.Tournament) < int(s.minTournamentNameLength) {
+			return conversion.ValueToPointer(TOURNAMENT_NAME_TOO_SHORT)
+		}
+		if len(request.TournamentIntervalTeamId.Tournament) > int(s.maxTournamentNameLength) {
+			return conversion.ValueToPointer(TOURNAMENT_NAME_TOO_LONG)
+		}
+		if request.TournamentIntervalTeamId.TeamId == 0 {
+			return conversion.ValueToPointer(TEAM_ID_REQUIRED)
+		}
+		return nil
+	}
+	return nil
+}
+
+func (s *Service) convertTournamentIntervalTeamIdToNullNameIntervalTeamIDStartedAt(ctx context.Context, nameIntervalTeamID *api.TournamentIntervalTeamId) (model.NullNameIntervalTeamIDStartedAt, error) {
+	if nameIntervalTeamID == nil {
+		return model.NullNameIntervalTeamIDStartedAt{
+			Valid: false,
+		}, nil
+	}
+	// Reads and updates use the latest window of a custom tournament, even if it has ended
+	startedAt, _, err := s.getStartTime(ctx, nameIntervalTeamID.Tournament, nameIntervalTeamID.Interval)
+	if err != nil {
+		return model.NullNameIntervalTeamIDStartedAt{}, err
+	}
+	return model.NullNameIntervalTeamIDStartedAt{
+		Name:                nameIntervalTeamID.Tournament,
+		TournamentInterval:  model.TournamentTeamTournamentInterval(nameIntervalTeamID.Interval.String()),
+		TeamID:              nameIntervalTeamID.TeamId,
+		TournamentStartedAt: startedAt,
+		Valid:               true,
+	}, nil
 }
@@ -1,19 +1,32 @@
 package tournament
 
 import (
+	"context"
+	"database/sql"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/MorhafAlshibly/coanda/internal/tournament/model"
 )
 
 func TestConvertTournamentIntervalUserIdToNullNameIntervalUserIDStartedAt(t *testing.T) {
-	s := Service{}
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	s := NewService(WithSql(db), WithDatabase(model.New(db)))
+	mock.ExpectQuery("SELECT (.+) FROM tournament_wipe_time").WithArgs("test").WillReturnError(sql.ErrNoRows)
 	tournamentIntervalUserId := &api.TournamentIntervalUserId{
 		Tournament: "test",
 		Interval:   api.TournamentInterval_DAILY,
 		UserId:     1,
 	}
-	nullNameIntervalUserIDStartedAt := s.convertTournamentIntervalUserIdToNullNameIntervalUserIDStartedAt(tournamentIntervalUserId)
+	nullNameIntervalUserIDStartedAt, err := s.convertTournamentIntervalUserIdToNullNameIntervalUserIDStartedAt(context.Background(), tournamentIntervalUserId)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if nullNameIntervalUserIDStartedAt.Name != "test" {
 		t.Fatalf("Expected name to be test, got %s", nullNameIntervalUserIDStartedAt.Name)
 	}
@@ -3,6 +3,16 @@ CREATE TABLE event (
     name VARCHAR(255) NOT NULL,
     data JSON NOT NULL,
     started_at DATETIME NOT NULL,
+    -- Rounds that have not ended when the event is paused are extended by the length of the pause once it resumes
+    paused_at DATETIME NULL,
+    cancelled_at DATETIME NULL,
+    -- Users join an event when they first add a result, unless registration is required, within the registration window and up to the max participants
+    registration_required BOOLEAN NOT NULL DEFAULT FALSE,
+    registration_opens_at DATETIME NULL,
+    registration_closes_at DATETIME NULL,
+    max_participants INT UNSIGNED NULL,
+    -- Rules on the data of a user to join the event, such as a minimum level
+    eligibility JSON NULL,
     sent_to_third_party_at DATETIME NULL,
     created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
@@ -14,6 +24,11 @@ CREATE TABLE event_round (
     event_id BIGINT UNSIGNED NOT NULL,
     name VARCHAR(255) NOT NULL,
     scoring JSON NOT NULL,
+    -- Results are ranked from lowest to highest, unless the round ranks higher results first
+    result_order ENUM('asc', 'desc') NOT NULL DEFAULT 'asc',
+    -- The attempts of a user are aggregated into their result, where average of top averages the best number of attempts given
+    result_aggregation ENUM('latest', 'best', 'sum', 'average_of_top') NOT NULL DEFAULT 'latest',
+    result_aggregation_top INT UNSIGNED NULL,
     data JSON NOT NULL,
     ended_at DATETIME NOT NULL,
     sent_to_third_party_at DATETIME NULL,
@@ -30,6 +45,10 @@ CREATE TABLE event_user (
     event_id BIGINT UNSIGNED NOT NULL,
     client_user_id BIGINT UNSIGNED NOT NULL,
     data JSON NOT NULL,
+    -- Disqualified users are kept but left out of the leaderboards, so the other users are ranked without them
+    disqualified_at DATETIME NULL,
+    disqualification_reason TEXT NULL,
+    disqualified_by VARCHAR(255) NULL,
     created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     PRIMARY KEY (id),
@@ -37,10 +56,36 @@ CREATE TABLE event_user (
     INDEX idx_event_id (event_id),
     CONSTRAINT fk_event_user_event FOREIGN KEY (event_id) REFERENCES event (id) ON DELETE CASCADE ON UPDATE CASCADE
 ) ENGINE = InnoDB;
+-- Every disqualification and reinstatement of an event user, with the reason and the moderator who made it
+CREATE TABLE event_user_disqualification (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    event_user_id BIGINT UNSIGNED NOT NULL,
+    action ENUM('disqualified', 'reinstated') NOT NULL,
+    reason TEXT NOT NULL,
+    moderator VARCHAR(255) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    INDEX idx_event_user_id (event_user_id),
+    CONSTRAINT fk_event_user_disqualification_event_user FOREIGN KEY (event_user_id) REFERENCES event_user (id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE = InnoDB;
+-- Teams take part in an event through the results of their members, where team_id is the id of a team in the team schema
+CREATE TABLE event_team (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    event_id BIGINT UNSIGNED NOT NULL,
+    team_id BIGINT UNSIGNED NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE INDEX event_team_team_id_event_id_idx (team_id, event_id),
+    INDEX idx_event_id (event_id),
+    CONSTRAINT fk_event_team_event FOREIGN KEY (event_id) REFERENCES event (id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE = InnoDB;
 CREATE TABLE event_round_user (
     id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
     event_user_id BIGINT UNSIGNED NOT NULL,
     event_round_id BIGINT UNSIGNED NOT NULL,
+    -- The team of the user when the result was added, which the result is credited to
+    event_team_id BIGINT UNSIGNED NULL,
     result BIGINT UNSIGNED NOT NULL,
     data JSON NOT NULL,
     created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -49,22 +94,93 @@ CREATE TABLE event_round_user (
     UNIQUE INDEX event_round_event_user_id_event_round_id_idx (event_user_id, event_round_id),
     INDEX idx_event_user_id (event_user_id),
     INDEX idx_event_round_id (event_round_id),
+    INDEX idx_event_team_id (event_team_id),
+    CONSTRAINT fk_event_round_user_event_team FOREIGN KEY (event_team_id) REFERENCES event_team (id) ON DELETE SET NULL ON UPDATE CASCADE,
     CONSTRAINT fk_event_round_user_event_round FOREIGN KEY (event_round_id) REFERENCES event_round (id) ON DELETE CASCADE ON UPDATE CASCADE,
     CONSTRAINT fk_event_round_user_event_user FOREIGN KEY (event_user_id) REFERENCES event_user (id) ON DELETE CASCADE ON UPDATE CASCADE
 ) ENGINE = InnoDB;
+-- Every result removed from an event round, which is kept after the event user is deleted
+CREATE TABLE event_round_user_removal (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    event_round_user_id BIGINT UNSIGNED NOT NULL,
+    event_user_id BIGINT UNSIGNED NOT NULL,
+    client_user_id BIGINT UNSIGNED NOT NULL,
+    event_round_id BIGINT UNSIGNED NOT NULL,
+    result BIGINT UNSIGNED NOT NULL,
+    data JSON NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    INDEX idx_event_user_id (event_user_id),
+    INDEX idx_event_round_id (event_round_id),
+    CONSTRAINT fk_event_round_user_removal_event_round FOREIGN KEY (event_round_id) REFERENCES event_round (id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE = InnoDB;
+CREATE TABLE event_round_attempt (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    event_user_id BIGINT UNSIGNED NOT NULL,
+    event_round_id BIGINT UNSIGNED NOT NULL,
+    result BIGINT UNSIGNED NOT NULL,
+    data JSON NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    INDEX event_round_attempt_event_user_id_event_round_id_idx (event_user_id, event_round_id),
+    CONSTRAINT fk_event_round_attempt_event_round_user FOREIGN KEY (event_user_id, event_round_id) REFERENCES event_round_user (event_user_id, event_round_id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE = InnoDB;
+-- How an event and its rounds are sent to the third party once they end, where null columns use the defaults of the sender
+CREATE TABLE event_third_party_delivery (
+    event_id BIGINT UNSIGNED NOT NULL,
+    -- The names of the rounds that are not sent
+    excluded_rounds JSON NULL,
+    top_limit INT UNSIGNED NULL,
+    -- The fields of the users that are sent, every field is sent if null
+    fields JSON NULL,
+    uri VARCHAR(2048) NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (event_id),
+    CONSTRAINT fk_event_third_party_delivery_event FOREIGN KEY (event_id) REFERENCES event (id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE = InnoDB;
+-- Templates create events whose rounds end at an offset in seconds from the start of the event
+CREATE TABLE event_template (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    name VARCHAR(255) NOT NULL,
+    data JSON NOT NULL,
+    rounds JSON NOT NULL,
+    -- Events are started on every activation of the cron schedule in UTC, and created the lead time before they start
+    cron_schedule VARCHAR(255) NULL,
+    lead_time_seconds INT UNSIGNED NOT NULL DEFAULT 0,
+    -- The start of the latest event created from the schedule, so events deleted by hand are not created again
+    scheduled_until DATETIME NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY (name)
+) ENGINE = InnoDB;
 CREATE VIEW event_round_leaderboard AS WITH ranked_event_user AS (
     SELECT eru.id,
         eru.event_user_id,
         eru.event_round_id,
+        eru.event_team_id,
         eru.result,
         DENSE_RANK() OVER (
             PARTITION BY eru.event_round_id
-            ORDER BY eru.result ASC
+            ORDER BY CASE
+                WHEN er.result_order = 'asc' THEN eru.result
+            END ASC,
+            CASE
+                WHEN er.result_order = 'desc' THEN eru.result
+            END DESC
         ) AS ranking,
+        COUNT(*) OVER (PARTITION BY eru.event_round_id) AS results,
+        MIN(eru.result) OVER (PARTITION BY eru.event_round_id) AS lowest_result,
+        MAX(eru.result) OVER (PARTITION BY eru.event_round_id) AS highest_result,
         eru.data,
         eru.created_at,
         eru.updated_at
     FROM event_round_user eru
+        JOIN event_round er ON eru.event_round_id = er.id
+        JOIN event_user deu ON eru.event_user_id = deu.id
+    -- Disqualified users are ranked out of the round
+    WHERE deu.disqualified_at IS NULL
 )
 SELECT reu.id,
     er.event_id,
@@ -72,16 +188,49 @@ SELECT reu.id,
     reu.event_user_id,
     eu.client_user_id,
     reu.event_round_id,
+    reu.event_team_id,
     reu.result,
-    IF(
-        reu.ranking <= JSON_LENGTH(er.scoring->'$.scoring'),
-        JSON_UNQUOTE(
-            JSON_EXTRACT(
-                er.scoring,
-                CONCAT('$.scoring[', reu.ranking - 1, ']')
+    -- Rounds score each rank from the scoring array, unless they use a formula
+    CAST(
+        CASE
+            JSON_UNQUOTE(JSON_EXTRACT(er.scoring, '$.type'))
+            WHEN 'percentile_bands' THEN COALESCE(
+                JSON_EXTRACT(
+                    er.scoring,
+                    CONCAT(
+                        '$.percentiles[',
+                        FLOOR((reu.ranking - 1) * 100 / reu.results),
+                        ']'
+                    )
+                ),
+                0
+            )
+            WHEN 'linear_decay' THEN GREATEST(
+                CAST(JSON_EXTRACT(er.scoring, '$.points') AS SIGNED) - (CAST(reu.ranking AS SIGNED) - 1) * CAST(JSON_EXTRACT(er.scoring, '$.decay') AS SIGNED),
+                COALESCE(CAST(JSON_EXTRACT(er.scoring, '$.minimum') AS SIGNED), 0)
+            )
+            -- Results of rounds where lower is better are mirrored within the round, so the best result scores the most
+            WHEN 'proportional_to_result' THEN LEAST(
+                FLOOR(
+                    IF(
+                        er.result_order = 'asc',
+                        reu.highest_result + reu.lowest_result - reu.result,
+                        reu.result
+                    ) * CAST(JSON_UNQUOTE(JSON_EXTRACT(er.scoring, '$.factor')) AS DECIMAL(20, 6))
+                ),
+                COALESCE(CAST(JSON_EXTRACT(er.scoring, '$.maximum') AS UNSIGNED), ~0)
+            )
+            ELSE IF(
+                reu.ranking <= JSON_LENGTH(er.scoring->'$.scoring'),
+                JSON_UNQUOTE(
+                    JSON_EXTRACT(
+                        er.scoring,
+                        CONCAT('$.scoring[', reu.ranking - 1, ']')
+                    )
+                ),
+                '0'
             )
-        ),
-        '0'
+        END AS UNSIGNED
     ) AS score,
     reu.ranking,
     reu.data,
@@ -93,45 +242,12 @@ FROM ranked_event_user reu
 ORDER BY er.event_id,
     reu.event_round_id,
     reu.ranking ASC;
-CREATE VIEW event_leaderboard AS WITH ranked_event_user AS (
-    SELECT eru.id,
-        eru.event_user_id,
-        eru.event_round_id,
-        eru.result,
-        DENSE_RANK() OVER (
-            PARTITION BY eru.event_round_id
-            ORDER BY eru.result ASC
-        ) AS ranking,
-        eru.created_at,
-        eru.updated_at,
-        IF(
-            DENSE_RANK() OVER (
-                PARTITION BY eru.event_round_id
-                ORDER BY eru.result ASC
-            ) <= JSON_LENGTH(er.scoring->'$.scoring'),
-            JSON_UNQUOTE(
-                JSON_EXTRACT(
-                    er.scoring,
-                    CONCAT(
-                        '$.scoring[',
-                        DENSE_RANK() OVER (
-                            PARTITION BY eru.event_round_id
-                            ORDER BY eru.result ASC
-                        ) - 1,
-                        ']'
-                    )
-                )
-            ),
-            '0'
-        ) AS score
-    FROM event_round_user eru
-        JOIN event_round er ON eru.event_round_id = er.id
-),
-user_scores AS (
-    SELECT reu.event_user_id,
-        SUM(CAST(reu.score AS UNSIGNED)) AS score
-    FROM ranked_event_user reu
-    GROUP BY reu.event_user_id
+-- The event score is the sum of the round scores, so both leaderboards score rounds the same way
+CREATE VIEW event_leaderboard AS WITH user_scores AS (
+    SELECT erl.event_user_id,
+        SUM(erl.score) AS score
+    FROM event_round_leaderboard erl
+    GROUP BY erl.event_user_id
 )
 SELECT eu.id,
     eu.event_id,
@@ -140,35 +256,91 @@ SELECT eu.id,
         WHEN us.score IS NULL THEN 0
         ELSE us.score
     END AS score,
+    -- Disqualified users are ranked apart from the others, so they can still be found without changing the ranks of the others
     DENSE_RANK() OVER (
-        PARTITION BY eu.event_id
+        PARTITION BY eu.event_id,
+        eu.disqualified_at IS NULL
         ORDER BY us.score DESC
     ) AS ranking,
     eu.data,
+    eu.disqualified_at,
+    eu.disqualification_reason,
+    eu.disqualified_by,
     eu.created_at,
     eu.updated_at
 FROM event_user eu
     LEFT JOIN user_scores us ON eu.id = us.event_user_id
 ORDER BY eu.event_id,
     ranking ASC;
+-- The team score is the sum of the round scores credited to the team by its members
+CREATE VIEW event_team_leaderboard AS WITH team_scores AS (
+    SELECT erl.event_team_id,
+        SUM(erl.score) AS score
+    FROM event_round_leaderboard erl
+    WHERE erl.event_team_id IS NOT NULL
+    GROUP BY erl.event_team_id
+)
+SELECT et.id,
+    et.event_id,
+    et.team_id,
+    CASE
+        WHEN ts.score IS NULL THEN 0
+        ELSE ts.score
+    END AS score,
+    DENSE_RANK() OVER (
+        PARTITION BY et.event_id
+        ORDER BY ts.score DESC
+    ) AS ranking,
+    et.created_at,
+    et.updated_at
+FROM event_team et
+    LEFT JOIN team_scores ts ON et.id = ts.event_team_id
+ORDER BY et.event_id,
+    ranking ASC;
+-- An event is a draft until it has a round, and ends when its last round ends
 CREATE VIEW event_with_round AS
 SELECT e.id AS id,
     e.name AS name,
+    CASE
+        WHEN e.cancelled_at IS NOT NULL THEN 'cancelled'
+        WHEN e.paused_at IS NOT NULL THEN 'paused'
+        WHEN last_round.ended_at IS NULL THEN 'draft'
+        WHEN e.started_at > NOW() THEN 'scheduled'
+        WHEN last_round.ended_at > NOW() THEN 'live'
+        ELSE 'ended'
+    END AS status,
     current_round.id AS current_round_id,
     current_round.name AS current_round_name,
     e.data AS data,
     er.id AS round_id,
     er.name AS round_name,
     er.scoring AS round_scoring,
+    er.result_order AS round_result_order,
+    er.result_aggregation AS round_result_aggregation,
+    er.result_aggregation_top AS round_result_aggregation_top,
     er.data AS round_data,
     er.ended_at AS round_ended_at,
     er.created_at AS round_created_at,
     er.updated_at AS round_updated_at,
     e.started_at AS started_at,
+    last_round.ended_at AS ended_at,
+    e.paused_at AS paused_at,
+    e.cancelled_at AS cancelled_at,
+    e.registration_required AS registration_required,
+    e.registration_opens_at AS registration_opens_at,
+    e.registration_closes_at AS registration_closes_at,
+    e.max_participants AS max_participants,
+    e.eligibility AS eligibility,
     e.created_at AS created_at,
     e.updated_at AS updated_at
 FROM event e
     LEFT JOIN event_round er ON e.id = er.event_id
+    LEFT JOIN (
+        SELECT er.event_id,
+            MAX(er.ended_at) AS ended_at
+        FROM event_round er
+        GROUP BY er.event_id
+    ) AS last_round ON e.id = last_round.event_id
     LEFT JOIN (
         SELECT er.id,
             er.name,
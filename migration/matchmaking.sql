@@ -4,6 +4,7 @@ CREATE TABLE matchmaking_arena (
     min_players TINYINT UNSIGNED NOT NULL,
     max_players_per_ticket TINYINT UNSIGNED NOT NULL,
     max_players TINYINT UNSIGNED NOT NULL,
+    teams JSON NOT NULL DEFAULT (JSON_OBJECT()),
     data JSON NOT NULL,
     created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
@@ -25,6 +26,7 @@ CREATE TABLE matchmaking_match (
 CREATE TABLE matchmaking_ticket (
     id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
     matchmaking_match_id BIGINT UNSIGNED NULL,
+    team TINYINT UNSIGNED NULL,
     data JSON NOT NULL,
     created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
@@ -62,6 +64,7 @@ SELECT mt.id AS ticket_id,
         ELSE "ENDED"
     END AS status,
     COUNT(1) OVER (PARTITION BY mt.id) AS user_count,
+    mt.team AS ticket_team,
     mt.data AS ticket_data,
     mt.created_at AS ticket_created_at,
     mt.updated_at AS ticket_updated_at,
@@ -87,6 +90,7 @@ SELECT mtwu.*,
     ma.min_players AS arena_min_players,
     ma.max_players_per_ticket AS arena_max_players_per_ticket,
     ma.max_players AS arena_max_players,
+    ma.teams AS arena_teams,
     DENSE_RANK() OVER (
         PARTITION BY mtwu.ticket_id
         ORDER BY ma.id
@@ -121,6 +125,7 @@ SELECT mm.id AS match_id,
     ma.min_players AS arena_min_players,
     ma.max_players_per_ticket AS arena_max_players_per_ticket,
     ma.max_players AS arena_max_players,
+    ma.teams AS arena_teams,
     ma.data AS arena_data,
     ma.created_at AS arena_created_at,
     ma.updated_at AS arena_updated_at
@@ -156,6 +161,7 @@ SELECT mmwa.match_id,
     mmwa.arena_min_players,
     mmwa.arena_max_players_per_ticket,
     mmwa.arena_max_players,
+    mmwa.arena_teams,
     mmwa.arena_data,
     mmwa.arena_created_at,
     mmwa.arena_updated_at,
@@ -163,6 +169,7 @@ SELECT mmwa.match_id,
     mtwua.matchmaking_user_id,
     mtwua.status AS ticket_status,
     mtwua.user_count AS ticket_user_count,
+    mtwua.ticket_team,
     DENSE_RANK() OVER (
         PARTITION BY mmwa.match_id
         ORDER BY mtwua.ticket_id
@@ -181,6 +188,7 @@ SELECT mmwa.match_id,
     mtwua.arena_min_players AS ticket_arena_min_players,
     mtwua.arena_max_players_per_ticket AS ticket_arena_max_players_per_ticket,
     mtwua.arena_max_players AS ticket_arena_max_players,
+    mtwua.arena_teams AS ticket_arena_teams,
     mtwua.arena_number,
     mtwua.arena_data AS ticket_arena_data,
     mtwua.arena_created_at AS ticket_arena_created_at,
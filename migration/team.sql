@@ -2,12 +2,15 @@ CREATE TABLE team (
     id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
     name VARCHAR(255) NOT NULL,
     score BIGINT NOT NULL DEFAULT 0,
+    owner_user_id BIGINT UNSIGNED NULL,
     data JSON NOT NULL,
     created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at DATETIME NULL,
     PRIMARY KEY (id),
     UNIQUE INDEX team_name_idx (name),
-    INDEX team_score_idx (score DESC)
+    INDEX team_score_idx (score DESC),
+    INDEX team_deleted_at_idx (deleted_at)
 ) ENGINE = InnoDB;
 CREATE VIEW ranked_team AS
 SELECT id,
@@ -16,10 +19,12 @@ SELECT id,
     DENSE_RANK() OVER (
         ORDER BY score DESC
     ) AS ranking,
+    owner_user_id,
     data,
     created_at,
     updated_at
 FROM team
+WHERE deleted_at IS NULL
 ORDER BY score DESC;
 CREATE TABLE team_member (
     id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
@@ -39,6 +44,7 @@ SELECT t.id,
     t.name,
     t.score,
     t.ranking,
+    t.owner_user_id,
     t.data,
     t.created_at,
     t.updated_at,
@@ -82,4 +88,32 @@ SELECT t.id,
                 )
         )
     ) AS first_open_member
-FROM team t;
\ No newline at end of file
+FROM team t;
+CREATE TABLE team_message (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    team_id BIGINT UNSIGNED NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    content TEXT NOT NULL,
+    pinned BOOLEAN NOT NULL DEFAULT FALSE,
+    data JSON NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    INDEX team_message_team_id_id_idx (team_id, id),
+    CONSTRAINT fk_team_message_team_id_is_team_id FOREIGN KEY (team_id) REFERENCES team(id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE = InnoDB;
+-- Seasons are kept after their team is deleted, with the name of the team at the time
+CREATE TABLE team_season (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    season_label VARCHAR(255) NOT NULL,
+    team_id BIGINT UNSIGNED NULL,
+    name VARCHAR(255) NOT NULL,
+    score BIGINT NOT NULL,
+    ranking BIGINT UNSIGNED NOT NULL,
+    data JSON NOT NULL,
+    archived_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE INDEX team_season_season_label_team_id_idx (season_label, team_id),
+    INDEX team_season_team_id_archived_at_idx (team_id, archived_at DESC),
+    CONSTRAINT fk_team_season_team_id_is_team_id FOREIGN KEY (team_id) REFERENCES team(id) ON DELETE SET NULL ON UPDATE CASCADE
+) ENGINE = InnoDB;
@@ -1,8 +1,9 @@
 CREATE TABLE tournament (
     id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
     name VARCHAR(255) NOT NULL,
-    tournament_interval ENUM('daily', 'weekly', 'monthly', 'unlimited') NOT NULL,
+    tournament_interval ENUM('daily', 'weekly', 'monthly', 'unlimited', 'custom') NOT NULL,
     user_id BIGINT UNSIGNED NOT NULL,
+    team_id BIGINT UNSIGNED NULL,
     score BIGINT NOT NULL DEFAULT 0,
     data JSON NOT NULL,
     tournament_started_at DATETIME NOT NULL,
@@ -26,6 +27,12 @@ CREATE TABLE tournament (
         user_id ASC,
         score DESC,
         tournament_started_at DESC
+    ),
+    INDEX name_tournament_interval_team_id_tournament_started_at_idx (
+        name ASC,
+        tournament_interval ASC,
+        team_id ASC,
+        tournament_started_at DESC
     )
 ) ENGINE = InnoDB;
 CREATE VIEW ranked_tournament AS
@@ -33,6 +40,7 @@ SELECT id,
     name,
     tournament_interval,
     user_id,
+    team_id,
     score,
     DENSE_RANK() OVER (
         PARTITION BY name,
@@ -50,22 +58,244 @@ ORDER BY name ASC,
     tournament_interval ASC,
     tournament_started_at DESC,
     score DESC;
--- CREATE TABLE archived_tournament (
---     id BIGINT UNSIGNED NOT NULL,
---     name VARCHAR(255) NOT NULL,
---     tournament_interval ENUM('daily', 'weekly', 'monthly', 'unlimited') NOT NULL,
---     user_id BIGINT UNSIGNED NOT NULL,
---     score BIGINT NOT NULL DEFAULT 0,
---     data JSON NOT NULL,
---     tournament_started_at DATETIME NOT NULL,
---     created_at DATETIME NOT NULL,
---     updated_at DATETIME NOT NULL,
---     archived_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
---     UNIQUE INDEX a_name_tournament_interval_user_id_tournament_started_at_idx (
---         name ASC,
---         tournament_interval ASC,
---         user_id ASC,
---         tournament_started_at DESC
---     ),
---     PRIMARY KEY (id)
--- ) ENGINE = InnoDB;
\ No newline at end of file
+CREATE TABLE tournament_team (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    name VARCHAR(255) NOT NULL,
+    tournament_interval ENUM('daily', 'weekly', 'monthly', 'unlimited', 'custom') NOT NULL,
+    team_id BIGINT UNSIGNED NOT NULL,
+    score BIGINT NOT NULL DEFAULT 0,
+    data JSON NOT NULL,
+    tournament_started_at DATETIME NOT NULL,
+    sent_to_third_party_at DATETIME NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE INDEX name_tournament_interval_team_id_tournament_started_at_idx (
+        name ASC,
+        tournament_interval ASC,
+        team_id ASC,
+        tournament_started_at DESC
+    )
+) ENGINE = InnoDB;
+-- The team score is its own score plus the contributions of every member entry linked to it
+CREATE VIEW ranked_tournament_team AS
+SELECT tt.id,
+    tt.name,
+    tt.tournament_interval,
+    tt.team_id,
+    CAST(tt.score + COALESCE(SUM(t.score), 0) AS SIGNED) AS score,
+    DENSE_RANK() OVER (
+        PARTITION BY tt.name,
+        tt.tournament_interval,
+        tt.tournament_started_at
+        ORDER BY tt.score + COALESCE(SUM(t.score), 0) DESC
+    ) AS ranking,
+    tt.data,
+    tt.tournament_started_at,
+    tt.sent_to_third_party_at,
+    tt.created_at,
+    tt.updated_at
+FROM tournament_team tt
+    LEFT JOIN tournament t ON t.team_id = tt.team_id
+    AND t.name = tt.name
+    AND t.tournament_interval = tt.tournament_interval
+    AND t.tournament_started_at = tt.tournament_started_at
+GROUP BY tt.id
+ORDER BY tt.name ASC,
+    tt.tournament_interval ASC,
+    tt.tournament_started_at DESC,
+    ranking ASC;
+-- Custom tournaments get their windows from a definition with the same name, either a cron schedule or a fixed duration
+CREATE TABLE tournament_definition (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    name VARCHAR(255) NOT NULL,
+    cron_schedule VARCHAR(255) NULL,
+    duration_seconds BIGINT UNSIGNED NULL,
+    starts_at DATETIME NOT NULL,
+    ends_at DATETIME NULL,
+    time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    data JSON NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE INDEX name_idx (name ASC)
+) ENGINE = InnoDB;
+-- Tournaments without wipe times use the wipe times the service was started with
+CREATE TABLE tournament_wipe_time (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    name VARCHAR(255) NOT NULL,
+    daily_tournament_minute SMALLINT UNSIGNED NOT NULL,
+    weekly_tournament_minute SMALLINT UNSIGNED NOT NULL,
+    weekly_tournament_day TINYINT UNSIGNED NOT NULL,
+    monthly_tournament_minute SMALLINT UNSIGNED NOT NULL,
+    monthly_tournament_day TINYINT UNSIGNED NOT NULL,
+    time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE INDEX name_idx (name ASC)
+) ENGINE = InnoDB;
+-- A reward tier is either a band of rankings, or the top percentage of entries in a tournament
+CREATE TABLE tournament_reward_tier (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    name VARCHAR(255) NOT NULL,
+    tournament_interval ENUM('daily', 'weekly', 'monthly', 'unlimited', 'custom') NOT NULL,
+    min_ranking BIGINT UNSIGNED NULL,
+    max_ranking BIGINT UNSIGNED NULL,
+    top_percentage DOUBLE NULL,
+    data JSON NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    INDEX name_tournament_interval_idx (name ASC, tournament_interval ASC)
+) ENGINE = InnoDB;
+-- Rewards are granted once per tier for every entry in an ended tournament, and keep the data of the tier at that time
+CREATE TABLE tournament_reward (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    tier_id BIGINT UNSIGNED NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    tournament_interval ENUM('daily', 'weekly', 'monthly', 'unlimited', 'custom') NOT NULL,
+    tournament_started_at DATETIME NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    ranking BIGINT UNSIGNED NOT NULL,
+    score BIGINT NOT NULL,
+    data JSON NOT NULL,
+    claimed_at DATETIME NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE INDEX tier_id_tournament_started_at_user_id_idx (
+        tier_id ASC,
+        tournament_started_at DESC,
+        user_id ASC
+    ),
+    INDEX user_id_claimed_at_created_at_idx (
+        user_id ASC,
+        claimed_at ASC,
+        created_at DESC
+    )
+) ENGINE = InnoDB;
+-- Brackets are played in matches between two users, where the winner of each match moves on until one user is left.
+-- Brackets can create a matchmaking match in the arena for every match once both of its users are known.
+CREATE TABLE tournament_bracket (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    name VARCHAR(255) NOT NULL,
+    format ENUM('single_elimination', 'double_elimination') NOT NULL,
+    matchmaking_arena_id BIGINT UNSIGNED NULL,
+    winner_user_id BIGINT UNSIGNED NULL,
+    data JSON NOT NULL,
+    ended_at DATETIME NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE INDEX name_idx (name ASC)
+) ENGINE = InnoDB;
+CREATE TABLE tournament_bracket_participant (
+    tournament_bracket_id BIGINT UNSIGNED NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    seed INT UNSIGNED NOT NULL,
+    seed_value BIGINT NULL,
+    PRIMARY KEY (tournament_bracket_id, user_id),
+    UNIQUE INDEX tournament_bracket_id_seed_idx (tournament_bracket_id ASC, seed ASC),
+    CONSTRAINT fk_tournament_bracket_participant_tournament_bracket FOREIGN KEY (tournament_bracket_id) REFERENCES tournament_bracket (id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE = InnoDB;
+-- The winner and loser of a match move to a slot of another match, or leave the bracket if there is none
+CREATE TABLE tournament_bracket_match (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    tournament_bracket_id BIGINT UNSIGNED NOT NULL,
+    side ENUM('winners', 'losers', 'grand_final') NOT NULL,
+    round INT UNSIGNED NOT NULL,
+    position INT UNSIGNED NOT NULL,
+    first_user_id BIGINT UNSIGNED NULL,
+    second_user_id BIGINT UNSIGNED NULL,
+    winner_user_id BIGINT UNSIGNED NULL,
+    winner_match_id BIGINT UNSIGNED NULL,
+    winner_match_slot TINYINT UNSIGNED NULL,
+    loser_match_id BIGINT UNSIGNED NULL,
+    loser_match_slot TINYINT UNSIGNED NULL,
+    matchmaking_match_id BIGINT UNSIGNED NULL,
+    completed_at DATETIME NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE INDEX tournament_bracket_id_side_round_position_idx (
+        tournament_bracket_id ASC,
+        side ASC,
+        round ASC,
+        position ASC
+    ),
+    CONSTRAINT fk_tournament_bracket_match_tournament_bracket FOREIGN KEY (tournament_bracket_id) REFERENCES tournament_bracket (id) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE = InnoDB;
+-- Swiss and round robin tournaments are played in rounds of pairings between the users of a tournament, where a pairing without a second user is a bye.
+-- Standings are calculated from the completed pairings of the tournament.
+CREATE TABLE tournament_pairing (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    name VARCHAR(255) NOT NULL,
+    tournament_interval ENUM('daily', 'weekly', 'monthly', 'unlimited', 'custom') NOT NULL,
+    tournament_started_at DATETIME NOT NULL,
+    format ENUM('swiss', 'round_robin') NOT NULL,
+    round INT UNSIGNED NOT NULL,
+    position INT UNSIGNED NOT NULL,
+    first_user_id BIGINT UNSIGNED NOT NULL,
+    second_user_id BIGINT UNSIGNED NULL,
+    result ENUM('first_won', 'second_won', 'draw') NULL,
+    completed_at DATETIME NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE INDEX name_tournament_interval_tournament_started_at_round_position_idx (
+        name ASC,
+        tournament_interval ASC,
+        tournament_started_at ASC,
+        round ASC,
+        position ASC
+    )
+) ENGINE = InnoDB;
+-- Windows that were sent to the third party are moved here after the retention period, with their final ranking
+CREATE TABLE archived_tournament (
+    id BIGINT UNSIGNED NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    tournament_interval ENUM('daily', 'weekly', 'monthly', 'unlimited', 'custom') NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    team_id BIGINT UNSIGNED NULL,
+    score BIGINT NOT NULL DEFAULT 0,
+    ranking BIGINT UNSIGNED NOT NULL,
+    data JSON NOT NULL,
+    tournament_started_at DATETIME NOT NULL,
+    sent_to_third_party_at DATETIME NULL,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    archived_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE INDEX a_name_tournament_interval_user_id_tournament_started_at_idx (
+        name ASC,
+        tournament_interval ASC,
+        user_id ASC,
+        tournament_started_at DESC
+    ),
+    INDEX a_user_id_tournament_started_at_idx (
+        user_id ASC,
+        tournament_started_at DESC
+    )
+) ENGINE = InnoDB;
+-- Team windows are archived with the windows of their members, so the score includes the contributions of the members
+CREATE TABLE archived_tournament_team (
+    id BIGINT UNSIGNED NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    tournament_interval ENUM('daily', 'weekly', 'monthly', 'unlimited', 'custom') NOT NULL,
+    team_id BIGINT UNSIGNED NOT NULL,
+    score BIGINT NOT NULL DEFAULT 0,
+    ranking BIGINT UNSIGNED NOT NULL,
+    data JSON NOT NULL,
+    tournament_started_at DATETIME NOT NULL,
+    sent_to_third_party_at DATETIME NULL,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    archived_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE INDEX a_name_tournament_interval_team_id_tournament_started_at_idx (
+        name ASC,
+        tournament_interval ASC,
+        team_id ASC,
+        tournament_started_at DESC
+    )
+) ENGINE = InnoDB;
\ No newline at end of file
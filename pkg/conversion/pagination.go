@@ -1,6 +1,15 @@
 package conversion
 
-import "github.com/MorhafAlshibly/coanda/api"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+
+	"github.com/MorhafAlshibly/coanda/api"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
 
 func PaginationToLimitOffset(pagination *api.Pagination, defaultMax uint8, maxMax uint8) (uint64, uint64) {
 	if pagination == nil {
@@ -14,3 +23,83 @@ func PaginationToLimitOffset(pagination *api.Pagination, defaultMax uint8, maxMa
 	offset := (page - 1) * uint64(max)
 	return uint64(max), offset
 }
+
+// Cursor is the position of a row in a sorted list, made of the sort key of the row and its id
+type Cursor struct {
+	Name string `json:"n,omitempty"`
+	Key  int64  `json:"k"`
+	ID   uint64 `json:"i"`
+	// Before is true if the cursor was given as the before token, and is not part of the token itself
+	Before bool `json:"-"`
+}
+
+func EncodeCursor(cursor Cursor) string {
+	// Marshalling a struct of strings and integers cannot fail
+	token, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+func DecodeCursor(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor Cursor
+	err = json.Unmarshal(raw, &cursor)
+	if err != nil || cursor.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+// PaginationToCursor returns the cursor given in the pagination, or nil if the pagination is page based
+func PaginationToCursor(pagination *api.Pagination) (*Cursor, error) {
+	if pagination == nil || (pagination.After == nil && pagination.Before == nil) {
+		return nil, nil
+	}
+	if pagination.After != nil && pagination.Before != nil {
+		return nil, ErrInvalidCursor
+	}
+	if pagination.After != nil {
+		return DecodeCursor(*pagination.After)
+	}
+	cursor, err := DecodeCursor(*pagination.Before)
+	if err != nil {
+		return nil, err
+	}
+	cursor.Before = true
+	return cursor, nil
+}
+
+// CursorPage trims a page that was fetched with one extra row, and returns the tokens for the next and previous pages.
+// The rows must be in the order they were queried in, which is reversed when seeking backwards.
+func CursorPage[T any](rows []T, limit uint64, offset uint64, cursor *Cursor, toCursor func(T) Cursor) ([]T, *string, *string) {
+	hasMore := uint64(len(rows)) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+	before := cursor != nil && cursor.Before
+	if before {
+		slices.Reverse(rows)
+	}
+	if len(rows) == 0 {
+		return rows, nil, nil
+	}
+	first := EncodeCursor(toCursor(rows[0]))
+	last := EncodeCursor(toCursor(rows[len(rows)-1]))
+	if before {
+		// The row the cursor points at comes after this page, so there is always a next page
+		if hasMore {
+			return rows, &last, &first
+		}
+		return rows, &last, nil
+	}
+	var next, previous *string
+	if hasMore {
+		next = &last
+	}
+	if cursor != nil || offset > 0 {
+		previous = &first
+	}
+	return rows, next, previous
+}
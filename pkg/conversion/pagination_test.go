@@ -74,3 +74,81 @@ func Test_PaginationToLimitOffset_PageSet_MaxAndOffset(t *testing.T) {
 		t.Errorf("Expected %v but got %v", max, actualOffset)
 	}
 }
+
+func Test_DecodeCursor_EncodedCursor_SameCursor(t *testing.T) {
+	cursor := Cursor{Name: "test", Key: -5, ID: 3}
+	actual, err := DecodeCursor(EncodeCursor(cursor))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *actual != cursor {
+		t.Errorf("Expected %v but got %v", cursor, *actual)
+	}
+}
+
+func Test_DecodeCursor_InvalidToken_ErrInvalidCursor(t *testing.T) {
+	_, err := DecodeCursor("not a cursor")
+	if err != ErrInvalidCursor {
+		t.Errorf("Expected %v but got %v", ErrInvalidCursor, err)
+	}
+}
+
+func Test_PaginationToCursor_NoTokens_NilCursor(t *testing.T) {
+	max := uint32(5)
+	cursor, err := PaginationToCursor(&api.Pagination{Max: &max})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor != nil {
+		t.Errorf("Expected nil but got %v", cursor)
+	}
+}
+
+func Test_PaginationToCursor_AfterAndBefore_ErrInvalidCursor(t *testing.T) {
+	token := EncodeCursor(Cursor{Key: 1, ID: 1})
+	_, err := PaginationToCursor(&api.Pagination{After: &token, Before: &token})
+	if err != ErrInvalidCursor {
+		t.Errorf("Expected %v but got %v", ErrInvalidCursor, err)
+	}
+}
+
+func Test_PaginationToCursor_Before_BeforeCursor(t *testing.T) {
+	token := EncodeCursor(Cursor{Key: 1, ID: 1})
+	cursor, err := PaginationToCursor(&api.Pagination{Before: &token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cursor.Before {
+		t.Errorf("Expected cursor to be before")
+	}
+}
+
+func Test_CursorPage_FirstPageWithMore_NextCursorOnly(t *testing.T) {
+	rows, next, previous := CursorPage([]uint64{1, 2, 3}, 2, 0, nil, func(id uint64) Cursor {
+		return Cursor{ID: id}
+	})
+	if len(rows) != 2 {
+		t.Fatalf("Expected %v but got %v", 2, len(rows))
+	}
+	if next == nil || *next != EncodeCursor(Cursor{ID: 2}) {
+		t.Errorf("Expected next cursor to point at the last row")
+	}
+	if previous != nil {
+		t.Errorf("Expected nil but got %v", *previous)
+	}
+}
+
+func Test_CursorPage_BeforeCursorWithMore_RowsReversed(t *testing.T) {
+	rows, next, previous := CursorPage([]uint64{5, 4, 3}, 2, 0, &Cursor{ID: 6, Before: true}, func(id uint64) Cursor {
+		return Cursor{ID: id}
+	})
+	if len(rows) != 2 || rows[0] != 4 || rows[1] != 5 {
+		t.Fatalf("Expected [4 5] but got %v", rows)
+	}
+	if next == nil || *next != EncodeCursor(Cursor{ID: 5}) {
+		t.Errorf("Expected next cursor to point at the last row")
+	}
+	if previous == nil || *previous != EncodeCursor(Cursor{ID: 4}) {
+		t.Errorf("Expected previous cursor to point at the first row")
+	}
+}
@@ -126,6 +126,14 @@ func SqlNullInt64ToUint64(i sql.NullInt64) *uint64 {
 	return nil
 }
 
+func SqlNullInt32ToUint32(i sql.NullInt32) *uint32 {
+	if i.Valid {
+		value := uint32(i.Int32)
+		return &value
+	}
+	return nil
+}
+
 func SqlNullStringToString(s sql.NullString) *string {
 	if s.Valid {
 		return &s.String
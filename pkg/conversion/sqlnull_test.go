@@ -208,6 +208,22 @@ func Test_SqlNullInt64ToUint64_InvalidNullInt64_Nil(t *testing.T) {
 	}
 }
 
+func Test_SqlNullInt32ToUint32_ValidNullInt32_Uint32(t *testing.T) {
+	i := sql.NullInt32{Int32: 10, Valid: true}
+	actual := SqlNullInt32ToUint32(i)
+	if *actual != uint32(i.Int32) {
+		t.Errorf("Expected %v but got %v", uint32(i.Int32), *actual)
+	}
+}
+
+func Test_SqlNullInt32ToUint32_InvalidNullInt32_Nil(t *testing.T) {
+	i := sql.NullInt32{}
+	actual := SqlNullInt32ToUint32(i)
+	if actual != nil {
+		t.Errorf("Expected %v but got %v", nil, actual)
+	}
+}
+
 func Test_SqlNullStringToString_InvalidNullString_Nil(t *testing.T) {
 	s := sql.NullString{}
 	actual := SqlNullStringToString(s)
@@ -0,0 +1,66 @@
+package eventTemplate
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// NameTimeFormat is the format of the start time appended to the name of a template to name its events
+const NameTimeFormat = "20060102T1504"
+
+// MaxScheduledStartTimes limits the events created from a template in a single run, so a frequent schedule with a long lead time is created over several runs
+const MaxScheduledStartTimes = 100
+
+// Round is a round of a template as it is stored, which ends at an offset from the start of the event
+type Round struct {
+	Name                 string          `json:"name"`
+	Data                 json.RawMessage `json:"data"`
+	Scoring              json.RawMessage `json:"scoring"`
+	ResultOrder          string          `json:"resultOrder"`
+	ResultAggregation    string          `json:"resultAggregation"`
+	ResultAggregationTop *uint32         `json:"resultAggregationTop,omitempty"`
+	EndedAfterSeconds    uint64          `json:"endedAfterSeconds"`
+}
+
+// EndedAt returns the time the round ends at for an event starting at the given time
+func (r Round) EndedAt(startedAt time.Time) time.Time {
+	return startedAt.Add(time.Duration(r.EndedAfterSeconds) * time.Second)
+}
+
+// EventName returns the name of the event created from the template starting at the given time, which is unique for every start minute
+func EventName(templateName string, startedAt time.Time) string {
+	return templateName + "-" + startedAt.UTC().Format(NameTimeFormat)
+}
+
+// MaxNameLength returns the longest a template name can be for the names of its events to fit in the given length
+func MaxNameLength(maxEventNameLength int) int {
+	return maxEventNameLength - len(NameTimeFormat) - 1
+}
+
+func ParseCronSchedule(spec string) (cron.Schedule, error) {
+	return cron.ParseStandard(spec)
+}
+
+// GetScheduledStartTimes returns the start times of the events of the cron schedule that should exist at the current time in UTC, up to MaxScheduledStartTimes.
+// These are the events starting within the lead time, and those that started within the catch up period in case a run of the scheduler was missed.
+// Start times up to when the template was scheduled until have already had their events created, so they are left out even if the event has since been deleted.
+func GetScheduledStartTimes(currentTime time.Time, cronSchedule string, leadTime time.Duration, catchUp time.Duration, scheduledUntil *time.Time) ([]time.Time, error) {
+	schedule, err := ParseCronSchedule(cronSchedule)
+	if err != nil {
+		return nil, err
+	}
+	currentTime = currentTime.UTC()
+	latestStartTime := currentTime.Add(leadTime)
+	earliestStartTime := currentTime.Add(-catchUp)
+	if scheduledUntil != nil && scheduledUntil.After(earliestStartTime) {
+		earliestStartTime = scheduledUntil.UTC()
+	}
+	startTimes := []time.Time{}
+	// The schedule returns the zero time if it has no activation in the next five years
+	for startTime := schedule.Next(earliestStartTime); !startTime.IsZero() && !startTime.After(latestStartTime) && len(startTimes) < MaxScheduledStartTimes; startTime = schedule.Next(startTime) {
+		startTimes = append(startTimes, startTime)
+	}
+	return startTimes, nil
+}
@@ -0,0 +1,122 @@
+package eventTemplate
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_EventName_StartTime_NameWithStartMinute(t *testing.T) {
+	startedAt := time.Date(2020, 1, 2, 15, 4, 30, 0, time.UTC)
+	name := EventName("weekly", startedAt)
+	if name != "weekly-20200102T1504" {
+		t.Fatalf("Expected name to be weekly-20200102T1504, got %s", name)
+	}
+}
+
+func Test_EventName_StartTimeNotInUTC_NameInUTC(t *testing.T) {
+	startedAt := time.Date(2020, 1, 2, 15, 4, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+	name := EventName("weekly", startedAt)
+	if name != "weekly-20200102T1304" {
+		t.Fatalf("Expected name to be weekly-20200102T1304, got %s", name)
+	}
+}
+
+func Test_MaxNameLength_MaxEventNameLength_RoomForStartTime(t *testing.T) {
+	maxNameLength := MaxNameLength(64)
+	name := EventName(string(make([]byte, maxNameLength)), time.Now())
+	if len(name) != 64 {
+		t.Fatalf("Expected event name to be 64 characters long, got %d", len(name))
+	}
+}
+
+func Test_Round_EndedAt_OffsetFromStart(t *testing.T) {
+	startedAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	round := Round{EndedAfterSeconds: 90 * 60}
+	endedAt := round.EndedAt(startedAt)
+	if !endedAt.Equal(time.Date(2020, 1, 1, 1, 30, 0, 0, time.UTC)) {
+		t.Fatalf("Expected round to end at 01:30, got %s", endedAt)
+	}
+}
+
+func Test_GetScheduledStartTimes_InvalidSchedule_Error(t *testing.T) {
+	_, err := GetScheduledStartTimes(time.Now(), "not a schedule", 0, 0, nil)
+	if err == nil {
+		t.Fatal("Expected error for invalid schedule")
+	}
+}
+
+func Test_GetScheduledStartTimes_NextStartAfterLeadTime_NoStartTimes(t *testing.T) {
+	currentTime := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	startTimes, err := GetScheduledStartTimes(currentTime, "0 12 * * *", time.Hour, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(startTimes) != 0 {
+		t.Fatalf("Expected no start times, got %v", startTimes)
+	}
+}
+
+func Test_GetScheduledStartTimes_NextStartWithinLeadTime_NextStartTime(t *testing.T) {
+	currentTime := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	startTimes, err := GetScheduledStartTimes(currentTime, "0 12 * * *", 2*time.Hour, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(startTimes) != 1 || !startTimes[0].Equal(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Expected start time to be 12:00, got %v", startTimes)
+	}
+}
+
+func Test_GetScheduledStartTimes_StartedWithinCatchUp_PreviousStartTime(t *testing.T) {
+	currentTime := time.Date(2020, 1, 1, 12, 3, 0, 0, time.UTC)
+	startTimes, err := GetScheduledStartTimes(currentTime, "0 12 * * *", 0, 5*time.Minute, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(startTimes) != 1 || !startTimes[0].Equal(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Expected start time to be 12:00, got %v", startTimes)
+	}
+}
+
+func Test_GetScheduledStartTimes_SeveralStartsWithinLeadTime_EveryStartTime(t *testing.T) {
+	currentTime := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	startTimes, err := GetScheduledStartTimes(currentTime, "0 * * * *", 3*time.Hour, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(startTimes) != 3 {
+		t.Fatalf("Expected 3 start times, got %v", startTimes)
+	}
+	for i, startTime := range startTimes {
+		if !startTime.Equal(time.Date(2020, 1, 1, 11+i, 0, 0, 0, time.UTC)) {
+			t.Fatalf("Expected start time %d to be %d:00, got %s", i, 11+i, startTime)
+		}
+	}
+}
+
+func Test_GetScheduledStartTimes_ScheduledUntil_LaterStartTimesOnly(t *testing.T) {
+	currentTime := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	// The event at 11:00 was created by an earlier run, and may since have been deleted
+	scheduledUntil := time.Date(2020, 1, 1, 11, 0, 0, 0, time.UTC)
+	startTimes, err := GetScheduledStartTimes(currentTime, "0 * * * *", 3*time.Hour, time.Hour, &scheduledUntil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(startTimes) != 2 || !startTimes[0].Equal(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Expected start times to be 12:00 and 13:00, got %v", startTimes)
+	}
+}
+
+func Test_GetScheduledStartTimes_ManyStartsWithinLeadTime_CappedStartTimes(t *testing.T) {
+	currentTime := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	startTimes, err := GetScheduledStartTimes(currentTime, "* * * * *", 24*time.Hour, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(startTimes) != MaxScheduledStartTimes {
+		t.Fatalf("Expected %d start times, got %d", MaxScheduledStartTimes, len(startTimes))
+	}
+	if !startTimes[0].Equal(time.Date(2020, 1, 1, 10, 1, 0, 0, time.UTC)) {
+		t.Fatalf("Expected the earliest start times first, got %s", startTimes[0])
+	}
+}
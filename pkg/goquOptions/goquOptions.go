@@ -18,3 +18,55 @@ func Apply(opts *SelectDataset, dataset *goqu.SelectDataset) *goqu.SelectDataset
 	}
 	return dataset
 }
+
+// SeekColumn is a column that a keyset paginated query is ordered by
+type SeekColumn struct {
+	Name       string
+	Descending bool
+}
+
+// Seek is the position to start a keyset paginated query from
+type Seek struct {
+	// Values of the seek columns for the row to start from, in the same order as the columns
+	Values []interface{}
+	// Before is true if the rows before the position should be returned instead of the rows after it
+	Before bool
+}
+
+// SeekOrder returns the order of a keyset paginated query, which is reversed when seeking backwards
+func SeekOrder(seek *Seek, columns ...SeekColumn) []exp.OrderedExpression {
+	before := seek != nil && seek.Before
+	order := make([]exp.OrderedExpression, len(columns))
+	for i, column := range columns {
+		if column.Descending != before {
+			order[i] = goqu.C(column.Name).Desc()
+		} else {
+			order[i] = goqu.C(column.Name).Asc()
+		}
+	}
+	return order
+}
+
+// ApplySeek orders the dataset by the columns and filters out the rows up to and including the seek position.
+// When seeking backwards the order is reversed, so the caller has to reverse the returned rows.
+func ApplySeek(seek *Seek, dataset *goqu.SelectDataset, columns ...SeekColumn) *goqu.SelectDataset {
+	dataset = dataset.Order(SeekOrder(seek, columns...)...)
+	if seek == nil || len(seek.Values) != len(columns) {
+		return dataset
+	}
+	// A row comes after the position if it is equal on the first columns and further along on the next one
+	conditions := make([]exp.Expression, len(columns))
+	for i, column := range columns {
+		expressions := make([]exp.Expression, 0, i+1)
+		for j := 0; j < i; j++ {
+			expressions = append(expressions, goqu.C(columns[j].Name).Eq(seek.Values[j]))
+		}
+		if column.Descending != seek.Before {
+			expressions = append(expressions, goqu.C(column.Name).Lt(seek.Values[i]))
+		} else {
+			expressions = append(expressions, goqu.C(column.Name).Gt(seek.Values[i]))
+		}
+		conditions[i] = goqu.And(expressions...)
+	}
+	return dataset.Where(goqu.Or(conditions...))
+}
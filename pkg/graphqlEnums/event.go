@@ -0,0 +1,313 @@
+package graphqlEnums
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type EventRoundResultOrder string
+
+const (
+	EventRoundResultOrderAsc  EventRoundResultOrder = "ASC"
+	EventRoundResultOrderDesc EventRoundResultOrder = "DESC"
+)
+
+var AllEventRoundResultOrder = []EventRoundResultOrder{
+	EventRoundResultOrderAsc,
+	EventRoundResultOrderDesc,
+}
+
+func (e EventRoundResultOrder) IsValid() bool {
+	switch e {
+	case EventRoundResultOrderAsc, EventRoundResultOrderDesc:
+		return true
+	}
+	return false
+}
+
+func (e EventRoundResultOrder) String() string {
+	return string(e)
+}
+
+func (e *EventRoundResultOrder) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventRoundResultOrder(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventRoundResultOrder", str)
+	}
+	return nil
+}
+
+func (e EventRoundResultOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EventRoundResultOrder) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EventRoundResultOrder) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type EventRoundScoringFormulaType string
+
+const (
+	EventRoundScoringFormulaTypePercentileBands      EventRoundScoringFormulaType = "PERCENTILE_BANDS"
+	EventRoundScoringFormulaTypeLinearDecay          EventRoundScoringFormulaType = "LINEAR_DECAY"
+	EventRoundScoringFormulaTypeProportionalToResult EventRoundScoringFormulaType = "PROPORTIONAL_TO_RESULT"
+)
+
+var AllEventRoundScoringFormulaType = []EventRoundScoringFormulaType{
+	EventRoundScoringFormulaTypePercentileBands,
+	EventRoundScoringFormulaTypeLinearDecay,
+	EventRoundScoringFormulaTypeProportionalToResult,
+}
+
+func (e EventRoundScoringFormulaType) IsValid() bool {
+	switch e {
+	case EventRoundScoringFormulaTypePercentileBands, EventRoundScoringFormulaTypeLinearDecay, EventRoundScoringFormulaTypeProportionalToResult:
+		return true
+	}
+	return false
+}
+
+func (e EventRoundScoringFormulaType) String() string {
+	return string(e)
+}
+
+func (e *EventRoundScoringFormulaType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventRoundScoringFormulaType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventRoundScoringFormulaType", str)
+	}
+	return nil
+}
+
+func (e EventRoundScoringFormulaType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EventRoundScoringFormulaType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EventRoundScoringFormulaType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type EventRoundResultAggregation string
+
+const (
+	EventRoundResultAggregationLatest       EventRoundResultAggregation = "LATEST"
+	EventRoundResultAggregationBest         EventRoundResultAggregation = "BEST"
+	EventRoundResultAggregationSum          EventRoundResultAggregation = "SUM"
+	EventRoundResultAggregationAverageOfTop EventRoundResultAggregation = "AVERAGE_OF_TOP"
+)
+
+var AllEventRoundResultAggregation = []EventRoundResultAggregation{
+	EventRoundResultAggregationLatest,
+	EventRoundResultAggregationBest,
+	EventRoundResultAggregationSum,
+	EventRoundResultAggregationAverageOfTop,
+}
+
+func (e EventRoundResultAggregation) IsValid() bool {
+	switch e {
+	case EventRoundResultAggregationLatest, EventRoundResultAggregationBest, EventRoundResultAggregationSum, EventRoundResultAggregationAverageOfTop:
+		return true
+	}
+	return false
+}
+
+func (e EventRoundResultAggregation) String() string {
+	return string(e)
+}
+
+func (e *EventRoundResultAggregation) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventRoundResultAggregation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventRoundResultAggregation", str)
+	}
+	return nil
+}
+
+func (e EventRoundResultAggregation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EventRoundResultAggregation) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EventRoundResultAggregation) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type EventStatus string
+
+const (
+	EventStatusDraft     EventStatus = "DRAFT"
+	EventStatusScheduled EventStatus = "SCHEDULED"
+	EventStatusLive      EventStatus = "LIVE"
+	EventStatusPaused    EventStatus = "PAUSED"
+	EventStatusEnded     EventStatus = "ENDED"
+	EventStatusCancelled EventStatus = "CANCELLED"
+)
+
+var AllEventStatus = []EventStatus{
+	EventStatusDraft,
+	EventStatusScheduled,
+	EventStatusLive,
+	EventStatusPaused,
+	EventStatusEnded,
+	EventStatusCancelled,
+}
+
+func (e EventStatus) IsValid() bool {
+	switch e {
+	case EventStatusDraft, EventStatusScheduled, EventStatusLive, EventStatusPaused, EventStatusEnded, EventStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (e EventStatus) String() string {
+	return string(e)
+}
+
+func (e *EventStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventStatus", str)
+	}
+	return nil
+}
+
+func (e EventStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EventStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EventStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type EventThirdPartyDeliveryField string
+
+const (
+	EventThirdPartyDeliveryFieldId           EventThirdPartyDeliveryField = "ID"
+	EventThirdPartyDeliveryFieldEventId      EventThirdPartyDeliveryField = "EVENT_ID"
+	EventThirdPartyDeliveryFieldEventUserId  EventThirdPartyDeliveryField = "EVENT_USER_ID"
+	EventThirdPartyDeliveryFieldClientUserId EventThirdPartyDeliveryField = "CLIENT_USER_ID"
+	EventThirdPartyDeliveryFieldResult       EventThirdPartyDeliveryField = "RESULT"
+	EventThirdPartyDeliveryFieldScore        EventThirdPartyDeliveryField = "SCORE"
+	EventThirdPartyDeliveryFieldRanking      EventThirdPartyDeliveryField = "RANKING"
+	EventThirdPartyDeliveryFieldData         EventThirdPartyDeliveryField = "DATA"
+	EventThirdPartyDeliveryFieldCreatedAt    EventThirdPartyDeliveryField = "CREATED_AT"
+	EventThirdPartyDeliveryFieldUpdatedAt    EventThirdPartyDeliveryField = "UPDATED_AT"
+)
+
+var AllEventThirdPartyDeliveryField = []EventThirdPartyDeliveryField{
+	EventThirdPartyDeliveryFieldId,
+	EventThirdPartyDeliveryFieldEventId,
+	EventThirdPartyDeliveryFieldEventUserId,
+	EventThirdPartyDeliveryFieldClientUserId,
+	EventThirdPartyDeliveryFieldResult,
+	EventThirdPartyDeliveryFieldScore,
+	EventThirdPartyDeliveryFieldRanking,
+	EventThirdPartyDeliveryFieldData,
+	EventThirdPartyDeliveryFieldCreatedAt,
+	EventThirdPartyDeliveryFieldUpdatedAt,
+}
+
+func (e EventThirdPartyDeliveryField) IsValid() bool {
+	switch e {
+	case EventThirdPartyDeliveryFieldId, EventThirdPartyDeliveryFieldEventId, EventThirdPartyDeliveryFieldEventUserId, EventThirdPartyDeliveryFieldClientUserId, EventThirdPartyDeliveryFieldResult, EventThirdPartyDeliveryFieldScore, EventThirdPartyDeliveryFieldRanking, EventThirdPartyDeliveryFieldData, EventThirdPartyDeliveryFieldCreatedAt, EventThirdPartyDeliveryFieldUpdatedAt:
+		return true
+	}
+	return false
+}
+
+func (e EventThirdPartyDeliveryField) String() string {
+	return string(e)
+}
+
+func (e *EventThirdPartyDeliveryField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventThirdPartyDeliveryField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventThirdPartyDeliveryField", str)
+	}
+	return nil
+}
+
+func (e EventThirdPartyDeliveryField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EventThirdPartyDeliveryField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EventThirdPartyDeliveryField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
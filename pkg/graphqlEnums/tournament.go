@@ -14,6 +14,7 @@ const (
 	TournamentIntervalWeekly    TournamentInterval = "WEEKLY"
 	TournamentIntervalMonthly   TournamentInterval = "MONTHLY"
 	TournamentIntervalUnlimited TournamentInterval = "UNLIMITED"
+	TournamentIntervalCustom    TournamentInterval = "CUSTOM"
 )
 
 var AllTournamentInterval = []TournamentInterval{
@@ -21,11 +22,12 @@ var AllTournamentInterval = []TournamentInterval{
 	TournamentIntervalWeekly,
 	TournamentIntervalMonthly,
 	TournamentIntervalUnlimited,
+	TournamentIntervalCustom,
 }
 
 func (e TournamentInterval) IsValid() bool {
 	switch e {
-	case TournamentIntervalDaily, TournamentIntervalWeekly, TournamentIntervalMonthly, TournamentIntervalUnlimited:
+	case TournamentIntervalDaily, TournamentIntervalWeekly, TournamentIntervalMonthly, TournamentIntervalUnlimited, TournamentIntervalCustom:
 		return true
 	}
 	return false
@@ -65,3 +67,343 @@ func (e TournamentInterval) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type TournamentScoreMode string
+
+const (
+	TournamentScoreModeSet       TournamentScoreMode = "SET"
+	TournamentScoreModeIncrement TournamentScoreMode = "INCREMENT"
+	TournamentScoreModeMax       TournamentScoreMode = "MAX"
+	TournamentScoreModeMin       TournamentScoreMode = "MIN"
+)
+
+var AllTournamentScoreMode = []TournamentScoreMode{
+	TournamentScoreModeSet,
+	TournamentScoreModeIncrement,
+	TournamentScoreModeMax,
+	TournamentScoreModeMin,
+}
+
+func (e TournamentScoreMode) IsValid() bool {
+	switch e {
+	case TournamentScoreModeSet, TournamentScoreModeIncrement, TournamentScoreModeMax, TournamentScoreModeMin:
+		return true
+	}
+	return false
+}
+
+func (e TournamentScoreMode) String() string {
+	return string(e)
+}
+
+func (e *TournamentScoreMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TournamentScoreMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TournamentScoreMode", str)
+	}
+	return nil
+}
+
+func (e TournamentScoreMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TournamentScoreMode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TournamentScoreMode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TournamentBracketFormat string
+
+const (
+	TournamentBracketFormatSingleElimination TournamentBracketFormat = "SINGLE_ELIMINATION"
+	TournamentBracketFormatDoubleElimination TournamentBracketFormat = "DOUBLE_ELIMINATION"
+)
+
+var AllTournamentBracketFormat = []TournamentBracketFormat{
+	TournamentBracketFormatSingleElimination,
+	TournamentBracketFormatDoubleElimination,
+}
+
+func (e TournamentBracketFormat) IsValid() bool {
+	switch e {
+	case TournamentBracketFormatSingleElimination, TournamentBracketFormatDoubleElimination:
+		return true
+	}
+	return false
+}
+
+func (e TournamentBracketFormat) String() string {
+	return string(e)
+}
+
+func (e *TournamentBracketFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TournamentBracketFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TournamentBracketFormat", str)
+	}
+	return nil
+}
+
+func (e TournamentBracketFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TournamentBracketFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TournamentBracketFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TournamentBracketSeeding string
+
+const (
+	TournamentBracketSeedingGivenOrder      TournamentBracketSeeding = "GIVEN_ORDER"
+	TournamentBracketSeedingTournamentScore TournamentBracketSeeding = "TOURNAMENT_SCORE"
+	TournamentBracketSeedingMatchmakingElo  TournamentBracketSeeding = "MATCHMAKING_ELO"
+)
+
+var AllTournamentBracketSeeding = []TournamentBracketSeeding{
+	TournamentBracketSeedingGivenOrder,
+	TournamentBracketSeedingTournamentScore,
+	TournamentBracketSeedingMatchmakingElo,
+}
+
+func (e TournamentBracketSeeding) IsValid() bool {
+	switch e {
+	case TournamentBracketSeedingGivenOrder, TournamentBracketSeedingTournamentScore, TournamentBracketSeedingMatchmakingElo:
+		return true
+	}
+	return false
+}
+
+func (e TournamentBracketSeeding) String() string {
+	return string(e)
+}
+
+func (e *TournamentBracketSeeding) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TournamentBracketSeeding(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TournamentBracketSeeding", str)
+	}
+	return nil
+}
+
+func (e TournamentBracketSeeding) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TournamentBracketSeeding) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TournamentBracketSeeding) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TournamentBracketSide string
+
+const (
+	TournamentBracketSideWinners    TournamentBracketSide = "WINNERS"
+	TournamentBracketSideLosers     TournamentBracketSide = "LOSERS"
+	TournamentBracketSideGrandFinal TournamentBracketSide = "GRAND_FINAL"
+)
+
+var AllTournamentBracketSide = []TournamentBracketSide{
+	TournamentBracketSideWinners,
+	TournamentBracketSideLosers,
+	TournamentBracketSideGrandFinal,
+}
+
+func (e TournamentBracketSide) IsValid() bool {
+	switch e {
+	case TournamentBracketSideWinners, TournamentBracketSideLosers, TournamentBracketSideGrandFinal:
+		return true
+	}
+	return false
+}
+
+func (e TournamentBracketSide) String() string {
+	return string(e)
+}
+
+func (e *TournamentBracketSide) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TournamentBracketSide(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TournamentBracketSide", str)
+	}
+	return nil
+}
+
+func (e TournamentBracketSide) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TournamentBracketSide) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TournamentBracketSide) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TournamentPairingFormat string
+
+const (
+	TournamentPairingFormatSwiss      TournamentPairingFormat = "SWISS"
+	TournamentPairingFormatRoundRobin TournamentPairingFormat = "ROUND_ROBIN"
+)
+
+var AllTournamentPairingFormat = []TournamentPairingFormat{
+	TournamentPairingFormatSwiss,
+	TournamentPairingFormatRoundRobin,
+}
+
+func (e TournamentPairingFormat) IsValid() bool {
+	switch e {
+	case TournamentPairingFormatSwiss, TournamentPairingFormatRoundRobin:
+		return true
+	}
+	return false
+}
+
+func (e TournamentPairingFormat) String() string {
+	return string(e)
+}
+
+func (e *TournamentPairingFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TournamentPairingFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TournamentPairingFormat", str)
+	}
+	return nil
+}
+
+func (e TournamentPairingFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TournamentPairingFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TournamentPairingFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TournamentPairingResult string
+
+const (
+	TournamentPairingResultFirstWon  TournamentPairingResult = "FIRST_WON"
+	TournamentPairingResultSecondWon TournamentPairingResult = "SECOND_WON"
+	TournamentPairingResultDraw      TournamentPairingResult = "DRAW"
+)
+
+var AllTournamentPairingResult = []TournamentPairingResult{
+	TournamentPairingResultFirstWon,
+	TournamentPairingResultSecondWon,
+	TournamentPairingResultDraw,
+}
+
+func (e TournamentPairingResult) IsValid() bool {
+	switch e {
+	case TournamentPairingResultFirstWon, TournamentPairingResultSecondWon, TournamentPairingResultDraw:
+		return true
+	}
+	return false
+}
+
+func (e TournamentPairingResult) String() string {
+	return string(e)
+}
+
+func (e *TournamentPairingResult) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TournamentPairingResult(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TournamentPairingResult", str)
+	}
+	return nil
+}
+
+func (e TournamentPairingResult) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TournamentPairingResult) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TournamentPairingResult) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,115 @@
+package matchmakingTeam
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// Teams is the team composition of an arena as it is stored, which splits the players of a match evenly between the teams
+type Teams struct {
+	Count       uint32 `json:"count"`
+	RoleDataKey string `json:"roleDataKey,omitempty"`
+	Roles       []Role `json:"roles,omitempty"`
+}
+
+// Role is a role every team has the given number of slots for
+type Role struct {
+	Name  string `json:"name"`
+	Count uint32 `json:"count"`
+}
+
+// Player is a player already in a match, with the team they are on and the roles they can fill
+type Player struct {
+	Team  uint32
+	Roles []string
+}
+
+// Size returns the number of players on each team of a match with the given maximum number of players
+func (t Teams) Size(maxPlayers uint32) uint32 {
+	if t.Count == 0 {
+		return 0
+	}
+	return maxPlayers / t.Count
+}
+
+// GetRoles returns the roles a user can fill from the role data key of their data, which is either a single role or a list of roles
+func GetRoles(data json.RawMessage, roleDataKey string) ([]string, error) {
+	var userData map[string]interface{}
+	err := json.Unmarshal(data, &userData)
+	if err != nil {
+		return nil, err
+	}
+	switch value := userData[roleDataKey].(type) {
+	case string:
+		return []string{value}, nil
+	case []interface{}:
+		roles := []string{}
+		for _, role := range value {
+			if roleString, ok := role.(string); ok {
+				roles = append(roles, roleString)
+			}
+		}
+		return roles, nil
+	}
+	// A user without a role cannot fill any role slot
+	return []string{}, nil
+}
+
+// AssignTeam returns the team to put a party on, keeping the party together on a team with room for all of it and with a role slot for every player.
+// The team with the fewest players is chosen so teams fill up evenly, teams are numbered from one and false is returned if no team can take the party.
+func AssignTeam(teams Teams, maxPlayers uint32, players []Player, party [][]string) (uint32, bool) {
+	size := teams.Size(maxPlayers)
+	playerCounts := make([]uint32, teams.Count+1)
+	teamRoles := make([][][]string, teams.Count+1)
+	for _, player := range players {
+		if player.Team == 0 || player.Team > teams.Count {
+			continue
+		}
+		playerCounts[player.Team]++
+		teamRoles[player.Team] = append(teamRoles[player.Team], player.Roles)
+	}
+	assignedTeam := uint32(0)
+	for team := uint32(1); team <= teams.Count; team++ {
+		if playerCounts[team]+uint32(len(party)) > size {
+			continue
+		}
+		if len(teams.Roles) > 0 && !canFillRoles(teams.Roles, append(append([][]string{}, teamRoles[team]...), party...)) {
+			continue
+		}
+		if assignedTeam == 0 || playerCounts[team] < playerCounts[assignedTeam] {
+			assignedTeam = team
+		}
+	}
+	return assignedTeam, assignedTeam != 0
+}
+
+// canFillRoles returns whether every player can be given a different role slot out of the ones they can fill
+func canFillRoles(roles []Role, players [][]string) bool {
+	slots := map[string]uint32{}
+	for _, role := range roles {
+		slots[role.Name] += role.Count
+	}
+	// Players with the fewest roles are placed first, as they have the least choice
+	sort.SliceStable(players, func(i, j int) bool {
+		return len(players[i]) < len(players[j])
+	})
+	return fillRoles(slots, players)
+}
+
+func fillRoles(slots map[string]uint32, players [][]string) bool {
+	if len(players) == 0 {
+		return true
+	}
+	for _, role := range players[0] {
+		if slots[role] == 0 {
+			continue
+		}
+		slots[role]--
+		filled := fillRoles(slots, players[1:])
+		slots[role]++
+		if filled {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package matchmakingTeam
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var fiveVersusFive = Teams{
+	Count:       2,
+	RoleDataKey: "role",
+	Roles: []Role{
+		{Name: "tank", Count: 1},
+		{Name: "healer", Count: 1},
+		{Name: "dps", Count: 3},
+	},
+}
+
+func Test_Size_MaxPlayers_PlayersPerTeam(t *testing.T) {
+	size := fiveVersusFive.Size(10)
+	if size != 5 {
+		t.Fatalf("Expected team size to be 5, got %d", size)
+	}
+}
+
+func Test_GetRoles_SingleRole_Role(t *testing.T) {
+	roles, err := GetRoles(json.RawMessage(`{"role": "tank"}`), "role")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) != 1 || roles[0] != "tank" {
+		t.Fatalf("Expected roles to be [tank], got %v", roles)
+	}
+}
+
+func Test_GetRoles_ListOfRoles_Roles(t *testing.T) {
+	roles, err := GetRoles(json.RawMessage(`{"role": ["tank", "dps"]}`), "role")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) != 2 || roles[0] != "tank" || roles[1] != "dps" {
+		t.Fatalf("Expected roles to be [tank dps], got %v", roles)
+	}
+}
+
+func Test_GetRoles_NoRole_NoRoles(t *testing.T) {
+	roles, err := GetRoles(json.RawMessage(`{"level": 3}`), "role")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) != 0 {
+		t.Fatalf("Expected no roles, got %v", roles)
+	}
+}
+
+func Test_AssignTeam_EmptyMatch_FirstTeam(t *testing.T) {
+	team, ok := AssignTeam(fiveVersusFive, 10, nil, [][]string{{"tank"}, {"healer"}})
+	if !ok || team != 1 {
+		t.Fatalf("Expected party to be assigned to team 1, got %d", team)
+	}
+}
+
+func Test_AssignTeam_FirstTeamHasMorePlayers_SecondTeam(t *testing.T) {
+	players := []Player{
+		{Team: 1, Roles: []string{"tank"}},
+	}
+	team, ok := AssignTeam(fiveVersusFive, 10, players, [][]string{{"dps"}})
+	if !ok || team != 2 {
+		t.Fatalf("Expected party to be assigned to team 2, got %d", team)
+	}
+}
+
+func Test_AssignTeam_RoleTakenOnEmptierTeam_TeamWithRoleSlot(t *testing.T) {
+	players := []Player{
+		{Team: 1, Roles: []string{"tank"}},
+		{Team: 1, Roles: []string{"dps"}},
+		{Team: 2, Roles: []string{"healer"}},
+	}
+	team, ok := AssignTeam(fiveVersusFive, 10, players, [][]string{{"healer"}})
+	if !ok || team != 1 {
+		t.Fatalf("Expected party to be assigned to team 1, got %d", team)
+	}
+}
+
+func Test_AssignTeam_PartyTooBigForRemainingSpace_NotAssigned(t *testing.T) {
+	players := []Player{
+		{Team: 1, Roles: []string{"tank"}},
+		{Team: 1, Roles: []string{"healer"}},
+		{Team: 1, Roles: []string{"dps"}},
+		{Team: 2, Roles: []string{"tank"}},
+		{Team: 2, Roles: []string{"healer"}},
+		{Team: 2, Roles: []string{"dps"}},
+	}
+	_, ok := AssignTeam(fiveVersusFive, 10, players, [][]string{{"dps"}, {"dps"}, {"dps"}})
+	if ok {
+		t.Fatal("Expected party not to be assigned to a team")
+	}
+}
+
+func Test_AssignTeam_NoRoleSlotLeft_NotAssigned(t *testing.T) {
+	players := []Player{
+		{Team: 1, Roles: []string{"healer"}},
+		{Team: 2, Roles: []string{"healer"}},
+	}
+	_, ok := AssignTeam(fiveVersusFive, 10, players, [][]string{{"healer"}})
+	if ok {
+		t.Fatal("Expected party not to be assigned to a team")
+	}
+}
+
+func Test_AssignTeam_FlexiblePlayerTakesOtherRole_Assigned(t *testing.T) {
+	players := []Player{
+		{Team: 1, Roles: []string{"tank", "healer"}},
+		{Team: 2, Roles: []string{"healer"}},
+		{Team: 2, Roles: []string{"tank"}},
+	}
+	team, ok := AssignTeam(fiveVersusFive, 10, players, [][]string{{"tank"}})
+	if !ok || team != 1 {
+		t.Fatalf("Expected party to be assigned to team 1, got %d", team)
+	}
+}
+
+func Test_AssignTeam_NoRoles_OnlyTeamSizeChecked(t *testing.T) {
+	teams := Teams{Count: 2}
+	players := []Player{
+		{Team: 1},
+		{Team: 1},
+	}
+	team, ok := AssignTeam(teams, 4, players, [][]string{{}, {}})
+	if !ok || team != 2 {
+		t.Fatalf("Expected party to be assigned to team 2, got %d", team)
+	}
+}
@@ -78,23 +78,25 @@ func (s *Server) Connect(t *testing.T) *sql.Tx {
 	return tx
 }
 
-func NewServer(schemaPath string) *Server {
+func NewServer(schemaPaths ...string) *Server {
 	var err error
 	s, err := GetServer()
 	if err != nil {
 		log.Fatalf("could not run mysql test server: %v", err)
 	}
-	schema, err := os.ReadFile(schemaPath)
-	if err != nil {
-		s.Close()
-		log.Fatalf("could not read schema file: %v", err)
-	}
-	conn := s.GetConnection()
-	_, err = conn.Db.Exec(string(schema))
-	conn.Db.Close()
-	if err != nil {
-		s.Close()
-		log.Fatalf("could not execute schema: %v", err)
+	for _, schemaPath := range schemaPaths {
+		schema, err := os.ReadFile(schemaPath)
+		if err != nil {
+			s.Close()
+			log.Fatalf("could not read schema file: %v", err)
+		}
+		conn := s.GetConnection()
+		_, err = conn.Db.Exec(string(schema))
+		conn.Db.Close()
+		if err != nil {
+			s.Close()
+			log.Fatalf("could not execute schema: %v", err)
+		}
 	}
 	return s
 }
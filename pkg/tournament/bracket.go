@@ -0,0 +1,205 @@
+package tournament
+
+import "errors"
+
+type BracketFormat uint8
+
+const (
+	SingleElimination BracketFormat = iota
+	DoubleElimination
+)
+
+type BracketSide uint8
+
+const (
+	WinnersSide BracketSide = iota
+	LosersSide
+	GrandFinalSide
+)
+
+var ErrNotEnoughParticipants = errors.New("a bracket needs at least two participants")
+
+// BracketSlot is one of the two participant slots of a match in a bracket
+type BracketSlot struct {
+	Match int
+	Slot  int
+}
+
+type BracketMatch struct {
+	Side     BracketSide
+	Round    uint32
+	Position uint32
+	// Seeds are the seeds placed in each slot when the bracket is created, or 0 if the slot is filled by an earlier match
+	Seeds [2]int
+	// WinnerTo and LoserTo are the slots the winner and loser of the match move to, nil if they leave the bracket
+	WinnerTo *BracketSlot
+	LoserTo  *BracketSlot
+	// EndsIfFirstWins is set on the grand final of a double elimination bracket. The participant from the winners bracket has not lost yet,
+	// so the bracket ends if they win, otherwise both participants move on to the reset match.
+	EndsIfFirstWins bool
+}
+
+type bracketSourceKind uint8
+
+const (
+	emptySource bracketSourceKind = iota
+	seedSource
+	winnerSource
+	loserSource
+)
+
+// bracketSource is where the participant of a slot comes from
+type bracketSource struct {
+	kind  bracketSourceKind
+	value int
+}
+
+type rawBracketMatch struct {
+	side     BracketSide
+	round    uint32
+	position uint32
+	sources  [2]bracketSource
+}
+
+// NewBracket returns the matches of a bracket for the number of participants, where seed 1 is the strongest participant.
+// Matches are ordered so every match comes after the matches that fill its slots.
+// Participants without an opponent in the first round move on without playing, and so do the participants they would meet in the losers bracket.
+// Double elimination brackets end with a grand final and a reset match, which is only played if the participant from the losers bracket wins the grand final.
+func NewBracket(format BracketFormat, participants int) ([]BracketMatch, error) {
+	if participants < 2 {
+		return nil, ErrNotEnoughParticipants
+	}
+	size := 2
+	for size < participants {
+		size *= 2
+	}
+	var raw []rawBracketMatch
+	addRound := func(side BracketSide, round uint32, sources []bracketSource) []bracketSource {
+		winners := make([]bracketSource, 0, len(sources)/2)
+		for i := 0; i+1 < len(sources); i += 2 {
+			raw = append(raw, rawBracketMatch{
+				side:     side,
+				round:    round,
+				position: uint32(i / 2),
+				sources:  [2]bracketSource{sources[i], sources[i+1]},
+			})
+			winners = append(winners, bracketSource{kind: winnerSource, value: len(raw) - 1})
+		}
+		return winners
+	}
+	losersOf := func(winners []bracketSource) []bracketSource {
+		losers := make([]bracketSource, len(winners))
+		for i, winner := range winners {
+			losers[i] = bracketSource{kind: loserSource, value: winner.value}
+		}
+		return losers
+	}
+	// Winners bracket
+	order := seedOrder(size)
+	sources := make([]bracketSource, size)
+	for i, seed := range order {
+		if seed <= participants {
+			sources[i] = bracketSource{kind: seedSource, value: seed}
+		}
+	}
+	var winnersRounds [][]bracketSource
+	for round := uint32(1); len(sources) > 1; round++ {
+		sources = addRound(WinnersSide, round, sources)
+		winnersRounds = append(winnersRounds, sources)
+	}
+	if format == DoubleElimination {
+		// Losers bracket, where the losers of each winners round after the first drop in against the survivors of the losers bracket
+		losers := losersOf(winnersRounds[0])
+		round := uint32(1)
+		if len(losers) > 1 {
+			losers = addRound(LosersSide, round, losers)
+			round++
+		}
+		for i := 1; i < len(winnersRounds); i++ {
+			dropped := losersOf(winnersRounds[i])
+			// Alternate the order participants drop in, so they are less likely to meet the participant they lost to again
+			if i%2 == 1 {
+				for j, k := 0, len(dropped)-1; j < k; j, k = j+1, k-1 {
+					dropped[j], dropped[k] = dropped[k], dropped[j]
+				}
+			}
+			interleaved := make([]bracketSource, 0, len(losers)*2)
+			for j := range losers {
+				interleaved = append(interleaved, losers[j], dropped[j])
+			}
+			losers = addRound(LosersSide, round, interleaved)
+			round++
+			if len(losers) > 1 {
+				losers = addRound(LosersSide, round, losers)
+				round++
+			}
+		}
+		final := addRound(GrandFinalSide, 1, []bracketSource{sources[0], losers[0]})
+		addRound(GrandFinalSide, 2, []bracketSource{final[0], {kind: loserSource, value: final[0].value}})
+		matches := resolveBracket(raw)
+		matches[len(matches)-2].EndsIfFirstWins = true
+		return matches, nil
+	}
+	return resolveBracket(raw), nil
+}
+
+// seedOrder returns the seeds in the order they are placed in the first round, so the strongest seeds meet as late as possible
+func seedOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		next := make([]int, 0, len(order)*2)
+		for _, seed := range order {
+			next = append(next, seed, len(order)*2+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// resolveBracket removes the matches that are missing a participant, moving the participant they have straight to the next match
+func resolveBracket(raw []rawBracketMatch) []BracketMatch {
+	winners := make([]bracketSource, len(raw))
+	losers := make([]bracketSource, len(raw))
+	resolve := func(source bracketSource) bracketSource {
+		switch source.kind {
+		case winnerSource:
+			return winners[source.value]
+		case loserSource:
+			return losers[source.value]
+		}
+		return source
+	}
+	var matches []BracketMatch
+	var sources [][2]bracketSource
+	for i, match := range raw {
+		first, second := resolve(match.sources[0]), resolve(match.sources[1])
+		if first.kind == emptySource || second.kind == emptySource {
+			if first.kind == emptySource {
+				first = second
+			}
+			winners[i] = first
+			continue
+		}
+		matches = append(matches, BracketMatch{
+			Side:     match.side,
+			Round:    match.round,
+			Position: match.position,
+		})
+		sources = append(sources, [2]bracketSource{first, second})
+		winners[i] = bracketSource{kind: winnerSource, value: len(matches) - 1}
+		losers[i] = bracketSource{kind: loserSource, value: len(matches) - 1}
+	}
+	for i, slots := range sources {
+		for slot, source := range slots {
+			switch source.kind {
+			case seedSource:
+				matches[i].Seeds[slot] = source.value
+			case winnerSource:
+				matches[source.value].WinnerTo = &BracketSlot{Match: i, Slot: slot}
+			case loserSource:
+				matches[source.value].LoserTo = &BracketSlot{Match: i, Slot: slot}
+			}
+		}
+	}
+	return matches
+}
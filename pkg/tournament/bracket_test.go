@@ -0,0 +1,234 @@
+package tournament
+
+import "testing"
+
+// playBracket plays every match of the bracket in order and returns the champion and the number of matches each seed lost
+func playBracket(t *testing.T, matches []BracketMatch, winner func(first int, second int) int) (int, map[int]int) {
+	slots := make([][2]int, len(matches))
+	for i, match := range matches {
+		slots[i] = match.Seeds
+	}
+	losses := map[int]int{}
+	champion := 0
+	skipped := map[int]bool{}
+	for i, match := range matches {
+		if skipped[i] {
+			continue
+		}
+		first, second := slots[i][0], slots[i][1]
+		if first == 0 || second == 0 {
+			t.Fatalf("match %d was played without two participants", i)
+		}
+		won := winner(first, second)
+		lost := first
+		if won == first {
+			lost = second
+		}
+		losses[lost]++
+		if match.EndsIfFirstWins && won == first {
+			if champion != 0 {
+				t.Fatalf("bracket has more than one final")
+			}
+			champion = won
+			skipped[match.WinnerTo.Match] = true
+			continue
+		}
+		if match.WinnerTo == nil {
+			if champion != 0 {
+				t.Fatalf("bracket has more than one final")
+			}
+			champion = won
+		} else {
+			if match.WinnerTo.Match <= i {
+				t.Fatalf("match %d moves its winner to an earlier match", i)
+			}
+			slots[match.WinnerTo.Match][match.WinnerTo.Slot] = won
+		}
+		if match.LoserTo != nil {
+			if match.LoserTo.Match <= i {
+				t.Fatalf("match %d moves its loser to an earlier match", i)
+			}
+			slots[match.LoserTo.Match][match.LoserTo.Slot] = lost
+		}
+	}
+	if champion == 0 {
+		t.Fatalf("bracket has no final")
+	}
+	return champion, losses
+}
+
+func lowerSeedWins(first int, second int) int {
+	if first < second {
+		return first
+	}
+	return second
+}
+
+func higherSeedWins(first int, second int) int {
+	if first > second {
+		return first
+	}
+	return second
+}
+
+func Test_NewBracket_OneParticipant_Error(t *testing.T) {
+	_, err := NewBracket(SingleElimination, 1)
+	if err != ErrNotEnoughParticipants {
+		t.Fatalf("Expected error to be %v, got %v", ErrNotEnoughParticipants, err)
+	}
+}
+
+func Test_NewBracket_SingleEliminationFourParticipants_SeedsMeetInFinal(t *testing.T) {
+	matches, err := NewBracket(SingleElimination, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 3 {
+		t.Fatalf("Expected 3 matches, got %d", len(matches))
+	}
+	if matches[0].Seeds != [2]int{1, 4} {
+		t.Fatalf("Expected first match to be 1 against 4, got %v", matches[0].Seeds)
+	}
+	if matches[1].Seeds != [2]int{2, 3} {
+		t.Fatalf("Expected second match to be 2 against 3, got %v", matches[1].Seeds)
+	}
+	if matches[2].Round != 2 || matches[2].Seeds != [2]int{0, 0} {
+		t.Fatalf("Expected final to be filled by the first round, got %+v", matches[2])
+	}
+}
+
+func Test_NewBracket_SingleEliminationThreeParticipants_TopSeedHasBye(t *testing.T) {
+	matches, err := NewBracket(SingleElimination, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 2 {
+		t.Fatalf("Expected 2 matches, got %d", len(matches))
+	}
+	if matches[0].Seeds != [2]int{2, 3} {
+		t.Fatalf("Expected first match to be 2 against 3, got %v", matches[0].Seeds)
+	}
+	if matches[1].Seeds != [2]int{1, 0} {
+		t.Fatalf("Expected seed 1 to be placed in the final, got %v", matches[1].Seeds)
+	}
+	if *matches[0].WinnerTo != (BracketSlot{Match: 1, Slot: 1}) {
+		t.Fatalf("Expected winner of the first match to move to the final, got %+v", matches[0].WinnerTo)
+	}
+}
+
+func Test_NewBracket_SingleElimination_EveryoneButChampionLosesOnce(t *testing.T) {
+	for participants := 2; participants <= 33; participants++ {
+		matches, err := NewBracket(SingleElimination, participants)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(matches) != participants-1 {
+			t.Fatalf("Expected %d matches for %d participants, got %d", participants-1, participants, len(matches))
+		}
+		champion, losses := playBracket(t, matches, lowerSeedWins)
+		if champion != 1 {
+			t.Fatalf("Expected seed 1 to win with %d participants, got %d", participants, champion)
+		}
+		for seed := 2; seed <= participants; seed++ {
+			if losses[seed] != 1 {
+				t.Fatalf("Expected seed %d to lose once with %d participants, lost %d", seed, participants, losses[seed])
+			}
+		}
+	}
+}
+
+func Test_NewBracket_DoubleElimination_EveryoneButChampionLosesTwice(t *testing.T) {
+	for participants := 2; participants <= 33; participants++ {
+		matches, err := NewBracket(DoubleElimination, participants)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The reset match is included, but only played if the participant from the losers bracket wins the grand final
+		if len(matches) != 2*participants-1 {
+			t.Fatalf("Expected %d matches for %d participants, got %d", 2*participants-1, participants, len(matches))
+		}
+		champion, losses := playBracket(t, matches, lowerSeedWins)
+		if champion != 1 {
+			t.Fatalf("Expected seed 1 to win with %d participants, got %d", participants, champion)
+		}
+		for seed := 2; seed <= participants; seed++ {
+			if losses[seed] != 2 {
+				t.Fatalf("Expected seed %d to lose twice with %d participants, lost %d", seed, participants, losses[seed])
+			}
+		}
+	}
+}
+
+func Test_NewBracket_DoubleEliminationUpsets_LosersBracketChampionWins(t *testing.T) {
+	matches, err := NewBracket(DoubleElimination, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	final := matches[len(matches)-2]
+	if final.Side != GrandFinalSide || !final.EndsIfFirstWins {
+		t.Fatalf("Expected second to last match to be the grand final, got %+v", final)
+	}
+	// Seed 1 loses its first match to seed 8 and then wins every match through the losers bracket
+	played := false
+	winner := func(first int, second int) int {
+		if first == 1 || second == 1 {
+			if !played {
+				played = true
+				return higherSeedWins(first, second)
+			}
+			return 1
+		}
+		return lowerSeedWins(first, second)
+	}
+	champion, losses := playBracket(t, matches, winner)
+	if champion != 1 {
+		t.Fatalf("Expected seed 1 to win, got %d", champion)
+	}
+	if losses[1] != 1 {
+		t.Fatalf("Expected seed 1 to lose once, lost %d", losses[1])
+	}
+}
+
+func Test_NewBracket_DoubleEliminationWinnersBracketChampionWinsGrandFinal_NoReset(t *testing.T) {
+	matches, err := NewBracket(DoubleElimination, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reset := matches[len(matches)-1]
+	if reset.Side != GrandFinalSide || reset.Round != 2 || reset.WinnerTo != nil || reset.LoserTo != nil {
+		t.Fatalf("Expected last match to be the reset match, got %+v", reset)
+	}
+	played := 0
+	champion, losses := playBracket(t, matches, func(first int, second int) int {
+		played++
+		return lowerSeedWins(first, second)
+	})
+	if played != len(matches)-1 {
+		t.Fatalf("Expected the reset match to be skipped, played %d of %d", played, len(matches))
+	}
+	if champion != 1 || losses[1] != 0 {
+		t.Fatalf("Expected seed 1 to win without losing, got %d with %d losses", champion, losses[1])
+	}
+}
+
+func Test_NewBracket_DoubleEliminationLosersBracketChampionWinsGrandFinal_ResetPlayed(t *testing.T) {
+	matches, err := NewBracket(DoubleElimination, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Seed 2 comes through the losers bracket and wins the grand final, then seed 1 wins the reset
+	played := 0
+	champion, losses := playBracket(t, matches, func(first int, second int) int {
+		played++
+		if played == len(matches)-1 {
+			return 2
+		}
+		return lowerSeedWins(first, second)
+	})
+	if played != len(matches) {
+		t.Fatalf("Expected the reset match to be played, played %d of %d", played, len(matches))
+	}
+	if champion != 1 || losses[1] != 1 || losses[2] != 2 {
+		t.Fatalf("Expected seed 1 to win the reset, got %d with losses %v", champion, losses)
+	}
+}
@@ -0,0 +1,265 @@
+package tournament
+
+import (
+	"errors"
+	"sort"
+)
+
+type PairingOutcome uint8
+
+const (
+	FirstWon PairingOutcome = iota
+	SecondWon
+	Draw
+)
+
+var ErrNoPairing = errors.New("participants cannot be paired without a rematch")
+
+// swissPairingBudget limits how many partial pairings are tried before giving up, so the backtracking stays bounded with many participants
+const swissPairingBudget = 10000
+
+// SwissByePoints is what a bye scores in a swiss tournament, where it counts as a win
+const SwissByePoints = 1
+
+// Pairing is a game between two participants, where a second participant of 0 is a bye
+type Pairing struct {
+	First  uint64
+	Second uint64
+}
+
+// PairingGame is a pairing of an earlier round, where the outcome is only counted if the game is completed
+type PairingGame struct {
+	Pairing
+	Outcome   PairingOutcome
+	Completed bool
+}
+
+type Standing struct {
+	UserID          uint64
+	Points          float64
+	Buchholz        float64
+	SonnebornBerger float64
+	Wins            uint32
+	Draws           uint32
+	Losses          uint32
+	Ranking         uint64
+}
+
+// RoundRobinRounds returns every round of a round robin between the participants, where every participant plays every other participant once.
+// If the number of participants is odd, every participant gets a bye in one of the rounds.
+func RoundRobinRounds(participants []uint64) ([][]Pairing, error) {
+	if len(participants) < 2 {
+		return nil, ErrNotEnoughParticipants
+	}
+	circle := append([]uint64(nil), participants...)
+	if len(circle)%2 == 1 {
+		circle = append(circle, 0)
+	}
+	n := len(circle)
+	rounds := make([][]Pairing, n-1)
+	for round := range rounds {
+		pairings := make([]Pairing, 0, n/2)
+		for i := 0; i < n/2; i++ {
+			first, second := circle[i], circle[n-1-i]
+			// Swap the first board every other round so the fixed participant does not always play first
+			if i == 0 && round%2 == 1 {
+				first, second = second, first
+			}
+			if first == 0 {
+				first, second = second, first
+			}
+			pairings = append(pairings, Pairing{First: first, Second: second})
+		}
+		rounds[round] = pairings
+		// Keep the first participant in place and rotate the others
+		last := circle[n-1]
+		copy(circle[2:], circle[1:n-1])
+		circle[1] = last
+	}
+	return rounds, nil
+}
+
+// SwissPairings returns the pairings of the next round of a swiss tournament, where participants are in seed order.
+// Participants are paired greedily with others on the same points where possible, the top half of each group against the bottom half, without playing anyone twice.
+// Earlier pairings are only revisited when the rest cannot be paired, and only a limited number of times before ErrNoPairing is returned.
+// If the number of participants is odd, the lowest ranked participant without a bye gets one.
+func SwissPairings(participants []uint64, games []PairingGame) ([]Pairing, error) {
+	if len(participants) < 2 {
+		return nil, ErrNotEnoughParticipants
+	}
+	points := map[uint64]float64{}
+	played := map[Pairing]bool{}
+	hadBye := map[uint64]bool{}
+	for _, game := range games {
+		if game.Second == 0 {
+			hadBye[game.First] = true
+		} else {
+			played[Pairing{First: game.First, Second: game.Second}] = true
+			played[Pairing{First: game.Second, Second: game.First}] = true
+		}
+		if game.Completed {
+			first, second := gamePoints(game, SwissByePoints)
+			points[game.First] += first
+			points[game.Second] += second
+		}
+	}
+	ranked := append([]uint64(nil), participants...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return points[ranked[i]] > points[ranked[j]]
+	})
+	budget := swissPairingBudget
+	var pair func(remaining []uint64) []Pairing
+	pair = func(remaining []uint64) []Pairing {
+		if len(remaining) == 0 {
+			return []Pairing{}
+		}
+		if budget == 0 {
+			return nil
+		}
+		budget--
+		first := remaining[0]
+		// Prefer the opponent halfway down the participants on the same points, then those below, then those above
+		group := 1
+		for group < len(remaining) && points[remaining[group]] == points[first] {
+			group++
+		}
+		start := group / 2
+		if start == 0 {
+			start = 1
+		}
+		candidates := make([]int, 0, len(remaining)-1)
+		for i := start; i < len(remaining); i++ {
+			candidates = append(candidates, i)
+		}
+		for i := start - 1; i >= 1; i-- {
+			candidates = append(candidates, i)
+		}
+		for _, i := range candidates {
+			second := remaining[i]
+			if played[Pairing{First: first, Second: second}] {
+				continue
+			}
+			rest := make([]uint64, 0, len(remaining)-2)
+			rest = append(rest, remaining[1:i]...)
+			rest = append(rest, remaining[i+1:]...)
+			if pairings := pair(rest); pairings != nil {
+				return append([]Pairing{{First: first, Second: second}}, pairings...)
+			}
+		}
+		return nil
+	}
+	if len(ranked)%2 == 0 {
+		pairings := pair(ranked)
+		if pairings == nil {
+			return nil, ErrNoPairing
+		}
+		return pairings, nil
+	}
+	for i := len(ranked) - 1; i >= 0; i-- {
+		if hadBye[ranked[i]] {
+			continue
+		}
+		rest := make([]uint64, 0, len(ranked)-1)
+		rest = append(rest, ranked[:i]...)
+		rest = append(rest, ranked[i+1:]...)
+		if pairings := pair(rest); pairings != nil {
+			return append(pairings, Pairing{First: ranked[i]}), nil
+		}
+	}
+	return nil, ErrNoPairing
+}
+
+// Standings returns the standings of the participants from the completed games, ranked by points, then Buchholz, then Sonneborn-Berger.
+// Buchholz is the sum of the points of every opponent, and Sonneborn-Berger is the sum of the points of every beaten opponent and half the points of every drawn opponent.
+// Participants with the same points and tie-breakers share a ranking, and stay in seed order.
+// A bye scores byePoints, and is only counted as a win if it scores anything.
+func Standings(participants []uint64, games []PairingGame, byePoints float64) []Standing {
+	standings := make([]Standing, len(participants))
+	index := make(map[uint64]int, len(participants))
+	for i, userId := range participants {
+		standings[i].UserID = userId
+		index[userId] = i
+	}
+	for _, game := range games {
+		if !game.Completed || (game.Second == 0 && byePoints == 0) {
+			continue
+		}
+		first, second := gamePoints(game, byePoints)
+		if i, ok := index[game.First]; ok {
+			standings[i].Points += first
+			addResult(&standings[i], first)
+		}
+		if i, ok := index[game.Second]; ok {
+			standings[i].Points += second
+			addResult(&standings[i], second)
+		}
+	}
+	for _, game := range games {
+		// Byes do not count towards tie-breakers
+		if !game.Completed || game.Second == 0 {
+			continue
+		}
+		first, second := gamePoints(game, byePoints)
+		i, firstOk := index[game.First]
+		j, secondOk := index[game.Second]
+		if !firstOk || !secondOk {
+			continue
+		}
+		standings[i].Buchholz += standings[j].Points
+		standings[j].Buchholz += standings[i].Points
+		standings[i].SonnebornBerger += first * standings[j].Points
+		standings[j].SonnebornBerger += second * standings[i].Points
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		return compareStandings(standings[i], standings[j]) > 0
+	})
+	for i := range standings {
+		if i > 0 && compareStandings(standings[i-1], standings[i]) == 0 {
+			standings[i].Ranking = standings[i-1].Ranking
+		} else {
+			standings[i].Ranking = uint64(i + 1)
+		}
+	}
+	return standings
+}
+
+// gamePoints returns the points each participant of the game scored, where a bye scores byePoints
+func gamePoints(game PairingGame, byePoints float64) (float64, float64) {
+	if game.Second == 0 {
+		return byePoints, 0
+	}
+	switch game.Outcome {
+	case FirstWon:
+		return 1, 0
+	case SecondWon:
+		return 0, 1
+	}
+	return 0.5, 0.5
+}
+
+func addResult(standing *Standing, points float64) {
+	switch points {
+	case 1:
+		standing.Wins++
+	case 0.5:
+		standing.Draws++
+	default:
+		standing.Losses++
+	}
+}
+
+func compareStandings(first Standing, second Standing) int {
+	for _, values := range [][2]float64{
+		{first.Points, second.Points},
+		{first.Buchholz, second.Buchholz},
+		{first.SonnebornBerger, second.SonnebornBerger},
+	} {
+		if values[0] > values[1] {
+			return 1
+		}
+		if values[0] < values[1] {
+			return -1
+		}
+	}
+	return 0
+}
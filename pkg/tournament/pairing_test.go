@@ -0,0 +1,179 @@
+package tournament
+
+import "testing"
+
+func Test_RoundRobinRounds_OneParticipant_Error(t *testing.T) {
+	_, err := RoundRobinRounds([]uint64{1})
+	if err != ErrNotEnoughParticipants {
+		t.Fatalf("Expected error to be %v, got %v", ErrNotEnoughParticipants, err)
+	}
+}
+
+func Test_RoundRobinRounds_EveryoneMeetsOnce(t *testing.T) {
+	for participants := 2; participants <= 12; participants++ {
+		userIds := make([]uint64, participants)
+		for i := range userIds {
+			userIds[i] = uint64(i + 1)
+		}
+		rounds, err := RoundRobinRounds(userIds)
+		if err != nil {
+			t.Fatal(err)
+		}
+		met := map[Pairing]int{}
+		byes := map[uint64]int{}
+		for _, round := range rounds {
+			playing := map[uint64]bool{}
+			for _, pairing := range round {
+				if playing[pairing.First] || (pairing.Second != 0 && playing[pairing.Second]) {
+					t.Fatalf("Expected users to play once per round with %d participants, got %v", participants, round)
+				}
+				playing[pairing.First] = true
+				if pairing.Second == 0 {
+					byes[pairing.First]++
+					continue
+				}
+				playing[pairing.Second] = true
+				if pairing.First > pairing.Second {
+					pairing.First, pairing.Second = pairing.Second, pairing.First
+				}
+				met[pairing]++
+			}
+		}
+		if len(met) != participants*(participants-1)/2 {
+			t.Fatalf("Expected %d games with %d participants, got %d", participants*(participants-1)/2, participants, len(met))
+		}
+		for pairing, count := range met {
+			if count != 1 {
+				t.Fatalf("Expected %v to meet once, met %d times", pairing, count)
+			}
+		}
+		if participants%2 == 1 && len(byes) != participants {
+			t.Fatalf("Expected every user to have a bye with %d participants, got %v", participants, byes)
+		}
+	}
+}
+
+func Test_SwissPairings_FirstRound_TopHalfPlaysBottomHalf(t *testing.T) {
+	pairings, err := SwissPairings([]uint64{1, 2, 3, 4}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pairings) != 2 || pairings[0] != (Pairing{First: 1, Second: 3}) || pairings[1] != (Pairing{First: 2, Second: 4}) {
+		t.Fatalf("Expected 1 against 3 and 2 against 4, got %v", pairings)
+	}
+}
+
+func Test_SwissPairings_SecondRound_WinnersMeetWithoutRematch(t *testing.T) {
+	games := []PairingGame{
+		{Pairing: Pairing{First: 1, Second: 3}, Outcome: FirstWon, Completed: true},
+		{Pairing: Pairing{First: 2, Second: 4}, Outcome: SecondWon, Completed: true},
+	}
+	pairings, err := SwissPairings([]uint64{1, 2, 3, 4}, games)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pairings[0] != (Pairing{First: 1, Second: 4}) || pairings[1] != (Pairing{First: 2, Second: 3}) {
+		t.Fatalf("Expected 1 against 4 and 2 against 3, got %v", pairings)
+	}
+}
+
+func Test_SwissPairings_OddParticipants_LowestWithoutByeGetsBye(t *testing.T) {
+	games := []PairingGame{
+		{Pairing: Pairing{First: 1, Second: 2}, Outcome: FirstWon, Completed: true},
+		{Pairing: Pairing{First: 3}, Completed: true},
+	}
+	pairings, err := SwissPairings([]uint64{1, 2, 3}, games)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bye := pairings[len(pairings)-1]
+	if bye != (Pairing{First: 2}) {
+		t.Fatalf("Expected 2 to get a bye, got %v", pairings)
+	}
+}
+
+func Test_SwissPairings_EveryoneHasPlayed_Error(t *testing.T) {
+	games := []PairingGame{
+		{Pairing: Pairing{First: 1, Second: 2}, Outcome: Draw, Completed: true},
+	}
+	_, err := SwissPairings([]uint64{1, 2}, games)
+	if err != ErrNoPairing {
+		t.Fatalf("Expected error to be %v, got %v", ErrNoPairing, err)
+	}
+}
+
+func Test_Standings_TieBreakers(t *testing.T) {
+	// 1, 2 and 3 beat each other in a cycle, so they share the lead on points and tie-breakers
+	games := []PairingGame{
+		{Pairing: Pairing{First: 1, Second: 3}, Outcome: FirstWon, Completed: true},
+		{Pairing: Pairing{First: 2, Second: 4}, Outcome: FirstWon, Completed: true},
+		{Pairing: Pairing{First: 1, Second: 2}, Outcome: SecondWon, Completed: true},
+		{Pairing: Pairing{First: 3, Second: 4}, Outcome: FirstWon, Completed: true},
+		{Pairing: Pairing{First: 1, Second: 4}, Outcome: FirstWon, Completed: true},
+		{Pairing: Pairing{First: 2, Second: 3}, Outcome: SecondWon, Completed: true},
+		{Pairing: Pairing{First: 4, Second: 5}, Completed: false},
+	}
+	standings := Standings([]uint64{1, 2, 3, 4}, games, SwissByePoints)
+	expected := []Standing{
+		{UserID: 1, Points: 2, Buchholz: 4, SonnebornBerger: 2, Wins: 2, Losses: 1, Ranking: 1},
+		{UserID: 2, Points: 2, Buchholz: 4, SonnebornBerger: 2, Wins: 2, Losses: 1, Ranking: 1},
+		{UserID: 3, Points: 2, Buchholz: 4, SonnebornBerger: 2, Wins: 2, Losses: 1, Ranking: 1},
+		{UserID: 4, Points: 0, Buchholz: 6, SonnebornBerger: 0, Losses: 3, Ranking: 4},
+	}
+	for i := range expected {
+		if standings[i] != expected[i] {
+			t.Fatalf("Expected standing %d to be %+v, got %+v", i, expected[i], standings[i])
+		}
+	}
+}
+
+func Test_Standings_DrawsAndByes(t *testing.T) {
+	games := []PairingGame{
+		{Pairing: Pairing{First: 1, Second: 2}, Outcome: Draw, Completed: true},
+		{Pairing: Pairing{First: 3}, Completed: true},
+		{Pairing: Pairing{First: 3, Second: 1}, Outcome: FirstWon, Completed: true},
+		{Pairing: Pairing{First: 2}, Completed: true},
+	}
+	standings := Standings([]uint64{1, 2, 3}, games, SwissByePoints)
+	if standings[0].UserID != 3 || standings[0].Points != 2 || standings[0].Buchholz != 0.5 || standings[0].SonnebornBerger != 0.5 {
+		t.Fatalf("Expected 3 to lead on 2 points, got %+v", standings[0])
+	}
+	if standings[1].UserID != 2 || standings[1].Points != 1.5 || standings[1].Draws != 1 || standings[1].Wins != 1 {
+		t.Fatalf("Expected 2 to be second on 1.5 points, got %+v", standings[1])
+	}
+	if standings[2].UserID != 1 || standings[2].Points != 0.5 || standings[2].SonnebornBerger != 0.75 {
+		t.Fatalf("Expected 1 to be last on 0.5 points, got %+v", standings[2])
+	}
+}
+
+func Test_SwissPairings_UnpairableBottom_GivesUpWithinBudget(t *testing.T) {
+	// 38, 39 and 40 have played everyone but each other, so one of them is always left without an opponent
+	participants := make([]uint64, 40)
+	for i := range participants {
+		participants[i] = uint64(i + 1)
+	}
+	games := []PairingGame{}
+	for _, userId := range []uint64{38, 39, 40} {
+		for opponent := uint64(1); opponent <= 37; opponent++ {
+			games = append(games, PairingGame{Pairing: Pairing{First: opponent, Second: userId}, Outcome: FirstWon, Completed: true})
+		}
+	}
+	_, err := SwissPairings(participants, games)
+	if err != ErrNoPairing {
+		t.Fatalf("Expected error to be %v, got %v", ErrNoPairing, err)
+	}
+}
+
+func Test_Standings_RoundRobinByeWorthNothing(t *testing.T) {
+	games := []PairingGame{
+		{Pairing: Pairing{First: 1, Second: 2}, Outcome: FirstWon, Completed: true},
+		{Pairing: Pairing{First: 3}, Completed: true},
+	}
+	standings := Standings([]uint64{1, 2, 3}, games, 0)
+	if standings[0].UserID != 1 || standings[0].Points != 1 {
+		t.Fatalf("Expected 1 to lead on 1 point, got %+v", standings[0])
+	}
+	if standings[1].UserID != 2 || standings[2].UserID != 3 || standings[2].Points != 0 || standings[2].Wins != 0 || standings[2].Losses != 0 {
+		t.Fatalf("Expected 3 to have no points or results from the bye, got %+v", standings)
+	}
+}
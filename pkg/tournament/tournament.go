@@ -1,9 +1,14 @@
 package tournament
 
 import (
+	"database/sql"
+	"errors"
 	"time"
+	// Definitions can be in any time zone, so the time zone database is embedded in case the host does not have one
+	_ "time/tzdata"
 
 	"github.com/MorhafAlshibly/coanda/api"
+	"github.com/robfig/cron/v3"
 )
 
 type WipeTimes struct {
@@ -12,28 +17,194 @@ type WipeTimes struct {
 	WeeklyTournamentDay     time.Weekday
 	MonthlyTournamentMinute uint16
 	MonthlyTournamentDay    uint8
+	// TimeZone is the time zone the wipe times are in, UTC if nil
+	TimeZone *time.Location
 }
 
+// NewWipeTimes creates wipe times from the columns they are stored in
+func NewWipeTimes(dailyTournamentMinute uint32, weeklyTournamentMinute uint32, weeklyTournamentDay uint32, monthlyTournamentMinute uint32, monthlyTournamentDay uint32, timeZone string) (WipeTimes, error) {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return WipeTimes{}, err
+	}
+	return WipeTimes{
+		DailyTournamentMinute:   uint16(dailyTournamentMinute),
+		WeeklyTournamentMinute:  uint16(weeklyTournamentMinute),
+		WeeklyTournamentDay:     time.Weekday(weeklyTournamentDay),
+		MonthlyTournamentMinute: uint16(monthlyTournamentMinute),
+		MonthlyTournamentDay:    uint8(monthlyTournamentDay),
+		TimeZone:                location,
+	}, nil
+}
+
+// GetStartTime returns the start of the current tournament in UTC.
+// The wipe times are wall clock times in the time zone, so a daily tournament wiped at midnight in a time zone with daylight saving lasts 23 or 25 hours when the clocks change.
 func GetStartTime(currentTime time.Time, interval api.TournamentInterval, times WipeTimes) time.Time {
+	location := times.TimeZone
+	if location == nil {
+		location = time.UTC
+	}
+	localTime := currentTime.In(location)
+	year, month, day := localTime.Date()
 	var startDate time.Time
 	switch interval {
 	case api.TournamentInterval_DAILY:
-		startDate = currentTime.UTC().Truncate(time.Hour * 24).Add(time.Duration(times.DailyTournamentMinute) * time.Minute)
-		if currentTime.UTC().Before(startDate) {
-			startDate = startDate.Add(-24 * time.Hour)
+		startDate = wallClock(year, month, day, times.DailyTournamentMinute, location)
+		if localTime.Before(startDate) {
+			startDate = wallClock(year, month, day-1, times.DailyTournamentMinute, location)
 		}
 	case api.TournamentInterval_WEEKLY:
-		startDate = currentTime.UTC().Truncate(time.Hour * 24).Add(time.Duration((int(times.WeeklyTournamentDay)-int(currentTime.UTC().Weekday())-7)%7) * 24 * time.Hour).Add(time.Duration(times.WeeklyTournamentMinute) * time.Minute)
-		if currentTime.UTC().Before(startDate) {
-			startDate = startDate.Add(-7 * 24 * time.Hour)
+		// Go rounds the remainder towards zero, so this is the number of days since the wipe day, as a negative number
+		dayOffset := (int(times.WeeklyTournamentDay) - int(localTime.Weekday()) - 7) % 7
+		startDate = wallClock(year, month, day+dayOffset, times.WeeklyTournamentMinute, location)
+		if localTime.Before(startDate) {
+			startDate = wallClock(year, month, day+dayOffset-7, times.WeeklyTournamentMinute, location)
 		}
 	case api.TournamentInterval_MONTHLY:
-		startDate = time.Date(currentTime.UTC().Year(), currentTime.UTC().Month(), int(times.MonthlyTournamentDay), 0, 0, 0, 0, time.UTC).Add(time.Duration(times.MonthlyTournamentMinute) * time.Minute)
-		if currentTime.UTC().Before(startDate) {
-			startDate = startDate.AddDate(0, -1, 0)
+		startDate = wallClock(year, month, int(times.MonthlyTournamentDay), times.MonthlyTournamentMinute, location)
+		if localTime.Before(startDate) {
+			startDate = wallClock(year, month-1, int(times.MonthlyTournamentDay), times.MonthlyTournamentMinute, location)
 		}
 	default:
-		startDate = time.Unix(0, 0).UTC()
+		startDate = time.Unix(0, 0)
+	}
+	return startDate.UTC()
+}
+
+// wallClock returns the time at the minute of the day in the location.
+// A time that is skipped when the clocks go forward is moved forward by the length of the gap, so 2:30am becomes 3:30am.
+func wallClock(year int, month time.Month, day int, minute uint16, location *time.Location) time.Time {
+	wall := time.Date(year, month, day, 0, int(minute), 0, 0, time.UTC)
+	date := time.Date(year, month, day, 0, int(minute), 0, 0, location)
+	if date.Hour() == wall.Hour() && date.Minute() == wall.Minute() {
+		return date
+	}
+	// Time zones do not change twice in a day, so the offset a day earlier is the offset before the gap
+	_, offset := date.AddDate(0, 0, -1).Zone()
+	return wall.Add(-time.Duration(offset) * time.Second).In(location)
+}
+
+// Definition is the schedule of a custom tournament. A new window starts on every activation of the cron schedule,
+// or every time the previous window has lasted for the duration if there is no cron schedule.
+type Definition struct {
+	CronSchedule *string
+	Duration     *time.Duration
+	StartsAt     time.Time
+	EndsAt       *time.Time
+	TimeZone     *time.Location
+}
+
+// NewDefinition creates a definition from the columns it is stored in
+func NewDefinition(cronSchedule sql.NullString, durationSeconds sql.NullInt64, startsAt time.Time, endsAt sql.NullTime, timeZone string) (Definition, error) {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return Definition{}, err
+	}
+	definition := Definition{
+		StartsAt: startsAt,
+		TimeZone: location,
+	}
+	if cronSchedule.Valid {
+		definition.CronSchedule = &cronSchedule.String
+	}
+	if durationSeconds.Valid {
+		duration := time.Duration(durationSeconds.Int64) * time.Second
+		definition.Duration = &duration
+	}
+	if endsAt.Valid {
+		definition.EndsAt = &endsAt.Time
+	}
+	return definition, nil
+}
+
+var ErrNoSchedule = errors.New("definition has neither a cron schedule nor a duration")
+
+func ParseCronSchedule(spec string) (cron.Schedule, error) {
+	return cron.ParseStandard(spec)
+}
+
+// GetDefinitionStartTime returns the start of the latest window of the definition at the current time, and whether that window is still running.
+// If the definition has not started yet, the unix epoch is returned.
+func GetDefinitionStartTime(currentTime time.Time, definition Definition) (time.Time, bool, error) {
+	if definition.CronSchedule == nil && (definition.Duration == nil || *definition.Duration <= 0) {
+		return time.Time{}, false, ErrNoSchedule
+	}
+	var schedule cron.Schedule
+	if definition.CronSchedule != nil {
+		var err error
+		schedule, err = ParseCronSchedule(*definition.CronSchedule)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+	}
+	location := definition.TimeZone
+	if location == nil {
+		location = time.UTC
+	}
+	// No window can start after the definition ends
+	searchTime := currentTime
+	if definition.EndsAt != nil && !searchTime.Before(*definition.EndsAt) {
+		searchTime = definition.EndsAt.Add(-time.Nanosecond)
+	}
+	var startDate time.Time
+	var found bool
+	if schedule != nil {
+		startDate, found = previousActivation(schedule, searchTime.In(location), definition.StartsAt.In(location))
+	} else if !searchTime.Before(definition.StartsAt) {
+		windows := searchTime.Sub(definition.StartsAt) / *definition.Duration
+		startDate, found = definition.StartsAt.Add(windows**definition.Duration), true
+	}
+	if !found {
+		return time.Unix(0, 0).UTC(), false, nil
+	}
+	endDate := definitionEndTime(startDate, definition, schedule, location)
+	return startDate.UTC(), currentTime.Before(endDate), nil
+}
+
+// definitionEndTime returns the time the window starting at the given time ends
+func definitionEndTime(startDate time.Time, definition Definition, schedule cron.Schedule, location *time.Location) time.Time {
+	var endDate time.Time
+	if schedule != nil {
+		endDate = schedule.Next(startDate.In(location))
+	}
+	if definition.Duration != nil && *definition.Duration > 0 {
+		durationEnd := startDate.Add(*definition.Duration)
+		if endDate.IsZero() || durationEnd.Before(endDate) {
+			endDate = durationEnd
+		}
+	}
+	if definition.EndsAt != nil && definition.EndsAt.Before(endDate) {
+		endDate = *definition.EndsAt
+	}
+	return endDate
+}
+
+// previousActivation returns the latest activation of the schedule that is not after the current time and not before the earliest time.
+// The schedule only looks forwards, so the search looks back over a window that doubles until it contains an activation.
+func previousActivation(schedule cron.Schedule, currentTime time.Time, earliest time.Time) (time.Time, bool) {
+	if currentTime.Before(earliest) {
+		return time.Time{}, false
+	}
+	// Next returns activations strictly after the given time, at a resolution of a second
+	floor := earliest.Add(-time.Second)
+	if earliest.Nanosecond() != 0 {
+		floor = earliest.Truncate(time.Second)
+	}
+	for lookback := time.Minute; ; lookback *= 2 {
+		from := currentTime.Add(-lookback)
+		reachedFloor := !from.After(floor)
+		if reachedFloor {
+			from = floor
+		}
+		activation := schedule.Next(from)
+		if !activation.IsZero() && !activation.After(currentTime) {
+			for next := schedule.Next(activation); !next.IsZero() && !next.After(currentTime); next = schedule.Next(next) {
+				activation = next
+			}
+			return activation, true
+		}
+		if reachedFloor {
+			return time.Time{}, false
+		}
 	}
-	return startDate
 }
@@ -189,3 +189,242 @@ func Test_GetStartTime_StartDateMonthlyAtNoonCurrentlyBeforeNoon_StartOfLastMont
 		t.Fatalf("Expected start date to be %s, got %s", correctDate, startDate)
 	}
 }
+
+func Test_GetStartTime_StartDateDailyInTimeZone_LocalMidnight(t *testing.T) {
+	location, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	times := WipeTimes{
+		TimeZone: location,
+	}
+	// 8pm UTC is already 5am the next day in Tokyo
+	currentTime := time.Date(2020, 1, 1, 20, 0, 0, 0, time.UTC)
+	correctDate := time.Date(2020, 1, 1, 15, 0, 0, 0, time.UTC)
+	startDate := GetStartTime(currentTime, api.TournamentInterval_DAILY, times)
+	if startDate != correctDate {
+		t.Fatalf("Expected start date to be %s, got %s", correctDate, startDate)
+	}
+}
+
+func Test_GetStartTime_StartDateDailyAfterClocksGoForward_LocalMidnight(t *testing.T) {
+	location, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	times := WipeTimes{
+		TimeZone: location,
+	}
+	// Clocks go forward on the 28th of March, so midnight on the 29th is 11pm UTC on the 28th
+	currentTime := time.Date(2021, 3, 29, 6, 0, 0, 0, time.UTC)
+	correctDate := time.Date(2021, 3, 28, 23, 0, 0, 0, time.UTC)
+	startDate := GetStartTime(currentTime, api.TournamentInterval_DAILY, times)
+	if startDate != correctDate {
+		t.Fatalf("Expected start date to be %s, got %s", correctDate, startDate)
+	}
+}
+
+func Test_GetStartTime_StartDateDailyOnDayClocksGoBack_LocalMidnightBeforeChange(t *testing.T) {
+	location, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	times := WipeTimes{
+		TimeZone: location,
+	}
+	// Clocks go back on the 31st of October, so that tournament lasts 25 hours
+	currentTime := time.Date(2021, 10, 31, 23, 30, 0, 0, time.UTC)
+	correctDate := time.Date(2021, 10, 30, 23, 0, 0, 0, time.UTC)
+	startDate := GetStartTime(currentTime, api.TournamentInterval_DAILY, times)
+	if startDate != correctDate {
+		t.Fatalf("Expected start date to be %s, got %s", correctDate, startDate)
+	}
+}
+
+func Test_GetStartTime_StartDateDailyInSkippedHour_AfterClocksGoForward(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	times := WipeTimes{
+		DailyTournamentMinute: 150,
+		TimeZone:              location,
+	}
+	// 2:30am does not exist on the 14th of March, so the tournament starts at 3:30am daylight time
+	currentTime := time.Date(2021, 3, 14, 12, 0, 0, 0, time.UTC)
+	correctDate := time.Date(2021, 3, 14, 7, 30, 0, 0, time.UTC)
+	startDate := GetStartTime(currentTime, api.TournamentInterval_DAILY, times)
+	if startDate != correctDate {
+		t.Fatalf("Expected start date to be %s, got %s", correctDate, startDate)
+	}
+}
+
+func Test_GetStartTime_StartDateWeeklyAfterClocksGoBack_LocalMonday(t *testing.T) {
+	location, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Fatal(err)
+	}
+	times := WipeTimes{
+		WeeklyTournamentMinute: 540,
+		WeeklyTournamentDay:    time.Monday,
+		TimeZone:               location,
+	}
+	// Clocks go back on the 4th of April, and it is already 9:30am on Monday in Sydney
+	currentTime := time.Date(2021, 4, 4, 23, 30, 0, 0, time.UTC)
+	correctDate := time.Date(2021, 4, 4, 23, 0, 0, 0, time.UTC)
+	startDate := GetStartTime(currentTime, api.TournamentInterval_WEEKLY, times)
+	if startDate != correctDate {
+		t.Fatalf("Expected start date to be %s, got %s", correctDate, startDate)
+	}
+}
+
+func Test_GetStartTime_StartDateMonthlyInSummerTime_LocalFirstOfMonth(t *testing.T) {
+	location, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	times := WipeTimes{
+		MonthlyTournamentDay: 1,
+		TimeZone:             location,
+	}
+	currentTime := time.Date(2021, 10, 15, 0, 0, 0, 0, time.UTC)
+	correctDate := time.Date(2021, 9, 30, 23, 0, 0, 0, time.UTC)
+	startDate := GetStartTime(currentTime, api.TournamentInterval_MONTHLY, times)
+	if startDate != correctDate {
+		t.Fatalf("Expected start date to be %s, got %s", correctDate, startDate)
+	}
+}
+
+func Test_GetDefinitionStartTime_NoSchedule_Error(t *testing.T) {
+	definition := Definition{
+		StartsAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	_, _, err := GetDefinitionStartTime(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), definition)
+	if err != ErrNoSchedule {
+		t.Fatalf("Expected error to be %v, got %v", ErrNoSchedule, err)
+	}
+}
+
+func Test_GetDefinitionStartTime_BeforeStart_NotActive(t *testing.T) {
+	duration := time.Hour
+	definition := Definition{
+		Duration: &duration,
+		StartsAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	startDate, active, err := GetDefinitionStartTime(time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC), definition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if active {
+		t.Fatal("Expected definition to not be active")
+	}
+	if startDate != time.Unix(0, 0).UTC() {
+		t.Fatalf("Expected start date to be the unix epoch, got %s", startDate)
+	}
+}
+
+func Test_GetDefinitionStartTime_Duration_CurrentWindow(t *testing.T) {
+	duration := 90 * time.Minute
+	definition := Definition{
+		Duration: &duration,
+		StartsAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	startDate, active, err := GetDefinitionStartTime(time.Date(2020, 1, 1, 4, 0, 0, 0, time.UTC), definition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !active {
+		t.Fatal("Expected definition to be active")
+	}
+	correctDate := time.Date(2020, 1, 1, 3, 0, 0, 0, time.UTC)
+	if startDate != correctDate {
+		t.Fatalf("Expected start date to be %s, got %s", correctDate, startDate)
+	}
+}
+
+func Test_GetDefinitionStartTime_DurationAfterEnd_LastWindowNotActive(t *testing.T) {
+	duration := 90 * time.Minute
+	endsAt := time.Date(2020, 1, 1, 4, 0, 0, 0, time.UTC)
+	definition := Definition{
+		Duration: &duration,
+		StartsAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:   &endsAt,
+	}
+	startDate, active, err := GetDefinitionStartTime(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), definition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if active {
+		t.Fatal("Expected definition to not be active")
+	}
+	correctDate := time.Date(2020, 1, 1, 3, 0, 0, 0, time.UTC)
+	if startDate != correctDate {
+		t.Fatalf("Expected start date to be %s, got %s", correctDate, startDate)
+	}
+}
+
+func Test_GetDefinitionStartTime_CronWithDurationAfterWindow_NotActive(t *testing.T) {
+	cronSchedule := "0 18 * * FRI"
+	duration := 48 * time.Hour
+	definition := Definition{
+		CronSchedule: &cronSchedule,
+		Duration:     &duration,
+		StartsAt:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	// Tuesday after the weekend tournament
+	startDate, active, err := GetDefinitionStartTime(time.Date(2020, 1, 7, 12, 0, 0, 0, time.UTC), definition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if active {
+		t.Fatal("Expected definition to not be active")
+	}
+	correctDate := time.Date(2020, 1, 3, 18, 0, 0, 0, time.UTC)
+	if startDate != correctDate {
+		t.Fatalf("Expected start date to be %s, got %s", correctDate, startDate)
+	}
+}
+
+func Test_GetDefinitionStartTime_CronOnStart_StartIncluded(t *testing.T) {
+	cronSchedule := "0 0 1 * *"
+	definition := Definition{
+		CronSchedule: &cronSchedule,
+		StartsAt:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	startDate, active, err := GetDefinitionStartTime(time.Date(2020, 1, 20, 0, 0, 0, 0, time.UTC), definition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !active {
+		t.Fatal("Expected definition to be active")
+	}
+	correctDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	if startDate != correctDate {
+		t.Fatalf("Expected start date to be %s, got %s", correctDate, startDate)
+	}
+}
+
+func Test_GetDefinitionStartTime_CronInTimeZoneAfterDaylightSaving_LocalMidnight(t *testing.T) {
+	location, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cronSchedule := "0 0 * * *"
+	definition := Definition{
+		CronSchedule: &cronSchedule,
+		StartsAt:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		TimeZone:     location,
+	}
+	// Clocks go forward on the 28th of March, so midnight on the 29th is 11pm UTC on the 28th
+	startDate, active, err := GetDefinitionStartTime(time.Date(2021, 3, 28, 23, 30, 0, 0, time.UTC), definition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !active {
+		t.Fatal("Expected definition to be active")
+	}
+	correctDate := time.Date(2021, 3, 28, 23, 0, 0, 0, time.UTC)
+	if startDate != correctDate {
+		t.Fatalf("Expected start date to be %s, got %s", correctDate, startDate)
+	}
+}